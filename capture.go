@@ -0,0 +1,351 @@
+package imgui
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/Splizard/imgui/golang"
+)
+
+// ImGuiCaptureNode is a node of the UI hierarchy recorded by LogToCapture(): the window, its tree nodes, tables,
+// rows and cells, and the widgets with their labels and current values. It can be exported to JSON or Markdown,
+// e.g. to implement an "export this panel" feature or to compare a text snapshot of the UI in tests.
+type ImGuiCaptureNode struct {
+	Type     ImGuiCaptureNodeType `json:"type"`
+	Label    string               `json:"label,omitempty"`
+	Value    string               `json:"value,omitempty"`
+	Columns  []string             `json:"columns,omitempty"` // Table: names declared with TableSetupColumn(), "" when unnamed
+	Children []*ImGuiCaptureNode  `json:"children,omitempty"`
+}
+
+// ImGuiCaptureStackData is an open node while capturing, along with what closes it.
+type ImGuiCaptureStackData struct {
+	Node      *ImGuiCaptureNode
+	Window    *ImGuiWindow // Window the node was submitted in
+	Table     *ImGuiTable  // Table/row/cell nodes: owner table
+	TreeDepth int          // Tree nodes: depth of their children, the node is closed when an item of Window is submitted at a lower depth
+}
+
+var captureNodeTypeNames = [...]string{
+	ImGuiCaptureNodeType_Window:         "window",
+	ImGuiCaptureNodeType_Text:           "text",
+	ImGuiCaptureNodeType_Button:         "button",
+	ImGuiCaptureNodeType_Value:          "value",
+	ImGuiCaptureNodeType_Checkbox:       "checkbox",
+	ImGuiCaptureNodeType_RadioButton:    "radio_button",
+	ImGuiCaptureNodeType_TreeNode:       "tree_node",
+	ImGuiCaptureNodeType_Separator:      "separator",
+	ImGuiCaptureNodeType_Table:          "table",
+	ImGuiCaptureNodeType_TableRow:       "table_row",
+	ImGuiCaptureNodeType_TableHeaderRow: "table_header_row",
+	ImGuiCaptureNodeType_TableCell:      "table_cell",
+}
+
+func (t ImGuiCaptureNodeType) String() string {
+	if t >= 0 && int(t) < int(len(captureNodeTypeNames)) {
+		return captureNodeTypeNames[t]
+	}
+	return "unknown"
+}
+
+func (t ImGuiCaptureNodeType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *ImGuiCaptureNodeType) UnmarshalText(text []byte) error {
+	for i, name := range captureNodeTypeNames {
+		if name == string(text) {
+			*t = ImGuiCaptureNodeType(i)
+			return nil
+		}
+	}
+	return &json.UnsupportedValueError{Str: string(text)}
+}
+
+// LogToCapture start capturing the UI hierarchy into root, which is reset to a window node named after the current window.
+// Like the other logging functions, tree nodes are automatically opened up to auto_open_depth and the capture ends with
+// LogFinish() or at the End() of the window. Text passed to LogText() is not captured.
+func (g *ImGuiContext) LogToCapture(root *ImGuiCaptureNode, auto_open_depth int /*= -1*/) {
+	if g.LogEnabled {
+		return
+	}
+	IM_ASSERT(root != nil)
+	var window = g.CurrentWindow
+	*root = ImGuiCaptureNode{Type: ImGuiCaptureNodeType_Window, Label: FindRenderedTextEnd(window.Name)}
+	g.LogBegin(ImGuiLogType_Capture, auto_open_depth)
+	g.LogCaptureStack = append(g.LogCaptureStack[:0], ImGuiCaptureStackData{Node: root, Window: window})
+	g.LogCaptureLabelTarget = nil
+}
+
+func (g *ImGuiContext) logCaptureTop() *ImGuiCaptureStackData {
+	return &g.LogCaptureStack[len(g.LogCaptureStack)-1]
+}
+
+func (g *ImGuiContext) logCapturePush(data ImGuiCaptureStackData) {
+	g.logCaptureAdd(data.Node)
+	g.LogCaptureStack = append(g.LogCaptureStack, data)
+}
+
+func (g *ImGuiContext) logCaptureAdd(node *ImGuiCaptureNode) {
+	var top = g.logCaptureTop().Node
+	top.Children = append(top.Children, node)
+}
+
+// logCapturePopTo closes the nodes opened after the last node matching the given type and table, returns false when there is none.
+func (g *ImGuiContext) logCapturePopTo(node_type ImGuiCaptureNodeType, table *ImGuiTable) bool {
+	for n := int(len(g.LogCaptureStack)) - 1; n > 0; n-- {
+		if data := &g.LogCaptureStack[n]; data.Node.Type == node_type && data.Table == table {
+			g.LogCaptureStack = g.LogCaptureStack[:n+1]
+			return true
+		}
+	}
+	return false
+}
+
+// LogCaptureRenderedText is called by LogRenderedText() when capturing: the kind of item is deduced from the
+// decorations set with LogSetNextTextDecoration() and from the checkbox/radio marks.
+func (g *ImGuiContext) LogCaptureRenderedText(ref_pos *ImVec2, prefix, text, suffix string) {
+	var window = g.CurrentWindow
+
+	var new_line = ref_pos == nil || ref_pos.y > g.LogLinePosY+g.Style.FramePadding.y+1
+	if ref_pos != nil {
+		g.LogLinePosY = ref_pos.y
+	}
+	if new_line {
+		g.LogCaptureLabelTarget = nil
+	}
+
+	// Close the tree nodes of this window we have popped out of
+	for len(g.LogCaptureStack) > 1 {
+		var top = g.logCaptureTop()
+		if top.Node.Type != ImGuiCaptureNodeType_TreeNode || top.Window != window || top.TreeDepth <= window.DC.TreeDepth {
+			break
+		}
+		g.LogCaptureStack = g.LogCaptureStack[:len(g.LogCaptureStack)-1]
+	}
+
+	var node = &ImGuiCaptureNode{Type: ImGuiCaptureNodeType_Text, Label: text}
+	switch {
+	case prefix == "[" && suffix == "]":
+		node.Type = ImGuiCaptureNodeType_Button
+	case prefix == "{" && suffix == "}":
+		*node = ImGuiCaptureNode{Type: ImGuiCaptureNodeType_Value, Value: text}
+	case prefix == ">" || (prefix == "###" && suffix == "###"):
+		node.Type = ImGuiCaptureNodeType_TreeNode
+		g.LogCaptureLabelTarget = nil
+		g.logCapturePush(ImGuiCaptureStackData{Node: node, Window: window, TreeDepth: window.DC.TreeDepth + 1})
+		return
+	case prefix == "" && (text == "[ ]" || text == "[x]" || text == "[~]"):
+		var values = map[string]string{"[ ]": "false", "[x]": "true", "[~]": "mixed"}
+		*node = ImGuiCaptureNode{Type: ImGuiCaptureNodeType_Checkbox, Value: values[text]}
+	case prefix == "" && (text == "( )" || text == "(X)"):
+		*node = ImGuiCaptureNode{Type: ImGuiCaptureNodeType_RadioButton, Value: "false"}
+		if text == "(X)" {
+			node.Value = "true"
+		}
+	case prefix == "" && strings.HasPrefix(text, "---") && strings.Trim(text, "-\n") == "":
+		*node = ImGuiCaptureNode{Type: ImGuiCaptureNodeType_Separator}
+	case g.LogCaptureLabelTarget != nil:
+		// Label of the preceding value, e.g. "{0.500}" followed by "Speed"
+		g.LogCaptureLabelTarget.Label = text
+		g.LogCaptureLabelTarget = nil
+		return
+	}
+
+	g.LogCaptureLabelTarget = nil
+	if node.Type == ImGuiCaptureNodeType_Value || node.Type == ImGuiCaptureNodeType_Checkbox || node.Type == ImGuiCaptureNodeType_RadioButton {
+		g.LogCaptureLabelTarget = node
+	}
+	g.logCaptureAdd(node)
+}
+
+// LogCaptureBeginTable is called by BeginTable() when capturing.
+func (g *ImGuiContext) LogCaptureBeginTable(table *ImGuiTable, name string) {
+	g.LogCaptureLabelTarget = nil
+	g.logCapturePush(ImGuiCaptureStackData{Node: &ImGuiCaptureNode{Type: ImGuiCaptureNodeType_Table, Label: FindRenderedTextEnd(name)}, Window: table.OuterWindow, Table: table})
+}
+
+// LogCaptureEndTable is called by EndTable() when capturing, column names are only known at this point.
+func (g *ImGuiContext) LogCaptureEndTable(table *ImGuiTable) {
+	g.LogCaptureLabelTarget = nil
+	if !g.logCapturePopTo(ImGuiCaptureNodeType_Table, table) {
+		return
+	}
+	var node = g.logCaptureTop().Node
+	node.Columns = make([]string, table.ColumnsCount)
+	for column_n := int(0); column_n < table.ColumnsCount; column_n++ {
+		node.Columns[column_n] = tableGetColumnName(table, column_n)
+	}
+	g.LogCaptureStack = g.LogCaptureStack[:len(g.LogCaptureStack)-1]
+}
+
+// LogCaptureTableBeginRow is called by TableBeginRow() when capturing.
+func (g *ImGuiContext) LogCaptureTableBeginRow(table *ImGuiTable) {
+	g.LogCaptureLabelTarget = nil
+	if !g.logCapturePopTo(ImGuiCaptureNodeType_Table, table) {
+		return
+	}
+	var row_type = ImGuiCaptureNodeType_TableRow
+	if table.RowFlags&ImGuiTableRowFlags_Headers != 0 {
+		row_type = ImGuiCaptureNodeType_TableHeaderRow
+	}
+	g.logCapturePush(ImGuiCaptureStackData{Node: &ImGuiCaptureNode{Type: row_type}, Window: table.InnerWindow, Table: table})
+}
+
+// LogCaptureTableBeginCell is called by TableBeginCell() when capturing. Cells of the columns skipped by the
+// clipping are left out, like in text logs.
+func (g *ImGuiContext) LogCaptureTableBeginCell(table *ImGuiTable) {
+	g.LogCaptureLabelTarget = nil
+	if !g.logCapturePopTo(ImGuiCaptureNodeType_TableRow, table) && !g.logCapturePopTo(ImGuiCaptureNodeType_TableHeaderRow, table) {
+		return
+	}
+	g.logCapturePush(ImGuiCaptureStackData{Node: &ImGuiCaptureNode{Type: ImGuiCaptureNodeType_TableCell}, Window: table.InnerWindow, Table: table})
+}
+
+// JSON returns the node and its children as indented JSON.
+func (node *ImGuiCaptureNode) JSON() string {
+	data, err := json.MarshalIndent(node, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// Markdown returns the node and its children as Markdown: the window name is a heading, the items are
+// nested lists (checkboxes are task list items) and the tables are GitHub Flavored Markdown tables.
+func (node *ImGuiCaptureNode) Markdown() string {
+	var out strings.Builder
+	if node.Type == ImGuiCaptureNodeType_Window {
+		out.WriteString("# " + node.Label + "\n")
+		for _, child := range node.Children {
+			child.writeMarkdown(&out, "")
+		}
+	} else {
+		node.writeMarkdown(&out, "")
+	}
+	return out.String()
+}
+
+func (node *ImGuiCaptureNode) writeMarkdown(out *strings.Builder, indent string) {
+	switch node.Type {
+	case ImGuiCaptureNodeType_Separator:
+		if indent == "" {
+			out.WriteString("\n---\n\n")
+		}
+	case ImGuiCaptureNodeType_Table:
+		node.writeMarkdownTable(out, indent)
+	case ImGuiCaptureNodeType_TableRow, ImGuiCaptureNodeType_TableHeaderRow, ImGuiCaptureNodeType_TableCell:
+		for _, child := range node.Children {
+			child.writeMarkdown(out, indent)
+		}
+	default:
+		var lines = strings.Split(node.markdownInline(), "\n")
+		out.WriteString(indent + "- " + lines[0] + "\n")
+		for _, line := range lines[1:] {
+			out.WriteString(indent + "  " + line + "\n")
+		}
+		for _, child := range node.Children {
+			child.writeMarkdown(out, indent+"  ")
+		}
+	}
+}
+
+// markdownInline returns the text of a single item, without its children.
+func (node *ImGuiCaptureNode) markdownInline() string {
+	switch node.Type {
+	case ImGuiCaptureNodeType_Button:
+		return "[" + node.Label + "]"
+	case ImGuiCaptureNodeType_Value:
+		if node.Label == "" {
+			return "`" + node.Value + "`"
+		}
+		return node.Label + ": `" + node.Value + "`"
+	case ImGuiCaptureNodeType_Checkbox:
+		var marks = map[string]string{"false": "[ ]", "true": "[x]", "mixed": "[~]"}
+		return marks[node.Value] + " " + node.Label
+	case ImGuiCaptureNodeType_RadioButton:
+		if node.Value == "true" {
+			return "(x) " + node.Label
+		}
+		return "( ) " + node.Label
+	case ImGuiCaptureNodeType_Separator:
+		return ""
+	}
+	return node.Label
+}
+
+// markdownCell returns the items of a table cell on a single line.
+func (node *ImGuiCaptureNode) markdownCell() string {
+	var items []string
+	var walk func(n *ImGuiCaptureNode)
+	walk = func(n *ImGuiCaptureNode) {
+		if text := n.markdownInline(); text != "" {
+			items = append(items, text)
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	for _, child := range node.Children {
+		walk(child)
+	}
+	var cell = strings.Join(items, " ")
+	cell = strings.ReplaceAll(cell, "|", "\\|")
+	return strings.ReplaceAll(cell, "\n", "<br>")
+}
+
+func (node *ImGuiCaptureNode) writeMarkdownTable(out *strings.Builder, indent string) {
+	var header []string
+	var rows [][]string
+	for _, row := range node.Children {
+		var cells []string
+		for _, cell := range row.Children {
+			cells = append(cells, cell.markdownCell())
+		}
+		if row.Type == ImGuiCaptureNodeType_TableHeaderRow && header == nil {
+			header = cells
+		} else {
+			rows = append(rows, cells)
+		}
+	}
+
+	// Prefer the names declared with TableSetupColumn(), the header row may have been clipped
+	var columns_count = int(len(node.Columns))
+	for _, cells := range append(rows, header) {
+		if int(len(cells)) > columns_count {
+			columns_count = int(len(cells))
+		}
+	}
+	if columns_count == 0 {
+		return
+	}
+	var named = false
+	for _, name := range node.Columns {
+		named = named || name != ""
+	}
+	if named {
+		header = make([]string, len(node.Columns))
+		for n, name := range node.Columns {
+			header[n] = strings.ReplaceAll(name, "|", "\\|")
+		}
+	}
+
+	var write_row = func(cells []string) {
+		out.WriteString(indent + "|")
+		for n := int(0); n < columns_count; n++ {
+			var cell string
+			if n < int(len(cells)) {
+				cell = cells[n]
+			}
+			out.WriteString(" " + cell + " |")
+		}
+		out.WriteString("\n")
+	}
+	out.WriteString("\n")
+	write_row(header)
+	out.WriteString(indent + "|" + strings.Repeat(" --- |", golang.Int(columns_count)) + "\n")
+	for _, cells := range rows {
+		write_row(cells)
+	}
+	out.WriteString("\n")
+}
@@ -0,0 +1,82 @@
+package imgui
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLogToCapture(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+
+	var capture ImGuiCaptureNode
+	var enabled = true
+	var speed float = 0.5
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.SetNextWindowSize(&ImVec2{400, 400}, 0)
+		ui.Begin("Panel###panel", nil, 0)
+		ui.LogToCapture(&capture, -1)
+		ui.Text("Settings")
+		ui.Checkbox("Enabled", &enabled)
+		ui.SliderFloat("Speed", &speed, 0, 1, "%.2f", 0)
+		ui.RadioButtonBool("Fast", true)
+		if ui.TreeNode("Advanced") {
+			ui.Button("Reset")
+			ui.TreePop()
+		}
+		ui.Separator()
+		if ui.BeginTable("items", 2, 0, ImVec2{}, 0) {
+			ui.TableSetupColumn("Name", 0, 0, 0)
+			ui.TableSetupColumn("Count", 0, 0, 0)
+			ui.TableHeadersRow()
+			ui.TableNextRow(0, 0)
+			ui.TableNextColumn()
+			ui.Text("apples")
+			ui.TableNextColumn()
+			ui.Text("3")
+			ui.EndTable()
+		}
+		ui.Text("Done")
+		ui.End()
+	})
+
+	var want = `# Panel
+- Settings
+- [x] Enabled
+- Speed: ` + "`0.50`" + `
+- (x) Fast
+- Advanced
+  - [Reset]
+
+---
+
+
+| Name | Count |
+| --- | --- |
+| apples | 3 |
+
+- Done
+`
+	if got := capture.Markdown(); got != want {
+		t.Errorf("markdown:\n%s\nwant:\n%s", got, want)
+	}
+
+	var decoded ImGuiCaptureNode
+	if err := json.Unmarshal([]byte(capture.JSON()), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Children) != 8 {
+		t.Fatalf("captured %d items, want 8:\n%s", len(decoded.Children), capture.JSON())
+	}
+	var tree, table = decoded.Children[4], decoded.Children[6]
+	if tree.Type != ImGuiCaptureNodeType_TreeNode || len(tree.Children) != 1 || tree.Children[0].Type != ImGuiCaptureNodeType_Button {
+		t.Errorf("tree node not captured with its children:\n%s", capture.JSON())
+	}
+	if table.Type != ImGuiCaptureNodeType_Table || len(table.Columns) != 2 || table.Columns[1] != "Count" || len(table.Children) != 2 ||
+		table.Children[0].Type != ImGuiCaptureNodeType_TableHeaderRow || table.Children[1].Children[0].Children[0].Label != "apples" {
+		t.Errorf("table not captured:\n%s", capture.JSON())
+	}
+	if slider := decoded.Children[2]; slider.Type != ImGuiCaptureNodeType_Value || slider.Label != "Speed" || slider.Value != "0.50" {
+		t.Errorf("slider captured as %+v", slider)
+	}
+}
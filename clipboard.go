@@ -3,32 +3,31 @@ package imgui
 // Clipboard Utilities
 // - Also see the LogToClipboard() function to capture GUI into clipboard, or easily output text data to the clipboard.
 
-func GetClipboardText() string {
-	var g = GImGui
+func (g *ImGuiContext) GetClipboardText() string {
 	if g.IO.GetClipboardTextFn != nil {
 		return g.IO.GetClipboardTextFn(g.IO.ClipboardUserData)
 	}
 	return ""
 }
 
-func SetClipboardText(text string) {
-	var g = GImGui
+func (g *ImGuiContext) SetClipboardText(text string) {
 	if g.IO.SetClipboardTextFn != nil {
 		g.IO.SetClipboardTextFn(g.IO.ClipboardUserData, text)
 	}
 }
 
 // GetClipboardTextFn_DefaultImpl Local Dear ImGui-only clipboard implementation, if user hasn't defined better clipboard handlers.
-func GetClipboardTextFn_DefaultImpl(any) string {
-	var g = GImGui
+// The default implementations use the context as user data, see Initialize().
+func GetClipboardTextFn_DefaultImpl(user_data_ctx any) string {
+	var g = user_data_ctx.(*ImGuiContext)
 	if len(g.ClipboardHandlerData) == 0 {
 		return ""
 	}
 	return string(g.ClipboardHandlerData)
 }
 
-func SetClipboardTextFn_DefaultImpl(_ any, text string) {
-	var g = GImGui
+func SetClipboardTextFn_DefaultImpl(user_data_ctx any, text string) {
+	var g = user_data_ctx.(*ImGuiContext)
 	g.ClipboardHandlerData = g.ClipboardHandlerData[:0]
 	g.ClipboardHandlerData = []byte(text)
 }
@@ -0,0 +1,3094 @@
+package imgui
+
+import (
+	"io"
+)
+
+// The functions below work on the current context, see SetCurrentContext(). They call the method of the same name
+// of ImGuiContext, which documents them: use the methods directly to work with several contexts at once.
+
+// capture.go
+
+func LogToCapture(root *ImGuiCaptureNode, auto_open_depth int /*= -1*/) {
+	GImGui.LogToCapture(root, auto_open_depth)
+}
+
+func LogCaptureRenderedText(ref_pos *ImVec2, prefix, text, suffix string) {
+	GImGui.LogCaptureRenderedText(ref_pos, prefix, text, suffix)
+}
+
+func LogCaptureBeginTable(table *ImGuiTable, name string) {
+	GImGui.LogCaptureBeginTable(table, name)
+}
+
+func LogCaptureEndTable(table *ImGuiTable) {
+	GImGui.LogCaptureEndTable(table)
+}
+
+func LogCaptureTableBeginRow(table *ImGuiTable) {
+	GImGui.LogCaptureTableBeginRow(table)
+}
+
+func LogCaptureTableBeginCell(table *ImGuiTable) {
+	GImGui.LogCaptureTableBeginCell(table)
+}
+
+// clipboard.go
+
+func GetClipboardText() string {
+	return GImGui.GetClipboardText()
+}
+
+func SetClipboardText(text string) {
+	GImGui.SetClipboardText(text)
+}
+
+// context.go
+
+// debug.go
+
+func ErrorCheckEndFrameRecover(log_callback ImGuiErrorLogCallback, user_data any) {
+	GImGui.ErrorCheckEndFrameRecover(log_callback, user_data)
+}
+
+func DebugDrawItemRect(col ImU32 /*= IM_COL32(255,0,0,255)*/) {
+	GImGui.DebugDrawItemRect(col)
+}
+
+func DebugStartItemPicker() {
+	GImGui.DebugStartItemPicker()
+}
+
+func ShowFontAtlas(atlas *ImFontAtlas) {
+	GImGui.ShowFontAtlas(atlas)
+}
+
+func DebugNodeColumns(columns *ImGuiOldColumns) {
+	GImGui.DebugNodeColumns(columns)
+}
+
+func DebugNodeDrawList(window *ImGuiWindow, draw_list *ImDrawList, label string) {
+	GImGui.DebugNodeDrawList(window, draw_list, label)
+}
+
+func DebugNodeFont(font *ImFont) {
+	GImGui.DebugNodeFont(font)
+}
+
+func DebugNodeStorage(storage *ImGuiStorage, label string) {
+	GImGui.DebugNodeStorage(storage, label)
+}
+
+func DebugNodeTabBar(tab_bar *ImGuiTabBar, label string) {
+	GImGui.DebugNodeTabBar(tab_bar, label)
+}
+
+func DebugNodeTable(table *ImGuiTable) {
+	GImGui.DebugNodeTable(table)
+}
+
+func DebugNodeTableSettings(settings *ImGuiTableSettings) {
+	GImGui.DebugNodeTableSettings(settings)
+}
+
+func DebugNodeWindow(window *ImGuiWindow, label string) {
+	GImGui.DebugNodeWindow(window, label)
+}
+
+func DebugNodeWindowSettings(settings *ImGuiWindowSettings) {
+	GImGui.DebugNodeWindowSettings(settings)
+}
+
+func DebugNodeWindowsList(windows []*ImGuiWindow, label string) {
+	GImGui.DebugNodeWindowsList(windows, label)
+}
+
+func DebugNodeViewport(viewport *ImGuiViewportP) {
+	GImGui.DebugNodeViewport(viewport)
+}
+
+func DebugRenderViewportThumbnail(draw_list *ImDrawList, viewport *ImGuiViewportP, bb *ImRect) {
+	GImGui.DebugRenderViewportThumbnail(draw_list, viewport, bb)
+}
+
+func MetricsHelpMarker(desc string) {
+	GImGui.MetricsHelpMarker(desc)
+}
+
+func RenderViewportsThumbnails() {
+	GImGui.RenderViewportsThumbnails()
+}
+
+func UpdateDebugToolItemPicker() {
+	GImGui.UpdateDebugToolItemPicker()
+}
+
+func ShowMetricsWindow(p_open *bool) {
+	GImGui.ShowMetricsWindow(p_open)
+}
+
+// demo.apps.go
+
+func ShowExampleAppMainMenuBar() {
+	GImGui.ShowExampleAppMainMenuBar()
+}
+
+func ShowExampleMenuFile() {
+	GImGui.ShowExampleMenuFile()
+}
+
+func ShowExampleAppConsole(p_open *bool) {
+	GImGui.ShowExampleAppConsole(p_open)
+}
+
+func ShowExampleAppLog(p_open *bool) {
+	GImGui.ShowExampleAppLog(p_open)
+}
+
+func ShowExampleAppLayout(p_open *bool) {
+	GImGui.ShowExampleAppLayout(p_open)
+}
+
+func ShowPlaceholderObject(prefix string, uid int) {
+	GImGui.ShowPlaceholderObject(prefix, uid)
+}
+
+func ShowExampleAppPropertyEditor(p_open *bool) {
+	GImGui.ShowExampleAppPropertyEditor(p_open)
+}
+
+func ShowExampleAppLongText(p_open *bool) {
+	GImGui.ShowExampleAppLongText(p_open)
+}
+
+func ShowExampleAppAutoResize(p_open *bool) {
+	GImGui.ShowExampleAppAutoResize(p_open)
+}
+
+func ShowExampleAppConstrainedResize(p_open *bool) {
+	GImGui.ShowExampleAppConstrainedResize(p_open)
+}
+
+func ShowExampleAppSimpleOverlay(p_open *bool) {
+	GImGui.ShowExampleAppSimpleOverlay(p_open)
+}
+
+func ShowExampleAppFullscreen(p_open *bool) {
+	GImGui.ShowExampleAppFullscreen(p_open)
+}
+
+func ShowExampleAppWindowTitles(p_open *bool) {
+	GImGui.ShowExampleAppWindowTitles(p_open)
+}
+
+func ShowExampleAppCustomRendering(p_open *bool) {
+	GImGui.ShowExampleAppCustomRendering(p_open)
+}
+
+func NotifyOfDocumentsClosedElsewhere(app *ExampleAppDocuments) {
+	GImGui.NotifyOfDocumentsClosedElsewhere(app)
+}
+
+func ShowExampleAppDocuments(p_open *bool) {
+	GImGui.ShowExampleAppDocuments(p_open)
+}
+
+// demo.go
+
+func HelpMarker(desc string) {
+	GImGui.HelpMarker(desc)
+}
+
+func ShowUserGuide() {
+	GImGui.ShowUserGuide()
+}
+
+func ShowDemoWindow(p_open *bool) {
+	GImGui.ShowDemoWindow(p_open)
+}
+
+func ShowDemoWindowWidgets() {
+	GImGui.ShowDemoWindowWidgets()
+}
+
+func ShowDemoWindowLayout() {
+	GImGui.ShowDemoWindowLayout()
+}
+
+func ShowDemoWindowPopups() {
+	GImGui.ShowDemoWindowPopups()
+}
+
+func ShowDemoWindowMisc() {
+	GImGui.ShowDemoWindowMisc()
+}
+
+// demo.tables.go
+
+func PushStyleCompact() {
+	GImGui.PushStyleCompact()
+}
+
+func PopStyleCompact() {
+	GImGui.PopStyleCompact()
+}
+
+func EditTableSizingFlags(p_flags *ImGuiTableFlags) {
+	GImGui.EditTableSizingFlags(p_flags)
+}
+
+func EditTableColumnsFlags(p_flags *ImGuiTableColumnFlags) {
+	GImGui.EditTableColumnsFlags(p_flags)
+}
+
+func ShowTableColumnsStatusFlags(flags ImGuiTableColumnFlags) {
+	GImGui.ShowTableColumnsStatusFlags(flags)
+}
+
+func ShowDemoWindowTables() {
+	GImGui.ShowDemoWindowTables()
+}
+
+func ShowDemoWindowColumns() {
+	GImGui.ShowDemoWindowColumns()
+}
+
+// demo.tools.go
+
+func ShowAboutWindow(p_open *bool) {
+	GImGui.ShowAboutWindow(p_open)
+}
+
+func ShowFontSelector(label string) {
+	GImGui.ShowFontSelector(label)
+}
+
+func ShowStyleSelector(label string) bool {
+	return GImGui.ShowStyleSelector(label)
+}
+
+func ShowStyleEditor(ref *ImGuiStyle) {
+	GImGui.ShowStyleEditor(ref)
+}
+
+// disabled.go
+
+func BeginDisabled(disabled bool /*= true*/) {
+	GImGui.BeginDisabled(disabled)
+}
+
+func EndDisabled() {
+	GImGui.EndDisabled()
+}
+
+// docking.builder.go
+
+func DockBuilderDockWindow(window_name string, node_id ImGuiID) {
+	GImGui.DockBuilderDockWindow(window_name, node_id)
+}
+
+func DockBuilderGetNode(node_id ImGuiID) *ImGuiDockNode {
+	return GImGui.DockBuilderGetNode(node_id)
+}
+
+func DockBuilderGetCentralNode(node_id ImGuiID) *ImGuiDockNode {
+	return GImGui.DockBuilderGetCentralNode(node_id)
+}
+
+func DockBuilderAddNode(id ImGuiID, flags ImGuiDockNodeFlags) ImGuiID {
+	return GImGui.DockBuilderAddNode(id, flags)
+}
+
+func DockBuilderRemoveNode(node_id ImGuiID) {
+	GImGui.DockBuilderRemoveNode(node_id)
+}
+
+func DockBuilderRemoveNodeChildNodes(root_id ImGuiID) {
+	GImGui.DockBuilderRemoveNodeChildNodes(root_id)
+}
+
+func DockBuilderRemoveNodeDockedWindows(root_id ImGuiID, clear_settings_refs bool) {
+	GImGui.DockBuilderRemoveNodeDockedWindows(root_id, clear_settings_refs)
+}
+
+func DockBuilderSetNodePos(node_id ImGuiID, pos ImVec2) {
+	GImGui.DockBuilderSetNodePos(node_id, pos)
+}
+
+func DockBuilderSetNodeSize(node_id ImGuiID, size ImVec2) {
+	GImGui.DockBuilderSetNodeSize(node_id, size)
+}
+
+func DockBuilderSplitNode(id ImGuiID, split_dir ImGuiDir, size_ratio_for_node_at_dir float, out_id_at_dir *ImGuiID, out_id_at_opposite_dir *ImGuiID) ImGuiID {
+	return GImGui.DockBuilderSplitNode(id, split_dir, size_ratio_for_node_at_dir, out_id_at_dir, out_id_at_opposite_dir)
+}
+
+func DockBuilderFinish(root_id ImGuiID) {
+	GImGui.DockBuilderFinish(root_id)
+}
+
+// docking.go
+
+func DockNodeIsWindowAlive(window *ImGuiWindow) bool {
+	return GImGui.DockNodeIsWindowAlive(window)
+}
+
+func DockNodeCountAliveWindows(node *ImGuiDockNode) int {
+	return GImGui.DockNodeCountAliveWindows(node)
+}
+
+func DockNodeTreeHasAliveWindows(node *ImGuiDockNode) bool {
+	return GImGui.DockNodeTreeHasAliveWindows(node)
+}
+
+func DockNodeAddWindow(node *ImGuiDockNode, window *ImGuiWindow) {
+	GImGui.DockNodeAddWindow(node, window)
+}
+
+func DockNodeRemoveWindow(node *ImGuiDockNode, window *ImGuiWindow, save_dock_id ImGuiID) {
+	GImGui.DockNodeRemoveWindow(node, window, save_dock_id)
+}
+
+func DockNodeMoveWindows(dst_node *ImGuiDockNode, src_node *ImGuiDockNode) {
+	GImGui.DockNodeMoveWindows(dst_node, src_node)
+}
+
+func DockSettingsRenameNodeReferences(old_node_id ImGuiID, new_node_id ImGuiID) {
+	GImGui.DockSettingsRenameNodeReferences(old_node_id, new_node_id)
+}
+
+func DockNodeTreeUpdateVisibility(node *ImGuiDockNode, host_window *ImGuiWindow) {
+	GImGui.DockNodeTreeUpdateVisibility(node, host_window)
+}
+
+func DockNodeTreeUpdatePosSize(node *ImGuiDockNode, pos ImVec2, size ImVec2) {
+	GImGui.DockNodeTreeUpdatePosSize(node, pos, size)
+}
+
+func DockNodeTreeUpdateSplitter(node *ImGuiDockNode) {
+	GImGui.DockNodeTreeUpdateSplitter(node)
+}
+
+func DockNodeCalcTabBarHeight(node *ImGuiDockNode) float {
+	return GImGui.DockNodeCalcTabBarHeight(node)
+}
+
+func DockNodeBeginHostWindow(node *ImGuiDockNode) {
+	GImGui.DockNodeBeginHostWindow(node)
+}
+
+func DockNodeUpdate(node *ImGuiDockNode) {
+	GImGui.DockNodeUpdate(node)
+}
+
+func DockNodeTreeUpdateLeaves(node *ImGuiDockNode) {
+	GImGui.DockNodeTreeUpdateLeaves(node)
+}
+
+func DockNodeUpdateTabBar(node *ImGuiDockNode, host_window *ImGuiWindow) {
+	GImGui.DockNodeUpdateTabBar(node, host_window)
+}
+
+func SetWindowDock(window *ImGuiWindow, dock_id ImGuiID, cond ImGuiCond) {
+	GImGui.SetWindowDock(window, dock_id, cond)
+}
+
+func BeginDocked(window *ImGuiWindow, p_open *bool, flags ImGuiWindowFlags) ImGuiWindowFlags {
+	return GImGui.BeginDocked(window, p_open, flags)
+}
+
+func DockNodePreviewDockSetup(data *ImGuiDockPreviewData, mouse_pos ImVec2) {
+	GImGui.DockNodePreviewDockSetup(data, mouse_pos)
+}
+
+func DockNodePreviewDockRender(data *ImGuiDockPreviewData) {
+	GImGui.DockNodePreviewDockRender(data)
+}
+
+func DockNodeCalcDropRectsAndTestMousePos(parent *ImRect, dir ImGuiDir, out_r *ImRect, outer_docking bool, test_mouse_pos *ImVec2) bool {
+	return GImGui.DockNodeCalcDropRectsAndTestMousePos(parent, dir, out_r, outer_docking, test_mouse_pos)
+}
+
+func DockSpace(id ImGuiID, size_arg ImVec2, flags ImGuiDockNodeFlags) ImGuiID {
+	return GImGui.DockSpace(id, size_arg, flags)
+}
+
+func DockSpaceOverViewport(viewport *ImGuiViewport, flags ImGuiDockNodeFlags) ImGuiID {
+	return GImGui.DockSpaceOverViewport(viewport, flags)
+}
+
+func SetNextWindowDockID(dock_id ImGuiID, cond ImGuiCond) {
+	GImGui.SetNextWindowDockID(dock_id, cond)
+}
+
+func GetWindowDockID() ImGuiID {
+	return GImGui.GetWindowDockID()
+}
+
+func IsWindowDocked() bool {
+	return GImGui.IsWindowDocked()
+}
+
+// dragdrop.go
+
+func BeginDragDropTargetCustom(bb *ImRect, id ImGuiID) bool {
+	return GImGui.BeginDragDropTargetCustom(bb, id)
+}
+
+func ClearDragDrop() {
+	GImGui.ClearDragDrop()
+}
+
+func UpdateDragDropExternPaths() {
+	GImGui.UpdateDragDropExternPaths()
+}
+
+func IsDragDropPayloadBeingAccepted() bool {
+	return GImGui.IsDragDropPayloadBeingAccepted()
+}
+
+func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
+	return GImGui.BeginDragDropSource(flags)
+}
+
+func SetDragDropPayload(ptype string, data any, data_size uintptr, cond ImGuiCond) bool {
+	return GImGui.SetDragDropPayload(ptype, data, data_size, cond)
+}
+
+func EndDragDropSource() {
+	GImGui.EndDragDropSource()
+}
+
+func BeginDragDropTarget() bool {
+	return GImGui.BeginDragDropTarget()
+}
+
+func AcceptDragDropPayload(ptype string, flags ImGuiDragDropFlags) *ImGuiPayload {
+	return GImGui.AcceptDragDropPayload(ptype, flags)
+}
+
+func RenderDragDropInsertHighlight(bb *ImRect, position ImGuiDropPosition) {
+	GImGui.RenderDragDropInsertHighlight(bb, position)
+}
+
+func RenderDragDropItemsPreview(items_count int) {
+	GImGui.RenderDragDropItemsPreview(items_count)
+}
+
+func EndDragDropTarget() {
+	GImGui.EndDragDropTarget()
+}
+
+func GetDragDropPayload() *ImGuiPayload {
+	return GImGui.GetDragDropPayload()
+}
+
+// drawing.go
+
+func GetDrawData() *ImDrawData {
+	return GImGui.GetDrawData()
+}
+
+// filesystem.go
+
+func ImFileOpen(filename string, mode string) ImFileHandle {
+	return GImGui.ImFileOpen(filename, mode)
+}
+
+func ImFileLoadToMemory(filename, mode string, out_file_size *size_t, padding_bytes int) []byte {
+	return GImGui.ImFileLoadToMemory(filename, mode, out_file_size, padding_bytes)
+}
+
+// font.go
+
+func GetFont() *ImFont {
+	return GImGui.GetFont()
+}
+
+func GetFontSize() float {
+	return GImGui.GetFontSize()
+}
+
+func GetFontTexUvWhitePixel() ImVec2 {
+	return GImGui.GetFontTexUvWhitePixel()
+}
+
+func PushFont(font *ImFont) {
+	GImGui.PushFont(font)
+}
+
+func PopFont() {
+	GImGui.PopFont()
+}
+
+func SetCurrentFont(font *ImFont) {
+	GImGui.SetCurrentFont(font)
+}
+
+func GetDefaultFont() *ImFont {
+	return GImGui.GetDefaultFont()
+}
+
+// frame.go
+
+func ErrorCheckNewFrameSanityChecks() {
+	GImGui.ErrorCheckNewFrameSanityChecks()
+}
+
+func NewFrame() {
+	GImGui.NewFrame()
+}
+
+func ErrorCheckEndFrameSanityChecks() {
+	GImGui.ErrorCheckEndFrameSanityChecks()
+}
+
+func EndFrame() {
+	GImGui.EndFrame()
+}
+
+// funcs.go
+
+func GetIO() *ImGuiIO {
+	return GImGui.GetIO()
+}
+
+func PushClipRect(cr_min ImVec2, cr_max ImVec2, intersect_with_current_clip_rect bool) {
+	GImGui.PushClipRect(cr_min, cr_max, intersect_with_current_clip_rect)
+}
+
+func PopClipRect() {
+	GImGui.PopClipRect()
+}
+
+func GetMainViewport() *ImGuiViewport {
+	return GImGui.GetMainViewport()
+}
+
+// garbage.go
+
+func GcCompactTransientMiscBuffers() {
+	GImGui.GcCompactTransientMiscBuffers()
+}
+
+// id_stack.go
+
+func PushOverrideID(id ImGuiID) {
+	GImGui.PushOverrideID(id)
+}
+
+func GetIDWithSeed(str string, seed ImGuiID) ImGuiID {
+	return GImGui.GetIDWithSeed(str, seed)
+}
+
+func PushString(str_id string) {
+	GImGui.PushString(str_id)
+}
+
+func PushInterface(ptr_id any) {
+	GImGui.PushInterface(ptr_id)
+}
+
+func PushID(int_id int) {
+	GImGui.PushID(int_id)
+}
+
+func PopID() {
+	GImGui.PopID()
+}
+
+func GetIDFromString(str_id string) ImGuiID {
+	return GImGui.GetIDFromString(str_id)
+}
+
+func GetIDs(str_id_begin string) ImGuiID {
+	return GImGui.GetIDs(str_id_begin)
+}
+
+func GetIDFromInterface(ptr_id any) ImGuiID {
+	return GImGui.GetIDFromInterface(ptr_id)
+}
+
+// imstb_textedit.go
+
+func STB_TEXTEDIT_LAYOUTROW(r *StbTexteditRow, obj *STB_TEXTEDIT_STRING, line_start_idx int) {
+	GImGui.STB_TEXTEDIT_LAYOUTROW(r, obj, line_start_idx)
+}
+
+func STB_TEXTEDIT_GETWIDTH(obj *STB_TEXTEDIT_STRING, line_start_idx, char_idx int) float {
+	return GImGui.STB_TEXTEDIT_GETWIDTH(obj, line_start_idx, char_idx)
+}
+
+// inputs.go
+
+func UpdateInputEvents(trickle_fast_inputs bool) {
+	GImGui.UpdateInputEvents(trickle_fast_inputs)
+}
+
+// internal.go
+
+func IMGUI_DEBUG_LOG(format string, args ...any) {
+	GImGui.IMGUI_DEBUG_LOG(format, args...)
+}
+
+// internal_funcs.go
+
+func GetForegroundDrawListViewport(viewport *ImGuiViewport) *ImDrawList {
+	return GImGui.GetForegroundDrawListViewport(viewport)
+}
+
+func IMGUI_TEST_ENGINE_ITEM_ADD(bb *ImRect, id ImGuiID) {
+	GImGui.IMGUI_TEST_ENGINE_ITEM_ADD(bb, id)
+}
+
+func IMGUI_TEST_ENGINE_ITEM_INFO(id ImGuiID, label string, flags ImGuiItemStatusFlags) {
+	GImGui.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, flags)
+}
+
+func GetItemID() ImGuiID {
+	return GImGui.GetItemID()
+}
+
+func GetItemStatusFlags() ImGuiItemStatusFlags {
+	return GImGui.GetItemStatusFlags()
+}
+
+func GetItemFlags() ImGuiItemFlags {
+	return GImGui.GetItemFlags()
+}
+
+func GetActiveID() ImGuiID {
+	return GImGui.GetActiveID()
+}
+
+func GetFocusID() ImGuiID {
+	return GImGui.GetFocusID()
+}
+
+func SetActiveID(id ImGuiID, window *ImGuiWindow) {
+	GImGui.SetActiveID(id, window)
+}
+
+func SetFocusID(id ImGuiID, window *ImGuiWindow) {
+	GImGui.SetFocusID(id, window)
+}
+
+func ClearActiveID() {
+	GImGui.ClearActiveID()
+}
+
+func GetHoveredID() ImGuiID {
+	return GImGui.GetHoveredID()
+}
+
+func SetHoveredID(id ImGuiID) {
+	GImGui.SetHoveredID(id)
+}
+
+func KeepAliveID(id ImGuiID) {
+	GImGui.KeepAliveID(id)
+}
+
+func MarkItemEdited(id ImGuiID) {
+	GImGui.MarkItemEdited(id)
+}
+
+func PushItemFlag(option ImGuiItemFlags, enabled bool) {
+	GImGui.PushItemFlag(option, enabled)
+}
+
+func PopItemFlag() {
+	GImGui.PopItemFlag()
+}
+
+func SetActiveIdUsingNavAndKeys() {
+	GImGui.SetActiveIdUsingNavAndKeys()
+}
+
+func IsActiveIdUsingNavDir(dir ImGuiDir) bool {
+	return GImGui.IsActiveIdUsingNavDir(dir)
+}
+
+func IsActiveIdUsingNavInput(input ImGuiNavInput) bool {
+	return GImGui.IsActiveIdUsingNavInput(input)
+}
+
+func IsActiveIdUsingKey(key ImGuiKey) bool {
+	return GImGui.IsActiveIdUsingKey(key)
+}
+
+func IsKeyPressedMap(key ImGuiKey, repeat bool /*= true*/) bool {
+	return GImGui.IsKeyPressedMap(key, repeat)
+}
+
+func IsNavInputDown(n ImGuiNavInput) bool {
+	return GImGui.IsNavInputDown(n)
+}
+
+func IsNavInputTest(n ImGuiNavInput, rm ImGuiInputReadMode) bool {
+	return GImGui.IsNavInputTest(n, rm)
+}
+
+func RenderColorRectWithAlphaCheckerboard(draw_list *ImDrawList, p_min ImVec2, p_max ImVec2, col ImU32, grid_step float, grid_off ImVec2, rounding float, flags ImDrawFlags) {
+	GImGui.RenderColorRectWithAlphaCheckerboard(draw_list, p_min, p_max, col, grid_step, grid_off, rounding, flags)
+}
+
+func RenderNavHighlight(bb *ImRect, id ImGuiID, flags ImGuiNavHighlightFlags) {
+	GImGui.RenderNavHighlight(bb, id, flags)
+}
+
+// keyboard.go
+
+func PushAllowKeyboardFocus(allow_keyboard_focus bool) {
+	GImGui.PushAllowKeyboardFocus(allow_keyboard_focus)
+}
+
+func PopAllowKeyboardFocus() {
+	GImGui.PopAllowKeyboardFocus()
+}
+
+func GetKeyData(key ImGuiKey) *ImGuiKeyData {
+	return GImGui.GetKeyData(key)
+}
+
+func GetKeyName(key ImGuiKey) string {
+	return GImGui.GetKeyName(key)
+}
+
+func IsKeyDown(key ImGuiKey) bool {
+	return GImGui.IsKeyDown(key)
+}
+
+func IsKeyReleased(key ImGuiKey) bool {
+	return GImGui.IsKeyReleased(key)
+}
+
+func GetKeyPressedAmount(key ImGuiKey, repeat_delay float, repeat_rate float) int {
+	return GImGui.GetKeyPressedAmount(key, repeat_delay, repeat_rate)
+}
+
+func CaptureKeyboardFromApp(want_capture_keyboard_value bool /*= true*/) {
+	GImGui.CaptureKeyboardFromApp(want_capture_keyboard_value)
+}
+
+func GetMergedKeyModFlags() ImGuiKeyModFlags {
+	return GImGui.GetMergedKeyModFlags()
+}
+
+func IsKeyPressed(key ImGuiKey, repeat bool /*= true*/) bool {
+	return GImGui.IsKeyPressed(key, repeat)
+}
+
+func UpdateKeyboardInputs() {
+	GImGui.UpdateKeyboardInputs()
+}
+
+// keyboard.shortcuts.go
+
+func ConvertShortcutMod(key_chord ImGuiKeyChord) ImGuiKeyChord {
+	return GImGui.ConvertShortcutMod(key_chord)
+}
+
+func GetKeyChordName(key_chord ImGuiKeyChord) string {
+	return GImGui.GetKeyChordName(key_chord)
+}
+
+func GetShortcutRoutingData(key_chord ImGuiKeyChord) *ImGuiKeyRoutingData {
+	return GImGui.GetShortcutRoutingData(key_chord)
+}
+
+func UpdateKeyRoutingTable() {
+	GImGui.UpdateKeyRoutingTable()
+}
+
+func CalcRoutingScore(location *ImGuiWindow, owner_id ImGuiID, flags ImGuiInputFlags) int {
+	return GImGui.CalcRoutingScore(location, owner_id, flags)
+}
+
+func SetShortcutRouting(key_chord ImGuiKeyChord, owner_id ImGuiID, flags ImGuiInputFlags) bool {
+	return GImGui.SetShortcutRouting(key_chord, owner_id, flags)
+}
+
+func SetShortcutRoutingEx(key_chord ImGuiKeyChord, owner_id ImGuiID, flags ImGuiInputFlags, location *ImGuiWindow) bool {
+	return GImGui.SetShortcutRoutingEx(key_chord, owner_id, flags, location)
+}
+
+func Shortcut(key_chord ImGuiKeyChord, flags ImGuiInputFlags) bool {
+	return GImGui.Shortcut(key_chord, flags)
+}
+
+func ShortcutEx(key_chord ImGuiKeyChord, owner_id ImGuiID, flags ImGuiInputFlags, location *ImGuiWindow) bool {
+	return GImGui.ShortcutEx(key_chord, owner_id, flags, location)
+}
+
+// layout.go
+
+func NewLine() {
+	GImGui.NewLine()
+}
+
+func Spacing() {
+	GImGui.Spacing()
+}
+
+func Dummy(size ImVec2) {
+	GImGui.Dummy(size)
+}
+
+func BeginGroup() {
+	GImGui.BeginGroup()
+}
+
+func EndGroup() {
+	GImGui.EndGroup()
+}
+
+func GetCursorPos() ImVec2 {
+	return GImGui.GetCursorPos()
+}
+
+func GetCursorPosX() float {
+	return GImGui.GetCursorPosX()
+}
+
+func GetCursorPosY() float {
+	return GImGui.GetCursorPosY()
+}
+
+func SetCursorPos(local_pos *ImVec2) {
+	GImGui.SetCursorPos(local_pos)
+}
+
+func SetCursorPosX(local_x float) {
+	GImGui.SetCursorPosX(local_x)
+}
+
+func SetCursorPosY(local_y float) {
+	GImGui.SetCursorPosY(local_y)
+}
+
+func GetCursorStartPos() ImVec2 {
+	return GImGui.GetCursorStartPos()
+}
+
+func GetCursorScreenPos() ImVec2 {
+	return GImGui.GetCursorScreenPos()
+}
+
+func SetCursorScreenPos(pos ImVec2) {
+	GImGui.SetCursorScreenPos(pos)
+}
+
+func AlignTextToFramePadding() {
+	GImGui.AlignTextToFramePadding()
+}
+
+func GetTextLineHeight() float {
+	return GImGui.GetTextLineHeight()
+}
+
+func GetTextLineHeightWithSpacing() float {
+	return GImGui.GetTextLineHeightWithSpacing()
+}
+
+func GetFrameHeight() float {
+	return GImGui.GetFrameHeight()
+}
+
+func GetFrameHeightWithSpacing() float {
+	return GImGui.GetFrameHeightWithSpacing()
+}
+
+func PushItemWidth(item_width float) {
+	GImGui.PushItemWidth(item_width)
+}
+
+func PushMultiItemsWidths(components int, width_full float) {
+	GImGui.PushMultiItemsWidths(components, width_full)
+}
+
+func PopItemWidth() {
+	GImGui.PopItemWidth()
+}
+
+func SetNextItemWidth(item_width float) {
+	GImGui.SetNextItemWidth(item_width)
+}
+
+func CalcItemWidth() float {
+	return GImGui.CalcItemWidth()
+}
+
+func GetContentRegionAvail() ImVec2 {
+	return GImGui.GetContentRegionAvail()
+}
+
+func GetContentRegionMax() ImVec2 {
+	return GImGui.GetContentRegionMax()
+}
+
+func GetContentRegionMaxAbs() ImVec2 {
+	return GImGui.GetContentRegionMaxAbs()
+}
+
+func GetWindowContentRegionMin() ImVec2 {
+	return GImGui.GetWindowContentRegionMin()
+}
+
+func GetWindowContentRegionMax() ImVec2 {
+	return GImGui.GetWindowContentRegionMax()
+}
+
+// listclipper.go
+
+func GetSkipItemForListClipping() bool {
+	return GImGui.GetSkipItemForListClipping()
+}
+
+func CalcListClipping(items_count int, items_height float, out_items_display_start *int, out_items_display_end *int) {
+	GImGui.CalcListClipping(items_count, items_height, out_items_display_start, out_items_display_end)
+}
+
+func SetCursorPosYAndSetupForPrevLine(pos_y, line_height float) {
+	GImGui.SetCursorPosYAndSetupForPrevLine(pos_y, line_height)
+}
+
+// logging.go
+
+func LogBegin(ltype ImGuiLogType, auto_open_depth int) {
+	GImGui.LogBegin(ltype, auto_open_depth)
+}
+
+func LogToBuffer(auto_open_depth int /*= -1*/) {
+	GImGui.LogToBuffer(auto_open_depth)
+}
+
+func LogRenderedText(ref_pos *ImVec2, text string) {
+	GImGui.LogRenderedText(ref_pos, text)
+}
+
+func LogSetNextTextDecoration(prefix string, suffix string) {
+	GImGui.LogSetNextTextDecoration(prefix, suffix)
+}
+
+func LogToTTY(auto_open_depth int /*= -1*/) {
+	GImGui.LogToTTY(auto_open_depth)
+}
+
+func LogToFile(auto_open_depth int /*= 1*/, filename string) {
+	GImGui.LogToFile(auto_open_depth, filename)
+}
+
+func LogToWriter(w io.Writer, auto_open_depth int /*= -1*/) {
+	GImGui.LogToWriter(w, auto_open_depth)
+}
+
+func LogToClipboard(auto_open_depth int /*= -1*/) {
+	GImGui.LogToClipboard(auto_open_depth)
+}
+
+func LogFinish() {
+	GImGui.LogFinish()
+}
+
+func LogButtons() {
+	GImGui.LogButtons()
+}
+
+func LogText(format string, args ...any) {
+	GImGui.LogText(format, args...)
+}
+
+// misc.utillities.go
+
+func GetViewportDrawList(viewport *ImGuiViewportP, drawlist_no size_t, drawlist_name string) *ImDrawList {
+	return GImGui.GetViewportDrawList(viewport, drawlist_no, drawlist_name)
+}
+
+func IsRectVisible(size ImVec2) bool {
+	return GImGui.IsRectVisible(size)
+}
+
+func IsRectVisibleMinMax(rect_min, rect_max ImVec2) bool {
+	return GImGui.IsRectVisibleMinMax(rect_min, rect_max)
+}
+
+func GetTime() double {
+	return GImGui.GetTime()
+}
+
+func GetFrameCount() int {
+	return GImGui.GetFrameCount()
+}
+
+func GetBackgroundDrawList(viewport *ImGuiViewport) *ImDrawList {
+	return GImGui.GetBackgroundDrawList(viewport)
+}
+
+func GetForegroundDrawList(viewport *ImGuiViewport) *ImDrawList {
+	return GImGui.GetForegroundDrawList(viewport)
+}
+
+func GetDrawListSharedData() *ImDrawListSharedData {
+	return GImGui.GetDrawListSharedData()
+}
+
+func SetStateStorage(storage *ImGuiStorage) {
+	GImGui.SetStateStorage(storage)
+}
+
+func GetStateStorage() ImGuiStorage {
+	return GImGui.GetStateStorage()
+}
+
+// mouse.go
+
+func IsMouseDown(button ImGuiMouseButton) bool {
+	return GImGui.IsMouseDown(button)
+}
+
+func IsMouseReleased(button ImGuiMouseButton) bool {
+	return GImGui.IsMouseReleased(button)
+}
+
+func IsMouseDoubleClicked(button ImGuiMouseButton) bool {
+	return GImGui.IsMouseDoubleClicked(button)
+}
+
+func IsAnyMouseDown() bool {
+	return GImGui.IsAnyMouseDown()
+}
+
+func GetMousePos() ImVec2 {
+	return GImGui.GetMousePos()
+}
+
+func GetMousePosOnOpeningCurrentPopup() ImVec2 {
+	return GImGui.GetMousePosOnOpeningCurrentPopup()
+}
+
+func GetMouseDragDelta(button ImGuiMouseButton /*= 0*/, lock_threshold float /*= -1.0*/) ImVec2 {
+	return GImGui.GetMouseDragDelta(button, lock_threshold)
+}
+
+func ResetMouseDragDelta(button ImGuiMouseButton) {
+	GImGui.ResetMouseDragDelta(button)
+}
+
+func GetMouseCursor() ImGuiMouseCursor {
+	return GImGui.GetMouseCursor()
+}
+
+func CaptureMouseFromApp(want_capture_mouse_value bool /*= true*/) {
+	GImGui.CaptureMouseFromApp(want_capture_mouse_value)
+}
+
+func IsMousePosValid(mouse_pos *ImVec2) bool {
+	return GImGui.IsMousePosValid(mouse_pos)
+}
+
+func IsMouseClicked(button ImGuiMouseButton, repeat bool) bool {
+	return GImGui.IsMouseClicked(button, repeat)
+}
+
+func SetMouseCursor(cursor_type ImGuiMouseCursor) {
+	GImGui.SetMouseCursor(cursor_type)
+}
+
+func StartLockWheelingWindow(window *ImGuiWindow) {
+	GImGui.StartLockWheelingWindow(window)
+}
+
+func IsMouseDragging(button ImGuiMouseButton, lock_threshold float /*= -1.0*/) bool {
+	return GImGui.IsMouseDragging(button, lock_threshold)
+}
+
+func IsMouseDragPastThreshold(button ImGuiMouseButton, lock_threshold float /*= -1.0f*/) bool {
+	return GImGui.IsMouseDragPastThreshold(button, lock_threshold)
+}
+
+func StartMouseMovingWindow(window *ImGuiWindow) {
+	GImGui.StartMouseMovingWindow(window)
+}
+
+func IsMouseHoveringRect(r_min, r_max ImVec2, clip bool /*= true*/) bool {
+	return GImGui.IsMouseHoveringRect(r_min, r_max, clip)
+}
+
+func IsWindowContentHoverable(window *ImGuiWindow, flags ImGuiHoveredFlags) bool {
+	return GImGui.IsWindowContentHoverable(window, flags)
+}
+
+func UpdateMouseMovingWindowEndFrame() {
+	GImGui.UpdateMouseMovingWindowEndFrame()
+}
+
+func UpdateMouseWheel() {
+	GImGui.UpdateMouseWheel()
+}
+
+func UpdateMouseInputs() {
+	GImGui.UpdateMouseInputs()
+}
+
+// navigation.go
+
+func FindWindowNavFocusable(i_start, i_stop, dir int) *ImGuiWindow {
+	return GImGui.FindWindowNavFocusable(i_start, i_stop, dir)
+}
+
+func NavMoveRequestForward(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGuiNavMoveFlags) {
+	GImGui.NavMoveRequestForward(move_dir, clip_dir, move_flags)
+}
+
+func NavMoveRequestTryWrapping(window *ImGuiWindow, move_flags ImGuiNavMoveFlags) {
+	GImGui.NavMoveRequestTryWrapping(window, move_flags)
+}
+
+func SetNavID(id ImGuiID, nav_layer ImGuiNavLayer, focus_scope_id ImGuiID, rect_rel *ImRect) {
+	GImGui.SetNavID(id, nav_layer, focus_scope_id, rect_rel)
+}
+
+func NavUpdateAnyRequestFlag() {
+	GImGui.NavUpdateAnyRequestFlag()
+}
+
+func GetNavInputAmount(n ImGuiNavInput, mode ImGuiInputReadMode) float {
+	return GImGui.GetNavInputAmount(n, mode)
+}
+
+func NavMoveRequestButNoResultYet() bool {
+	return GImGui.NavMoveRequestButNoResultYet()
+}
+
+func NavApplyItemToResult(result *ImGuiNavItemData) {
+	GImGui.NavApplyItemToResult(result)
+}
+
+func NavProcessItem() {
+	GImGui.NavProcessItem()
+}
+
+func NavUpdatePageUpPageDown() float {
+	return GImGui.NavUpdatePageUpPageDown()
+}
+
+func NavUpdateCreateMoveRequest() {
+	GImGui.NavUpdateCreateMoveRequest()
+}
+
+func NavUpdateCancelRequest() {
+	GImGui.NavUpdateCancelRequest()
+}
+
+func NavRestoreLayer(layer ImGuiNavLayer) {
+	GImGui.NavRestoreLayer(layer)
+}
+
+func FindWindowFocusIndex(window *ImGuiWindow) int {
+	return GImGui.FindWindowFocusIndex(window)
+}
+
+func NavUpdateWindowingHighlightWindow(focus_change_dir int) {
+	GImGui.NavUpdateWindowingHighlightWindow(focus_change_dir)
+}
+
+func NavUpdateInitResult() {
+	GImGui.NavUpdateInitResult()
+}
+
+func NavCalcPreferredRefPos() ImVec2 {
+	return GImGui.NavCalcPreferredRefPos()
+}
+
+func NavUpdateWindowing() {
+	GImGui.NavUpdateWindowing()
+}
+
+func NavUpdate() {
+	GImGui.NavUpdate()
+}
+
+func NavInitWindow(window *ImGuiWindow, force_reinit bool) {
+	GImGui.NavInitWindow(window, force_reinit)
+}
+
+func NavScoreItem(result *ImGuiNavItemData) bool {
+	return GImGui.NavScoreItem(result)
+}
+
+func NavEndFrame() {
+	GImGui.NavEndFrame()
+}
+
+func NavUpdateWindowingOverlay() {
+	GImGui.NavUpdateWindowingOverlay()
+}
+
+func NavMoveRequestSubmit(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGuiNavMoveFlags) {
+	GImGui.NavMoveRequestSubmit(move_dir, clip_dir, move_flags)
+}
+
+func GetNavInputAmount2d(dir_sources ImGuiNavDirSourceFlags, mode ImGuiInputReadMode, slow_factor float, fast_factor float) ImVec2 {
+	return GImGui.GetNavInputAmount2d(dir_sources, mode, slow_factor, fast_factor)
+}
+
+func NavMoveRequestApplyResult() {
+	GImGui.NavMoveRequestApplyResult()
+}
+
+func NavMoveRequestCancel() {
+	GImGui.NavMoveRequestCancel()
+}
+
+// popups.go
+
+func BeginPopup(str_id string, flags ImGuiWindowFlags) bool {
+	return GImGui.BeginPopup(str_id, flags)
+}
+
+func BeginPopupModal(name string, p_open *bool, flags ImGuiWindowFlags) bool {
+	return GImGui.BeginPopupModal(name, p_open, flags)
+}
+
+func EndPopup() {
+	GImGui.EndPopup()
+}
+
+func OpenPopup(str_id string, popup_flags ImGuiPopupFlags) {
+	GImGui.OpenPopup(str_id, popup_flags)
+}
+
+func OpenPopupID(id ImGuiID, popup_flags ImGuiPopupFlags) {
+	GImGui.OpenPopupID(id, popup_flags)
+}
+
+func OpenPopupOnItemClick(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) {
+	GImGui.OpenPopupOnItemClick(str_id, popup_flags)
+}
+
+func CloseCurrentPopup() {
+	GImGui.CloseCurrentPopup()
+}
+
+func BeginPopupContextItem(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) bool {
+	return GImGui.BeginPopupContextItem(str_id, popup_flags)
+}
+
+func BeginPopupContextVoid(str_id string, popup_flags ImGuiPopupFlags) bool {
+	return GImGui.BeginPopupContextVoid(str_id, popup_flags)
+}
+
+func BeginPopupContextWindow(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) bool {
+	return GImGui.BeginPopupContextWindow(str_id, popup_flags)
+}
+
+func IsPopupOpen(str_id string, flags ImGuiPopupFlags) bool {
+	return GImGui.IsPopupOpen(str_id, flags)
+}
+
+func GetTopMostPopupModal() *ImGuiWindow {
+	return GImGui.GetTopMostPopupModal()
+}
+
+func GetPopupAllowedExtentRect(window *ImGuiWindow) ImRect {
+	return GImGui.GetPopupAllowedExtentRect(window)
+}
+
+func FindBestWindowPosForPopup(window *ImGuiWindow) ImVec2 {
+	return GImGui.FindBestWindowPosForPopup(window)
+}
+
+func ClosePopupsOverWindow(ref_window *ImGuiWindow, restore_focus_to_window_under_popup bool) {
+	GImGui.ClosePopupsOverWindow(ref_window, restore_focus_to_window_under_popup)
+}
+
+func IsPopupOpenID(id ImGuiID, popup_flags ImGuiPopupFlags) bool {
+	return GImGui.IsPopupOpenID(id, popup_flags)
+}
+
+func OpenPopupEx(id ImGuiID, popup_flags ImGuiPopupFlags) {
+	GImGui.OpenPopupEx(id, popup_flags)
+}
+
+func ClosePopupToLevel(remaining int, restore_focus_to_window_under_popup bool) {
+	GImGui.ClosePopupToLevel(remaining, restore_focus_to_window_under_popup)
+}
+
+func BeginPopupEx(id ImGuiID, flags ImGuiWindowFlags) bool {
+	return GImGui.BeginPopupEx(id, flags)
+}
+
+// rendering.go
+
+func RenderFrameBorder(p_min ImVec2, p_max ImVec2, rounding float) {
+	GImGui.RenderFrameBorder(p_min, p_max, rounding)
+}
+
+func Render() {
+	GImGui.Render()
+}
+
+// rendering.text.go
+
+func TextColored(col *ImVec4, format string, args ...any) {
+	GImGui.TextColored(col, format, args...)
+}
+
+func RenderText(pos ImVec2, text string, hide_text_after_hash bool /*= true*/) {
+	GImGui.RenderText(pos, text, hide_text_after_hash)
+}
+
+func RenderTextWrapped(pos ImVec2, text string, wrap_width float) {
+	GImGui.RenderTextWrapped(pos, text, wrap_width)
+}
+
+func RenderTextEllipsis(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2, clip_max_x float, ellipsis_max_x float, text string, text_size_if_known *ImVec2) {
+	GImGui.RenderTextEllipsis(draw_list, pos_min, pos_max, clip_max_x, ellipsis_max_x, text, text_size_if_known)
+}
+
+func Text(format string, args ...any) {
+	GImGui.Text(format, args...)
+}
+
+func TextEx(text string, flags ImGuiTextFlags) {
+	GImGui.TextEx(text, flags)
+}
+
+func CalcTextSize(text string, hide_text_after_double_hash bool /*= true*/, wrap_width float /*= -1.0*/) ImVec2 {
+	return GImGui.CalcTextSize(text, hide_text_after_double_hash, wrap_width)
+}
+
+func RenderTextClippedEx(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2, text string, text_size_if_known *ImVec2, align *ImVec2, clip_rect *ImRect) {
+	GImGui.RenderTextClippedEx(draw_list, pos_min, pos_max, text, text_size_if_known, align, clip_rect)
+}
+
+func RenderTextClipped(pos_min *ImVec2, pos_max *ImVec2, text string, text_size_if_known *ImVec2, align *ImVec2, clip_rect *ImRect) {
+	GImGui.RenderTextClipped(pos_min, pos_max, text, text_size_if_known, align, clip_rect)
+}
+
+// scrolling.go
+
+func Scrollbar(axis ImGuiAxis) {
+	GImGui.Scrollbar(axis)
+}
+
+func ScrollbarEx(bb_frame *ImRect, id ImGuiID, axis ImGuiAxis, p_scroll_v *float, size_avail_v float, size_contents_v float, flags ImDrawFlags) bool {
+	return GImGui.ScrollbarEx(bb_frame, id, axis, p_scroll_v, size_avail_v, size_contents_v, flags)
+}
+
+// settings.codec.go
+
+func SaveSettingsEntries() []ImGuiSettingsEntry {
+	return GImGui.SaveSettingsEntries()
+}
+
+func LoadSettingsEntries(entries []ImGuiSettingsEntry) {
+	GImGui.LoadSettingsEntries(entries)
+}
+
+func LoadSettings(r io.Reader) error {
+	return GImGui.LoadSettings(r)
+}
+
+func SaveSettings(w io.Writer) error {
+	return GImGui.SaveSettings(w)
+}
+
+// settings.go
+
+func LoadIniSettingsFromDisk(ini_filename string) {
+	GImGui.LoadIniSettingsFromDisk(ini_filename)
+}
+
+func LoadIniSettingsFromMemory(buf []byte, ini_size uintptr) {
+	GImGui.LoadIniSettingsFromMemory(buf, ini_size)
+}
+
+func SaveIniSettingsToDisk(ini_filename string) {
+	GImGui.SaveIniSettingsToDisk(ini_filename)
+}
+
+func SaveIniSettingsToMemory(out_size *uintptr) []byte {
+	return GImGui.SaveIniSettingsToMemory(out_size)
+}
+
+func MarkIniSettingsDirty() {
+	GImGui.MarkIniSettingsDirty()
+}
+
+func MarkIniSettingsDirtyWindow(window *ImGuiWindow) {
+	GImGui.MarkIniSettingsDirtyWindow(window)
+}
+
+func ClearIniSettings() {
+	GImGui.ClearIniSettings()
+}
+
+func CreateNewWindowSettings(name string) *ImGuiWindowSettings {
+	return GImGui.CreateNewWindowSettings(name)
+}
+
+func FindWindowSettings(id ImGuiID) *ImGuiWindowSettings {
+	return GImGui.FindWindowSettings(id)
+}
+
+func FindOrCreateWindowSettings(name string) *ImGuiWindowSettings {
+	return GImGui.FindOrCreateWindowSettings(name)
+}
+
+func FindSettingsHandler(name string) *ImGuiSettingsHandler {
+	return GImGui.FindSettingsHandler(name)
+}
+
+func AddSettingsHandler(handler *ImGuiSettingsHandler) {
+	GImGui.AddSettingsHandler(handler)
+}
+
+func RemoveSettingsHandler(type_name string) {
+	GImGui.RemoveSettingsHandler(type_name)
+}
+
+func UpdateSettings() {
+	GImGui.UpdateSettings()
+}
+
+// settings.handlers.go
+
+func AddSettingsEntryHandler(type_name string, read func(entry ImGuiSettingsEntry), write func() []ImGuiSettingsEntry) {
+	GImGui.AddSettingsEntryHandler(type_name, read, write)
+}
+
+func AddSettingsStruct(type_name, name string, ptr any) {
+	GImGui.AddSettingsStruct(type_name, name, ptr)
+}
+
+// settings.version.go
+
+func AddSettingsAlias(type_name, old_name, new_name string) {
+	GImGui.AddSettingsAlias(type_name, old_name, new_name)
+}
+
+func AddWindowSettingsAlias(old_name, new_name string) {
+	GImGui.AddWindowSettingsAlias(old_name, new_name)
+}
+
+func AddTableSettingsAlias(old_id, new_id ImGuiID) {
+	GImGui.AddTableSettingsAlias(old_id, new_id)
+}
+
+// spacing.go
+
+func Indent(indent_w float) {
+	GImGui.Indent(indent_w)
+}
+
+func Unindent(indent_w float) {
+	GImGui.Unindent(indent_w)
+}
+
+// style.go
+
+func GetStyle() *ImGuiStyle {
+	return GImGui.GetStyle()
+}
+
+func PushStyleFloat(idx ImGuiStyleVar, val float) {
+	GImGui.PushStyleFloat(idx, val)
+}
+
+func PushStyleVec(idx ImGuiStyleVar, val ImVec2) {
+	GImGui.PushStyleVec(idx, val)
+}
+
+func PopStyleVar(count int /*= 1*/) {
+	GImGui.PopStyleVar(count)
+}
+
+func GetColorU32FromID(idx ImGuiCol, alpha_mul float /*= 1.0*/) ImU32 {
+	return GImGui.GetColorU32FromID(idx, alpha_mul)
+}
+
+func GetColorU32FromVec(col ImVec4) ImU32 {
+	return GImGui.GetColorU32FromVec(col)
+}
+
+func GetColorU32FromInt(col ImU32) ImU32 {
+	return GImGui.GetColorU32FromInt(col)
+}
+
+func GetStyleColorVec4(idx ImGuiCol) *ImVec4 {
+	return GImGui.GetStyleColorVec4(idx)
+}
+
+func PushStyleColorInt(idx ImGuiCol, col ImU32) {
+	GImGui.PushStyleColorInt(idx, col)
+}
+
+func PushStyleColorVec(idx ImGuiCol, col *ImVec4) {
+	GImGui.PushStyleColorVec(idx, col)
+}
+
+func PopStyleColor(count int /*= 1*/) {
+	GImGui.PopStyleColor(count)
+}
+
+// styles.go
+
+func StyleColorsDark(style *ImGuiStyle) {
+	GImGui.StyleColorsDark(style)
+}
+
+func StyleColorsClassic(style *ImGuiStyle) {
+	GImGui.StyleColorsClassic(style)
+}
+
+func StyleColorsLight(style *ImGuiStyle) {
+	GImGui.StyleColorsLight(style)
+}
+
+// tables.columns.go
+
+func PushColumnsBackground() {
+	GImGui.PushColumnsBackground()
+}
+
+func BeginColumns(str_id string, columns_count int, flags ImGuiOldColumnFlags) {
+	GImGui.BeginColumns(str_id, columns_count, flags)
+}
+
+func EndColumns() {
+	GImGui.EndColumns()
+}
+
+func PushColumnClipRect(column_index int) {
+	GImGui.PushColumnClipRect(column_index)
+}
+
+func PopColumnsBackground() {
+	GImGui.PopColumnsBackground()
+}
+
+func GetColumnsID(str_id string, columns_count int) ImGuiID {
+	return GImGui.GetColumnsID(str_id, columns_count)
+}
+
+func Columns(columns_count int /*= 1*/, id string /*= L*/, border bool /*= true*/) {
+	GImGui.Columns(columns_count, id, border)
+}
+
+func NextColumn() {
+	GImGui.NextColumn()
+}
+
+func GetColumnIndex() int {
+	return GImGui.GetColumnIndex()
+}
+
+func GetColumnWidth(column_index int /*= -1*/) float {
+	return GImGui.GetColumnWidth(column_index)
+}
+
+func SetColumnWidth(column_index int, width float) {
+	GImGui.SetColumnWidth(column_index, width)
+}
+
+func GetColumnOffset(column_index int /*= -1*/) float {
+	return GImGui.GetColumnOffset(column_index)
+}
+
+func SetColumnOffset(column_index int, offset float) {
+	GImGui.SetColumnOffset(column_index, offset)
+}
+
+func GetColumnsCount() int {
+	return GImGui.GetColumnsCount()
+}
+
+func GetDraggedColumnOffset(columns *ImGuiOldColumns, column_index int) float {
+	return GImGui.GetDraggedColumnOffset(columns, column_index)
+}
+
+// tables.internal.go
+
+func TableOpenContextMenu(column_n int /*= -1*/) {
+	GImGui.TableOpenContextMenu(column_n)
+}
+
+func TableSetColumnWidth(column_n int, width float) {
+	GImGui.TableSetColumnWidth(column_n, width)
+}
+
+func TableSetColumnSortDirection(column_n int, sort_direction ImGuiSortDirection, append_to_sort_specs bool) {
+	GImGui.TableSetColumnSortDirection(column_n, sort_direction, append_to_sort_specs)
+}
+
+func TableGetHoveredColumn() int {
+	return GImGui.TableGetHoveredColumn()
+}
+
+func TableGetHeaderRowHeight() float {
+	return GImGui.TableGetHeaderRowHeight()
+}
+
+func TablePushBackgroundChannel() {
+	GImGui.TablePushBackgroundChannel()
+}
+
+func TablePopBackgroundChannel() {
+	GImGui.TablePopBackgroundChannel()
+}
+
+func GetCurrentTable() *ImGuiTable {
+	return GImGui.GetCurrentTable()
+}
+
+func TableFindByID(id ImGuiID) *ImGuiTable {
+	return GImGui.TableFindByID(id)
+}
+
+func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFlags, outer_size *ImVec2, inner_width float) bool {
+	return GImGui.BeginTableEx(name, id, columns_count, flags, outer_size, inner_width)
+}
+
+func TableBeginApplyRequests(table *ImGuiTable) {
+	GImGui.TableBeginApplyRequests(table)
+}
+
+func TableUpdateLayout(table *ImGuiTable) {
+	GImGui.TableUpdateLayout(table)
+}
+
+func TableUpdateBorders(table *ImGuiTable) {
+	GImGui.TableUpdateBorders(table)
+}
+
+func TableDrawBorders(table *ImGuiTable) {
+	GImGui.TableDrawBorders(table)
+}
+
+func TableDrawContextMenu(table *ImGuiTable) {
+	GImGui.TableDrawContextMenu(table)
+}
+
+func TableMergeDrawChannels(table *ImGuiTable) {
+	GImGui.TableMergeDrawChannels(table)
+}
+
+func TableBeginRow(table *ImGuiTable) {
+	GImGui.TableBeginRow(table)
+}
+
+func TableEndRow(table *ImGuiTable) {
+	GImGui.TableEndRow(table)
+}
+
+func TableBeginCell(table *ImGuiTable, column_n int) {
+	GImGui.TableBeginCell(table, column_n)
+}
+
+func TableRemove(table *ImGuiTable) {
+	GImGui.TableRemove(table)
+}
+
+func TableGcCompactTransientBuffers(table *ImGuiTable) {
+	GImGui.TableGcCompactTransientBuffers(table)
+}
+
+func TableGcCompactSettings() {
+	GImGui.TableGcCompactSettings()
+}
+
+func TableLoadSettings(table *ImGuiTable) {
+	GImGui.TableLoadSettings(table)
+}
+
+func TableSaveSettings(table *ImGuiTable) {
+	GImGui.TableSaveSettings(table)
+}
+
+func TableGetBoundSettings(table *ImGuiTable) *ImGuiTableSettings {
+	return GImGui.TableGetBoundSettings(table)
+}
+
+func TableSettingsCreate(id ImGuiID, columns_count int) *ImGuiTableSettings {
+	return GImGui.TableSettingsCreate(id, columns_count)
+}
+
+func TableSettingsFindByID(id ImGuiID) *ImGuiTableSettings {
+	return GImGui.TableSettingsFindByID(id)
+}
+
+// tables.public.go
+
+func BeginTable(str_id string, columns_count int, flags ImGuiTableFlags, outer_size ImVec2, inner_width float) bool {
+	return GImGui.BeginTable(str_id, columns_count, flags, outer_size, inner_width)
+}
+
+func EndTable() {
+	GImGui.EndTable()
+}
+
+func TableNextRow(row_flags ImGuiTableRowFlags /*= 0*/, row_min_height float) {
+	GImGui.TableNextRow(row_flags, row_min_height)
+}
+
+func TableNextColumn() bool {
+	return GImGui.TableNextColumn()
+}
+
+func TableSetColumnIndex(column_n int) bool {
+	return GImGui.TableSetColumnIndex(column_n)
+}
+
+func TableSetupColumn(label string, flags ImGuiTableColumnFlags, init_width_or_weight float /*= 0*/, user_id ImGuiID) {
+	GImGui.TableSetupColumn(label, flags, init_width_or_weight, user_id)
+}
+
+func TableSetupScrollFreeze(columns int, rows int) {
+	GImGui.TableSetupScrollFreeze(columns, rows)
+}
+
+func TableHeadersRow() {
+	GImGui.TableHeadersRow()
+}
+
+func TableHeader(label string) {
+	GImGui.TableHeader(label)
+}
+
+func TableGetSortSpecs() *ImGuiTableSortSpecs {
+	return GImGui.TableGetSortSpecs()
+}
+
+func TableGetColumnCount() int {
+	return GImGui.TableGetColumnCount()
+}
+
+func TableGetColumnIndex() int {
+	return GImGui.TableGetColumnIndex()
+}
+
+func TableGetRowIndex() int {
+	return GImGui.TableGetRowIndex()
+}
+
+func TableGetColumnName(column_n int /*= -1*/) string {
+	return GImGui.TableGetColumnName(column_n)
+}
+
+func TableGetColumnFlags(column_n int /*= -1*/) ImGuiTableColumnFlags {
+	return GImGui.TableGetColumnFlags(column_n)
+}
+
+func TableSetColumnEnabled(column_n int, enabled bool) {
+	GImGui.TableSetColumnEnabled(column_n, enabled)
+}
+
+func TableSetBgColor(target ImGuiTableBgTarget, color ImU32, column_n int /*= -1*/) {
+	GImGui.TableSetBgColor(target, color, column_n)
+}
+
+// tabs.go
+
+func UpdateTabFocus() {
+	GImGui.UpdateTabFocus()
+}
+
+// text.go
+
+func PushTextWrapPos(wrap_local_pos_x float) {
+	GImGui.PushTextWrapPos(wrap_local_pos_x)
+}
+
+func PopTextWrapPos() {
+	GImGui.PopTextWrapPos()
+}
+
+// tooltips.go
+
+func BeginTooltip() {
+	GImGui.BeginTooltip()
+}
+
+func EndTooltip() {
+	GImGui.EndTooltip()
+}
+
+func SetTooltip(format string, args ...any) {
+	GImGui.SetTooltip(format, args...)
+}
+
+func BeginTooltipEx(extra_flags ImGuiWindowFlags, tooltip_flags ImGuiTooltipFlags) {
+	GImGui.BeginTooltipEx(extra_flags, tooltip_flags)
+}
+
+// viewports.go
+
+func GetPlatformIO() *ImGuiPlatformIO {
+	return GImGui.GetPlatformIO()
+}
+
+func FindViewportByID(id ImGuiID) *ImGuiViewport {
+	return GImGui.FindViewportByID(id)
+}
+
+func FindViewportByPlatformHandle(platform_handle any) *ImGuiViewport {
+	return GImGui.FindViewportByPlatformHandle(platform_handle)
+}
+
+func SetNextWindowViewport(viewport_id ImGuiID) {
+	GImGui.SetNextWindowViewport(viewport_id)
+}
+
+func GetWindowViewport() *ImGuiViewport {
+	return GImGui.GetWindowViewport()
+}
+
+func SetCurrentViewport(current_window *ImGuiWindow, viewport *ImGuiViewportP) {
+	GImGui.SetCurrentViewport(current_window, viewport)
+}
+
+func AddUpdateViewport(window *ImGuiWindow, id ImGuiID, pos ImVec2, size ImVec2, flags ImGuiViewportFlags) *ImGuiViewportP {
+	return GImGui.AddUpdateViewport(window, id, pos, size, flags)
+}
+
+func DestroyViewport(viewport *ImGuiViewportP) {
+	GImGui.DestroyViewport(viewport)
+}
+
+func FindHoveredViewportFromPlatformWindowStack(mouse_platform_pos ImVec2) *ImGuiViewportP {
+	return GImGui.FindHoveredViewportFromPlatformWindowStack(mouse_platform_pos)
+}
+
+func UpdateViewportsNewFrame() {
+	GImGui.UpdateViewportsNewFrame()
+}
+
+func GetWindowAlwaysWantOwnViewport(window *ImGuiWindow) bool {
+	return GImGui.GetWindowAlwaysWantOwnViewport(window)
+}
+
+func UpdateTryMergeWindowIntoHostViewport(window *ImGuiWindow, viewport *ImGuiViewportP) bool {
+	return GImGui.UpdateTryMergeWindowIntoHostViewport(window, viewport)
+}
+
+func WindowSelectViewport(window *ImGuiWindow) {
+	GImGui.WindowSelectViewport(window)
+}
+
+func WindowSyncOwnedViewport(window *ImGuiWindow) {
+	GImGui.WindowSyncOwnedViewport(window)
+}
+
+func UpdatePlatformWindows() {
+	GImGui.UpdatePlatformWindows()
+}
+
+func RenderPlatformWindowsDefault(platform_render_arg any, renderer_render_arg any) {
+	GImGui.RenderPlatformWindowsDefault(platform_render_arg, renderer_render_arg)
+}
+
+func DestroyPlatformWindow(viewport *ImGuiViewportP) {
+	GImGui.DestroyPlatformWindow(viewport)
+}
+
+func DestroyPlatformWindows() {
+	GImGui.DestroyPlatformWindows()
+}
+
+func SetupViewportDrawData(viewport *ImGuiViewportP, draw_lists *[]*ImDrawList) {
+	GImGui.SetupViewportDrawData(viewport, draw_lists)
+}
+
+// widgets.behaviour.go
+
+func SplitterBehavior(bb *ImRect, id ImGuiID, axis ImGuiAxis, size1 *float, size2 *float, min_size1 float, min_size2 float, hover_extend float, hover_visibility_delay float) bool {
+	return GImGui.SplitterBehavior(bb, id, axis, size1, size2, min_size1, min_size2, hover_extend, hover_visibility_delay)
+}
+
+func SliderBehavior(bb *ImRect, id ImGuiID, data_type ImGuiDataType, p_v any, p_min any, p_max any, format string, flags ImGuiSliderFlags, out_grab_bb *ImRect) bool {
+	return GImGui.SliderBehavior(bb, id, data_type, p_v, p_min, p_max, format, flags, out_grab_bb)
+}
+
+// widgets.button.go
+
+func PushButtonRepeat(repeat bool) {
+	GImGui.PushButtonRepeat(repeat)
+}
+
+func PopButtonRepeat() {
+	GImGui.PopButtonRepeat()
+}
+
+func Button(label string) bool {
+	return GImGui.Button(label)
+}
+
+func SmallButton(label string) bool {
+	return GImGui.SmallButton(label)
+}
+
+func ButtonEx(label string, size_arg *ImVec2, flags ImGuiButtonFlags) bool {
+	return GImGui.ButtonEx(label, size_arg, flags)
+}
+
+func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, flags ImGuiButtonFlags) bool {
+	return GImGui.ButtonBehavior(bb, id, out_hovered, out_held, flags)
+}
+
+func CollapseButton(id ImGuiID, pos *ImVec2) bool {
+	return GImGui.CollapseButton(id, pos)
+}
+
+func InvisibleButton(str_id string, size_arg ImVec2, flags ImGuiButtonFlags) bool {
+	return GImGui.InvisibleButton(str_id, size_arg, flags)
+}
+
+func ArrowButton(str_id string, dir ImGuiDir) bool {
+	return GImGui.ArrowButton(str_id, dir)
+}
+
+func RadioButtonBool(label string, active bool) bool {
+	return GImGui.RadioButtonBool(label, active)
+}
+
+func RadioButtonInt(label string, v *int, v_button int) bool {
+	return GImGui.RadioButtonInt(label, v, v_button)
+}
+
+func CloseButton(id ImGuiID, pos *ImVec2) bool {
+	return GImGui.CloseButton(id, pos)
+}
+
+func ArrowButtonEx(str_id string, dir ImGuiDir, size ImVec2, flags ImGuiButtonFlags) bool {
+	return GImGui.ArrowButtonEx(str_id, dir, size, flags)
+}
+
+// widgets.checkbox.go
+
+func CheckboxFlagsInt(label string, flags *int, flags_value int) bool {
+	return GImGui.CheckboxFlagsInt(label, flags, flags_value)
+}
+
+func CheckboxFlagsUint(label string, flags *uint, flags_value uint) bool {
+	return GImGui.CheckboxFlagsUint(label, flags, flags_value)
+}
+
+func Checkbox(label string, v *bool) bool {
+	return GImGui.Checkbox(label, v)
+}
+
+// widgets.colorpicker.go
+
+func ColorEdit3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
+	return GImGui.ColorEdit3(label, col, flags)
+}
+
+func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
+	return GImGui.ColorEdit4(label, col, flags)
+}
+
+func ColorPicker3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
+	return GImGui.ColorPicker3(label, col, flags)
+}
+
+func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_col []float) bool {
+	return GImGui.ColorPicker4(label, col, flags, ref_col)
+}
+
+func ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImVec2) bool {
+	return GImGui.ColorButton(desc_id, col, flags, size)
+}
+
+func SetColorEditOptions(flags ImGuiColorEditFlags) {
+	GImGui.SetColorEditOptions(flags)
+}
+
+func ColorTooltip(text string, col [4]float, flags ImGuiColorEditFlags) {
+	GImGui.ColorTooltip(text, col, flags)
+}
+
+func ColorEditOptionsPopup(col [4]float, flags ImGuiColorEditFlags) {
+	GImGui.ColorEditOptionsPopup(col, flags)
+}
+
+func ColorPickerOptionsPopup(ref_col *[4]float, flags ImGuiColorEditFlags) {
+	GImGui.ColorPickerOptionsPopup(ref_col, flags)
+}
+
+// widgets.combo.go
+
+func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool {
+	return GImGui.BeginCombo(label, preview_value, flags)
+}
+
+func BeginComboPreview() bool {
+	return GImGui.BeginComboPreview()
+}
+
+func EndComboPreview() {
+	GImGui.EndComboPreview()
+}
+
+func BeginComboPopup(popup_id ImGuiID, bb *ImRect, flags ImGuiComboFlags) bool {
+	return GImGui.BeginComboPopup(popup_id, bb, flags)
+}
+
+func EndCombo() {
+	GImGui.EndCombo()
+}
+
+func CalcMaxPopupHeightFromItemCount(items_count int) float32 {
+	return GImGui.CalcMaxPopupHeightFromItemCount(items_count)
+}
+
+func Combo(label string, current_item *int, items []string, items_count int, popup_max_height_in_items int /*= -1*/) bool {
+	return GImGui.Combo(label, current_item, items, items_count, popup_max_height_in_items)
+}
+
+func ComboFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count, popup_max_height_in_items int /*= -1*/) bool {
+	return GImGui.ComboFunc(label, current_item, items_getter, data, items_count, popup_max_height_in_items)
+}
+
+// widgets.drag.go
+
+func DragFloat(label string, v *float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragFloat(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragFloat2(label string, v *[2]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragFloat2(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragFloat3(label string, v *[3]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragFloat3(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragFloat4(label string, v *[4]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragFloat4(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragFloatRange2(label string, v_current_min *float, v_current_max *float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "*/, format_max string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragFloatRange2(label, v_current_min, v_current_max, v_speed, v_min, v_max, format, format_max, flags)
+}
+
+func DragInt(label string, v *int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragInt(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragInt2(label string, v [2]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragInt2(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragInt3(label string, v [3]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragInt3(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragInt4(label string, v [4]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.DragInt4(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func DragIntRange2(label string, v_current_min *int, v_current_max *int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "*/, format_max string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragIntRange2(label, v_current_min, v_current_max, v_speed, v_min, v_max, format, format_max, flags)
+}
+
+func DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float /*= 0*/, p_min any /*= L*/, p_max any /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragScalar(label, data_type, p_data, v_speed, p_min, p_max, format, flags)
+}
+
+func DragScalarFloat(label string, data_type ImGuiDataType, p_data *float, v_speed float /*= 0*/, p_min *float /*= L*/, p_max *float /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragScalarFloat(label, data_type, p_data, v_speed, p_min, p_max, format, flags)
+}
+
+func DragScalarFloats(label string, data_type ImGuiDataType, p_data []float, v_speed float /*= 0*/, p_min *float /*= L*/, p_max *float /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragScalarFloats(label, data_type, p_data, v_speed, p_min, p_max, format, flags)
+}
+
+func DragScalarInt(label string, data_type ImGuiDataType, p_data *int, v_speed float /*= 0*/, p_min *int /*= L*/, p_max *int /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragScalarInt(label, data_type, p_data, v_speed, p_min, p_max, format, flags)
+}
+
+func DragScalarInts(label string, data_type ImGuiDataType, p_data []int, v_speed float /*= 0*/, p_min *int /*= L*/, p_max *int /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragScalarInts(label, data_type, p_data, v_speed, p_min, p_max, format, flags)
+}
+
+func DragBehavior(id ImGuiID, data_type ImGuiDataType, p_v any, v_speed float, p_min any, p_max any, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.DragBehavior(id, data_type, p_v, v_speed, p_min, p_max, format, flags)
+}
+
+// widgets.go
+
+func IsClippedEx(bb *ImRect, id ImGuiID, clip_even_when_logged bool) bool {
+	return GImGui.IsClippedEx(bb, id, clip_even_when_logged)
+}
+
+func IsItemActive() bool {
+	return GImGui.IsItemActive()
+}
+
+func ItemHoverable(bb *ImRect, id ImGuiID) bool {
+	return GImGui.ItemHoverable(bb, id)
+}
+
+func CalcItemSize(size ImVec2, default_w float, default_h float) ImVec2 {
+	return GImGui.CalcItemSize(size, default_w, default_h)
+}
+
+func ItemAdd(bb *ImRect, id ImGuiID, nav_bb_arg *ImRect, extra_flags ImGuiItemFlags) bool {
+	return GImGui.ItemAdd(bb, id, nav_bb_arg, extra_flags)
+}
+
+func ItemSizeVec(size *ImVec2, text_baseline_y float) {
+	GImGui.ItemSizeVec(size, text_baseline_y)
+}
+
+func SameLine(offset_from_start_x, spacing_w float) {
+	GImGui.SameLine(offset_from_start_x, spacing_w)
+}
+
+func ItemSizeRect(bb *ImRect, text_baseline_y float) {
+	GImGui.ItemSizeRect(bb, text_baseline_y)
+}
+
+// widgets.helpers.go
+
+func ActivateItem(id ImGuiID) {
+	GImGui.ActivateItem(id)
+}
+
+func ItemInputable(window *ImGuiWindow, id ImGuiID) {
+	GImGui.ItemInputable(window, id)
+}
+
+func CalcWrapWidthForPos(pos *ImVec2, wrap_pos_x float) float {
+	return GImGui.CalcWrapWidthForPos(pos, wrap_pos_x)
+}
+
+func IsItemToggledSelection() bool {
+	return GImGui.IsItemToggledSelection()
+}
+
+func SetItemUsingMouseWheel() {
+	GImGui.SetItemUsingMouseWheel()
+}
+
+// widgets.image.go
+
+func Image(user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, tint_col ImVec4, border_col ImVec4) {
+	GImGui.Image(user_texture_id, size, uv0, uv1, tint_col, border_col)
+}
+
+func ImageButtonEx(id ImGuiID, texture_id ImTextureID, size *ImVec2, uv0 *ImVec2, uv1 *ImVec2, padding *ImVec2, bg_col *ImVec4, tint_col *ImVec4) bool {
+	return GImGui.ImageButtonEx(id, texture_id, size, uv0, uv1, padding, bg_col, tint_col)
+}
+
+func ImageButton(user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, frame_padding int /*/*= /*/, bg_col ImVec4, tint_col ImVec4) bool {
+	return GImGui.ImageButton(user_texture_id, size, uv0, uv1, frame_padding, bg_col, tint_col)
+}
+
+// widgets.input.go
+
+func TempInputText(bb *ImRect, id ImGuiID, label string, buf *[]byte, flags ImGuiInputTextFlags) bool {
+	return GImGui.TempInputText(bb, id, label, buf, flags)
+}
+
+func TempInputScalar(bb *ImRect, id ImGuiID, label string, data_type ImGuiDataType, p_data any, format string, p_clamp_min any, p_clamp_max any) bool {
+	return GImGui.TempInputScalar(bb, id, label, data_type, p_data, format, p_clamp_min, p_clamp_max)
+}
+
+func TempInputIsActive(id ImGuiID) bool {
+	return GImGui.TempInputIsActive(id)
+}
+
+func GetInputTextState(id ImGuiID) *ImGuiInputTextState {
+	return GImGui.GetInputTextState(id)
+}
+
+func InputText(label string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return GImGui.InputText(label, char, flags, callback, user_data)
+}
+
+func InputTextMultiline(label string, buf *[]byte, size ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return GImGui.InputTextMultiline(label, buf, size, flags, callback, user_data)
+}
+
+func InputTextWithHint(label string, hint string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return GImGui.InputTextWithHint(label, hint, char, flags, callback, user_data)
+}
+
+func InputTextString(label string, s *string, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return GImGui.InputTextString(label, s, flags, callback, user_data)
+}
+
+func InputTextMultilineString(label string, s *string, size ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return GImGui.InputTextMultilineString(label, s, size, flags, callback, user_data)
+}
+
+func InputTextWithHintString(label string, hint string, s *string, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return GImGui.InputTextWithHintString(label, hint, s, flags, callback, user_data)
+}
+
+func InputFloat(label string, v *float, step, step_fast float, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputFloat(label, v, step, step_fast, format, flags)
+}
+
+func InputFloat2(label string, v *[2]float, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputFloat2(label, v, format, flags)
+}
+
+func InputFloat3(label string, v *[3]float, format string /*= "%.3f"*/, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputFloat3(label, v, format, flags)
+}
+
+func InputFloat4(label string, v *[4]float, format string /*= "%.3f"*/, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputFloat4(label, v, format, flags)
+}
+
+func InputInt(label string, v *int, step int /*= 1*/, step_fast int /*= 100*/, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputInt(label, v, step, step_fast, flags)
+}
+
+func InputInt2(label string, v *[2]int, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputInt2(label, v, flags)
+}
+
+func InputInt3(label string, v *[3]int, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputInt3(label, v, flags)
+}
+
+func InputInt4(label string, v *[4]int, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputInt4(label, v, flags)
+}
+
+func InputDouble(label string, v *double, step double /*= 0*/, step_fast double /*= 0*/, format string /*= "%.6f"*/, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputDouble(label, v, step, step_fast, format, flags)
+}
+
+func InputTextCalcTextSizeW(text []ImWchar, remaining *[]ImWchar, out_offset *ImVec2, stop_on_new_line bool) ImVec2 {
+	return GImGui.InputTextCalcTextSizeW(text, remaining, out_offset, stop_on_new_line)
+}
+
+func InputTextFilterCharacter(p_char *rune, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any, input_source ImGuiInputSource) bool {
+	return GImGui.InputTextFilterCharacter(p_char, flags, callback, user_data, input_source)
+}
+
+func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, callback_user_data any) bool {
+	return GImGui.InputTextEx(label, hint, buf, size_arg, flags, callback, callback_user_data)
+}
+
+// widgets.input.scalar.go
+
+func InputScalarInt64(label string, p_data, p_step, p_step_fast *int64, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarInt64(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+func InputScalarInt64s(label string, p_data []int64, p_step, p_step_fast *int64, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarInt64s(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+func InputScalarInt32(label string, p_data, p_step, p_step_fast *int32, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarInt32(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+func InputScalarInt32s(label string, p_data []int32, p_step, p_step_fast *int32, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarInt32s(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+func InputScalarFloat64(label string, p_data, p_step, p_step_fast *float64, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarFloat64(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+func InputScalarFloat64s(label string, p_data []float64, p_step, p_step_fast *float64, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarFloat64s(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+func InputScalarFloat32(label string, p_data, p_step, p_step_fast *float32, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarFloat32(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+func InputScalarFloat32s(label string, p_data []float32, p_step, p_step_fast *float32, format string, flags ImGuiInputTextFlags) bool {
+	return GImGui.InputScalarFloat32s(label, p_data, p_step, p_step_fast, format, flags)
+}
+
+// widgets.listbox.go
+
+func BeginListBox(label string, size_arg ImVec2) bool {
+	return GImGui.BeginListBox(label, size_arg)
+}
+
+func EndListBox() {
+	GImGui.EndListBox()
+}
+
+func ListBox(label string, current_item *int, items []string, items_count int, height_in_items int /*= -1*/) bool {
+	return GImGui.ListBox(label, current_item, items, items_count, height_in_items)
+}
+
+func ListBoxFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count int, height_in_items int /*= -1*/) bool {
+	return GImGui.ListBoxFunc(label, current_item, items_getter, data, items_count, height_in_items)
+}
+
+// widgets.menu.go
+
+func BeginMenuBar() bool {
+	return GImGui.BeginMenuBar()
+}
+
+func EndMenuBar() {
+	GImGui.EndMenuBar()
+}
+
+func BeginMainMenuBar() bool {
+	return GImGui.BeginMainMenuBar()
+}
+
+func EndMainMenuBar() {
+	GImGui.EndMainMenuBar()
+}
+
+func BeginMenu(label string, enabled bool /*= true*/) bool {
+	return GImGui.BeginMenu(label, enabled)
+}
+
+func EndMenu() {
+	GImGui.EndMenu()
+}
+
+func MenuItem(label string, shortcut string /*= L*/, selected *bool /*= e*/, enabled bool /*= true*/) bool {
+	return GImGui.MenuItem(label, shortcut, selected, enabled)
+}
+
+func MenuItemSelected(label string, shortcut string, p_selected *bool, enabled bool /*= true*/) bool {
+	return GImGui.MenuItemSelected(label, shortcut, p_selected, enabled)
+}
+
+func MenuItemShortcut(label string, key_chord ImGuiKeyChord, p_selected *bool, enabled bool /*= true*/) bool {
+	return GImGui.MenuItemShortcut(label, key_chord, p_selected, enabled)
+}
+
+func BeginViewportSideBar(name string, viewport_p *ImGuiViewport, dir ImGuiDir, axis_size float, window_flags ImGuiWindowFlags) bool {
+	return GImGui.BeginViewportSideBar(name, viewport_p, dir, axis_size, window_flags)
+}
+
+func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
+	return GImGui.BeginMenuEx(label, icon, enabled)
+}
+
+func MenuItemEx(label string, icon string, shortcut string, selected *bool, enabled bool /*= true*/) bool {
+	return GImGui.MenuItemEx(label, icon, shortcut, selected, enabled)
+}
+
+// widgets.multiselect.go
+
+func BeginMultiSelect(flags ImGuiMultiSelectFlags, selection_size int, items_count int) *ImGuiMultiSelectIO {
+	return GImGui.BeginMultiSelect(flags, selection_size, items_count)
+}
+
+func EndMultiSelect() *ImGuiMultiSelectIO {
+	return GImGui.EndMultiSelect()
+}
+
+func SetNextItemSelectionUserData(selection_user_data ImGuiSelectionUserData) {
+	GImGui.SetNextItemSelectionUserData(selection_user_data)
+}
+
+func MultiSelectItemHeader(id ImGuiID, p_selected *bool, p_button_flags *ImGuiButtonFlags) {
+	GImGui.MultiSelectItemHeader(id, p_selected, p_button_flags)
+}
+
+func MultiSelectItemFooter(id ImGuiID, p_selected *bool, p_pressed *bool) {
+	GImGui.MultiSelectItemFooter(id, p_selected, p_pressed)
+}
+
+func GetBoxSelectState(id ImGuiID) *ImGuiBoxSelectState {
+	return GImGui.GetBoxSelectState(id)
+}
+
+func BeginBoxSelect(scope_rect *ImRect, window *ImGuiWindow, box_select_id ImGuiID, ms_flags ImGuiMultiSelectFlags) bool {
+	return GImGui.BeginBoxSelect(scope_rect, window, box_select_id, ms_flags)
+}
+
+func EndBoxSelect(scope_rect *ImRect, ms_flags ImGuiMultiSelectFlags) {
+	GImGui.EndBoxSelect(scope_rect, ms_flags)
+}
+
+// widgets.plotting.go
+
+func PlotLines(label string, values []float, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/, stride int /*= sizeof(float)*/) {
+	GImGui.PlotLines(label, values, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size, stride)
+}
+
+func PlotLinesFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/) {
+	GImGui.PlotLinesFunc(label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+}
+
+func PlotHistogram(label string, values []float, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/, stride int /* = sizeof(float)*/) {
+	GImGui.PlotHistogram(label, values, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size, stride)
+}
+
+func PlotHistogramFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/) {
+	GImGui.PlotHistogramFunc(label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+}
+
+func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int, overlay_text string, scale_min float, scale_max float, frame_size ImVec2) int {
+	return GImGui.PlotEx(plot_type, label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, frame_size)
+}
+
+// widgets.progress.go
+
+func ProgressBar(fraction float, size_arg ImVec2 /*= ImVec2(-FLT_MIN, 0)*/, overlay string) {
+	GImGui.ProgressBar(fraction, size_arg, overlay)
+}
+
+// widgets.query.go
+
+func IsItemHovered(flags ImGuiHoveredFlags) bool {
+	return GImGui.IsItemHovered(flags)
+}
+
+func IsItemFocused() bool {
+	return GImGui.IsItemFocused()
+}
+
+func IsItemClicked(mouse_button ImGuiMouseButton) bool {
+	return GImGui.IsItemClicked(mouse_button)
+}
+
+func IsItemVisible() bool {
+	return GImGui.IsItemVisible()
+}
+
+func IsItemEdited() bool {
+	return GImGui.IsItemEdited()
+}
+
+func IsItemActivated() bool {
+	return GImGui.IsItemActivated()
+}
+
+func IsItemDeactivated() bool {
+	return GImGui.IsItemDeactivated()
+}
+
+func IsItemDeactivatedAfterEdit() bool {
+	return GImGui.IsItemDeactivatedAfterEdit()
+}
+
+func IsItemToggledOpen() bool {
+	return GImGui.IsItemToggledOpen()
+}
+
+func IsAnyItemHovered() bool {
+	return GImGui.IsAnyItemHovered()
+}
+
+func IsAnyItemActive() bool {
+	return GImGui.IsAnyItemActive()
+}
+
+func IsAnyItemFocused() bool {
+	return GImGui.IsAnyItemFocused()
+}
+
+func GetItemRectMin() ImVec2 {
+	return GImGui.GetItemRectMin()
+}
+
+func GetItemRectMax() ImVec2 {
+	return GImGui.GetItemRectMax()
+}
+
+func GetItemRectSize() ImVec2 {
+	return GImGui.GetItemRectSize()
+}
+
+func SetItemAllowOverlap() {
+	GImGui.SetItemAllowOverlap()
+}
+
+// widgets.seperator.go
+
+func Separator() {
+	GImGui.Separator()
+}
+
+func SeparatorEx(flags ImGuiSeparatorFlags) {
+	GImGui.SeparatorEx(flags)
+}
+
+// widgets.slider.go
+
+func SliderFloat(label string, v *float, v_min float, v_max float, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderFloat(label, v, v_min, v_max, format, flags)
+}
+
+func SliderFloat2(label string, v *[2]float, v_min float, v_max float, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderFloat2(label, v, v_min, v_max, format, flags)
+}
+
+func SliderFloat3(label string, v *[3]float, v_min float, v_max float, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderFloat3(label, v, v_min, v_max, format, flags)
+}
+
+func SliderFloat4(label string, v *[4]float, v_min float, v_max float, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderFloat4(label, v, v_min, v_max, format, flags)
+}
+
+func SliderAngle(label string, v_rad *float, v_degrees_min float /*= 0*/, v_degrees_max float /*= 0*/, format string /* = "%.0f deg"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderAngle(label, v_rad, v_degrees_min, v_degrees_max, format, flags)
+}
+
+func SliderInt(label string, v *int, v_min int, v_max int, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderInt(label, v, v_min, v_max, format, flags)
+}
+
+func SliderInt2(label string, v [2]int, v_min int, v_max int, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderInt2(label, v, v_min, v_max, format, flags)
+}
+
+func SliderInt3(label string, v [3]int, v_min int, v_max int, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderInt3(label, v, v_min, v_max, format, flags)
+}
+
+func SliderInt4(label string, v [4]int, v_min int, v_max int, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderInt4(label, v, v_min, v_max, format, flags)
+}
+
+func SliderScalar(label string, data_type ImGuiDataType, p_data any, p_min any, p_max any, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderScalar(label, data_type, p_data, p_min, p_max, format, flags)
+}
+
+func SliderScalarN(label string, data_type ImGuiDataType, p_data []float, p_min float, p_max float, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.SliderScalarN(label, data_type, p_data, p_min, p_max, format, flags)
+}
+
+func VSliderFloat(label string, size ImVec2, v *float, v_min float, v_max float, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.VSliderFloat(label, size, v, v_min, v_max, format, flags)
+}
+
+func VSliderInt(label string, size ImVec2, v *int, v_min int, v_max int, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return GImGui.VSliderInt(label, size, v, v_min, v_max, format, flags)
+}
+
+func VSliderScalar(label string, size ImVec2, data_type ImGuiDataType, p_data any, p_min any, p_max any, format string, flags ImGuiSliderFlags) bool {
+	return GImGui.VSliderScalar(label, size, data_type, p_data, p_min, p_max, format, flags)
+}
+
+// widgets.tabbar.go
+
+func GetTabBarFromTabBarRef(ref ImGuiPtrOrIndex) *ImGuiTabBar {
+	return GImGui.GetTabBarFromTabBarRef(ref)
+}
+
+func GetTabBarRefFromTabBar(tab_bar *ImGuiTabBar) ImGuiPtrOrIndex {
+	return GImGui.GetTabBarRefFromTabBar(tab_bar)
+}
+
+func BeginTabBar(str_id string, flags ImGuiTabBarFlags) bool {
+	return GImGui.BeginTabBar(str_id, flags)
+}
+
+func EndTabBar() {
+	GImGui.EndTabBar()
+}
+
+func BeginTabItem(label string, p_open *bool, flags ImGuiTabItemFlags) bool {
+	return GImGui.BeginTabItem(label, p_open, flags)
+}
+
+func EndTabItem() {
+	GImGui.EndTabItem()
+}
+
+func TabItemButton(label string, flags ImGuiTabItemFlags) bool {
+	return GImGui.TabItemButton(label, flags)
+}
+
+func SetTabItemClosed(tab_or_docked_window_label string) {
+	GImGui.SetTabItemClosed(tab_or_docked_window_label)
+}
+
+func BeginTabBarEx(tab_bar *ImGuiTabBar, tab_bar_bb *ImRect, flags ImGuiTabBarFlags) bool {
+	return GImGui.BeginTabBarEx(tab_bar, tab_bar_bb, flags)
+}
+
+func TabBarQueueReorderFromMousePos(tab_bar *ImGuiTabBar, src_tab *ImGuiTabItem, mouse_pos ImVec2) {
+	GImGui.TabBarQueueReorderFromMousePos(tab_bar, src_tab, mouse_pos)
+}
+
+func TabBarProcessReorder(tab_bar *ImGuiTabBar) bool {
+	return GImGui.TabBarProcessReorder(tab_bar)
+}
+
+func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabItemFlags) bool {
+	return GImGui.TabItemEx(tab_bar, label, p_open, flags)
+}
+
+func TabItemCalcSize(label string, has_close_button bool) ImVec2 {
+	return GImGui.TabItemCalcSize(label, has_close_button)
+}
+
+func TabItemBackground(draw_list *ImDrawList, bb *ImRect, flags ImGuiTabItemFlags, col ImU32) {
+	GImGui.TabItemBackground(draw_list, bb, flags, col)
+}
+
+func TabItemLabelAndCloseButton(draw_list *ImDrawList, bb *ImRect, flags ImGuiTabItemFlags, frame_padding ImVec2, label string, tab_id ImGuiID, close_button_id ImGuiID, is_contents_visible bool, out_just_closed *bool, out_text_clipped *bool) {
+	GImGui.TabItemLabelAndCloseButton(draw_list, bb, flags, frame_padding, label, tab_id, close_button_id, is_contents_visible, out_just_closed, out_text_clipped)
+}
+
+func TabBarScrollToTab(tab_bar *ImGuiTabBar, tab_id ImGuiID, sections [3]ImGuiTabBarSection) {
+	GImGui.TabBarScrollToTab(tab_bar, tab_id, sections)
+}
+
+func TabBarTabListPopupButton(tab_bar *ImGuiTabBar) *ImGuiTabItem {
+	return GImGui.TabBarTabListPopupButton(tab_bar)
+}
+
+func TabBarCalcTabID(tab_bar *ImGuiTabBar, label string) ImU32 {
+	return GImGui.TabBarCalcTabID(tab_bar, label)
+}
+
+func TabBarCalcMaxTabWidth() float {
+	return GImGui.TabBarCalcMaxTabWidth()
+}
+
+func TabBarScrollingButtons(tab_bar *ImGuiTabBar) *ImGuiTabItem {
+	return GImGui.TabBarScrollingButtons(tab_bar)
+}
+
+func TabBarLayout(tab_bar *ImGuiTabBar) {
+	GImGui.TabBarLayout(tab_bar)
+}
+
+// widgets.text.go
+
+func TextUnformatted(text string) {
+	GImGui.TextUnformatted(text)
+}
+
+func TextDisabled(format string, args ...any) {
+	GImGui.TextDisabled(format, args...)
+}
+
+func TextWrapped(format string, args ...any) {
+	GImGui.TextWrapped(format, args...)
+}
+
+func LabelText(label string, format string, args ...any) {
+	GImGui.LabelText(label, format, args...)
+}
+
+func BulletText(format string, args ...any) {
+	GImGui.BulletText(format, args...)
+}
+
+func Bullet() {
+	GImGui.Bullet()
+}
+
+// widgets.tree.go
+
+func TreeNodeF(str_id string, format string, args ...any) bool {
+	return GImGui.TreeNodeF(str_id, format, args...)
+}
+
+func TreeNodeInterface(ptr_id any, format string, args ...any) bool {
+	return GImGui.TreeNodeInterface(ptr_id, format, args...)
+}
+
+func TreeNodeEx(str_id string, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
+	return GImGui.TreeNodeEx(str_id, flags, format, args...)
+}
+
+func TreeNodeInterfaceEx(ptr_id any, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
+	return GImGui.TreeNodeInterfaceEx(ptr_id, flags, format, args...)
+}
+
+func TreePush(str_id string) {
+	GImGui.TreePush(str_id)
+}
+
+func TreePushInterface(ptr_id any) {
+	GImGui.TreePushInterface(ptr_id)
+}
+
+func GetTreeNodeToLabelSpacing() float {
+	return GImGui.GetTreeNodeToLabelSpacing()
+}
+
+func CollapsingHeader(label string, flags ImGuiTreeNodeFlags) bool {
+	return GImGui.CollapsingHeader(label, flags)
+}
+
+func CollapsingHeaderVisible(label string, p_visible *bool, flags ImGuiTreeNodeFlags) bool {
+	return GImGui.CollapsingHeaderVisible(label, p_visible, flags)
+}
+
+func SetNextItemOpen(is_open bool, cond ImGuiCond) {
+	GImGui.SetNextItemOpen(is_open, cond)
+}
+
+func TreeNode(label string) bool {
+	return GImGui.TreeNode(label)
+}
+
+func TreePushOverrideID(id ImGuiID) {
+	GImGui.TreePushOverrideID(id)
+}
+
+func TreePop() {
+	GImGui.TreePop()
+}
+
+func TreeNodeBehaviorIsOpen(id ImGuiID, flags ImGuiTreeNodeFlags) bool {
+	return GImGui.TreeNodeBehaviorIsOpen(id, flags)
+}
+
+func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
+	return GImGui.TreeNodeBehavior(id, flags, label)
+}
+
+// windows.begin.go
+
+func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
+	return GImGui.Begin(name, p_open, flags)
+}
+
+// windows.children.go
+
+func BeginChildEx(name string, id ImGuiID, size_arg *ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	return GImGui.BeginChildEx(name, id, size_arg, border, flags)
+}
+
+func BeginChild(str_id string, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	return GImGui.BeginChild(str_id, size, border, flags)
+}
+
+func BeginChildID(id ImGuiID, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	return GImGui.BeginChildID(id, size, border, flags)
+}
+
+func EndChild() {
+	GImGui.EndChild()
+}
+
+func BeginChildFrame(id ImGuiID, size ImVec2, flags ImGuiWindowFlags) bool {
+	return GImGui.BeginChildFrame(id, size, flags)
+}
+
+func EndChildFrame() {
+	GImGui.EndChildFrame()
+}
+
+// windows.focus.go
+
+func SelectablePointer(label string, p_selected *bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
+	return GImGui.SelectablePointer(label, p_selected, flags, size_arg)
+}
+
+func Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
+	return GImGui.Selectable(label, selected, flags, size_arg)
+}
+
+func SetItemDefaultFocus() {
+	GImGui.SetItemDefaultFocus()
+}
+
+func SetKeyboardFocusHere(offset int) {
+	GImGui.SetKeyboardFocusHere(offset)
+}
+
+func PushFocusScope(id ImGuiID) {
+	GImGui.PushFocusScope(id)
+}
+
+func PopFocusScope() {
+	GImGui.PopFocusScope()
+}
+
+func GetFocusedFocusScope() ImGuiID {
+	return GImGui.GetFocusedFocusScope()
+}
+
+func GetFocusScope() ImGuiID {
+	return GImGui.GetFocusScope()
+}
+
+func FocusWindow(window *ImGuiWindow) {
+	GImGui.FocusWindow(window)
+}
+
+func BringWindowToFocusFront(window *ImGuiWindow) {
+	GImGui.BringWindowToFocusFront(window)
+}
+
+func BringWindowToDisplayFront(window *ImGuiWindow) {
+	GImGui.BringWindowToDisplayFront(window)
+}
+
+func FocusTopMostWindowUnderOne(under_this_window *ImGuiWindow, ignore_window *ImGuiWindow) {
+	GImGui.FocusTopMostWindowUnderOne(under_this_window, ignore_window)
+}
+
+// windows.go
+
+func SetNextWindowBgAlpha(alpha float) {
+	GImGui.SetNextWindowBgAlpha(alpha)
+}
+
+func AddRootWindowToDrawData(window *ImGuiWindow) {
+	GImGui.AddRootWindowToDrawData(window)
+}
+
+func AddWindowToDrawData(window *ImGuiWindow, layer int) {
+	GImGui.AddWindowToDrawData(window, layer)
+}
+
+func FindWindowByID(id ImGuiID) *ImGuiWindow {
+	return GImGui.FindWindowByID(id)
+}
+
+func FindWindowByName(e string) *ImGuiWindow {
+	return GImGui.FindWindowByName(e)
+}
+
+func SetCurrentWindow(window *ImGuiWindow) {
+	GImGui.SetCurrentWindow(window)
+}
+
+func SetNextWindowSize(size *ImVec2, cond ImGuiCond) {
+	GImGui.SetNextWindowSize(size, cond)
+}
+
+func CreateNewWindow(name string, flags ImGuiWindowFlags) *ImGuiWindow {
+	return GImGui.CreateNewWindow(name, flags)
+}
+
+func CalcWindowAutoFitSize(window *ImGuiWindow, size_contents *ImVec2) ImVec2 {
+	return GImGui.CalcWindowAutoFitSize(window, size_contents)
+}
+
+func ClampWindowRect(window *ImGuiWindow, visibility_rect *ImRect) {
+	GImGui.ClampWindowRect(window, visibility_rect)
+}
+
+func CalcWindowSizeAfterConstraint(window *ImGuiWindow, size_desired *ImVec2) ImVec2 {
+	return GImGui.CalcWindowSizeAfterConstraint(window, size_desired)
+}
+
+func End() {
+	GImGui.End()
+}
+
+func FindHoveredWindow() {
+	GImGui.FindHoveredWindow()
+}
+
+func UpdateHoveredWindowAndCaptureFlags() {
+	GImGui.UpdateHoveredWindowAndCaptureFlags()
+}
+
+func UpdateMouseMovingWindowNewFrame() {
+	GImGui.UpdateMouseMovingWindowNewFrame()
+}
+
+// windows.helpers.go
+
+func GetCurrentWindowRead() *ImGuiWindow {
+	return GImGui.GetCurrentWindowRead()
+}
+
+func GetCurrentWindow() *ImGuiWindow {
+	return GImGui.GetCurrentWindow()
+}
+
+func CalcWindowNextAutoFitSize(window *ImGuiWindow) ImVec2 {
+	return GImGui.CalcWindowNextAutoFitSize(window)
+}
+
+func IsWindowAbove(potential_above *ImGuiWindow, potential_below *ImGuiWindow) bool {
+	return GImGui.IsWindowAbove(potential_above, potential_below)
+}
+
+func BringWindowToDisplayBack(window *ImGuiWindow) {
+	GImGui.BringWindowToDisplayBack(window)
+}
+
+// windows.mut.go
+
+func SetNextWindowPos(pos *ImVec2, cond ImGuiCond, pivot ImVec2) {
+	GImGui.SetNextWindowPos(pos, cond, pivot)
+}
+
+func SetNextWindowSizeConstraints(size_min ImVec2, size_max ImVec2, custom_callback ImGuiSizeCallback, custom_callback_data any) {
+	GImGui.SetNextWindowSizeConstraints(size_min, size_max, custom_callback, custom_callback_data)
+}
+
+func SetNextWindowContentSize(size ImVec2) {
+	GImGui.SetNextWindowContentSize(size)
+}
+
+func SetNextWindowCollapsed(collapsed bool, cond ImGuiCond) {
+	GImGui.SetNextWindowCollapsed(collapsed, cond)
+}
+
+func SetNextWindowFocus() {
+	GImGui.SetNextWindowFocus()
+}
+
+func SetWindowPos(pos ImVec2, cond ImGuiCond) {
+	GImGui.SetWindowPos(pos, cond)
+}
+
+func SetWindowSize(size ImVec2, cond ImGuiCond) {
+	GImGui.SetWindowSize(size, cond)
+}
+
+func SetWindowCollapsed(collapsed bool, cond ImGuiCond) {
+	GImGui.SetWindowCollapsed(collapsed, cond)
+}
+
+func SetWindowFocus() {
+	GImGui.SetWindowFocus()
+}
+
+func SetWindowFontScale(scale float) {
+	GImGui.SetWindowFontScale(scale)
+}
+
+func SetNamedWindowPos(name string, pos ImVec2, cond ImGuiCond) {
+	GImGui.SetNamedWindowPos(name, pos, cond)
+}
+
+func SetNamedWindowSize(name string, size ImVec2, cond ImGuiCond) {
+	GImGui.SetNamedWindowSize(name, size, cond)
+}
+
+func SetNamedWindowCollapsed(name string, collapsed bool, cond ImGuiCond) {
+	GImGui.SetNamedWindowCollapsed(name, collapsed, cond)
+}
+
+func SetNamedWindowFocus(name string) {
+	GImGui.SetNamedWindowFocus(name)
+}
+
+// windows.queries.go
+
+func IsWindowAppearing() bool {
+	return GImGui.IsWindowAppearing()
+}
+
+func IsWindowCollapsed() bool {
+	return GImGui.IsWindowCollapsed()
+}
+
+func IsWindowFocused(flags ImGuiFocusedFlags) bool {
+	return GImGui.IsWindowFocused(flags)
+}
+
+func IsWindowHovered(flags ImGuiHoveredFlags) bool {
+	return GImGui.IsWindowHovered(flags)
+}
+
+func GetWindowDrawList() *ImDrawList {
+	return GImGui.GetWindowDrawList()
+}
+
+func GetWindowPos() ImVec2 {
+	return GImGui.GetWindowPos()
+}
+
+func GetWindowSize() ImVec2 {
+	return GImGui.GetWindowSize()
+}
+
+func GetWindowWidth() float {
+	return GImGui.GetWindowWidth()
+}
+
+func GetWindowHeight() float {
+	return GImGui.GetWindowHeight()
+}
+
+// windows.render.go
+
+func RenderFrame(p_min ImVec2, p_max ImVec2, fill_col ImU32, border bool /*= true*/, rounding float) {
+	GImGui.RenderFrame(p_min, p_max, fill_col, border, rounding)
+}
+
+func RenderWindowDecorations(window *ImGuiWindow, title_bar_rect *ImRect, title_bar_is_highlight bool, resize_grip_count int, resize_grip_col [4]ImU32, resize_grip_draw_size float) {
+	GImGui.RenderWindowDecorations(window, title_bar_rect, title_bar_is_highlight, resize_grip_count, resize_grip_col, resize_grip_draw_size)
+}
+
+func RenderWindowOuterBorders(window *ImGuiWindow) {
+	GImGui.RenderWindowOuterBorders(window)
+}
+
+func RenderWindowTitleBarContents(window *ImGuiWindow, title_bar_rect *ImRect, name string, p_open *bool) {
+	GImGui.RenderWindowTitleBarContents(window, title_bar_rect, name, p_open)
+}
+
+// windows.resize.go
+
+func CalcResizePosSizeFromAnyCorner(window *ImGuiWindow, corner_target, corner_norm *ImVec2, out_pos, out_size *ImVec2) {
+	GImGui.CalcResizePosSizeFromAnyCorner(window, corner_target, corner_norm, out_pos, out_size)
+}
+
+func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border_held *int, resize_grip_count int, resize_grip_col *[4]ImU32, visibility_rect *ImRect) bool {
+	return GImGui.UpdateWindowManualResize(window, size_auto_fit, border_held, resize_grip_count, resize_grip_col, visibility_rect)
+}
+
+// windows.scroll.go
+
+func GetScrollX() float {
+	return GImGui.GetScrollX()
+}
+
+func GetScrollY() float {
+	return GImGui.GetScrollY()
+}
+
+func SetScrollX(scroll_x float) {
+	GImGui.SetScrollX(scroll_x)
+}
+
+func SetScrollY(scroll_y float) {
+	GImGui.SetScrollY(scroll_y)
+}
+
+func GetScrollMaxX() float {
+	return GImGui.GetScrollMaxX()
+}
+
+func GetScrollMaxY() float {
+	return GImGui.GetScrollMaxY()
+}
+
+func SetScrollHereX(center_x_ratio float /*= 0.5*/) {
+	GImGui.SetScrollHereX(center_x_ratio)
+}
+
+func SetScrollHereY(center_y_ratio float /*= 0.5*/) {
+	GImGui.SetScrollHereY(center_y_ratio)
+}
+
+func SetScrollFromPosX(local_x, center_x_ratio float /*= 0.5*/) {
+	GImGui.SetScrollFromPosX(local_x, center_x_ratio)
+}
+
+func SetScrollFromPosY(local_y, center_y_ratio float /*= 0.5*/) {
+	GImGui.SetScrollFromPosY(local_y, center_y_ratio)
+}
+
+func SetNextWindowScroll(scroll *ImVec2) {
+	GImGui.SetNextWindowScroll(scroll)
+}
+
+func ScrollToBringRectIntoView(window *ImGuiWindow, item_rect *ImRect) ImVec2 {
+	return GImGui.ScrollToBringRectIntoView(window, item_rect)
+}
+
+func CalcNextScrollFromScrollTargetAndClamp(window *ImGuiWindow) ImVec2 {
+	return GImGui.CalcNextScrollFromScrollTargetAndClamp(window)
+}
@@ -1,6 +1,11 @@
 package imgui
 
-import "bytes"
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+)
 
 type ImGuiContext struct {
 	Initialized                        bool
@@ -35,6 +40,10 @@ type ImGuiContext struct {
 	WheelingWindowRefMousePos      ImVec2
 	WheelingWindowTimer            float
 
+	// Inputs
+	InputEventsTrail []ImGuiInputEvent                      // Past input events processed by the current NewFrame(), for applications wanting to access a precise trail.
+	KeysRoutingTable map[ImGuiKeyChord]*ImGuiKeyRoutingData // Routing of the key chords registered by Shortcut()/SetShortcutRouting()
+
 	// Item/widgets state and tracking information
 	HoveredId                                ImGuiID // Hovered widget, filled during the frame
 	HoveredIdPreviousFrame                   ImGuiID
@@ -53,11 +62,11 @@ type ImGuiContext struct {
 	ActiveIdHasBeenPressedBefore             bool // Track whether the active id led to a press (this is to allow changing between PressOnClick and PressOnRelease without pressing twice). Used by range_select branch.
 	ActiveIdHasBeenEditedBefore              bool // Was the value associated to the widget Edited over the course of the Active state.
 	ActiveIdHasBeenEditedThisFrame           bool
-	ActiveIdUsingMouseWheel                  bool   // Active widget will want to read mouse wheel. Blocks scrolling the underlying window.
-	ActiveIdUsingNavDirMask                  ImU32  // Active widget will want to read those nav move requests (e.g. can activate a button and move away from it)
-	ActiveIdUsingNavInputMask                ImU32  // Active widget will want to read those nav inputs.
-	ActiveIdUsingKeyInputMask                ImU64  // Active widget will want to read those key inputs. When we grow the ImGuiKey enum we'll need to either to order the enum to make useful keys come first, either redesign this into e.g. a small array.
-	ActiveIdClickOffset                      ImVec2 // Clicked offset from upper-left corner, if applicable (currently only set by ButtonBehavior)
+	ActiveIdUsingMouseWheel                  bool                   // Active widget will want to read mouse wheel. Blocks scrolling the underlying window.
+	ActiveIdUsingNavDirMask                  ImU32                  // Active widget will want to read those nav move requests (e.g. can activate a button and move away from it)
+	ActiveIdUsingNavInputMask                ImU32                  // Active widget will want to read those nav inputs.
+	ActiveIdUsingKeyInputMask                ImBitArrayForNamedKeys // Active widget will want to read those key inputs.
+	ActiveIdClickOffset                      ImVec2                 // Clicked offset from upper-left corner, if applicable (currently only set by ButtonBehavior)
 	ActiveIdWindow                           *ImGuiWindow
 	ActiveIdSource                           ImGuiInputSource // Activating with mouse or nav (gamepad/keyboard)
 	ActiveIdMouseButton                      ImGuiMouseButton
@@ -85,7 +94,13 @@ type ImGuiContext struct {
 	BeginPopupStack []ImGuiPopupData // Which level of BeginPopup() we are in (reset every frame)
 
 	// Viewports
-	Viewports []*ImGuiViewportP // Active viewports (Size==1 in 'master' branch). Each viewports hold their copy of ImDrawData.
+	Viewports                     []*ImGuiViewportP // Active viewports (always 1+, and generally 1 unless multi-viewports are enabled). Each viewports hold their copy of ImDrawData.
+	CurrentViewport               *ImGuiViewportP   // We track changes of viewport (happening in Begin) so we can call Platform_OnChangedViewport()
+	MouseViewport                 *ImGuiViewportP
+	PlatformIO                    ImGuiPlatformIO
+	PlatformLastFocusedViewportId ImGuiID
+	ViewportFrontMostStampCount   int // Every time the front-most window changes, we stamp its viewport with an incrementing counter
+	FrameCountPlatformEnded       int // Frame count of the last UpdatePlatformWindows() call
 
 	// Gamepad/keyboard Navigation
 	NavWindow                  *ImGuiWindow // Focused window for navigation. Could be called 'FocusWindow'
@@ -99,6 +114,7 @@ type ImGuiContext struct {
 	NavJustMovedToId           ImGuiID      // Just navigated to this id (result of a successfully MoveRequest).
 	NavJustMovedToFocusScopeId ImGuiID      // Just navigated to this focus scope id (result of a successfully MoveRequest).
 	NavJustMovedToKeyMods      ImGuiKeyModFlags
+	NavJustMovedToHasSelection bool             // Copy of move result's InFlags & ImGuiItemFlags_HasSelectionUserData. Maybe we should just store ImGuiNavItemData.
 	NavNextActivateId          ImGuiID          // Set by ActivateItem(), queued until next frame.
 	NavInputSource             ImGuiInputSource // Keyboard or Gamepad mode? THIS WILL ONLY BE None or NavGamepad or NavKeyboard.
 	NavLayer                   ImGuiNavLayer    // Layer we are navigating on. For now the system is hard-coded for 0=main contents and 1=menu/title bar, may expose layers later.
@@ -167,6 +183,7 @@ type ImGuiContext struct {
 	DragDropHoldJustPressedId       ImGuiID  // Set when holding a payload just made ButtonBehavior() return a press.
 	DragDropPayloadBufHeap          []byte   // We don't expose the ImVector<> directly, ImGuiPayload only holds pointer+size
 	DragDropPayloadBufLocal         [16]byte // Local buffer for small payloads
+	DragDropExternPaths             []string // Paths dropped by io.AddExternalDrop(), turned into an IMGUI_PAYLOAD_TYPE_FILES payload by NewFrame()
 
 	// Table
 	CurrentTable                *ImGuiTable
@@ -176,12 +193,22 @@ type ImGuiContext struct {
 	TablesLastTimeActive        map[int]float // Last used timestamp of each tables (SOA, for efficient GC)
 	DrawChannelsTempMergeBuffer []ImDrawChannel
 
+	// Multi-Select state
+	BoxSelectState             ImGuiBoxSelectState
+	CurrentMultiSelect         *ImGuiMultiSelectTempData
+	MultiSelectTempDataStacked int // Number of MultiSelectTempData in use (previous instances are kept allocated, so we don't use len(MultiSelectTempData))
+	MultiSelectTempData        []*ImGuiMultiSelectTempData
+	MultiSelectStorage         map[ImGuiID]*ImGuiMultiSelectState
+
 	// Tab bars
 	CurrentTabBar      *ImGuiTabBar
 	TabBars            map[ImGuiID]*ImGuiTabBar
 	CurrentTabBarStack []ImGuiPtrOrIndex
 	ShrinkWidthBuffer  []ImGuiShrinkWidthItem
 
+	// Docking
+	DockContext ImGuiDockContext
+
 	// Widget state
 	MouseLastValidPos               ImVec2
 	InputTextState                  ImGuiInputTextState
@@ -201,9 +228,11 @@ type ImGuiContext struct {
 	DisabledAlphaBackup             float // Backup for style.Alpha for BeginDisabled()
 	ScrollbarClickDeltaToGrabCenter float // Distance between mouse and center of grab box, normalized in parent space. Use storage?
 	TooltipOverrideCount            int
-	TooltipSlowDelay                float     // Time before slow tooltips appears (FIXME: This is temporary until we merge in tooltip timer+priority work)
-	ClipboardHandlerData            []char    // If no custom clipboard handler is defined
-	MenusIdSubmittedThisFrame       []ImGuiID // A list of menu IDs that were rendered at least once
+	TooltipSlowDelay                float                           // Time before slow tooltips appears (FIXME: This is temporary until we merge in tooltip timer+priority work)
+	ClipboardHandlerData            []char                          // If no custom clipboard handler is defined
+	MenusIdSubmittedThisFrame       []ImGuiID                       // A list of menu IDs that were rendered at least once
+	MenusShortcuts                  map[ImGuiID]*ImGuiMenuShortcuts // Shortcuts of the menus submitted outside of a menu, by menu ID
+	MenusShortcutsPass              ImGuiMenuShortcutsPass
 
 	// Platform support
 	PlatformImePos             ImVec2 // Cursor position request & last passed to the OS Input Method Editor
@@ -212,19 +241,25 @@ type ImGuiContext struct {
 
 	// Settings
 	SettingsLoaded     bool
-	SettingsDirtyTimer float                  // Save .ini Settings to memory when time reaches zero
-	SettingsIniData    ImGuiTextBuffer        // In memory .ini settings
-	SettingsHandlers   []ImGuiSettingsHandler // List of .ini settings handlers
-	SettingsWindows    []ImGuiWindowSettings  // ImGuiWindow .ini settings entries
-	SettingsTables     []ImGuiTableSettings   // ImGuiTable .ini settings entries
-	Hooks              []ImGuiContextHook     // Hooks for extensions (e.g. test engine)
-	HookIdNext         ImGuiID                // Next available HookId
+	SettingsDirtyTimer float                         // Save .ini Settings to memory when time reaches zero
+	SettingsIniData    ImGuiTextBuffer               // In memory .ini settings
+	SettingsHandlers   []ImGuiSettingsHandler        // List of .ini settings handlers
+	SettingsUnhandled  []ImGuiSettingsUnhandledEntry // Entries loaded without a handler, saved back as is or read by a handler added later
+	SettingsAliases    []ImGuiSettingsAlias          // Entries renamed when loading (AddWindowSettingsAlias/AddTableSettingsAlias)
+	SettingsVersion    int                           // Version of the last loaded settings (io.SettingsVersion when they were saved, 0 when unversioned)
+	SettingsWindows    []ImGuiWindowSettings         // ImGuiWindow .ini settings entries
+	SettingsTables     []ImGuiTableSettings          // ImGuiTable .ini settings entries
+	Hooks              []ImGuiContextHook            // Hooks for extensions (e.g. test engine)
+	HookIdNext         ImGuiID                       // Next available HookId
 
 	// Capture/Logging
-	LogEnabled              bool         // Currently capturing
-	LogType                 ImGuiLogType // Capture target
-	LogFile                 ImFileHandle // If != NULL log to stdout/ file
-	LogBuffer               bytes.Buffer // Accumulation buffer when log to clipboard. This is pointer so our GImGui static constructor doesn't call heap allocators.
+	LogEnabled              bool                    // Currently capturing
+	LogType                 ImGuiLogType            // Capture target
+	LogFile                 ImFileHandle            // If != NULL log to stdout/ file
+	LogWriter               io.Writer               // If != NULL log to this writer (LogToWriter)
+	LogCaptureStack         []ImGuiCaptureStackData // Open nodes when capturing to a node tree (LogToCapture), the first one is the root
+	LogCaptureLabelTarget   *ImGuiCaptureNode       // Value/checkbox/radio node waiting for the label rendered after it on the same line
+	LogBuffer               bytes.Buffer            // Accumulation buffer when log to clipboard. This is pointer so our GImGui static constructor doesn't call heap allocators.
 	LogNextPrefix           string
 	LogNextSuffix           string
 	LogLinePosY             float
@@ -233,6 +268,10 @@ type ImGuiContext struct {
 	LogDepthToExpand        int
 	LogDepthToExpandDefault int // Default/stored value for LogDepthMaxExpand if not specified in the LogXXX function call.
 
+	// Test engine
+	TestEngineHookItems bool                 // Will call test engine hooks: ItemAdd(), ItemInfo()
+	TestEngine          ImGuiTestEngineHooks // Test engine user data
+
 	// Debug Tools
 	DebugItemPickerActive  bool    // Item picker is active (started with DebugStartItemPicker())
 	DebugItemPickerBreakId ImGuiID // Will call IM_DEBUG_BREAK() when encountering this id
@@ -249,6 +288,9 @@ type ImGuiContext struct {
 	TempBuffer                   string // Temporary text buffer
 
 	FontAtlasOwnedByContext bool
+
+	uiMutex  sync.Mutex // Held while the handle returned by Lock() is in use
+	uiLocked *ImGuiUI   // Handle returned by Lock(), nil when unlocked
 }
 
 func NewImGuiContext(atlas *ImFontAtlas) ImGuiContext {
@@ -290,11 +332,15 @@ func NewImGuiContext(atlas *ImFontAtlas) ImGuiContext {
 		WantCaptureKeyboardNextFrame:      -1,
 		WantTextInputNextFrame:            -1,
 		TabBars:                           make(map[ImGuiID]*ImGuiTabBar),
+		MultiSelectStorage:                make(map[ImGuiID]*ImGuiMultiSelectState),
 	}
 }
 
 // Context creation and access
 //   - Each context create its own ImFontAtlas by default. You may instance one yourself and pass it to CreateContext() to share a font atlas between contexts.
+//     A shared font atlas must be built before the contexts sharing it are used from different goroutines.
+//   - The first context created becomes the current context used by the global functions. The methods of ImGuiContext work on
+//     their own context whether it is current or not, so that several contexts can be used from different goroutines.
 //   - DLL users: heaps and globals are not shared across DLL boundaries! You will need to call SetCurrentContext() + SetAllocatorFunctions()
 //     for each static/DLL boundary you are calling from. Read "Context and Memory Allocators" section of imgui.cpp for details.
 func CreateContext(shared_font_atlas *ImFontAtlas) *ImGuiContext {
@@ -351,6 +397,7 @@ func RemoveContextHook(context *ImGuiContext, hook_to_remove ImGuiID) {
 func Initialize(context *ImGuiContext) {
 	var g = context
 	IM_ASSERT(!g.Initialized && !g.SettingsLoaded)
+	g.IO.ClipboardUserData = g // Default clipboard implementation use the context as user data
 
 	// Add .ini handle for ImGuiWindow type
 	{
@@ -366,11 +413,19 @@ func Initialize(context *ImGuiContext) {
 	}
 
 	// Add .ini handle for ImGuiTable type
-	//TableSettingsInstallHandler(context)
+	TableSettingsInstallHandler(context)
+
+	// Add .ini handle for docking
+	DockContextInitialize(context)
 
 	// Create default viewport
 	var viewport = NewImGuiViewportP()
+	viewport.ID = IMGUI_VIEWPORT_DEFAULT_ID
+	viewport.Idx = 0
+	viewport.PlatformWindowCreated = true
+	viewport.Flags = ImGuiViewportFlags_OwnedByApp
 	g.Viewports = append(g.Viewports, &viewport)
+	g.PlatformIO.Viewports = append(g.PlatformIO.Viewports, g.Viewports[0])
 
 	g.Initialized = true
 }
@@ -392,14 +447,17 @@ func Shutdown(context *ImGuiContext) {
 
 	// Save settings (unless we haven't attempted to load them: CreateContext/DestroyContext without a call to NewFrame shouldn't save an empty file)
 	if g.SettingsLoaded && g.IO.IniFilename != "" {
-		var backup_context = GImGui
-		SetCurrentContext(g)
-		SaveIniSettingsToDisk(g.IO.IniFilename)
-		SetCurrentContext(backup_context)
+		g.SaveIniSettingsToDisk(g.IO.IniFilename)
 	}
 
 	CallContextHooks(g, ImGuiContextHookType_Shutdown)
 
+	// Shutdown extensions
+	DockContextShutdown(g)
+
+	// Destroy platform windows
+	g.DestroyPlatformWindows()
+
 	// Clear everything else
 	g.Windows = nil
 	g.WindowsFocusOrder = nil
@@ -420,8 +478,13 @@ func Shutdown(context *ImGuiContext) {
 	g.BeginPopupStack = nil
 
 	g.Viewports = nil
+	g.PlatformIO.Viewports = nil
+	g.CurrentViewport = nil
+	g.MouseViewport = nil
 
 	g.TabBars = nil
+	g.MultiSelectTempData = nil
+	g.MultiSelectStorage = nil
 	g.CurrentTabBarStack = nil
 	g.ShrinkWidthBuffer = nil
 
@@ -431,15 +494,25 @@ func Shutdown(context *ImGuiContext) {
 
 	g.ClipboardHandlerData = nil
 	g.MenusIdSubmittedThisFrame = nil
+	g.MenusShortcuts = nil
+	g.MenusShortcutsPass = ImGuiMenuShortcutsPass{}
+	g.KeysRoutingTable = nil
 	g.InputTextState = ImGuiInputTextState{}
 
 	g.SettingsWindows = nil
 	g.SettingsHandlers = nil
+	g.SettingsUnhandled = nil
+	g.SettingsAliases = nil
 
 	if g.LogFile != nil {
-		ImFileClose(g.LogFile)
+		if g.LogFile != ImFileHandle(os.Stdout) {
+			ImFileClose(g.LogFile)
+		}
 		g.LogFile = nil
 	}
+	g.LogWriter = nil
+	g.LogCaptureStack = nil
+	g.LogCaptureLabelTarget = nil
 	g.LogBuffer.Reset()
 
 	g.Initialized = false
@@ -0,0 +1,2082 @@
+package imgui
+
+import (
+	"io"
+)
+
+// The API below is also available as methods of ImGuiUI, a handle to a context obtained with ImGuiContext.Lock(),
+// so that a context can be shared by several goroutines without them stepping on each other's frame:
+//
+//	ui := ctx.Lock()
+//	ui.NewFrame()
+//	ui.Begin("Hello", nil, 0)
+//	ui.Text("world")
+//	ui.End()
+//	ui.Render()
+//	ui.Unlock()
+//
+// Each context has its own lock: goroutines driving different contexts run concurrently, only the goroutines sharing
+// a context take turns. The handle and the ImGuiContext methods never read the current context (GImGui), the global
+// functions are wrappers calling these methods on the current context, see context.current.go.
+// Like sync.Mutex, Lock() is not re-entrant: locking a context again from its own locked section deadlocks, locking
+// another context from it is fine. CreateContext() and DestroyContext() don't lock, a context must not be destroyed
+// while locked. Generic functions such as DragT() cannot be methods: call their DragTContext() variants instead.
+
+// ImGuiUI is a handle to a context locked by the calling goroutine, see ImGuiContext.Lock().
+type ImGuiUI struct {
+	ctx *ImGuiContext
+}
+
+// Lock waits until no other goroutine holds the lock of ctx and returns a handle to it.
+// The handle must be released with Unlock(), its methods must not be called after that.
+func (ctx *ImGuiContext) Lock() *ImGuiUI {
+	ctx.uiMutex.Lock()
+	var ui = &ImGuiUI{ctx: ctx}
+	ctx.uiLocked = ui
+	return ui
+}
+
+// Unlock releases the handle and lets other goroutines lock the context.
+func (ui *ImGuiUI) Unlock() {
+	ui.assertLocked()
+	var ctx = ui.ctx
+	ctx.uiLocked = nil
+	ui.ctx = nil
+	ctx.uiMutex.Unlock()
+}
+
+// Frame locks ctx for a whole frame: gui is called between NewFrame() and Render().
+// The returned draw data belongs to ctx, it stays valid until the next frame of ctx.
+func (ctx *ImGuiContext) Frame(gui func(ui *ImGuiUI)) *ImDrawData {
+	var ui = ctx.Lock()
+	defer ui.Unlock()
+	ctx.NewFrame()
+	gui(ui)
+	ctx.Render()
+	return ctx.GetDrawData()
+}
+
+// Context returns the context locked by the handle.
+func (ui *ImGuiUI) Context() *ImGuiContext { return ui.ctx }
+
+// assertLocked checks that the handle was not released with Unlock() and still holds the lock of its context.
+func (ui *ImGuiUI) assertLocked() {
+	IM_ASSERT_USER_ERROR(ui.ctx != nil && ui.ctx.uiLocked == ui, "ImGuiUI used after Unlock()!")
+}
+
+// Main
+
+func (ui *ImGuiUI) GetIO() *ImGuiIO {
+	ui.assertLocked()
+	return ui.ctx.GetIO()
+}
+
+func (ui *ImGuiUI) GetStyle() *ImGuiStyle {
+	ui.assertLocked()
+	return ui.ctx.GetStyle()
+}
+
+func (ui *ImGuiUI) NewFrame() {
+	ui.assertLocked()
+	ui.ctx.NewFrame()
+}
+
+func (ui *ImGuiUI) EndFrame() {
+	ui.assertLocked()
+	ui.ctx.EndFrame()
+}
+
+func (ui *ImGuiUI) Render() {
+	ui.assertLocked()
+	ui.ctx.Render()
+}
+
+func (ui *ImGuiUI) GetDrawData() *ImDrawData {
+	ui.assertLocked()
+	return ui.ctx.GetDrawData()
+}
+
+// Demo, Debug, Information
+
+func (ui *ImGuiUI) ShowDemoWindow(p_open *bool) {
+	ui.assertLocked()
+	ui.ctx.ShowDemoWindow(p_open)
+}
+
+func (ui *ImGuiUI) ShowMetricsWindow(p_open *bool) {
+	ui.assertLocked()
+	ui.ctx.ShowMetricsWindow(p_open)
+}
+
+func (ui *ImGuiUI) ShowAboutWindow(p_open *bool) {
+	ui.assertLocked()
+	ui.ctx.ShowAboutWindow(p_open)
+}
+
+func (ui *ImGuiUI) ShowStyleEditor(ref *ImGuiStyle) {
+	ui.assertLocked()
+	ui.ctx.ShowStyleEditor(ref)
+}
+
+func (ui *ImGuiUI) ShowStyleSelector(label string) bool {
+	ui.assertLocked()
+	return ui.ctx.ShowStyleSelector(label)
+}
+
+func (ui *ImGuiUI) ShowFontSelector(label string) {
+	ui.assertLocked()
+	ui.ctx.ShowFontSelector(label)
+}
+
+func (ui *ImGuiUI) ShowUserGuide() {
+	ui.assertLocked()
+	ui.ctx.ShowUserGuide()
+}
+
+func (ui *ImGuiUI) GetVersion() string {
+	ui.assertLocked()
+	return GetVersion()
+}
+
+// Styles
+
+func (ui *ImGuiUI) StyleColorsDark(style *ImGuiStyle) {
+	ui.assertLocked()
+	ui.ctx.StyleColorsDark(style)
+}
+
+func (ui *ImGuiUI) StyleColorsLight(style *ImGuiStyle) {
+	ui.assertLocked()
+	ui.ctx.StyleColorsLight(style)
+}
+
+func (ui *ImGuiUI) StyleColorsClassic(style *ImGuiStyle) {
+	ui.assertLocked()
+	ui.ctx.StyleColorsClassic(style)
+}
+
+// Windows
+
+func (ui *ImGuiUI) Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.Begin(name, p_open, flags)
+}
+
+func (ui *ImGuiUI) End() {
+	ui.assertLocked()
+	ui.ctx.End()
+}
+
+// Child Windows
+
+func (ui *ImGuiUI) BeginChild(str_id string, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginChild(str_id, size, border, flags)
+}
+
+func (ui *ImGuiUI) BeginChildID(id ImGuiID, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginChildID(id, size, border, flags)
+}
+
+func (ui *ImGuiUI) EndChild() {
+	ui.assertLocked()
+	ui.ctx.EndChild()
+}
+
+// Windows Utilities
+
+func (ui *ImGuiUI) IsWindowAppearing() bool {
+	ui.assertLocked()
+	return ui.ctx.IsWindowAppearing()
+}
+
+func (ui *ImGuiUI) IsWindowCollapsed() bool {
+	ui.assertLocked()
+	return ui.ctx.IsWindowCollapsed()
+}
+
+func (ui *ImGuiUI) IsWindowFocused(flags ImGuiFocusedFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.IsWindowFocused(flags)
+}
+
+func (ui *ImGuiUI) IsWindowHovered(flags ImGuiHoveredFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.IsWindowHovered(flags)
+}
+
+func (ui *ImGuiUI) GetWindowDrawList() *ImDrawList {
+	ui.assertLocked()
+	return ui.ctx.GetWindowDrawList()
+}
+
+func (ui *ImGuiUI) GetWindowPos() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetWindowPos()
+}
+
+func (ui *ImGuiUI) GetWindowSize() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetWindowSize()
+}
+
+func (ui *ImGuiUI) GetWindowWidth() float {
+	ui.assertLocked()
+	return ui.ctx.GetWindowWidth()
+}
+
+func (ui *ImGuiUI) GetWindowHeight() float {
+	ui.assertLocked()
+	return ui.ctx.GetWindowHeight()
+}
+
+// Window manipulation
+
+func (ui *ImGuiUI) SetNextWindowPos(pos *ImVec2, cond ImGuiCond, pivot ImVec2) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowPos(pos, cond, pivot)
+}
+
+func (ui *ImGuiUI) SetNextWindowSize(size *ImVec2, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowSize(size, cond)
+}
+
+func (ui *ImGuiUI) SetNextWindowSizeConstraints(size_min ImVec2, size_max ImVec2, custom_callback ImGuiSizeCallback, custom_callback_data any) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowSizeConstraints(size_min, size_max, custom_callback, custom_callback_data)
+}
+
+func (ui *ImGuiUI) SetNextWindowContentSize(size ImVec2) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowContentSize(size)
+}
+
+func (ui *ImGuiUI) SetNextWindowCollapsed(collapsed bool, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowCollapsed(collapsed, cond)
+}
+
+func (ui *ImGuiUI) SetNextWindowFocus() {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowFocus()
+}
+
+func (ui *ImGuiUI) SetNextWindowBgAlpha(alpha float) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowBgAlpha(alpha)
+}
+
+func (ui *ImGuiUI) SetWindowPos(pos ImVec2, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetWindowPos(pos, cond)
+}
+
+func (ui *ImGuiUI) SetWindowSize(size ImVec2, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetWindowSize(size, cond)
+}
+
+func (ui *ImGuiUI) SetWindowCollapsed(collapsed bool, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetWindowCollapsed(collapsed, cond)
+}
+
+func (ui *ImGuiUI) SetWindowFocus() {
+	ui.assertLocked()
+	ui.ctx.SetWindowFocus()
+}
+
+func (ui *ImGuiUI) SetWindowFontScale(scale float) {
+	ui.assertLocked()
+	ui.ctx.SetWindowFontScale(scale)
+}
+
+func (ui *ImGuiUI) SetNamedWindowPos(name string, pos ImVec2, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetNamedWindowPos(name, pos, cond)
+}
+
+func (ui *ImGuiUI) SetNamedWindowSize(name string, size ImVec2, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetNamedWindowSize(name, size, cond)
+}
+
+func (ui *ImGuiUI) SetNamedWindowCollapsed(name string, collapsed bool, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetNamedWindowCollapsed(name, collapsed, cond)
+}
+
+func (ui *ImGuiUI) SetNamedWindowFocus(name string) {
+	ui.assertLocked()
+	ui.ctx.SetNamedWindowFocus(name)
+}
+
+// Content region
+
+func (ui *ImGuiUI) GetContentRegionAvail() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetContentRegionAvail()
+}
+
+func (ui *ImGuiUI) GetContentRegionMax() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetContentRegionMax()
+}
+
+func (ui *ImGuiUI) GetWindowContentRegionMin() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetWindowContentRegionMin()
+}
+
+func (ui *ImGuiUI) GetWindowContentRegionMax() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetWindowContentRegionMax()
+}
+
+// Windows Scrolling
+
+func (ui *ImGuiUI) GetScrollX() float {
+	ui.assertLocked()
+	return ui.ctx.GetScrollX()
+}
+
+func (ui *ImGuiUI) GetScrollY() float {
+	ui.assertLocked()
+	return ui.ctx.GetScrollY()
+}
+
+func (ui *ImGuiUI) SetScrollX(scroll_x float) {
+	ui.assertLocked()
+	ui.ctx.SetScrollX(scroll_x)
+}
+
+func (ui *ImGuiUI) SetScrollY(scroll_y float) {
+	ui.assertLocked()
+	ui.ctx.SetScrollY(scroll_y)
+}
+
+func (ui *ImGuiUI) GetScrollMaxX() float {
+	ui.assertLocked()
+	return ui.ctx.GetScrollMaxX()
+}
+
+func (ui *ImGuiUI) GetScrollMaxY() float {
+	ui.assertLocked()
+	return ui.ctx.GetScrollMaxY()
+}
+
+func (ui *ImGuiUI) SetScrollHereX(center_x_ratio float) {
+	ui.assertLocked()
+	ui.ctx.SetScrollHereX(center_x_ratio)
+}
+
+func (ui *ImGuiUI) SetScrollHereY(center_y_ratio float) {
+	ui.assertLocked()
+	ui.ctx.SetScrollHereY(center_y_ratio)
+}
+
+func (ui *ImGuiUI) SetScrollFromPosX(local_x, center_x_ratio float) {
+	ui.assertLocked()
+	ui.ctx.SetScrollFromPosX(local_x, center_x_ratio)
+}
+
+func (ui *ImGuiUI) SetScrollFromPosY(local_y, center_y_ratio float) {
+	ui.assertLocked()
+	ui.ctx.SetScrollFromPosY(local_y, center_y_ratio)
+}
+
+// Parameters stacks (shared)
+
+func (ui *ImGuiUI) PushFont(font *ImFont) {
+	ui.assertLocked()
+	ui.ctx.PushFont(font)
+}
+
+func (ui *ImGuiUI) PopFont() {
+	ui.assertLocked()
+	ui.ctx.PopFont()
+}
+
+func (ui *ImGuiUI) PushStyleColorInt(idx ImGuiCol, col ImU32) {
+	ui.assertLocked()
+	ui.ctx.PushStyleColorInt(idx, col)
+}
+
+func (ui *ImGuiUI) PushStyleColorVec(idx ImGuiCol, col *ImVec4) {
+	ui.assertLocked()
+	ui.ctx.PushStyleColorVec(idx, col)
+}
+
+func (ui *ImGuiUI) PopStyleColor(count int) {
+	ui.assertLocked()
+	ui.ctx.PopStyleColor(count)
+}
+
+func (ui *ImGuiUI) PushStyleFloat(idx ImGuiStyleVar, val float) {
+	ui.assertLocked()
+	ui.ctx.PushStyleFloat(idx, val)
+}
+
+func (ui *ImGuiUI) PushStyleVec(idx ImGuiStyleVar, val ImVec2) {
+	ui.assertLocked()
+	ui.ctx.PushStyleVec(idx, val)
+}
+
+func (ui *ImGuiUI) PopStyleVar(count int) {
+	ui.assertLocked()
+	ui.ctx.PopStyleVar(count)
+}
+
+func (ui *ImGuiUI) PushAllowKeyboardFocus(allow_keyboard_focus bool) {
+	ui.assertLocked()
+	ui.ctx.PushAllowKeyboardFocus(allow_keyboard_focus)
+}
+
+func (ui *ImGuiUI) PopAllowKeyboardFocus() {
+	ui.assertLocked()
+	ui.ctx.PopAllowKeyboardFocus()
+}
+
+func (ui *ImGuiUI) PushButtonRepeat(repeat bool) {
+	ui.assertLocked()
+	ui.ctx.PushButtonRepeat(repeat)
+}
+
+func (ui *ImGuiUI) PopButtonRepeat() {
+	ui.assertLocked()
+	ui.ctx.PopButtonRepeat()
+}
+
+// Parameters stacks (current window)
+
+func (ui *ImGuiUI) PushItemWidth(item_width float) {
+	ui.assertLocked()
+	ui.ctx.PushItemWidth(item_width)
+}
+
+func (ui *ImGuiUI) PopItemWidth() {
+	ui.assertLocked()
+	ui.ctx.PopItemWidth()
+}
+
+func (ui *ImGuiUI) SetNextItemWidth(item_width float) {
+	ui.assertLocked()
+	ui.ctx.SetNextItemWidth(item_width)
+}
+
+func (ui *ImGuiUI) CalcItemWidth() float {
+	ui.assertLocked()
+	return ui.ctx.CalcItemWidth()
+}
+
+func (ui *ImGuiUI) PushTextWrapPos(wrap_local_pos_x float) {
+	ui.assertLocked()
+	ui.ctx.PushTextWrapPos(wrap_local_pos_x)
+}
+
+func (ui *ImGuiUI) PopTextWrapPos() {
+	ui.assertLocked()
+	ui.ctx.PopTextWrapPos()
+}
+
+// Style read access
+
+func (ui *ImGuiUI) GetFont() *ImFont {
+	ui.assertLocked()
+	return ui.ctx.GetFont()
+}
+
+func (ui *ImGuiUI) GetFontSize() float {
+	ui.assertLocked()
+	return ui.ctx.GetFontSize()
+}
+
+func (ui *ImGuiUI) GetFontTexUvWhitePixel() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetFontTexUvWhitePixel()
+}
+
+func (ui *ImGuiUI) GetColorU32FromID(idx ImGuiCol, alpha_mul float) ImU32 {
+	ui.assertLocked()
+	return ui.ctx.GetColorU32FromID(idx, alpha_mul)
+}
+
+func (ui *ImGuiUI) GetColorU32FromVec(col ImVec4) ImU32 {
+	ui.assertLocked()
+	return ui.ctx.GetColorU32FromVec(col)
+}
+
+func (ui *ImGuiUI) GetColorU32FromInt(col ImU32) ImU32 {
+	ui.assertLocked()
+	return ui.ctx.GetColorU32FromInt(col)
+}
+
+func (ui *ImGuiUI) GetStyleColorVec4(idx ImGuiCol) *ImVec4 {
+	ui.assertLocked()
+	return ui.ctx.GetStyleColorVec4(idx)
+}
+
+// Cursor / Layout
+
+func (ui *ImGuiUI) Separator() {
+	ui.assertLocked()
+	ui.ctx.Separator()
+}
+
+func (ui *ImGuiUI) SameLine(offset_from_start_x, spacing_w float) {
+	ui.assertLocked()
+	ui.ctx.SameLine(offset_from_start_x, spacing_w)
+}
+
+func (ui *ImGuiUI) NewLine() {
+	ui.assertLocked()
+	ui.ctx.NewLine()
+}
+
+func (ui *ImGuiUI) Spacing() {
+	ui.assertLocked()
+	ui.ctx.Spacing()
+}
+
+func (ui *ImGuiUI) Dummy(size ImVec2) {
+	ui.assertLocked()
+	ui.ctx.Dummy(size)
+}
+
+func (ui *ImGuiUI) Indent(indent_w float) {
+	ui.assertLocked()
+	ui.ctx.Indent(indent_w)
+}
+
+func (ui *ImGuiUI) Unindent(indent_w float) {
+	ui.assertLocked()
+	ui.ctx.Unindent(indent_w)
+}
+
+func (ui *ImGuiUI) BeginGroup() {
+	ui.assertLocked()
+	ui.ctx.BeginGroup()
+}
+
+func (ui *ImGuiUI) EndGroup() {
+	ui.assertLocked()
+	ui.ctx.EndGroup()
+}
+
+func (ui *ImGuiUI) GetCursorPos() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetCursorPos()
+}
+
+func (ui *ImGuiUI) GetCursorPosX() float {
+	ui.assertLocked()
+	return ui.ctx.GetCursorPosX()
+}
+
+func (ui *ImGuiUI) GetCursorPosY() float {
+	ui.assertLocked()
+	return ui.ctx.GetCursorPosY()
+}
+
+func (ui *ImGuiUI) SetCursorPos(local_pos *ImVec2) {
+	ui.assertLocked()
+	ui.ctx.SetCursorPos(local_pos)
+}
+
+func (ui *ImGuiUI) SetCursorPosX(local_x float) {
+	ui.assertLocked()
+	ui.ctx.SetCursorPosX(local_x)
+}
+
+func (ui *ImGuiUI) SetCursorPosY(local_y float) {
+	ui.assertLocked()
+	ui.ctx.SetCursorPosY(local_y)
+}
+
+func (ui *ImGuiUI) GetCursorStartPos() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetCursorStartPos()
+}
+
+func (ui *ImGuiUI) GetCursorScreenPos() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetCursorScreenPos()
+}
+
+func (ui *ImGuiUI) SetCursorScreenPos(pos ImVec2) {
+	ui.assertLocked()
+	ui.ctx.SetCursorScreenPos(pos)
+}
+
+func (ui *ImGuiUI) AlignTextToFramePadding() {
+	ui.assertLocked()
+	ui.ctx.AlignTextToFramePadding()
+}
+
+func (ui *ImGuiUI) GetTextLineHeight() float {
+	ui.assertLocked()
+	return ui.ctx.GetTextLineHeight()
+}
+
+func (ui *ImGuiUI) GetTextLineHeightWithSpacing() float {
+	ui.assertLocked()
+	return ui.ctx.GetTextLineHeightWithSpacing()
+}
+
+func (ui *ImGuiUI) GetFrameHeight() float {
+	ui.assertLocked()
+	return ui.ctx.GetFrameHeight()
+}
+
+func (ui *ImGuiUI) GetFrameHeightWithSpacing() float {
+	ui.assertLocked()
+	return ui.ctx.GetFrameHeightWithSpacing()
+}
+
+// ID stack/scopes
+
+func (ui *ImGuiUI) PushString(str_id string) {
+	ui.assertLocked()
+	ui.ctx.PushString(str_id)
+}
+
+func (ui *ImGuiUI) PushInterface(ptr_id any) {
+	ui.assertLocked()
+	ui.ctx.PushInterface(ptr_id)
+}
+
+func (ui *ImGuiUI) PushID(int_id int) {
+	ui.assertLocked()
+	ui.ctx.PushID(int_id)
+}
+
+func (ui *ImGuiUI) PopID() {
+	ui.assertLocked()
+	ui.ctx.PopID()
+}
+
+func (ui *ImGuiUI) GetIDFromString(str_id string) ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.GetIDFromString(str_id)
+}
+
+func (ui *ImGuiUI) GetIDs(str_id_begin string) ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.GetIDs(str_id_begin)
+}
+
+func (ui *ImGuiUI) GetIDFromInterface(ptr_id any) ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.GetIDFromInterface(ptr_id)
+}
+
+// Widgets: Text
+
+func (ui *ImGuiUI) TextUnformatted(text string) {
+	ui.assertLocked()
+	ui.ctx.TextUnformatted(text)
+}
+
+func (ui *ImGuiUI) Text(format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.Text(format, args...)
+}
+
+func (ui *ImGuiUI) TextColored(col *ImVec4, format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.TextColored(col, format, args...)
+}
+
+func (ui *ImGuiUI) TextDisabled(format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.TextDisabled(format, args...)
+}
+
+func (ui *ImGuiUI) TextWrapped(format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.TextWrapped(format, args...)
+}
+
+func (ui *ImGuiUI) LabelText(label string, format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.LabelText(label, format, args...)
+}
+
+func (ui *ImGuiUI) BulletText(format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.BulletText(format, args...)
+}
+
+// Widgets: Main
+
+func (ui *ImGuiUI) Button(label string) bool {
+	ui.assertLocked()
+	return ui.ctx.Button(label)
+}
+
+func (ui *ImGuiUI) SmallButton(label string) bool {
+	ui.assertLocked()
+	return ui.ctx.SmallButton(label)
+}
+
+func (ui *ImGuiUI) InvisibleButton(str_id string, size_arg ImVec2, flags ImGuiButtonFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InvisibleButton(str_id, size_arg, flags)
+}
+
+func (ui *ImGuiUI) ArrowButton(str_id string, dir ImGuiDir) bool {
+	ui.assertLocked()
+	return ui.ctx.ArrowButton(str_id, dir)
+}
+
+func (ui *ImGuiUI) Image(user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, tint_col ImVec4, border_col ImVec4) {
+	ui.assertLocked()
+	ui.ctx.Image(user_texture_id, size, uv0, uv1, tint_col, border_col)
+}
+
+func (ui *ImGuiUI) ImageButton(user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, frame_padding int, bg_col ImVec4, tint_col ImVec4) bool {
+	ui.assertLocked()
+	return ui.ctx.ImageButton(user_texture_id, size, uv0, uv1, frame_padding, bg_col, tint_col)
+}
+
+func (ui *ImGuiUI) Checkbox(label string, v *bool) bool {
+	ui.assertLocked()
+	return ui.ctx.Checkbox(label, v)
+}
+
+func (ui *ImGuiUI) CheckboxFlagsInt(label string, flags *int, flags_value int) bool {
+	ui.assertLocked()
+	return ui.ctx.CheckboxFlagsInt(label, flags, flags_value)
+}
+
+func (ui *ImGuiUI) CheckboxFlagsUint(label string, flags *uint, flags_value uint) bool {
+	ui.assertLocked()
+	return ui.ctx.CheckboxFlagsUint(label, flags, flags_value)
+}
+
+func (ui *ImGuiUI) RadioButtonBool(label string, active bool) bool {
+	ui.assertLocked()
+	return ui.ctx.RadioButtonBool(label, active)
+}
+
+func (ui *ImGuiUI) RadioButtonInt(label string, v *int, v_button int) bool {
+	ui.assertLocked()
+	return ui.ctx.RadioButtonInt(label, v, v_button)
+}
+
+func (ui *ImGuiUI) ProgressBar(fraction float, size_arg ImVec2, overlay string) {
+	ui.assertLocked()
+	ui.ctx.ProgressBar(fraction, size_arg, overlay)
+}
+
+func (ui *ImGuiUI) Bullet() {
+	ui.assertLocked()
+	ui.ctx.Bullet()
+}
+
+// Widgets: Combo Box
+
+func (ui *ImGuiUI) BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginCombo(label, preview_value, flags)
+}
+
+func (ui *ImGuiUI) EndCombo() {
+	ui.assertLocked()
+	ui.ctx.EndCombo()
+}
+
+func (ui *ImGuiUI) Combo(label string, current_item *int, items []string, items_count int, popup_max_height_in_items int) bool {
+	ui.assertLocked()
+	return ui.ctx.Combo(label, current_item, items, items_count, popup_max_height_in_items)
+}
+
+func (ui *ImGuiUI) ComboFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count, popup_max_height_in_items int) bool {
+	ui.assertLocked()
+	return ui.ctx.ComboFunc(label, current_item, items_getter, data, items_count, popup_max_height_in_items)
+}
+
+// Widgets: Drag Sliders
+
+func (ui *ImGuiUI) DragFloat(label string, v *float, v_speed float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragFloat(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragFloat2(label string, v *[2]float, v_speed float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragFloat2(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragFloat3(label string, v *[3]float, v_speed float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragFloat3(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragFloat4(label string, v *[4]float, v_speed float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragFloat4(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragFloatRange2(label string, v_current_min *float, v_current_max *float, v_speed float, v_min float, v_max float, format string, format_max string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragFloatRange2(label, v_current_min, v_current_max, v_speed, v_min, v_max, format, format_max, flags)
+}
+
+func (ui *ImGuiUI) DragInt(label string, v *int, v_speed float, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragInt(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragInt2(label string, v [2]int, v_speed float, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragInt2(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragInt3(label string, v [3]int, v_speed float, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragInt3(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragInt4(label string, v [4]int, v_speed float, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragInt4(label, v, v_speed, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) DragIntRange2(label string, v_current_min *int, v_current_max *int, v_speed float, v_min int, v_max int, format string, format_max string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragIntRange2(label, v_current_min, v_current_max, v_speed, v_min, v_max, format, format_max, flags)
+}
+
+func (ui *ImGuiUI) DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float, p_min any, p_max any, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.DragScalar(label, data_type, p_data, v_speed, p_min, p_max, format, flags)
+}
+
+// Widgets: Regular Sliders
+
+func (ui *ImGuiUI) SliderFloat(label string, v *float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderFloat(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderFloat2(label string, v *[2]float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderFloat2(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderFloat3(label string, v *[3]float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderFloat3(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderFloat4(label string, v *[4]float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderFloat4(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderAngle(label string, v_rad *float, v_degrees_min float, v_degrees_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderAngle(label, v_rad, v_degrees_min, v_degrees_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderInt(label string, v *int, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderInt(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderInt2(label string, v [2]int, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderInt2(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderInt3(label string, v [3]int, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderInt3(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderInt4(label string, v [4]int, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderInt4(label, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderScalar(label string, data_type ImGuiDataType, p_data any, p_min any, p_max any, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderScalar(label, data_type, p_data, p_min, p_max, format, flags)
+}
+
+func (ui *ImGuiUI) SliderScalarN(label string, data_type ImGuiDataType, p_data []float, p_min float, p_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SliderScalarN(label, data_type, p_data, p_min, p_max, format, flags)
+}
+
+func (ui *ImGuiUI) VSliderFloat(label string, size ImVec2, v *float, v_min float, v_max float, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.VSliderFloat(label, size, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) VSliderInt(label string, size ImVec2, v *int, v_min int, v_max int, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.VSliderInt(label, size, v, v_min, v_max, format, flags)
+}
+
+func (ui *ImGuiUI) VSliderScalar(label string, size ImVec2, data_type ImGuiDataType, p_data any, p_min any, p_max any, format string, flags ImGuiSliderFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.VSliderScalar(label, size, data_type, p_data, p_min, p_max, format, flags)
+}
+
+// Widgets: Input with Keyboard
+
+func (ui *ImGuiUI) InputText(label string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any) bool {
+	ui.assertLocked()
+	return ui.ctx.InputText(label, char, flags, callback, user_data)
+}
+
+func (ui *ImGuiUI) InputTextMultiline(label string, buf *[]byte, size ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any) bool {
+	ui.assertLocked()
+	return ui.ctx.InputTextMultiline(label, buf, size, flags, callback, user_data)
+}
+
+func (ui *ImGuiUI) InputTextWithHint(label string, hint string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any) bool {
+	ui.assertLocked()
+	return ui.ctx.InputTextWithHint(label, hint, char, flags, callback, user_data)
+}
+
+func (ui *ImGuiUI) InputTextString(label string, s *string, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any) bool {
+	ui.assertLocked()
+	return ui.ctx.InputTextString(label, s, flags, callback, user_data)
+}
+
+func (ui *ImGuiUI) InputTextMultilineString(label string, s *string, size ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any) bool {
+	ui.assertLocked()
+	return ui.ctx.InputTextMultilineString(label, s, size, flags, callback, user_data)
+}
+
+func (ui *ImGuiUI) InputTextWithHintString(label string, hint string, s *string, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any) bool {
+	ui.assertLocked()
+	return ui.ctx.InputTextWithHintString(label, hint, s, flags, callback, user_data)
+}
+
+func (ui *ImGuiUI) InputFloat(label string, v *float, step, step_fast float, format string, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputFloat(label, v, step, step_fast, format, flags)
+}
+
+func (ui *ImGuiUI) InputFloat2(label string, v *[2]float, format string, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputFloat2(label, v, format, flags)
+}
+
+func (ui *ImGuiUI) InputFloat3(label string, v *[3]float, format string, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputFloat3(label, v, format, flags)
+}
+
+func (ui *ImGuiUI) InputFloat4(label string, v *[4]float, format string, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputFloat4(label, v, format, flags)
+}
+
+func (ui *ImGuiUI) InputInt(label string, v *int, step int, step_fast int, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputInt(label, v, step, step_fast, flags)
+}
+
+func (ui *ImGuiUI) InputInt2(label string, v *[2]int, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputInt2(label, v, flags)
+}
+
+func (ui *ImGuiUI) InputInt3(label string, v *[3]int, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputInt3(label, v, flags)
+}
+
+func (ui *ImGuiUI) InputInt4(label string, v *[4]int, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputInt4(label, v, flags)
+}
+
+func (ui *ImGuiUI) InputDouble(label string, v *double, step double, step_fast double, format string, flags ImGuiInputTextFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.InputDouble(label, v, step, step_fast, format, flags)
+}
+
+// Widgets: Color Editor/Picker
+
+func (ui *ImGuiUI) ColorEdit3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.ColorEdit3(label, col, flags)
+}
+
+func (ui *ImGuiUI) ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.ColorEdit4(label, col, flags)
+}
+
+func (ui *ImGuiUI) ColorPicker3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.ColorPicker3(label, col, flags)
+}
+
+func (ui *ImGuiUI) ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_col []float) bool {
+	ui.assertLocked()
+	return ui.ctx.ColorPicker4(label, col, flags, ref_col)
+}
+
+func (ui *ImGuiUI) ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImVec2) bool {
+	ui.assertLocked()
+	return ui.ctx.ColorButton(desc_id, col, flags, size)
+}
+
+func (ui *ImGuiUI) SetColorEditOptions(flags ImGuiColorEditFlags) {
+	ui.assertLocked()
+	ui.ctx.SetColorEditOptions(flags)
+}
+
+// Widgets: Trees
+
+func (ui *ImGuiUI) TreeNode(label string) bool {
+	ui.assertLocked()
+	return ui.ctx.TreeNode(label)
+}
+
+func (ui *ImGuiUI) TreeNodeEx(str_id string, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
+	ui.assertLocked()
+	return ui.ctx.TreeNodeEx(str_id, flags, format, args...)
+}
+
+func (ui *ImGuiUI) TreeNodeF(str_id string, format string, args ...any) bool {
+	ui.assertLocked()
+	return ui.ctx.TreeNodeF(str_id, format, args...)
+}
+
+func (ui *ImGuiUI) TreeNodeInterface(ptr_id any, format string, args ...any) bool {
+	ui.assertLocked()
+	return ui.ctx.TreeNodeInterface(ptr_id, format, args...)
+}
+
+func (ui *ImGuiUI) TreeNodeInterfaceEx(ptr_id any, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
+	ui.assertLocked()
+	return ui.ctx.TreeNodeInterfaceEx(ptr_id, flags, format, args...)
+}
+
+func (ui *ImGuiUI) TreePush(str_id string) {
+	ui.assertLocked()
+	ui.ctx.TreePush(str_id)
+}
+
+func (ui *ImGuiUI) TreePushInterface(ptr_id any) {
+	ui.assertLocked()
+	ui.ctx.TreePushInterface(ptr_id)
+}
+
+func (ui *ImGuiUI) TreePop() {
+	ui.assertLocked()
+	ui.ctx.TreePop()
+}
+
+func (ui *ImGuiUI) GetTreeNodeToLabelSpacing() float {
+	ui.assertLocked()
+	return ui.ctx.GetTreeNodeToLabelSpacing()
+}
+
+func (ui *ImGuiUI) CollapsingHeader(label string, flags ImGuiTreeNodeFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.CollapsingHeader(label, flags)
+}
+
+func (ui *ImGuiUI) CollapsingHeaderVisible(label string, p_visible *bool, flags ImGuiTreeNodeFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.CollapsingHeaderVisible(label, p_visible, flags)
+}
+
+func (ui *ImGuiUI) SetNextItemOpen(is_open bool, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetNextItemOpen(is_open, cond)
+}
+
+// Widgets: Selectables
+
+func (ui *ImGuiUI) Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
+	ui.assertLocked()
+	return ui.ctx.Selectable(label, selected, flags, size_arg)
+}
+
+func (ui *ImGuiUI) SelectablePointer(label string, p_selected *bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
+	ui.assertLocked()
+	return ui.ctx.SelectablePointer(label, p_selected, flags, size_arg)
+}
+
+// Multi-selection system
+
+func (ui *ImGuiUI) BeginMultiSelect(flags ImGuiMultiSelectFlags, selection_size int, items_count int) *ImGuiMultiSelectIO {
+	ui.assertLocked()
+	return ui.ctx.BeginMultiSelect(flags, selection_size, items_count)
+}
+
+func (ui *ImGuiUI) EndMultiSelect() *ImGuiMultiSelectIO {
+	ui.assertLocked()
+	return ui.ctx.EndMultiSelect()
+}
+
+func (ui *ImGuiUI) SetNextItemSelectionUserData(selection_user_data ImGuiSelectionUserData) {
+	ui.assertLocked()
+	ui.ctx.SetNextItemSelectionUserData(selection_user_data)
+}
+
+// Widgets: List Boxes
+
+func (ui *ImGuiUI) BeginListBox(label string, size_arg ImVec2) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginListBox(label, size_arg)
+}
+
+func (ui *ImGuiUI) EndListBox() {
+	ui.assertLocked()
+	ui.ctx.EndListBox()
+}
+
+func (ui *ImGuiUI) ListBox(label string, current_item *int, items []string, items_count int, height_in_items int) bool {
+	ui.assertLocked()
+	return ui.ctx.ListBox(label, current_item, items, items_count, height_in_items)
+}
+
+func (ui *ImGuiUI) ListBoxFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count int, height_in_items int) bool {
+	ui.assertLocked()
+	return ui.ctx.ListBoxFunc(label, current_item, items_getter, data, items_count, height_in_items)
+}
+
+// Widgets: Data Plotting
+
+func (ui *ImGuiUI) PlotLines(label string, values []float, values_count int, values_offset int, overlay_text string, scale_min float, scale_max float, graph_size ImVec2, stride int) {
+	ui.assertLocked()
+	ui.ctx.PlotLines(label, values, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size, stride)
+}
+
+func (ui *ImGuiUI) PlotLinesFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int, overlay_text string, scale_min float, scale_max float, graph_size ImVec2) {
+	ui.assertLocked()
+	ui.ctx.PlotLinesFunc(label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+}
+
+func (ui *ImGuiUI) PlotHistogram(label string, values []float, values_count int, values_offset int, overlay_text string, scale_min float, scale_max float, graph_size ImVec2, stride int) {
+	ui.assertLocked()
+	ui.ctx.PlotHistogram(label, values, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size, stride)
+}
+
+func (ui *ImGuiUI) PlotHistogramFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int, overlay_text string, scale_min float, scale_max float, graph_size ImVec2) {
+	ui.assertLocked()
+	ui.ctx.PlotHistogramFunc(label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+}
+
+// Widgets: Menus
+
+func (ui *ImGuiUI) BeginMenuBar() bool {
+	ui.assertLocked()
+	return ui.ctx.BeginMenuBar()
+}
+
+func (ui *ImGuiUI) EndMenuBar() {
+	ui.assertLocked()
+	ui.ctx.EndMenuBar()
+}
+
+func (ui *ImGuiUI) BeginMainMenuBar() bool {
+	ui.assertLocked()
+	return ui.ctx.BeginMainMenuBar()
+}
+
+func (ui *ImGuiUI) EndMainMenuBar() {
+	ui.assertLocked()
+	ui.ctx.EndMainMenuBar()
+}
+
+func (ui *ImGuiUI) BeginMenu(label string, enabled bool) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginMenu(label, enabled)
+}
+
+func (ui *ImGuiUI) EndMenu() {
+	ui.assertLocked()
+	ui.ctx.EndMenu()
+}
+
+func (ui *ImGuiUI) MenuItem(label string, shortcut string, selected *bool, enabled bool) bool {
+	ui.assertLocked()
+	return ui.ctx.MenuItem(label, shortcut, selected, enabled)
+}
+
+func (ui *ImGuiUI) MenuItemSelected(label string, shortcut string, p_selected *bool, enabled bool) bool {
+	ui.assertLocked()
+	return ui.ctx.MenuItemSelected(label, shortcut, p_selected, enabled)
+}
+
+func (ui *ImGuiUI) MenuItemShortcut(label string, key_chord ImGuiKeyChord, p_selected *bool, enabled bool) bool {
+	ui.assertLocked()
+	return ui.ctx.MenuItemShortcut(label, key_chord, p_selected, enabled)
+}
+
+// Tooltips
+
+func (ui *ImGuiUI) BeginTooltip() {
+	ui.assertLocked()
+	ui.ctx.BeginTooltip()
+}
+
+func (ui *ImGuiUI) EndTooltip() {
+	ui.assertLocked()
+	ui.ctx.EndTooltip()
+}
+
+func (ui *ImGuiUI) SetTooltip(format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.SetTooltip(format, args...)
+}
+
+// Popups, Modals
+
+func (ui *ImGuiUI) BeginPopup(str_id string, flags ImGuiWindowFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginPopup(str_id, flags)
+}
+
+func (ui *ImGuiUI) BeginPopupModal(name string, p_open *bool, flags ImGuiWindowFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginPopupModal(name, p_open, flags)
+}
+
+func (ui *ImGuiUI) EndPopup() {
+	ui.assertLocked()
+	ui.ctx.EndPopup()
+}
+
+func (ui *ImGuiUI) OpenPopup(str_id string, popup_flags ImGuiPopupFlags) {
+	ui.assertLocked()
+	ui.ctx.OpenPopup(str_id, popup_flags)
+}
+
+func (ui *ImGuiUI) OpenPopupID(id ImGuiID, popup_flags ImGuiPopupFlags) {
+	ui.assertLocked()
+	ui.ctx.OpenPopupID(id, popup_flags)
+}
+
+func (ui *ImGuiUI) OpenPopupOnItemClick(str_id string, popup_flags ImGuiPopupFlags) {
+	ui.assertLocked()
+	ui.ctx.OpenPopupOnItemClick(str_id, popup_flags)
+}
+
+func (ui *ImGuiUI) CloseCurrentPopup() {
+	ui.assertLocked()
+	ui.ctx.CloseCurrentPopup()
+}
+
+func (ui *ImGuiUI) BeginPopupContextItem(str_id string, popup_flags ImGuiPopupFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginPopupContextItem(str_id, popup_flags)
+}
+
+func (ui *ImGuiUI) BeginPopupContextWindow(str_id string, popup_flags ImGuiPopupFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginPopupContextWindow(str_id, popup_flags)
+}
+
+func (ui *ImGuiUI) BeginPopupContextVoid(str_id string, popup_flags ImGuiPopupFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginPopupContextVoid(str_id, popup_flags)
+}
+
+func (ui *ImGuiUI) IsPopupOpen(str_id string, flags ImGuiPopupFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.IsPopupOpen(str_id, flags)
+}
+
+// Tables
+
+func (ui *ImGuiUI) BeginTable(str_id string, columns_count int, flags ImGuiTableFlags, outer_size ImVec2, inner_width float) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginTable(str_id, columns_count, flags, outer_size, inner_width)
+}
+
+func (ui *ImGuiUI) EndTable() {
+	ui.assertLocked()
+	ui.ctx.EndTable()
+}
+
+func (ui *ImGuiUI) TableNextRow(row_flags ImGuiTableRowFlags, row_min_height float) {
+	ui.assertLocked()
+	ui.ctx.TableNextRow(row_flags, row_min_height)
+}
+
+func (ui *ImGuiUI) TableNextColumn() bool {
+	ui.assertLocked()
+	return ui.ctx.TableNextColumn()
+}
+
+func (ui *ImGuiUI) TableSetColumnIndex(column_n int) bool {
+	ui.assertLocked()
+	return ui.ctx.TableSetColumnIndex(column_n)
+}
+
+func (ui *ImGuiUI) TableSetupColumn(label string, flags ImGuiTableColumnFlags, init_width_or_weight float, user_id ImGuiID) {
+	ui.assertLocked()
+	ui.ctx.TableSetupColumn(label, flags, init_width_or_weight, user_id)
+}
+
+func (ui *ImGuiUI) TableSetupScrollFreeze(columns int, rows int) {
+	ui.assertLocked()
+	ui.ctx.TableSetupScrollFreeze(columns, rows)
+}
+
+func (ui *ImGuiUI) TableHeadersRow() {
+	ui.assertLocked()
+	ui.ctx.TableHeadersRow()
+}
+
+func (ui *ImGuiUI) TableHeader(label string) {
+	ui.assertLocked()
+	ui.ctx.TableHeader(label)
+}
+
+func (ui *ImGuiUI) TableGetSortSpecs() *ImGuiTableSortSpecs {
+	ui.assertLocked()
+	return ui.ctx.TableGetSortSpecs()
+}
+
+func (ui *ImGuiUI) TableGetColumnCount() int {
+	ui.assertLocked()
+	return ui.ctx.TableGetColumnCount()
+}
+
+func (ui *ImGuiUI) TableGetColumnIndex() int {
+	ui.assertLocked()
+	return ui.ctx.TableGetColumnIndex()
+}
+
+func (ui *ImGuiUI) TableGetRowIndex() int {
+	ui.assertLocked()
+	return ui.ctx.TableGetRowIndex()
+}
+
+func (ui *ImGuiUI) TableGetColumnName(column_n int) string {
+	ui.assertLocked()
+	return ui.ctx.TableGetColumnName(column_n)
+}
+
+func (ui *ImGuiUI) TableGetColumnFlags(column_n int) ImGuiTableColumnFlags {
+	ui.assertLocked()
+	return ui.ctx.TableGetColumnFlags(column_n)
+}
+
+func (ui *ImGuiUI) TableSetColumnEnabled(column_n int, enabled bool) {
+	ui.assertLocked()
+	ui.ctx.TableSetColumnEnabled(column_n, enabled)
+}
+
+func (ui *ImGuiUI) TableSetBgColor(target ImGuiTableBgTarget, color ImU32, column_n int) {
+	ui.assertLocked()
+	ui.ctx.TableSetBgColor(target, color, column_n)
+}
+
+// Legacy Columns API
+
+func (ui *ImGuiUI) Columns(columns_count int, id string, border bool) {
+	ui.assertLocked()
+	ui.ctx.Columns(columns_count, id, border)
+}
+
+func (ui *ImGuiUI) NextColumn() {
+	ui.assertLocked()
+	ui.ctx.NextColumn()
+}
+
+func (ui *ImGuiUI) GetColumnIndex() int {
+	ui.assertLocked()
+	return ui.ctx.GetColumnIndex()
+}
+
+func (ui *ImGuiUI) GetColumnWidth(column_index int) float {
+	ui.assertLocked()
+	return ui.ctx.GetColumnWidth(column_index)
+}
+
+func (ui *ImGuiUI) SetColumnWidth(column_index int, width float) {
+	ui.assertLocked()
+	ui.ctx.SetColumnWidth(column_index, width)
+}
+
+func (ui *ImGuiUI) GetColumnOffset(column_index int) float {
+	ui.assertLocked()
+	return ui.ctx.GetColumnOffset(column_index)
+}
+
+func (ui *ImGuiUI) SetColumnOffset(column_index int, offset float) {
+	ui.assertLocked()
+	ui.ctx.SetColumnOffset(column_index, offset)
+}
+
+func (ui *ImGuiUI) GetColumnsCount() int {
+	ui.assertLocked()
+	return ui.ctx.GetColumnsCount()
+}
+
+// Tab Bars, Tabs
+
+func (ui *ImGuiUI) BeginTabBar(str_id string, flags ImGuiTabBarFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginTabBar(str_id, flags)
+}
+
+func (ui *ImGuiUI) EndTabBar() {
+	ui.assertLocked()
+	ui.ctx.EndTabBar()
+}
+
+func (ui *ImGuiUI) BeginTabItem(label string, p_open *bool, flags ImGuiTabItemFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginTabItem(label, p_open, flags)
+}
+
+func (ui *ImGuiUI) EndTabItem() {
+	ui.assertLocked()
+	ui.ctx.EndTabItem()
+}
+
+func (ui *ImGuiUI) TabItemButton(label string, flags ImGuiTabItemFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.TabItemButton(label, flags)
+}
+
+func (ui *ImGuiUI) SetTabItemClosed(tab_or_docked_window_label string) {
+	ui.assertLocked()
+	ui.ctx.SetTabItemClosed(tab_or_docked_window_label)
+}
+
+// Logging/Capture
+
+func (ui *ImGuiUI) LogToTTY(auto_open_depth int) {
+	ui.assertLocked()
+	ui.ctx.LogToTTY(auto_open_depth)
+}
+
+func (ui *ImGuiUI) LogToFile(auto_open_depth int, filename string) {
+	ui.assertLocked()
+	ui.ctx.LogToFile(auto_open_depth, filename)
+}
+
+func (ui *ImGuiUI) LogToWriter(w io.Writer, auto_open_depth int) {
+	ui.assertLocked()
+	ui.ctx.LogToWriter(w, auto_open_depth)
+}
+
+func (ui *ImGuiUI) LogToCapture(root *ImGuiCaptureNode, auto_open_depth int) {
+	ui.assertLocked()
+	ui.ctx.LogToCapture(root, auto_open_depth)
+}
+
+func (ui *ImGuiUI) LogToClipboard(auto_open_depth int) {
+	ui.assertLocked()
+	ui.ctx.LogToClipboard(auto_open_depth)
+}
+
+func (ui *ImGuiUI) LogFinish() {
+	ui.assertLocked()
+	ui.ctx.LogFinish()
+}
+
+func (ui *ImGuiUI) LogButtons() {
+	ui.assertLocked()
+	ui.ctx.LogButtons()
+}
+
+func (ui *ImGuiUI) LogText(format string, args ...any) {
+	ui.assertLocked()
+	ui.ctx.LogText(format, args...)
+}
+
+// Docking
+
+func (ui *ImGuiUI) DockSpace(id ImGuiID, size ImVec2, flags ImGuiDockNodeFlags) ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.DockSpace(id, size, flags)
+}
+
+func (ui *ImGuiUI) DockSpaceOverViewport(viewport *ImGuiViewport, flags ImGuiDockNodeFlags) ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.DockSpaceOverViewport(viewport, flags)
+}
+
+func (ui *ImGuiUI) SetNextWindowDockID(dock_id ImGuiID, cond ImGuiCond) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowDockID(dock_id, cond)
+}
+
+func (ui *ImGuiUI) GetWindowDockID() ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.GetWindowDockID()
+}
+
+func (ui *ImGuiUI) IsWindowDocked() bool {
+	ui.assertLocked()
+	return ui.ctx.IsWindowDocked()
+}
+
+func (ui *ImGuiUI) DockBuilderDockWindow(window_name string, node_id ImGuiID) {
+	ui.assertLocked()
+	ui.ctx.DockBuilderDockWindow(window_name, node_id)
+}
+
+func (ui *ImGuiUI) DockBuilderGetNode(node_id ImGuiID) *ImGuiDockNode {
+	ui.assertLocked()
+	return ui.ctx.DockBuilderGetNode(node_id)
+}
+
+func (ui *ImGuiUI) DockBuilderGetCentralNode(node_id ImGuiID) *ImGuiDockNode {
+	ui.assertLocked()
+	return ui.ctx.DockBuilderGetCentralNode(node_id)
+}
+
+func (ui *ImGuiUI) DockBuilderAddNode(node_id ImGuiID, flags ImGuiDockNodeFlags) ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.DockBuilderAddNode(node_id, flags)
+}
+
+func (ui *ImGuiUI) DockBuilderRemoveNode(node_id ImGuiID) {
+	ui.assertLocked()
+	ui.ctx.DockBuilderRemoveNode(node_id)
+}
+
+func (ui *ImGuiUI) DockBuilderRemoveNodeDockedWindows(node_id ImGuiID, clear_settings_refs bool) {
+	ui.assertLocked()
+	ui.ctx.DockBuilderRemoveNodeDockedWindows(node_id, clear_settings_refs)
+}
+
+func (ui *ImGuiUI) DockBuilderRemoveNodeChildNodes(node_id ImGuiID) {
+	ui.assertLocked()
+	ui.ctx.DockBuilderRemoveNodeChildNodes(node_id)
+}
+
+func (ui *ImGuiUI) DockBuilderSetNodePos(node_id ImGuiID, pos ImVec2) {
+	ui.assertLocked()
+	ui.ctx.DockBuilderSetNodePos(node_id, pos)
+}
+
+func (ui *ImGuiUI) DockBuilderSetNodeSize(node_id ImGuiID, size ImVec2) {
+	ui.assertLocked()
+	ui.ctx.DockBuilderSetNodeSize(node_id, size)
+}
+
+func (ui *ImGuiUI) DockBuilderSplitNode(node_id ImGuiID, split_dir ImGuiDir, size_ratio_for_node_at_dir float, out_id_at_dir *ImGuiID, out_id_at_opposite_dir *ImGuiID) ImGuiID {
+	ui.assertLocked()
+	return ui.ctx.DockBuilderSplitNode(node_id, split_dir, size_ratio_for_node_at_dir, out_id_at_dir, out_id_at_opposite_dir)
+}
+
+func (ui *ImGuiUI) DockBuilderFinish(node_id ImGuiID) {
+	ui.assertLocked()
+	ui.ctx.DockBuilderFinish(node_id)
+}
+
+// Drag and Drop
+
+func (ui *ImGuiUI) BeginDragDropSource(flags ImGuiDragDropFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginDragDropSource(flags)
+}
+
+func (ui *ImGuiUI) SetDragDropPayload(ptype string, data any, data_size uintptr, cond ImGuiCond) bool {
+	ui.assertLocked()
+	return ui.ctx.SetDragDropPayload(ptype, data, data_size, cond)
+}
+
+func (ui *ImGuiUI) EndDragDropSource() {
+	ui.assertLocked()
+	ui.ctx.EndDragDropSource()
+}
+
+func (ui *ImGuiUI) BeginDragDropTarget() bool {
+	ui.assertLocked()
+	return ui.ctx.BeginDragDropTarget()
+}
+
+func (ui *ImGuiUI) AcceptDragDropPayload(ptype string, flags ImGuiDragDropFlags) *ImGuiPayload {
+	ui.assertLocked()
+	return ui.ctx.AcceptDragDropPayload(ptype, flags)
+}
+
+func (ui *ImGuiUI) EndDragDropTarget() {
+	ui.assertLocked()
+	ui.ctx.EndDragDropTarget()
+}
+
+func (ui *ImGuiUI) GetDragDropPayload() *ImGuiPayload {
+	ui.assertLocked()
+	return ui.ctx.GetDragDropPayload()
+}
+
+// Disabling
+
+func (ui *ImGuiUI) BeginDisabled(disabled bool) {
+	ui.assertLocked()
+	ui.ctx.BeginDisabled(disabled)
+}
+
+func (ui *ImGuiUI) EndDisabled() {
+	ui.assertLocked()
+	ui.ctx.EndDisabled()
+}
+
+// Clipping
+
+func (ui *ImGuiUI) PushClipRect(cr_min ImVec2, cr_max ImVec2, intersect_with_current_clip_rect bool) {
+	ui.assertLocked()
+	ui.ctx.PushClipRect(cr_min, cr_max, intersect_with_current_clip_rect)
+}
+
+func (ui *ImGuiUI) PopClipRect() {
+	ui.assertLocked()
+	ui.ctx.PopClipRect()
+}
+
+// Focus, Activation
+
+func (ui *ImGuiUI) SetItemDefaultFocus() {
+	ui.assertLocked()
+	ui.ctx.SetItemDefaultFocus()
+}
+
+func (ui *ImGuiUI) SetKeyboardFocusHere(offset int) {
+	ui.assertLocked()
+	ui.ctx.SetKeyboardFocusHere(offset)
+}
+
+// Item/Widgets Utilities and Query Functions
+
+func (ui *ImGuiUI) IsItemHovered(flags ImGuiHoveredFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemHovered(flags)
+}
+
+func (ui *ImGuiUI) IsItemActive() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemActive()
+}
+
+func (ui *ImGuiUI) IsItemFocused() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemFocused()
+}
+
+func (ui *ImGuiUI) IsItemClicked(mouse_button ImGuiMouseButton) bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemClicked(mouse_button)
+}
+
+func (ui *ImGuiUI) IsItemVisible() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemVisible()
+}
+
+func (ui *ImGuiUI) IsItemEdited() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemEdited()
+}
+
+func (ui *ImGuiUI) IsItemActivated() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemActivated()
+}
+
+func (ui *ImGuiUI) IsItemDeactivated() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemDeactivated()
+}
+
+func (ui *ImGuiUI) IsItemDeactivatedAfterEdit() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemDeactivatedAfterEdit()
+}
+
+func (ui *ImGuiUI) IsItemToggledOpen() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemToggledOpen()
+}
+
+func (ui *ImGuiUI) IsItemToggledSelection() bool {
+	ui.assertLocked()
+	return ui.ctx.IsItemToggledSelection()
+}
+
+func (ui *ImGuiUI) IsAnyItemHovered() bool {
+	ui.assertLocked()
+	return ui.ctx.IsAnyItemHovered()
+}
+
+func (ui *ImGuiUI) IsAnyItemActive() bool {
+	ui.assertLocked()
+	return ui.ctx.IsAnyItemActive()
+}
+
+func (ui *ImGuiUI) IsAnyItemFocused() bool {
+	ui.assertLocked()
+	return ui.ctx.IsAnyItemFocused()
+}
+
+func (ui *ImGuiUI) GetItemRectMin() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetItemRectMin()
+}
+
+func (ui *ImGuiUI) GetItemRectMax() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetItemRectMax()
+}
+
+func (ui *ImGuiUI) GetItemRectSize() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetItemRectSize()
+}
+
+func (ui *ImGuiUI) SetItemAllowOverlap() {
+	ui.assertLocked()
+	ui.ctx.SetItemAllowOverlap()
+}
+
+// Viewports
+
+func (ui *ImGuiUI) GetMainViewport() *ImGuiViewport {
+	ui.assertLocked()
+	return ui.ctx.GetMainViewport()
+}
+
+func (ui *ImGuiUI) GetWindowViewport() *ImGuiViewport {
+	ui.assertLocked()
+	return ui.ctx.GetWindowViewport()
+}
+
+func (ui *ImGuiUI) SetNextWindowViewport(viewport_id ImGuiID) {
+	ui.assertLocked()
+	ui.ctx.SetNextWindowViewport(viewport_id)
+}
+
+func (ui *ImGuiUI) GetPlatformIO() *ImGuiPlatformIO {
+	ui.assertLocked()
+	return ui.ctx.GetPlatformIO()
+}
+
+func (ui *ImGuiUI) FindViewportByID(id ImGuiID) *ImGuiViewport {
+	ui.assertLocked()
+	return ui.ctx.FindViewportByID(id)
+}
+
+func (ui *ImGuiUI) FindViewportByPlatformHandle(platform_handle any) *ImGuiViewport {
+	ui.assertLocked()
+	return ui.ctx.FindViewportByPlatformHandle(platform_handle)
+}
+
+func (ui *ImGuiUI) UpdatePlatformWindows() {
+	ui.assertLocked()
+	ui.ctx.UpdatePlatformWindows()
+}
+
+func (ui *ImGuiUI) RenderPlatformWindowsDefault(platform_render_arg, renderer_render_arg any) {
+	ui.assertLocked()
+	ui.ctx.RenderPlatformWindowsDefault(platform_render_arg, renderer_render_arg)
+}
+
+func (ui *ImGuiUI) DestroyPlatformWindows() {
+	ui.assertLocked()
+	ui.ctx.DestroyPlatformWindows()
+}
+
+// Miscellaneous Utilities
+
+func (ui *ImGuiUI) IsRectVisible(size ImVec2) bool {
+	ui.assertLocked()
+	return ui.ctx.IsRectVisible(size)
+}
+
+func (ui *ImGuiUI) IsRectVisibleMinMax(rect_min, rect_max ImVec2) bool {
+	ui.assertLocked()
+	return ui.ctx.IsRectVisibleMinMax(rect_min, rect_max)
+}
+
+func (ui *ImGuiUI) GetTime() double {
+	ui.assertLocked()
+	return ui.ctx.GetTime()
+}
+
+func (ui *ImGuiUI) GetFrameCount() int {
+	ui.assertLocked()
+	return ui.ctx.GetFrameCount()
+}
+
+func (ui *ImGuiUI) GetBackgroundDrawList(viewport *ImGuiViewport) *ImDrawList {
+	ui.assertLocked()
+	return ui.ctx.GetBackgroundDrawList(viewport)
+}
+
+func (ui *ImGuiUI) GetForegroundDrawList(viewport *ImGuiViewport) *ImDrawList {
+	ui.assertLocked()
+	return ui.ctx.GetForegroundDrawList(viewport)
+}
+
+func (ui *ImGuiUI) GetDrawListSharedData() *ImDrawListSharedData {
+	ui.assertLocked()
+	return ui.ctx.GetDrawListSharedData()
+}
+
+func (ui *ImGuiUI) GetStyleColorName(idx ImGuiCol) string {
+	ui.assertLocked()
+	return GetStyleColorName(idx)
+}
+
+func (ui *ImGuiUI) SetStateStorage(storage *ImGuiStorage) {
+	ui.assertLocked()
+	ui.ctx.SetStateStorage(storage)
+}
+
+func (ui *ImGuiUI) GetStateStorage() ImGuiStorage {
+	ui.assertLocked()
+	return ui.ctx.GetStateStorage()
+}
+
+func (ui *ImGuiUI) BeginChildFrame(id ImGuiID, size ImVec2, flags ImGuiWindowFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.BeginChildFrame(id, size, flags)
+}
+
+func (ui *ImGuiUI) EndChildFrame() {
+	ui.assertLocked()
+	ui.ctx.EndChildFrame()
+}
+
+// Text Utilities
+
+func (ui *ImGuiUI) CalcTextSize(text string, hide_text_after_double_hash bool, wrap_width float) ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.CalcTextSize(text, hide_text_after_double_hash, wrap_width)
+}
+
+// Inputs Utilities: Keyboard
+
+func (ui *ImGuiUI) GetKeyIndex(key ImGuiKey) ImGuiKey {
+	ui.assertLocked()
+	return GetKeyIndex(key)
+}
+
+func (ui *ImGuiUI) GetKeyName(key ImGuiKey) string {
+	ui.assertLocked()
+	return ui.ctx.GetKeyName(key)
+}
+
+func (ui *ImGuiUI) IsKeyDown(key ImGuiKey) bool {
+	ui.assertLocked()
+	return ui.ctx.IsKeyDown(key)
+}
+
+func (ui *ImGuiUI) IsKeyPressed(key ImGuiKey, repeat bool) bool {
+	ui.assertLocked()
+	return ui.ctx.IsKeyPressed(key, repeat)
+}
+
+func (ui *ImGuiUI) IsKeyReleased(key ImGuiKey) bool {
+	ui.assertLocked()
+	return ui.ctx.IsKeyReleased(key)
+}
+
+func (ui *ImGuiUI) GetKeyPressedAmount(key ImGuiKey, repeat_delay float, repeat_rate float) int {
+	ui.assertLocked()
+	return ui.ctx.GetKeyPressedAmount(key, repeat_delay, repeat_rate)
+}
+
+func (ui *ImGuiUI) GetKeyChordName(key_chord ImGuiKeyChord) string {
+	ui.assertLocked()
+	return ui.ctx.GetKeyChordName(key_chord)
+}
+
+func (ui *ImGuiUI) Shortcut(key_chord ImGuiKeyChord, flags ImGuiInputFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.Shortcut(key_chord, flags)
+}
+
+func (ui *ImGuiUI) SetShortcutRouting(key_chord ImGuiKeyChord, owner_id ImGuiID, flags ImGuiInputFlags) bool {
+	ui.assertLocked()
+	return ui.ctx.SetShortcutRouting(key_chord, owner_id, flags)
+}
+
+func (ui *ImGuiUI) CaptureKeyboardFromApp(want_capture_keyboard_value bool) {
+	ui.assertLocked()
+	ui.ctx.CaptureKeyboardFromApp(want_capture_keyboard_value)
+}
+
+// Inputs Utilities: Mouse
+
+func (ui *ImGuiUI) IsMouseDown(button ImGuiMouseButton) bool {
+	ui.assertLocked()
+	return ui.ctx.IsMouseDown(button)
+}
+
+func (ui *ImGuiUI) IsMouseClicked(button ImGuiMouseButton, repeat bool) bool {
+	ui.assertLocked()
+	return ui.ctx.IsMouseClicked(button, repeat)
+}
+
+func (ui *ImGuiUI) IsMouseReleased(button ImGuiMouseButton) bool {
+	ui.assertLocked()
+	return ui.ctx.IsMouseReleased(button)
+}
+
+func (ui *ImGuiUI) IsMouseDoubleClicked(button ImGuiMouseButton) bool {
+	ui.assertLocked()
+	return ui.ctx.IsMouseDoubleClicked(button)
+}
+
+func (ui *ImGuiUI) IsMouseHoveringRect(r_min, r_max ImVec2, clip bool) bool {
+	ui.assertLocked()
+	return ui.ctx.IsMouseHoveringRect(r_min, r_max, clip)
+}
+
+func (ui *ImGuiUI) IsMousePosValid(mouse_pos *ImVec2) bool {
+	ui.assertLocked()
+	return ui.ctx.IsMousePosValid(mouse_pos)
+}
+
+func (ui *ImGuiUI) IsAnyMouseDown() bool {
+	ui.assertLocked()
+	return ui.ctx.IsAnyMouseDown()
+}
+
+func (ui *ImGuiUI) GetMousePos() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetMousePos()
+}
+
+func (ui *ImGuiUI) GetMousePosOnOpeningCurrentPopup() ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetMousePosOnOpeningCurrentPopup()
+}
+
+func (ui *ImGuiUI) IsMouseDragging(button ImGuiMouseButton, lock_threshold float) bool {
+	ui.assertLocked()
+	return ui.ctx.IsMouseDragging(button, lock_threshold)
+}
+
+func (ui *ImGuiUI) GetMouseDragDelta(button ImGuiMouseButton, lock_threshold float) ImVec2 {
+	ui.assertLocked()
+	return ui.ctx.GetMouseDragDelta(button, lock_threshold)
+}
+
+func (ui *ImGuiUI) ResetMouseDragDelta(button ImGuiMouseButton) {
+	ui.assertLocked()
+	ui.ctx.ResetMouseDragDelta(button)
+}
+
+func (ui *ImGuiUI) GetMouseCursor() ImGuiMouseCursor {
+	ui.assertLocked()
+	return ui.ctx.GetMouseCursor()
+}
+
+func (ui *ImGuiUI) SetMouseCursor(cursor_type ImGuiMouseCursor) {
+	ui.assertLocked()
+	ui.ctx.SetMouseCursor(cursor_type)
+}
+
+func (ui *ImGuiUI) CaptureMouseFromApp(want_capture_mouse_value bool) {
+	ui.assertLocked()
+	ui.ctx.CaptureMouseFromApp(want_capture_mouse_value)
+}
+
+// Clipboard Utilities
+
+func (ui *ImGuiUI) GetClipboardText() string {
+	ui.assertLocked()
+	return ui.ctx.GetClipboardText()
+}
+
+func (ui *ImGuiUI) SetClipboardText(text string) {
+	ui.assertLocked()
+	ui.ctx.SetClipboardText(text)
+}
+
+// Settings/.Ini Utilities
+
+func (ui *ImGuiUI) LoadIniSettingsFromDisk(ini_filename string) {
+	ui.assertLocked()
+	ui.ctx.LoadIniSettingsFromDisk(ini_filename)
+}
+
+func (ui *ImGuiUI) LoadIniSettingsFromMemory(buf []byte, ini_size uintptr) {
+	ui.assertLocked()
+	ui.ctx.LoadIniSettingsFromMemory(buf, ini_size)
+}
+
+func (ui *ImGuiUI) SaveIniSettingsToDisk(ini_filename string) {
+	ui.assertLocked()
+	ui.ctx.SaveIniSettingsToDisk(ini_filename)
+}
+
+func (ui *ImGuiUI) SaveIniSettingsToMemory(out_size *uintptr) []byte {
+	ui.assertLocked()
+	return ui.ctx.SaveIniSettingsToMemory(out_size)
+}
+
+func (ui *ImGuiUI) LoadSettings(r io.Reader) error {
+	ui.assertLocked()
+	return ui.ctx.LoadSettings(r)
+}
+
+func (ui *ImGuiUI) SaveSettings(w io.Writer) error {
+	ui.assertLocked()
+	return ui.ctx.SaveSettings(w)
+}
+
+func (ui *ImGuiUI) LoadSettingsEntries(entries []ImGuiSettingsEntry) {
+	ui.assertLocked()
+	ui.ctx.LoadSettingsEntries(entries)
+}
+
+func (ui *ImGuiUI) SaveSettingsEntries() []ImGuiSettingsEntry {
+	ui.assertLocked()
+	return ui.ctx.SaveSettingsEntries()
+}
+
+func (ui *ImGuiUI) AddSettingsHandler(handler *ImGuiSettingsHandler) {
+	ui.assertLocked()
+	ui.ctx.AddSettingsHandler(handler)
+}
+
+func (ui *ImGuiUI) RemoveSettingsHandler(type_name string) {
+	ui.assertLocked()
+	ui.ctx.RemoveSettingsHandler(type_name)
+}
+
+func (ui *ImGuiUI) AddSettingsEntryHandler(type_name string, read func(entry ImGuiSettingsEntry), write func() []ImGuiSettingsEntry) {
+	ui.assertLocked()
+	ui.ctx.AddSettingsEntryHandler(type_name, read, write)
+}
+
+func (ui *ImGuiUI) AddSettingsStruct(type_name, name string, ptr any) {
+	ui.assertLocked()
+	ui.ctx.AddSettingsStruct(type_name, name, ptr)
+}
+
+func (ui *ImGuiUI) MarkIniSettingsDirty() {
+	ui.assertLocked()
+	ui.ctx.MarkIniSettingsDirty()
+}
+
+func (ui *ImGuiUI) AddSettingsAlias(type_name, old_name, new_name string) {
+	ui.assertLocked()
+	ui.ctx.AddSettingsAlias(type_name, old_name, new_name)
+}
+
+func (ui *ImGuiUI) AddWindowSettingsAlias(old_name, new_name string) {
+	ui.assertLocked()
+	ui.ctx.AddWindowSettingsAlias(old_name, new_name)
+}
+
+func (ui *ImGuiUI) AddTableSettingsAlias(old_id, new_id ImGuiID) {
+	ui.assertLocked()
+	ui.ctx.AddTableSettingsAlias(old_id, new_id)
+}
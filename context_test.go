@@ -0,0 +1,117 @@
+package imgui
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestContextLock(t *testing.T) {
+	const frames = 10
+
+	// CreateContext and DestroyContext don't lock, they are called while no goroutine is running.
+	var contexts [4]*ImGuiContext
+	for i := range contexts {
+		contexts[i] = newTestContext(nil)
+	}
+	defer func() {
+		for _, ctx := range contexts {
+			DestroyContext(ctx)
+		}
+	}()
+	var shared = newTestContext(nil)
+	defer DestroyContext(shared)
+
+	// The contexts run concurrently and must not rely on the current context.
+	var backup = GetCurrentContext()
+	SetCurrentContext(nil)
+	defer SetCurrentContext(backup)
+
+	var wg sync.WaitGroup
+	var errs = make(chan error, len(contexts))
+	for i, ctx := range contexts {
+		wg.Add(1)
+		go func(i int, ctx *ImGuiContext) {
+			defer wg.Done()
+
+			var name = fmt.Sprintf("Client %d", i)
+			for frame := int(0); frame < frames; frame++ {
+				ctx.Frame(func(ui *ImGuiUI) {
+					ui.Begin(name, nil, 0)
+					ui.Text("frame %d", frame)
+					ui.End()
+				})
+			}
+
+			if ctx.FrameCount != frames {
+				errs <- fmt.Errorf("%s: FrameCount = %d, want %d", name, ctx.FrameCount, frames)
+			}
+			for _, window := range ctx.Windows {
+				if window.Name != name && window.Name != "Debug##Default" {
+					errs <- fmt.Errorf("%s: unexpected window %q", name, window.Name)
+				}
+			}
+		}(int(i), ctx)
+	}
+	// Goroutines sharing a context take turns.
+	for i := int(0); i < 2; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for frame := int(0); frame < frames; frame++ {
+				shared.Frame(func(ui *ImGuiUI) {
+					ui.Begin(fmt.Sprintf("Shared %d", i), nil, 0)
+					ui.End()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+	if shared.FrameCount != 2*frames {
+		t.Errorf("shared context: FrameCount = %d, want %d", shared.FrameCount, 2*frames)
+	}
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestContextUnlocked(t *testing.T) {
+	var ctx = CreateContext(nil)
+	defer DestroyContext(ctx)
+
+	var ui = ctx.Lock()
+	ui.Unlock()
+	defer func() {
+		if recover() == nil {
+			t.Error("using a handle after Unlock() didn't panic")
+		}
+	}()
+	ui.GetIO()
+}
+
+func TestContextStaleHandle(t *testing.T) {
+	var ctx = CreateContext(nil)
+	defer DestroyContext(ctx)
+	var other = CreateContext(nil)
+	defer DestroyContext(other)
+
+	var stale = ctx.Lock()
+	stale.Unlock()
+	var ui = ctx.Lock()
+	defer ui.Unlock()
+
+	// Another context can be locked from a locked section.
+	var other_ui = other.Lock()
+	if other_ui.Context() != other {
+		t.Error("Lock() returned a handle to another context")
+	}
+	other_ui.Unlock()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("using a released handle while its context is locked again didn't panic")
+		}
+	}()
+	stale.GetIO()
+}
@@ -0,0 +1,1701 @@
+package imgui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Main Menu Bar / ShowExampleAppMainMenuBar()
+//-----------------------------------------------------------------------------
+
+// Demonstrate creating a "main" fullscreen menu bar and populating it.
+// Note the difference between BeginMainMenuBar() and BeginMenuBar():
+// - BeginMenuBar() = menu-bar inside current window (which needs the ImGuiWindowFlags_MenuBar flag!)
+// - BeginMainMenuBar() = helper to create menu-bar-sized window at the top of the main viewport + call BeginMenuBar() into it.
+func (g *ImGuiContext) ShowExampleAppMainMenuBar() {
+	if g.BeginMainMenuBar() {
+		if g.BeginMenu("File", true) {
+			g.ShowExampleMenuFile()
+			g.EndMenu()
+		}
+		if g.BeginMenu("Edit", true) {
+			if g.MenuItem("Undo", "CTRL+Z", nil, true) {
+			}
+			if g.MenuItem("Redo", "CTRL+Y", nil, false) { // Disabled item
+			}
+			g.Separator()
+			if g.MenuItem("Cut", "CTRL+X", nil, true) {
+			}
+			if g.MenuItem("Copy", "CTRL+C", nil, true) {
+			}
+			if g.MenuItem("Paste", "CTRL+V", nil, true) {
+			}
+			g.EndMenu()
+		}
+		g.EndMainMenuBar()
+	}
+}
+
+var menuFileState struct {
+	enabled bool
+	f       float
+	n       int
+	b       bool
+}
+
+func init() {
+	menuFileState.enabled = true
+	menuFileState.f = 0.5
+	menuFileState.b = true
+}
+
+// ShowExampleMenuFile Note that shortcuts are currently provided for display only
+// (future version will add explicit flags to BeginMenu() to request processing shortcuts)
+func (g *ImGuiContext) ShowExampleMenuFile() {
+	var state = &menuFileState
+
+	g.MenuItem("(demo menu)", "", nil, false)
+	if g.MenuItem("New", "", nil, true) {
+	}
+	if g.MenuItem("Open", "Ctrl+O", nil, true) {
+	}
+	if g.BeginMenu("Open Recent", true) {
+		g.MenuItem("fish_hat.c", "", nil, true)
+		g.MenuItem("fish_hat.inl", "", nil, true)
+		g.MenuItem("fish_hat.h", "", nil, true)
+		if g.BeginMenu("More..", true) {
+			g.MenuItem("Hello", "", nil, true)
+			g.MenuItem("Sailor", "", nil, true)
+			if g.BeginMenu("Recurse..", true) {
+				g.ShowExampleMenuFile()
+				g.EndMenu()
+			}
+			g.EndMenu()
+		}
+		g.EndMenu()
+	}
+	if g.MenuItem("Save", "Ctrl+S", nil, true) {
+	}
+	if g.MenuItem("Save As..", "", nil, true) {
+	}
+
+	g.Separator()
+	if g.BeginMenu("Options", true) {
+		g.MenuItemSelected("Enabled", "", &state.enabled, true)
+		g.BeginChild("child", ImVec2{0, 60}, true, 0)
+		for i := 0; i < 10; i++ {
+			g.Text("Scrolling Text %d", i)
+		}
+		g.EndChild()
+		g.SliderFloat("Value", &state.f, 0.0, 1.0, "%.3f", 0)
+		g.InputFloat("Input", &state.f, 0.1, 0, "%.3f", 0)
+		g.Combo("Combo", &state.n, []string{"Yes", "No", "Maybe"}, 3, -1)
+		g.EndMenu()
+	}
+
+	if g.BeginMenu("Colors", true) {
+		var sz = g.GetTextLineHeight()
+		for i := ImGuiCol(0); i < ImGuiCol_COUNT; i++ {
+			var name = GetStyleColorName(i)
+			var p = g.GetCursorScreenPos()
+			g.GetWindowDrawList().AddRectFilled(p, ImVec2{p.x + sz, p.y + sz}, g.GetColorU32FromID(i, 1), 0, 0)
+			g.Dummy(ImVec2{sz, sz})
+			g.SameLine(0, -1)
+			g.MenuItem(name, "", nil, true)
+		}
+		g.EndMenu()
+	}
+
+	// Here we demonstrate appending again to the "Options" menu (which we already created above)
+	// Of course in this demo it is a little bit silly that this function calls BeginMenu("Options") twice.
+	// In a real code-base using it would make senses to use this feature from very different code locations.
+	if g.BeginMenu("Options", true) { // <-- Append!
+		g.Checkbox("SomeOption", &state.b)
+		g.EndMenu()
+	}
+
+	if g.BeginMenu("Disabled", false) { // Disabled
+		IM_ASSERT(false)
+	}
+	var checked = true
+	if g.MenuItem("Checked", "", &checked, true) {
+	}
+	if g.MenuItem("Quit", "Alt+F4", nil, true) {
+	}
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Debug Console / ShowExampleAppConsole()
+//-----------------------------------------------------------------------------
+
+// ExampleAppConsole Demonstrate creating a simple console window, with scrolling, filtering, completion and history.
+// For the console example, we are using a more Go like approach of declaring a type to hold both data and methods.
+type ExampleAppConsole struct {
+	InputBuf       string
+	Items          []string
+	Commands       []string
+	History        []string
+	HistoryPos     int // -1: new line, 0..len(History)-1 browsing history.
+	Filter         ImGuiTextFilter
+	AutoScroll     bool
+	ScrollToBottom bool
+}
+
+func NewExampleAppConsole() *ExampleAppConsole {
+	var console = &ExampleAppConsole{
+		HistoryPos: -1,
+
+		// "CLASSIFY" is here to provide the test case where "C"+[tab] completes to "CL" and display multiple matches.
+		Commands:   []string{"HELP", "HISTORY", "CLEAR", "CLASSIFY"},
+		AutoScroll: true,
+	}
+	console.AddLog("Welcome to Dear ImGui!")
+	return console
+}
+
+func (console *ExampleAppConsole) ClearLog() {
+	console.Items = console.Items[:0]
+}
+
+func (console *ExampleAppConsole) AddLog(format string, args ...any) {
+	console.Items = append(console.Items, fmt.Sprintf(format, args...))
+}
+
+func (console *ExampleAppConsole) Draw(title string, p_open *bool) {
+	console.DrawContext(GImGui, title, p_open)
+}
+
+// DrawContext is Draw() for the context g.
+func (console *ExampleAppConsole) DrawContext(g *ImGuiContext, title string, p_open *bool) {
+	g.SetNextWindowSize(&ImVec2{520, 600}, ImGuiCond_FirstUseEver)
+	if !g.Begin(title, p_open, 0) {
+		g.End()
+		return
+	}
+
+	// As a specific feature guaranteed by the library, after calling Begin() the last Item represent the title bar.
+	// So e.g. IsItemHovered() will return true when hovering the title bar.
+	// Here we create a context menu only available from the title bar.
+	if g.BeginPopupContextItem("", ImGuiPopupFlags_MouseButtonRight) {
+		if g.MenuItem("Close Console", "", nil, true) {
+			*p_open = false
+		}
+		g.EndPopup()
+	}
+
+	g.TextWrapped(
+		"This example implements a console with basic coloring, completion (TAB key) and history (Up/Down keys). A more elaborate " +
+			"implementation may want to store entries along with extra data such as timestamp, emitter, etc.")
+	g.TextWrapped("Enter 'HELP' for help.")
+
+	// TODO: display items starting from the bottom
+
+	if g.SmallButton("Add Debug Text") {
+		console.AddLog("%d some text", len(console.Items))
+		console.AddLog("some more text")
+		console.AddLog("display very important message here!")
+	}
+	g.SameLine(0, -1)
+	if g.SmallButton("Add Debug Error") {
+		console.AddLog("[error] something went wrong")
+	}
+	g.SameLine(0, -1)
+	if g.SmallButton("Clear") {
+		console.ClearLog()
+	}
+	g.SameLine(0, -1)
+	var copy_to_clipboard = g.SmallButton("Copy")
+
+	g.Separator()
+
+	// Options menu
+	if g.BeginPopup("Options", 0) {
+		g.Checkbox("Auto-scroll", &console.AutoScroll)
+		g.EndPopup()
+	}
+
+	// Options, Filter
+	if g.Button("Options") {
+		g.OpenPopup("Options", 0)
+	}
+	g.SameLine(0, -1)
+	console.Filter.DrawContext(g, "Filter (\"incl,-excl\") (\"error\")", 180)
+	g.Separator()
+
+	// Reserve enough left-over height for 1 separator + 1 input text
+	var footer_height_to_reserve = g.GetStyle().ItemSpacing.y + g.GetFrameHeightWithSpacing()
+	g.BeginChild("ScrollingRegion", ImVec2{0, -footer_height_to_reserve}, false, ImGuiWindowFlags_HorizontalScrollbar)
+	if g.BeginPopupContextWindow("", ImGuiPopupFlags_MouseButtonRight) {
+		if g.Selectable("Clear", false, 0, ImVec2{}) {
+			console.ClearLog()
+		}
+		g.EndPopup()
+	}
+
+	// Display every line as a separate entry so we can change their color or add custom widgets.
+	// If you only want raw text you can use TextUnformatted(log).
+	// NB- if you have thousands of entries this approach may be too inefficient and may require user-side clipping
+	// to only process visible items. The clipper will automatically measure the height of your first item and then
+	// "seek" to display only items in the visible area.
+	// To use the clipper we can replace your standard loop:
+	//      for i := range Items {
+	//   With:
+	//      var clipper ImGuiListClipper
+	//      clipper.Begin(int(len(Items)), -1)
+	//      for clipper.Step() {
+	//         for i := clipper.DisplayStart; i < clipper.DisplayEnd; i++ {
+	// - That your items are evenly spaced (same height)
+	// - That you have cheap random access to your elements (you can access them given their index,
+	//   without processing all the ones before)
+	// You cannot this code as-is if a filter is active because it breaks the 'cheap random-access' property.
+	// We would need random-access on the post-filtered list.
+	// A typical application wanting coarse clipping and filtering may want to pre-compute an array of indices
+	// or offsets of items that passed the filtering test, recomputing this array when user changes the filter,
+	// and appending newly elements as they are inserted. This is left as a task to the user until we can manage
+	// to improve this example code!
+	// If your items are of variable height:
+	// - Split them into same height items would be simpler and facilitate random-seeking into your list.
+	// - Consider using manual call to IsRectVisible() and skipping extraneous decoration from your items.
+	g.PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{4, 1}) // Tighten spacing
+	if copy_to_clipboard {
+		g.LogToClipboard(-1)
+	}
+	for _, item := range console.Items {
+		if !console.Filter.PassFilter(item) {
+			continue
+		}
+
+		// Normally you would store more information in your item than just a string.
+		// (e.g. make Items[] an array of structure, store color/type etc.)
+		var color ImVec4
+		var has_color = false
+		if strings.Contains(item, "[error]") {
+			color = ImVec4{1.0, 0.4, 0.4, 1.0}
+			has_color = true
+		} else if strings.HasPrefix(item, "# ") {
+			color = ImVec4{1.0, 0.8, 0.6, 1.0}
+			has_color = true
+		}
+		if has_color {
+			g.PushStyleColorVec(ImGuiCol_Text, &color)
+		}
+		g.TextUnformatted(item)
+		if has_color {
+			g.PopStyleColor(1)
+		}
+	}
+	if copy_to_clipboard {
+		g.LogFinish()
+	}
+
+	if console.ScrollToBottom || (console.AutoScroll && g.GetScrollY() >= g.GetScrollMaxY()) {
+		g.SetScrollHereY(1.0)
+	}
+	console.ScrollToBottom = false
+
+	g.PopStyleVar(1)
+	g.EndChild()
+	g.Separator()
+
+	// Command-line
+	var reclaim_focus = false
+	var input_text_flags = ImGuiInputTextFlags_EnterReturnsTrue | ImGuiInputTextFlags_CallbackCompletion | ImGuiInputTextFlags_CallbackHistory
+	if g.InputTextString("Input", &console.InputBuf, input_text_flags, console.TextEditCallback, nil) {
+		var s = strings.TrimRight(console.InputBuf, " ")
+		if s != "" {
+			console.ExecCommand(s)
+		}
+		console.InputBuf = ""
+		reclaim_focus = true
+	}
+
+	// Auto-focus on window apparition
+	g.SetItemDefaultFocus()
+	if reclaim_focus {
+		g.SetKeyboardFocusHere(-1) // Auto focus previous widget
+	}
+
+	g.End()
+}
+
+func (console *ExampleAppConsole) ExecCommand(command_line string) {
+	console.AddLog("# %s\n", command_line)
+
+	// Insert into history. First find match and delete it so it can be pushed to the back.
+	// This isn't trying to be smart or optimal.
+	console.HistoryPos = -1
+	for i := len(console.History) - 1; i >= 0; i-- {
+		if strings.EqualFold(console.History[i], command_line) {
+			console.History = append(console.History[:i], console.History[i+1:]...)
+			break
+		}
+	}
+	console.History = append(console.History, command_line)
+
+	// Process command
+	if strings.EqualFold(command_line, "CLEAR") {
+		console.ClearLog()
+	} else if strings.EqualFold(command_line, "HELP") {
+		console.AddLog("Commands:")
+		for _, command := range console.Commands {
+			console.AddLog("- %s", command)
+		}
+	} else if strings.EqualFold(command_line, "HISTORY") {
+		var first = len(console.History) - 10
+		if first < 0 {
+			first = 0
+		}
+		for i := first; i < len(console.History); i++ {
+			console.AddLog("%3d: %s\n", i, console.History[i])
+		}
+	} else {
+		console.AddLog("Unknown command: '%s'\n", command_line)
+	}
+
+	// On command input, we scroll to bottom even if AutoScroll==false
+	console.ScrollToBottom = true
+}
+
+func (console *ExampleAppConsole) TextEditCallback(data *ImGuiInputTextCallbackData) int {
+	//console.AddLog("cursor: %d, selection: %d-%d", data.CursorPos, data.SelectionStart, data.SelectionEnd)
+	switch data.EventFlag {
+	case ImGuiInputTextFlags_CallbackCompletion:
+		// Example of TEXT COMPLETION
+
+		// Locate beginning of current word
+		var word_end = data.CursorPos
+		var word_start = word_end
+		for word_start > 0 {
+			var c = data.Buf[word_start-1]
+			if c == ' ' || c == '\t' || c == ',' || c == ';' {
+				break
+			}
+			word_start--
+		}
+		var word = string(data.Buf[word_start:word_end])
+
+		// Build a list of candidates
+		var candidates []string
+		for _, command := range console.Commands {
+			if len(command) >= len(word) && strings.EqualFold(command[:len(word)], word) {
+				candidates = append(candidates, command)
+			}
+		}
+
+		if len(candidates) == 0 {
+			// No match
+			console.AddLog("No match for \"%s\"!\n", word)
+		} else if len(candidates) == 1 {
+			// Single match. Delete the beginning of the word and replace it entirely so we've got nice casing.
+			data.DeleteChars(word_start, word_end-word_start)
+			data.InsertChars(data.CursorPos, candidates[0])
+			data.InsertChars(data.CursorPos, " ")
+		} else {
+			// Multiple matches. Complete as much as we can..
+			// So inputing "C"+Tab will complete to "CL" then display "CLEAR" and "CLASSIFY" as matches.
+			var match_len = len(word)
+			for {
+				var c rune
+				var all_candidates_matches = true
+				for i := 0; i < len(candidates) && all_candidates_matches; i++ {
+					var ci rune
+					if match_len < len(candidates[i]) {
+						ci = unicode.ToUpper(rune(candidates[i][match_len]))
+					}
+					if i == 0 {
+						c = ci
+					} else if c == 0 || c != ci {
+						all_candidates_matches = false
+					}
+				}
+				if !all_candidates_matches {
+					break
+				}
+				match_len++
+			}
+
+			if match_len > 0 {
+				data.DeleteChars(word_start, word_end-word_start)
+				data.InsertChars(data.CursorPos, candidates[0][:match_len])
+			}
+
+			// List matches
+			console.AddLog("Possible matches:\n")
+			for _, candidate := range candidates {
+				console.AddLog("- %s\n", candidate)
+			}
+		}
+
+	case ImGuiInputTextFlags_CallbackHistory:
+		// Example of HISTORY
+		var prev_history_pos = console.HistoryPos
+		if data.EventKey == ImGuiKey_UpArrow {
+			if console.HistoryPos == -1 {
+				console.HistoryPos = int(len(console.History)) - 1
+			} else if console.HistoryPos > 0 {
+				console.HistoryPos--
+			}
+		} else if data.EventKey == ImGuiKey_DownArrow {
+			if console.HistoryPos != -1 {
+				console.HistoryPos++
+				if console.HistoryPos >= int(len(console.History)) {
+					console.HistoryPos = -1
+				}
+			}
+		}
+
+		// A better implementation would preserve the data on the current input line along with cursor position.
+		if prev_history_pos != console.HistoryPos {
+			var history_str string
+			if console.HistoryPos >= 0 {
+				history_str = console.History[console.HistoryPos]
+			}
+			data.DeleteChars(0, data.BufTextLen)
+			data.InsertChars(0, history_str)
+		}
+	}
+	return 0
+}
+
+var exampleAppConsole *ExampleAppConsole
+
+func (g *ImGuiContext) ShowExampleAppConsole(p_open *bool) {
+	if exampleAppConsole == nil {
+		exampleAppConsole = NewExampleAppConsole()
+	}
+	exampleAppConsole.DrawContext(g, "Example: Console", p_open)
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Debug Log / ShowExampleAppLog()
+//-----------------------------------------------------------------------------
+
+// ExampleAppLog Usage:
+//
+//	var my_log = NewExampleAppLog()
+//	my_log.AddLog("Hello %d world\n", 123)
+//	my_log.Draw("title", nil)
+type ExampleAppLog struct {
+	Buf         ImGuiTextBuffer
+	Filter      ImGuiTextFilter
+	LineOffsets []int // Index to lines offset. We maintain this with AddLog() calls.
+	AutoScroll  bool  // Keep scrolling if already at the bottom.
+}
+
+func NewExampleAppLog() *ExampleAppLog {
+	var log = &ExampleAppLog{AutoScroll: true}
+	log.Clear()
+	return log
+}
+
+func (log *ExampleAppLog) Clear() {
+	log.Buf = log.Buf[:0]
+	log.LineOffsets = append(log.LineOffsets[:0], 0)
+}
+
+func (log *ExampleAppLog) AddLog(format string, args ...any) {
+	var old_size = int(len(log.Buf))
+	log.Buf = append(log.Buf, fmt.Sprintf(format, args...)...)
+	for new_size := int(len(log.Buf)); old_size < new_size; old_size++ {
+		if log.Buf[old_size] == '\n' {
+			log.LineOffsets = append(log.LineOffsets, old_size+1)
+		}
+	}
+}
+
+// line returns the text of the given line, without its trailing newline.
+func (log *ExampleAppLog) line(line_no int) string {
+	var line_start = log.LineOffsets[line_no]
+	var line_end = int(len(log.Buf))
+	if line_no+1 < int(len(log.LineOffsets)) {
+		line_end = log.LineOffsets[line_no+1] - 1
+	}
+	return string(log.Buf[line_start:line_end])
+}
+
+func (log *ExampleAppLog) Draw(title string, p_open *bool) {
+	log.DrawContext(GImGui, title, p_open)
+}
+
+// DrawContext is Draw() for the context g.
+func (log *ExampleAppLog) DrawContext(g *ImGuiContext, title string, p_open *bool) {
+	if !g.Begin(title, p_open, 0) {
+		g.End()
+		return
+	}
+
+	// Options menu
+	if g.BeginPopup("Options", 0) {
+		g.Checkbox("Auto-scroll", &log.AutoScroll)
+		g.EndPopup()
+	}
+
+	// Main window
+	if g.Button("Options") {
+		g.OpenPopup("Options", 0)
+	}
+	g.SameLine(0, -1)
+	var clear = g.Button("Clear")
+	g.SameLine(0, -1)
+	var copy = g.Button("Copy")
+	g.SameLine(0, -1)
+	log.Filter.DrawContext(g, "Filter", -100.0)
+
+	g.Separator()
+	g.BeginChild("scrolling", ImVec2{0, 0}, false, ImGuiWindowFlags_HorizontalScrollbar)
+
+	if clear {
+		log.Clear()
+	}
+	if copy {
+		g.LogToClipboard(-1)
+	}
+
+	g.PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{0, 0})
+	if log.Filter.IsActive() {
+		// In this example we don't use the clipper when Filter is enabled.
+		// This is because we don't have a random access on the result on our filter.
+		// A real application processing logs with ten of thousands of entries may want to store the result of
+		// search/filter.. especially if the filtering function is not trivial (e.g. reg-exp).
+		for line_no := int(0); line_no < int(len(log.LineOffsets)); line_no++ {
+			var line = log.line(line_no)
+			if log.Filter.PassFilter(line) {
+				g.TextUnformatted(line)
+			}
+		}
+	} else {
+		// The simplest and easy way to display the entire buffer:
+		//   TextUnformatted(string(log.Buf))
+		// And it'll just work. TextUnformatted() has specialization for large blob of text and will fast-forward
+		// to skip non-visible lines. Here we instead demonstrate using the clipper to only process lines that are
+		// within the visible area.
+		// If you have tens of thousands of items and their processing cost is non-negligible, coarse clipping them
+		// on your side is recommended. Using ImGuiListClipper requires
+		// - A) random access into your data
+		// - B) items all being the  same height,
+		// both of which we can handle since we an array pointing to the beginning of each line of text.
+		// When using the filter (in the block of code above) we don't have random access into the data to display
+		// anymore, which is why we don't use the clipper. Storing or skimming through the search result would make
+		// it possible (and would be recommended if you want to search through tens of thousands of entries).
+		var clipper = ImGuiListClipper{Ctx: g}
+		clipper.Begin(int(len(log.LineOffsets)), -1)
+		for clipper.Step() {
+			for line_no := clipper.DisplayStart; line_no < clipper.DisplayEnd; line_no++ {
+				g.TextUnformatted(log.line(line_no))
+			}
+		}
+		clipper.End()
+	}
+	g.PopStyleVar(1)
+
+	if log.AutoScroll && g.GetScrollY() >= g.GetScrollMaxY() {
+		g.SetScrollHereY(1.0)
+	}
+
+	g.EndChild()
+	g.End()
+}
+
+var exampleAppLogState struct {
+	log     *ExampleAppLog
+	counter int
+}
+
+// ShowExampleAppLog Demonstrate creating a simple log window with basic filtering.
+func (g *ImGuiContext) ShowExampleAppLog(p_open *bool) {
+	var state = &exampleAppLogState
+	if state.log == nil {
+		state.log = NewExampleAppLog()
+	}
+
+	// For the demo: add a debug button _BEFORE_ the normal log window contents
+	// We take advantage of a rarely used feature: multiple calls to Begin()/End() are appending to the _same_ window.
+	// Most of the contents of the window will be added by the log.Draw() call.
+	g.SetNextWindowSize(&ImVec2{500, 400}, ImGuiCond_FirstUseEver)
+	g.Begin("Example: Log", p_open, 0)
+	if g.SmallButton("[Debug] Add 5 entries") {
+		var categories = [3]string{"info", "warn", "error"}
+		var words = []string{"Bumfuzzled", "Cattywampus", "Snickersnee", "Abibliophobia", "Absquatulate", "Nincompoop", "Pauciloquent"}
+		for n := 0; n < 5; n++ {
+			var category = categories[state.counter%int(len(categories))]
+			var word = words[state.counter%int(len(words))]
+			state.log.AddLog("[%05d] [%s] Hello, current time is %.1f, here's a word: '%s'\n",
+				g.GetFrameCount(), category, g.GetTime(), word)
+			state.counter++
+		}
+	}
+	g.End()
+
+	// Actually call in the regular Log helper (which will Begin() into the same window as we just did)
+	state.log.DrawContext(g, "Example: Log", p_open)
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Simple Layout / ShowExampleAppLayout()
+//-----------------------------------------------------------------------------
+
+var exampleAppLayoutState struct {
+	selected int
+}
+
+// ShowExampleAppLayout Demonstrate create a window with multiple child windows.
+func (g *ImGuiContext) ShowExampleAppLayout(p_open *bool) {
+	g.SetNextWindowSize(&ImVec2{500, 440}, ImGuiCond_FirstUseEver)
+	if g.Begin("Example: Simple layout", p_open, ImGuiWindowFlags_MenuBar) {
+		if g.BeginMenuBar() {
+			if g.BeginMenu("File", true) {
+				if g.MenuItem("Close", "", nil, true) {
+					*p_open = false
+				}
+				g.EndMenu()
+			}
+			g.EndMenuBar()
+		}
+
+		// Left
+		var selected = &exampleAppLayoutState.selected
+		{
+			g.BeginChild("left pane", ImVec2{150, 0}, true, 0)
+			for i := int(0); i < 100; i++ {
+				// FIXME: Good candidate to use ImGuiSelectableFlags_SelectOnNav
+				var label = fmt.Sprintf("MyObject %d", i)
+				if g.Selectable(label, *selected == i, 0, ImVec2{}) {
+					*selected = i
+				}
+			}
+			g.EndChild()
+		}
+		g.SameLine(0, -1)
+
+		// Right
+		{
+			g.BeginGroup()
+			g.BeginChild("item view", ImVec2{0, -g.GetFrameHeightWithSpacing()}, false, 0) // Leave room for 1 line below us
+			g.Text("MyObject: %d", *selected)
+			g.Separator()
+			if g.BeginTabBar("##Tabs", ImGuiTabBarFlags_None) {
+				if g.BeginTabItem("Description", nil, 0) {
+					g.TextWrapped("Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua. ")
+					g.EndTabItem()
+				}
+				if g.BeginTabItem("Details", nil, 0) {
+					g.Text("ID: 0123456789")
+					g.EndTabItem()
+				}
+				g.EndTabBar()
+			}
+			g.EndChild()
+			if g.Button("Revert") {
+			}
+			g.SameLine(0, -1)
+			if g.Button("Save") {
+			}
+			g.EndGroup()
+		}
+	}
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Property Editor / ShowExampleAppPropertyEditor()
+//-----------------------------------------------------------------------------
+
+var placeholderMembers = [8]float{0.0, 0.0, 1.0, 3.1416, 100.0, 999.0}
+
+func (g *ImGuiContext) ShowPlaceholderObject(prefix string, uid int) {
+	// Use object uid as identifier. Most commonly you could also use the object pointer as a base ID.
+	g.PushID(uid)
+
+	// Text and Tree nodes are less high than framed widgets, using AlignTextToFramePadding() we add vertical spacing to make the tree lines equal high.
+	g.TableNextRow(0, 0)
+	g.TableSetColumnIndex(0)
+	g.AlignTextToFramePadding()
+	var node_open = g.TreeNodeF("Object", "%s_%d", prefix, uid)
+	g.TableSetColumnIndex(1)
+	g.Text("my sailor is rich")
+
+	if node_open {
+		for i := int(0); i < 8; i++ {
+			g.PushID(i) // Use field index as identifier.
+			if i < 2 {
+				g.ShowPlaceholderObject("Child", 424242)
+			} else {
+				// Here we use a TreeNode to highlight on hover (we could use e.g. Selectable as well)
+				g.TableNextRow(0, 0)
+				g.TableSetColumnIndex(0)
+				g.AlignTextToFramePadding()
+				var flags = ImGuiTreeNodeFlags_Leaf | ImGuiTreeNodeFlags_NoTreePushOnOpen | ImGuiTreeNodeFlags_Bullet
+				g.TreeNodeEx("Field", flags, "Field_%d", i)
+
+				g.TableSetColumnIndex(1)
+				g.SetNextItemWidth(-FLT_MIN)
+				if i >= 5 {
+					g.InputFloat("##value", &placeholderMembers[i], 1.0, 0, "%.3f", 0)
+				} else {
+					g.DragFloat("##value", &placeholderMembers[i], 0.01, 0, 0, "%.3f", 0)
+				}
+				g.NextColumn()
+			}
+			g.PopID()
+		}
+		g.TreePop()
+	}
+	g.PopID()
+}
+
+// ShowExampleAppPropertyEditor Demonstrate create a simple property editor.
+func (g *ImGuiContext) ShowExampleAppPropertyEditor(p_open *bool) {
+	g.SetNextWindowSize(&ImVec2{430, 450}, ImGuiCond_FirstUseEver)
+	if !g.Begin("Example: Property editor", p_open, 0) {
+		g.End()
+		return
+	}
+
+	g.HelpMarker(
+		"This example shows how you may implement a property editor using two columns.\n" +
+			"All objects/fields data are dummies here.\n" +
+			"Remember that in many simple cases, you can use SameLine(xxx) to position\n" +
+			"your cursor horizontally instead of using the Columns() API.")
+
+	g.PushStyleVec(ImGuiStyleVar_FramePadding, ImVec2{2, 2})
+	if g.BeginTable("split", 2, ImGuiTableFlags_BordersOuter|ImGuiTableFlags_Resizable, ImVec2{}, 0) {
+		// Iterate placeholder objects (all the same data)
+		for obj_i := int(0); obj_i < 4; obj_i++ {
+			g.ShowPlaceholderObject("Object", obj_i)
+			//Separator()
+		}
+		g.EndTable()
+	}
+	g.PopStyleVar(1)
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Long Text / ShowExampleAppLongText()
+//-----------------------------------------------------------------------------
+
+var exampleAppLongTextState struct {
+	test_type int
+	log       ImGuiTextBuffer
+	lines     int
+}
+
+// ShowExampleAppLongText Demonstrate/test rendering huge amount of text, and the incidence of clipping.
+func (g *ImGuiContext) ShowExampleAppLongText(p_open *bool) {
+	g.SetNextWindowSize(&ImVec2{520, 600}, ImGuiCond_FirstUseEver)
+	if !g.Begin("Example: Long text display", p_open, 0) {
+		g.End()
+		return
+	}
+
+	var state = &exampleAppLongTextState
+	g.Text("Printing unusually long amount of text.")
+	var test_types = []string{
+		"Single call to TextUnformatted()",
+		"Multiple calls to Text(), clipped",
+		"Multiple calls to Text(), not clipped (slow)",
+	}
+	g.Combo("Test type", &state.test_type, test_types, int(len(test_types)), -1)
+	g.Text("Buffer contents: %d lines, %d bytes", state.lines, len(state.log))
+	if g.Button("Clear") {
+		state.log = state.log[:0]
+		state.lines = 0
+	}
+	g.SameLine(0, -1)
+	if g.Button("Add 1000 lines") {
+		for i := int(0); i < 1000; i++ {
+			state.log = append(state.log, fmt.Sprintf("%d The quick brown fox jumps over the lazy dog\n", state.lines+i)...)
+		}
+		state.lines += 1000
+	}
+	g.BeginChild("Log", ImVec2{}, false, 0)
+	switch state.test_type {
+	case 0:
+		// Single call to TextUnformatted() with a big buffer
+		g.TextUnformatted(string(state.log))
+	case 1:
+		// Multiple calls to Text(), manually coarsely clipped - demonstrate how to use the ImGuiListClipper helper.
+		g.PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{0, 0})
+		var clipper = ImGuiListClipper{Ctx: g}
+		clipper.Begin(state.lines, -1)
+		for clipper.Step() {
+			for i := clipper.DisplayStart; i < clipper.DisplayEnd; i++ {
+				g.Text("%d The quick brown fox jumps over the lazy dog", i)
+			}
+		}
+		g.PopStyleVar(1)
+	case 2:
+		// Multiple calls to Text(), not clipped (slow)
+		g.PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{0, 0})
+		for i := int(0); i < state.lines; i++ {
+			g.Text("%d The quick brown fox jumps over the lazy dog", i)
+		}
+		g.PopStyleVar(1)
+	}
+	g.EndChild()
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Auto Resize / ShowExampleAppAutoResize()
+//-----------------------------------------------------------------------------
+
+var exampleAppAutoResizeState struct {
+	lines int
+}
+
+func init() {
+	exampleAppAutoResizeState.lines = 10
+}
+
+// ShowExampleAppAutoResize Demonstrate creating a window which gets auto-resized according to its content.
+func (g *ImGuiContext) ShowExampleAppAutoResize(p_open *bool) {
+	if !g.Begin("Example: Auto-resizing window", p_open, ImGuiWindowFlags_AlwaysAutoResize) {
+		g.End()
+		return
+	}
+
+	var lines = &exampleAppAutoResizeState.lines
+	g.TextUnformatted(
+		"Window will resize every-frame to the size of its content.\n" +
+			"Note that you probably don't want to query the window size to\n" +
+			"output your content because that would create a feedback loop.")
+	g.SliderInt("Number of lines", lines, 1, 20, "%d", 0)
+	for i := int(0); i < *lines; i++ {
+		g.Text("%*sThis is line %d", i*4, "", i) // Pad with space to extend size horizontally
+	}
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Constrained Resize / ShowExampleAppConstrainedResize()
+//-----------------------------------------------------------------------------
+
+var exampleAppConstrainedResizeState struct {
+	auto_resize   bool
+	type_         int
+	display_lines int
+}
+
+func init() {
+	exampleAppConstrainedResizeState.display_lines = 10
+}
+
+// Helper functions to demonstrate programmatic constraints
+func customConstraintsSquare(data *ImGuiSizeCallbackData) {
+	var size = ImMax(data.DesiredSize.x, data.DesiredSize.y)
+	data.DesiredSize.x = size
+	data.DesiredSize.y = size
+}
+
+func customConstraintsStep(data *ImGuiSizeCallbackData) {
+	var step = data.UserData.(float)
+	data.DesiredSize = ImVec2{(float)((int)(data.DesiredSize.x/step+0.5)) * step, (float)((int)(data.DesiredSize.y/step+0.5)) * step}
+}
+
+// ShowExampleAppConstrainedResize Demonstrate creating a window with custom resize constraints.
+func (g *ImGuiContext) ShowExampleAppConstrainedResize(p_open *bool) {
+	var test_desc = []string{
+		"Resize vertical only",
+		"Resize horizontal only",
+		"Width > 100, Height > 100",
+		"Width 400-500",
+		"Height 400-500",
+		"Custom: Always Square",
+		"Custom: Fixed Steps (100)",
+	}
+
+	var state = &exampleAppConstrainedResizeState
+	switch state.type_ {
+	case 0:
+		g.SetNextWindowSizeConstraints(ImVec2{-1, 0}, ImVec2{-1, FLT_MAX}, nil, nil) // Vertical only
+	case 1:
+		g.SetNextWindowSizeConstraints(ImVec2{0, -1}, ImVec2{FLT_MAX, -1}, nil, nil) // Horizontal only
+	case 2:
+		g.SetNextWindowSizeConstraints(ImVec2{100, 100}, ImVec2{FLT_MAX, FLT_MAX}, nil, nil) // Width > 100, Height > 100
+	case 3:
+		g.SetNextWindowSizeConstraints(ImVec2{400, -1}, ImVec2{500, -1}, nil, nil) // Width 400-500
+	case 4:
+		g.SetNextWindowSizeConstraints(ImVec2{-1, 400}, ImVec2{-1, 500}, nil, nil) // Height 400-500
+	case 5:
+		g.SetNextWindowSizeConstraints(ImVec2{0, 0}, ImVec2{FLT_MAX, FLT_MAX}, customConstraintsSquare, nil) // Always Square
+	case 6:
+		g.SetNextWindowSizeConstraints(ImVec2{0, 0}, ImVec2{FLT_MAX, FLT_MAX}, customConstraintsStep, float(100)) // Fixed Step
+	}
+
+	var flags ImGuiWindowFlags
+	if state.auto_resize {
+		flags = ImGuiWindowFlags_AlwaysAutoResize
+	}
+	if g.Begin("Example: Constrained Resize", p_open, flags) {
+		if g.Button("200x200") {
+			g.SetWindowSize(ImVec2{200, 200}, 0)
+		}
+		g.SameLine(0, -1)
+		if g.Button("500x500") {
+			g.SetWindowSize(ImVec2{500, 500}, 0)
+		}
+		g.SameLine(0, -1)
+		if g.Button("800x200") {
+			g.SetWindowSize(ImVec2{800, 200}, 0)
+		}
+		g.SetNextItemWidth(200)
+		g.Combo("Constraint", &state.type_, test_desc, int(len(test_desc)), -1)
+		g.SetNextItemWidth(200)
+		g.DragInt("Lines", &state.display_lines, 0.2, 1, 100, "%d", 0)
+		g.Checkbox("Auto-resize", &state.auto_resize)
+		for i := int(0); i < state.display_lines; i++ {
+			g.Text("%*sHello, sailor! Making this line long enough for the example.", i*4, "")
+		}
+	}
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Simple overlay / ShowExampleAppSimpleOverlay()
+//-----------------------------------------------------------------------------
+
+var exampleAppSimpleOverlayState struct {
+	corner int
+}
+
+// ShowExampleAppSimpleOverlay Demonstrate creating a simple static window with no decoration
+// + a context-menu to choose which corner of the screen to use.
+func (g *ImGuiContext) ShowExampleAppSimpleOverlay(p_open *bool) {
+	var corner = &exampleAppSimpleOverlayState.corner
+	var io = g.GetIO()
+	var window_flags = ImGuiWindowFlags_NoDecoration | ImGuiWindowFlags_AlwaysAutoResize | ImGuiWindowFlags_NoSavedSettings | ImGuiWindowFlags_NoFocusOnAppearing | ImGuiWindowFlags_NoNav
+	if *corner != -1 {
+		const PAD = 10.0
+		var viewport = g.GetMainViewport()
+		var work_pos = viewport.WorkPos // Use work area to avoid menu-bar/task-bar, if any!
+		var work_size = viewport.WorkSize
+		var window_pos, window_pos_pivot ImVec2
+		if *corner&1 != 0 {
+			window_pos.x = work_pos.x + work_size.x - PAD
+			window_pos_pivot.x = 1.0
+		} else {
+			window_pos.x = work_pos.x + PAD
+		}
+		if *corner&2 != 0 {
+			window_pos.y = work_pos.y + work_size.y - PAD
+			window_pos_pivot.y = 1.0
+		} else {
+			window_pos.y = work_pos.y + PAD
+		}
+		g.SetNextWindowPos(&window_pos, ImGuiCond_Always, window_pos_pivot)
+		window_flags |= ImGuiWindowFlags_NoMove
+	}
+	g.SetNextWindowBgAlpha(0.35) // Transparent background
+	if g.Begin("Example: Simple overlay", p_open, window_flags) {
+		g.Text("Simple overlay\n" + "in the corner of the screen.\n" + "(right-click to change position)")
+		g.Separator()
+		if g.IsMousePosValid(nil) {
+			g.Text("Mouse Position: (%.1f,%.1f)", io.MousePos.x, io.MousePos.y)
+		} else {
+			g.Text("Mouse Position: <invalid>")
+		}
+		if g.BeginPopupContextWindow("", ImGuiPopupFlags_MouseButtonRight) {
+			var corners = []string{"Custom", "Top-left", "Top-right", "Bottom-left", "Bottom-right"}
+			for i, name := range corners {
+				var n = int(i) - 1
+				var selected = *corner == n
+				if g.MenuItem(name, "", &selected, true) {
+					*corner = n
+				}
+			}
+			if p_open != nil && g.MenuItem("Close", "", nil, true) {
+				*p_open = false
+			}
+			g.EndPopup()
+		}
+	}
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Fullscreen window / ShowExampleAppFullscreen()
+//-----------------------------------------------------------------------------
+
+var exampleAppFullscreenState struct {
+	use_work_area bool
+	flags         ImGuiWindowFlags
+}
+
+func init() {
+	exampleAppFullscreenState.use_work_area = true
+	exampleAppFullscreenState.flags = ImGuiWindowFlags_NoDecoration | ImGuiWindowFlags_NoMove | ImGuiWindowFlags_NoResize | ImGuiWindowFlags_NoSavedSettings
+}
+
+// ShowExampleAppFullscreen Demonstrate creating a window covering the entire screen/viewport
+func (g *ImGuiContext) ShowExampleAppFullscreen(p_open *bool) {
+	var state = &exampleAppFullscreenState
+
+	// We demonstrate using the full viewport area or the work area (without menu-bars, task-bars etc.)
+	// Based on your use case you may want one of the other.
+	var viewport = g.GetMainViewport()
+	if state.use_work_area {
+		g.SetNextWindowPos(&viewport.WorkPos, 0, ImVec2{})
+		g.SetNextWindowSize(&viewport.WorkSize, 0)
+	} else {
+		g.SetNextWindowPos(&viewport.Pos, 0, ImVec2{})
+		g.SetNextWindowSize(&viewport.Size, 0)
+	}
+
+	if g.Begin("Example: Fullscreen window", p_open, state.flags) {
+		g.Checkbox("Use work area instead of main area", &state.use_work_area)
+		g.SameLine(0, -1)
+		g.HelpMarker("Main Area = entire viewport,\nWork Area = entire viewport minus sections used by the main menu bars, task bars etc.\n\nEnable the main-menu bar in Examples menu to see the difference.")
+
+		var flags = (*int32)(&state.flags)
+		g.CheckboxFlagsInt("ImGuiWindowFlags_NoBackground", flags, int32(ImGuiWindowFlags_NoBackground))
+		g.CheckboxFlagsInt("ImGuiWindowFlags_NoDecoration", flags, int32(ImGuiWindowFlags_NoDecoration))
+		g.Indent(0)
+		g.CheckboxFlagsInt("ImGuiWindowFlags_NoTitleBar", flags, int32(ImGuiWindowFlags_NoTitleBar))
+		g.CheckboxFlagsInt("ImGuiWindowFlags_NoCollapse", flags, int32(ImGuiWindowFlags_NoCollapse))
+		g.CheckboxFlagsInt("ImGuiWindowFlags_NoScrollbar", flags, int32(ImGuiWindowFlags_NoScrollbar))
+		g.Unindent(0)
+
+		if p_open != nil && g.Button("Close this window") {
+			*p_open = false
+		}
+	}
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Manipulating Window Titles / ShowExampleAppWindowTitles()
+//-----------------------------------------------------------------------------
+
+// ShowExampleAppWindowTitles Demonstrate using "##" and "###" in identifiers to manipulate ID generation.
+// This apply to all regular items as well.
+// Read FAQ section "How can I have multiple widgets with the same label?" for details.
+func (g *ImGuiContext) ShowExampleAppWindowTitles(p_open *bool) {
+	var viewport = g.GetMainViewport()
+	var base_pos = viewport.Pos
+
+	// By default, Windows are uniquely identified by their title.
+	// You can use the "##" and "###" markers to manipulate the display/ID.
+
+	// Using "##" to display same title but have unique identifier.
+	g.SetNextWindowPos(&ImVec2{base_pos.x + 100, base_pos.y + 100}, ImGuiCond_FirstUseEver, ImVec2{})
+	g.Begin("Same title as another window##1", nil, 0)
+	g.Text("This is window 1.\nMy title is the same as window 2, but my identifier is unique.")
+	g.End()
+
+	g.SetNextWindowPos(&ImVec2{base_pos.x + 100, base_pos.y + 200}, ImGuiCond_FirstUseEver, ImVec2{})
+	g.Begin("Same title as another window##2", nil, 0)
+	g.Text("This is window 2.\nMy title is the same as window 1, but my identifier is unique.")
+	g.End()
+
+	// Using "###" to display a changing title but keep a static identifier "AnimatedTitle"
+	var buf = fmt.Sprintf("Animated title %c %d###AnimatedTitle", "|/-\\"[(int)(g.GetTime()/0.25)&3], g.GetFrameCount())
+	g.SetNextWindowPos(&ImVec2{base_pos.x + 100, base_pos.y + 300}, ImGuiCond_FirstUseEver, ImVec2{})
+	g.Begin(buf, nil, 0)
+	g.Text("This window has a changing title.")
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Custom Rendering using ImDrawList API / ShowExampleAppCustomRendering()
+//-----------------------------------------------------------------------------
+
+var exampleAppCustomRenderingState struct {
+	sz                         float
+	thickness                  float
+	ngon_sides                 int
+	circle_segments_override   bool
+	circle_segments_override_v int
+	curve_segments_override    bool
+	curve_segments_override_v  int
+	colf                       [4]float
+
+	points                  []ImVec2
+	scrolling               ImVec2
+	opt_enable_grid         bool
+	opt_enable_context_menu bool
+	adding_line             bool
+
+	draw_bg bool
+	draw_fg bool
+}
+
+func init() {
+	var state = &exampleAppCustomRenderingState
+	state.sz = 36.0
+	state.thickness = 3.0
+	state.ngon_sides = 6
+	state.circle_segments_override_v = 12
+	state.curve_segments_override_v = 8
+	state.colf = [4]float{1.0, 1.0, 0.4, 1.0}
+	state.opt_enable_grid = true
+	state.opt_enable_context_menu = true
+	state.draw_bg = true
+	state.draw_fg = true
+}
+
+// ShowExampleAppCustomRendering Demonstrate using the low-level ImDrawList to draw custom shapes.
+func (g *ImGuiContext) ShowExampleAppCustomRendering(p_open *bool) {
+	if !g.Begin("Example: Custom rendering", p_open, 0) {
+		g.End()
+		return
+	}
+
+	var state = &exampleAppCustomRenderingState
+
+	if g.BeginTabBar("##TabBar", 0) {
+		if g.BeginTabItem("Primitives", nil, 0) {
+			g.PushItemWidth(-g.GetFontSize() * 15)
+			var draw_list = g.GetWindowDrawList()
+
+			// Draw gradients
+			// (note that those are currently exacerbating our sRGB/Linear issues)
+			// Calling GetColorU32FromInt() multiplies the given colors by the current Style Alpha, but you may pass the IM_COL32() directly as well..
+			g.Text("Gradients")
+			var gradient_size = ImVec2{g.CalcItemWidth(), g.GetFrameHeight()}
+			{
+				var p0 = g.GetCursorScreenPos()
+				var p1 = ImVec2{p0.x + gradient_size.x, p0.y + gradient_size.y}
+				var col_a = g.GetColorU32FromInt(IM_COL32(0, 0, 0, 255))
+				var col_b = g.GetColorU32FromInt(IM_COL32(255, 255, 255, 255))
+				draw_list.AddRectFilledMultiColor(p0, p1, col_a, col_b, col_b, col_a)
+				g.InvisibleButton("##gradient1", gradient_size, 0)
+			}
+			{
+				var p0 = g.GetCursorScreenPos()
+				var p1 = ImVec2{p0.x + gradient_size.x, p0.y + gradient_size.y}
+				var col_a = g.GetColorU32FromInt(IM_COL32(0, 255, 0, 255))
+				var col_b = g.GetColorU32FromInt(IM_COL32(255, 0, 0, 255))
+				draw_list.AddRectFilledMultiColor(p0, p1, col_a, col_b, col_b, col_a)
+				g.InvisibleButton("##gradient2", gradient_size, 0)
+			}
+
+			// Draw a bunch of primitives
+			g.Text("All primitives")
+			g.DragFloat("Size", &state.sz, 0.2, 2.0, 100.0, "%.0f", 0)
+			g.DragFloat("Thickness", &state.thickness, 0.05, 1.0, 8.0, "%.02f", 0)
+			g.SliderInt("N-gon sides", &state.ngon_sides, 3, 12, "%d", 0)
+			g.Checkbox("##circlesegmentoverride", &state.circle_segments_override)
+			g.SameLine(0.0, g.GetStyle().ItemInnerSpacing.x)
+			if g.SliderInt("Circle segments override", &state.circle_segments_override_v, 3, 40, "%d", 0) {
+				state.circle_segments_override = true
+			}
+			g.Checkbox("##curvessegmentoverride", &state.curve_segments_override)
+			g.SameLine(0.0, g.GetStyle().ItemInnerSpacing.x)
+			if g.SliderInt("Curves segments override", &state.curve_segments_override_v, 3, 40, "%d", 0) {
+				state.curve_segments_override = true
+			}
+			g.ColorEdit4("Color", &state.colf, 0)
+
+			var sz = state.sz
+			var thickness = state.thickness
+			var p = g.GetCursorScreenPos()
+			var col = ColorConvertFloat4ToU32(ImVec4{state.colf[0], state.colf[1], state.colf[2], state.colf[3]})
+			const spacing = 10.0
+			const corners_tl_br = ImDrawFlags_RoundCornersTopLeft | ImDrawFlags_RoundCornersBottomRight
+			var rounding = sz / 5.0
+			var circle_segments int
+			if state.circle_segments_override {
+				circle_segments = state.circle_segments_override_v
+			}
+			var curve_segments int
+			if state.curve_segments_override {
+				curve_segments = state.curve_segments_override_v
+			}
+			var x = p.x + 4.0
+			var y = p.y + 4.0
+			for n := 0; n < 2; n++ {
+				// First line uses a thickness of 1.0f, second line uses the configurable thickness
+				var th float = 1.0
+				if n != 0 {
+					th = thickness
+				}
+				draw_list.AddNgon(ImVec2{x + sz*0.5, y + sz*0.5}, sz*0.5, col, state.ngon_sides, th) // N-gon
+				x += sz + spacing
+				draw_list.AddCircle(ImVec2{x + sz*0.5, y + sz*0.5}, sz*0.5, col, circle_segments, th) // Circle
+				x += sz + spacing
+				draw_list.AddRect(ImVec2{x, y}, ImVec2{x + sz, y + sz}, col, 0.0, ImDrawFlags_None, th) // Square
+				x += sz + spacing
+				draw_list.AddRect(ImVec2{x, y}, ImVec2{x + sz, y + sz}, col, rounding, ImDrawFlags_None, th) // Square with all rounded corners
+				x += sz + spacing
+				draw_list.AddRect(ImVec2{x, y}, ImVec2{x + sz, y + sz}, col, rounding, corners_tl_br, th) // Square with two rounded corners
+				x += sz + spacing
+				draw_list.AddTriangle(&ImVec2{x + sz*0.5, y}, &ImVec2{x + sz, y + sz - 0.5}, ImVec2{x, y + sz - 0.5}, col, th) // Triangle
+				x += sz + spacing
+				//draw_list.AddTriangle(&ImVec2{x + sz*0.2, y}, &ImVec2{x, y + sz - 0.5}, ImVec2{x + sz*0.4, y + sz - 0.5}, col, th) // Thin triangle
+				//x += sz*0.4 + spacing
+				draw_list.AddLine(&ImVec2{x, y}, &ImVec2{x + sz, y}, col, th) // Horizontal line (note: drawing a filled rectangle will be faster!)
+				x += sz + spacing
+				draw_list.AddLine(&ImVec2{x, y}, &ImVec2{x, y + sz}, col, th) // Vertical line (note: drawing a filled rectangle will be faster!)
+				x += spacing
+				draw_list.AddLine(&ImVec2{x, y}, &ImVec2{x + sz, y + sz}, col, th) // Diagonal line
+				x += sz + spacing
+
+				// Quadratic Bezier Curve (3 control points)
+				var cp3 = [3]ImVec2{{x, y + sz*0.6}, {x + sz*0.5, y - sz*0.4}, {x + sz, y + sz}}
+				draw_list.AddBezierQuadratic(&cp3[0], &cp3[1], cp3[2], col, th, curve_segments)
+				x += sz + spacing
+
+				// Cubic Bezier Curve (4 control points)
+				var cp4 = [4]ImVec2{{x, y}, {x + sz*1.3, y + sz*0.3}, {x + sz - sz*1.3, y + sz - sz*0.3}, {x + sz, y + sz}}
+				draw_list.AddBezierCubic(&cp4[0], &cp4[1], cp4[2], cp4[3], col, th, curve_segments)
+
+				x = p.x + 4
+				y += sz + spacing
+			}
+			draw_list.AddNgonFilled(ImVec2{x + sz*0.5, y + sz*0.5}, sz*0.5, col, state.ngon_sides) // N-gon
+			x += sz + spacing
+			draw_list.AddCircleFilled(ImVec2{x + sz*0.5, y + sz*0.5}, sz*0.5, col, circle_segments) // Circle
+			x += sz + spacing
+			draw_list.AddRectFilled(ImVec2{x, y}, ImVec2{x + sz, y + sz}, col, 0, 0) // Square
+			x += sz + spacing
+			draw_list.AddRectFilled(ImVec2{x, y}, ImVec2{x + sz, y + sz}, col, 10.0, 0) // Square with all rounded corners
+			x += sz + spacing
+			draw_list.AddRectFilled(ImVec2{x, y}, ImVec2{x + sz, y + sz}, col, 10.0, corners_tl_br) // Square with two rounded corners
+			x += sz + spacing
+			draw_list.AddTriangleFilled(&ImVec2{x + sz*0.5, y}, &ImVec2{x + sz, y + sz - 0.5}, ImVec2{x, y + sz - 0.5}, col) // Triangle
+			x += sz + spacing
+			//draw_list.AddTriangleFilled(&ImVec2{x + sz*0.2, y}, &ImVec2{x, y + sz - 0.5}, ImVec2{x + sz*0.4, y + sz - 0.5}, col) // Thin triangle
+			//x += sz*0.4 + spacing
+			draw_list.AddRectFilled(ImVec2{x, y}, ImVec2{x + sz, y + thickness}, col, 0, 0) // Horizontal line (faster than AddLine, but only handle integer thickness)
+			x += sz + spacing
+			draw_list.AddRectFilled(ImVec2{x, y}, ImVec2{x + thickness, y + sz}, col, 0, 0) // Vertical line (faster than AddLine, but only handle integer thickness)
+			x += spacing * 2.0
+			draw_list.AddRectFilled(ImVec2{x, y}, ImVec2{x + 1, y + 1}, col, 0, 0) // Pixel (faster than AddLine)
+			x += sz
+			draw_list.AddRectFilledMultiColor(ImVec2{x, y}, ImVec2{x + sz, y + sz}, IM_COL32(0, 0, 0, 255), IM_COL32(255, 0, 0, 255), IM_COL32(255, 255, 0, 255), IM_COL32(0, 255, 0, 255))
+
+			g.Dummy(ImVec2{(sz + spacing) * 10.2, (sz + spacing) * 3.0})
+			g.PopItemWidth()
+			g.EndTabItem()
+		}
+
+		if g.BeginTabItem("Canvas", nil, 0) {
+			g.Checkbox("Enable grid", &state.opt_enable_grid)
+			g.Checkbox("Enable context menu", &state.opt_enable_context_menu)
+			g.Text("Mouse Left: drag to add lines,\nMouse Right: drag to scroll, click for context menu.")
+
+			// Typically you would use a BeginChild()/EndChild() pair to benefit from a clipping region + own scrolling.
+			// Here we demonstrate that this can be replaced by simple offsetting + custom drawing + PushClipRect/PopClipRect() calls.
+			// To use a child window instead we could use, e.g:
+			//      PushStyleVec(ImGuiStyleVar_WindowPadding, ImVec2{0, 0})      // Disable padding
+			//      PushStyleColorInt(ImGuiCol_ChildBg, IM_COL32(50, 50, 50, 255)) // Set a background color
+			//      BeginChild("canvas", ImVec2{0.0, 0.0}, true, ImGuiWindowFlags_NoMove)
+			//      PopStyleColor(1)
+			//      PopStyleVar(1)
+			//      [...]
+			//      EndChild()
+
+			// Using InvisibleButton() as a convenience 1) it will advance the layout cursor and 2) allows us to use IsItemHovered()/IsItemActive()
+			var canvas_p0 = g.GetCursorScreenPos()    // ImDrawList API uses screen coordinates!
+			var canvas_sz = g.GetContentRegionAvail() // Resize canvas to what's available
+			if canvas_sz.x < 50.0 {
+				canvas_sz.x = 50.0
+			}
+			if canvas_sz.y < 50.0 {
+				canvas_sz.y = 50.0
+			}
+			var canvas_p1 = ImVec2{canvas_p0.x + canvas_sz.x, canvas_p0.y + canvas_sz.y}
+
+			// Draw border and background color
+			var io = g.GetIO()
+			var draw_list = g.GetWindowDrawList()
+			draw_list.AddRectFilled(canvas_p0, canvas_p1, IM_COL32(50, 50, 50, 255), 0, 0)
+			draw_list.AddRect(canvas_p0, canvas_p1, IM_COL32(255, 255, 255, 255), 0, 0, 1)
+
+			// This will catch our interactions
+			g.InvisibleButton("canvas", canvas_sz, ImGuiButtonFlags_MouseButtonLeft|ImGuiButtonFlags_MouseButtonRight)
+			var is_hovered = g.IsItemHovered(0)                                                   // Hovered
+			var is_active = g.IsItemActive()                                                      // Held
+			var origin = ImVec2{canvas_p0.x + state.scrolling.x, canvas_p0.y + state.scrolling.y} // Lock scrolled origin
+			var mouse_pos_in_canvas = ImVec2{io.MousePos.x - origin.x, io.MousePos.y - origin.y}
+
+			// Add first and second point
+			if is_hovered && !state.adding_line && g.IsMouseClicked(ImGuiMouseButton_Left, false) {
+				state.points = append(state.points, mouse_pos_in_canvas, mouse_pos_in_canvas)
+				state.adding_line = true
+			}
+			if state.adding_line {
+				state.points[len(state.points)-1] = mouse_pos_in_canvas
+				if !g.IsMouseDown(ImGuiMouseButton_Left) {
+					state.adding_line = false
+				}
+			}
+
+			// Pan (we use a zero mouse threshold when there's no context menu)
+			// You may decide to make that threshold dynamic based on whether the mouse is hovering something etc.
+			var mouse_threshold_for_pan float = -1.0
+			if !state.opt_enable_context_menu {
+				mouse_threshold_for_pan = 0.0
+			}
+			if is_active && g.IsMouseDragging(ImGuiMouseButton_Right, mouse_threshold_for_pan) {
+				state.scrolling.x += io.MouseDelta.x
+				state.scrolling.y += io.MouseDelta.y
+			}
+
+			// Context menu (under default mouse threshold)
+			var drag_delta = g.GetMouseDragDelta(ImGuiMouseButton_Right, -1.0)
+			if state.opt_enable_context_menu && g.IsMouseReleased(ImGuiMouseButton_Right) && drag_delta.x == 0.0 && drag_delta.y == 0.0 {
+				g.OpenPopupOnItemClick("context", ImGuiPopupFlags_MouseButtonRight)
+			}
+			if g.BeginPopup("context", 0) {
+				if state.adding_line {
+					state.points = state.points[:len(state.points)-2]
+				}
+				state.adding_line = false
+				if g.MenuItem("Remove one", "", nil, len(state.points) > 0) {
+					state.points = state.points[:len(state.points)-2]
+				}
+				if g.MenuItem("Remove all", "", nil, len(state.points) > 0) {
+					state.points = state.points[:0]
+				}
+				g.EndPopup()
+			}
+
+			// Draw grid + all lines in the canvas
+			draw_list.PushClipRect(canvas_p0, canvas_p1, true)
+			if state.opt_enable_grid {
+				const GRID_STEP = 64.0
+				for x := ImFmod(state.scrolling.x, GRID_STEP); x < canvas_sz.x; x += GRID_STEP {
+					draw_list.AddLine(&ImVec2{canvas_p0.x + x, canvas_p0.y}, &ImVec2{canvas_p0.x + x, canvas_p1.y}, IM_COL32(200, 200, 200, 40), 1.0)
+				}
+				for y := ImFmod(state.scrolling.y, GRID_STEP); y < canvas_sz.y; y += GRID_STEP {
+					draw_list.AddLine(&ImVec2{canvas_p0.x, canvas_p0.y + y}, &ImVec2{canvas_p1.x, canvas_p0.y + y}, IM_COL32(200, 200, 200, 40), 1.0)
+				}
+			}
+			for n := 0; n < len(state.points); n += 2 {
+				draw_list.AddLine(&ImVec2{origin.x + state.points[n].x, origin.y + state.points[n].y}, &ImVec2{origin.x + state.points[n+1].x, origin.y + state.points[n+1].y}, IM_COL32(255, 255, 0, 255), 2.0)
+			}
+			draw_list.PopClipRect()
+
+			g.EndTabItem()
+		}
+
+		if g.BeginTabItem("BG/FG draw lists", nil, 0) {
+			g.Checkbox("Draw in Background draw list", &state.draw_bg)
+			g.SameLine(0, -1)
+			g.HelpMarker("The Background draw list will be rendered below every Dear ImGui windows.")
+			g.Checkbox("Draw in Foreground draw list", &state.draw_fg)
+			g.SameLine(0, -1)
+			g.HelpMarker("The Foreground draw list will be rendered over every Dear ImGui windows.")
+			var window_pos = g.GetWindowPos()
+			var window_size = g.GetWindowSize()
+			var window_center = ImVec2{window_pos.x + window_size.x*0.5, window_pos.y + window_size.y*0.5}
+			if state.draw_bg {
+				g.GetBackgroundDrawList(nil).AddCircle(window_center, window_size.x*0.6, IM_COL32(255, 0, 0, 200), 0, 10+4)
+			}
+			if state.draw_fg {
+				g.GetForegroundDrawList(nil).AddCircle(window_center, window_size.y*0.6, IM_COL32(0, 255, 0, 200), 0, 10)
+			}
+			g.EndTabItem()
+		}
+
+		g.EndTabBar()
+	}
+
+	g.End()
+}
+
+//-----------------------------------------------------------------------------
+// [SECTION] Example App: Documents Handling / ShowExampleAppDocuments()
+//-----------------------------------------------------------------------------
+
+// MyDocument is a simplified structure to mimic a Document model
+type MyDocument struct {
+	Name      string // Document title
+	Open      bool   // Set when open (we keep an array of all available documents to simplify demo code!)
+	OpenPrev  bool   // Copy of Open from last update.
+	Dirty     bool   // Set when the document has been modified
+	WantClose bool   // Set when the document
+	Color     ImVec4 // An arbitrary variable associated to the document
+}
+
+func NewMyDocument(name string, open bool, color ImVec4) MyDocument {
+	return MyDocument{
+		Name:     name,
+		Open:     open,
+		OpenPrev: open,
+		Color:    color,
+	}
+}
+
+func (doc *MyDocument) DoOpen()       { doc.Open = true }
+func (doc *MyDocument) DoQueueClose() { doc.WantClose = true }
+func (doc *MyDocument) DoForceClose() { doc.Open = false; doc.Dirty = false }
+func (doc *MyDocument) DoSave()       { doc.Dirty = false }
+
+// DisplayContents Display placeholder contents for the Document
+func (doc *MyDocument) DisplayContents(g *ImGuiContext) {
+	g.PushInterface(doc)
+	g.Text("Document \"%s\"", doc.Name)
+	g.PushStyleColorVec(ImGuiCol_Text, &doc.Color)
+	g.TextWrapped("Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua.")
+	g.PopStyleColor(1)
+	if g.ButtonEx("Modify", &ImVec2{100, 0}, 0) {
+		doc.Dirty = true
+	}
+	g.SameLine(0, -1)
+	if g.ButtonEx("Save", &ImVec2{100, 0}, 0) {
+		doc.DoSave()
+	}
+	var col = [3]float{doc.Color.x, doc.Color.y, doc.Color.z}
+	if g.ColorEdit3("color", &col, 0) { // Useful to test drag and drop and hold-dragged-to-open-tab behavior.
+		doc.Color.x, doc.Color.y, doc.Color.z = col[0], col[1], col[2]
+	}
+	g.PopID()
+}
+
+// DisplayContextMenu Display context menu for the Document
+func (doc *MyDocument) DisplayContextMenu(g *ImGuiContext) {
+	if !g.BeginPopupContextItem("", ImGuiPopupFlags_MouseButtonRight) {
+		return
+	}
+
+	if g.MenuItem(fmt.Sprintf("Save %s", doc.Name), "CTRL+S", nil, doc.Open) {
+		doc.DoSave()
+	}
+	if g.MenuItem("Close", "CTRL+W", nil, doc.Open) {
+		doc.DoQueueClose()
+	}
+	g.EndPopup()
+}
+
+type ExampleAppDocuments struct {
+	Documents []MyDocument
+}
+
+func NewExampleAppDocuments() *ExampleAppDocuments {
+	return &ExampleAppDocuments{
+		Documents: []MyDocument{
+			NewMyDocument("Lettuce", true, ImVec4{0.4, 0.8, 0.4, 1.0}),
+			NewMyDocument("Eggplant", true, ImVec4{0.8, 0.5, 1.0, 1.0}),
+			NewMyDocument("Carrot", true, ImVec4{1.0, 0.8, 0.5, 1.0}),
+			NewMyDocument("Tomato", false, ImVec4{1.0, 0.3, 0.4, 1.0}),
+			NewMyDocument("A Rather Long Title", false, ImVec4{1.0, 1.0, 1.0, 1.0}),
+			NewMyDocument("Some Document", false, ImVec4{1.0, 1.0, 1.0, 1.0}),
+		},
+	}
+}
+
+// [Optional] Notify the system of Tabs/Windows closure that happened outside the regular tab interface.
+// If a tab has been closed programmatically (aka closed from another source such as the Checkbox() in the demo,
+// as opposed to clicking on the regular tab closing button) and stops being submitted, it will take a frame for
+// the tab bar to notice its absence. During this frame there will be a gap in the tab bar, and if the tab that has
+// disappeared was the selected one, the tab bar will report no selected tab during the frame. This will effectively
+// give the impression of a flicker for one frame.
+// We call SetTabItemClosed() to manually notify the Tab Bar or Docking system of removed tabs to avoid this glitch.
+// Note that this completely optional, and only affect tab bars with the ImGuiTabBarFlags_Reorderable flag.
+func (g *ImGuiContext) NotifyOfDocumentsClosedElsewhere(app *ExampleAppDocuments) {
+	for i := range app.Documents {
+		var doc = &app.Documents[i]
+		if !doc.Open && doc.OpenPrev {
+			g.SetTabItemClosed(doc.Name)
+		}
+		doc.OpenPrev = doc.Open
+	}
+}
+
+var exampleAppDocumentsState struct {
+	app *ExampleAppDocuments
+
+	// Options
+	opt_reorderable   bool
+	opt_fitting_flags ImGuiTabBarFlags
+
+	close_queue []*MyDocument
+}
+
+func init() {
+	exampleAppDocumentsState.opt_reorderable = true
+	exampleAppDocumentsState.opt_fitting_flags = ImGuiTabBarFlags_FittingPolicyDefault_
+}
+
+func (g *ImGuiContext) ShowExampleAppDocuments(p_open *bool) {
+	var state = &exampleAppDocumentsState
+	if state.app == nil {
+		state.app = NewExampleAppDocuments()
+	}
+	var app = state.app
+
+	var window_contents_visible = g.Begin("Example: Documents", p_open, ImGuiWindowFlags_MenuBar)
+	if !window_contents_visible {
+		g.End()
+		return
+	}
+
+	// Menu
+	if g.BeginMenuBar() {
+		if g.BeginMenu("File", true) {
+			var open_count int
+			for i := range app.Documents {
+				if app.Documents[i].Open {
+					open_count++
+				}
+			}
+
+			if g.BeginMenu("Open", open_count < int(len(app.Documents))) {
+				for i := range app.Documents {
+					var doc = &app.Documents[i]
+					if !doc.Open {
+						if g.MenuItem(doc.Name, "", nil, true) {
+							doc.DoOpen()
+						}
+					}
+				}
+				g.EndMenu()
+			}
+			if g.MenuItem("Close All Documents", "", nil, open_count > 0) {
+				for i := range app.Documents {
+					app.Documents[i].DoQueueClose()
+				}
+			}
+			g.MenuItem("Exit", "Alt+F4", nil, true)
+			g.EndMenu()
+		}
+		g.EndMenuBar()
+	}
+
+	// [Debug] List documents with one checkbox for each
+	for i := range app.Documents {
+		var doc = &app.Documents[i]
+		if i > 0 {
+			g.SameLine(0, -1)
+		}
+		g.PushInterface(doc)
+		if g.Checkbox(doc.Name, &doc.Open) {
+			if !doc.Open {
+				doc.DoForceClose()
+			}
+		}
+		g.PopID()
+	}
+
+	g.Separator()
+
+	// About the ImGuiWindowFlags_UnsavedDocument / ImGuiTabItemFlags_UnsavedDocument flags.
+	// They have multiple effects:
+	// - Display a dot next to the title.
+	// - Tab is selected when clicking the X close button.
+	// - Closure is not assumed (will wait for user to stop submitting the tab).
+	//   Otherwise closure is assumed when pressing the X, so if you keep submitting the tab may reappear at end of tab bar.
+	//   We need to assume closure by default otherwise waiting for "lack of submission" on the next frame would leave an empty
+	//   hole for one-frame, both in the tab-bar and in tab-contents when closing a tab/window.
+	//   The rarely used SetTabItemClosed() function is a way to notify of programmatic closure to avoid the one-frame hole.
+
+	// Submit Tab Bar and Tabs
+	{
+		var tab_bar_flags = state.opt_fitting_flags
+		if state.opt_reorderable {
+			tab_bar_flags |= ImGuiTabBarFlags_Reorderable
+		}
+		if g.BeginTabBar("##tabs", tab_bar_flags) {
+			if state.opt_reorderable {
+				g.NotifyOfDocumentsClosedElsewhere(app)
+			}
+
+			// Submit Tabs
+			for i := range app.Documents {
+				var doc = &app.Documents[i]
+				if !doc.Open {
+					continue
+				}
+
+				var tab_flags ImGuiTabItemFlags
+				if doc.Dirty {
+					tab_flags = ImGuiTabItemFlags_UnsavedDocument
+				}
+				var visible = g.BeginTabItem(doc.Name, &doc.Open, tab_flags)
+
+				// Cancel attempt to close when unsaved add to save queue so we can display a popup.
+				if !doc.Open && doc.Dirty {
+					doc.Open = true
+					doc.DoQueueClose()
+				}
+
+				doc.DisplayContextMenu(g)
+				if visible {
+					doc.DisplayContents(g)
+					g.EndTabItem()
+				}
+			}
+
+			g.EndTabBar()
+		}
+	}
+
+	// Update closing queue
+	if len(state.close_queue) == 0 {
+		// Close queue is locked once we started a popup
+		for i := range app.Documents {
+			var doc = &app.Documents[i]
+			if doc.WantClose {
+				doc.WantClose = false
+				state.close_queue = append(state.close_queue, doc)
+			}
+		}
+	}
+
+	// Display closing confirmation UI
+	if len(state.close_queue) > 0 {
+		var close_queue_unsaved_documents int
+		for _, doc := range state.close_queue {
+			if doc.Dirty {
+				close_queue_unsaved_documents++
+			}
+		}
+
+		if close_queue_unsaved_documents == 0 {
+			// Close documents when all are unsaved
+			for _, doc := range state.close_queue {
+				doc.DoForceClose()
+			}
+			state.close_queue = state.close_queue[:0]
+		} else {
+			if !g.IsPopupOpen("Save?", 0) {
+				g.OpenPopup("Save?", 0)
+			}
+			if g.BeginPopupModal("Save?", nil, ImGuiWindowFlags_AlwaysAutoResize) {
+				g.Text("Save change to the following items?")
+				var item_height = g.GetTextLineHeightWithSpacing()
+				if g.BeginChildFrame(g.GetIDFromString("frame"), ImVec2{-FLT_MIN, 6.25 * item_height}, 0) {
+					for _, doc := range state.close_queue {
+						if doc.Dirty {
+							g.Text("%s", doc.Name)
+						}
+					}
+				}
+				g.EndChildFrame()
+
+				var button_size = ImVec2{g.GetFontSize() * 7.0, 0.0}
+				if g.ButtonEx("Yes", &button_size, 0) {
+					for _, doc := range state.close_queue {
+						if doc.Dirty {
+							doc.DoSave()
+						}
+						doc.DoForceClose()
+					}
+					state.close_queue = state.close_queue[:0]
+					g.CloseCurrentPopup()
+				}
+				g.SameLine(0, -1)
+				if g.ButtonEx("No", &button_size, 0) {
+					for _, doc := range state.close_queue {
+						doc.DoForceClose()
+					}
+					state.close_queue = state.close_queue[:0]
+					g.CloseCurrentPopup()
+				}
+				g.SameLine(0, -1)
+				if g.ButtonEx("Cancel", &button_size, 0) {
+					state.close_queue = state.close_queue[:0]
+					g.CloseCurrentPopup()
+				}
+				g.EndPopup()
+			}
+		}
+	}
+
+	g.End()
+}
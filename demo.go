@@ -7,49 +7,49 @@ import (
 
 // HelpMarker Helper to display a little (?) mark which shows a tooltip when hovered.
 // In your own code you may want to display an actual icon if you are using a merged icon fonts (see docs/FONTS.md)
-func HelpMarker(desc string) {
-	TextDisabled(" (?)")
+func (g *ImGuiContext) HelpMarker(desc string) {
+	g.TextDisabled(" (?)")
 
 	// TODO: this is never true for some reason
-	if IsItemHovered(0) {
-		BeginTooltip()
-		PushTextWrapPos(GetFontSize() * 35.0)
-		TextUnformatted(desc)
-		PopTextWrapPos()
-		EndTooltip()
+	if g.IsItemHovered(0) {
+		g.BeginTooltip()
+		g.PushTextWrapPos(g.GetFontSize() * 35.0)
+		g.TextUnformatted(desc)
+		g.PopTextWrapPos()
+		g.EndTooltip()
 	}
 }
 
 // ShowUserGuide Helper to display basic user controls.
-func ShowUserGuide() {
-	var io = GetIO()
-	BulletText("Double-click on title bar to collapse window.")
-	BulletText(
+func (g *ImGuiContext) ShowUserGuide() {
+	var io = g.GetIO()
+	g.BulletText("Double-click on title bar to collapse window.")
+	g.BulletText(
 		"Click and drag on lower corner to resize window\n" +
 			"(double-click to auto fit window to its contents).")
-	BulletText("CTRL+Click on a slider or drag box to input value as text.")
-	BulletText("TAB/SHIFT+TAB to cycle through keyboard editable fields.")
+	g.BulletText("CTRL+Click on a slider or drag box to input value as text.")
+	g.BulletText("TAB/SHIFT+TAB to cycle through keyboard editable fields.")
 	if io.FontAllowUserScaling {
-		BulletText("CTRL+Mouse Wheel to zoom window contents.")
-	}
-	BulletText("While inputing text:\n")
-	Indent(0)
-	BulletText("CTRL+Left/Right to word jump.")
-	BulletText("CTRL+A or double-click to select all.")
-	BulletText("CTRL+X/C/V to use clipboard cut/copy/paste.")
-	BulletText("CTRL+Z,CTRL+Y to undo/redo.")
-	BulletText("ESCAPE to revert.")
-	BulletText("You can apply arithmetic operators +,*,/ on numerical values.\nUse +- to subtract.")
-	Unindent(0)
-	BulletText("With keyboard navigation enabled:")
-	Indent(0)
-	BulletText("Arrow keys to navigate.")
-	BulletText("Space to activate a widget.")
-	BulletText("Return to input text into a widget.")
-	BulletText("Escape to deactivate a widget, close popup, exit child window.")
-	BulletText("Alt to jump to the menu layer of a window.")
-	BulletText("CTRL+Tab to select a window.")
-	Unindent(0)
+		g.BulletText("CTRL+Mouse Wheel to zoom window contents.")
+	}
+	g.BulletText("While inputing text:\n")
+	g.Indent(0)
+	g.BulletText("CTRL+Left/Right to word jump.")
+	g.BulletText("CTRL+A or double-click to select all.")
+	g.BulletText("CTRL+X/C/V to use clipboard cut/copy/paste.")
+	g.BulletText("CTRL+Z,CTRL+Y to undo/redo.")
+	g.BulletText("ESCAPE to revert.")
+	g.BulletText("You can apply arithmetic operators +,*,/ on numerical values.\nUse +- to subtract.")
+	g.Unindent(0)
+	g.BulletText("With keyboard navigation enabled:")
+	g.Indent(0)
+	g.BulletText("Arrow keys to navigate.")
+	g.BulletText("Space to activate a widget.")
+	g.BulletText("Return to input text into a widget.")
+	g.BulletText("Escape to deactivate a widget, close popup, exit child window.")
+	g.BulletText("Alt to jump to the menu layer of a window.")
+	g.BulletText("CTRL+Tab to select a window.")
+	g.Unindent(0)
 }
 
 var demoState struct {
@@ -79,61 +79,61 @@ var demoState struct {
 // You may execute this function to experiment with the UI and understand what it does.
 // You may then search for keywords in the code when you are interested by a specific feature.
 // create Demo window. demonstrate most ImGui features. call this to learn about the library! try to make it always available in your application!
-func ShowDemoWindow(p_open *bool) {
+func (g *ImGuiContext) ShowDemoWindow(p_open *bool) {
 	// Exceptionally add an extra assert here for people confused about initial Dear ImGui setup
 	// Most ImGui functions would normally just crash if the context is missing.
 	IM_ASSERT_USER_ERROR(GetCurrentContext() != nil, "Missing dear imgui context. Refer to examples app!")
 
 	if demoState.show_app_main_menu_bar {
-		//ShowExampleAppMainMenuBar();
+		g.ShowExampleAppMainMenuBar()
 	}
 	if demoState.show_app_documents {
-		//ShowExampleAppDocuments(&state.show_app_documents);
+		g.ShowExampleAppDocuments(&demoState.show_app_documents)
 	}
 	if demoState.show_app_console {
-		//ShowExampleAppConsole(&show_app_console);
+		g.ShowExampleAppConsole(&demoState.show_app_console)
 	}
 	if demoState.show_app_log {
-		//ShowExampleAppLog(&show_app_log);
+		g.ShowExampleAppLog(&demoState.show_app_log)
 	}
 	if demoState.show_app_layout {
-		//ShowExampleAppLayout(&show_app_layout);
+		g.ShowExampleAppLayout(&demoState.show_app_layout)
 	}
 	if demoState.show_app_property_editor {
-		//ShowExampleAppPropertyEditor(&show_app_property_editor);
+		g.ShowExampleAppPropertyEditor(&demoState.show_app_property_editor)
 	}
 	if demoState.show_app_long_text {
-		//ShowExampleAppLongText(&show_app_long_text);
+		g.ShowExampleAppLongText(&demoState.show_app_long_text)
 	}
 	if demoState.show_app_auto_resize {
-		//ShowExampleAppAutoResize(&show_app_auto_resize);
+		g.ShowExampleAppAutoResize(&demoState.show_app_auto_resize)
 	}
 	if demoState.show_app_constrained_resize {
-		//ShowExampleAppConstrainedResize(&show_app_constrained_resize);
+		g.ShowExampleAppConstrainedResize(&demoState.show_app_constrained_resize)
 	}
 	if demoState.show_app_simple_overlay {
-		//ShowExampleAppSimpleOverlay(&show_app_simple_overlay);
+		g.ShowExampleAppSimpleOverlay(&demoState.show_app_simple_overlay)
 	}
 	if demoState.show_app_fullscreen {
-		//ShowExampleAppFullscreen(&show_app_fullscreen);
+		g.ShowExampleAppFullscreen(&demoState.show_app_fullscreen)
 	}
 	if demoState.show_app_window_titles {
-		//ShowExampleAppWindowTitles(&show_app_window_titles);
+		g.ShowExampleAppWindowTitles(&demoState.show_app_window_titles)
 	}
 	if demoState.show_app_custom_rendering {
-		//ShowExampleAppCustomRendering(&show_app_custom_rendering);
+		g.ShowExampleAppCustomRendering(&demoState.show_app_custom_rendering)
 	}
 
 	if demoState.show_app_metrics {
-		ShowMetricsWindow(&demoState.show_app_metrics)
+		g.ShowMetricsWindow(&demoState.show_app_metrics)
 	}
 	if demoState.show_app_about {
-		ShowAboutWindow(&demoState.show_app_about)
+		g.ShowAboutWindow(&demoState.show_app_about)
 	}
 	if demoState.show_app_style_editor {
-		Begin("Dear ImGui Style Editor", &demoState.show_app_style_editor, 0)
-		ShowStyleEditor(nil)
-		End()
+		g.Begin("Dear ImGui Style Editor", &demoState.show_app_style_editor, 0)
+		g.ShowStyleEditor(nil)
+		g.End()
 	}
 
 	var window_flags ImGuiWindowFlags = 0
@@ -173,14 +173,14 @@ func ShowDemoWindow(p_open *bool) {
 
 	// We specify a default position/size in case there's no data in the .ini file.
 	// We only do it to make the demo applications a little more welcoming, but typically this isn't required.
-	var main_viewport = GetMainViewport()
-	SetNextWindowPos(NewImVec2(main_viewport.WorkPos.X()+650, main_viewport.WorkPos.Y()+20), ImGuiCond_FirstUseEver, ImVec2{})
-	SetNextWindowSize(NewImVec2(550, 680), ImGuiCond_FirstUseEver)
+	var main_viewport = g.GetMainViewport()
+	g.SetNextWindowPos(NewImVec2(main_viewport.WorkPos.X()+650, main_viewport.WorkPos.Y()+20), ImGuiCond_FirstUseEver, ImVec2{})
+	g.SetNextWindowSize(NewImVec2(550, 680), ImGuiCond_FirstUseEver)
 
 	// Main body of the Demo window starts here.
-	if !Begin("Dear ImGui Demo", p_open, window_flags) {
+	if !g.Begin("Dear ImGui Demo", p_open, window_flags) {
 		// Early out if the window is collapsed, as an optimization.
-		End()
+		g.End()
 		return
 	}
 
@@ -190,185 +190,185 @@ func ShowDemoWindow(p_open *bool) {
 	//PushItemWidth(-GetWindowWidth() * 0.35f);
 
 	// e.g. Leave a fixed amount of width for labels (by passing a negative value), the rest goes to widgets.
-	PushItemWidth(GetFontSize() * -12)
+	g.PushItemWidth(g.GetFontSize() * -12)
 
 	// Menu Bar
-	if BeginMenuBar() {
-		if BeginMenu("Menu", true) {
-			//ShowExampleMenuFile()
-			EndMenu()
-		}
-		if BeginMenu("Examples", true) {
-			MenuItem("Main menu bar", "", &demoState.show_app_main_menu_bar, true)
-			MenuItem("Console", "", &demoState.show_app_console, true)
-			MenuItem("Log", "", &demoState.show_app_log, true)
-			MenuItem("Simple layout", "", &demoState.show_app_layout, true)
-			MenuItem("Property editor", "", &demoState.show_app_property_editor, true)
-			MenuItem("Long text display", "", &demoState.show_app_long_text, true)
-			MenuItem("Auto-resizing window", "", &demoState.show_app_auto_resize, true)
-			MenuItem("Constrained-resizing window", "", &demoState.show_app_constrained_resize, true)
-			MenuItem("Simple overlay", "", &demoState.show_app_simple_overlay, true)
-			MenuItem("Fullscreen window", "", &demoState.show_app_fullscreen, true)
-			MenuItem("Manipulating window titles", "", &demoState.show_app_window_titles, true)
-			MenuItem("Custom rendering", "", &demoState.show_app_custom_rendering, true)
-			MenuItem("Documents", "", &demoState.show_app_documents, true)
-			EndMenu()
-		}
-		if BeginMenu("Tools", true) {
-			MenuItem("Metrics/Debugger", "", &demoState.show_app_metrics, true)
-			MenuItem("Style Editor", "", &demoState.show_app_style_editor, true)
-			MenuItem("About Dear ImGui", "", &demoState.show_app_about, true)
-			EndMenu()
-		}
-		EndMenuBar()
-	}
-
-	Text("dear imgui says hello. (%s)", IMGUI_VERSION)
-	Spacing()
-
-	if CollapsingHeader("Help", 0) {
-		Text("ABOUT THIS DEMO:")
-		BulletText("Sections below are demonstrating many aspects of the library.")
-		BulletText("The \"Examples\" menu above leads to more demo contents.")
-		BulletText("The \"Tools\" menu above gives access to: About Box, Style Editor,\n" +
+	if g.BeginMenuBar() {
+		if g.BeginMenu("Menu", true) {
+			g.ShowExampleMenuFile()
+			g.EndMenu()
+		}
+		if g.BeginMenu("Examples", true) {
+			g.MenuItemSelected("Main menu bar", "", &demoState.show_app_main_menu_bar, true)
+			g.MenuItemSelected("Console", "", &demoState.show_app_console, true)
+			g.MenuItemSelected("Log", "", &demoState.show_app_log, true)
+			g.MenuItemSelected("Simple layout", "", &demoState.show_app_layout, true)
+			g.MenuItemSelected("Property editor", "", &demoState.show_app_property_editor, true)
+			g.MenuItemSelected("Long text display", "", &demoState.show_app_long_text, true)
+			g.MenuItemSelected("Auto-resizing window", "", &demoState.show_app_auto_resize, true)
+			g.MenuItemSelected("Constrained-resizing window", "", &demoState.show_app_constrained_resize, true)
+			g.MenuItemSelected("Simple overlay", "", &demoState.show_app_simple_overlay, true)
+			g.MenuItemSelected("Fullscreen window", "", &demoState.show_app_fullscreen, true)
+			g.MenuItemSelected("Manipulating window titles", "", &demoState.show_app_window_titles, true)
+			g.MenuItemSelected("Custom rendering", "", &demoState.show_app_custom_rendering, true)
+			g.MenuItemSelected("Documents", "", &demoState.show_app_documents, true)
+			g.EndMenu()
+		}
+		if g.BeginMenu("Tools", true) {
+			g.MenuItemSelected("Metrics/Debugger", "", &demoState.show_app_metrics, true)
+			g.MenuItemSelected("Style Editor", "", &demoState.show_app_style_editor, true)
+			g.MenuItemSelected("About Dear ImGui", "", &demoState.show_app_about, true)
+			g.EndMenu()
+		}
+		g.EndMenuBar()
+	}
+
+	g.Text("dear imgui says hello. (%s)", IMGUI_VERSION)
+	g.Spacing()
+
+	if g.CollapsingHeader("Help", 0) {
+		g.Text("ABOUT THIS DEMO:")
+		g.BulletText("Sections below are demonstrating many aspects of the library.")
+		g.BulletText("The \"Examples\" menu above leads to more demo contents.")
+		g.BulletText("The \"Tools\" menu above gives access to: About Box, Style Editor,\n" +
 			"and Metrics/Debugger (general purpose Dear ImGui debugging tool).")
-		Separator()
-
-		Text("PROGRAMMER GUIDE:")
-		BulletText("See the ShowDemoWindow() code in imgui_demo.cpp. <- you are here!")
-		BulletText("See comments in cpp.")
-		BulletText("See example applications in the examples/ folder.")
-		BulletText("Read the FAQ at http://www.dearorg/faq/")
-		BulletText("Set 'io.ConfigFlags |= NavEnableKeyboard' for keyboard controls.")
-		BulletText("Set 'io.ConfigFlags |= NavEnableGamepad' for gamepad controls.")
-		Separator()
-
-		Text("USER GUIDE:")
-		ShowUserGuide()
-	}
-
-	if CollapsingHeader("Configuration", 0) {
-		var io = GetIO()
-
-		if TreeNode("Configuration##2") {
-			CheckboxFlagsInt("io.ConfigFlags: NavEnableKeyboard", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NavEnableKeyboard))
-			SameLine(0, 0)
-			HelpMarker("Enable keyboard controls.")
-			CheckboxFlagsInt("io.ConfigFlags: NavEnableGamepad", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NavEnableGamepad))
-			SameLine(0, 0)
-			HelpMarker("Enable gamepad controls. Require backend to set io.BackendFlags |= ImGuiBackendFlags_HasGamepad.\n\nRead instructions in cpp for details.")
-			CheckboxFlagsInt("io.ConfigFlags: NavEnableSetMousePos", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NavEnableSetMousePos))
-			SameLine(0, 0)
-			HelpMarker("Instruct navigation to move the mouse cursor. See comment for ImGuiConfigFlags_NavEnableSetMousePos.")
-			CheckboxFlagsInt("io.ConfigFlags: NoMouse", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NoMouse))
+		g.Separator()
+
+		g.Text("PROGRAMMER GUIDE:")
+		g.BulletText("See the ShowDemoWindow() code in imgui_demo.cpp. <- you are here!")
+		g.BulletText("See comments in cpp.")
+		g.BulletText("See example applications in the examples/ folder.")
+		g.BulletText("Read the FAQ at http://www.dearorg/faq/")
+		g.BulletText("Set 'io.ConfigFlags |= NavEnableKeyboard' for keyboard controls.")
+		g.BulletText("Set 'io.ConfigFlags |= NavEnableGamepad' for gamepad controls.")
+		g.Separator()
+
+		g.Text("USER GUIDE:")
+		g.ShowUserGuide()
+	}
+
+	if g.CollapsingHeader("Configuration", 0) {
+		var io = g.GetIO()
+
+		if g.TreeNode("Configuration##2") {
+			g.CheckboxFlagsInt("io.ConfigFlags: NavEnableKeyboard", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NavEnableKeyboard))
+			g.SameLine(0, 0)
+			g.HelpMarker("Enable keyboard controls.")
+			g.CheckboxFlagsInt("io.ConfigFlags: NavEnableGamepad", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NavEnableGamepad))
+			g.SameLine(0, 0)
+			g.HelpMarker("Enable gamepad controls. Require backend to set io.BackendFlags |= ImGuiBackendFlags_HasGamepad.\n\nRead instructions in cpp for details.")
+			g.CheckboxFlagsInt("io.ConfigFlags: NavEnableSetMousePos", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NavEnableSetMousePos))
+			g.SameLine(0, 0)
+			g.HelpMarker("Instruct navigation to move the mouse cursor. See comment for ImGuiConfigFlags_NavEnableSetMousePos.")
+			g.CheckboxFlagsInt("io.ConfigFlags: NoMouse", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NoMouse))
 			if io.ConfigFlags&ImGuiConfigFlags_NoMouse != 0 {
 				// The "NoMouse" option can get us stuck with a disabled mouse! Let's provide an alternative way to fix it:
-				if math.Mod((float64)(GetTime()), 0.40) < 0.20 {
-					SameLine(0, 0)
-					Text("<<PRESS SPACE TO DISABLE>>")
+				if math.Mod((float64)(g.GetTime()), 0.40) < 0.20 {
+					g.SameLine(0, 0)
+					g.Text("<<PRESS SPACE TO DISABLE>>")
 				}
-				if IsKeyPressed(GetKeyIndex(ImGuiKey_Space), true) {
+				if g.IsKeyPressed(ImGuiKey_Space, true) {
 					io.ConfigFlags &= ^ImGuiConfigFlags_NoMouse
 				}
 			}
-			CheckboxFlagsInt("io.ConfigFlags: NoMouseCursorChange", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NoMouseCursorChange))
-			SameLine(0, 0)
-			HelpMarker("Instruct backend to not alter mouse cursor shape and visibility.")
-			Checkbox("io.ConfigInputTextCursorBlink", &io.ConfigInputTextCursorBlink)
-			SameLine(0, 0)
-			HelpMarker("Enable blinking cursor (optional as some users consider it to be distracting)")
-			Checkbox("io.ConfigDragClickToInputText", &io.ConfigDragClickToInputText)
-			SameLine(0, 0)
-			HelpMarker("Enable turning DragXXX widgets into text input with a simple mouse click-release (without moving).")
-			Checkbox("io.ConfigWindowsResizeFromEdges", &io.ConfigWindowsResizeFromEdges)
-			SameLine(0, 0)
-			HelpMarker("Enable resizing of windows from their edges and from the lower-left corner.\nThis requires (io.BackendFlags & ImGuiBackendFlags_HasMouseCursors) because it needs mouse cursor feedback.")
-			Checkbox("io.ConfigWindowsMoveFromTitleBarOnly", &io.ConfigWindowsMoveFromTitleBarOnly)
-			Checkbox("io.MouseDrawCursor", &io.MouseDrawCursor)
-			SameLine(0, 0)
-			HelpMarker("Instruct Dear ImGui to render a mouse cursor itself. Note that a mouse cursor rendered via your application GPU rendering path will feel more laggy than hardware cursor, but will be more in sync with your other visuals.\n\nSome desktop applications may use both kinds of cursors (e.g. enable software cursor only when resizing/dragging something).")
-			Text("Also see Style->Rendering for rendering options.")
-			TreePop()
-			Separator()
-		}
-
-		if TreeNode("Backend Flags") {
-			HelpMarker(
+			g.CheckboxFlagsInt("io.ConfigFlags: NoMouseCursorChange", (*int32)(&io.ConfigFlags), int32(ImGuiConfigFlags_NoMouseCursorChange))
+			g.SameLine(0, 0)
+			g.HelpMarker("Instruct backend to not alter mouse cursor shape and visibility.")
+			g.Checkbox("io.ConfigInputTextCursorBlink", &io.ConfigInputTextCursorBlink)
+			g.SameLine(0, 0)
+			g.HelpMarker("Enable blinking cursor (optional as some users consider it to be distracting)")
+			g.Checkbox("io.ConfigDragClickToInputText", &io.ConfigDragClickToInputText)
+			g.SameLine(0, 0)
+			g.HelpMarker("Enable turning DragXXX widgets into text input with a simple mouse click-release (without moving).")
+			g.Checkbox("io.ConfigWindowsResizeFromEdges", &io.ConfigWindowsResizeFromEdges)
+			g.SameLine(0, 0)
+			g.HelpMarker("Enable resizing of windows from their edges and from the lower-left corner.\nThis requires (io.BackendFlags & ImGuiBackendFlags_HasMouseCursors) because it needs mouse cursor feedback.")
+			g.Checkbox("io.ConfigWindowsMoveFromTitleBarOnly", &io.ConfigWindowsMoveFromTitleBarOnly)
+			g.Checkbox("io.MouseDrawCursor", &io.MouseDrawCursor)
+			g.SameLine(0, 0)
+			g.HelpMarker("Instruct Dear ImGui to render a mouse cursor itself. Note that a mouse cursor rendered via your application GPU rendering path will feel more laggy than hardware cursor, but will be more in sync with your other visuals.\n\nSome desktop applications may use both kinds of cursors (e.g. enable software cursor only when resizing/dragging something).")
+			g.Text("Also see Style->Rendering for rendering options.")
+			g.TreePop()
+			g.Separator()
+		}
+
+		if g.TreeNode("Backend Flags") {
+			g.HelpMarker(
 				"Those flags are set by the backends (imgui_impl_xxx files) to specify their capabilities.\n" +
 					"Here we expose them as read-only fields to avoid breaking interactions with your backend.")
 
 			// Make a local copy to avoid modifying actual backend flags.
 			var backend_flags = io.BackendFlags
-			CheckboxFlagsInt("io.BackendFlags: HasGamepad", (*int32)(&backend_flags), int32(ImGuiBackendFlags_HasGamepad))
-			CheckboxFlagsInt("io.BackendFlags: HasMouseCursors", (*int32)(&backend_flags), int32(ImGuiBackendFlags_HasMouseCursors))
-			CheckboxFlagsInt("io.BackendFlags: HasSetMousePos", (*int32)(&backend_flags), int32(ImGuiBackendFlags_HasSetMousePos))
-			CheckboxFlagsInt("io.BackendFlags: RendererHasVtxOffset", (*int32)(&backend_flags), int32(ImGuiBackendFlags_RendererHasVtxOffset))
-			TreePop()
-			Separator()
+			g.CheckboxFlagsInt("io.BackendFlags: HasGamepad", (*int32)(&backend_flags), int32(ImGuiBackendFlags_HasGamepad))
+			g.CheckboxFlagsInt("io.BackendFlags: HasMouseCursors", (*int32)(&backend_flags), int32(ImGuiBackendFlags_HasMouseCursors))
+			g.CheckboxFlagsInt("io.BackendFlags: HasSetMousePos", (*int32)(&backend_flags), int32(ImGuiBackendFlags_HasSetMousePos))
+			g.CheckboxFlagsInt("io.BackendFlags: RendererHasVtxOffset", (*int32)(&backend_flags), int32(ImGuiBackendFlags_RendererHasVtxOffset))
+			g.TreePop()
+			g.Separator()
 		}
 
-		if TreeNode("Style") {
-			HelpMarker("The same contents can be accessed in 'Tools->Style Editor' or by calling the ShowStyleEditor() function.")
-			ShowStyleEditor(nil)
-			TreePop()
-			Separator()
+		if g.TreeNode("Style") {
+			g.HelpMarker("The same contents can be accessed in 'Tools->Style Editor' or by calling the ShowStyleEditor() function.")
+			g.ShowStyleEditor(nil)
+			g.TreePop()
+			g.Separator()
 		}
 
-		if TreeNode("Capture/Logging") {
-			HelpMarker(
+		if g.TreeNode("Capture/Logging") {
+			g.HelpMarker(
 				"The logging API redirects all text output so you can easily capture the content of " +
 					"a window or a block. Tree nodes can be automatically expanded.\n" +
 					"Try opening any of the contents below in this window and then click one of the \"Log To\" button.")
-			LogButtons()
+			g.LogButtons()
 
-			HelpMarker("You can also call LogText() to output directly to the log without a visual output.")
-			if Button("Copy \"Hello, world!\" to clipboard") {
-				LogToClipboard(-1)
-				LogText("Hello, world!")
-				LogFinish()
+			g.HelpMarker("You can also call LogText() to output directly to the log without a visual output.")
+			if g.Button("Copy \"Hello, world!\" to clipboard") {
+				g.LogToClipboard(-1)
+				g.LogText("Hello, world!")
+				g.LogFinish()
 			}
-			TreePop()
-		}
-	}
-
-	if CollapsingHeader("Window options", 0) {
-		if BeginTable("split", 3, 0, ImVec2{}, 0) {
-			TableNextColumn()
-			Checkbox("No titlebar", &demoState.no_titlebar)
-			TableNextColumn()
-			Checkbox("No scrollbar", &demoState.no_scrollbar)
-			TableNextColumn()
-			Checkbox("No menu", &demoState.no_menu)
-			TableNextColumn()
-			Checkbox("No move", &demoState.no_move)
-			TableNextColumn()
-			Checkbox("No resize", &demoState.no_resize)
-			TableNextColumn()
-			Checkbox("No collapse", &demoState.no_collapse)
-			TableNextColumn()
-			Checkbox("No close", &demoState.no_close)
-			TableNextColumn()
-			Checkbox("No nav", &demoState.no_nav)
-			TableNextColumn()
-			Checkbox("No background", &demoState.no_background)
-			TableNextColumn()
-			Checkbox("No bring to front", &demoState.no_bring_to_front)
-			TableNextColumn()
-			Checkbox("Unsaved document", &demoState.unsaved_document)
-			EndTable()
+			g.TreePop()
+		}
+	}
+
+	if g.CollapsingHeader("Window options", 0) {
+		if g.BeginTable("split", 3, 0, ImVec2{}, 0) {
+			g.TableNextColumn()
+			g.Checkbox("No titlebar", &demoState.no_titlebar)
+			g.TableNextColumn()
+			g.Checkbox("No scrollbar", &demoState.no_scrollbar)
+			g.TableNextColumn()
+			g.Checkbox("No menu", &demoState.no_menu)
+			g.TableNextColumn()
+			g.Checkbox("No move", &demoState.no_move)
+			g.TableNextColumn()
+			g.Checkbox("No resize", &demoState.no_resize)
+			g.TableNextColumn()
+			g.Checkbox("No collapse", &demoState.no_collapse)
+			g.TableNextColumn()
+			g.Checkbox("No close", &demoState.no_close)
+			g.TableNextColumn()
+			g.Checkbox("No nav", &demoState.no_nav)
+			g.TableNextColumn()
+			g.Checkbox("No background", &demoState.no_background)
+			g.TableNextColumn()
+			g.Checkbox("No bring to front", &demoState.no_bring_to_front)
+			g.TableNextColumn()
+			g.Checkbox("Unsaved document", &demoState.unsaved_document)
+			g.EndTable()
 		}
 	}
 
 	// All demo contents
-	ShowDemoWindowWidgets()
-	ShowDemoWindowLayout()
-	ShowDemoWindowPopups()
-	//ShowDemoWindowTables()
-	ShowDemoWindowMisc()
+	g.ShowDemoWindowWidgets()
+	g.ShowDemoWindowLayout()
+	g.ShowDemoWindowPopups()
+	g.ShowDemoWindowTables()
+	g.ShowDemoWindowMisc()
 
 	// End of ShowDemoWindow()
-	PopItemWidth()
-	End()
+	g.PopItemWidth()
+	g.End()
 }
 
 // ImVec4FromHSV converts HSV values to an ImVec4 color
@@ -433,6 +433,10 @@ var widgetsState struct {
 	selectable_columns   [10]bool
 	selectable_grid      [4][4]bool
 
+	// Multi-Select
+	multiselect_basic   ImGuiSelectionBasicStorage
+	multiselect_clipper ImGuiSelectionBasicStorage
+
 	// Tabs
 	tab_bar_flags    ImGuiTabBarFlags
 	tabs_opened      [4]bool
@@ -476,6 +480,15 @@ var widgetsState struct {
 	range_begin_i int
 	range_end_i int
 
+	// Drag and Drop
+	dnd_col1       [3]float
+	dnd_col2       [4]float
+	dnd_mode       int
+	dnd_names      [9]string
+	dnd_item_names [5]string
+	dnd_multi      []string
+	dnd_multi_sel  []bool
+
 	// Disable all
 	disable_all bool
 }
@@ -536,101 +549,143 @@ func init() {
 	widgetsState.range_end = 90
 	widgetsState.range_begin_i = 100
 	widgetsState.range_end_i = 1000
+
+	// Drag and Drop
+	widgetsState.dnd_col1 = [3]float{1.0, 0.0, 0.2}
+	widgetsState.dnd_col2 = [4]float{0.4, 0.7, 0.0, 0.5}
+	widgetsState.dnd_names = [9]string{
+		"Bobby", "Beatrice", "Betty",
+		"Brianna", "Barry", "Bernard",
+		"Bibi", "Blaine", "Bryn",
+	}
+	widgetsState.dnd_item_names = [5]string{"Item One", "Item Two", "Item Three", "Item Four", "Item Five"}
+	widgetsState.dnd_multi = []string{"Apple", "Banana", "Cherry", "Kiwi", "Mango", "Orange", "Pear", "Plum"}
+	widgetsState.dnd_multi_sel = make([]bool, len(widgetsState.dnd_multi))
+}
+
+// demoMoveItems moves the items at indices (in increasing order) to insert_index, keeping their order and selection.
+func demoMoveItems(names []string, selected []bool, indices []int, insert_index int) {
+	var moved = make([]bool, len(names))
+	for _, i := range indices {
+		moved[i] = true
+	}
+	var out_names = make([]string, 0, len(names))
+	var out_selected = make([]bool, 0, len(names))
+	var flush = func() {
+		for _, i := range indices {
+			out_names = append(out_names, names[i])
+			out_selected = append(out_selected, selected[i])
+		}
+	}
+	for i := range names {
+		if int(i) == insert_index {
+			flush()
+		}
+		if !moved[i] {
+			out_names = append(out_names, names[i])
+			out_selected = append(out_selected, selected[i])
+		}
+	}
+	if insert_index >= int(len(names)) {
+		flush()
+	}
+	copy(names, out_names)
+	copy(selected, out_selected)
 }
 
-func ShowDemoWindowWidgets() {
-	if !CollapsingHeader("Widgets", 0) {
+func (g *ImGuiContext) ShowDemoWindowWidgets() {
+	if !g.CollapsingHeader("Widgets", 0) {
 		return
 	}
 
 	if widgetsState.disable_all {
-		BeginDisabled(true)
+		g.BeginDisabled(true)
 	}
 
-	if TreeNode("Basic") {
-		if Button("Button") {
+	if g.TreeNode("Basic") {
+		if g.Button("Button") {
 			widgetsState.clicked++
 		}
 		if widgetsState.clicked&1 != 0 {
-			SameLine(0, 0)
-			Text("Thanks for clicking me!")
+			g.SameLine(0, 0)
+			g.Text("Thanks for clicking me!")
 		}
 
-		Checkbox("checkbox", &widgetsState.check)
+		g.Checkbox("checkbox", &widgetsState.check)
 
-		RadioButtonInt("radio a", &widgetsState.e, 0)
-		SameLine(0, 0)
-		RadioButtonInt("radio b", &widgetsState.e, 1)
-		SameLine(0, 0)
-		RadioButtonInt("radio c", &widgetsState.e, 2)
+		g.RadioButtonInt("radio a", &widgetsState.e, 0)
+		g.SameLine(0, 0)
+		g.RadioButtonInt("radio b", &widgetsState.e, 1)
+		g.SameLine(0, 0)
+		g.RadioButtonInt("radio c", &widgetsState.e, 2)
 
 		// Color buttons, demonstrate using PushID() to add unique identifier in the ID stack, and changing style.
 		for i := int(0); i < 7; i++ {
 			if i > 0 {
-				SameLine(0, 0)
+				g.SameLine(0, 0)
 			}
-			PushID(i)
+			g.PushID(i)
 			col := ImVec4FromHSV(float(i)/7.0, 0.6, 0.6)
-			PushStyleColorVec(ImGuiCol_Button, &col)
+			g.PushStyleColorVec(ImGuiCol_Button, &col)
 			col2 := ImVec4FromHSV(float(i)/7.0, 0.7, 0.7)
-			PushStyleColorVec(ImGuiCol_ButtonHovered, &col2)
+			g.PushStyleColorVec(ImGuiCol_ButtonHovered, &col2)
 			col3 := ImVec4FromHSV(float(i)/7.0, 0.8, 0.8)
-			PushStyleColorVec(ImGuiCol_ButtonActive, &col3)
-			Button("Click")
-			PopStyleColor(3)
-			PopID()
+			g.PushStyleColorVec(ImGuiCol_ButtonActive, &col3)
+			g.Button("Click")
+			g.PopStyleColor(3)
+			g.PopID()
 		}
 
 		// Use AlignTextToFramePadding() to align text baseline to the baseline of framed widgets elements
-		AlignTextToFramePadding()
-		Text("Hold to repeat:")
-		SameLine(0, 0)
+		g.AlignTextToFramePadding()
+		g.Text("Hold to repeat:")
+		g.SameLine(0, 0)
 
 		// Arrow buttons with Repeater
-		spacing := GetStyle().ItemInnerSpacing.x
-		PushButtonRepeat(true)
-		if ArrowButton("##left", ImGuiDir_Left) {
+		spacing := g.GetStyle().ItemInnerSpacing.x
+		g.PushButtonRepeat(true)
+		if g.ArrowButton("##left", ImGuiDir_Left) {
 			widgetsState.counter--
 		}
-		SameLine(0.0, spacing)
-		if ArrowButton("##right", ImGuiDir_Right) {
+		g.SameLine(0.0, spacing)
+		if g.ArrowButton("##right", ImGuiDir_Right) {
 			widgetsState.counter++
 		}
-		PopButtonRepeat()
-		SameLine(0, 0)
-		Text("%d", widgetsState.counter)
+		g.PopButtonRepeat()
+		g.SameLine(0, 0)
+		g.Text("%d", widgetsState.counter)
 
-		Text("Hover over me")
-		if IsItemHovered(0) {
-			SetTooltip("I am a tooltip")
+		g.Text("Hover over me")
+		if g.IsItemHovered(0) {
+			g.SetTooltip("I am a tooltip")
 		}
 
-		SameLine(0, 0)
-		Text("- or me")
-		if IsItemHovered(0) {
-			BeginTooltip()
-			Text("I am a fancy tooltip")
+		g.SameLine(0, 0)
+		g.Text("- or me")
+		if g.IsItemHovered(0) {
+			g.BeginTooltip()
+			g.Text("I am a fancy tooltip")
 			arr := []float{0.6, 0.1, 1.0, 0.5, 0.92, 0.1, 0.2}
-			PlotLines("Curve", arr, int(len(arr)), 0, "", FLT_MAX, FLT_MAX, ImVec2{}, 1)
-			EndTooltip()
+			g.PlotLines("Curve", arr, int(len(arr)), 0, "", FLT_MAX, FLT_MAX, ImVec2{}, 1)
+			g.EndTooltip()
 		}
 
-		Separator()
+		g.Separator()
 
-		LabelText("label", "Value")
+		g.LabelText("label", "Value")
 
 		{
 			// Using the _simplified_ one-liner Combo() api here
 			items := []string{"AAAA", "BBBB", "CCCC", "DDDD", "EEEE", "FFFF", "GGGG", "HHHH", "IIIIIII", "JJJJ", "KKKKKKK"}
-			Combo("combo", &widgetsState.item_current_combo, items, int(len(items)), -1)
-			SameLine(0, 0)
-			HelpMarker("Using the simplified one-liner Combo API here.\nRefer to the \"Combo\" section below for an explanation of how to use the more flexible and general BeginCombo/EndCombo API.")
+			g.Combo("combo", &widgetsState.item_current_combo, items, int(len(items)), -1)
+			g.SameLine(0, 0)
+			g.HelpMarker("Using the simplified one-liner Combo API here.\nRefer to the \"Combo\" section below for an explanation of how to use the more flexible and general BeginCombo/EndCombo API.")
 		}
 
 		{
-			InputText("input text", &widgetsState.str0, 0, nil, nil)
-			SameLine(0, 0)
-			HelpMarker(
+			g.InputText("input text", &widgetsState.str0, 0, nil, nil)
+			g.SameLine(0, 0)
+			g.HelpMarker(
 				"USER:\n" +
 					"Hold SHIFT or use mouse to select text.\n" +
 					"CTRL+Left/Right to word jump.\n" +
@@ -643,49 +698,49 @@ func ShowDemoWindowWidgets() {
 					"to a dynamic string type. See misc/cpp/imgui_stdlib.h for an example (this is not demonstrated " +
 					"in imgui_demo.cpp).")
 
-			InputTextWithHint("input text (w/ hint)", "enter text here", &widgetsState.str1, 0, nil, nil)
+			g.InputTextWithHint("input text (w/ hint)", "enter text here", &widgetsState.str1, 0, nil, nil)
 
-			InputInt("input int", &widgetsState.i0, 1, 100, 0)
-			SameLine(0, 0)
-			HelpMarker(
+			g.InputInt("input int", &widgetsState.i0, 1, 100, 0)
+			g.SameLine(0, 0)
+			g.HelpMarker(
 				"You can apply arithmetic operators +,*,/ on numerical values.\n" +
 					"  e.g. [ 100 ], input '*2', result becomes [ 200 ]\n" +
 					"Use +- to subtract.")
 
-			InputFloat("input float", &widgetsState.f0, 0.01, 1.0, "%.3f", 0)
+			g.InputFloat("input float", &widgetsState.f0, 0.01, 1.0, "%.3f", 0)
 
-			InputFloat("input scientific", &widgetsState.f1, 0.0, 0.0, "%e", 0)
-			SameLine(0, 0)
-			HelpMarker("You can input value using the scientific notation,\n  e.g. \"1e+8\" becomes \"100000000\".")
+			g.InputFloat("input scientific", &widgetsState.f1, 0.0, 0.0, "%e", 0)
+			g.SameLine(0, 0)
+			g.HelpMarker("You can input value using the scientific notation,\n  e.g. \"1e+8\" becomes \"100000000\".")
 
 			var vec3 = [3]float{widgetsState.vec4a[0], widgetsState.vec4a[1], widgetsState.vec4a[2]}
-			InputFloat3("input float3", &vec3, "%.3f", 0)
+			g.InputFloat3("input float3", &vec3, "%.3f", 0)
 			widgetsState.vec4a[0], widgetsState.vec4a[1], widgetsState.vec4a[2] = vec3[0], vec3[1], vec3[2]
 		}
 
 		{
-			DragInt("drag int", &widgetsState.i1, 1, 0, 0, "%d", 0)
-			SameLine(0, 0)
-			HelpMarker(
+			g.DragInt("drag int", &widgetsState.i1, 1, 0, 0, "%d", 0)
+			g.SameLine(0, 0)
+			g.HelpMarker(
 				"Click and drag to edit value.\n" +
 					"Hold SHIFT/ALT for faster/slower edit.\n" +
 					"Double-click or CTRL+click to input value.")
 
-			DragInt("drag int 0..100", &widgetsState.i2, 1, 0, 100, "%d%%", ImGuiSliderFlags_AlwaysClamp)
+			g.DragInt("drag int 0..100", &widgetsState.i2, 1, 0, 100, "%d%%", ImGuiSliderFlags_AlwaysClamp)
 
-			DragFloat("drag float", &widgetsState.f1drag, 0.005, 0.0, 0.0, "%.3f", 0)
-			DragFloat("drag small float", &widgetsState.f2drag, 0.0001, 0.0, 0.0, "%.06f ns", 0)
+			g.DragFloat("drag float", &widgetsState.f1drag, 0.005, 0.0, 0.0, "%.3f", 0)
+			g.DragFloat("drag small float", &widgetsState.f2drag, 0.0001, 0.0, 0.0, "%.06f ns", 0)
 		}
 
 		{
-			SliderInt("slider int", &widgetsState.i1slider, -1, 3, "%d", 0)
-			SameLine(0, 0)
-			HelpMarker("CTRL+click to input value.")
+			g.SliderInt("slider int", &widgetsState.i1slider, -1, 3, "%d", 0)
+			g.SameLine(0, 0)
+			g.HelpMarker("CTRL+click to input value.")
 
-			SliderFloat("slider float", &widgetsState.f1slider, 0.0, 1.0, "ratio = %.3f", 0)
-			SliderFloat("slider float (log)", &widgetsState.f2slider, -10.0, 10.0, "%.4f", ImGuiSliderFlags_Logarithmic)
+			g.SliderFloat("slider float", &widgetsState.f1slider, 0.0, 1.0, "ratio = %.3f", 0)
+			g.SliderFloat("slider float (log)", &widgetsState.f2slider, -10.0, 10.0, "%.4f", ImGuiSliderFlags_Logarithmic)
 
-			SliderAngle("slider angle", &widgetsState.angle, -360.0, 360.0, "%.0f deg", 0)
+			g.SliderAngle("slider angle", &widgetsState.angle, -360.0, 360.0, "%.0f deg", 0)
 
 			// Using the format string to display a name instead of an integer.
 			elems_names := []string{"Fire", "Earth", "Air", "Water"}
@@ -693,68 +748,68 @@ func ShowDemoWindowWidgets() {
 			if widgetsState.elem >= 0 && widgetsState.elem < int(len(elems_names)) {
 				elem_name = elems_names[widgetsState.elem]
 			}
-			SliderInt("slider enum", &widgetsState.elem, 0, int(len(elems_names)-1), elem_name, 0)
-			SameLine(0, 0)
-			HelpMarker("Using the format string parameter to display a name instead of the underlying integer.")
+			g.SliderInt("slider enum", &widgetsState.elem, 0, int(len(elems_names)-1), elem_name, 0)
+			g.SameLine(0, 0)
+			g.HelpMarker("Using the format string parameter to display a name instead of the underlying integer.")
 		}
 
 		{
-			ColorEdit3("color 1", &widgetsState.col1, 0)
-			SameLine(0, 0)
-			HelpMarker(
+			g.ColorEdit3("color 1", &widgetsState.col1, 0)
+			g.SameLine(0, 0)
+			g.HelpMarker(
 				"Click on the color square to open a color picker.\n" +
 					"Click and hold to use drag and drop.\n" +
 					"Right-click on the color square to show options.\n" +
 					"CTRL+click on individual component to input value.\n")
 
-			ColorEdit4("color 2", &widgetsState.col2, 0)
+			g.ColorEdit4("color 2", &widgetsState.col2, 0)
 		}
 
 		{
 			// Using the _simplified_ one-liner ListBox() api here
 			items := []string{"Apple", "Banana", "Cherry", "Kiwi", "Mango", "Orange", "Pineapple", "Strawberry", "Watermelon"}
-			ListBox("listbox", &widgetsState.item_current_list, items, int(len(items)), 4)
-			SameLine(0, 0)
-			HelpMarker("Using the simplified one-liner ListBox API here.\nRefer to the \"List boxes\" section below for an explanation of how to use the more flexible and general BeginListBox/EndListBox API.")
+			g.ListBox("listbox", &widgetsState.item_current_list, items, int(len(items)), 4)
+			g.SameLine(0, 0)
+			g.HelpMarker("Using the simplified one-liner ListBox API here.\nRefer to the \"List boxes\" section below for an explanation of how to use the more flexible and general BeginListBox/EndListBox API.")
 		}
 
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Trees") {
-		if TreeNode("Basic trees") {
+	if g.TreeNode("Trees") {
+		if g.TreeNode("Basic trees") {
 			for i := int(0); i < 5; i++ {
 				// Use SetNextItemOpen() so set the default state of a node to be open.
 				if i == 0 {
-					SetNextItemOpen(true, ImGuiCond_Once)
+					g.SetNextItemOpen(true, ImGuiCond_Once)
 				}
 
-				if TreeNodeInterface(i, "Child %d", i) {
-					Text("blah blah")
-					SameLine(0, 0)
-					if SmallButton("button") {
+				if g.TreeNodeInterface(i, "Child %d", i) {
+					g.Text("blah blah")
+					g.SameLine(0, 0)
+					if g.SmallButton("button") {
 					}
-					TreePop()
+					g.TreePop()
 				}
 			}
-			TreePop()
+			g.TreePop()
 		}
 
-		if TreeNode("Advanced, with Selectable nodes") {
-			HelpMarker(
+		if g.TreeNode("Advanced, with Selectable nodes") {
+			g.HelpMarker(
 				"This is a more typical looking tree with selectable nodes.\n" +
 					"Click to select, CTRL+Click to toggle, click on arrows or double-click to open.")
-			CheckboxFlagsInt("ImGuiTreeNodeFlags_OpenOnArrow", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_OpenOnArrow))
-			CheckboxFlagsInt("ImGuiTreeNodeFlags_OpenOnDoubleClick", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_OpenOnDoubleClick))
-			CheckboxFlagsInt("ImGuiTreeNodeFlags_SpanAvailWidth", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_SpanAvailWidth))
-			SameLine(0, 0)
-			HelpMarker("Extend hit area to all available width instead of allowing more items to be laid out after the node.")
-			CheckboxFlagsInt("ImGuiTreeNodeFlags_SpanFullWidth", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_SpanFullWidth))
-			Checkbox("Align label with current X position", &widgetsState.align_label_with_current_x_position)
-			Checkbox("Test tree node as drag source", &widgetsState.test_drag_and_drop)
-			Text("Hello!")
+			g.CheckboxFlagsInt("ImGuiTreeNodeFlags_OpenOnArrow", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_OpenOnArrow))
+			g.CheckboxFlagsInt("ImGuiTreeNodeFlags_OpenOnDoubleClick", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_OpenOnDoubleClick))
+			g.CheckboxFlagsInt("ImGuiTreeNodeFlags_SpanAvailWidth", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_SpanAvailWidth))
+			g.SameLine(0, 0)
+			g.HelpMarker("Extend hit area to all available width instead of allowing more items to be laid out after the node.")
+			g.CheckboxFlagsInt("ImGuiTreeNodeFlags_SpanFullWidth", (*int32)(&widgetsState.base_flags), int32(ImGuiTreeNodeFlags_SpanFullWidth))
+			g.Checkbox("Align label with current X position", &widgetsState.align_label_with_current_x_position)
+			g.Checkbox("Test tree node as drag source", &widgetsState.test_drag_and_drop)
+			g.Text("Hello!")
 			if widgetsState.align_label_with_current_x_position {
-				Unindent(GetTreeNodeToLabelSpacing())
+				g.Unindent(g.GetTreeNodeToLabelSpacing())
 			}
 
 			node_clicked := int(-1)
@@ -766,139 +821,139 @@ func ShowDemoWindowWidgets() {
 				}
 				if i < 3 {
 					// Items 0..2 are Tree Node
-					node_open := TreeNodeInterfaceEx(i, node_flags, "Selectable Node %d", i)
-					if IsItemClicked(0) {
+					node_open := g.TreeNodeInterfaceEx(i, node_flags, "Selectable Node %d", i)
+					if g.IsItemClicked(0) {
 						node_clicked = int(i)
 					}
-					if widgetsState.test_drag_and_drop && BeginDragDropSource(0) {
-						SetDragDropPayload("_TREENODE", nil, 0, 0)
-						Text("This is a drag and drop source")
-						EndDragDropSource()
+					if widgetsState.test_drag_and_drop && g.BeginDragDropSource(0) {
+						g.SetDragDropPayload("_TREENODE", nil, 0, 0)
+						g.Text("This is a drag and drop source")
+						g.EndDragDropSource()
 					}
 					if node_open {
-						BulletText("Blah blah\nBlah Blah")
-						TreePop()
+						g.BulletText("Blah blah\nBlah Blah")
+						g.TreePop()
 					}
 				} else {
 					// Items 3..5 are Tree Leaves
 					node_flags |= ImGuiTreeNodeFlags_Leaf | ImGuiTreeNodeFlags_NoTreePushOnOpen
-					TreeNodeInterfaceEx(i, node_flags, "Selectable Leaf %d", i)
-					if IsItemClicked(0) {
+					g.TreeNodeInterfaceEx(i, node_flags, "Selectable Leaf %d", i)
+					if g.IsItemClicked(0) {
 						node_clicked = int(i)
 					}
-					if widgetsState.test_drag_and_drop && BeginDragDropSource(0) {
-						SetDragDropPayload("_TREENODE", nil, 0, 0)
-						Text("This is a drag and drop source")
-						EndDragDropSource()
+					if widgetsState.test_drag_and_drop && g.BeginDragDropSource(0) {
+						g.SetDragDropPayload("_TREENODE", nil, 0, 0)
+						g.Text("This is a drag and drop source")
+						g.EndDragDropSource()
 					}
 				}
 			}
 			if node_clicked != -1 {
 				// Update selection state
-				if GetIO().KeyCtrl {
+				if g.GetIO().KeyCtrl {
 					widgetsState.selection_mask ^= 1 << node_clicked // CTRL+click to toggle
 				} else {
 					widgetsState.selection_mask = 1 << node_clicked // Click to single-select
 				}
 			}
 			if widgetsState.align_label_with_current_x_position {
-				Indent(GetTreeNodeToLabelSpacing())
+				g.Indent(g.GetTreeNodeToLabelSpacing())
 			}
-			TreePop()
+			g.TreePop()
 		}
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Collapsing Headers") {
-		Checkbox("Show 2nd header", &widgetsState.closable_group)
-		if CollapsingHeader("Header", 0) {
-			Text("IsItemHovered: %d", bool2int(IsItemHovered(0)))
+	if g.TreeNode("Collapsing Headers") {
+		g.Checkbox("Show 2nd header", &widgetsState.closable_group)
+		if g.CollapsingHeader("Header", 0) {
+			g.Text("IsItemHovered: %d", bool2int(g.IsItemHovered(0)))
 			for i := int(0); i < 5; i++ {
-				Text("Some content %d", i)
+				g.Text("Some content %d", i)
 			}
 		}
-		if CollapsingHeaderVisible("Header with a close button", &widgetsState.closable_group, 0) {
-			Text("IsItemHovered: %d", bool2int(IsItemHovered(0)))
+		if g.CollapsingHeaderVisible("Header with a close button", &widgetsState.closable_group, 0) {
+			g.Text("IsItemHovered: %d", bool2int(g.IsItemHovered(0)))
 			for i := int(0); i < 5; i++ {
-				Text("More content %d", i)
+				g.Text("More content %d", i)
 			}
 		}
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Bullets") {
-		BulletText("Bullet point 1")
-		BulletText("Bullet point 2\nOn multiple lines")
-		if TreeNode("Tree node") {
-			BulletText("Another bullet point")
-			TreePop()
+	if g.TreeNode("Bullets") {
+		g.BulletText("Bullet point 1")
+		g.BulletText("Bullet point 2\nOn multiple lines")
+		if g.TreeNode("Tree node") {
+			g.BulletText("Another bullet point")
+			g.TreePop()
 		}
-		Bullet()
-		Text("Bullet point 3 (two calls)")
-		Bullet()
-		SmallButton("Button")
-		TreePop()
+		g.Bullet()
+		g.Text("Bullet point 3 (two calls)")
+		g.Bullet()
+		g.SmallButton("Button")
+		g.TreePop()
 	}
 
-	if TreeNode("Text") {
-		if TreeNode("Colorful Text") {
+	if g.TreeNode("Text") {
+		if g.TreeNode("Colorful Text") {
 			// Using shortcut. You can use PushStyleColor()/PopStyleColor() for more flexibility.
 			pink := ImVec4{1.0, 0.0, 1.0, 1.0}
-			TextColored(&pink, "Pink")
+			g.TextColored(&pink, "Pink")
 			yellow := ImVec4{1.0, 1.0, 0.0, 1.0}
-			TextColored(&yellow, "Yellow")
-			TextDisabled("Disabled")
-			SameLine(0, 0)
-			HelpMarker("The TextDisabled color is stored in ImGuiStyle.")
-			TreePop()
+			g.TextColored(&yellow, "Yellow")
+			g.TextDisabled("Disabled")
+			g.SameLine(0, 0)
+			g.HelpMarker("The TextDisabled color is stored in ImGuiStyle.")
+			g.TreePop()
 		}
 
-		if TreeNode("Word Wrapping") {
+		if g.TreeNode("Word Wrapping") {
 			// Using shortcut. You can use PushTextWrapPos()/PopTextWrapPos() for more flexibility.
-			TextWrapped(
+			g.TextWrapped(
 				"This text should automatically wrap on the edge of the window. The current implementation " +
 					"for text wrapping follows simple rules suitable for English and possibly other languages.")
-			Spacing()
+			g.Spacing()
 
-			SliderFloat("Wrap width", &widgetsState.wrap_width, -20, 600, "%.0f", 0)
+			g.SliderFloat("Wrap width", &widgetsState.wrap_width, -20, 600, "%.0f", 0)
 
-			draw_list := GetWindowDrawList()
+			draw_list := g.GetWindowDrawList()
 			for n := int(0); n < 2; n++ {
-				Text("Test paragraph %d:", n)
-				pos := GetCursorScreenPos()
+				g.Text("Test paragraph %d:", n)
+				pos := g.GetCursorScreenPos()
 				marker_min := ImVec2{pos.x + widgetsState.wrap_width, pos.y}
-				marker_max := ImVec2{pos.x + widgetsState.wrap_width + 10, pos.y + GetTextLineHeight()}
-				PushTextWrapPos(GetCursorPos().x + widgetsState.wrap_width)
+				marker_max := ImVec2{pos.x + widgetsState.wrap_width + 10, pos.y + g.GetTextLineHeight()}
+				g.PushTextWrapPos(g.GetCursorPos().x + widgetsState.wrap_width)
 				if n == 0 {
-					Text("The lazy dog is a good dog. This paragraph should fit within %.0f pixels. Testing a 1 character word. The quick brown fox jumps over the lazy dog.", widgetsState.wrap_width)
+					g.Text("The lazy dog is a good dog. This paragraph should fit within %.0f pixels. Testing a 1 character word. The quick brown fox jumps over the lazy dog.", widgetsState.wrap_width)
 				} else {
-					Text("aaaaaaaa bbbbbbbb, c cccccccc,dddddddd. d eeeeeeee   ffffffff. gggggggg!hhhhhhhh")
+					g.Text("aaaaaaaa bbbbbbbb, c cccccccc,dddddddd. d eeeeeeee   ffffffff. gggggggg!hhhhhhhh")
 				}
 
 				// Draw actual text bounding box, following by marker of our expected limit
-				draw_list.AddRect(GetItemRectMin(), GetItemRectMax(), IM_COL32(255, 255, 0, 255), 0, 0, 1.0)
+				draw_list.AddRect(g.GetItemRectMin(), g.GetItemRectMax(), IM_COL32(255, 255, 0, 255), 0, 0, 1.0)
 				draw_list.AddRectFilled(marker_min, marker_max, IM_COL32(255, 0, 255, 255), 0, 0)
-				PopTextWrapPos()
+				g.PopTextWrapPos()
 			}
 
-			TreePop()
+			g.TreePop()
 		}
 
-		if TreeNode("UTF-8 Text") {
-			TextWrapped(
+		if g.TreeNode("UTF-8 Text") {
+			g.TextWrapped(
 				"CJK text will only appears if the font was loaded with the appropriate CJK character ranges. " +
 					"Call io.Fonts->AddFontFromFileTTF() manually to load extra character ranges. " +
 					"Read docs/FONTS.md for details.")
-			Text("Hiragana: かきくけこ (kakikukeko)")
-			Text("Kanjis: 日本語 (nihongo)")
-			TreePop()
+			g.Text("Hiragana: かきくけこ (kakikukeko)")
+			g.Text("Kanjis: 日本語 (nihongo)")
+			g.TreePop()
 		}
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Images") {
-		io := GetIO()
-		TextWrapped(
+	if g.TreeNode("Images") {
+		io := g.GetIO()
+		g.TextWrapped(
 			"Below we are displaying the font texture (which is the only texture we have access to in this demo). " +
 				"Use the 'ImTextureID' type as storage to pass pointers or identifier to your own texture data. " +
 				"Hover the texture for a zoomed view!")
@@ -908,15 +963,15 @@ func ShowDemoWindowWidgets() {
 		my_tex_w := float(io.Fonts.TexWidth)
 		my_tex_h := float(io.Fonts.TexHeight)
 		{
-			Text("%.0fx%.0f", my_tex_w, my_tex_h)
-			pos := GetCursorScreenPos()
+			g.Text("%.0fx%.0f", my_tex_w, my_tex_h)
+			pos := g.GetCursorScreenPos()
 			uv_min := ImVec2{0.0, 0.0}
 			uv_max := ImVec2{1.0, 1.0}
 			tint_col := ImVec4{1.0, 1.0, 1.0, 1.0}
 			border_col := ImVec4{1.0, 1.0, 1.0, 0.5}
-			Image(my_tex_id, ImVec2{my_tex_w, my_tex_h}, uv_min, uv_max, tint_col, border_col)
-			if IsItemHovered(0) {
-				BeginTooltip()
+			g.Image(my_tex_id, ImVec2{my_tex_w, my_tex_h}, uv_min, uv_max, tint_col, border_col)
+			if g.IsItemHovered(0) {
+				g.BeginTooltip()
 				region_sz := float(32.0)
 				region_x := io.MousePos.x - pos.x - region_sz*0.5
 				region_y := io.MousePos.y - pos.y - region_sz*0.5
@@ -931,48 +986,48 @@ func ShowDemoWindowWidgets() {
 				} else if region_y > my_tex_h-region_sz {
 					region_y = my_tex_h - region_sz
 				}
-				Text("Min: (%.2f, %.2f)", region_x, region_y)
-				Text("Max: (%.2f, %.2f)", region_x+region_sz, region_y+region_sz)
+				g.Text("Min: (%.2f, %.2f)", region_x, region_y)
+				g.Text("Max: (%.2f, %.2f)", region_x+region_sz, region_y+region_sz)
 				uv0 := ImVec2{region_x / my_tex_w, region_y / my_tex_h}
 				uv1 := ImVec2{(region_x + region_sz) / my_tex_w, (region_y + region_sz) / my_tex_h}
-				Image(my_tex_id, ImVec2{region_sz * zoom, region_sz * zoom}, uv0, uv1, tint_col, border_col)
-				EndTooltip()
+				g.Image(my_tex_id, ImVec2{region_sz * zoom, region_sz * zoom}, uv0, uv1, tint_col, border_col)
+				g.EndTooltip()
 			}
 		}
-		TextWrapped("And now some textured buttons..")
+		g.TextWrapped("And now some textured buttons..")
 		for i := int(0); i < 8; i++ {
-			PushID(i)
+			g.PushID(i)
 			frame_padding := int(-1 + i)
 			size := ImVec2{32.0, 32.0}
 			uv0 := ImVec2{0.0, 0.0}
 			uv1 := ImVec2{32.0 / my_tex_w, 32.0 / my_tex_h}
 			bg_col := ImVec4{0.0, 0.0, 0.0, 1.0}
 			tint_col := ImVec4{1.0, 1.0, 1.0, 1.0}
-			if ImageButton(my_tex_id, size, uv0, uv1, frame_padding, bg_col, tint_col) {
+			if g.ImageButton(my_tex_id, size, uv0, uv1, frame_padding, bg_col, tint_col) {
 				widgetsState.pressed_count++
 			}
-			PopID()
-			SameLine(0, 0)
+			g.PopID()
+			g.SameLine(0, 0)
 		}
-		NewLine()
-		Text("Pressed %d times.", widgetsState.pressed_count)
-		TreePop()
+		g.NewLine()
+		g.Text("Pressed %d times.", widgetsState.pressed_count)
+		g.TreePop()
 	}
 
-	if TreeNode("Combo") {
+	if g.TreeNode("Combo") {
 		// Expose flags as checkbox for the demo
 		combo_flags_int := int(widgetsState.combo_flags)
-		CheckboxFlagsInt("ImGuiComboFlags_PopupAlignLeft", &combo_flags_int, int(ImGuiComboFlags_PopupAlignLeft))
+		g.CheckboxFlagsInt("ImGuiComboFlags_PopupAlignLeft", &combo_flags_int, int(ImGuiComboFlags_PopupAlignLeft))
 		widgetsState.combo_flags = ImGuiComboFlags(combo_flags_int)
-		SameLine(0, 0)
-		HelpMarker("Only makes a difference if the popup is larger than the combo")
+		g.SameLine(0, 0)
+		g.HelpMarker("Only makes a difference if the popup is larger than the combo")
 		combo_flags_int = int(widgetsState.combo_flags)
-		if CheckboxFlagsInt("ImGuiComboFlags_NoArrowButton", &combo_flags_int, int(ImGuiComboFlags_NoArrowButton)) {
+		if g.CheckboxFlagsInt("ImGuiComboFlags_NoArrowButton", &combo_flags_int, int(ImGuiComboFlags_NoArrowButton)) {
 			combo_flags_int &= ^int(ImGuiComboFlags_NoPreview)
 		}
 		widgetsState.combo_flags = ImGuiComboFlags(combo_flags_int)
 		combo_flags_int = int(widgetsState.combo_flags)
-		if CheckboxFlagsInt("ImGuiComboFlags_NoPreview", &combo_flags_int, int(ImGuiComboFlags_NoPreview)) {
+		if g.CheckboxFlagsInt("ImGuiComboFlags_NoPreview", &combo_flags_int, int(ImGuiComboFlags_NoPreview)) {
 			combo_flags_int &= ^int(ImGuiComboFlags_NoArrowButton)
 		}
 		widgetsState.combo_flags = ImGuiComboFlags(combo_flags_int)
@@ -980,81 +1035,81 @@ func ShowDemoWindowWidgets() {
 		// Using the generic BeginCombo() API
 		items := []string{"AAAA", "BBBB", "CCCC", "DDDD", "EEEE", "FFFF", "GGGG", "HHHH", "IIII", "JJJJ", "KKKK", "LLLLLLL", "MMMM", "OOOOOOO"}
 		combo_preview_value := items[widgetsState.combo_item_current_idx]
-		if BeginCombo("combo 1", combo_preview_value, widgetsState.combo_flags) {
+		if g.BeginCombo("combo 1", combo_preview_value, widgetsState.combo_flags) {
 			for n := int(0); n < int(len(items)); n++ {
 				is_selected := widgetsState.combo_item_current_idx == n
-				if Selectable(items[n], is_selected, 0, ImVec2{}) {
+				if g.Selectable(items[n], is_selected, 0, ImVec2{}) {
 					widgetsState.combo_item_current_idx = n
 				}
 				if is_selected {
-					SetItemDefaultFocus()
+					g.SetItemDefaultFocus()
 				}
 			}
-			EndCombo()
+			g.EndCombo()
 		}
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("List boxes") {
+	if g.TreeNode("List boxes") {
 		items := []string{"AAAA", "BBBB", "CCCC", "DDDD", "EEEE", "FFFF", "GGGG", "HHHH", "IIII", "JJJJ", "KKKK", "LLLLLLL", "MMMM", "OOOOOOO"}
-		if BeginListBox("listbox 1", ImVec2{}) {
+		if g.BeginListBox("listbox 1", ImVec2{}) {
 			for n := int(0); n < int(len(items)); n++ {
 				is_selected := widgetsState.listbox_item_current_idx == n
-				if Selectable(items[n], is_selected, 0, ImVec2{}) {
+				if g.Selectable(items[n], is_selected, 0, ImVec2{}) {
 					widgetsState.listbox_item_current_idx = n
 				}
 				if is_selected {
-					SetItemDefaultFocus()
+					g.SetItemDefaultFocus()
 				}
 			}
-			EndListBox()
+			g.EndListBox()
 		}
 
 		// Custom size: use all width, 5 items tall
-		Text("Full-width:")
-		if BeginListBox("##listbox 2", ImVec2{-FLT_MIN, 5 * GetTextLineHeightWithSpacing()}) {
+		g.Text("Full-width:")
+		if g.BeginListBox("##listbox 2", ImVec2{-FLT_MIN, 5 * g.GetTextLineHeightWithSpacing()}) {
 			for n := int(0); n < int(len(items)); n++ {
 				is_selected := widgetsState.listbox_item_current_idx == n
-				if Selectable(items[n], is_selected, 0, ImVec2{}) {
+				if g.Selectable(items[n], is_selected, 0, ImVec2{}) {
 					widgetsState.listbox_item_current_idx = n
 				}
 				if is_selected {
-					SetItemDefaultFocus()
+					g.SetItemDefaultFocus()
 				}
 			}
-			EndListBox()
+			g.EndListBox()
 		}
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Selectables") {
-		if TreeNode("Basic") {
-			SelectablePointer("1. I am selectable", &widgetsState.selectable_basic[0], 0, ImVec2{})
-			SelectablePointer("2. I am selectable", &widgetsState.selectable_basic[1], 0, ImVec2{})
-			Text("(I am not selectable)")
-			SelectablePointer("4. I am selectable", &widgetsState.selectable_basic[3], 0, ImVec2{})
-			if Selectable("5. I am double clickable", widgetsState.selectable_basic[4], ImGuiSelectableFlags_AllowDoubleClick, ImVec2{}) {
-				if IsMouseDoubleClicked(0) {
+	if g.TreeNode("Selectables") {
+		if g.TreeNode("Basic") {
+			g.SelectablePointer("1. I am selectable", &widgetsState.selectable_basic[0], 0, ImVec2{})
+			g.SelectablePointer("2. I am selectable", &widgetsState.selectable_basic[1], 0, ImVec2{})
+			g.Text("(I am not selectable)")
+			g.SelectablePointer("4. I am selectable", &widgetsState.selectable_basic[3], 0, ImVec2{})
+			if g.Selectable("5. I am double clickable", widgetsState.selectable_basic[4], ImGuiSelectableFlags_AllowDoubleClick, ImVec2{}) {
+				if g.IsMouseDoubleClicked(0) {
 					widgetsState.selectable_basic[4] = !widgetsState.selectable_basic[4]
 				}
 			}
-			TreePop()
+			g.TreePop()
 		}
-		if TreeNode("Selection State: Single Selection") {
+		if g.TreeNode("Selection State: Single Selection") {
 			for n := int(0); n < 5; n++ {
 				label := fmt.Sprintf("Object %d", n)
-				if Selectable(label, widgetsState.selectable_single == n, 0, ImVec2{}) {
+				if g.Selectable(label, widgetsState.selectable_single == n, 0, ImVec2{}) {
 					widgetsState.selectable_single = n
 				}
 			}
-			TreePop()
+			g.TreePop()
 		}
-		if TreeNode("Selection State: Multiple Selection") {
-			HelpMarker("Hold CTRL and click to select multiple items.")
+		if g.TreeNode("Selection State: Multiple Selection") {
+			g.HelpMarker("Hold CTRL and click to select multiple items.")
 			for n := int(0); n < 5; n++ {
 				label := fmt.Sprintf("Object %d", n)
-				if Selectable(label, widgetsState.selectable_multiple[n], 0, ImVec2{}) {
-					if !GetIO().KeyCtrl {
+				if g.Selectable(label, widgetsState.selectable_multiple[n], 0, ImVec2{}) {
+					if !g.GetIO().KeyCtrl {
 						// Clear selection when CTRL is not held
 						for i := range widgetsState.selectable_multiple {
 							widgetsState.selectable_multiple[i] = false
@@ -1063,48 +1118,100 @@ func ShowDemoWindowWidgets() {
 					widgetsState.selectable_multiple[n] = !widgetsState.selectable_multiple[n]
 				}
 			}
-			TreePop()
-		}
-		if TreeNode("Rendering more text into the same line") {
-			SelectablePointer("main.c", &widgetsState.selectable_render[0], 0, ImVec2{})
-			SameLine(300, 0)
-			Text(" 2,345 bytes")
-			SelectablePointer("Hello.cpp", &widgetsState.selectable_render[1], 0, ImVec2{})
-			SameLine(300, 0)
-			Text("12,345 bytes")
-			SelectablePointer("Hello.h", &widgetsState.selectable_render[2], 0, ImVec2{})
-			SameLine(300, 0)
-			Text(" 2,345 bytes")
-			TreePop()
-		}
-		if TreeNode("In columns") {
-			if BeginTable("split1", 3, ImGuiTableFlags_Resizable|ImGuiTableFlags_NoSavedSettings|ImGuiTableFlags_Borders, ImVec2{}, 0) {
+			g.TreePop()
+		}
+		if g.TreeNode("Multi-Select") {
+			g.HelpMarker("Supported by Selectable() and TreeNode(): CTRL+Click to toggle, SHIFT+Click to select a range, CTRL+A to select all, SHIFT+Arrows to extend the selection, click and drag from empty space to box-select.")
+			var selection = &widgetsState.multiselect_basic
+			const items_count = 50
+			g.Text("Selection: %d/%d", selection.Size, items_count)
+
+			// The selection is stored by the application, BeginMultiSelect() and EndMultiSelect() emit requests to update it.
+			if g.BeginChild("##Basket", ImVec2{-FLT_MIN, g.GetFontSize() * 20}, true, ImGuiWindowFlags_None) {
+				var flags = ImGuiMultiSelectFlags_ClearOnEscape | ImGuiMultiSelectFlags_BoxSelect1d
+				var ms_io = g.BeginMultiSelect(flags, selection.Size, items_count)
+				selection.ApplyRequests(ms_io)
+				for n := int(0); n < items_count; n++ {
+					label := fmt.Sprintf("Object %05d", n)
+					g.SetNextItemSelectionUserData(ImGuiSelectionUserData(n))
+					g.Selectable(label, selection.Contains(ImGuiID(n)), 0, ImVec2{})
+				}
+				ms_io = g.EndMultiSelect()
+				selection.ApplyRequests(ms_io)
+			}
+			g.EndChild()
+			g.TreePop()
+		}
+		if g.TreeNode("Multi-Select (with clipper)") {
+			g.HelpMarker("Only the visible items are submitted: the selection requests describe ranges of items, including the items that were clipped.")
+			var selection = &widgetsState.multiselect_clipper
+			const items_count = 1000000
+			g.Text("Selection: %d/%d", selection.Size, items_count)
+
+			if g.BeginChild("##Basket", ImVec2{-FLT_MIN, g.GetFontSize() * 20}, true, ImGuiWindowFlags_None) {
+				var flags = ImGuiMultiSelectFlags_ClearOnEscape | ImGuiMultiSelectFlags_BoxSelect1d
+				var ms_io = g.BeginMultiSelect(flags, selection.Size, items_count)
+				selection.ApplyRequests(ms_io)
+
+				var clipper = NewImGuiListClipper()
+				clipper.Ctx = g
+				clipper.Begin(items_count, -1)
+				if ms_io.RangeSrcItem != ImGuiSelectionUserData_Invalid {
+					clipper.IncludeItemByIndex(int(ms_io.RangeSrcItem)) // Ensure RangeSrc item is not clipped.
+				}
+				for clipper.Step() {
+					for n := clipper.DisplayStart; n < clipper.DisplayEnd; n++ {
+						label := fmt.Sprintf("Object %07d", n)
+						g.SetNextItemSelectionUserData(ImGuiSelectionUserData(n))
+						g.Selectable(label, selection.Contains(ImGuiID(n)), 0, ImVec2{})
+					}
+				}
+				ms_io = g.EndMultiSelect()
+				selection.ApplyRequests(ms_io)
+			}
+			g.EndChild()
+			g.TreePop()
+		}
+		if g.TreeNode("Rendering more text into the same line") {
+			g.SelectablePointer("main.c", &widgetsState.selectable_render[0], 0, ImVec2{})
+			g.SameLine(300, 0)
+			g.Text(" 2,345 bytes")
+			g.SelectablePointer("Hello.cpp", &widgetsState.selectable_render[1], 0, ImVec2{})
+			g.SameLine(300, 0)
+			g.Text("12,345 bytes")
+			g.SelectablePointer("Hello.h", &widgetsState.selectable_render[2], 0, ImVec2{})
+			g.SameLine(300, 0)
+			g.Text(" 2,345 bytes")
+			g.TreePop()
+		}
+		if g.TreeNode("In columns") {
+			if g.BeginTable("split1", 3, ImGuiTableFlags_Resizable|ImGuiTableFlags_NoSavedSettings|ImGuiTableFlags_Borders, ImVec2{}, 0) {
 				for i := int(0); i < 10; i++ {
 					label := fmt.Sprintf("Item %d", i)
-					TableNextColumn()
-					SelectablePointer(label, &widgetsState.selectable_columns[i], 0, ImVec2{})
+					g.TableNextColumn()
+					g.SelectablePointer(label, &widgetsState.selectable_columns[i], 0, ImVec2{})
 				}
-				EndTable()
+				g.EndTable()
 			}
-			Separator()
-			if BeginTable("split2", 3, ImGuiTableFlags_Resizable|ImGuiTableFlags_NoSavedSettings|ImGuiTableFlags_Borders, ImVec2{}, 0) {
+			g.Separator()
+			if g.BeginTable("split2", 3, ImGuiTableFlags_Resizable|ImGuiTableFlags_NoSavedSettings|ImGuiTableFlags_Borders, ImVec2{}, 0) {
 				for i := int(0); i < 10; i++ {
 					label := fmt.Sprintf("Item %d", i)
-					TableNextRow(0, 0)
-					TableNextColumn()
-					SelectablePointer(label, &widgetsState.selectable_columns[i], ImGuiSelectableFlags_SpanAllColumns, ImVec2{})
-					TableNextColumn()
-					Text("Some other contents")
-					TableNextColumn()
-					Text("123456")
+					g.TableNextRow(0, 0)
+					g.TableNextColumn()
+					g.SelectablePointer(label, &widgetsState.selectable_columns[i], ImGuiSelectableFlags_SpanAllColumns, ImVec2{})
+					g.TableNextColumn()
+					g.Text("Some other contents")
+					g.TableNextColumn()
+					g.Text("123456")
 				}
-				EndTable()
+				g.EndTable()
 			}
-			TreePop()
+			g.TreePop()
 		}
-		if TreeNode("Grid") {
+		if g.TreeNode("Grid") {
 			// Add in a bit of silly fun...
-			time := float(GetTime())
+			time := float(g.GetTime())
 			winning_state := true
 			for ri := 0; ri < 4; ri++ {
 				for ci := 0; ci < 4; ci++ {
@@ -1114,16 +1221,16 @@ func ShowDemoWindowWidgets() {
 				}
 			}
 			if winning_state {
-				PushStyleVec(ImGuiStyleVar_SelectableTextAlign, ImVec2{0.5 + 0.5*ImCos(time*2.0), 0.5 + 0.5*ImSin(time*3.0)})
+				g.PushStyleVec(ImGuiStyleVar_SelectableTextAlign, ImVec2{0.5 + 0.5*ImCos(time*2.0), 0.5 + 0.5*ImSin(time*3.0)})
 			}
 
 			for ri := 0; ri < 4; ri++ {
 				for ci := 0; ci < 4; ci++ {
 					if ci > 0 {
-						SameLine(0, 0)
+						g.SameLine(0, 0)
 					}
-					PushID(int(ri*4 + ci))
-					if Selectable("Sailor", widgetsState.selectable_grid[ri][ci], 0, ImVec2{50, 50}) {
+					g.PushID(int(ri*4 + ci))
+					if g.Selectable("Sailor", widgetsState.selectable_grid[ri][ci], 0, ImVec2{50, 50}) {
 						// Toggle clicked cell + toggle neighbors
 						widgetsState.selectable_grid[ri][ci] = !widgetsState.selectable_grid[ri][ci]
 						if ci > 0 {
@@ -1139,93 +1246,93 @@ func ShowDemoWindowWidgets() {
 							widgetsState.selectable_grid[ri+1][ci] = !widgetsState.selectable_grid[ri+1][ci]
 						}
 					}
-					PopID()
+					g.PopID()
 				}
 			}
 
 			if winning_state {
-				PopStyleVar(1)
+				g.PopStyleVar(1)
 			}
-			TreePop()
+			g.TreePop()
 		}
-		TreePop()
+		g.TreePop()
 	}
 
 	// Tabs
-	if TreeNode("Tabs") {
-		if TreeNode("Basic") {
+	if g.TreeNode("Tabs") {
+		if g.TreeNode("Basic") {
 			tab_bar_flags := ImGuiTabBarFlags_None
-			if BeginTabBar("MyTabBar", tab_bar_flags) {
-				if BeginTabItem("Avocado", nil, 0) {
-					Text("This is the Avocado tab!\nblah blah blah blah blah")
-					EndTabItem()
+			if g.BeginTabBar("MyTabBar", tab_bar_flags) {
+				if g.BeginTabItem("Avocado", nil, 0) {
+					g.Text("This is the Avocado tab!\nblah blah blah blah blah")
+					g.EndTabItem()
 				}
-				if BeginTabItem("Broccoli", nil, 0) {
-					Text("This is the Broccoli tab!\nblah blah blah blah blah")
-					EndTabItem()
+				if g.BeginTabItem("Broccoli", nil, 0) {
+					g.Text("This is the Broccoli tab!\nblah blah blah blah blah")
+					g.EndTabItem()
 				}
-				if BeginTabItem("Cucumber", nil, 0) {
-					Text("This is the Cucumber tab!\nblah blah blah blah blah")
-					EndTabItem()
+				if g.BeginTabItem("Cucumber", nil, 0) {
+					g.Text("This is the Cucumber tab!\nblah blah blah blah blah")
+					g.EndTabItem()
 				}
-				EndTabBar()
+				g.EndTabBar()
 			}
-			Separator()
-			TreePop()
+			g.Separator()
+			g.TreePop()
 		}
 
-		if TreeNode("Advanced & Close Button") {
+		if g.TreeNode("Advanced & Close Button") {
 			// Expose a couple of the available flags
 			tab_bar_flags_int := int(widgetsState.tab_bar_flags)
-			CheckboxFlagsInt("ImGuiTabBarFlags_Reorderable", &tab_bar_flags_int, int(ImGuiTabBarFlags_Reorderable))
-			CheckboxFlagsInt("ImGuiTabBarFlags_AutoSelectNewTabs", &tab_bar_flags_int, int(ImGuiTabBarFlags_AutoSelectNewTabs))
-			CheckboxFlagsInt("ImGuiTabBarFlags_TabListPopupButton", &tab_bar_flags_int, int(ImGuiTabBarFlags_TabListPopupButton))
-			CheckboxFlagsInt("ImGuiTabBarFlags_NoCloseWithMiddleMouseButton", &tab_bar_flags_int, int(ImGuiTabBarFlags_NoCloseWithMiddleMouseButton))
+			g.CheckboxFlagsInt("ImGuiTabBarFlags_Reorderable", &tab_bar_flags_int, int(ImGuiTabBarFlags_Reorderable))
+			g.CheckboxFlagsInt("ImGuiTabBarFlags_AutoSelectNewTabs", &tab_bar_flags_int, int(ImGuiTabBarFlags_AutoSelectNewTabs))
+			g.CheckboxFlagsInt("ImGuiTabBarFlags_TabListPopupButton", &tab_bar_flags_int, int(ImGuiTabBarFlags_TabListPopupButton))
+			g.CheckboxFlagsInt("ImGuiTabBarFlags_NoCloseWithMiddleMouseButton", &tab_bar_flags_int, int(ImGuiTabBarFlags_NoCloseWithMiddleMouseButton))
 			widgetsState.tab_bar_flags = ImGuiTabBarFlags(tab_bar_flags_int)
 
 			// Tab Bar
 			names := []string{"Artichoke", "Beetroot", "Celery", "Daikon"}
 			for n := int(0); n < int(len(names)); n++ {
 				if n > 0 {
-					SameLine(0, 0)
+					g.SameLine(0, 0)
 				}
-				Checkbox(names[n], &widgetsState.tabs_opened[n])
+				g.Checkbox(names[n], &widgetsState.tabs_opened[n])
 			}
 
 			// Passing a bool* to BeginTabItem() is similar to passing one to Begin()
-			if BeginTabBar("MyTabBar2", widgetsState.tab_bar_flags) {
+			if g.BeginTabBar("MyTabBar2", widgetsState.tab_bar_flags) {
 				for n := int(0); n < int(len(names)); n++ {
-					if widgetsState.tabs_opened[n] && BeginTabItem(names[n], &widgetsState.tabs_opened[n], ImGuiTabItemFlags_None) {
-						Text("This is the %s tab!", names[n])
+					if widgetsState.tabs_opened[n] && g.BeginTabItem(names[n], &widgetsState.tabs_opened[n], ImGuiTabItemFlags_None) {
+						g.Text("This is the %s tab!", names[n])
 						if n&1 != 0 {
-							Text("I am an odd tab.")
+							g.Text("I am an odd tab.")
 						}
-						EndTabItem()
+						g.EndTabItem()
 					}
 				}
-				EndTabBar()
+				g.EndTabBar()
 			}
-			Separator()
-			TreePop()
+			g.Separator()
+			g.TreePop()
 		}
-		TreePop()
+		g.TreePop()
 	}
 
 	// Plots Widgets
-	if TreeNode("Plots Widgets") {
-		Checkbox("Animate", &widgetsState.plots_animate)
+	if g.TreeNode("Plots Widgets") {
+		g.Checkbox("Animate", &widgetsState.plots_animate)
 
 		// Plot as lines and plot as histogram
 		// Note: stride is 1 in Go (element count), not sizeof(float) like in C++
 		arr := []float{0.6, 0.1, 1.0, 0.5, 0.92, 0.1, 0.2}
-		PlotLines("Frame Times", arr, int(len(arr)), 0, "", FLT_MAX, FLT_MAX, ImVec2{}, 1)
-		PlotHistogram("Histogram", arr, int(len(arr)), 0, "", 0.0, 1.0, ImVec2{0, 80.0}, 1)
+		g.PlotLines("Frame Times", arr, int(len(arr)), 0, "", FLT_MAX, FLT_MAX, ImVec2{}, 1)
+		g.PlotHistogram("Histogram", arr, int(len(arr)), 0, "", 0.0, 1.0, ImVec2{0, 80.0}, 1)
 
 		// Fill an array of contiguous float values to plot
 		if !widgetsState.plots_animate || widgetsState.plots_refresh == 0.0 {
-			widgetsState.plots_refresh = GetTime()
+			widgetsState.plots_refresh = g.GetTime()
 		}
-		for widgetsState.plots_refresh < GetTime() {
+		for widgetsState.plots_refresh < g.GetTime() {
 			widgetsState.plots_values[widgetsState.plots_offset] = ImCos(widgetsState.plots_phase)
 			widgetsState.plots_offset = (widgetsState.plots_offset + 1) % int(len(widgetsState.plots_values))
 			widgetsState.plots_phase += 0.10 * float(widgetsState.plots_offset)
@@ -1240,14 +1347,14 @@ func ShowDemoWindowWidgets() {
 			}
 			average /= float(len(widgetsState.plots_values))
 			overlay := fmt.Sprintf("avg %f", average)
-			PlotLines("Lines", widgetsState.plots_values[:], int(len(widgetsState.plots_values)), widgetsState.plots_offset, overlay, -1.0, 1.0, ImVec2{0, 80.0}, 1)
+			g.PlotLines("Lines", widgetsState.plots_values[:], int(len(widgetsState.plots_values)), widgetsState.plots_offset, overlay, -1.0, 1.0, ImVec2{0, 80.0}, 1)
 		}
 
-		Separator()
+		g.Separator()
 
 		// Animate a simple progress bar
 		if widgetsState.plots_animate {
-			widgetsState.plots_progress += widgetsState.plots_progress_dir * 0.4 * GetIO().DeltaTime
+			widgetsState.plots_progress += widgetsState.plots_progress_dir * 0.4 * g.GetIO().DeltaTime
 			if widgetsState.plots_progress >= 1.1 {
 				widgetsState.plots_progress = 1.1
 				widgetsState.plots_progress_dir *= -1.0
@@ -1259,9 +1366,9 @@ func ShowDemoWindowWidgets() {
 		}
 
 		// Typically we would use ImVec2(-1.0f,0.0f) or ImVec2(-FLT_MIN,0.0f) to use all available width
-		ProgressBar(widgetsState.plots_progress, ImVec2{0.0, 0.0}, "")
-		SameLine(0, GetStyle().ItemInnerSpacing.x)
-		Text("Progress Bar")
+		g.ProgressBar(widgetsState.plots_progress, ImVec2{0.0, 0.0}, "")
+		g.SameLine(0, g.GetStyle().ItemInnerSpacing.x)
+		g.Text("Progress Bar")
 
 		progress_saturated := widgetsState.plots_progress
 		if progress_saturated < 0.0 {
@@ -1271,21 +1378,21 @@ func ShowDemoWindowWidgets() {
 			progress_saturated = 1.0
 		}
 		buf := fmt.Sprintf("%d/%d", int(progress_saturated*1753), 1753)
-		ProgressBar(widgetsState.plots_progress, ImVec2{0.0, 0.0}, buf)
-		TreePop()
+		g.ProgressBar(widgetsState.plots_progress, ImVec2{0.0, 0.0}, buf)
+		g.TreePop()
 	}
 
 	// Color/Picker Widgets
-	if TreeNode("Color/Picker Widgets") {
-		Checkbox("With Alpha Preview", &widgetsState.color_alpha_preview)
-		Checkbox("With Half Alpha Preview", &widgetsState.color_alpha_half_preview)
-		Checkbox("With Drag and Drop", &widgetsState.color_drag_and_drop)
-		Checkbox("With Options Menu", &widgetsState.color_options_menu)
-		SameLine(0, 0)
-		HelpMarker("Right-click on the individual color widget to show options.")
-		Checkbox("With HDR", &widgetsState.color_hdr)
-		SameLine(0, 0)
-		HelpMarker("Currently all this does is to lift the 0..1 limits on dragging widgets.")
+	if g.TreeNode("Color/Picker Widgets") {
+		g.Checkbox("With Alpha Preview", &widgetsState.color_alpha_preview)
+		g.Checkbox("With Half Alpha Preview", &widgetsState.color_alpha_half_preview)
+		g.Checkbox("With Drag and Drop", &widgetsState.color_drag_and_drop)
+		g.Checkbox("With Options Menu", &widgetsState.color_options_menu)
+		g.SameLine(0, 0)
+		g.HelpMarker("Right-click on the individual color widget to show options.")
+		g.Checkbox("With HDR", &widgetsState.color_hdr)
+		g.SameLine(0, 0)
+		g.HelpMarker("Currently all this does is to lift the 0..1 limits on dragging widgets.")
 
 		misc_flags := ImGuiColorEditFlags(0)
 		if widgetsState.color_hdr {
@@ -1303,58 +1410,58 @@ func ShowDemoWindowWidgets() {
 			misc_flags |= ImGuiColorEditFlags_NoOptions
 		}
 
-		Text("Color widget:")
-		SameLine(0, 0)
-		HelpMarker("Click on the color square to open a color picker.\nCTRL+click on individual component to input value.")
+		g.Text("Color widget:")
+		g.SameLine(0, 0)
+		g.HelpMarker("Click on the color square to open a color picker.\nCTRL+click on individual component to input value.")
 		col3 := [3]float{widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z}
-		ColorEdit3("MyColor##1", &col3, misc_flags)
+		g.ColorEdit3("MyColor##1", &col3, misc_flags)
 		widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z = col3[0], col3[1], col3[2]
 
-		Text("Color widget HSV with Alpha:")
+		g.Text("Color widget HSV with Alpha:")
 		col4 := [4]float{widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z, widgetsState.color_picker_color.w}
-		ColorEdit4("MyColor##2", &col4, ImGuiColorEditFlags_DisplayHSV|misc_flags)
+		g.ColorEdit4("MyColor##2", &col4, ImGuiColorEditFlags_DisplayHSV|misc_flags)
 		widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z, widgetsState.color_picker_color.w = col4[0], col4[1], col4[2], col4[3]
 
-		Text("Color widget with Float Display:")
+		g.Text("Color widget with Float Display:")
 		col4 = [4]float{widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z, widgetsState.color_picker_color.w}
-		ColorEdit4("MyColor##2f", &col4, ImGuiColorEditFlags_Float|misc_flags)
+		g.ColorEdit4("MyColor##2f", &col4, ImGuiColorEditFlags_Float|misc_flags)
 		widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z, widgetsState.color_picker_color.w = col4[0], col4[1], col4[2], col4[3]
 
-		Text("Color button with Picker:")
-		SameLine(0, 0)
-		HelpMarker("With the ImGuiColorEditFlags_NoInputs flag you can hide all the slider/text inputs.\nWith the ImGuiColorEditFlags_NoLabel flag you can pass a non-empty label which will only be used for the tooltip and picker popup.")
+		g.Text("Color button with Picker:")
+		g.SameLine(0, 0)
+		g.HelpMarker("With the ImGuiColorEditFlags_NoInputs flag you can hide all the slider/text inputs.\nWith the ImGuiColorEditFlags_NoLabel flag you can pass a non-empty label which will only be used for the tooltip and picker popup.")
 		col4 = [4]float{widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z, widgetsState.color_picker_color.w}
-		ColorEdit4("MyColor##3", &col4, ImGuiColorEditFlags_NoInputs|ImGuiColorEditFlags_NoLabel|misc_flags)
+		g.ColorEdit4("MyColor##3", &col4, ImGuiColorEditFlags_NoInputs|ImGuiColorEditFlags_NoLabel|misc_flags)
 		widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z, widgetsState.color_picker_color.w = col4[0], col4[1], col4[2], col4[3]
 
-		Text("Color button only:")
-		Checkbox("ImGuiColorEditFlags_NoBorder", &widgetsState.color_no_border)
+		g.Text("Color button only:")
+		g.Checkbox("ImGuiColorEditFlags_NoBorder", &widgetsState.color_no_border)
 		no_border_flag := ImGuiColorEditFlags(0)
 		if widgetsState.color_no_border {
 			no_border_flag = ImGuiColorEditFlags_NoBorder
 		}
-		ColorButton("MyColor##3c", widgetsState.color_picker_color, misc_flags|no_border_flag, ImVec2{80, 80})
+		g.ColorButton("MyColor##3c", widgetsState.color_picker_color, misc_flags|no_border_flag, ImVec2{80, 80})
 
-		Text("Color picker:")
-		Checkbox("With Alpha", &widgetsState.color_alpha)
-		Checkbox("With Alpha Bar", &widgetsState.color_alpha_bar)
-		Checkbox("With Side Preview", &widgetsState.color_side_preview)
+		g.Text("Color picker:")
+		g.Checkbox("With Alpha", &widgetsState.color_alpha)
+		g.Checkbox("With Alpha Bar", &widgetsState.color_alpha_bar)
+		g.Checkbox("With Side Preview", &widgetsState.color_side_preview)
 		if widgetsState.color_side_preview {
-			SameLine(0, 0)
-			Checkbox("With Ref Color", &widgetsState.color_ref_color)
+			g.SameLine(0, 0)
+			g.Checkbox("With Ref Color", &widgetsState.color_ref_color)
 			if widgetsState.color_ref_color {
-				SameLine(0, 0)
+				g.SameLine(0, 0)
 				ref_col := [4]float{widgetsState.color_ref_color_v.x, widgetsState.color_ref_color_v.y, widgetsState.color_ref_color_v.z, widgetsState.color_ref_color_v.w}
-				ColorEdit4("##RefColor", &ref_col, ImGuiColorEditFlags_NoInputs|misc_flags)
+				g.ColorEdit4("##RefColor", &ref_col, ImGuiColorEditFlags_NoInputs|misc_flags)
 				widgetsState.color_ref_color_v.x, widgetsState.color_ref_color_v.y, widgetsState.color_ref_color_v.z, widgetsState.color_ref_color_v.w = ref_col[0], ref_col[1], ref_col[2], ref_col[3]
 			}
 		}
-		Combo("Display Mode", &widgetsState.color_display_mode, []string{"Auto/Current", "None", "RGB Only", "HSV Only", "Hex Only"}, 5, -1)
-		SameLine(0, 0)
-		HelpMarker("ColorEdit defaults to displaying RGB inputs if you don't specify a display mode, but the user can change it with a right-click.\n\nColorPicker defaults to displaying RGB+HSV+Hex if you don't specify a display mode.\n\nYou can change the defaults using SetColorEditOptions().")
-		Combo("Picker Mode", &widgetsState.color_picker_mode, []string{"Auto/Current", "Hue bar + SV rect", "Hue wheel + SV triangle"}, 3, -1)
-		SameLine(0, 0)
-		HelpMarker("User can right-click the picker to change mode.")
+		g.Combo("Display Mode", &widgetsState.color_display_mode, []string{"Auto/Current", "None", "RGB Only", "HSV Only", "Hex Only"}, 5, -1)
+		g.SameLine(0, 0)
+		g.HelpMarker("ColorEdit defaults to displaying RGB inputs if you don't specify a display mode, but the user can change it with a right-click.\n\nColorPicker defaults to displaying RGB+HSV+Hex if you don't specify a display mode.\n\nYou can change the defaults using SetColorEditOptions().")
+		g.Combo("Picker Mode", &widgetsState.color_picker_mode, []string{"Auto/Current", "Hue bar + SV rect", "Hue wheel + SV triangle"}, 3, -1)
+		g.SameLine(0, 0)
+		g.HelpMarker("User can right-click the picker to change mode.")
 
 		picker_flags := misc_flags
 		if !widgetsState.color_alpha {
@@ -1390,77 +1497,212 @@ func ShowDemoWindowWidgets() {
 		if widgetsState.color_ref_color {
 			ref_col = []float{widgetsState.color_ref_color_v.x, widgetsState.color_ref_color_v.y, widgetsState.color_ref_color_v.z, widgetsState.color_ref_color_v.w}
 		}
-		ColorPicker4("MyColor##4", &col4, picker_flags, ref_col)
+		g.ColorPicker4("MyColor##4", &col4, picker_flags, ref_col)
 		widgetsState.color_picker_color.x, widgetsState.color_picker_color.y, widgetsState.color_picker_color.z, widgetsState.color_picker_color.w = col4[0], col4[1], col4[2], col4[3]
 
-		Text("Set defaults in code:")
-		SameLine(0, 0)
-		HelpMarker("SetColorEditOptions() is designed to allow you to set boot-time default.\nWe don't have Push/Pop functions because you can force options on a per-widget basis if needed, and the user can change non-forced ones with the options menu.\nWe don't have a getter to avoid encouraging you to persistently save values that aren't forward-compatible.")
-		if Button("Default: Uint8 + HSV + Hue Bar") {
-			SetColorEditOptions(ImGuiColorEditFlags_Uint8 | ImGuiColorEditFlags_DisplayHSV | ImGuiColorEditFlags_PickerHueBar)
+		g.Text("Set defaults in code:")
+		g.SameLine(0, 0)
+		g.HelpMarker("SetColorEditOptions() is designed to allow you to set boot-time default.\nWe don't have Push/Pop functions because you can force options on a per-widget basis if needed, and the user can change non-forced ones with the options menu.\nWe don't have a getter to avoid encouraging you to persistently save values that aren't forward-compatible.")
+		if g.Button("Default: Uint8 + HSV + Hue Bar") {
+			g.SetColorEditOptions(ImGuiColorEditFlags_Uint8 | ImGuiColorEditFlags_DisplayHSV | ImGuiColorEditFlags_PickerHueBar)
 		}
-		if Button("Default: Float + HDR + Hue Wheel") {
-			SetColorEditOptions(ImGuiColorEditFlags_Float | ImGuiColorEditFlags_HDR | ImGuiColorEditFlags_PickerHueWheel)
+		if g.Button("Default: Float + HDR + Hue Wheel") {
+			g.SetColorEditOptions(ImGuiColorEditFlags_Float | ImGuiColorEditFlags_HDR | ImGuiColorEditFlags_PickerHueWheel)
 		}
 
 		// HSV encoded support
-		Spacing()
-		Text("HSV encoded colors")
-		SameLine(0, 0)
-		HelpMarker("By default, colors are given to ColorEdit and ColorPicker in RGB, but ImGuiColorEditFlags_InputHSV allows you to store colors as HSV and pass them to ColorEdit and ColorPicker as HSV. This comes with the added benefit that you can manipulate hue values with the picker even when saturation or value are zero.")
-		Text("Color widget with InputHSV:")
+		g.Spacing()
+		g.Text("HSV encoded colors")
+		g.SameLine(0, 0)
+		g.HelpMarker("By default, colors are given to ColorEdit and ColorPicker in RGB, but ImGuiColorEditFlags_InputHSV allows you to store colors as HSV and pass them to ColorEdit and ColorPicker as HSV. This comes with the added benefit that you can manipulate hue values with the picker even when saturation or value are zero.")
+		g.Text("Color widget with InputHSV:")
 		hsv_col := [4]float{widgetsState.color_hsv.x, widgetsState.color_hsv.y, widgetsState.color_hsv.z, widgetsState.color_hsv.w}
-		ColorEdit4("HSV shown as RGB##1", &hsv_col, ImGuiColorEditFlags_DisplayRGB|ImGuiColorEditFlags_InputHSV|ImGuiColorEditFlags_Float)
-		ColorEdit4("HSV shown as HSV##1", &hsv_col, ImGuiColorEditFlags_DisplayHSV|ImGuiColorEditFlags_InputHSV|ImGuiColorEditFlags_Float)
+		g.ColorEdit4("HSV shown as RGB##1", &hsv_col, ImGuiColorEditFlags_DisplayRGB|ImGuiColorEditFlags_InputHSV|ImGuiColorEditFlags_Float)
+		g.ColorEdit4("HSV shown as HSV##1", &hsv_col, ImGuiColorEditFlags_DisplayHSV|ImGuiColorEditFlags_InputHSV|ImGuiColorEditFlags_Float)
 		widgetsState.color_hsv.x, widgetsState.color_hsv.y, widgetsState.color_hsv.z, widgetsState.color_hsv.w = hsv_col[0], hsv_col[1], hsv_col[2], hsv_col[3]
 
-		TreePop()
+		g.TreePop()
 	}
 
 	// Drag/Slider Flags
-	if TreeNode("Drag/Slider Flags") {
+	if g.TreeNode("Drag/Slider Flags") {
 		// Demonstrate using advanced flags for DragXXX and SliderXXX functions
 		slider_flags_int := int(widgetsState.slider_flags)
-		CheckboxFlagsInt("ImGuiSliderFlags_AlwaysClamp", &slider_flags_int, int(ImGuiSliderFlags_AlwaysClamp))
-		SameLine(0, 0)
-		HelpMarker("Always clamp value to min/max bounds (if any) when input manually with CTRL+Click.")
-		CheckboxFlagsInt("ImGuiSliderFlags_Logarithmic", &slider_flags_int, int(ImGuiSliderFlags_Logarithmic))
-		SameLine(0, 0)
-		HelpMarker("Enable logarithmic editing (more precision for small values).")
-		CheckboxFlagsInt("ImGuiSliderFlags_NoRoundToFormat", &slider_flags_int, int(ImGuiSliderFlags_NoRoundToFormat))
-		SameLine(0, 0)
-		HelpMarker("Disable rounding underlying value to match precision of the format string (e.g. %.3f values are rounded to those 3 digits).")
-		CheckboxFlagsInt("ImGuiSliderFlags_NoInput", &slider_flags_int, int(ImGuiSliderFlags_NoInput))
-		SameLine(0, 0)
-		HelpMarker("Disable CTRL+Click or Enter key allowing to input text directly into the widget.")
+		g.CheckboxFlagsInt("ImGuiSliderFlags_AlwaysClamp", &slider_flags_int, int(ImGuiSliderFlags_AlwaysClamp))
+		g.SameLine(0, 0)
+		g.HelpMarker("Always clamp value to min/max bounds (if any) when input manually with CTRL+Click.")
+		g.CheckboxFlagsInt("ImGuiSliderFlags_Logarithmic", &slider_flags_int, int(ImGuiSliderFlags_Logarithmic))
+		g.SameLine(0, 0)
+		g.HelpMarker("Enable logarithmic editing (more precision for small values).")
+		g.CheckboxFlagsInt("ImGuiSliderFlags_NoRoundToFormat", &slider_flags_int, int(ImGuiSliderFlags_NoRoundToFormat))
+		g.SameLine(0, 0)
+		g.HelpMarker("Disable rounding underlying value to match precision of the format string (e.g. %.3f values are rounded to those 3 digits).")
+		g.CheckboxFlagsInt("ImGuiSliderFlags_NoInput", &slider_flags_int, int(ImGuiSliderFlags_NoInput))
+		g.SameLine(0, 0)
+		g.HelpMarker("Disable CTRL+Click or Enter key allowing to input text directly into the widget.")
 		widgetsState.slider_flags = ImGuiSliderFlags(slider_flags_int)
 
 		// Drags
-		Text("Underlying float value: %f", widgetsState.drag_f)
-		DragFloat("DragFloat (0 -> 1)", &widgetsState.drag_f, 0.005, 0.0, 1.0, "%.3f", widgetsState.slider_flags)
-		DragFloat("DragFloat (0 -> +inf)", &widgetsState.drag_f, 0.005, 0.0, FLT_MAX, "%.3f", widgetsState.slider_flags)
-		DragFloat("DragFloat (-inf -> 1)", &widgetsState.drag_f, 0.005, -FLT_MAX, 1.0, "%.3f", widgetsState.slider_flags)
-		DragFloat("DragFloat (-inf -> +inf)", &widgetsState.drag_f, 0.005, -FLT_MAX, FLT_MAX, "%.3f", widgetsState.slider_flags)
-		DragInt("DragInt (0 -> 100)", &widgetsState.drag_i, 0.5, 0, 100, "%d", widgetsState.slider_flags)
+		g.Text("Underlying float value: %f", widgetsState.drag_f)
+		g.DragFloat("DragFloat (0 -> 1)", &widgetsState.drag_f, 0.005, 0.0, 1.0, "%.3f", widgetsState.slider_flags)
+		g.DragFloat("DragFloat (0 -> +inf)", &widgetsState.drag_f, 0.005, 0.0, FLT_MAX, "%.3f", widgetsState.slider_flags)
+		g.DragFloat("DragFloat (-inf -> 1)", &widgetsState.drag_f, 0.005, -FLT_MAX, 1.0, "%.3f", widgetsState.slider_flags)
+		g.DragFloat("DragFloat (-inf -> +inf)", &widgetsState.drag_f, 0.005, -FLT_MAX, FLT_MAX, "%.3f", widgetsState.slider_flags)
+		g.DragInt("DragInt (0 -> 100)", &widgetsState.drag_i, 0.5, 0, 100, "%d", widgetsState.slider_flags)
 
 		// Sliders
-		Text("Underlying float value: %f", widgetsState.slider_f)
-		SliderFloat("SliderFloat (0 -> 1)", &widgetsState.slider_f, 0.0, 1.0, "%.3f", widgetsState.slider_flags)
-		SliderInt("SliderInt (0 -> 100)", &widgetsState.slider_i, 0, 100, "%d", widgetsState.slider_flags)
+		g.Text("Underlying float value: %f", widgetsState.slider_f)
+		g.SliderFloat("SliderFloat (0 -> 1)", &widgetsState.slider_f, 0.0, 1.0, "%.3f", widgetsState.slider_flags)
+		g.SliderInt("SliderInt (0 -> 100)", &widgetsState.slider_i, 0, 100, "%d", widgetsState.slider_flags)
 
-		TreePop()
+		g.TreePop()
 	}
 
 	// Range Widgets
-	if TreeNode("Range Widgets") {
-		DragFloatRange2("range float", &widgetsState.range_begin, &widgetsState.range_end, 0.25, 0.0, 100.0, "Min: %.1f %%", "Max: %.1f %%", ImGuiSliderFlags_AlwaysClamp)
-		DragIntRange2("range int", &widgetsState.range_begin_i, &widgetsState.range_end_i, 5, 0, 1000, "Min: %d units", "Max: %d units", 0)
-		DragIntRange2("range int (no bounds)", &widgetsState.range_begin_i, &widgetsState.range_end_i, 5, 0, 0, "Min: %d units", "Max: %d units", 0)
-		TreePop()
+	if g.TreeNode("Range Widgets") {
+		g.DragFloatRange2("range float", &widgetsState.range_begin, &widgetsState.range_end, 0.25, 0.0, 100.0, "Min: %.1f %%", "Max: %.1f %%", ImGuiSliderFlags_AlwaysClamp)
+		g.DragIntRange2("range int", &widgetsState.range_begin_i, &widgetsState.range_end_i, 5, 0, 1000, "Min: %d units", "Max: %d units", 0)
+		g.DragIntRange2("range int (no bounds)", &widgetsState.range_begin_i, &widgetsState.range_end_i, 5, 0, 0, "Min: %d units", "Max: %d units", 0)
+		g.TreePop()
+	}
+
+	if g.TreeNode("Drag and Drop") {
+		if g.TreeNode("Drag and drop in standard widgets") {
+			// ColorEdit widgets automatically act as drag source and drag target.
+			// They are using standardized payload strings IMGUI_PAYLOAD_TYPE_COLOR_3F and IMGUI_PAYLOAD_TYPE_COLOR_4F
+			// to allow your own widgets to use colors in their drag and drop interaction.
+			// Also see 'Demo->Widgets->Color/Picker Widgets->Palette' demo.
+			g.HelpMarker("You can drag from the color squares.")
+			g.ColorEdit3("color 1", &widgetsState.dnd_col1, 0)
+			g.ColorEdit4("color 2", &widgetsState.dnd_col2, 0)
+			g.TreePop()
+		}
+
+		if g.TreeNode("Drag and drop to copy/swap items") {
+			const (
+				Mode_Copy = iota
+				Mode_Move
+				Mode_Swap
+			)
+			var mode = &widgetsState.dnd_mode
+			g.RadioButtonInt("Copy", mode, Mode_Copy)
+			g.SameLine(0, -1)
+			g.RadioButtonInt("Move", mode, Mode_Move)
+			g.SameLine(0, -1)
+			g.RadioButtonInt("Swap", mode, Mode_Swap)
+			var names = &widgetsState.dnd_names
+			for n := int(0); n < int(len(names)); n++ {
+				g.PushID(n)
+				if (n % 3) != 0 {
+					g.SameLine(0, -1)
+				}
+				g.ButtonEx(names[n], &ImVec2{60, 60}, 0)
+
+				// Our buttons are both drag sources and drag targets here!
+				if g.BeginDragDropSource(ImGuiDragDropFlags_None) {
+					// Set payload to carry the index of our item (could be anything)
+					SetDragDropPayloadTContext(g, "DND_DEMO_CELL", n, ImGuiCond_Always)
+
+					// Display preview (could be anything, e.g. when dragging an image we could decide to display
+					// the filename and a small preview of the image, etc.)
+					switch *mode {
+					case Mode_Copy:
+						g.Text("Copy %s", names[n])
+					case Mode_Move:
+						g.Text("Move %s", names[n])
+					case Mode_Swap:
+						g.Text("Swap %s", names[n])
+					}
+					g.EndDragDropSource()
+				}
+				if g.BeginDragDropTarget() {
+					if payload_n, ok, _ := AcceptDragDropPayloadTContext[int](g, "DND_DEMO_CELL", 0); ok {
+						switch *mode {
+						case Mode_Copy:
+							names[n] = names[payload_n]
+						case Mode_Move:
+							names[n] = names[payload_n]
+							names[payload_n] = ""
+						case Mode_Swap:
+							names[n], names[payload_n] = names[payload_n], names[n]
+						}
+					}
+					g.EndDragDropTarget()
+				}
+				g.PopID()
+			}
+			g.TreePop()
+		}
+
+		if g.TreeNode("Drag to reorder items (simple)") {
+			// Simple reordering
+			g.HelpMarker(
+				"We don't use the drag and drop api at all here! " +
+					"Instead we query when the item is held but not hovered, and order items accordingly.")
+			var item_names = &widgetsState.dnd_item_names
+			for n := int(0); n < int(len(item_names)); n++ {
+				var item = item_names[n]
+				g.Selectable(item, false, 0, ImVec2{})
+
+				if g.IsItemActive() && !g.IsItemHovered(0) {
+					var n_next = n + 1
+					if g.GetMouseDragDelta(0, -1).y < 0.0 {
+						n_next = n - 1
+					}
+					if n_next >= 0 && n_next < int(len(item_names)) {
+						item_names[n] = item_names[n_next]
+						item_names[n_next] = item
+						g.ResetMouseDragDelta(0)
+					}
+				}
+			}
+			g.TreePop()
+		}
+
+		if g.TreeNode("Drag to reorder several items") {
+			g.HelpMarker(
+				"CTRL+Click to select several items, then drag them between other items.\n" +
+					"The payload carries all the selected items, the tooltip shows their count by default.")
+			var names, selected = widgetsState.dnd_multi, widgetsState.dnd_multi_sel
+			for n := int(0); n < int(len(names)); n++ {
+				if g.Selectable(names[n], selected[n], 0, ImVec2{}) {
+					if !g.GetIO().KeyCtrl {
+						for i := range selected {
+							selected[i] = false
+						}
+					}
+					selected[n] = !selected[n]
+				}
+
+				// Dragging a selected item drags the whole selection
+				if g.BeginDragDropSource(ImGuiDragDropFlags_None) {
+					var dragged = []int{n}
+					if selected[n] {
+						dragged = dragged[:0]
+						for i := range selected {
+							if selected[i] {
+								dragged = append(dragged, int(i))
+							}
+						}
+					}
+					SetDragDropPayloadItemsTContext(g, "DND_DEMO_ITEMS", dragged, ImGuiCond_Once)
+					g.EndDragDropSource()
+				}
+				if g.BeginDragDropTarget() {
+					if dragged, ok, _ := AcceptDragDropPayloadItemsTContext[int](g, "DND_DEMO_ITEMS", ImGuiDragDropFlags_AcceptInsertBeforeAfter); ok {
+						demoMoveItems(names, selected, dragged, g.GetDragDropPayload().InsertIndex(n))
+					}
+					g.EndDragDropTarget()
+				}
+			}
+			g.TreePop()
+		}
+
+		g.TreePop()
 	}
 
 	if widgetsState.disable_all {
-		EndDisabled()
+		g.EndDisabled()
 	}
 }
 
@@ -1493,15 +1735,15 @@ func init() {
 	layoutState.item = -1
 }
 
-func ShowDemoWindowLayout() {
-	if !CollapsingHeader("Layout & Scrolling", 0) {
+func (g *ImGuiContext) ShowDemoWindowLayout() {
+	if !g.CollapsingHeader("Layout & Scrolling", 0) {
 		return
 	}
 
-	if TreeNode("Child windows") {
-		HelpMarker("Use child windows to begin into a self-contained independent scrolling/clipping regions within a host window.")
-		Checkbox("Disable Mouse Wheel", &layoutState.disable_mouse_wheel)
-		Checkbox("Disable Menu", &layoutState.disable_menu)
+	if g.TreeNode("Child windows") {
+		g.HelpMarker("Use child windows to begin into a self-contained independent scrolling/clipping regions within a host window.")
+		g.Checkbox("Disable Mouse Wheel", &layoutState.disable_mouse_wheel)
+		g.Checkbox("Disable Menu", &layoutState.disable_menu)
 
 		// Child 1: no border, enable horizontal scrollbar
 		{
@@ -1509,14 +1751,14 @@ func ShowDemoWindowLayout() {
 			if layoutState.disable_mouse_wheel {
 				window_flags |= ImGuiWindowFlags_NoScrollWithMouse
 			}
-			BeginChild("ChildL", ImVec2{GetContentRegionAvail().x * 0.5, 260}, false, window_flags)
+			g.BeginChild("ChildL", ImVec2{g.GetContentRegionAvail().x * 0.5, 260}, false, window_flags)
 			for i := int(0); i < 100; i++ {
-				Text("%04d: scrollable region", i)
+				g.Text("%04d: scrollable region", i)
 			}
-			EndChild()
+			g.EndChild()
 		}
 
-		SameLine(0, 0)
+		g.SameLine(0, 0)
 
 		// Child 2: rounded border
 		{
@@ -1527,316 +1769,316 @@ func ShowDemoWindowLayout() {
 			if !layoutState.disable_menu {
 				window_flags |= ImGuiWindowFlags_MenuBar
 			}
-			PushStyleFloat(ImGuiStyleVar_ChildRounding, 5.0)
-			BeginChild("ChildR", ImVec2{0, 260}, true, window_flags)
-			if !layoutState.disable_menu && BeginMenuBar() {
-				if BeginMenu("Menu", true) {
+			g.PushStyleFloat(ImGuiStyleVar_ChildRounding, 5.0)
+			g.BeginChild("ChildR", ImVec2{0, 260}, true, window_flags)
+			if !layoutState.disable_menu && g.BeginMenuBar() {
+				if g.BeginMenu("Menu", true) {
 					// Simplified menu
-					MenuItem("New", "", nil, true)
-					MenuItem("Open", "Ctrl+O", nil, true)
-					EndMenu()
+					g.MenuItem("New", "", nil, true)
+					g.MenuItem("Open", "Ctrl+O", nil, true)
+					g.EndMenu()
 				}
-				EndMenuBar()
+				g.EndMenuBar()
 			}
-			if BeginTable("split", 2, ImGuiTableFlags_Resizable|ImGuiTableFlags_NoSavedSettings, ImVec2{}, 0) {
+			if g.BeginTable("split", 2, ImGuiTableFlags_Resizable|ImGuiTableFlags_NoSavedSettings, ImVec2{}, 0) {
 				for i := int(0); i < 100; i++ {
 					buf := fmt.Sprintf("%03d", i)
-					TableNextColumn()
-					Button(buf)
+					g.TableNextColumn()
+					g.Button(buf)
 				}
-				EndTable()
+				g.EndTable()
 			}
-			EndChild()
-			PopStyleVar(1)
+			g.EndChild()
+			g.PopStyleVar(1)
 		}
 
-		Separator()
+		g.Separator()
 
 		// Demonstrate a few extra things
 		{
-			SetNextItemWidth(GetFontSize() * 8)
-			DragInt("Offset X", &layoutState.offset_x, 1.0, -1000, 1000, "%d", 0)
+			g.SetNextItemWidth(g.GetFontSize() * 8)
+			g.DragInt("Offset X", &layoutState.offset_x, 1.0, -1000, 1000, "%d", 0)
 
-			SetCursorPosX(GetCursorPosX() + float(layoutState.offset_x))
-			PushStyleColorInt(ImGuiCol_ChildBg, IM_COL32(255, 0, 0, 100))
-			BeginChild("Red", ImVec2{200, 100}, true, ImGuiWindowFlags_None)
+			g.SetCursorPosX(g.GetCursorPosX() + float(layoutState.offset_x))
+			g.PushStyleColorInt(ImGuiCol_ChildBg, IM_COL32(255, 0, 0, 100))
+			g.BeginChild("Red", ImVec2{200, 100}, true, ImGuiWindowFlags_None)
 			for n := int(0); n < 50; n++ {
-				Text("Some test %d", n)
+				g.Text("Some test %d", n)
 			}
-			EndChild()
-			child_is_hovered := IsItemHovered(0)
-			child_rect_min := GetItemRectMin()
-			child_rect_max := GetItemRectMax()
-			PopStyleColor(1)
-			Text("Hovered: %d", bool2int(child_is_hovered))
-			Text("Rect of child window is: (%.0f,%.0f) (%.0f,%.0f)", child_rect_min.x, child_rect_min.y, child_rect_max.x, child_rect_max.y)
+			g.EndChild()
+			child_is_hovered := g.IsItemHovered(0)
+			child_rect_min := g.GetItemRectMin()
+			child_rect_max := g.GetItemRectMax()
+			g.PopStyleColor(1)
+			g.Text("Hovered: %d", bool2int(child_is_hovered))
+			g.Text("Rect of child window is: (%.0f,%.0f) (%.0f,%.0f)", child_rect_min.x, child_rect_min.y, child_rect_max.x, child_rect_max.y)
 		}
 
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Widgets Width") {
-		Checkbox("Show indented items", &layoutState.show_indented_items)
+	if g.TreeNode("Widgets Width") {
+		g.Checkbox("Show indented items", &layoutState.show_indented_items)
 
 		// Use SetNextItemWidth() to set the width of a single upcoming item.
-		Text("SetNextItemWidth/PushItemWidth(100)")
-		SameLine(0, 0)
-		HelpMarker("Fixed width.")
-		PushItemWidth(100)
-		DragFloat("float##1b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+		g.Text("SetNextItemWidth/PushItemWidth(100)")
+		g.SameLine(0, 0)
+		g.HelpMarker("Fixed width.")
+		g.PushItemWidth(100)
+		g.DragFloat("float##1b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
 		if layoutState.show_indented_items {
-			Indent(0)
-			DragFloat("float (indented)##1b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
-			Unindent(0)
+			g.Indent(0)
+			g.DragFloat("float (indented)##1b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+			g.Unindent(0)
 		}
-		PopItemWidth()
+		g.PopItemWidth()
 
-		Text("SetNextItemWidth/PushItemWidth(-100)")
-		SameLine(0, 0)
-		HelpMarker("Align to right edge minus 100")
-		PushItemWidth(-100)
-		DragFloat("float##2a", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+		g.Text("SetNextItemWidth/PushItemWidth(-100)")
+		g.SameLine(0, 0)
+		g.HelpMarker("Align to right edge minus 100")
+		g.PushItemWidth(-100)
+		g.DragFloat("float##2a", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
 		if layoutState.show_indented_items {
-			Indent(0)
-			DragFloat("float (indented)##2b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
-			Unindent(0)
+			g.Indent(0)
+			g.DragFloat("float (indented)##2b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+			g.Unindent(0)
 		}
-		PopItemWidth()
+		g.PopItemWidth()
 
-		Text("SetNextItemWidth/PushItemWidth(GetContentRegionAvail().x * 0.5f)")
-		SameLine(0, 0)
-		HelpMarker("Half of available width.\n(~ right-cursor_pos)\n(works within a column set)")
-		PushItemWidth(GetContentRegionAvail().x * 0.5)
-		DragFloat("float##3a", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+		g.Text("SetNextItemWidth/PushItemWidth(GetContentRegionAvail().x * 0.5f)")
+		g.SameLine(0, 0)
+		g.HelpMarker("Half of available width.\n(~ right-cursor_pos)\n(works within a column set)")
+		g.PushItemWidth(g.GetContentRegionAvail().x * 0.5)
+		g.DragFloat("float##3a", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
 		if layoutState.show_indented_items {
-			Indent(0)
-			DragFloat("float (indented)##3b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
-			Unindent(0)
+			g.Indent(0)
+			g.DragFloat("float (indented)##3b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+			g.Unindent(0)
 		}
-		PopItemWidth()
+		g.PopItemWidth()
 
-		Text("SetNextItemWidth/PushItemWidth(-FLT_MIN)")
-		SameLine(0, 0)
-		HelpMarker("Align to right edge")
-		PushItemWidth(-FLT_MIN)
-		DragFloat("##float5a", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+		g.Text("SetNextItemWidth/PushItemWidth(-FLT_MIN)")
+		g.SameLine(0, 0)
+		g.HelpMarker("Align to right edge")
+		g.PushItemWidth(-FLT_MIN)
+		g.DragFloat("##float5a", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
 		if layoutState.show_indented_items {
-			Indent(0)
-			DragFloat("float (indented)##5b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
-			Unindent(0)
+			g.Indent(0)
+			g.DragFloat("float (indented)##5b", &layoutState.width_f, 1, 0, 0, "%.3f", 0)
+			g.Unindent(0)
 		}
-		PopItemWidth()
+		g.PopItemWidth()
 
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Basic Horizontal Layout") {
-		TextWrapped("(Use ImGui::SameLine() to keep adding items to the right of the preceding item)")
+	if g.TreeNode("Basic Horizontal Layout") {
+		g.TextWrapped("(Use ImGui::SameLine() to keep adding items to the right of the preceding item)")
 
 		// Text
-		Text("Two items: Hello")
-		SameLine(0, 0)
+		g.Text("Two items: Hello")
+		g.SameLine(0, 0)
 		yellow := ImVec4{1, 1, 0, 1}
-		TextColored(&yellow, "Sailor")
+		g.TextColored(&yellow, "Sailor")
 
 		// Adjust spacing
-		Text("More spacing: Hello")
-		SameLine(0, 20)
-		TextColored(&yellow, "Sailor")
+		g.Text("More spacing: Hello")
+		g.SameLine(0, 20)
+		g.TextColored(&yellow, "Sailor")
 
 		// Button
-		AlignTextToFramePadding()
-		Text("Normal buttons")
-		SameLine(0, 0)
-		Button("Banana")
-		SameLine(0, 0)
-		Button("Apple")
-		SameLine(0, 0)
-		Button("Corniflower")
+		g.AlignTextToFramePadding()
+		g.Text("Normal buttons")
+		g.SameLine(0, 0)
+		g.Button("Banana")
+		g.SameLine(0, 0)
+		g.Button("Apple")
+		g.SameLine(0, 0)
+		g.Button("Corniflower")
 
 		// Button
-		Text("Small buttons")
-		SameLine(0, 0)
-		SmallButton("Like this one")
-		SameLine(0, 0)
-		Text("can fit within a text block.")
+		g.Text("Small buttons")
+		g.SameLine(0, 0)
+		g.SmallButton("Like this one")
+		g.SameLine(0, 0)
+		g.Text("can fit within a text block.")
 
 		// Aligned to arbitrary position
-		Text("Aligned")
-		SameLine(150, 0)
-		Text("x=150")
-		SameLine(300, 0)
-		Text("x=300")
-		Text("Aligned")
-		SameLine(150, 0)
-		SmallButton("x=150")
-		SameLine(300, 0)
-		SmallButton("x=300")
+		g.Text("Aligned")
+		g.SameLine(150, 0)
+		g.Text("x=150")
+		g.SameLine(300, 0)
+		g.Text("x=300")
+		g.Text("Aligned")
+		g.SameLine(150, 0)
+		g.SmallButton("x=150")
+		g.SameLine(300, 0)
+		g.SmallButton("x=300")
 
 		// Checkbox
-		Checkbox("My", &layoutState.c1)
-		SameLine(0, 0)
-		Checkbox("Tailor", &layoutState.c2)
-		SameLine(0, 0)
-		Checkbox("Is", &layoutState.c3)
-		SameLine(0, 0)
-		Checkbox("Rich", &layoutState.c4)
+		g.Checkbox("My", &layoutState.c1)
+		g.SameLine(0, 0)
+		g.Checkbox("Tailor", &layoutState.c2)
+		g.SameLine(0, 0)
+		g.Checkbox("Is", &layoutState.c3)
+		g.SameLine(0, 0)
+		g.Checkbox("Rich", &layoutState.c4)
 
 		// Various
-		PushItemWidth(80)
+		g.PushItemWidth(80)
 		items := []string{"AAAA", "BBBB", "CCCC", "DDDD"}
-		Combo("Combo", &layoutState.item, items, int(len(items)), -1)
-		SameLine(0, 0)
-		SliderFloat("X", &layoutState.f0, 0.0, 5.0, "%.3f", 0)
-		SameLine(0, 0)
-		SliderFloat("Y", &layoutState.f1, 0.0, 5.0, "%.3f", 0)
-		SameLine(0, 0)
-		SliderFloat("Z", &layoutState.f2, 0.0, 5.0, "%.3f", 0)
-		PopItemWidth()
+		g.Combo("Combo", &layoutState.item, items, int(len(items)), -1)
+		g.SameLine(0, 0)
+		g.SliderFloat("X", &layoutState.f0, 0.0, 5.0, "%.3f", 0)
+		g.SameLine(0, 0)
+		g.SliderFloat("Y", &layoutState.f1, 0.0, 5.0, "%.3f", 0)
+		g.SameLine(0, 0)
+		g.SliderFloat("Z", &layoutState.f2, 0.0, 5.0, "%.3f", 0)
+		g.PopItemWidth()
 
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Groups") {
-		HelpMarker("BeginGroup() basically locks the horizontal position for new line. EndGroup() bundles the whole group so that you can use \"item\" functions such as IsItemHovered()/IsItemActive() or SameLine() etc. on the whole group.")
-		BeginGroup()
+	if g.TreeNode("Groups") {
+		g.HelpMarker("BeginGroup() basically locks the horizontal position for new line. EndGroup() bundles the whole group so that you can use \"item\" functions such as IsItemHovered()/IsItemActive() or SameLine() etc. on the whole group.")
+		g.BeginGroup()
 		{
-			BeginGroup()
-			Button("AAA")
-			SameLine(0, 0)
-			Button("BBB")
-			SameLine(0, 0)
-			BeginGroup()
-			Button("CCC")
-			Button("DDD")
-			EndGroup()
-			SameLine(0, 0)
-			Button("EEE")
-			EndGroup()
-			if IsItemHovered(0) {
-				SetTooltip("First group hovered")
+			g.BeginGroup()
+			g.Button("AAA")
+			g.SameLine(0, 0)
+			g.Button("BBB")
+			g.SameLine(0, 0)
+			g.BeginGroup()
+			g.Button("CCC")
+			g.Button("DDD")
+			g.EndGroup()
+			g.SameLine(0, 0)
+			g.Button("EEE")
+			g.EndGroup()
+			if g.IsItemHovered(0) {
+				g.SetTooltip("First group hovered")
 			}
 		}
 		// Capture the group size and create widgets based on that size
-		size := GetItemRectSize()
+		size := g.GetItemRectSize()
 		values := layoutState.group_values[:]
 
-		PlotHistogram("##values", values, int(len(values)), 0, "", 0.0, 1.0, size, 4)
-
-		Button("ACTION")
-		SameLine(0, 0)
-		Button("REACTION")
-		SameLine(0, 0)
-		Button("DEMO")
-		SameLine(0, 0)
-		Button("DEMO")
-		SameLine(0, 0)
-		Button("DEMO")
-		EndGroup()
-		SameLine(0, 0)
-
-		Button("HIERARCHYHHH")
-		SameLine(0, 0)
-		BeginGroup()
-		Button("Track")
-		SameLine(0, 0)
-		Button("Move")
-		SameLine(0, 0)
-		Button("Rotate")
-		EndGroup()
-
-		TreePop()
-	}
-
-	if TreeNode("Text Baseline Alignment") {
+		g.PlotHistogram("##values", values, int(len(values)), 0, "", 0.0, 1.0, size, 4)
+
+		g.Button("ACTION")
+		g.SameLine(0, 0)
+		g.Button("REACTION")
+		g.SameLine(0, 0)
+		g.Button("DEMO")
+		g.SameLine(0, 0)
+		g.Button("DEMO")
+		g.SameLine(0, 0)
+		g.Button("DEMO")
+		g.EndGroup()
+		g.SameLine(0, 0)
+
+		g.Button("HIERARCHYHHH")
+		g.SameLine(0, 0)
+		g.BeginGroup()
+		g.Button("Track")
+		g.SameLine(0, 0)
+		g.Button("Move")
+		g.SameLine(0, 0)
+		g.Button("Rotate")
+		g.EndGroup()
+
+		g.TreePop()
+	}
+
+	if g.TreeNode("Text Baseline Alignment") {
 		{
-			BulletText("Text baseline:")
-			SameLine(0, 0)
-			HelpMarker("This is testing the vertical alignment that gets applied on text to keep it aligned with widgets. Lines only composed of text or \"small\" widgets use less vertical space than lines with framed widgets.")
-			Indent(0)
+			g.BulletText("Text baseline:")
+			g.SameLine(0, 0)
+			g.HelpMarker("This is testing the vertical alignment that gets applied on text to keep it aligned with widgets. Lines only composed of text or \"small\" widgets use less vertical space than lines with framed widgets.")
+			g.Indent(0)
 
-			Text("KO Alarm")
-				SameLine(0, 0)
-				Button("Some framed item")
-				SameLine(0, 0)
-				Text("Some text")
+			g.Text("KO Alarm")
+				g.SameLine(0, 0)
+				g.Button("Some framed item")
+				g.SameLine(0, 0)
+				g.Text("Some text")
 
 				// If your line starts with text, call AlignTextToFramePadding() to align text to upcoming widgets.
-				AlignTextToFramePadding()
-				Text("OK Alarm")
-				SameLine(0, 0)
-				Button("Some framed item")
-				SameLine(0, 0)
-				Text("Some text")
+				g.AlignTextToFramePadding()
+				g.Text("OK Alarm")
+				g.SameLine(0, 0)
+				g.Button("Some framed item")
+				g.SameLine(0, 0)
+				g.Text("Some text")
 
-			Unindent(0)
+			g.Unindent(0)
 		}
 
 		{
-			BulletText("Multi-line text:")
-			Indent(0)
-			Text("One\nTwo\nThree")
-			SameLine(0, 0)
-			Text("Hello\nWorld")
-			SameLine(0, 0)
-			Text("Banana")
-
-			Text("Banana")
-			SameLine(0, 0)
-			Text("Hello\nWorld")
-			SameLine(0, 0)
-			Text("One\nTwo\nThree")
-
-			Button("HOP##1")
-			SameLine(0, 0)
-			Text("Banana")
-			SameLine(0, 0)
-			Text("Hello\nWorld")
-			SameLine(0, 0)
-			Text("Banana")
-
-			Button("HOP##2")
-			SameLine(0, 0)
-			Text("Hello\nWorld")
-			SameLine(0, 0)
-			Text("Banana")
-			Unindent(0)
-		}
-
-		TreePop()
-	}
-
-	if TreeNode("Scrolling") {
+			g.BulletText("Multi-line text:")
+			g.Indent(0)
+			g.Text("One\nTwo\nThree")
+			g.SameLine(0, 0)
+			g.Text("Hello\nWorld")
+			g.SameLine(0, 0)
+			g.Text("Banana")
+
+			g.Text("Banana")
+			g.SameLine(0, 0)
+			g.Text("Hello\nWorld")
+			g.SameLine(0, 0)
+			g.Text("One\nTwo\nThree")
+
+			g.Button("HOP##1")
+			g.SameLine(0, 0)
+			g.Text("Banana")
+			g.SameLine(0, 0)
+			g.Text("Hello\nWorld")
+			g.SameLine(0, 0)
+			g.Text("Banana")
+
+			g.Button("HOP##2")
+			g.SameLine(0, 0)
+			g.Text("Hello\nWorld")
+			g.SameLine(0, 0)
+			g.Text("Banana")
+			g.Unindent(0)
+		}
+
+		g.TreePop()
+	}
+
+	if g.TreeNode("Scrolling") {
 		// Vertical scroll functions
-		HelpMarker("Use SetScrollHereY() or SetScrollFromPosY() to scroll to a given vertical position.")
+		g.HelpMarker("Use SetScrollHereY() or SetScrollFromPosY() to scroll to a given vertical position.")
 
-		Checkbox("Decoration", &demoState.show_scrolling_decoration)
+		g.Checkbox("Decoration", &demoState.show_scrolling_decoration)
 
 		if demoState.show_scrolling_decoration {
-			SameLine(0, 0)
-			Checkbox("Track", &demoState.show_scrolling_track)
-			SameLine(0, 0)
-			PushItemWidth(100)
-			Combo("##combo", &demoState.scrolling_track_item, []string{"track", "track centered"}, 2, -1)
-			PopItemWidth()
+			g.SameLine(0, 0)
+			g.Checkbox("Track", &demoState.show_scrolling_track)
+			g.SameLine(0, 0)
+			g.PushItemWidth(100)
+			g.Combo("##combo", &demoState.scrolling_track_item, []string{"track", "track centered"}, 2, -1)
+			g.PopItemWidth()
 		}
 
 		if demoState.show_scrolling_track && demoState.show_scrolling_decoration {
 			for i := int(0); i < 5; i++ {
 				if i > 0 {
-					SameLine(0, 0)
+					g.SameLine(0, 0)
 				}
-				BeginGroup()
-				scroll_to_off := Button(fmt.Sprintf("Scroll Offset##%d", i))
-				scroll_to_pos := Button(fmt.Sprintf("Scroll Pos##%d", i))
+				g.BeginGroup()
+				scroll_to_off := g.Button(fmt.Sprintf("Scroll Offset##%d", i))
+				scroll_to_pos := g.Button(fmt.Sprintf("Scroll Pos##%d", i))
 				if scroll_to_off || scroll_to_pos {
 					demoState.scrolling_track_item = i
 				}
-				EndGroup()
+				g.EndGroup()
 			}
 		}
 
-		TreePop()
+		g.TreePop()
 	}
 }
 
@@ -1854,130 +2096,130 @@ func init() {
 	copy(popupsState.name[:], "Label1")
 }
 
-func ShowDemoWindowPopups() {
-	if !CollapsingHeader("Popups & Modal windows", 0) {
+func (g *ImGuiContext) ShowDemoWindowPopups() {
+	if !g.CollapsingHeader("Popups & Modal windows", 0) {
 		return
 	}
 
-	if TreeNode("Popups") {
-		TextWrapped("When a popup is active, it inhibits interacting with windows that are behind the popup. Clicking outside the popup closes it.")
+	if g.TreeNode("Popups") {
+		g.TextWrapped("When a popup is active, it inhibits interacting with windows that are behind the popup. Clicking outside the popup closes it.")
 
 		names := []string{"Bream", "Haddock", "Mackerel", "Pollock", "Tilefish"}
 
 		// Simple selection popup
-		if Button("Select..") {
-			OpenPopup("my_select_popup", 0)
+		if g.Button("Select..") {
+			g.OpenPopup("my_select_popup", 0)
 		}
-		SameLine(0, 0)
+		g.SameLine(0, 0)
 		if popupsState.selected_fish == -1 {
-			TextUnformatted("<None>")
+			g.TextUnformatted("<None>")
 		} else {
-			TextUnformatted(names[popupsState.selected_fish])
+			g.TextUnformatted(names[popupsState.selected_fish])
 		}
-		if BeginPopup("my_select_popup", 0) {
-			Text("Aquarium")
-			Separator()
+		if g.BeginPopup("my_select_popup", 0) {
+			g.Text("Aquarium")
+			g.Separator()
 			for i := 0; i < len(names); i++ {
-				if Selectable(names[i], false, 0, ImVec2{}) {
+				if g.Selectable(names[i], false, 0, ImVec2{}) {
 					popupsState.selected_fish = int(i)
 				}
 			}
-			EndPopup()
+			g.EndPopup()
 		}
 
 		// Showing a menu with toggles
-		if Button("Toggle..") {
-			OpenPopup("my_toggle_popup", 0)
+		if g.Button("Toggle..") {
+			g.OpenPopup("my_toggle_popup", 0)
 		}
-		if BeginPopup("my_toggle_popup", 0) {
+		if g.BeginPopup("my_toggle_popup", 0) {
 			for i := 0; i < len(names); i++ {
-				MenuItemSelected(names[i], "", &popupsState.toggles[i], true)
+				g.MenuItemSelected(names[i], "", &popupsState.toggles[i], true)
 			}
-			if BeginMenu("Sub-menu", true) {
-				MenuItem("Click me", "", nil, true)
-				EndMenu()
+			if g.BeginMenu("Sub-menu", true) {
+				g.MenuItem("Click me", "", nil, true)
+				g.EndMenu()
 			}
 
-			Separator()
-			Text("Tooltip here")
-			if IsItemHovered(0) {
-				SetTooltip("I am a tooltip over a popup")
+			g.Separator()
+			g.Text("Tooltip here")
+			if g.IsItemHovered(0) {
+				g.SetTooltip("I am a tooltip over a popup")
 			}
 
-			if Button("Stacked Popup") {
-				OpenPopup("another popup", 0)
+			if g.Button("Stacked Popup") {
+				g.OpenPopup("another popup", 0)
 			}
-			if BeginPopup("another popup", 0) {
+			if g.BeginPopup("another popup", 0) {
 				for i := 0; i < len(names); i++ {
-					MenuItemSelected(names[i], "", &popupsState.toggles[i], true)
+					g.MenuItemSelected(names[i], "", &popupsState.toggles[i], true)
 				}
-				if BeginMenu("Sub-menu", true) {
-					MenuItem("Click me", "", nil, true)
-					if Button("Stacked Popup") {
-						OpenPopup("another popup", 0)
+				if g.BeginMenu("Sub-menu", true) {
+					g.MenuItem("Click me", "", nil, true)
+					if g.Button("Stacked Popup") {
+						g.OpenPopup("another popup", 0)
 					}
-					if BeginPopup("another popup", 0) {
-						Text("I am the last one here.")
-						EndPopup()
+					if g.BeginPopup("another popup", 0) {
+						g.Text("I am the last one here.")
+						g.EndPopup()
 					}
-					EndMenu()
+					g.EndMenu()
 				}
-				EndPopup()
+				g.EndPopup()
 			}
-			EndPopup()
+			g.EndPopup()
 		}
 
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Context menus") {
-		HelpMarker("\"Context\" functions are simple helpers to associate a Popup to a given Item or Window identifier.")
+	if g.TreeNode("Context menus") {
+		g.HelpMarker("\"Context\" functions are simple helpers to associate a Popup to a given Item or Window identifier.")
 
 		// Example 1
 			{
 				context_names := []string{"Label1", "Label2", "Label3", "Label4", "Label5"}
 				for n := 0; n < 5; n++ {
-					Selectable(context_names[n], false, 0, ImVec2{})
-					if BeginPopupContextItem("", ImGuiPopupFlags_MouseButtonRight) {
-						Text("This a popup for \"%s\"!", context_names[n])
-						if Button("Close") {
-							CloseCurrentPopup()
+					g.Selectable(context_names[n], false, 0, ImVec2{})
+					if g.BeginPopupContextItem("", ImGuiPopupFlags_MouseButtonRight) {
+						g.Text("This a popup for \"%s\"!", context_names[n])
+						if g.Button("Close") {
+							g.CloseCurrentPopup()
 						}
-						EndPopup()
+						g.EndPopup()
 					}
-					if IsItemHovered(0) {
-						SetTooltip("Right-click to open popup")
+					if g.IsItemHovered(0) {
+						g.SetTooltip("Right-click to open popup")
 					}
 				}
 			}
 
 		// Example 2
 		{
-			HelpMarker("Text() elements don't have stable identifiers so we need to provide one.")
-			Text("Value = %.3f <-- (1) right-click this value", popupsState.context_value)
-			if BeginPopupContextItem("my popup", ImGuiPopupFlags_MouseButtonRight) {
-				if Selectable("Set to zero", false, 0, ImVec2{}) {
+			g.HelpMarker("Text() elements don't have stable identifiers so we need to provide one.")
+			g.Text("Value = %.3f <-- (1) right-click this value", popupsState.context_value)
+			if g.BeginPopupContextItem("my popup", ImGuiPopupFlags_MouseButtonRight) {
+				if g.Selectable("Set to zero", false, 0, ImVec2{}) {
 					popupsState.context_value = 0.0
 				}
-				if Selectable("Set to PI", false, 0, ImVec2{}) {
+				if g.Selectable("Set to PI", false, 0, ImVec2{}) {
 					popupsState.context_value = 3.1415
 				}
-				SetNextItemWidth(-FLT_MIN)
-				DragFloat("##Value", &popupsState.context_value, 0.1, 0.0, 0.0, "%.3f", 0)
-				EndPopup()
+				g.SetNextItemWidth(-FLT_MIN)
+				g.DragFloat("##Value", &popupsState.context_value, 0.1, 0.0, 0.0, "%.3f", 0)
+				g.EndPopup()
 			}
 
-			Text("(2) Or right-click this text")
-			OpenPopupOnItemClick("my popup", ImGuiPopupFlags_MouseButtonRight)
+			g.Text("(2) Or right-click this text")
+			g.OpenPopupOnItemClick("my popup", ImGuiPopupFlags_MouseButtonRight)
 
-			if Button("(3) Or click this button") {
-				OpenPopup("my popup", 0)
+			if g.Button("(3) Or click this button") {
+				g.OpenPopup("my popup", 0)
 			}
 		}
 
 		// Example 3
 		{
-			HelpMarker("Showcase using a popup ID linked to item ID, with the item having a changing label + stable ID using the ### operator.")
+			g.HelpMarker("Showcase using a popup ID linked to item ID, with the item having a changing label + stable ID using the ### operator.")
 			name := string(popupsState.name[:])
 			for i, c := range popupsState.name {
 				if c == 0 {
@@ -1986,91 +2228,91 @@ func ShowDemoWindowPopups() {
 				}
 			}
 			buf := fmt.Sprintf("Button: %s###Button", name)
-			Button(buf)
-			if BeginPopupContextItem("", ImGuiPopupFlags_MouseButtonRight) {
-				Text("Edit name:")
+			g.Button(buf)
+			if g.BeginPopupContextItem("", ImGuiPopupFlags_MouseButtonRight) {
+				g.Text("Edit name:")
 				nameSlice := popupsState.name[:]
-				InputText("##edit", &nameSlice, 0, nil, nil)
-				if Button("Close") {
-					CloseCurrentPopup()
+				g.InputText("##edit", &nameSlice, 0, nil, nil)
+				if g.Button("Close") {
+					g.CloseCurrentPopup()
 				}
-				EndPopup()
+				g.EndPopup()
 			}
-			SameLine(0, 0)
-			Text("(<-- right-click here)")
+			g.SameLine(0, 0)
+			g.Text("(<-- right-click here)")
 		}
 
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Modals") {
-		TextWrapped("Modal windows are like popups but the user cannot close them by clicking outside.")
+	if g.TreeNode("Modals") {
+		g.TextWrapped("Modal windows are like popups but the user cannot close them by clicking outside.")
 
-		if Button("Delete..") {
-			OpenPopup("Delete?", 0)
+		if g.Button("Delete..") {
+			g.OpenPopup("Delete?", 0)
 		}
 
 		// Always center this window when appearing
-		center := GetMainViewport().GetCenter()
-		SetNextWindowPos(&center, ImGuiCond_Appearing, ImVec2{0.5, 0.5})
+		center := g.GetMainViewport().GetCenter()
+		g.SetNextWindowPos(&center, ImGuiCond_Appearing, ImVec2{0.5, 0.5})
 
-		if BeginPopupModal("Delete?", nil, ImGuiWindowFlags_AlwaysAutoResize) {
-			Text("All those beautiful files will be deleted.\nThis operation cannot be undone!\n\n")
-			Separator()
+		if g.BeginPopupModal("Delete?", nil, ImGuiWindowFlags_AlwaysAutoResize) {
+			g.Text("All those beautiful files will be deleted.\nThis operation cannot be undone!\n\n")
+			g.Separator()
 
-			PushStyleVec(ImGuiStyleVar_FramePadding, ImVec2{0, 0})
-			Checkbox("Don't ask me next time", &demoState.no_close)
-			PopStyleVar(1)
+			g.PushStyleVec(ImGuiStyleVar_FramePadding, ImVec2{0, 0})
+			g.Checkbox("Don't ask me next time", &demoState.no_close)
+			g.PopStyleVar(1)
 
-			if Button("OK") {
-				CloseCurrentPopup()
+			if g.Button("OK") {
+				g.CloseCurrentPopup()
 			}
-			SetItemDefaultFocus()
-			SameLine(0, 0)
-			if Button("Cancel") {
-				CloseCurrentPopup()
+			g.SetItemDefaultFocus()
+			g.SameLine(0, 0)
+			if g.Button("Cancel") {
+				g.CloseCurrentPopup()
 			}
-			EndPopup()
+			g.EndPopup()
 		}
 
-		if Button("Stacked modals..") {
-			OpenPopup("Stacked 1", 0)
+		if g.Button("Stacked modals..") {
+			g.OpenPopup("Stacked 1", 0)
 		}
-		if BeginPopupModal("Stacked 1", nil, 0) {
-			Text("Hello from Stacked The First\nUsing style.Colors[ImGuiCol_ModalWindowDimBg] behind it.")
+		if g.BeginPopupModal("Stacked 1", nil, 0) {
+			g.Text("Hello from Stacked The First\nUsing style.Colors[ImGuiCol_ModalWindowDimBg] behind it.")
 
-			if Button("Another modal..") {
-				OpenPopup("Stacked 2", 0)
+			if g.Button("Another modal..") {
+				g.OpenPopup("Stacked 2", 0)
 			}
-			SetItemDefaultFocus()
-			if BeginPopupModal("Stacked 2", nil, 0) {
-				Text("Hello from Stacked The Second!")
-				if Button("Close") {
-					CloseCurrentPopup()
+			g.SetItemDefaultFocus()
+			if g.BeginPopupModal("Stacked 2", nil, 0) {
+				g.Text("Hello from Stacked The Second!")
+				if g.Button("Close") {
+					g.CloseCurrentPopup()
 				}
-				EndPopup()
+				g.EndPopup()
 			}
 
-			if Button("Close") {
-				CloseCurrentPopup()
+			if g.Button("Close") {
+				g.CloseCurrentPopup()
 			}
-			EndPopup()
+			g.EndPopup()
 		}
 
-		TreePop()
+		g.TreePop()
 	}
 
-	if TreeNode("Menus inside a regular window") {
-		TextWrapped("Below we are testing adding menu items to a regular window. It's rather unusual but should work!")
-		Separator()
+	if g.TreeNode("Menus inside a regular window") {
+		g.TextWrapped("Below we are testing adding menu items to a regular window. It's rather unusual but should work!")
+		g.Separator()
 
-		MenuItem("Menu item", "CTRL+M", nil, true)
-		if BeginMenu("Menu inside a regular window", true) {
-			MenuItem("Submenu item", "", nil, true)
-			EndMenu()
+		g.MenuItem("Menu item", "CTRL+M", nil, true)
+		if g.BeginMenu("Menu inside a regular window", true) {
+			g.MenuItem("Submenu item", "", nil, true)
+			g.EndMenu()
 		}
-		Separator()
-		TreePop()
+		g.Separator()
+		g.TreePop()
 	}
 }
 
@@ -2079,23 +2321,23 @@ var miscState struct {
 	filter ImGuiTextFilter
 }
 
-func ShowDemoWindowMisc() {
-	if !CollapsingHeader("Filtering", 0) {
+func (g *ImGuiContext) ShowDemoWindowMisc() {
+	if !g.CollapsingHeader("Filtering", 0) {
 		return
 	}
 
 	// Helper to display a little (?) mark which shows a tooltip when hovered.
-	HelpMarker("Filter usage:\n" +
+	g.HelpMarker("Filter usage:\n" +
 		"  \"\"         display all lines\n" +
 		"  \"xxx\"      display lines containing \"xxx\"\n" +
 		"  \"xxx,yyy\"  display lines containing \"xxx\" or \"yyy\"\n" +
 		"  \"-xxx\"     hide lines containing \"xxx\"")
 
-	miscState.filter.Draw("Filter (inc,-exc)", 0)
+	miscState.filter.DrawContext(g, "Filter (inc,-exc)", 0)
 	lines := []string{"aaa1.c", "bbb1.c", "ccc1.c", "aaa2.cpp", "bbb2.cpp", "ccc2.cpp", "abc.h", "hello, world"}
 	for i := 0; i < len(lines); i++ {
 		if miscState.filter.PassFilter(lines[i]) {
-			BulletText("%s", lines[i])
+			g.BulletText("%s", lines[i])
 		}
 	}
 }
@@ -0,0 +1,2181 @@
+package imgui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Splizard/imgui/golang"
+)
+
+// We are passing our own identifier to TableSetupColumn() to facilitate identifying columns in the sorting code.
+// This identifier will be passed down into ImGuiTableSortSpec::ColumnUserID.
+// But it is possible to omit the user id parameter of TableSetupColumn() and just use the column index instead! (ImGuiTableSortSpec::ColumnIndex)
+// If you don't use sorting, you will generally never care about giving column an ID!
+const (
+	MyItemColumnID_ID ImGuiID = iota
+	MyItemColumnID_Name
+	MyItemColumnID_Action
+	MyItemColumnID_Quantity
+	MyItemColumnID_Description
+)
+
+type MyItem struct {
+	ID       int
+	Name     string
+	Quantity int
+}
+
+// SortWithSortSpecs sorts items in place according to the table sort specifications.
+func SortWithSortSpecs(specs *ImGuiTableSortSpecs, items []MyItem) {
+	sort.SliceStable(items, func(i, j golang.Int) bool {
+		var a, b = &items[i], &items[j]
+		for n := int(0); n < specs.SpecsCount; n++ {
+			// Here we identify columns using the ColumnUserID value that we ourselves passed to TableSetupColumn()
+			// We could also choose to identify columns based on their index (sort_spec.ColumnIndex), which is simpler!
+			var sort_spec = &specs.Specs[n]
+			var delta int
+			switch sort_spec.ColumnUserID {
+			case MyItemColumnID_ID:
+				delta = a.ID - b.ID
+			case MyItemColumnID_Name:
+				delta = int(strings.Compare(a.Name, b.Name))
+			case MyItemColumnID_Quantity:
+				delta = a.Quantity - b.Quantity
+			case MyItemColumnID_Description:
+				delta = int(strings.Compare(a.Name, b.Name))
+			default:
+				IM_ASSERT(false)
+			}
+			if delta > 0 {
+				return sort_spec.SortDirection != ImGuiSortDirection_Ascending
+			}
+			if delta < 0 {
+				return sort_spec.SortDirection == ImGuiSortDirection_Ascending
+			}
+		}
+
+		// Always return a way to differentiate items.
+		// Your own compare function may want to avoid fallback on implicit sort specs e.g. a Name compare if it wasn't already part of the sort specs.
+		return a.ID < b.ID
+	})
+}
+
+var template_items_names = []string{
+	"Banana", "Apple", "Cherry", "Watermelon", "Grapefruit", "Strawberry", "Mango",
+	"Kiwi", "Orange", "Pineapple", "Blueberry", "Plum", "Coconut", "Pear", "Apricot",
+}
+
+// Simple storage to output a dummy file-system.
+type demoTreeNode struct {
+	Name       string
+	Type       string
+	Size       int
+	ChildIdx   int
+	ChildCount int
+}
+
+var demoTreeNodes = [...]demoTreeNode{
+	{"Root", "Folder", -1, 1, 3},                                    // 0
+	{"Music", "Folder", -1, 4, 2},                                   // 1
+	{"Textures", "Folder", -1, 6, 3},                                // 2
+	{"desktop.ini", "System file", 1024, -1, -1},                    // 3
+	{"File1_a.wav", "Audio file", 123000, -1, -1},                   // 4
+	{"File1_b.wav", "Audio file", 456000, -1, -1},                   // 5
+	{"Image001.png", "Image file", 203128, -1, -1},                  // 6
+	{"Copy of Image001.png", "Image file", 203256, -1, -1},          // 7
+	{"Copy of Image001 (Final2).png", "Image file", 203512, -1, -1}, // 8
+}
+
+func (g *ImGuiContext) showDemoTreeNode(node *demoTreeNode, all_nodes []demoTreeNode) {
+	g.TableNextRow(0, 0)
+	g.TableNextColumn()
+	var is_folder = node.ChildCount > 0
+	if is_folder {
+		var open = g.TreeNodeEx(node.Name, ImGuiTreeNodeFlags_SpanFullWidth, "%s", node.Name)
+		g.TableNextColumn()
+		g.TextDisabled("--")
+		g.TableNextColumn()
+		g.TextUnformatted(node.Type)
+		if open {
+			for child_n := int(0); child_n < node.ChildCount; child_n++ {
+				g.showDemoTreeNode(&all_nodes[node.ChildIdx+child_n], all_nodes)
+			}
+			g.TreePop()
+		}
+	} else {
+		g.TreeNodeEx(node.Name, ImGuiTreeNodeFlags_Leaf|ImGuiTreeNodeFlags_Bullet|ImGuiTreeNodeFlags_NoTreePushOnOpen|ImGuiTreeNodeFlags_SpanFullWidth, "%s", node.Name)
+		g.TableNextColumn()
+		g.Text("%d", node.Size)
+		g.TableNextColumn()
+		g.TextUnformatted(node.Type)
+	}
+}
+
+var tablesState struct {
+	disable_indent bool
+
+	borders struct {
+		flags           ImGuiTableFlags
+		contents_type   int
+		display_headers bool
+	}
+	resizable_stretch_flags ImGuiTableFlags
+	resizable_fixed_flags   ImGuiTableFlags
+	reorderable_flags       ImGuiTableFlags
+
+	padding struct {
+		flags1               ImGuiTableFlags
+		show_headers         bool
+		flags2               ImGuiTableFlags
+		cell_padding         [2]float
+		show_widget_frame_bg bool
+		text_bufs            [3 * 5][]byte
+	}
+
+	sizing struct {
+		flags1              ImGuiTableFlags
+		sizing_policy_flags [4]ImGuiTableFlags
+		flags               ImGuiTableFlags
+		contents_type       int
+		column_count        int
+		text_buf            []byte
+	}
+
+	scrolly_flags ImGuiTableFlags
+
+	scrollx struct {
+		flags       ImGuiTableFlags
+		freeze_cols int
+		freeze_rows int
+		flags2      ImGuiTableFlags
+		inner_width float
+	}
+
+	columns_flags struct {
+		column_flags     [3]ImGuiTableColumnFlags
+		column_flags_out [3]ImGuiTableColumnFlags
+	}
+
+	columns_widths struct {
+		flags1 ImGuiTableFlags
+		flags2 ImGuiTableFlags
+	}
+
+	outer_size_flags ImGuiTableFlags
+
+	background struct {
+		flags         ImGuiTableFlags
+		row_bg_type   int
+		row_bg_target int
+		cell_bg_type  int
+	}
+
+	item_width_dummy_f             float
+	custom_headers_column_selected [3]bool
+	context_menus_flags            ImGuiTableFlags
+
+	sorting struct {
+		items []MyItem
+		flags ImGuiTableFlags
+	}
+
+	advanced struct {
+		flags                   ImGuiTableFlags
+		contents_type           int
+		freeze_cols             int
+		freeze_rows             int
+		items_count             int
+		outer_size_value        [2]float
+		outer_size_init         bool
+		row_min_height          float
+		inner_width_with_scroll float
+		outer_size_enabled      bool
+		show_headers            bool
+		show_wrapped_text       bool
+		items                   []MyItem
+		selection               map[int]bool
+		items_need_sort         bool
+		show_debug_details      bool
+	}
+}
+
+var columnsState struct {
+	selected      int
+	h_borders     bool
+	v_borders     bool
+	columns_count int
+	foo           float
+	bar           float
+}
+
+func init() {
+	var state = &tablesState
+	state.borders.flags = ImGuiTableFlags_Borders | ImGuiTableFlags_RowBg
+	state.borders.display_headers = false
+	state.resizable_stretch_flags = ImGuiTableFlags_SizingStretchSame | ImGuiTableFlags_Resizable | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_BordersV | ImGuiTableFlags_ContextMenuInBody
+	state.resizable_fixed_flags = ImGuiTableFlags_SizingFixedFit | ImGuiTableFlags_Resizable | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_BordersV | ImGuiTableFlags_ContextMenuInBody
+	state.reorderable_flags = ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_BordersV
+
+	state.padding.flags1 = ImGuiTableFlags_BordersV
+	state.padding.show_headers = false
+	state.padding.flags2 = ImGuiTableFlags_Borders | ImGuiTableFlags_RowBg
+	state.padding.show_widget_frame_bg = true
+	for i := range state.padding.text_bufs {
+		state.padding.text_bufs[i] = []byte("edit me")
+	}
+
+	state.sizing.flags1 = ImGuiTableFlags_BordersV | ImGuiTableFlags_BordersOuterH | ImGuiTableFlags_RowBg | ImGuiTableFlags_ContextMenuInBody
+	state.sizing.sizing_policy_flags = [4]ImGuiTableFlags{ImGuiTableFlags_SizingFixedFit, ImGuiTableFlags_SizingFixedSame, ImGuiTableFlags_SizingStretchProp, ImGuiTableFlags_SizingStretchSame}
+	state.sizing.flags = ImGuiTableFlags_ScrollY | ImGuiTableFlags_Borders | ImGuiTableFlags_RowBg | ImGuiTableFlags_Resizable
+	state.sizing.contents_type = 0
+	state.sizing.column_count = 3
+
+	state.scrolly_flags = ImGuiTableFlags_ScrollY | ImGuiTableFlags_RowBg | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_BordersV | ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable
+
+	state.scrollx.flags = ImGuiTableFlags_ScrollX | ImGuiTableFlags_ScrollY | ImGuiTableFlags_RowBg | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_BordersV | ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable
+	state.scrollx.freeze_cols = 1
+	state.scrollx.freeze_rows = 1
+	state.scrollx.flags2 = ImGuiTableFlags_SizingStretchSame | ImGuiTableFlags_ScrollX | ImGuiTableFlags_ScrollY | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_RowBg | ImGuiTableFlags_ContextMenuInBody
+	state.scrollx.inner_width = 1000.0
+
+	state.columns_flags.column_flags = [3]ImGuiTableColumnFlags{ImGuiTableColumnFlags_DefaultSort, ImGuiTableColumnFlags_None, ImGuiTableColumnFlags_DefaultHide}
+
+	state.columns_widths.flags1 = ImGuiTableFlags_Borders | ImGuiTableFlags_NoBordersInBodyUntilResize
+	state.columns_widths.flags2 = ImGuiTableFlags_None
+
+	state.outer_size_flags = ImGuiTableFlags_SizingFixedFit | ImGuiTableFlags_RowBg | ImGuiTableFlags_Borders | ImGuiTableFlags_Resizable | ImGuiTableFlags_ContextMenuInBody | ImGuiTableFlags_NoHostExtendX
+
+	state.background.flags = ImGuiTableFlags_RowBg
+	state.background.row_bg_type = 1
+	state.background.row_bg_target = 1
+	state.background.cell_bg_type = 1
+
+	state.context_menus_flags = ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable | ImGuiTableFlags_Borders | ImGuiTableFlags_ContextMenuInBody
+
+	state.sorting.flags = ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable | ImGuiTableFlags_Sortable | ImGuiTableFlags_SortMulti |
+		ImGuiTableFlags_RowBg | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_BordersV | ImGuiTableFlags_NoBordersInBody |
+		ImGuiTableFlags_ScrollY
+
+	state.advanced.flags = ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable |
+		ImGuiTableFlags_Sortable | ImGuiTableFlags_SortMulti |
+		ImGuiTableFlags_RowBg | ImGuiTableFlags_Borders | ImGuiTableFlags_NoBordersInBody |
+		ImGuiTableFlags_ScrollX | ImGuiTableFlags_ScrollY |
+		ImGuiTableFlags_SizingFixedFit
+	state.advanced.contents_type = 5 // CT_SelectableSpanRow
+	state.advanced.freeze_cols = 1
+	state.advanced.freeze_rows = 1
+	state.advanced.items_count = int(len(template_items_names)) * 2
+	state.advanced.outer_size_enabled = true
+	state.advanced.show_headers = true
+	state.advanced.selection = make(map[int]bool)
+
+	columnsState.selected = -1
+	columnsState.h_borders = true
+	columnsState.v_borders = true
+	columnsState.columns_count = 4
+	columnsState.foo = 1.0
+	columnsState.bar = 1.0
+}
+
+// Make the UI compact because there are so many fields
+func (g *ImGuiContext) PushStyleCompact() {
+	var style = g.GetStyle()
+	g.PushStyleVec(ImGuiStyleVar_FramePadding, ImVec2{style.FramePadding.x, (float)((int)(style.FramePadding.y * 0.60))})
+	g.PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{style.ItemSpacing.x, (float)((int)(style.ItemSpacing.y * 0.60))})
+}
+
+func (g *ImGuiContext) PopStyleCompact() {
+	g.PopStyleVar(2)
+}
+
+// EditTableSizingFlags Show a combo box with a choice of sizing policies
+func (g *ImGuiContext) EditTableSizingFlags(p_flags *ImGuiTableFlags) {
+	type EnumDesc struct {
+		Value   ImGuiTableFlags
+		Name    string
+		Tooltip string
+	}
+	var policies = []EnumDesc{
+		{ImGuiTableFlags_None, "Default", "Use default sizing policy:\n- ImGuiTableFlags_SizingFixedFit if ScrollX is on or if host window has ImGuiWindowFlags_AlwaysAutoResize.\n- ImGuiTableFlags_SizingStretchSame otherwise."},
+		{ImGuiTableFlags_SizingFixedFit, "ImGuiTableFlags_SizingFixedFit", "Columns default to _WidthFixed (if resizable) or _WidthAuto (if not resizable), matching contents width."},
+		{ImGuiTableFlags_SizingFixedSame, "ImGuiTableFlags_SizingFixedSame", "Columns are all the same width, matching the maximum contents width.\nImplicitly disable ImGuiTableFlags_Resizable and enable ImGuiTableFlags_NoKeepColumnsVisible."},
+		{ImGuiTableFlags_SizingStretchProp, "ImGuiTableFlags_SizingStretchProp", "Columns default to _WidthStretch with weights proportional to their widths."},
+		{ImGuiTableFlags_SizingStretchSame, "ImGuiTableFlags_SizingStretchSame", "Columns default to _WidthStretch with same weights."},
+	}
+	var idx int
+	for idx = 0; idx < int(len(policies)); idx++ {
+		if policies[idx].Value == (*p_flags & ImGuiTableFlags_SizingMask_) {
+			break
+		}
+	}
+	var preview_text string
+	if idx < int(len(policies)) {
+		preview_text = policies[idx].Name
+		if idx > 0 {
+			preview_text = strings.TrimPrefix(preview_text, "ImGuiTableFlags")
+		}
+	}
+	if g.BeginCombo("Sizing Policy", preview_text, 0) {
+		for n := range policies {
+			if g.Selectable(policies[n].Name, idx == int(n), 0, ImVec2{}) {
+				*p_flags = (*p_flags & ^ImGuiTableFlags_SizingMask_) | policies[n].Value
+			}
+		}
+		g.EndCombo()
+	}
+	g.SameLine(0, -1)
+	g.TextDisabled("(?)")
+	if g.IsItemHovered(0) {
+		g.BeginTooltip()
+		g.PushTextWrapPos(g.GetFontSize() * 50.0)
+		for m := range policies {
+			g.Separator()
+			g.Text("%s:", policies[m].Name)
+			g.Separator()
+			g.SetCursorPosX(g.GetCursorPosX() + g.GetStyle().IndentSpacing*0.5)
+			g.TextUnformatted(policies[m].Tooltip)
+		}
+		g.PopTextWrapPos()
+		g.EndTooltip()
+	}
+}
+
+func (g *ImGuiContext) EditTableColumnsFlags(p_flags *ImGuiTableColumnFlags) {
+	var flags = (*int32)(p_flags)
+	g.CheckboxFlagsInt("_Disabled", flags, int32(ImGuiTableColumnFlags_Disabled))
+	g.SameLine(0, -1)
+	g.HelpMarker("Master disable flag (also hide from context menu)")
+	g.CheckboxFlagsInt("_DefaultHide", flags, int32(ImGuiTableColumnFlags_DefaultHide))
+	g.CheckboxFlagsInt("_DefaultSort", flags, int32(ImGuiTableColumnFlags_DefaultSort))
+	if g.CheckboxFlagsInt("_WidthStretch", flags, int32(ImGuiTableColumnFlags_WidthStretch)) {
+		*p_flags &= ^(ImGuiTableColumnFlags_WidthMask_ ^ ImGuiTableColumnFlags_WidthStretch)
+	}
+	if g.CheckboxFlagsInt("_WidthFixed", flags, int32(ImGuiTableColumnFlags_WidthFixed)) {
+		*p_flags &= ^(ImGuiTableColumnFlags_WidthMask_ ^ ImGuiTableColumnFlags_WidthFixed)
+	}
+	g.CheckboxFlagsInt("_NoResize", flags, int32(ImGuiTableColumnFlags_NoResize))
+	g.CheckboxFlagsInt("_NoReorder", flags, int32(ImGuiTableColumnFlags_NoReorder))
+	g.CheckboxFlagsInt("_NoHide", flags, int32(ImGuiTableColumnFlags_NoHide))
+	g.CheckboxFlagsInt("_NoClip", flags, int32(ImGuiTableColumnFlags_NoClip))
+	g.CheckboxFlagsInt("_NoSort", flags, int32(ImGuiTableColumnFlags_NoSort))
+	g.CheckboxFlagsInt("_NoSortAscending", flags, int32(ImGuiTableColumnFlags_NoSortAscending))
+	g.CheckboxFlagsInt("_NoSortDescending", flags, int32(ImGuiTableColumnFlags_NoSortDescending))
+	g.CheckboxFlagsInt("_NoHeaderLabel", flags, int32(ImGuiTableColumnFlags_NoHeaderLabel))
+	g.CheckboxFlagsInt("_NoHeaderWidth", flags, int32(ImGuiTableColumnFlags_NoHeaderWidth))
+	g.CheckboxFlagsInt("_PreferSortAscending", flags, int32(ImGuiTableColumnFlags_PreferSortAscending))
+	g.CheckboxFlagsInt("_PreferSortDescending", flags, int32(ImGuiTableColumnFlags_PreferSortDescending))
+	g.CheckboxFlagsInt("_IndentEnable", flags, int32(ImGuiTableColumnFlags_IndentEnable))
+	g.SameLine(0, -1)
+	g.HelpMarker("Default for column 0")
+	g.CheckboxFlagsInt("_IndentDisable", flags, int32(ImGuiTableColumnFlags_IndentDisable))
+	g.SameLine(0, -1)
+	g.HelpMarker("Default for column >0")
+}
+
+func (g *ImGuiContext) ShowTableColumnsStatusFlags(flags ImGuiTableColumnFlags) {
+	g.CheckboxFlagsInt("_IsEnabled", (*int32)(&flags), int32(ImGuiTableColumnFlags_IsEnabled))
+	g.CheckboxFlagsInt("_IsVisible", (*int32)(&flags), int32(ImGuiTableColumnFlags_IsVisible))
+	g.CheckboxFlagsInt("_IsSorted", (*int32)(&flags), int32(ImGuiTableColumnFlags_IsSorted))
+	g.CheckboxFlagsInt("_IsHovered", (*int32)(&flags), int32(ImGuiTableColumnFlags_IsHovered))
+}
+
+func (g *ImGuiContext) ShowDemoWindowTables() {
+	var state = &tablesState
+
+	//SetNextItemOpen(true, ImGuiCond_Once)
+	if !g.CollapsingHeader("Tables & Columns", 0) {
+		return
+	}
+
+	// Using those as a base value to create width/height that are factor of the size of our font
+	var TEXT_BASE_WIDTH = g.CalcTextSize("A", true, -1).x
+	var TEXT_BASE_HEIGHT = g.GetTextLineHeightWithSpacing()
+
+	g.PushString("Tables")
+
+	var open_action int = -1
+	if g.Button("Open all") {
+		open_action = 1
+	}
+	g.SameLine(0, -1)
+	if g.Button("Close all") {
+		open_action = 0
+	}
+	g.SameLine(0, -1)
+
+	// Options
+	g.Checkbox("Disable tree indentation", &state.disable_indent)
+	g.SameLine(0, -1)
+	g.HelpMarker("Disable the indenting of tree nodes so demo tables can use the full window width.")
+	g.Separator()
+	if state.disable_indent {
+		g.PushStyleFloat(ImGuiStyleVar_IndentSpacing, 0.0)
+	}
+
+	// About Styling of tables
+	// Most settings are configured on a per-table basis via the flags passed to BeginTable() and TableSetupColumns APIs.
+	// There are however a few settings that a shared and part of the ImGuiStyle structure:
+	//   style.CellPadding                          // Padding within each cell
+	//   style.Colors[ImGuiCol_TableHeaderBg]       // Table header background
+	//   style.Colors[ImGuiCol_TableBorderStrong]   // Table outer and header borders
+	//   style.Colors[ImGuiCol_TableBorderLight]    // Table inner borders
+	//   style.Colors[ImGuiCol_TableRowBg]          // Table row background when ImGuiTableFlags_RowBg is enabled (even rows)
+	//   style.Colors[ImGuiCol_TableRowBgAlt]       // Table row background when ImGuiTableFlags_RowBg is enabled (odds rows)
+
+	// Demos
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Basic") {
+		// Here we will showcase three different ways to output a table.
+		// They are very simple variations of a same thing!
+
+		// [Method 1] Using TableNextRow() to create a new row, and TableSetColumnIndex() to select the column.
+		// In many situations, this is the most flexible and easy to use pattern.
+		g.HelpMarker("Using TableNextRow() + calling TableSetColumnIndex() _before_ each cell, in a loop.")
+		if g.BeginTable("table1", 3, 0, ImVec2{}, 0) {
+			for row := int(0); row < 4; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					g.Text("Row %d Column %d", row, column)
+				}
+			}
+			g.EndTable()
+		}
+
+		// [Method 2] Using TableNextColumn() called multiple times, instead of using a for loop + TableSetColumnIndex().
+		// This is generally more convenient when you have code manually submitting the contents of each columns.
+		g.HelpMarker("Using TableNextRow() + calling TableNextColumn() _before_ each cell, manually.")
+		if g.BeginTable("table2", 3, 0, ImVec2{}, 0) {
+			for row := 0; row < 4; row++ {
+				g.TableNextRow(0, 0)
+				g.TableNextColumn()
+				g.Text("Row %d", row)
+				g.TableNextColumn()
+				g.Text("Some contents")
+				g.TableNextColumn()
+				g.Text("123.456")
+			}
+			g.EndTable()
+		}
+
+		// [Method 3] We call TableNextColumn() _before_ each cell. We never call TableNextRow(),
+		// as TableNextColumn() will automatically wrap around and create new roes as needed.
+		// This is generally more convenient when your cells all contains the same type of data.
+		g.HelpMarker(
+			"Only using TableNextColumn(), which tends to be convenient for tables where every cells contains the same type of contents.\n" +
+				"This is also more similar to the old NextColumn() function of the Columns API, and provided to facilitate the Columns->Tables API transition.")
+		if g.BeginTable("table3", 3, 0, ImVec2{}, 0) {
+			for item := 0; item < 14; item++ {
+				g.TableNextColumn()
+				g.Text("Item %d", item)
+			}
+			g.EndTable()
+		}
+
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Borders, background") {
+		// Expose a few Borders related flags interactively
+		const (
+			CT_Text = iota
+			CT_FillButton
+		)
+		var s = &state.borders
+		var flags = (*int32)(&s.flags)
+
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_RowBg", flags, int32(ImGuiTableFlags_RowBg))
+		g.CheckboxFlagsInt("ImGuiTableFlags_Borders", flags, int32(ImGuiTableFlags_Borders))
+		g.SameLine(0, -1)
+		g.HelpMarker("ImGuiTableFlags_Borders\n = ImGuiTableFlags_BordersInnerV\n | ImGuiTableFlags_BordersOuterV\n | ImGuiTableFlags_BordersInnerV\n | ImGuiTableFlags_BordersOuterH")
+		g.Indent(0)
+
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersH", flags, int32(ImGuiTableFlags_BordersH))
+		g.Indent(0)
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuterH", flags, int32(ImGuiTableFlags_BordersOuterH))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersInnerH", flags, int32(ImGuiTableFlags_BordersInnerH))
+		g.Unindent(0)
+
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersV", flags, int32(ImGuiTableFlags_BordersV))
+		g.Indent(0)
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuterV", flags, int32(ImGuiTableFlags_BordersOuterV))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersInnerV", flags, int32(ImGuiTableFlags_BordersInnerV))
+		g.Unindent(0)
+
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuter", flags, int32(ImGuiTableFlags_BordersOuter))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersInner", flags, int32(ImGuiTableFlags_BordersInner))
+		g.Unindent(0)
+
+		g.AlignTextToFramePadding()
+		g.Text("Cell contents:")
+		g.SameLine(0, -1)
+		g.RadioButtonInt("Text", &s.contents_type, CT_Text)
+		g.SameLine(0, -1)
+		g.RadioButtonInt("FillButton", &s.contents_type, CT_FillButton)
+		g.Checkbox("Display headers", &s.display_headers)
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoBordersInBody", flags, int32(ImGuiTableFlags_NoBordersInBody))
+		g.SameLine(0, -1)
+		g.HelpMarker("Disable vertical borders in columns Body (borders will always appears in Headers")
+		g.PopStyleCompact()
+
+		if g.BeginTable("table1", 3, s.flags, ImVec2{}, 0) {
+			// Display headers so we can inspect their interaction with borders.
+			// (Headers are not the main purpose of this section of the demo, so we are not elaborating on them too much. See other sections for details)
+			if s.display_headers {
+				g.TableSetupColumn("One", 0, 0, 0)
+				g.TableSetupColumn("Two", 0, 0, 0)
+				g.TableSetupColumn("Three", 0, 0, 0)
+				g.TableHeadersRow()
+			}
+
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					var buf = fmt.Sprintf("Hello %d,%d", column, row)
+					if s.contents_type == CT_Text {
+						g.TextUnformatted(buf)
+					} else if s.contents_type != 0 {
+						g.ButtonEx(buf, &ImVec2{-FLT_MIN, 0.0}, 0)
+					}
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Resizable, stretch") {
+		// By default, if we don't enable ScrollX the sizing policy for each columns is "Stretch"
+		// Each columns maintain a sizing weight, and they will occupy all available width.
+		var flags = &state.resizable_stretch_flags
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", (*int32)(flags), int32(ImGuiTableFlags_Resizable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersV", (*int32)(flags), int32(ImGuiTableFlags_BordersV))
+		g.SameLine(0, -1)
+		g.HelpMarker("Using the _Resizable flag automatically enables the _BordersInnerV flag as well, this is why the resize borders are still showing when unchecking this.")
+		g.PopStyleCompact()
+
+		if g.BeginTable("table1", 3, *flags, ImVec2{}, 0) {
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					g.Text("Hello %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Resizable, fixed") {
+		// Here we use ImGuiTableFlags_SizingFixedFit (even though _ScrollX is not set)
+		// So columns will adopt the "Fixed" policy and will maintain a fixed width regardless of the whole available width (unless table is small)
+		// If there is not enough available width to fit all columns, they will however be resized down.
+		// FIXME-TABLE: Providing a stretch-on-init would make sense especially for tables which don't have saved settings
+		g.HelpMarker(
+			"Using _Resizable + _SizingFixedFit flags.\n" +
+				"Fixed-width columns generally makes more sense if you want to use horizontal scrolling.\n\n" +
+				"Double-click a column border to auto-fit the column to its contents.")
+		g.PushStyleCompact()
+		var flags = &state.resizable_fixed_flags
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoHostExtendX", (*int32)(flags), int32(ImGuiTableFlags_NoHostExtendX))
+		g.PopStyleCompact()
+
+		if g.BeginTable("table1", 3, *flags, ImVec2{}, 0) {
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					g.Text("Hello %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Resizable, mixed") {
+		g.HelpMarker(
+			"Using TableSetupColumn() to alter resizing policy on a per-column basis.\n\n" +
+				"When combining Fixed and Stretch columns, generally you only want one, maybe two trailing columns to use _WidthStretch.")
+		var flags ImGuiTableFlags = ImGuiTableFlags_SizingFixedFit | ImGuiTableFlags_RowBg | ImGuiTableFlags_Borders | ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable
+
+		if g.BeginTable("table1", 3, flags, ImVec2{}, 0) {
+			g.TableSetupColumn("AAA", ImGuiTableColumnFlags_WidthFixed, 0, 0)
+			g.TableSetupColumn("BBB", ImGuiTableColumnFlags_WidthFixed, 0, 0)
+			g.TableSetupColumn("CCC", ImGuiTableColumnFlags_WidthStretch, 0, 0)
+			g.TableHeadersRow()
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					var policy = "Fixed"
+					if column == 2 {
+						policy = "Stretch"
+					}
+					g.Text("%s %d,%d", policy, column, row)
+				}
+			}
+			g.EndTable()
+		}
+		if g.BeginTable("table2", 6, flags, ImVec2{}, 0) {
+			g.TableSetupColumn("AAA", ImGuiTableColumnFlags_WidthFixed, 0, 0)
+			g.TableSetupColumn("BBB", ImGuiTableColumnFlags_WidthFixed, 0, 0)
+			g.TableSetupColumn("CCC", ImGuiTableColumnFlags_WidthFixed|ImGuiTableColumnFlags_DefaultHide, 0, 0)
+			g.TableSetupColumn("DDD", ImGuiTableColumnFlags_WidthStretch, 0, 0)
+			g.TableSetupColumn("EEE", ImGuiTableColumnFlags_WidthStretch, 0, 0)
+			g.TableSetupColumn("FFF", ImGuiTableColumnFlags_WidthStretch|ImGuiTableColumnFlags_DefaultHide, 0, 0)
+			g.TableHeadersRow()
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 6; column++ {
+					g.TableSetColumnIndex(column)
+					var policy = "Fixed"
+					if column >= 3 {
+						policy = "Stretch"
+					}
+					g.Text("%s %d,%d", policy, column, row)
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Reorderable, hideable, with headers") {
+		g.HelpMarker(
+			"Click and drag column headers to reorder columns.\n\n" +
+				"Right-click on a header to open a context menu.")
+		var flags = &state.reorderable_flags
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", (*int32)(flags), int32(ImGuiTableFlags_Resizable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_Reorderable", (*int32)(flags), int32(ImGuiTableFlags_Reorderable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_Hideable", (*int32)(flags), int32(ImGuiTableFlags_Hideable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoBordersInBody", (*int32)(flags), int32(ImGuiTableFlags_NoBordersInBody))
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoBordersInBodyUntilResize", (*int32)(flags), int32(ImGuiTableFlags_NoBordersInBodyUntilResize))
+		g.SameLine(0, -1)
+		g.HelpMarker("Disable vertical borders in columns Body until hovered for resize (borders will always appears in Headers)")
+		g.PopStyleCompact()
+
+		if g.BeginTable("table1", 3, *flags, ImVec2{}, 0) {
+			// Submit columns name with TableSetupColumn() and call TableHeadersRow() to create a row with a header in each column.
+			// (Later we will show how TableSetupColumn() has other uses, optional flags, sizing weight etc.)
+			g.TableSetupColumn("One", 0, 0, 0)
+			g.TableSetupColumn("Two", 0, 0, 0)
+			g.TableSetupColumn("Three", 0, 0, 0)
+			g.TableHeadersRow()
+			for row := 0; row < 6; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					g.Text("Hello %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+
+		// Use outer_size.x == 0.0f instead of default to make the table as tight as possible (only valid when no scrolling and no stretch column)
+		if g.BeginTable("table2", 3, *flags|ImGuiTableFlags_SizingFixedFit, ImVec2{0.0, 0.0}, 0) {
+			g.TableSetupColumn("One", 0, 0, 0)
+			g.TableSetupColumn("Two", 0, 0, 0)
+			g.TableSetupColumn("Three", 0, 0, 0)
+			g.TableHeadersRow()
+			for row := 0; row < 6; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					g.Text("Fixed %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Padding") {
+		// First example: showcase use of padding flags and effect of BorderOuterV/BorderInnerV on X padding.
+		// We don't expose BorderOuterH/BorderInnerH here because they have no effect on X padding.
+		g.HelpMarker(
+			"We often want outer padding activated when any using features which makes the edges of a column visible:\n" +
+				"e.g.:\n" +
+				"- BorderOuterV\n" +
+				"- any form of row selection\n" +
+				"Because of this, activating BorderOuterV sets the default to PadOuterX. Using PadOuterX or NoPadOuterX you can override the default.\n\n" +
+				"Actual padding values are using style.CellPadding.\n\n" +
+				"In this demo we don't show horizontal borders to emphasis how they don't affect default horizontal padding.")
+
+		var s = &state.padding
+		var flags1 = (*int32)(&s.flags1)
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_PadOuterX", flags1, int32(ImGuiTableFlags_PadOuterX))
+		g.SameLine(0, -1)
+		g.HelpMarker("Enable outer-most padding (default if ImGuiTableFlags_BordersOuterV is set)")
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoPadOuterX", flags1, int32(ImGuiTableFlags_NoPadOuterX))
+		g.SameLine(0, -1)
+		g.HelpMarker("Disable outer-most padding (default if ImGuiTableFlags_BordersOuterV is not set)")
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoPadInnerX", flags1, int32(ImGuiTableFlags_NoPadInnerX))
+		g.SameLine(0, -1)
+		g.HelpMarker("Disable inner padding between columns (double inner padding if BordersOuterV is on, single inner padding if BordersOuterV is off)")
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuterV", flags1, int32(ImGuiTableFlags_BordersOuterV))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersInnerV", flags1, int32(ImGuiTableFlags_BordersInnerV))
+		g.Checkbox("show_headers", &s.show_headers)
+		g.PopStyleCompact()
+
+		if g.BeginTable("table_padding", 3, s.flags1, ImVec2{}, 0) {
+			if s.show_headers {
+				g.TableSetupColumn("One", 0, 0, 0)
+				g.TableSetupColumn("Two", 0, 0, 0)
+				g.TableSetupColumn("Three", 0, 0, 0)
+				g.TableHeadersRow()
+			}
+
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					if row == 0 {
+						g.Text("Avail %.2f", g.GetContentRegionAvail().x)
+					} else {
+						var buf = fmt.Sprintf("Hello %d,%d", column, row)
+						g.ButtonEx(buf, &ImVec2{-FLT_MIN, 0.0}, 0)
+					}
+					//if TableGetColumnFlags(-1)&ImGuiTableColumnFlags_IsHovered != 0 {
+					//	TableSetBgColor(ImGuiTableBgTarget_CellBg, IM_COL32(0, 100, 0, 255), -1)
+					//}
+				}
+			}
+			g.EndTable()
+		}
+
+		// Second example: set style.CellPadding to (0.0) or a custom value.
+		// FIXME-TABLE: Vertical border effectively not displayed the same way as horizontal one...
+		g.HelpMarker("Setting style.CellPadding to (0,0) or a custom value.")
+		var flags2 = (*int32)(&s.flags2)
+
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_Borders", flags2, int32(ImGuiTableFlags_Borders))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersH", flags2, int32(ImGuiTableFlags_BordersH))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersV", flags2, int32(ImGuiTableFlags_BordersV))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersInner", flags2, int32(ImGuiTableFlags_BordersInner))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuter", flags2, int32(ImGuiTableFlags_BordersOuter))
+		g.CheckboxFlagsInt("ImGuiTableFlags_RowBg", flags2, int32(ImGuiTableFlags_RowBg))
+		g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", flags2, int32(ImGuiTableFlags_Resizable))
+		g.Checkbox("show_widget_frame_bg", &s.show_widget_frame_bg)
+		g.SliderFloat2("CellPadding", &s.cell_padding, 0.0, 10.0, "%.0f", 0)
+		g.PopStyleCompact()
+
+		g.PushStyleVec(ImGuiStyleVar_CellPadding, ImVec2{s.cell_padding[0], s.cell_padding[1]})
+		if g.BeginTable("table_padding_2", 3, s.flags2, ImVec2{}, 0) {
+			if !s.show_widget_frame_bg {
+				g.PushStyleColorInt(ImGuiCol_FrameBg, 0)
+			}
+			for cell := range s.text_bufs {
+				g.TableNextColumn()
+				g.SetNextItemWidth(-FLT_MIN)
+				g.PushID(int(cell))
+				g.InputText("##cell", &s.text_bufs[cell], 0, nil, nil)
+				g.PopID()
+			}
+			if !s.show_widget_frame_bg {
+				g.PopStyleColor(1)
+			}
+			g.EndTable()
+		}
+		g.PopStyleVar(1)
+
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Sizing policies") {
+		var s = &state.sizing
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", (*int32)(&s.flags1), int32(ImGuiTableFlags_Resizable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoHostExtendX", (*int32)(&s.flags1), int32(ImGuiTableFlags_NoHostExtendX))
+		g.PopStyleCompact()
+
+		for table_n := range s.sizing_policy_flags {
+			g.PushID(int(table_n))
+			g.SetNextItemWidth(TEXT_BASE_WIDTH * 30)
+			g.EditTableSizingFlags(&s.sizing_policy_flags[table_n])
+
+			// To make it easier to understand the different sizing policy,
+			// For each policy: we display one table where the columns have equal contents width, and one where the columns have different contents width.
+			if g.BeginTable("table1", 3, s.sizing_policy_flags[table_n]|s.flags1, ImVec2{}, 0) {
+				for row := 0; row < 3; row++ {
+					g.TableNextRow(0, 0)
+					g.TableNextColumn()
+					g.Text("Oh dear")
+					g.TableNextColumn()
+					g.Text("Oh dear")
+					g.TableNextColumn()
+					g.Text("Oh dear")
+				}
+				g.EndTable()
+			}
+			if g.BeginTable("table2", 3, s.sizing_policy_flags[table_n]|s.flags1, ImVec2{}, 0) {
+				for row := 0; row < 3; row++ {
+					g.TableNextRow(0, 0)
+					g.TableNextColumn()
+					g.Text("AAAA")
+					g.TableNextColumn()
+					g.Text("BBBBBBBB")
+					g.TableNextColumn()
+					g.Text("CCCCCCCCCCCC")
+				}
+				g.EndTable()
+			}
+			g.PopID()
+		}
+
+		g.Spacing()
+		g.TextUnformatted("Advanced")
+		g.SameLine(0, -1)
+		g.HelpMarker("This section allows you to interact and see the effect of various sizing policies depending on whether Scroll is enabled and the contents of your columns.")
+
+		const (
+			CT_ShowWidth = iota
+			CT_ShortText
+			CT_LongText
+			CT_Button
+			CT_FillButton
+			CT_InputText
+		)
+
+		g.PushStyleCompact()
+		g.PushString("Advanced")
+		g.PushItemWidth(TEXT_BASE_WIDTH * 30)
+		g.EditTableSizingFlags(&s.flags)
+		var contents = []string{"Show width", "Short Text", "Long Text", "Button", "Fill Button", "InputText"}
+		g.Combo("Contents", &s.contents_type, contents, int(len(contents)), -1)
+		if s.contents_type == CT_FillButton {
+			g.SameLine(0, -1)
+			g.HelpMarker("Be mindful that using right-alignment (e.g. size.x = -FLT_MIN) creates a feedback loop where contents width can feed into auto-column width can feed into contents width.")
+		}
+		g.DragInt("Columns", &s.column_count, 0.1, 1, 64, "%d", ImGuiSliderFlags_AlwaysClamp)
+		g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", (*int32)(&s.flags), int32(ImGuiTableFlags_Resizable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_PreciseWidths", (*int32)(&s.flags), int32(ImGuiTableFlags_PreciseWidths))
+		g.SameLine(0, -1)
+		g.HelpMarker("Disable distributing remainder width to stretched columns (width allocation on a 100-wide table with 3 columns: Without this flag: 33,33,34. With this flag: 33,33,33). With larger number of columns, resizing will appear to be less smooth.")
+		g.CheckboxFlagsInt("ImGuiTableFlags_ScrollX", (*int32)(&s.flags), int32(ImGuiTableFlags_ScrollX))
+		g.CheckboxFlagsInt("ImGuiTableFlags_ScrollY", (*int32)(&s.flags), int32(ImGuiTableFlags_ScrollY))
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoClip", (*int32)(&s.flags), int32(ImGuiTableFlags_NoClip))
+		g.PopItemWidth()
+		g.PopID()
+		g.PopStyleCompact()
+
+		if g.BeginTable("table2", s.column_count, s.flags, ImVec2{0.0, TEXT_BASE_HEIGHT * 7}, 0) {
+			for cell := int(0); cell < 10*s.column_count; cell++ {
+				g.TableNextColumn()
+				var column = g.TableGetColumnIndex()
+				var row = g.TableGetRowIndex()
+
+				g.PushID(cell)
+				var label = fmt.Sprintf("Hello %d,%d", column, row)
+				switch s.contents_type {
+				case CT_ShortText:
+					g.TextUnformatted(label)
+				case CT_LongText:
+					var long = "long"
+					if column != 0 {
+						long = "longeeer"
+					}
+					g.Text("Some %s text %d,%d\nOver two lines..", long, column, row)
+				case CT_ShowWidth:
+					g.Text("W: %.1f", g.GetContentRegionAvail().x)
+				case CT_Button:
+					g.Button(label)
+				case CT_FillButton:
+					g.ButtonEx(label, &ImVec2{-FLT_MIN, 0.0}, 0)
+				case CT_InputText:
+					g.SetNextItemWidth(-FLT_MIN)
+					g.InputText("##", &s.text_buf, 0, nil, nil)
+				}
+				g.PopID()
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Vertical scrolling, with clipping") {
+		g.HelpMarker("Here we activate ScrollY, which will create a child window container to allow hosting scrollable contents.\n\nWe also demonstrate using ImGuiListClipper to virtualize the submission of many items.")
+		var flags = &state.scrolly_flags
+
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_ScrollY", (*int32)(flags), int32(ImGuiTableFlags_ScrollY))
+		g.PopStyleCompact()
+
+		// When using ScrollX or ScrollY we need to specify a size for our table container!
+		// Otherwise by default the table will fit all available space, like a BeginChild() call.
+		var outer_size = ImVec2{0.0, TEXT_BASE_HEIGHT * 8}
+		if g.BeginTable("table_scrolly", 3, *flags, outer_size, 0) {
+			g.TableSetupScrollFreeze(0, 1) // Make top row always visible
+			g.TableSetupColumn("One", ImGuiTableColumnFlags_None, 0, 0)
+			g.TableSetupColumn("Two", ImGuiTableColumnFlags_None, 0, 0)
+			g.TableSetupColumn("Three", ImGuiTableColumnFlags_None, 0, 0)
+			g.TableHeadersRow()
+
+			// Demonstrate using clipper for large vertical lists
+			var clipper = ImGuiListClipper{Ctx: g}
+			clipper.Begin(1000, -1)
+			for clipper.Step() {
+				for row := clipper.DisplayStart; row < clipper.DisplayEnd; row++ {
+					g.TableNextRow(0, 0)
+					for column := int(0); column < 3; column++ {
+						g.TableSetColumnIndex(column)
+						g.Text("Hello %d,%d", column, row)
+					}
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Horizontal scrolling") {
+		g.HelpMarker(
+			"When ScrollX is enabled, the default sizing policy becomes ImGuiTableFlags_SizingFixedFit, " +
+				"as automatically stretching columns doesn't make much sense with horizontal scrolling.\n\n" +
+				"Also note that as of the current version, you will almost always want to enable ScrollY along with ScrollX," +
+				"because the container window won't automatically extend vertically to fix contents (this may be improved in future versions).")
+		var s = &state.scrollx
+
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", (*int32)(&s.flags), int32(ImGuiTableFlags_Resizable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_ScrollX", (*int32)(&s.flags), int32(ImGuiTableFlags_ScrollX))
+		g.CheckboxFlagsInt("ImGuiTableFlags_ScrollY", (*int32)(&s.flags), int32(ImGuiTableFlags_ScrollY))
+		g.SetNextItemWidth(g.GetFrameHeight())
+		g.DragInt("freeze_cols", &s.freeze_cols, 0.2, 0, 9, "%d", ImGuiSliderFlags_NoInput)
+		g.SetNextItemWidth(g.GetFrameHeight())
+		g.DragInt("freeze_rows", &s.freeze_rows, 0.2, 0, 9, "%d", ImGuiSliderFlags_NoInput)
+		g.PopStyleCompact()
+
+		// When using ScrollX or ScrollY we need to specify a size for our table container!
+		// Otherwise by default the table will fit all available space, like a BeginChild() call.
+		var outer_size = ImVec2{0.0, TEXT_BASE_HEIGHT * 8}
+		if g.BeginTable("table_scrollx", 7, s.flags, outer_size, 0) {
+			g.TableSetupScrollFreeze(s.freeze_cols, s.freeze_rows)
+			g.TableSetupColumn("Line #", ImGuiTableColumnFlags_NoHide, 0, 0) // Make the first column not hideable to match our use of TableSetupScrollFreeze()
+			g.TableSetupColumn("One", 0, 0, 0)
+			g.TableSetupColumn("Two", 0, 0, 0)
+			g.TableSetupColumn("Three", 0, 0, 0)
+			g.TableSetupColumn("Four", 0, 0, 0)
+			g.TableSetupColumn("Five", 0, 0, 0)
+			g.TableSetupColumn("Six", 0, 0, 0)
+			g.TableHeadersRow()
+			for row := 0; row < 20; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 7; column++ {
+					// Both TableNextColumn() and TableSetColumnIndex() return true when a column is visible or performing width measurement.
+					// Because here we know that:
+					// - A) all our columns are contributing the same to row height
+					// - B) column 0 is always visible,
+					// We only always submit this one column and can skip others.
+					// More advanced per-column clipping behaviors may benefit from polling the status flags via TableGetColumnFlags().
+					if !g.TableSetColumnIndex(column) && column > 0 {
+						continue
+					}
+					if column == 0 {
+						g.Text("Line %d", row)
+					} else {
+						g.Text("Hello world %d,%d", column, row)
+					}
+				}
+			}
+			g.EndTable()
+		}
+
+		g.Spacing()
+		g.TextUnformatted("Stretch + ScrollX")
+		g.SameLine(0, -1)
+		g.HelpMarker(
+			"Showcase using Stretch columns + ScrollX together: " +
+				"this is rather unusual and only makes sense when specifying an 'inner_width' for the table!\n" +
+				"Without an explicit value, inner_width is == outer_size.x and therefore using Stretch columns + ScrollX together doesn't make sense.")
+		g.PushStyleCompact()
+		g.PushString("flags3")
+		g.PushItemWidth(TEXT_BASE_WIDTH * 30)
+		g.CheckboxFlagsInt("ImGuiTableFlags_ScrollX", (*int32)(&s.flags2), int32(ImGuiTableFlags_ScrollX))
+		g.DragFloat("inner_width", &s.inner_width, 1.0, 0.0, FLT_MAX, "%.1f", 0)
+		g.PopItemWidth()
+		g.PopID()
+		g.PopStyleCompact()
+		if g.BeginTable("table2", 7, s.flags2, outer_size, s.inner_width) {
+			for cell := 0; cell < 20*7; cell++ {
+				g.TableNextColumn()
+				g.Text("Hello world %d,%d", g.TableGetColumnIndex(), g.TableGetRowIndex())
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Columns flags") {
+		// Create a first table just to show all the options/flags we want to make visible in our example!
+		const column_count = 3
+		var column_names = [column_count]string{"One", "Two", "Three"}
+		var s = &state.columns_flags
+
+		if g.BeginTable("table_columns_flags_checkboxes", column_count, ImGuiTableFlags_None, ImVec2{}, 0) {
+			g.PushStyleCompact()
+			for column := range column_names {
+				g.TableNextColumn()
+				g.PushID(int(column))
+				g.AlignTextToFramePadding() // FIXME-TABLE: Workaround for wrong text baseline propagation
+				g.Text("'%s'", column_names[column])
+				g.Spacing()
+				g.Text("Input flags:")
+				g.EditTableColumnsFlags(&s.column_flags[column])
+				g.Spacing()
+				g.Text("Output flags:")
+				g.ShowTableColumnsStatusFlags(s.column_flags_out[column])
+				g.PopID()
+			}
+			g.PopStyleCompact()
+			g.EndTable()
+		}
+
+		// Create the real table we care about for the example!
+		// We use a scrolling table to be able to showcase the difference between the _IsEnabled and _IsVisible flags above, otherwise in
+		// a non-scrolling table columns are always visible (unless using ImGuiTableFlags_NoKeepColumnsVisible + resizing the parent window down)
+		const flags = ImGuiTableFlags_SizingFixedFit | ImGuiTableFlags_ScrollX | ImGuiTableFlags_ScrollY |
+			ImGuiTableFlags_RowBg | ImGuiTableFlags_BordersOuter | ImGuiTableFlags_BordersV |
+			ImGuiTableFlags_Resizable | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable | ImGuiTableFlags_Sortable
+		var outer_size = ImVec2{0.0, TEXT_BASE_HEIGHT * 9}
+		if g.BeginTable("table_columns_flags", column_count, flags, outer_size, 0) {
+			for column := range column_names {
+				g.TableSetupColumn(column_names[column], s.column_flags[column], 0, 0)
+			}
+			g.TableHeadersRow()
+			for column := range column_names {
+				s.column_flags_out[column] = g.TableGetColumnFlags(int(column))
+			}
+			var indent_step = (float)((int)(TEXT_BASE_WIDTH) / 2)
+			for row := 0; row < 8; row++ {
+				g.Indent(indent_step) // Add some indentation to demonstrate usage of per-column IndentEnable/IndentDisable flags.
+				g.TableNextRow(0, 0)
+				for column := int(0); column < column_count; column++ {
+					g.TableSetColumnIndex(column)
+					var prefix = "Hello"
+					if column == 0 {
+						prefix = "Indented"
+					}
+					g.Text("%s %s", prefix, g.TableGetColumnName(column))
+				}
+			}
+			g.Unindent(indent_step * 8.0)
+
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Columns widths") {
+		g.HelpMarker("Using TableSetupColumn() to setup default width.")
+
+		var s = &state.columns_widths
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", (*int32)(&s.flags1), int32(ImGuiTableFlags_Resizable))
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoBordersInBodyUntilResize", (*int32)(&s.flags1), int32(ImGuiTableFlags_NoBordersInBodyUntilResize))
+		g.PopStyleCompact()
+		if g.BeginTable("table1", 3, s.flags1, ImVec2{}, 0) {
+			// We could also set ImGuiTableFlags_SizingFixedFit on the table and all columns will default to ImGuiTableColumnFlags_WidthFixed.
+			g.TableSetupColumn("one", ImGuiTableColumnFlags_WidthFixed, 100.0, 0) // Default to 100.0f
+			g.TableSetupColumn("two", ImGuiTableColumnFlags_WidthFixed, 200.0, 0) // Default to 200.0f
+			g.TableSetupColumn("three", ImGuiTableColumnFlags_WidthFixed, 0, 0)   // Default to auto
+			g.TableHeadersRow()
+			for row := 0; row < 4; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					g.TableSetColumnIndex(column)
+					if row == 0 {
+						g.Text("(w: %5.1f)", g.GetContentRegionAvail().x)
+					} else {
+						g.Text("Hello %d,%d", column, row)
+					}
+				}
+			}
+			g.EndTable()
+		}
+
+		g.HelpMarker("Using TableSetupColumn() to setup explicit width.\n\nUnless _NoKeepColumnsVisible is set, fixed columns with set width may still be shrunk down if there's not enough space in the host.")
+
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoKeepColumnsVisible", (*int32)(&s.flags2), int32(ImGuiTableFlags_NoKeepColumnsVisible))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersInnerV", (*int32)(&s.flags2), int32(ImGuiTableFlags_BordersInnerV))
+		g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuterV", (*int32)(&s.flags2), int32(ImGuiTableFlags_BordersOuterV))
+		g.PopStyleCompact()
+		if g.BeginTable("table2", 4, s.flags2, ImVec2{}, 0) {
+			// We could also set ImGuiTableFlags_SizingFixedFit on the table and all columns will default to ImGuiTableColumnFlags_WidthFixed.
+			g.TableSetupColumn("", ImGuiTableColumnFlags_WidthFixed, 100.0, 0)
+			g.TableSetupColumn("", ImGuiTableColumnFlags_WidthFixed, TEXT_BASE_WIDTH*15.0, 0)
+			g.TableSetupColumn("", ImGuiTableColumnFlags_WidthFixed, TEXT_BASE_WIDTH*30.0, 0)
+			g.TableSetupColumn("", ImGuiTableColumnFlags_WidthFixed, TEXT_BASE_WIDTH*15.0, 0)
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 4; column++ {
+					g.TableSetColumnIndex(column)
+					if row == 0 {
+						g.Text("(w: %5.1f)", g.GetContentRegionAvail().x)
+					} else {
+						g.Text("Hello %d,%d", column, row)
+					}
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Nested tables") {
+		g.HelpMarker("This demonstrate embedding a table into another table cell.")
+
+		if g.BeginTable("table_nested1", 2, ImGuiTableFlags_Borders|ImGuiTableFlags_Resizable|ImGuiTableFlags_Reorderable|ImGuiTableFlags_Hideable, ImVec2{}, 0) {
+			g.TableSetupColumn("A0", 0, 0, 0)
+			g.TableSetupColumn("A1", 0, 0, 0)
+			g.TableHeadersRow()
+
+			g.TableNextColumn()
+			g.Text("A0 Row 0")
+			{
+				var rows_height = TEXT_BASE_HEIGHT * 2
+				if g.BeginTable("table_nested2", 2, ImGuiTableFlags_Borders|ImGuiTableFlags_Resizable|ImGuiTableFlags_Reorderable|ImGuiTableFlags_Hideable, ImVec2{}, 0) {
+					g.TableSetupColumn("B0", 0, 0, 0)
+					g.TableSetupColumn("B1", 0, 0, 0)
+					g.TableHeadersRow()
+
+					g.TableNextRow(ImGuiTableRowFlags_None, rows_height)
+					g.TableNextColumn()
+					g.Text("B0 Row 0")
+					g.TableNextColumn()
+					g.Text("B1 Row 0")
+					g.TableNextRow(ImGuiTableRowFlags_None, rows_height)
+					g.TableNextColumn()
+					g.Text("B0 Row 1")
+					g.TableNextColumn()
+					g.Text("B1 Row 1")
+
+					g.EndTable()
+				}
+			}
+			g.TableNextColumn()
+			g.Text("A1 Row 0")
+			g.TableNextColumn()
+			g.Text("A0 Row 1")
+			g.TableNextColumn()
+			g.Text("A1 Row 1")
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Row height") {
+		g.HelpMarker("You can pass a 'min_row_height' to TableNextRow().\n\nRows are padded with 'style.CellPadding.y' on top and bottom, so effectively the minimum row height will always be >= 'style.CellPadding.y * 2.0f'.\n\nWe cannot honor a _maximum_ row height as that would requires a unique clipping rectangle per row.")
+		if g.BeginTable("table_row_height", 1, ImGuiTableFlags_BordersOuter|ImGuiTableFlags_BordersInnerV, ImVec2{}, 0) {
+			for row := 0; row < 10; row++ {
+				var min_row_height = (float)((int)(TEXT_BASE_HEIGHT * 0.30 * float(row)))
+				g.TableNextRow(ImGuiTableRowFlags_None, min_row_height)
+				g.TableNextColumn()
+				g.Text("min_row_height = %.2f", min_row_height)
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Outer size") {
+		// Showcasing use of ImGuiTableFlags_NoHostExtendX and ImGuiTableFlags_NoHostExtendY
+		// Important to that note how the two flags have slightly different behaviors!
+		g.Text("Using NoHostExtendX and NoHostExtendY:")
+		g.PushStyleCompact()
+		var flags = &state.outer_size_flags
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoHostExtendX", (*int32)(flags), int32(ImGuiTableFlags_NoHostExtendX))
+		g.SameLine(0, -1)
+		g.HelpMarker("Make outer width auto-fit to columns, overriding outer_size.x value.\n\nOnly available when ScrollX/ScrollY are disabled and Stretch columns are not used.")
+		g.CheckboxFlagsInt("ImGuiTableFlags_NoHostExtendY", (*int32)(flags), int32(ImGuiTableFlags_NoHostExtendY))
+		g.SameLine(0, -1)
+		g.HelpMarker("Make outer height stop exactly at outer_size.y (prevent auto-extending table past the limit).\n\nOnly available when ScrollX/ScrollY are disabled. Data below the limit will be clipped and not visible.")
+		g.PopStyleCompact()
+
+		var outer_size = ImVec2{0.0, TEXT_BASE_HEIGHT * 5.5}
+		if g.BeginTable("table1", 3, *flags, outer_size, 0) {
+			for row := 0; row < 10; row++ {
+				g.TableNextRow(0, 0)
+				for column := 0; column < 3; column++ {
+					g.TableNextColumn()
+					g.Text("Cell %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+		g.SameLine(0, -1)
+		g.Text("Hello!")
+
+		g.Spacing()
+
+		g.Text("Using explicit size:")
+		if g.BeginTable("table2", 3, ImGuiTableFlags_Borders|ImGuiTableFlags_RowBg, ImVec2{TEXT_BASE_WIDTH * 30, 0.0}, 0) {
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := 0; column < 3; column++ {
+					g.TableNextColumn()
+					g.Text("Cell %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+		g.SameLine(0, -1)
+		if g.BeginTable("table3", 3, ImGuiTableFlags_Borders|ImGuiTableFlags_RowBg, ImVec2{TEXT_BASE_WIDTH * 30, 0.0}, 0) {
+			for row := 0; row < 3; row++ {
+				g.TableNextRow(0, TEXT_BASE_HEIGHT*1.5)
+				for column := 0; column < 3; column++ {
+					g.TableNextColumn()
+					g.Text("Cell %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Background color") {
+		var s = &state.background
+		var flags = (*int32)(&s.flags)
+
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_Borders", flags, int32(ImGuiTableFlags_Borders))
+		g.CheckboxFlagsInt("ImGuiTableFlags_RowBg", flags, int32(ImGuiTableFlags_RowBg))
+		g.SameLine(0, -1)
+		g.HelpMarker("ImGuiTableFlags_RowBg automatically sets RowBg0 to alternative colors pulled from the Style.")
+		g.Combo("row bg type", &s.row_bg_type, []string{"None", "Red", "Gradient"}, 3, -1)
+		g.Combo("row bg target", &s.row_bg_target, []string{"RowBg0", "RowBg1"}, 2, -1)
+		g.SameLine(0, -1)
+		g.HelpMarker("Target RowBg0 to override the alternating odd/even colors,\nTarget RowBg1 to blend with them.")
+		g.Combo("cell bg type", &s.cell_bg_type, []string{"None", "Blue"}, 2, -1)
+		g.SameLine(0, -1)
+		g.HelpMarker("We are colorizing cells to B1->C2 here.")
+		IM_ASSERT(s.row_bg_type >= 0 && s.row_bg_type <= 2)
+		IM_ASSERT(s.row_bg_target >= 0 && s.row_bg_target <= 1)
+		IM_ASSERT(s.cell_bg_type >= 0 && s.cell_bg_type <= 1)
+		g.PopStyleCompact()
+
+		if g.BeginTable("table1", 5, s.flags, ImVec2{}, 0) {
+			for row := int(0); row < 6; row++ {
+				g.TableNextRow(0, 0)
+
+				// Demonstrate setting a row background color with 'TableSetBgColor(ImGuiTableBgTarget_RowBgX, ...)'
+				// We use a transparent color so we can see the one behind in case our target is RowBg1 and RowBg0 was already targeted by the ImGuiTableFlags_RowBg flag.
+				if s.row_bg_type != 0 {
+					var row_bg_color ImU32
+					if s.row_bg_type == 1 {
+						row_bg_color = g.GetColorU32FromVec(ImVec4{0.7, 0.3, 0.3, 0.65}) // Flat
+					} else {
+						row_bg_color = g.GetColorU32FromVec(ImVec4{0.2 + float(row)*0.1, 0.2, 0.2, 0.65}) // Gradient
+					}
+					g.TableSetBgColor(ImGuiTableBgTarget_RowBg0+ImGuiTableBgTarget(s.row_bg_target), row_bg_color, -1)
+				}
+
+				// Fill cells
+				for column := int(0); column < 5; column++ {
+					g.TableSetColumnIndex(column)
+					g.Text("%c%c", 'A'+row, '0'+column)
+
+					// Change background of Cells B1->C2
+					// Demonstrate setting a cell background color with 'TableSetBgColor(ImGuiTableBgTarget_CellBg, ...)'
+					// (the CellBg color will be blended over the RowBg and ColumnBg colors)
+					// We can also pass a column number as a third parameter to TableSetBgColor() and do this outside the column loop.
+					if row >= 1 && row <= 2 && column >= 1 && column <= 2 && s.cell_bg_type == 1 {
+						var cell_bg_color = g.GetColorU32FromVec(ImVec4{0.3, 0.3, 0.7, 0.65})
+						g.TableSetBgColor(ImGuiTableBgTarget_CellBg, cell_bg_color, -1)
+					}
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Tree view") {
+		const flags = ImGuiTableFlags_BordersV | ImGuiTableFlags_BordersOuterH | ImGuiTableFlags_Resizable | ImGuiTableFlags_RowBg | ImGuiTableFlags_NoBordersInBody
+
+		if g.BeginTable("3ways", 3, flags, ImVec2{}, 0) {
+			// The first column will use the default _WidthStretch when ScrollX is Off and _WidthFixed when ScrollX is On
+			g.TableSetupColumn("Name", ImGuiTableColumnFlags_NoHide, 0, 0)
+			g.TableSetupColumn("Size", ImGuiTableColumnFlags_WidthFixed, TEXT_BASE_WIDTH*12.0, 0)
+			g.TableSetupColumn("Type", ImGuiTableColumnFlags_WidthFixed, TEXT_BASE_WIDTH*18.0, 0)
+			g.TableHeadersRow()
+
+			g.showDemoTreeNode(&demoTreeNodes[0], demoTreeNodes[:])
+
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Item width") {
+		g.HelpMarker(
+			"Showcase using PushItemWidth() and how it is preserved on a per-column basis.\n\n" +
+				"Note that on auto-resizing non-resizable fixed columns, querying the content width for e.g. right-alignment doesn't make sense.")
+		if g.BeginTable("table_item_width", 3, ImGuiTableFlags_Borders, ImVec2{}, 0) {
+			g.TableSetupColumn("small", 0, 0, 0)
+			g.TableSetupColumn("half", 0, 0, 0)
+			g.TableSetupColumn("right-align", 0, 0, 0)
+			g.TableHeadersRow()
+
+			for row := int(0); row < 3; row++ {
+				g.TableNextRow(0, 0)
+				if row == 0 {
+					// Setup ItemWidth once (instead of setting up every time, which is also possible but less efficient)
+					g.TableSetColumnIndex(0)
+					g.PushItemWidth(TEXT_BASE_WIDTH * 3.0) // Small
+					g.TableSetColumnIndex(1)
+					g.PushItemWidth(-g.GetContentRegionAvail().x * 0.5)
+					g.TableSetColumnIndex(2)
+					g.PushItemWidth(-FLT_MIN) // Right-aligned
+				}
+
+				// Draw our contents
+				g.PushID(row)
+				g.TableSetColumnIndex(0)
+				g.SliderFloat("float0", &state.item_width_dummy_f, 0.0, 1.0, "%.3f", 0)
+				g.TableSetColumnIndex(1)
+				g.SliderFloat("float1", &state.item_width_dummy_f, 0.0, 1.0, "%.3f", 0)
+				g.TableSetColumnIndex(2)
+				g.SliderFloat("float2", &state.item_width_dummy_f, 0.0, 1.0, "%.3f", 0)
+				g.PopID()
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	// Demonstrate using TableHeader() calls instead of TableHeadersRow()
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Custom headers") {
+		const COLUMNS_COUNT = 3
+		if g.BeginTable("table_custom_headers", COLUMNS_COUNT, ImGuiTableFlags_Borders|ImGuiTableFlags_Reorderable|ImGuiTableFlags_Hideable, ImVec2{}, 0) {
+			g.TableSetupColumn("Apricot", 0, 0, 0)
+			g.TableSetupColumn("Banana", 0, 0, 0)
+			g.TableSetupColumn("Cherry", 0, 0, 0)
+
+			// Dummy entire-column selection storage
+			// FIXME: It would be nice to actually demonstrate full-featured selection using those checkbox.
+			var column_selected = &state.custom_headers_column_selected
+
+			// Instead of calling TableHeadersRow() we'll submit custom headers ourselves
+			g.TableNextRow(ImGuiTableRowFlags_Headers, 0)
+			for column := int(0); column < COLUMNS_COUNT; column++ {
+				g.TableSetColumnIndex(column)
+				var column_name = g.TableGetColumnName(column) // Retrieve name passed to TableSetupColumn()
+				g.PushID(column)
+				g.PushStyleVec(ImGuiStyleVar_FramePadding, ImVec2{})
+				g.Checkbox("##checkall", &column_selected[column])
+				g.PopStyleVar(1)
+				g.SameLine(0.0, g.GetStyle().ItemInnerSpacing.x)
+				g.TableHeader(column_name)
+				g.PopID()
+			}
+
+			for row := 0; row < 5; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < 3; column++ {
+					var buf = fmt.Sprintf("Cell %d,%d", column, row)
+					g.TableSetColumnIndex(column)
+					g.Selectable(buf, column_selected[column], 0, ImVec2{})
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	// Demonstrate creating custom context menus inside columns, while playing it nice with context menus provided by TableHeadersRow()/TableHeader()
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Context menus") {
+		g.HelpMarker("By default, right-clicking over a TableHeadersRow()/TableHeader() line will open the default context-menu.\nUsing ImGuiTableFlags_ContextMenuInBody we also allow right-clicking over columns body.")
+		var flags1 = &state.context_menus_flags
+
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_ContextMenuInBody", (*int32)(flags1), int32(ImGuiTableFlags_ContextMenuInBody))
+		g.PopStyleCompact()
+
+		// Context Menus: first example
+		// [1.1] Right-click on the TableHeadersRow() line to open the default table context menu.
+		// [1.2] Right-click in columns also open the default table context menu (if ImGuiTableFlags_ContextMenuInBody is set)
+		const COLUMNS_COUNT = 3
+		if g.BeginTable("table_context_menu", COLUMNS_COUNT, *flags1, ImVec2{}, 0) {
+			g.TableSetupColumn("One", 0, 0, 0)
+			g.TableSetupColumn("Two", 0, 0, 0)
+			g.TableSetupColumn("Three", 0, 0, 0)
+
+			// [1.1]] Right-click on the TableHeadersRow() line to open the default table context menu.
+			g.TableHeadersRow()
+
+			// Submit dummy contents
+			for row := 0; row < 4; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < COLUMNS_COUNT; column++ {
+					g.TableSetColumnIndex(column)
+					g.Text("Cell %d,%d", column, row)
+				}
+			}
+			g.EndTable()
+		}
+
+		// Context Menus: second example
+		// [2.1] Right-click on the TableHeadersRow() line to open the default table context menu.
+		// [2.2] Right-click on the ".." to open a custom popup
+		// [2.3] Right-click in columns to open another custom popup
+		g.HelpMarker("Demonstrate mixing table context menu (over header), item context button (over button) and custom per-colum context menu (over column body).")
+		const flags2 = ImGuiTableFlags_Resizable | ImGuiTableFlags_SizingFixedFit | ImGuiTableFlags_Reorderable | ImGuiTableFlags_Hideable | ImGuiTableFlags_Borders
+		if g.BeginTable("table_context_menu_2", COLUMNS_COUNT, flags2, ImVec2{}, 0) {
+			g.TableSetupColumn("One", 0, 0, 0)
+			g.TableSetupColumn("Two", 0, 0, 0)
+			g.TableSetupColumn("Three", 0, 0, 0)
+
+			// [2.1] Right-click on the TableHeadersRow() line to open the default table context menu.
+			g.TableHeadersRow()
+			for row := int(0); row < 4; row++ {
+				g.TableNextRow(0, 0)
+				for column := int(0); column < COLUMNS_COUNT; column++ {
+					// Submit dummy contents
+					g.TableSetColumnIndex(column)
+					g.Text("Cell %d,%d", column, row)
+					g.SameLine(0, -1)
+
+					// [2.2] Right-click on the ".." to open a custom popup
+					g.PushID(row*COLUMNS_COUNT + column)
+					g.SmallButton("..")
+					if g.BeginPopupContextItem("", ImGuiPopupFlags_MouseButtonRight) {
+						g.Text("This is the popup for Button(\"..\") in Cell %d,%d", column, row)
+						if g.Button("Close") {
+							g.CloseCurrentPopup()
+						}
+						g.EndPopup()
+					}
+					g.PopID()
+				}
+			}
+
+			// [2.3] Right-click anywhere in columns to open another custom popup
+			// (instead of testing for !IsAnyItemHovered() we could also call OpenPopup() with ImGuiPopupFlags_NoOpenOverExistingPopup
+			// to manage popup priority as the popups triggers, here "are we hovering a column" are overlapping)
+			var hovered_column int = -1
+			for column := int(0); column < COLUMNS_COUNT+1; column++ {
+				g.PushID(column)
+				if g.TableGetColumnFlags(column)&ImGuiTableColumnFlags_IsHovered != 0 {
+					hovered_column = column
+				}
+				if hovered_column == column && !g.IsAnyItemHovered() && g.IsMouseReleased(1) {
+					g.OpenPopup("MyPopup", 0)
+				}
+				if g.BeginPopup("MyPopup", 0) {
+					if column == COLUMNS_COUNT {
+						g.Text("This is a custom popup for unused space after the last column.")
+					} else {
+						g.Text("This is a custom popup for Column %d", column)
+					}
+					if g.Button("Close") {
+						g.CloseCurrentPopup()
+					}
+					g.EndPopup()
+				}
+				g.PopID()
+			}
+
+			g.EndTable()
+			g.Text("Hovered column: %d", hovered_column)
+		}
+		g.TreePop()
+	}
+
+	// Demonstrate creating multiple tables with the same ID
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Synced instances") {
+		g.HelpMarker("Multiple tables with the same identifier will share their settings, width, visibility, order etc.")
+		for n := 0; n < 3; n++ {
+			var buf = fmt.Sprintf("Synced Table %d", n)
+			var open = g.CollapsingHeader(buf, ImGuiTreeNodeFlags_DefaultOpen)
+			if open && g.BeginTable("Table", 3, ImGuiTableFlags_Resizable|ImGuiTableFlags_Reorderable|ImGuiTableFlags_Hideable|ImGuiTableFlags_Borders|ImGuiTableFlags_SizingFixedFit|ImGuiTableFlags_NoSavedSettings, ImVec2{}, 0) {
+				g.TableSetupColumn("One", 0, 0, 0)
+				g.TableSetupColumn("Two", 0, 0, 0)
+				g.TableSetupColumn("Three", 0, 0, 0)
+				g.TableHeadersRow()
+				for cell := 0; cell < 9; cell++ {
+					g.TableNextColumn()
+					g.Text("this cell %d", cell)
+				}
+				g.EndTable()
+			}
+		}
+		g.TreePop()
+	}
+
+	// Demonstrate using Sorting facilities
+	// This is a simplified version of the "Advanced" example, where we mostly focus on the code necessary to handle sorting.
+	// Note that the "Advanced" example also showcase manually triggering a sort (e.g. if item quantities have been modified)
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Sorting") {
+		var s = &state.sorting
+
+		// Create item list
+		if len(s.items) == 0 {
+			s.items = make([]MyItem, 50)
+			for n := range s.items {
+				var template_n = n % len(template_items_names)
+				var item = &s.items[n]
+				item.ID = int(n)
+				item.Name = template_items_names[template_n]
+				item.Quantity = int((n*n - n) % 20) // Assign default quantities
+			}
+		}
+
+		// Options
+		g.PushStyleCompact()
+		g.CheckboxFlagsInt("ImGuiTableFlags_SortMulti", (*int32)(&s.flags), int32(ImGuiTableFlags_SortMulti))
+		g.SameLine(0, -1)
+		g.HelpMarker("When sorting is enabled: hold shift when clicking headers to sort on multiple column. TableGetSortSpecs() may return specs where (SpecsCount > 1).")
+		g.CheckboxFlagsInt("ImGuiTableFlags_SortTristate", (*int32)(&s.flags), int32(ImGuiTableFlags_SortTristate))
+		g.SameLine(0, -1)
+		g.HelpMarker("When sorting is enabled: allow no sorting, disable default sorting. TableGetSortSpecs() may return specs where (SpecsCount == 0).")
+		g.PopStyleCompact()
+
+		if g.BeginTable("table_sorting", 4, s.flags, ImVec2{0.0, TEXT_BASE_HEIGHT * 15}, 0.0) {
+			// Declare columns
+			// We use the "user_id" parameter of TableSetupColumn() to specify a user id that will be stored in the sort specifications.
+			// This is so our sort function can identify a column given our own identifier. We could also identify them based on their index!
+			// Demonstrate using a mixture of flags among available sort-related flags:
+			// - ImGuiTableColumnFlags_DefaultSort
+			// - ImGuiTableColumnFlags_NoSort / ImGuiTableColumnFlags_NoSortAscending / ImGuiTableColumnFlags_NoSortDescending
+			// - ImGuiTableColumnFlags_PreferSortAscending / ImGuiTableColumnFlags_PreferSortDescending
+			g.TableSetupColumn("ID", ImGuiTableColumnFlags_DefaultSort|ImGuiTableColumnFlags_WidthFixed, 0.0, MyItemColumnID_ID)
+			g.TableSetupColumn("Name", ImGuiTableColumnFlags_WidthFixed, 0.0, MyItemColumnID_Name)
+			g.TableSetupColumn("Action", ImGuiTableColumnFlags_NoSort|ImGuiTableColumnFlags_WidthFixed, 0.0, MyItemColumnID_Action)
+			g.TableSetupColumn("Quantity", ImGuiTableColumnFlags_PreferSortDescending|ImGuiTableColumnFlags_WidthStretch, 0.0, MyItemColumnID_Quantity)
+			g.TableSetupScrollFreeze(0, 1) // Make row always visible
+			g.TableHeadersRow()
+
+			// Sort our data if sort specs have been changed!
+			if sorts_specs := g.TableGetSortSpecs(); sorts_specs != nil && sorts_specs.SpecsDirty {
+				SortWithSortSpecs(sorts_specs, s.items)
+				sorts_specs.SpecsDirty = false
+			}
+
+			// Demonstrate using clipper for large vertical lists
+			var clipper = ImGuiListClipper{Ctx: g}
+			clipper.Begin(int(len(s.items)), -1)
+			for clipper.Step() {
+				for row_n := clipper.DisplayStart; row_n < clipper.DisplayEnd; row_n++ {
+					// Display a data item
+					var item = &s.items[row_n]
+					g.PushID(item.ID)
+					g.TableNextRow(0, 0)
+					g.TableNextColumn()
+					g.Text("%04d", item.ID)
+					g.TableNextColumn()
+					g.TextUnformatted(item.Name)
+					g.TableNextColumn()
+					g.SmallButton("None")
+					g.TableNextColumn()
+					g.Text("%d", item.Quantity)
+					g.PopID()
+				}
+			}
+			g.EndTable()
+		}
+		g.TreePop()
+	}
+
+	// In this example we'll expose most table flags and settings.
+	// For specific flags and settings refer to the corresponding section for more detailed explanation.
+	// This section is mostly useful to experiment with combining certain flags or settings with each others.
+	//SetNextItemOpen(true, ImGuiCond_Once) // [DEBUG]
+	if open_action != -1 {
+		g.SetNextItemOpen(open_action != 0, 0)
+	}
+	if g.TreeNode("Advanced") {
+		var s = &state.advanced
+		var flags = (*int32)(&s.flags)
+
+		const (
+			CT_Text = iota
+			CT_Button
+			CT_SmallButton
+			CT_FillButton
+			CT_Selectable
+			CT_SelectableSpanRow
+		)
+		var contents_type_names = []string{"Text", "Button", "SmallButton", "FillButton", "Selectable", "Selectable (span row)"}
+		if !s.outer_size_init {
+			s.outer_size_value = [2]float{0.0, TEXT_BASE_HEIGHT * 12}
+			s.outer_size_init = true
+		}
+		//SetNextItemOpen(true, ImGuiCond_Once) // FIXME-TABLE: Enabling this results in initial clipped first pass on table which tend to affects column sizing
+		if g.TreeNode("Options") {
+			// Make the UI compact because there are so many fields
+			g.PushStyleCompact()
+			g.PushItemWidth(TEXT_BASE_WIDTH * 28.0)
+
+			if g.TreeNodeEx("Features:", ImGuiTreeNodeFlags_DefaultOpen, "Features:") {
+				g.CheckboxFlagsInt("ImGuiTableFlags_Resizable", flags, int32(ImGuiTableFlags_Resizable))
+				g.CheckboxFlagsInt("ImGuiTableFlags_Reorderable", flags, int32(ImGuiTableFlags_Reorderable))
+				g.CheckboxFlagsInt("ImGuiTableFlags_Hideable", flags, int32(ImGuiTableFlags_Hideable))
+				g.CheckboxFlagsInt("ImGuiTableFlags_Sortable", flags, int32(ImGuiTableFlags_Sortable))
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoSavedSettings", flags, int32(ImGuiTableFlags_NoSavedSettings))
+				g.CheckboxFlagsInt("ImGuiTableFlags_ContextMenuInBody", flags, int32(ImGuiTableFlags_ContextMenuInBody))
+				g.TreePop()
+			}
+
+			if g.TreeNodeEx("Decorations:", ImGuiTreeNodeFlags_DefaultOpen, "Decorations:") {
+				g.CheckboxFlagsInt("ImGuiTableFlags_RowBg", flags, int32(ImGuiTableFlags_RowBg))
+				g.CheckboxFlagsInt("ImGuiTableFlags_BordersV", flags, int32(ImGuiTableFlags_BordersV))
+				g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuterV", flags, int32(ImGuiTableFlags_BordersOuterV))
+				g.CheckboxFlagsInt("ImGuiTableFlags_BordersInnerV", flags, int32(ImGuiTableFlags_BordersInnerV))
+				g.CheckboxFlagsInt("ImGuiTableFlags_BordersH", flags, int32(ImGuiTableFlags_BordersH))
+				g.CheckboxFlagsInt("ImGuiTableFlags_BordersOuterH", flags, int32(ImGuiTableFlags_BordersOuterH))
+				g.CheckboxFlagsInt("ImGuiTableFlags_BordersInnerH", flags, int32(ImGuiTableFlags_BordersInnerH))
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoBordersInBody", flags, int32(ImGuiTableFlags_NoBordersInBody))
+				g.SameLine(0, -1)
+				g.HelpMarker("Disable vertical borders in columns Body (borders will always appears in Headers")
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoBordersInBodyUntilResize", flags, int32(ImGuiTableFlags_NoBordersInBodyUntilResize))
+				g.SameLine(0, -1)
+				g.HelpMarker("Disable vertical borders in columns Body until hovered for resize (borders will always appears in Headers)")
+				g.TreePop()
+			}
+
+			if g.TreeNodeEx("Sizing:", ImGuiTreeNodeFlags_DefaultOpen, "Sizing:") {
+				g.EditTableSizingFlags(&s.flags)
+				g.SameLine(0, -1)
+				g.HelpMarker("In the Advanced demo we override the policy of each column so those table-wide settings have less effect that typical.")
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoHostExtendX", flags, int32(ImGuiTableFlags_NoHostExtendX))
+				g.SameLine(0, -1)
+				g.HelpMarker("Make outer width auto-fit to columns, overriding outer_size.x value.\n\nOnly available when ScrollX/ScrollY are disabled and Stretch columns are not used.")
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoHostExtendY", flags, int32(ImGuiTableFlags_NoHostExtendY))
+				g.SameLine(0, -1)
+				g.HelpMarker("Make outer height stop exactly at outer_size.y (prevent auto-extending table past the limit).\n\nOnly available when ScrollX/ScrollY are disabled. Data below the limit will be clipped and not visible.")
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoKeepColumnsVisible", flags, int32(ImGuiTableFlags_NoKeepColumnsVisible))
+				g.SameLine(0, -1)
+				g.HelpMarker("Only available if ScrollX is disabled.")
+				g.CheckboxFlagsInt("ImGuiTableFlags_PreciseWidths", flags, int32(ImGuiTableFlags_PreciseWidths))
+				g.SameLine(0, -1)
+				g.HelpMarker("Disable distributing remainder width to stretched columns (width allocation on a 100-wide table with 3 columns: Without this flag: 33,33,34. With this flag: 33,33,33). With larger number of columns, resizing will appear to be less smooth.")
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoClip", flags, int32(ImGuiTableFlags_NoClip))
+				g.SameLine(0, -1)
+				g.HelpMarker("Disable clipping rectangle for every individual columns (reduce draw command count, items will be able to overflow into other columns). Generally incompatible with ScrollFreeze options.")
+				g.TreePop()
+			}
+
+			if g.TreeNodeEx("Padding:", ImGuiTreeNodeFlags_DefaultOpen, "Padding:") {
+				g.CheckboxFlagsInt("ImGuiTableFlags_PadOuterX", flags, int32(ImGuiTableFlags_PadOuterX))
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoPadOuterX", flags, int32(ImGuiTableFlags_NoPadOuterX))
+				g.CheckboxFlagsInt("ImGuiTableFlags_NoPadInnerX", flags, int32(ImGuiTableFlags_NoPadInnerX))
+				g.TreePop()
+			}
+
+			if g.TreeNodeEx("Scrolling:", ImGuiTreeNodeFlags_DefaultOpen, "Scrolling:") {
+				g.CheckboxFlagsInt("ImGuiTableFlags_ScrollX", flags, int32(ImGuiTableFlags_ScrollX))
+				g.SameLine(0, -1)
+				g.SetNextItemWidth(g.GetFrameHeight())
+				g.DragInt("freeze_cols", &s.freeze_cols, 0.2, 0, 9, "%d", ImGuiSliderFlags_NoInput)
+				g.CheckboxFlagsInt("ImGuiTableFlags_ScrollY", flags, int32(ImGuiTableFlags_ScrollY))
+				g.SameLine(0, -1)
+				g.SetNextItemWidth(g.GetFrameHeight())
+				g.DragInt("freeze_rows", &s.freeze_rows, 0.2, 0, 9, "%d", ImGuiSliderFlags_NoInput)
+				g.TreePop()
+			}
+
+			if g.TreeNodeEx("Sorting:", ImGuiTreeNodeFlags_DefaultOpen, "Sorting:") {
+				g.CheckboxFlagsInt("ImGuiTableFlags_SortMulti", flags, int32(ImGuiTableFlags_SortMulti))
+				g.SameLine(0, -1)
+				g.HelpMarker("When sorting is enabled: hold shift when clicking headers to sort on multiple column. TableGetSortSpecs() may return specs where (SpecsCount > 1).")
+				g.CheckboxFlagsInt("ImGuiTableFlags_SortTristate", flags, int32(ImGuiTableFlags_SortTristate))
+				g.SameLine(0, -1)
+				g.HelpMarker("When sorting is enabled: allow no sorting, disable default sorting. TableGetSortSpecs() may return specs where (SpecsCount == 0).")
+				g.TreePop()
+			}
+
+			if g.TreeNodeEx("Other:", ImGuiTreeNodeFlags_DefaultOpen, "Other:") {
+				g.Checkbox("show_headers", &s.show_headers)
+				g.Checkbox("show_wrapped_text", &s.show_wrapped_text)
+
+				g.DragFloat2("##OuterSize", &s.outer_size_value, 1.0, 0.0, 0.0, "%.3f", 0)
+				g.SameLine(0.0, g.GetStyle().ItemInnerSpacing.x)
+				g.Checkbox("outer_size", &s.outer_size_enabled)
+				g.SameLine(0, -1)
+				g.HelpMarker("If scrolling is disabled (ScrollX and ScrollY not set):\n" +
+					"- The table is output directly in the parent window.\n" +
+					"- OuterSize.x < 0.0f will right-align the table.\n" +
+					"- OuterSize.x = 0.0f will narrow fit the table unless there are any Stretch column.\n" +
+					"- OuterSize.y then becomes the minimum size for the table, which will extend vertically if there are more rows (unless NoHostExtendY is set).")
+
+				// From a user point of view we will tend to use 'inner_width' differently depending on whether our table is embedding scrolling.
+				// To facilitate toying with this demo we will actually pass 0.0f to the BeginTable() when ScrollX is disabled.
+				g.DragFloat("inner_width (when ScrollX active)", &s.inner_width_with_scroll, 1.0, 0.0, FLT_MAX, "%.3f", 0)
+
+				g.DragFloat("row_min_height", &s.row_min_height, 1.0, 0.0, FLT_MAX, "%.3f", 0)
+				g.SameLine(0, -1)
+				g.HelpMarker("Specify height of the Selectable item.")
+
+				g.DragInt("items_count", &s.items_count, 0.1, 0, 9999, "%d", 0)
+				g.Combo("items_type (first column)", &s.contents_type, contents_type_names, int(len(contents_type_names)), -1)
+				g.TreePop()
+			}
+
+			g.PopItemWidth()
+			g.PopStyleCompact()
+			g.Spacing()
+			g.TreePop()
+		}
+
+		// Update item list if we changed the number of items
+		if int(len(s.items)) != s.items_count {
+			s.items = make([]MyItem, s.items_count)
+			for n := range s.items {
+				var template_n = n % len(template_items_names)
+				var item = &s.items[n]
+				item.ID = int(n)
+				item.Name = template_items_names[template_n]
+				switch template_n { // Assign default quantities
+				case 3:
+					item.Quantity = 10
+				case 4:
+					item.Quantity = 20
+				default:
+					item.Quantity = 0
+				}
+			}
+		}
+
+		var parent_draw_list = g.GetWindowDrawList()
+		var parent_draw_list_draw_cmd_count = int(len(parent_draw_list.CmdBuffer))
+		var table_scroll_cur, table_scroll_max ImVec2 // For debug display
+		var table_draw_list *ImDrawList               // "
+
+		// Submit table
+		var inner_width_to_use float
+		if s.flags&ImGuiTableFlags_ScrollX != 0 {
+			inner_width_to_use = s.inner_width_with_scroll
+		}
+		var outer_size ImVec2
+		if s.outer_size_enabled {
+			outer_size = ImVec2{s.outer_size_value[0], s.outer_size_value[1]}
+		}
+		if g.BeginTable("table_advanced", 6, s.flags, outer_size, inner_width_to_use) {
+			// Declare columns
+			// We use the "user_id" parameter of TableSetupColumn() to specify a user id that will be stored in the sort specifications.
+			// This is so our sort function can identify a column given our own identifier. We could also identify them based on their index!
+			var description_flags ImGuiTableColumnFlags = ImGuiTableColumnFlags_WidthStretch
+			if s.flags&ImGuiTableFlags_NoHostExtendX != 0 {
+				description_flags = 0
+			}
+			g.TableSetupColumn("ID", ImGuiTableColumnFlags_DefaultSort|ImGuiTableColumnFlags_WidthFixed|ImGuiTableColumnFlags_NoHide, 0.0, MyItemColumnID_ID)
+			g.TableSetupColumn("Name", ImGuiTableColumnFlags_WidthFixed, 0.0, MyItemColumnID_Name)
+			g.TableSetupColumn("Action", ImGuiTableColumnFlags_NoSort|ImGuiTableColumnFlags_WidthFixed, 0.0, MyItemColumnID_Action)
+			g.TableSetupColumn("Quantity", ImGuiTableColumnFlags_PreferSortDescending, 0.0, MyItemColumnID_Quantity)
+			g.TableSetupColumn("Description", description_flags, 0.0, MyItemColumnID_Description)
+			g.TableSetupColumn("Hidden", ImGuiTableColumnFlags_DefaultHide|ImGuiTableColumnFlags_NoSort, 0, 0)
+			g.TableSetupScrollFreeze(s.freeze_cols, s.freeze_rows)
+
+			// Sort our data if sort specs have been changed!
+			var sorts_specs = g.TableGetSortSpecs()
+			if sorts_specs != nil && sorts_specs.SpecsDirty {
+				s.items_need_sort = true
+			}
+			if sorts_specs != nil && s.items_need_sort && len(s.items) > 1 {
+				SortWithSortSpecs(sorts_specs, s.items)
+				sorts_specs.SpecsDirty = false
+			}
+			s.items_need_sort = false
+
+			// Take note of whether we are currently sorting based on the Quantity field,
+			// we will use this to trigger sorting when we know the data of this column has been modified.
+			var sorts_specs_using_quantity = g.TableGetColumnFlags(3)&ImGuiTableColumnFlags_IsSorted != 0
+
+			// Show headers
+			if s.show_headers {
+				g.TableHeadersRow()
+			}
+
+			// Show data
+			// FIXME-TABLE FIXME-NAV: How we can get decent up/down even though we have the buttons here?
+			g.PushButtonRepeat(true)
+
+			// Demonstrate using clipper for large vertical lists
+			var clipper = ImGuiListClipper{Ctx: g}
+			clipper.Begin(int(len(s.items)), -1)
+			for clipper.Step() {
+				for row_n := clipper.DisplayStart; row_n < clipper.DisplayEnd; row_n++ {
+					var item = &s.items[row_n]
+					var item_is_selected = s.selection[item.ID]
+					g.PushID(item.ID)
+					g.TableNextRow(ImGuiTableRowFlags_None, s.row_min_height)
+
+					// For the demo purpose we can select among different type of items submitted in the first column
+					g.TableSetColumnIndex(0)
+					var label = fmt.Sprintf("%04d", item.ID)
+					switch s.contents_type {
+					case CT_Text:
+						g.TextUnformatted(label)
+					case CT_Button:
+						g.Button(label)
+					case CT_SmallButton:
+						g.SmallButton(label)
+					case CT_FillButton:
+						g.ButtonEx(label, &ImVec2{-FLT_MIN, 0.0}, 0)
+					case CT_Selectable, CT_SelectableSpanRow:
+						var selectable_flags = ImGuiSelectableFlags_None
+						if s.contents_type == CT_SelectableSpanRow {
+							selectable_flags = ImGuiSelectableFlags_SpanAllColumns | ImGuiSelectableFlags_AllowItemOverlap
+						}
+						if g.Selectable(label, item_is_selected, selectable_flags, ImVec2{0, s.row_min_height}) {
+							if g.GetIO().KeyCtrl {
+								if item_is_selected {
+									delete(s.selection, item.ID)
+								} else {
+									s.selection[item.ID] = true
+								}
+							} else {
+								s.selection = map[int]bool{item.ID: true}
+							}
+						}
+					}
+
+					if g.TableSetColumnIndex(1) {
+						g.TextUnformatted(item.Name)
+					}
+
+					// Here we demonstrate marking our data set as needing to be sorted again if we modified a quantity,
+					// and we are currently sorting on the column showing the Quantity.
+					// To avoid triggering a sort while holding the button, we only trigger it when the button has been released.
+					// You will probably need a more advanced system in your code if you want to automatically sort when a specific entry changes.
+					if g.TableSetColumnIndex(2) {
+						if g.SmallButton("Chop") {
+							item.Quantity += 1
+						}
+						if sorts_specs_using_quantity && g.IsItemDeactivated() {
+							s.items_need_sort = true
+						}
+						g.SameLine(0, -1)
+						if g.SmallButton("Eat") {
+							item.Quantity -= 1
+						}
+						if sorts_specs_using_quantity && g.IsItemDeactivated() {
+							s.items_need_sort = true
+						}
+					}
+
+					if g.TableSetColumnIndex(3) {
+						g.Text("%d", item.Quantity)
+					}
+
+					g.TableSetColumnIndex(4)
+					if s.show_wrapped_text {
+						g.TextWrapped("Lorem ipsum dolor sit amet")
+					} else {
+						g.Text("Lorem ipsum dolor sit amet")
+					}
+
+					if g.TableSetColumnIndex(5) {
+						g.Text("1234")
+					}
+
+					g.PopID()
+				}
+			}
+			g.PopButtonRepeat()
+
+			// Store some info to display debug details below
+			table_scroll_cur = ImVec2{g.GetScrollX(), g.GetScrollY()}
+			table_scroll_max = ImVec2{g.GetScrollMaxX(), g.GetScrollMaxY()}
+			table_draw_list = g.GetWindowDrawList()
+			g.EndTable()
+		}
+		g.Checkbox("Debug details", &s.show_debug_details)
+		if s.show_debug_details && table_draw_list != nil {
+			g.SameLine(0.0, 0.0)
+			var table_draw_list_draw_cmd_count = int(len(table_draw_list.CmdBuffer))
+			if table_draw_list == parent_draw_list {
+				g.Text(": DrawCmd: +%d (in same window)",
+					table_draw_list_draw_cmd_count-parent_draw_list_draw_cmd_count)
+			} else {
+				g.Text(": DrawCmd: +%d (in child window), Scroll: (%.f/%.f) (%.f/%.f)",
+					table_draw_list_draw_cmd_count-1, table_scroll_cur.x, table_scroll_max.x, table_scroll_cur.y, table_scroll_max.y)
+			}
+		}
+		g.TreePop()
+	}
+
+	g.PopID()
+
+	g.ShowDemoWindowColumns()
+
+	if state.disable_indent {
+		g.PopStyleVar(1)
+	}
+}
+
+// Demonstrate old/legacy Columns API!
+// [2020: Columns are under-featured and not maintained. Prefer using the more flexible and powerful BeginTable() API!]
+func (g *ImGuiContext) ShowDemoWindowColumns() {
+	var state = &columnsState
+
+	var open = g.TreeNode("Legacy Columns API")
+	g.SameLine(0, -1)
+	g.HelpMarker("Columns() is an old API! Prefer using the more flexible and powerful BeginTable() API!")
+	if !open {
+		return
+	}
+
+	// Basic columns
+	if g.TreeNode("Basic") {
+		g.Text("Without border:")
+		g.Columns(3, "mycolumns3", false) // 3-ways, no border
+		g.Separator()
+		for n := 0; n < 14; n++ {
+			var label = fmt.Sprintf("Item %d", n)
+			if g.Selectable(label, false, 0, ImVec2{}) {
+			}
+			//if ButtonEx(label, &ImVec2{-FLT_MIN, 0.0}, 0) {}
+			g.NextColumn()
+		}
+		g.Columns(1, "", true)
+		g.Separator()
+
+		g.Text("With border:")
+		g.Columns(4, "mycolumns", true) // 4-ways, with border
+		g.Separator()
+		g.Text("ID")
+		g.NextColumn()
+		g.Text("Name")
+		g.NextColumn()
+		g.Text("Path")
+		g.NextColumn()
+		g.Text("Hovered")
+		g.NextColumn()
+		g.Separator()
+		var names = [3]string{"One", "Two", "Three"}
+		var paths = [3]string{"/path/one", "/path/two", "/path/three"}
+		for i := int(0); i < 3; i++ {
+			var label = fmt.Sprintf("%04d", i)
+			if g.Selectable(label, state.selected == i, ImGuiSelectableFlags_SpanAllColumns, ImVec2{}) {
+				state.selected = i
+			}
+			var hovered = g.IsItemHovered(0)
+			g.NextColumn()
+			g.TextUnformatted(names[i])
+			g.NextColumn()
+			g.TextUnformatted(paths[i])
+			g.NextColumn()
+			g.Text("%v", hovered)
+			g.NextColumn()
+		}
+		g.Columns(1, "", true)
+		g.Separator()
+		g.TreePop()
+	}
+
+	if g.TreeNode("Borders") {
+		// NB: Future columns API should allow automatic horizontal borders.
+		const lines_count = 3
+		g.SetNextItemWidth(g.GetFontSize() * 8)
+		g.DragInt("##columns_count", &state.columns_count, 0.1, 2, 10, "%d columns", 0)
+		if state.columns_count < 2 {
+			state.columns_count = 2
+		}
+		g.SameLine(0, -1)
+		g.Checkbox("horizontal", &state.h_borders)
+		g.SameLine(0, -1)
+		g.Checkbox("vertical", &state.v_borders)
+		g.Columns(state.columns_count, "", state.v_borders)
+		for i := int(0); i < state.columns_count*lines_count; i++ {
+			if state.h_borders && g.GetColumnIndex() == 0 {
+				g.Separator()
+			}
+			g.Text("%c%c%c", 'a'+i, 'a'+i, 'a'+i)
+			g.Text("Width %.2f", g.GetColumnWidth(-1))
+			g.Text("Avail %.2f", g.GetContentRegionAvail().x)
+			g.Text("Offset %.2f", g.GetColumnOffset(-1))
+			g.Text("Long text that is likely to clip")
+			g.ButtonEx("Button", &ImVec2{-FLT_MIN, 0.0}, 0)
+			g.NextColumn()
+		}
+		g.Columns(1, "", true)
+		if state.h_borders {
+			g.Separator()
+		}
+		g.TreePop()
+	}
+
+	// Create multiple items in a same cell before switching to next column
+	if g.TreeNode("Mixed items") {
+		g.Columns(3, "mixed", true)
+		g.Separator()
+
+		g.Text("Hello")
+		g.Button("Banana")
+		g.NextColumn()
+
+		g.Text("ImGui")
+		g.Button("Apple")
+		g.InputFloat("red", &state.foo, 0.05, 0, "%.3f", 0)
+		g.Text("An extra line here.")
+		g.NextColumn()
+
+		g.Text("Sailor")
+		g.Button("Corniflower")
+		g.InputFloat("blue", &state.bar, 0.05, 0, "%.3f", 0)
+		g.NextColumn()
+
+		if g.CollapsingHeader("Category A", 0) {
+			g.Text("Blah blah blah")
+		}
+		g.NextColumn()
+		if g.CollapsingHeader("Category B", 0) {
+			g.Text("Blah blah blah")
+		}
+		g.NextColumn()
+		if g.CollapsingHeader("Category C", 0) {
+			g.Text("Blah blah blah")
+		}
+		g.NextColumn()
+		g.Columns(1, "", true)
+		g.Separator()
+		g.TreePop()
+	}
+
+	// Word wrapping
+	if g.TreeNode("Word-wrapping") {
+		g.Columns(2, "word-wrapping", true)
+		g.Separator()
+		g.TextWrapped("The quick brown fox jumps over the lazy dog.")
+		g.TextWrapped("Hello Left")
+		g.NextColumn()
+		g.TextWrapped("The quick brown fox jumps over the lazy dog.")
+		g.TextWrapped("Hello Right")
+		g.Columns(1, "", true)
+		g.Separator()
+		g.TreePop()
+	}
+
+	if g.TreeNode("Horizontal Scrolling") {
+		g.SetNextWindowContentSize(ImVec2{1500.0, 0.0})
+		var child_size = ImVec2{0, g.GetFontSize() * 20.0}
+		g.BeginChild("##ScrollingRegion", child_size, false, ImGuiWindowFlags_HorizontalScrollbar)
+		g.Columns(10, "", true)
+
+		// Also demonstrate using clipper for large vertical lists
+		const ITEMS_COUNT = 2000
+		var clipper = ImGuiListClipper{Ctx: g}
+		clipper.Begin(ITEMS_COUNT, -1)
+		for clipper.Step() {
+			for i := clipper.DisplayStart; i < clipper.DisplayEnd; i++ {
+				for j := 0; j < 10; j++ {
+					g.Text("Line %d Column %d...", i, j)
+					g.NextColumn()
+				}
+			}
+		}
+		g.Columns(1, "", true)
+		g.EndChild()
+		g.TreePop()
+	}
+
+	if g.TreeNode("Tree") {
+		g.Columns(2, "tree", true)
+		for x := int(0); x < 3; x++ {
+			var open1 = g.TreeNodeInterface(x, "Node%d", x)
+			g.NextColumn()
+			g.Text("Node contents")
+			g.NextColumn()
+			if open1 {
+				for y := int(0); y < 3; y++ {
+					var open2 = g.TreeNodeInterface(y, "Node%d.%d", x, y)
+					g.NextColumn()
+					g.Text("Node contents")
+					if open2 {
+						g.Text("Even more contents")
+						if g.TreeNode("Tree in column") {
+							g.Text("The quick brown fox jumps over the lazy dog")
+							g.TreePop()
+						}
+					}
+					g.NextColumn()
+					if open2 {
+						g.TreePop()
+					}
+				}
+				g.TreePop()
+			}
+		}
+		g.Columns(1, "", true)
+		g.TreePop()
+	}
+
+	g.TreePop()
+}
@@ -0,0 +1,488 @@
+package imgui
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+var aboutState struct {
+	show_config_info bool
+}
+
+// ShowAboutWindow create About window. display Dear ImGui version, credits and build/system information.
+// Access from Dear ImGui Demo -> Tools -> About
+func (g *ImGuiContext) ShowAboutWindow(p_open *bool) {
+	if !g.Begin("About Dear ImGui", p_open, ImGuiWindowFlags_AlwaysAutoResize) {
+		g.End()
+		return
+	}
+	g.Text("Dear ImGui %s", GetVersion())
+	g.Separator()
+	g.Text("By Omar Cornut and all Dear ImGui contributors.")
+	g.Text("Dear ImGui is licensed under the MIT License, see LICENSE for more information.")
+
+	g.Checkbox("Config/Build Information", &aboutState.show_config_info)
+	if aboutState.show_config_info {
+		var io = g.GetIO()
+		var style = g.GetStyle()
+
+		var copy_to_clipboard = g.Button("Copy to clipboard")
+		var child_size = ImVec2{0, g.GetTextLineHeightWithSpacing() * 18}
+		g.BeginChildFrame(g.GetIDFromString("cfg_infos"), child_size, ImGuiWindowFlags_NoMove)
+		if copy_to_clipboard {
+			g.LogToClipboard(-1)
+			g.LogText("```\n") // Back quotes will make text appears without formatting when pasting on GitHub
+		}
+
+		g.Text("Dear ImGui %s (%d)", IMGUI_VERSION, IMGUI_VERSION_NUM)
+		g.Separator()
+		g.Text("sizeof(int): %d, sizeof(ImDrawIdx): %d, sizeof(ImDrawVert): %d", unsafe.Sizeof(int(0)), unsafe.Sizeof(ImDrawIdx(0)), unsafe.Sizeof(ImDrawVert{}))
+		g.Text("runtime: %s %s/%s", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+		g.Separator()
+		var backend_platform_name, backend_renderer_name = io.BackendPlatformName, io.BackendRendererName
+		if backend_platform_name == "" {
+			backend_platform_name = "NULL"
+		}
+		if backend_renderer_name == "" {
+			backend_renderer_name = "NULL"
+		}
+		g.Text("io.BackendPlatformName: %s", backend_platform_name)
+		g.Text("io.BackendRendererName: %s", backend_renderer_name)
+		g.Text("io.ConfigFlags: 0x%08X", io.ConfigFlags)
+		if io.ConfigFlags&ImGuiConfigFlags_NavEnableKeyboard != 0 {
+			g.Text(" NavEnableKeyboard")
+		}
+		if io.ConfigFlags&ImGuiConfigFlags_NavEnableGamepad != 0 {
+			g.Text(" NavEnableGamepad")
+		}
+		if io.ConfigFlags&ImGuiConfigFlags_NavEnableSetMousePos != 0 {
+			g.Text(" NavEnableSetMousePos")
+		}
+		if io.ConfigFlags&ImGuiConfigFlags_NavNoCaptureKeyboard != 0 {
+			g.Text(" NavNoCaptureKeyboard")
+		}
+		if io.ConfigFlags&ImGuiConfigFlags_NoMouse != 0 {
+			g.Text(" NoMouse")
+		}
+		if io.ConfigFlags&ImGuiConfigFlags_NoMouseCursorChange != 0 {
+			g.Text(" NoMouseCursorChange")
+		}
+		if io.MouseDrawCursor {
+			g.Text("io.MouseDrawCursor")
+		}
+		if io.ConfigMacOSXBehaviors {
+			g.Text("io.ConfigMacOSXBehaviors")
+		}
+		if io.ConfigInputTextCursorBlink {
+			g.Text("io.ConfigInputTextCursorBlink")
+		}
+		if io.ConfigWindowsResizeFromEdges {
+			g.Text("io.ConfigWindowsResizeFromEdges")
+		}
+		if io.ConfigWindowsMoveFromTitleBarOnly {
+			g.Text("io.ConfigWindowsMoveFromTitleBarOnly")
+		}
+		if io.ConfigMemoryCompactTimer >= 0.0 {
+			g.Text("io.ConfigMemoryCompactTimer = %.1f", io.ConfigMemoryCompactTimer)
+		}
+		g.Text("io.BackendFlags: 0x%08X", io.BackendFlags)
+		if io.BackendFlags&ImGuiBackendFlags_HasGamepad != 0 {
+			g.Text(" HasGamepad")
+		}
+		if io.BackendFlags&ImGuiBackendFlags_HasMouseCursors != 0 {
+			g.Text(" HasMouseCursors")
+		}
+		if io.BackendFlags&ImGuiBackendFlags_HasSetMousePos != 0 {
+			g.Text(" HasSetMousePos")
+		}
+		if io.BackendFlags&ImGuiBackendFlags_RendererHasVtxOffset != 0 {
+			g.Text(" RendererHasVtxOffset")
+		}
+		g.Separator()
+		g.Text("io.Fonts: %d fonts, Flags: 0x%08X, TexSize: %d,%d", len(io.Fonts.Fonts), io.Fonts.Flags, io.Fonts.TexWidth, io.Fonts.TexHeight)
+		g.Text("io.DisplaySize: %.2f,%.2f", io.DisplaySize.x, io.DisplaySize.y)
+		g.Text("io.DisplayFramebufferScale: %.2f,%.2f", io.DisplayFramebufferScale.x, io.DisplayFramebufferScale.y)
+		g.Separator()
+		g.Text("style.WindowPadding: %.2f,%.2f", style.WindowPadding.x, style.WindowPadding.y)
+		g.Text("style.WindowBorderSize: %.2f", style.WindowBorderSize)
+		g.Text("style.FramePadding: %.2f,%.2f", style.FramePadding.x, style.FramePadding.y)
+		g.Text("style.FrameRounding: %.2f", style.FrameRounding)
+		g.Text("style.FrameBorderSize: %.2f", style.FrameBorderSize)
+		g.Text("style.ItemSpacing: %.2f,%.2f", style.ItemSpacing.x, style.ItemSpacing.y)
+		g.Text("style.ItemInnerSpacing: %.2f,%.2f", style.ItemInnerSpacing.x, style.ItemInnerSpacing.y)
+
+		if copy_to_clipboard {
+			g.LogText("\n```\n")
+			g.LogFinish()
+		}
+		g.EndChildFrame()
+	}
+	g.End()
+}
+
+// ShowFontSelector add font selector block (not a window), essentially a combo listing the loaded fonts.
+// Here we use the regular BeginCombo()/EndCombo() api which is more the more flexible one.
+func (g *ImGuiContext) ShowFontSelector(label string) {
+	var io = g.GetIO()
+	var font_current = g.GetFont()
+	if g.BeginCombo(label, font_current.GetDebugName(), 0) {
+		for n, font := range io.Fonts.Fonts {
+			g.PushID(int(n))
+			if g.Selectable(font.GetDebugName(), font == font_current, 0, ImVec2{}) {
+				io.FontDefault = font
+			}
+			g.PopID()
+		}
+		g.EndCombo()
+	}
+	g.SameLine(0, -1)
+	g.HelpMarker(
+		"- Load additional fonts with io.Fonts.AddFontFromFileTTF().\n" +
+			"- The font atlas is built when calling io.Fonts.GetTexDataAsXXXX() or io.Fonts.Build().\n" +
+			"- Read FAQ and docs/FONTS.md for more details.\n" +
+			"- If you need to add/remove fonts at runtime (e.g. for DPI change), do it before calling NewFrame().")
+}
+
+var styleSelectorState struct {
+	style_idx int
+}
+
+func init() {
+	styleSelectorState.style_idx = -1
+}
+
+// ShowStyleSelector add style selector block (not a window), essentially a combo listing the default styles.
+// See ShowStyleEditor() for more advanced options.
+func (g *ImGuiContext) ShowStyleSelector(label string) bool {
+	var items = []string{"Dark", "Light", "Classic"}
+	if g.Combo(label, &styleSelectorState.style_idx, items, int(len(items)), -1) {
+		switch styleSelectorState.style_idx {
+		case 0:
+			g.StyleColorsDark(nil)
+		case 1:
+			g.StyleColorsLight(nil)
+		case 2:
+			g.StyleColorsClassic(nil)
+		}
+		return true
+	}
+	return false
+}
+
+var styleEditorState struct {
+	ref_saved_style ImGuiStyle
+	init            bool
+
+	// Colors
+	output_dest          int
+	output_only_modified bool
+	filter               ImGuiTextFilter
+	alpha_flags          ImGuiColorEditFlags
+
+	// Fonts
+	window_scale float
+}
+
+func init() {
+	styleEditorState.init = true
+	styleEditorState.output_only_modified = true
+	styleEditorState.window_scale = 1.0
+}
+
+// styleSliderFloat2 is SliderFloat2() over an ImVec2 field of the style.
+func (g *ImGuiContext) styleSliderFloat2(label string, v *ImVec2, v_min, v_max float, format string) bool {
+	var values = [2]float{v.x, v.y}
+	if !g.SliderFloat2(label, &values, v_min, v_max, format, 0) {
+		return false
+	}
+	*v = ImVec2{values[0], values[1]}
+	return true
+}
+
+// styleBorderSize is the border size of a border checkbox, 1.0 when checked.
+func styleBorderSize(border bool) float {
+	if border {
+		return 1.0
+	}
+	return 0.0
+}
+
+// ShowStyleEditor add style editor block (not a window). you can pass in a reference ImGuiStyle structure to compare to,
+// revert to and save to (else it uses the default style)
+func (g *ImGuiContext) ShowStyleEditor(ref *ImGuiStyle) {
+	var state = &styleEditorState
+
+	// You can pass in a reference ImGuiStyle structure to compare to, revert to and save to
+	// (without a reference style pointer, we will use one compared locally as a reference)
+	var style = g.GetStyle()
+
+	// Default to using internal storage as reference
+	if state.init && ref == nil {
+		state.ref_saved_style = *style
+	}
+	state.init = false
+	if ref == nil {
+		ref = &state.ref_saved_style
+	}
+
+	g.PushItemWidth(g.GetWindowWidth() * 0.50)
+
+	if g.ShowStyleSelector("Colors##Selector") {
+		state.ref_saved_style = *style
+	}
+	g.ShowFontSelector("Fonts##Selector")
+
+	// Simplified Settings (expose floating-pointer border sizes as boolean representing 0.0f or 1.0f)
+	if g.SliderFloat("FrameRounding", &style.FrameRounding, 0.0, 12.0, "%.0f", 0) {
+		style.GrabRounding = style.FrameRounding // Make GrabRounding always the same value as FrameRounding
+	}
+	var window_border = style.WindowBorderSize > 0.0
+	if g.Checkbox("WindowBorder", &window_border) {
+		style.WindowBorderSize = styleBorderSize(window_border)
+	}
+	g.SameLine(0, -1)
+	var frame_border = style.FrameBorderSize > 0.0
+	if g.Checkbox("FrameBorder", &frame_border) {
+		style.FrameBorderSize = styleBorderSize(frame_border)
+	}
+	g.SameLine(0, -1)
+	var popup_border = style.PopupBorderSize > 0.0
+	if g.Checkbox("PopupBorder", &popup_border) {
+		style.PopupBorderSize = styleBorderSize(popup_border)
+	}
+
+	// Save/Revert button
+	if g.Button("Save Ref") {
+		state.ref_saved_style = *style
+		*ref = *style
+	}
+	g.SameLine(0, -1)
+	if g.Button("Revert Ref") {
+		*style = *ref
+	}
+	g.SameLine(0, -1)
+	g.HelpMarker(
+		"Save/Revert in local non-persistent storage. Default Colors definition are not affected. " +
+			"Use \"Export\" below to save them somewhere.")
+
+	g.Separator()
+
+	if g.BeginTabBar("##tabs", ImGuiTabBarFlags_None) {
+		if g.BeginTabItem("Sizes", nil, 0) {
+			g.Text("Main")
+			g.styleSliderFloat2("WindowPadding", &style.WindowPadding, 0.0, 20.0, "%.0f")
+			g.styleSliderFloat2("FramePadding", &style.FramePadding, 0.0, 20.0, "%.0f")
+			g.styleSliderFloat2("CellPadding", &style.CellPadding, 0.0, 20.0, "%.0f")
+			g.styleSliderFloat2("ItemSpacing", &style.ItemSpacing, 0.0, 20.0, "%.0f")
+			g.styleSliderFloat2("ItemInnerSpacing", &style.ItemInnerSpacing, 0.0, 20.0, "%.0f")
+			g.styleSliderFloat2("TouchExtraPadding", &style.TouchExtraPadding, 0.0, 10.0, "%.0f")
+			g.SliderFloat("IndentSpacing", &style.IndentSpacing, 0.0, 30.0, "%.0f", 0)
+			g.SliderFloat("ScrollbarSize", &style.ScrollbarSize, 1.0, 20.0, "%.0f", 0)
+			g.SliderFloat("GrabMinSize", &style.GrabMinSize, 1.0, 20.0, "%.0f", 0)
+			g.Text("Borders")
+			g.SliderFloat("WindowBorderSize", &style.WindowBorderSize, 0.0, 1.0, "%.0f", 0)
+			g.SliderFloat("ChildBorderSize", &style.ChildBorderSize, 0.0, 1.0, "%.0f", 0)
+			g.SliderFloat("PopupBorderSize", &style.PopupBorderSize, 0.0, 1.0, "%.0f", 0)
+			g.SliderFloat("FrameBorderSize", &style.FrameBorderSize, 0.0, 1.0, "%.0f", 0)
+			g.SliderFloat("TabBorderSize", &style.TabBorderSize, 0.0, 1.0, "%.0f", 0)
+			g.Text("Rounding")
+			g.SliderFloat("WindowRounding", &style.WindowRounding, 0.0, 12.0, "%.0f", 0)
+			g.SliderFloat("ChildRounding", &style.ChildRounding, 0.0, 12.0, "%.0f", 0)
+			g.SliderFloat("FrameRounding", &style.FrameRounding, 0.0, 12.0, "%.0f", 0)
+			g.SliderFloat("PopupRounding", &style.PopupRounding, 0.0, 12.0, "%.0f", 0)
+			g.SliderFloat("ScrollbarRounding", &style.ScrollbarRounding, 0.0, 12.0, "%.0f", 0)
+			g.SliderFloat("GrabRounding", &style.GrabRounding, 0.0, 12.0, "%.0f", 0)
+			g.SliderFloat("LogSliderDeadzone", &style.LogSliderDeadzone, 0.0, 12.0, "%.0f", 0)
+			g.SliderFloat("TabRounding", &style.TabRounding, 0.0, 12.0, "%.0f", 0)
+			g.Text("Alignment")
+			g.styleSliderFloat2("WindowTitleAlign", &style.WindowTitleAlign, 0.0, 1.0, "%.2f")
+			var window_menu_button_position = int(style.WindowMenuButtonPosition) + 1
+			if g.Combo("WindowMenuButtonPosition", &window_menu_button_position, []string{"None", "Left", "Right"}, 3, -1) {
+				style.WindowMenuButtonPosition = ImGuiDir(window_menu_button_position - 1)
+			}
+			var color_button_position = int(style.ColorButtonPosition)
+			if g.Combo("ColorButtonPosition", &color_button_position, []string{"Left", "Right"}, 2, -1) {
+				style.ColorButtonPosition = ImGuiDir(color_button_position)
+			}
+			g.styleSliderFloat2("ButtonTextAlign", &style.ButtonTextAlign, 0.0, 1.0, "%.2f")
+			g.SameLine(0, -1)
+			g.HelpMarker("Alignment applies when a button is larger than its text content.")
+			g.styleSliderFloat2("SelectableTextAlign", &style.SelectableTextAlign, 0.0, 1.0, "%.2f")
+			g.SameLine(0, -1)
+			g.HelpMarker("Alignment applies when a selectable is larger than its text content.")
+			g.Text("Safe Area Padding")
+			g.SameLine(0, -1)
+			g.HelpMarker("Adjust if you cannot see the edges of your screen (e.g. on a TV where scaling has not been configured).")
+			g.styleSliderFloat2("DisplaySafeAreaPadding", &style.DisplaySafeAreaPadding, 0.0, 30.0, "%.0f")
+			g.EndTabItem()
+		}
+
+		if g.BeginTabItem("Colors", nil, 0) {
+			if g.Button("Export") {
+				if state.output_dest == 0 {
+					g.LogToClipboard(-1)
+				} else {
+					g.LogToTTY(-1)
+				}
+				// Exported as Go code, paste it after creating the context (or in a function taking the style)
+				g.LogText("var colors = &imgui.GetStyle().Colors" + IM_NEWLINE)
+				for i := ImGuiCol(0); i < ImGuiCol_COUNT; i++ {
+					var col = style.Colors[i]
+					var name = GetStyleColorName(i)
+					if !state.output_only_modified || col != ref.Colors[i] {
+						g.LogText("colors[imgui.ImGuiCol_%s]%*s= *imgui.NewImVec4(%.2f, %.2f, %.2f, %.2f)"+IM_NEWLINE,
+							name, 23-len(name), "", col.x, col.y, col.z, col.w)
+					}
+				}
+				g.LogFinish()
+			}
+			g.SameLine(0, -1)
+			g.SetNextItemWidth(120)
+			g.Combo("##output_type", &state.output_dest, []string{"To Clipboard", "To TTY"}, 2, -1)
+			g.SameLine(0, -1)
+			g.Checkbox("Only Modified Colors", &state.output_only_modified)
+
+			state.filter.DrawContext(g, "Filter colors", g.GetFontSize()*16)
+
+			if g.RadioButtonBool("Opaque", state.alpha_flags == ImGuiColorEditFlags_None) {
+				state.alpha_flags = ImGuiColorEditFlags_None
+			}
+			g.SameLine(0, -1)
+			if g.RadioButtonBool("Alpha", state.alpha_flags == ImGuiColorEditFlags_AlphaPreview) {
+				state.alpha_flags = ImGuiColorEditFlags_AlphaPreview
+			}
+			g.SameLine(0, -1)
+			if g.RadioButtonBool("Both", state.alpha_flags == ImGuiColorEditFlags_AlphaPreviewHalf) {
+				state.alpha_flags = ImGuiColorEditFlags_AlphaPreviewHalf
+			}
+			g.SameLine(0, -1)
+			g.HelpMarker(
+				"In the color list:\n" +
+					"Left-click on color square to open color picker,\n" +
+					"Right-click to open edit options menu.")
+
+			g.BeginChild("##colors", ImVec2{}, true, ImGuiWindowFlags_AlwaysVerticalScrollbar|ImGuiWindowFlags_AlwaysHorizontalScrollbar|ImGuiWindowFlags_NavFlattened)
+			g.PushItemWidth(-160)
+			for i := ImGuiCol(0); i < ImGuiCol_COUNT; i++ {
+				var name = GetStyleColorName(i)
+				if !state.filter.PassFilter(name) {
+					continue
+				}
+				g.PushID(int(i))
+				var col = [4]float{style.Colors[i].x, style.Colors[i].y, style.Colors[i].z, style.Colors[i].w}
+				if g.ColorEdit4("##color", &col, ImGuiColorEditFlags_AlphaBar|state.alpha_flags) {
+					style.Colors[i] = ImVec4{col[0], col[1], col[2], col[3]}
+				}
+				if style.Colors[i] != ref.Colors[i] {
+					// Tips: in a real user application, you may want to merge and use an icon font into the main font,
+					// so instead of "Save"/"Revert" you'd use icons!
+					// Read the FAQ and docs/FONTS.md about using icon fonts. It's really easy and super convenient!
+					g.SameLine(0.0, style.ItemInnerSpacing.x)
+					if g.Button("Save") {
+						ref.Colors[i] = style.Colors[i]
+					}
+					g.SameLine(0.0, style.ItemInnerSpacing.x)
+					if g.Button("Revert") {
+						style.Colors[i] = ref.Colors[i]
+					}
+				}
+				g.SameLine(0.0, style.ItemInnerSpacing.x)
+				g.TextUnformatted(name)
+				g.PopID()
+			}
+			g.PopItemWidth()
+			g.EndChild()
+
+			g.EndTabItem()
+		}
+
+		if g.BeginTabItem("Fonts", nil, 0) {
+			var io = g.GetIO()
+			var atlas = io.Fonts
+			g.HelpMarker("Read FAQ and docs/FONTS.md for details on font loading.")
+			g.ShowFontAtlas(atlas)
+
+			// Post-baking font scaling. Note that this is NOT the nice way of scaling fonts, read below.
+			// (we enforce hard clamping manually as by default DragFloat/SliderFloat allows CTRL+Click text to get out of bounds).
+			const MIN_SCALE = 0.3
+			const MAX_SCALE = 2.0
+			g.HelpMarker(
+				"Those are old settings provided for convenience.\n" +
+					"However, the _correct_ way of scaling your UI is currently to reload your font at the designed size, " +
+					"rebuild the font atlas, and call style.ScaleAllSizes() on a reference ImGuiStyle structure.\n" +
+					"Using those settings here will give you poor quality results.")
+			g.PushItemWidth(g.GetFontSize() * 8)
+			if g.DragFloat("window scale", &state.window_scale, 0.005, MIN_SCALE, MAX_SCALE, "%.2f", ImGuiSliderFlags_AlwaysClamp) { // Scale only this window
+				g.SetWindowFontScale(state.window_scale)
+			}
+			g.DragFloat("global scale", &io.FontGlobalScale, 0.005, MIN_SCALE, MAX_SCALE, "%.2f", ImGuiSliderFlags_AlwaysClamp) // Scale everything
+			g.PopItemWidth()
+
+			g.EndTabItem()
+		}
+
+		if g.BeginTabItem("Rendering", nil, 0) {
+			g.Checkbox("Anti-aliased lines", &style.AntiAliasedLines)
+			g.SameLine(0, -1)
+			g.HelpMarker("When disabling anti-aliasing lines, you'll probably want to disable borders in your style as well.")
+
+			g.Checkbox("Anti-aliased lines use texture", &style.AntiAliasedLinesUseTex)
+			g.SameLine(0, -1)
+			g.HelpMarker("Faster lines using texture data. Require backend to render with bilinear filtering (not point/nearest filtering).")
+
+			g.Checkbox("Anti-aliased fill", &style.AntiAliasedFill)
+			g.PushItemWidth(g.GetFontSize() * 8)
+			g.DragFloat("Curve Tessellation Tolerance", &style.CurveTessellationTol, 0.02, 0.10, 10.0, "%.2f", 0)
+			if style.CurveTessellationTol < 0.10 {
+				style.CurveTessellationTol = 0.10
+			}
+
+			// When editing the "Circle Segment Max Error" value, draw a preview of its effect on auto-tessellated circles.
+			g.DragFloat("Circle Tessellation Max Error", &style.CircleTessellationMaxError, 0.005, 0.10, 5.0, "%.2f", ImGuiSliderFlags_AlwaysClamp)
+			if g.IsItemActive() {
+				var pos = g.GetCursorScreenPos()
+				g.SetNextWindowPos(&pos, 0, ImVec2{})
+				g.BeginTooltip()
+				g.TextUnformatted("(R = radius, N = number of segments)")
+				g.Spacing()
+				var draw_list = g.GetWindowDrawList()
+				var min_widget_width = g.CalcTextSize("N: MMM\nR: MMM", true, -1).x
+				for n := 0; n < 8; n++ {
+					const RAD_MIN = 5.0
+					const RAD_MAX = 70.0
+					var rad = RAD_MIN + (RAD_MAX-RAD_MIN)*(float)(n)/(8.0-1.0)
+
+					g.BeginGroup()
+
+					g.Text("R: %.f\nN: %d", rad, draw_list._CalcCircleAutoSegmentCount(rad))
+
+					var canvas_width = ImMax(min_widget_width, rad*2.0)
+					var offset_x = ImFloor(canvas_width * 0.5)
+					var offset_y = ImFloor(RAD_MAX)
+
+					var p1 = g.GetCursorScreenPos()
+					draw_list.AddCircle(ImVec2{p1.x + offset_x, p1.y + offset_y}, rad, g.GetColorU32FromID(ImGuiCol_Text, 1), 0, 1)
+					g.Dummy(ImVec2{canvas_width, RAD_MAX * 2})
+
+					g.EndGroup()
+					g.SameLine(0, -1)
+				}
+				g.EndTooltip()
+			}
+			g.SameLine(0, -1)
+			g.HelpMarker("When drawing circle primitives with \"num_segments == 0\" tesselation will be calculated automatically.")
+
+			g.DragFloat("Global Alpha", &style.Alpha, 0.005, 0.20, 1.0, "%.2f", 0) // Not exposing zero here so user doesn't "lose" the UI (zero alpha clips all widgets). But application code could have a toggle to switch between zero and non-zero.
+			g.DragFloat("Disabled Alpha", &style.DisabledAlpha, 0.005, 0.0, 1.0, "%.2f", 0)
+			g.SameLine(0, -1)
+			g.HelpMarker("Additional alpha multiplier for disabled items (multiply over current value of Alpha).")
+			g.PopItemWidth()
+
+			g.EndTabItem()
+		}
+
+		g.EndTabBar()
+	}
+
+	g.PopItemWidth()
+}
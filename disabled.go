@@ -11,8 +11,7 @@ package imgui
 // - Feedback welcome at https://github.com/ocornut/imgui/issues/211
 // - BeginDisabled(false) essentially does nothing useful but is provided to facilitate use of boolean expressions. If you can avoid calling BeginDisabled(False)/EndDisabled() best to avoid it.
 // - Optimized shortcuts instead of PushStyleVar() + PushItemFlag()
-func BeginDisabled(disabled bool /*= true*/) {
-	var g = GImGui
+func (g *ImGuiContext) BeginDisabled(disabled bool /*= true*/) {
 	var was_disabled = (g.CurrentItemFlags & ImGuiItemFlags_Disabled) != 0
 	if !was_disabled && disabled {
 		g.DisabledAlphaBackup = g.Style.Alpha
@@ -24,8 +23,7 @@ func BeginDisabled(disabled bool /*= true*/) {
 	g.ItemFlagsStack = append(g.ItemFlagsStack, g.CurrentItemFlags)
 }
 
-func EndDisabled() {
-	var g = GImGui
+func (g *ImGuiContext) EndDisabled() {
 	var was_disabled = (g.CurrentItemFlags & ImGuiItemFlags_Disabled) != 0
 	//PopItemFlag();
 	g.ItemFlagsStack = g.ItemFlagsStack[:len(g.ItemFlagsStack)-1]
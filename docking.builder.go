@@ -0,0 +1,238 @@
+package imgui
+
+import (
+	"sort"
+
+	"github.com/Splizard/imgui/golang"
+)
+
+// Docking Builder API
+// Build a dock layout programmatically, typically on the first frame (or when no .ini layout was loaded):
+//   var dockspace_id = GetIDs("MyDockSpace")
+//   if DockBuilderGetNode(dockspace_id) == nil {
+//       DockBuilderAddNode(dockspace_id, ImGuiDockNodeFlags_DockSpace)
+//       DockBuilderSetNodeSize(dockspace_id, GetMainViewport().Size)
+//       var dock_id_left ImGuiID
+//       var dock_id_main = dockspace_id
+//       DockBuilderSplitNode(dock_id_main, ImGuiDir_Left, 0.20, &dock_id_left, &dock_id_main)
+//       DockBuilderDockWindow("Tools", dock_id_left)
+//       DockBuilderDockWindow("Document", dock_id_main)
+//       DockBuilderFinish(dockspace_id)
+//   }
+//   DockSpace(dockspace_id, ImVec2{}, 0)
+// The dock nodes are persisted in the .ini file like any other node, so the layout only needs to be built once.
+
+// DockBuilderDockWindow docks a window into a node. The window doesn't need to exist yet, its settings are then created.
+func (g *ImGuiContext) DockBuilderDockWindow(window_name string, node_id ImGuiID) {
+	// We don't preserve relative order of multiple docked windows (by clearing DockOrder back to -1)
+	if window := g.FindWindowByName(window_name); window != nil {
+		g.SetWindowDock(window, node_id, ImGuiCond_Always)
+		window.DockOrder = -1
+	} else {
+		var settings = g.FindOrCreateWindowSettings(window_name)
+		settings.DockId = node_id
+		settings.DockOrder = -1
+	}
+}
+
+func (g *ImGuiContext) DockBuilderGetNode(node_id ImGuiID) *ImGuiDockNode {
+	return DockContextFindNodeByID(g, node_id)
+}
+
+// DockBuilderGetCentralNode returns the central node of the dockspace containing node_id, or nil.
+func (g *ImGuiContext) DockBuilderGetCentralNode(node_id ImGuiID) *ImGuiDockNode {
+	var node = g.DockBuilderGetNode(node_id)
+	if node == nil {
+		return nil
+	}
+	return DockNodeTreeFindCentralNode(DockNodeGetRootNode(node))
+}
+
+// DockBuilderAddNode creates an empty node, replacing any existing node with the same id.
+// Pass ImGuiDockNodeFlags_DockSpace to create a node which is hosted by a DockSpace() call, otherwise the node is floating.
+func (g *ImGuiContext) DockBuilderAddNode(id ImGuiID, flags ImGuiDockNodeFlags) ImGuiID {
+	if id != 0 {
+		g.DockBuilderRemoveNode(id)
+	}
+
+	var node *ImGuiDockNode
+	if flags&ImGuiDockNodeFlags_DockSpace != 0 {
+		node = DockContextAddNode(g, id)
+		node.LocalFlags = ImGuiDockNodeFlags_DockSpace | ImGuiDockNodeFlags_CentralNode
+		node.SharedFlags = flags & ^ImGuiDockNodeFlags_DockSpace
+	} else {
+		node = DockContextAddNode(g, id)
+		node.LocalFlags = flags
+	}
+	node.LastFrameAlive = g.FrameCount // Set this otherwise BeginDocked will undock during the same frame.
+	return node.ID
+}
+
+// DockBuilderRemoveNode removes a node and all its child nodes, undocking their windows.
+func (g *ImGuiContext) DockBuilderRemoveNode(node_id ImGuiID) {
+	var node = DockContextFindNodeByID(g, node_id)
+	if node == nil {
+		return
+	}
+	g.DockBuilderRemoveNodeDockedWindows(node_id, true)
+	g.DockBuilderRemoveNodeChildNodes(node_id)
+	// Node may have moved or deleted if e.g. any merge happened
+	node = DockContextFindNodeByID(g, node_id)
+	if node == nil {
+		return
+	}
+	if node.IsCentralNode() && node.ParentNode != nil {
+		node.ParentNode.LocalFlags |= ImGuiDockNodeFlags_CentralNode
+	}
+	DockContextRemoveNode(g, node, true)
+}
+
+// DockBuilderRemoveNodeChildNodes removes all the child nodes of a node, moving their windows into it.
+func (g *ImGuiContext) DockBuilderRemoveNodeChildNodes(root_id ImGuiID) {
+	var dc = &g.DockContext
+
+	var root_node = DockContextFindNodeByID(g, root_id)
+	if root_id != 0 && root_node == nil {
+		return
+	}
+	var has_central_node = false
+
+	// Process active windows
+	var nodes_to_remove []*ImGuiDockNode
+	for _, node := range dc.Nodes {
+		var want_removal = root_id == 0 || (node.ID != root_id && DockNodeGetRootNode(node).ID == root_id)
+		if want_removal {
+			if node.IsCentralNode() {
+				has_central_node = true
+			}
+			if root_node != nil {
+				g.DockNodeMoveWindows(root_node, node)
+				g.DockSettingsRenameNodeReferences(node.ID, root_node.ID)
+			}
+			nodes_to_remove = append(nodes_to_remove, node)
+		}
+	}
+	sort.Slice(nodes_to_remove, func(i, j golang.Int) bool { return nodes_to_remove[i].ID < nodes_to_remove[j].ID })
+
+	// DockNodeMoveWindows->DockNodeAddWindow will normally set the central node flag to the root node, but not when moving windows
+	if has_central_node && root_node != nil {
+		root_node.LocalFlags |= ImGuiDockNodeFlags_CentralNode
+	}
+
+	// Apply to settings
+	for i := range g.SettingsWindows {
+		var window_settings_dock_id = g.SettingsWindows[i].DockId
+		if window_settings_dock_id != 0 {
+			for _, node := range nodes_to_remove {
+				if node.ID == window_settings_dock_id {
+					g.SettingsWindows[i].DockId = root_id
+					break
+				}
+			}
+		}
+	}
+
+	// Not really efficient, but easier to destroy a whole hierarchy considering DockContextRemoveNode is attempting to merge nodes
+	for _, node := range nodes_to_remove {
+		if node.HostWindow != nil && node.HostWindow.DockNodeAsHost == node {
+			node.HostWindow.DockNodeAsHost = nil
+		}
+		delete(dc.Nodes, node.ID)
+	}
+	if root_node != nil {
+		root_node.ChildNodes = [2]*ImGuiDockNode{}
+		root_node.SplitAxis = ImGuiAxis_None
+	}
+	g.MarkIniSettingsDirty()
+}
+
+// DockBuilderRemoveNodeDockedWindows undocks all the windows of a node tree (or of all the nodes when root_id is 0).
+func (g *ImGuiContext) DockBuilderRemoveNodeDockedWindows(root_id ImGuiID, clear_settings_refs bool) {
+	// Clear references in settings
+	if clear_settings_refs {
+		for i := range g.SettingsWindows {
+			var settings = &g.SettingsWindows[i]
+			var want_removal = root_id == 0 || settings.DockId == root_id
+			if !want_removal && settings.DockId != 0 {
+				if node := DockContextFindNodeByID(g, settings.DockId); node != nil && DockNodeGetRootNode(node).ID == root_id {
+					want_removal = true
+				}
+			}
+			if want_removal {
+				settings.DockId = 0
+			}
+		}
+	}
+
+	// Clear references in windows
+	for _, window := range g.Windows {
+		var want_removal = root_id == 0 || (window.DockNode != nil && DockNodeGetRootNode(window.DockNode).ID == root_id)
+		if want_removal {
+			DockContextProcessUndockWindow(g, window, clear_settings_refs)
+		}
+	}
+}
+
+func (g *ImGuiContext) DockBuilderSetNodePos(node_id ImGuiID, pos ImVec2) {
+	var node = DockContextFindNodeByID(g, node_id)
+	if node == nil {
+		return
+	}
+	node.Pos = pos
+}
+
+func (g *ImGuiContext) DockBuilderSetNodeSize(node_id ImGuiID, size ImVec2) {
+	var node = DockContextFindNodeByID(g, node_id)
+	if node == nil {
+		return
+	}
+	IM_ASSERT(size.x > 0.0 && size.y > 0.0)
+	node.Size = size
+	node.SizeRef = size
+}
+
+// DockBuilderSplitNode splits a node in two along split_dir, the new node at split_dir takes size_ratio_for_node_at_dir of the space.
+// It returns the id of the node at split_dir, and also writes it to out_id_at_dir. out_id_at_opposite_dir receives the id of the other node.
+// The windows of the split node end up in the node opposite to split_dir.
+func (g *ImGuiContext) DockBuilderSplitNode(id ImGuiID, split_dir ImGuiDir, size_ratio_for_node_at_dir float, out_id_at_dir *ImGuiID, out_id_at_opposite_dir *ImGuiID) ImGuiID {
+	IM_ASSERT(split_dir != ImGuiDir_None)
+
+	var node = DockContextFindNodeByID(g, id)
+	if node == nil {
+		IM_ASSERT(node != nil)
+		return 0
+	}
+	IM_ASSERT(!node.IsSplitNode()) // Assert if already Split
+
+	var split_axis = ImGuiAxis_Y
+	if split_dir == ImGuiDir_Left || split_dir == ImGuiDir_Right {
+		split_axis = ImGuiAxis_X
+	}
+	var split_inheritor_child_idx int = 0
+	var split_ratio = 1.0 - size_ratio_for_node_at_dir
+	if split_dir == ImGuiDir_Left || split_dir == ImGuiDir_Up {
+		split_inheritor_child_idx = 1
+		split_ratio = size_ratio_for_node_at_dir
+	}
+	DockNodeTreeSplit(g, node, split_axis, split_inheritor_child_idx, split_ratio, nil)
+
+	var id_at_dir = node.ChildNodes[split_inheritor_child_idx^1].ID
+	var id_at_opposite_dir = node.ChildNodes[split_inheritor_child_idx].ID
+	if out_id_at_dir != nil {
+		*out_id_at_dir = id_at_dir
+	}
+	if out_id_at_opposite_dir != nil {
+		*out_id_at_opposite_dir = id_at_opposite_dir
+	}
+	return id_at_dir
+}
+
+// DockBuilderFinish lays out the node tree after it was built, so node positions and sizes are valid before the first DockSpace() call.
+func (g *ImGuiContext) DockBuilderFinish(root_id ImGuiID) {
+	var root_node = DockContextFindNodeByID(g, root_id)
+	if root_node == nil {
+		return
+	}
+	g.DockNodeTreeUpdatePosSize(root_node, root_node.Pos, root_node.Size)
+	g.MarkIniSettingsDirty()
+}
@@ -0,0 +1,1767 @@
+package imgui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Splizard/imgui/golang"
+)
+
+// Docking
+// - Drag from window title bar or their tab to dock/undock. Hold SHIFT to disable docking (or the opposite when io.ConfigDockingWithShift is set).
+// - Drop on a window or a dock node center marker to merge both into a tab bar, drop on a side marker to split the target.
+// - Enable docking with io.ConfigFlags |= ImGuiConfigFlags_DockingEnable.
+// - DockSpace() creates an explicit dock node _within_ an existing window, DockSpaceOverViewport() covers a viewport with one.
+//   DockSpace() needs to be submitted _before_ any window they can host. Submit it early in your frame!
+// - Dock nodes are persisted in the .ini file under a [Docking][Data] entry, windows remember their node with their DockId.
+// - Use the DockBuilderXXX functions (docking.builder.go) to create a layout programmatically.
+
+const DOCKING_SPLITTER_SIZE = 2.0
+
+type ImGuiDockRequestType int
+
+// ImGuiDockNode is a node of the docking tree. Split nodes own two child nodes, leaf nodes own windows which are displayed as a tab bar.
+// Root nodes are either floating (hosted in their own "##DockNode_XXXX" window) or a dockspace (hosted in a child window of a user window).
+type ImGuiDockNode struct {
+	ID            ImGuiID
+	SharedFlags   ImGuiDockNodeFlags // (Write) Flags shared by all nodes of a same dockspace hierarchy (inherited from the root node)
+	LocalFlags    ImGuiDockNodeFlags // (Write) Flags specific to this node
+	ParentNode    *ImGuiDockNode
+	ChildNodes    [2]*ImGuiDockNode // [Split node only] Child nodes (left/right or top/bottom).
+	Windows       []*ImGuiWindow    // Note: unordered list! Iterate TabBar.Tabs for user-order.
+	TabBar        *ImGuiTabBar
+	Pos           ImVec2    // Current position
+	Size          ImVec2    // Current size
+	SizeRef       ImVec2    // [Split node only] Last explicitly written-to size (overridden when using a splitter affecting the node), used to calculate Size.
+	SplitAxis     ImGuiAxis // [Split node only] Split axis (X or Y)
+	HostWindow    *ImGuiWindow
+	VisibleWindow *ImGuiWindow // Generally point to window which is ID is == SelectedTabID, but when CTRL+Tabbing this can be a different window.
+
+	LastFrameAlive   int     // Last frame number the node was updated or kept alive explicitly with DockSpace() + ImGuiDockNodeFlags_KeepAliveOnly
+	LastFrameActive  int     // Last frame number the node was updated.
+	SelectedTabId    ImGuiID // [Leaf node only] Which of our tab/window is selected.
+	WantCloseTabId   ImGuiID // [Leaf node only] Set when closing a specific tab/window.
+	LastFocusedTabId ImGuiID // [Leaf node only] Which of our tab/window had the focus the last time it was checked.
+	IsVisible        bool    // Set to false when the node is hidden (usually disabled as it has no active window)
+}
+
+func NewImGuiDockNode(id ImGuiID) *ImGuiDockNode {
+	return &ImGuiDockNode{
+		ID:              id,
+		SplitAxis:       ImGuiAxis_None,
+		LastFrameAlive:  -1,
+		LastFrameActive: -1,
+	}
+}
+
+func (n *ImGuiDockNode) IsRootNode() bool     { return n.ParentNode == nil }
+func (n *ImGuiDockNode) IsDockSpace() bool    { return n.LocalFlags&ImGuiDockNodeFlags_DockSpace != 0 }
+func (n *ImGuiDockNode) IsFloatingNode() bool { return n.ParentNode == nil && !n.IsDockSpace() }
+func (n *ImGuiDockNode) IsCentralNode() bool  { return n.LocalFlags&ImGuiDockNodeFlags_CentralNode != 0 }
+func (n *ImGuiDockNode) IsSplitNode() bool    { return n.ChildNodes[0] != nil }
+func (n *ImGuiDockNode) IsLeafNode() bool     { return n.ChildNodes[0] == nil }
+func (n *ImGuiDockNode) GetMergedFlags() ImGuiDockNodeFlags {
+	return n.SharedFlags | n.LocalFlags
+}
+func (n *ImGuiDockNode) Rect() ImRect { return ImRect{n.Pos, n.Pos.Add(n.Size)} }
+
+// ImGuiDockRequest is a docking operation queued to be processed at the beginning of the next frame.
+type ImGuiDockRequest struct {
+	Type               ImGuiDockRequestType
+	DockTargetWindow   *ImGuiWindow   // Destination/Target Window to dock into (may be a loose window or a DockNode, might be NULL in which case DockTargetNode cannot be NULL)
+	DockTargetNode     *ImGuiDockNode // Destination/Target Node to dock into
+	DockPayload        *ImGuiWindow   // Source/Payload window to dock (may be a loose window or a DockNode), [Optional]
+	DockSplitDir       ImGuiDir
+	DockSplitRatio     float
+	DockSplitOuter     bool
+	UndockTargetWindow *ImGuiWindow
+}
+
+// ImGuiDockNodeSettings is the persistent data of a dock node, as read from/written to the .ini file.
+type ImGuiDockNodeSettings struct {
+	ID            ImGuiID
+	ParentNodeId  ImGuiID
+	SelectedTabId ImGuiID
+	Depth         int
+	Flags         ImGuiDockNodeFlags // NB: We save individual flags one by one in ascii format (ImGuiDockNodeFlags_SavedFlagsMask_)
+	SplitAxis     ImGuiAxis
+	Pos           ImVec2ih
+	Size          ImVec2ih
+	SizeRef       ImVec2ih
+}
+
+// ImGuiDockPreviewData is the result of hit testing a moving window against a docking target.
+type ImGuiDockPreviewData struct {
+	Payload           *ImGuiWindow
+	TargetWindow      *ImGuiWindow
+	TargetNode        *ImGuiDockNode
+	TargetRect        ImRect
+	IsDropAllowed     bool
+	IsCenterAvailable bool
+	IsSidesAvailable  bool // Hold your breath, grammar freaks..
+	IsOuterAvailable  bool
+	SplitDir          ImGuiDir
+	SplitRatio        float
+	OuterDocking      bool
+	DropRects         [ImGuiDir_COUNT + 1]ImRect // May be slightly different from hit-testing drop rects used in DockNodeCalcDropRects()
+	OuterDropRects    [ImGuiDir_COUNT + 1]ImRect
+}
+
+// ImGuiDockContext is the docking state of a context.
+type ImGuiDockContext struct {
+	Nodes         map[ImGuiID]*ImGuiDockNode // Map ID -> ImGuiDockNode*: Active nodes
+	Requests      []ImGuiDockRequest
+	NodesSettings []ImGuiDockNodeSettings
+	Preview       ImGuiDockPreviewData // Drop preview of the window being moved this frame, if any
+}
+
+//-----------------------------------------------------------------------------
+// Docking: Context
+//-----------------------------------------------------------------------------
+
+func DockContextInitialize(ctx *ImGuiContext) {
+	var g = ctx
+	g.DockContext.Nodes = make(map[ImGuiID]*ImGuiDockNode)
+
+	// Add .ini handle for persistent docking data
+	var ini_handler ImGuiSettingsHandler
+	ini_handler.TypeName = "Docking"
+	ini_handler.TypeHash = ImHashStr("Docking", 0, 0)
+	ini_handler.ClearAllFn = DockSettingsHandler_ClearAll
+	ini_handler.ReadInitFn = DockSettingsHandler_ClearAll // Also clear on read since the nodes are rebuilt from scratch
+	ini_handler.ReadOpenFn = DockSettingsHandler_ReadOpen
+	ini_handler.ReadLineFn = DockSettingsHandler_ReadLine
+	ini_handler.ApplyAllFn = DockSettingsHandler_ApplyAll
+	ini_handler.WriteAllFn = DockSettingsHandler_WriteAll
+	g.SettingsHandlers = append(g.SettingsHandlers, ini_handler)
+}
+
+func DockContextShutdown(ctx *ImGuiContext) {
+	var dc = &ctx.DockContext
+	dc.Nodes = nil
+	dc.Requests = nil
+	dc.NodesSettings = nil
+	dc.Preview = ImGuiDockPreviewData{}
+}
+
+// DockContextClearNodes unbinds every window from its dock node and deletes all nodes.
+func DockContextClearNodes(ctx *ImGuiContext, clear_settings_refs bool) {
+	var g = ctx
+	var dc = &g.DockContext
+	for _, window := range g.Windows {
+		if window.DockNode != nil {
+			ctx.DockNodeRemoveWindow(window.DockNode, window, 0)
+		}
+		if clear_settings_refs {
+			window.DockId = 0
+		}
+	}
+	for _, node := range dc.Nodes {
+		if node.HostWindow != nil && node.HostWindow.DockNodeAsHost == node {
+			node.HostWindow.DockNodeAsHost = nil
+		}
+	}
+	dc.Nodes = make(map[ImGuiID]*ImGuiDockNode)
+	dc.Requests = dc.Requests[:0]
+}
+
+// DockContextNewFrameUpdateUndocking processes the undocking requests queued during the previous frame.
+// This is called before the hovered window is computed, so that an undocked window can be moved right away.
+func DockContextNewFrameUpdateUndocking(ctx *ImGuiContext) {
+	var g = ctx
+	var dc = &g.DockContext
+	if g.IO.ConfigFlags&ImGuiConfigFlags_DockingEnable == 0 {
+		if len(dc.Nodes) > 0 || len(dc.Requests) > 0 {
+			DockContextClearNodes(ctx, false)
+		}
+		return
+	}
+
+	var n int
+	for _, req := range dc.Requests {
+		if req.Type == ImGuiDockRequestType_Undock && req.UndockTargetWindow != nil {
+			DockContextProcessUndockRequest(ctx, req.UndockTargetWindow)
+		} else {
+			dc.Requests[n] = req
+			n++
+		}
+	}
+	dc.Requests = dc.Requests[:n]
+}
+
+// DockContextNewFrameUpdateDocking processes the docking requests and submits the host windows of the floating dock nodes.
+func DockContextNewFrameUpdateDocking(ctx *ImGuiContext) {
+	var g = ctx
+	var dc = &g.DockContext
+	if g.IO.ConfigFlags&ImGuiConfigFlags_DockingEnable == 0 {
+		return
+	}
+
+	// Process Docking requests
+	for i := range dc.Requests {
+		if dc.Requests[i].Type == ImGuiDockRequestType_Dock {
+			DockContextProcessDock(ctx, &dc.Requests[i])
+		}
+	}
+	dc.Requests = dc.Requests[:0]
+
+	// Create windows for each floating dock node (in a stable order)
+	var roots []*ImGuiDockNode
+	for _, node := range dc.Nodes {
+		if node.IsFloatingNode() {
+			roots = append(roots, node)
+		}
+	}
+	sort.Slice(roots, func(i, j golang.Int) bool { return roots[i].ID < roots[j].ID })
+	for _, node := range roots {
+		if ctx.DockNodeTreeHasAliveWindows(node) {
+			ctx.DockNodeBeginHostWindow(node)
+		}
+	}
+}
+
+func DockContextFindNodeByID(ctx *ImGuiContext, id ImGuiID) *ImGuiDockNode {
+	return ctx.DockContext.Nodes[id]
+}
+
+// DockContextGenNodeID generates an ID for new node (the exact ID value doesn't matter as long as it is not already used)
+func DockContextGenNodeID(ctx *ImGuiContext) ImGuiID {
+	var id ImGuiID = 0x0001
+	for DockContextFindNodeByID(ctx, id) != nil {
+		id++
+	}
+	return id
+}
+
+func DockContextAddNode(ctx *ImGuiContext, id ImGuiID) *ImGuiDockNode {
+	// Generate an ID for the new node (the exact ID value doesn't matter as long as it is not already used) and add the first window.
+	if id == 0 {
+		id = DockContextGenNodeID(ctx)
+	} else {
+		IM_ASSERT(DockContextFindNodeByID(ctx, id) == nil)
+	}
+	var node = NewImGuiDockNode(id)
+	ctx.DockContext.Nodes[node.ID] = node
+	return node
+}
+
+func DockContextRemoveNode(ctx *ImGuiContext, node *ImGuiDockNode, merge_sibling_into_parent_node bool) {
+	IM_ASSERT(len(node.Windows) == 0)
+	if node.HostWindow != nil && node.HostWindow.DockNodeAsHost == node {
+		node.HostWindow.DockNodeAsHost = nil
+	}
+
+	var parent_node = node.ParentNode
+	if merge_sibling_into_parent_node && parent_node != nil {
+		var merge_lead_child = parent_node.ChildNodes[0]
+		if merge_lead_child == node {
+			merge_lead_child = parent_node.ChildNodes[1]
+		}
+		DockNodeTreeMerge(ctx, parent_node, merge_lead_child)
+	} else {
+		for n := range node.ChildNodes {
+			if parent_node != nil && parent_node.ChildNodes[n] == node {
+				parent_node.ChildNodes[n] = nil
+			}
+		}
+		delete(ctx.DockContext.Nodes, node.ID)
+	}
+	ctx.MarkIniSettingsDirty()
+}
+
+func DockContextQueueDock(ctx *ImGuiContext, target *ImGuiWindow, target_node *ImGuiDockNode, payload *ImGuiWindow, split_dir ImGuiDir, split_ratio float, split_outer bool) {
+	IM_ASSERT(target != payload)
+	var req ImGuiDockRequest
+	req.Type = ImGuiDockRequestType_Dock
+	req.DockTargetWindow = target
+	req.DockTargetNode = target_node
+	req.DockPayload = payload
+	req.DockSplitDir = split_dir
+	req.DockSplitRatio = split_ratio
+	req.DockSplitOuter = split_outer
+	ctx.DockContext.Requests = append(ctx.DockContext.Requests, req)
+}
+
+func DockContextQueueUndockWindow(ctx *ImGuiContext, window *ImGuiWindow) {
+	for _, req := range ctx.DockContext.Requests {
+		if req.Type == ImGuiDockRequestType_Undock && req.UndockTargetWindow == window {
+			return
+		}
+	}
+	var req ImGuiDockRequest
+	req.Type = ImGuiDockRequestType_Undock
+	req.UndockTargetWindow = window
+	ctx.DockContext.Requests = append(ctx.DockContext.Requests, req)
+}
+
+func DockContextProcessDock(ctx *ImGuiContext, req *ImGuiDockRequest) {
+	IM_ASSERT(req.Type == ImGuiDockRequestType_Dock && req.DockPayload != nil)
+	IM_ASSERT(req.DockTargetWindow != nil || req.DockTargetNode != nil)
+
+	var payload_window = req.DockPayload
+	var target_window = req.DockTargetWindow
+	var node = req.DockTargetNode
+
+	// A floating dock node is moved as a whole
+	var payload_node *ImGuiDockNode
+	if payload_window != nil && payload_window.Flags&ImGuiWindowFlags_DockNodeHost != 0 && payload_window.DockNodeAsHost != nil {
+		payload_node = payload_window.DockNodeAsHost
+		IM_ASSERT(payload_node.IsFloatingNode())
+	}
+
+	// The window we will select in the target node
+	var selected_window = payload_window
+	if payload_node != nil {
+		selected_window = DockNodeTreeFindVisibleWindow(payload_node)
+	}
+
+	// Docking into a loose window creates a new floating node around it
+	if node == nil {
+		node = DockContextAddNode(ctx, 0)
+		node.Pos = target_window.Pos
+		node.Size = target_window.SizeFull
+		node.SizeRef = node.Size
+		ctx.DockNodeAddWindow(node, target_window)
+	}
+
+	if payload_node != nil {
+		// Detach the floating node from its host window: the target node host window takes over
+		if payload_node.HostWindow != nil && payload_node.HostWindow.DockNodeAsHost == payload_node {
+			payload_node.HostWindow.DockNodeAsHost = nil
+		}
+		payload_node.HostWindow = nil
+	}
+
+	var split_dir = req.DockSplitDir
+	if split_dir != ImGuiDir_None {
+		// Split into two, one side will be our payload node unless we are dropping a loose window
+		if req.DockSplitOuter {
+			node = DockNodeGetRootNode(node)
+		}
+		var split_axis = ImGuiAxis_Y
+		if split_dir == ImGuiDir_Left || split_dir == ImGuiDir_Right {
+			split_axis = ImGuiAxis_X
+		}
+		var split_inheritor_child_idx int = 0
+		var split_ratio = 1.0 - req.DockSplitRatio
+		if split_dir == ImGuiDir_Left || split_dir == ImGuiDir_Up {
+			split_inheritor_child_idx = 1
+			split_ratio = req.DockSplitRatio
+		}
+		DockNodeTreeSplit(ctx, node, split_axis, split_inheritor_child_idx, split_ratio, payload_node)
+		node = node.ChildNodes[split_inheritor_child_idx^1]
+		if payload_node == nil {
+			ctx.DockNodeAddWindow(node, payload_window)
+		}
+	} else if payload_node != nil {
+		// Move all windows of the payload tree into the target node tab bar
+		DockNodeTreeMoveWindowsAndDelete(ctx, node, payload_node)
+	} else {
+		ctx.DockNodeAddWindow(node, payload_window)
+	}
+
+	// Select the payload window in its new node
+	if selected_window != nil && selected_window.DockNode != nil {
+		var selected_node = selected_window.DockNode
+		selected_node.SelectedTabId = selected_window.TabId
+		if selected_node.TabBar != nil {
+			selected_node.TabBar.NextSelectedTabId = selected_window.TabId
+		}
+		ctx.FocusWindow(selected_window)
+	}
+	ctx.MarkIniSettingsDirty()
+}
+
+// DockContextProcessUndockWindow removes a window from its dock node, leaving it floating at the position it was docked at.
+func DockContextProcessUndockWindow(ctx *ImGuiContext, window *ImGuiWindow, clear_persistent_docking_ref bool) {
+	var node = window.DockNode
+	if node == nil {
+		if clear_persistent_docking_ref {
+			window.DockId = 0
+		}
+		return
+	}
+
+	var r = node.Rect()
+	var save_dock_id ImGuiID
+	if !clear_persistent_docking_ref {
+		save_dock_id = node.ID
+	}
+	ctx.DockNodeRemoveWindow(node, window, save_dock_id)
+	if r.GetWidth() > 0 && r.GetHeight() > 0 {
+		window.Pos = *ImFloorVec(&r.Min)
+		window.SizeFull = *ImFloorVec(&ImVec2{ImMax(r.GetWidth(), ctx.Style.WindowMinSize.x), ImMax(r.GetHeight(), ctx.Style.WindowMinSize.y)})
+		window.Size = window.SizeFull
+	}
+	ctx.MarkIniSettingsDirty()
+}
+
+// DockContextProcessUndockRequest undocks a window after its tab was dragged out of its node tab bar.
+func DockContextProcessUndockRequest(ctx *ImGuiContext, window *ImGuiWindow) {
+	var g = ctx
+	var node = window.DockNode
+	if node == nil {
+		return
+	}
+	var root_id = DockNodeGetRootNode(node).ID
+	DockContextProcessUndockWindow(ctx, window, true)
+
+	// A floating node left with a single window dissolves back into a regular window
+	if root := DockContextFindNodeByID(ctx, root_id); root != nil && root.IsFloatingNode() && root.IsLeafNode() && len(root.Windows) <= 1 {
+		var r = root.Rect()
+		if len(root.Windows) == 1 {
+			var last_window = root.Windows[0]
+			ctx.DockNodeRemoveWindow(root, last_window, 0)
+			last_window.Pos = r.Min
+			last_window.SizeFull = r.GetSize()
+			last_window.Size = last_window.SizeFull
+		}
+		if root = DockContextFindNodeByID(ctx, root_id); root != nil {
+			DockContextRemoveNode(ctx, root, false)
+		}
+	}
+
+	// Keep dragging the window we just pulled out
+	if g.IO.MouseDown[0] {
+		ctx.StartMouseMovingWindow(window)
+	} else {
+		ctx.FocusWindow(window)
+	}
+}
+
+//-----------------------------------------------------------------------------
+// Docking: Node
+//-----------------------------------------------------------------------------
+
+func DockNodeGetRootNode(node *ImGuiDockNode) *ImGuiDockNode {
+	for node.ParentNode != nil {
+		node = node.ParentNode
+	}
+	return node
+}
+
+// DockNodeIsWindowAlive returns true when the window was submitted during this frame or the previous one.
+func (g *ImGuiContext) DockNodeIsWindowAlive(window *ImGuiWindow) bool {
+	return window.LastFrameActive >= g.FrameCount-1
+}
+
+func (g *ImGuiContext) DockNodeCountAliveWindows(node *ImGuiDockNode) int {
+	var count int
+	for _, window := range node.Windows {
+		if g.DockNodeIsWindowAlive(window) {
+			count++
+		}
+	}
+	return count
+}
+
+func (g *ImGuiContext) DockNodeTreeHasAliveWindows(node *ImGuiDockNode) bool {
+	if node.IsSplitNode() {
+		return g.DockNodeTreeHasAliveWindows(node.ChildNodes[0]) || g.DockNodeTreeHasAliveWindows(node.ChildNodes[1])
+	}
+	return g.DockNodeCountAliveWindows(node) > 0
+}
+
+func DockNodeTreeFindCentralNode(node *ImGuiDockNode) *ImGuiDockNode {
+	if node.IsCentralNode() {
+		return node
+	}
+	for _, child := range node.ChildNodes {
+		if child != nil {
+			if central_node := DockNodeTreeFindCentralNode(child); central_node != nil {
+				return central_node
+			}
+		}
+	}
+	return nil
+}
+
+func DockNodeTreeFindFirstLeafNode(node *ImGuiDockNode) *ImGuiDockNode {
+	for node.IsSplitNode() {
+		node = node.ChildNodes[0]
+	}
+	return node
+}
+
+// DockNodeTreeFindFallbackLeafNode returns the leaf where windows targeting a split node end up: the central node if any, otherwise the first leaf.
+func DockNodeTreeFindFallbackLeafNode(node *ImGuiDockNode) *ImGuiDockNode {
+	if central_node := DockNodeTreeFindCentralNode(node); central_node != nil {
+		return central_node
+	}
+	return DockNodeTreeFindFirstLeafNode(node)
+}
+
+func DockNodeTreeFindVisibleNodeByPos(node *ImGuiDockNode, pos ImVec2) *ImGuiDockNode {
+	if !node.IsVisible {
+		return nil
+	}
+	var r = node.Rect()
+	if !r.ContainsVec(pos) {
+		return nil
+	}
+	if node.IsLeafNode() {
+		return node
+	}
+	if hovered_node := DockNodeTreeFindVisibleNodeByPos(node.ChildNodes[0], pos); hovered_node != nil {
+		return hovered_node
+	}
+	return DockNodeTreeFindVisibleNodeByPos(node.ChildNodes[1], pos)
+}
+
+func DockNodeTreeFindVisibleWindow(node *ImGuiDockNode) *ImGuiWindow {
+	if node.IsSplitNode() {
+		if window := DockNodeTreeFindVisibleWindow(node.ChildNodes[0]); window != nil {
+			return window
+		}
+		return DockNodeTreeFindVisibleWindow(node.ChildNodes[1])
+	}
+	if node.VisibleWindow != nil {
+		return node.VisibleWindow
+	}
+	if len(node.Windows) > 0 {
+		return node.Windows[0]
+	}
+	return nil
+}
+
+func DockNodeTreeHasCentralNode(node *ImGuiDockNode) bool {
+	return DockNodeTreeFindCentralNode(node) != nil
+}
+
+func (g *ImGuiContext) DockNodeAddWindow(node *ImGuiDockNode, window *ImGuiWindow) {
+	if window.DockNode != nil {
+		// Can overwrite an existing window.DockNode (e.g. pointing to a disabled DockSpace node)
+		IM_ASSERT(window.DockNode.ID != node.ID)
+		g.DockNodeRemoveWindow(window.DockNode, window, 0)
+	}
+	IM_ASSERT(window.DockNode == nil || window.DockNodeAsHost == nil)
+
+	// Windows restored from .ini data are ordered by their last known DockOrder, others are appended
+	if node.TabBar != nil && len(node.TabBar.Tabs) > 0 {
+		window.DockOrder = -1
+	}
+	node.Windows = append(node.Windows, window)
+	sort.SliceStable(node.Windows, func(i, j golang.Int) bool {
+		var a, b = node.Windows[i].DockOrder, node.Windows[j].DockOrder
+		if a == -1 || b == -1 {
+			return a != -1 && b == -1
+		}
+		return a < b
+	})
+	window.DockNode = node
+	window.DockId = node.ID
+
+	// When reactivating a floating node with a loose window, the window pos/size are authoritative over the node storage.
+	if node.HostWindow == nil && node.IsFloatingNode() && len(node.Windows) == 1 && node.Size.x <= 0 && node.Size.y <= 0 {
+		node.Pos = window.Pos
+		node.Size = window.SizeFull
+		node.SizeRef = node.Size
+	}
+}
+
+func (g *ImGuiContext) DockNodeRemoveWindow(node *ImGuiDockNode, window *ImGuiWindow, save_dock_id ImGuiID) {
+	IM_ASSERT(window.DockNode == node)
+	IM_ASSERT(save_dock_id == 0 || save_dock_id == node.ID)
+
+	window.DockNode = nil
+	window.DockIsActive = false
+	window.DockTabIsVisible = false
+	window.DockId = save_dock_id
+	window.Flags &= ^ImGuiWindowFlags_ChildWindow
+	if parent_window := window.ParentWindow; parent_window != nil {
+		for i, child := range parent_window.DC.ChildWindows {
+			if child == window {
+				parent_window.DC.ChildWindows = append(parent_window.DC.ChildWindows[:i], parent_window.DC.ChildWindows[i+1:]...)
+				break
+			}
+		}
+	}
+	UpdateWindowParentAndRootLinks(window, window.Flags, nil) // Update immediately
+
+	// Remove window
+	for i, w := range node.Windows {
+		if w == window {
+			node.Windows = append(node.Windows[:i], node.Windows[i+1:]...)
+			break
+		}
+	}
+	if node.VisibleWindow == window {
+		node.VisibleWindow = nil
+	}
+	if node.TabBar != nil {
+		TabBarRemoveTab(node.TabBar, window.TabId)
+		if len(node.Windows) == 0 {
+			node.TabBar = nil
+		}
+	}
+	g.MarkIniSettingsDirty()
+
+	// Automatic dock node delete themselves if they are not holding at least one tab
+	if len(node.Windows) == 0 && !node.IsCentralNode() && !node.IsDockSpace() && window.DockId != node.ID {
+		DockContextRemoveNode(g, node, true)
+	}
+}
+
+func DockNodeMoveChildNodes(dst_node *ImGuiDockNode, src_node *ImGuiDockNode) {
+	IM_ASSERT(len(dst_node.Windows) == 0)
+	dst_node.ChildNodes = src_node.ChildNodes
+	if dst_node.ChildNodes[0] != nil {
+		dst_node.ChildNodes[0].ParentNode = dst_node
+	}
+	if dst_node.ChildNodes[1] != nil {
+		dst_node.ChildNodes[1].ParentNode = dst_node
+	}
+	dst_node.SplitAxis = src_node.SplitAxis
+	dst_node.SizeRef = src_node.SizeRef
+	src_node.ChildNodes = [2]*ImGuiDockNode{}
+}
+
+func (g *ImGuiContext) DockNodeMoveWindows(dst_node *ImGuiDockNode, src_node *ImGuiDockNode) {
+	IM_ASSERT(src_node != nil && dst_node != nil && dst_node != src_node)
+
+	// Keep the tab bar (and its ordering) when the destination has none
+	var move_tab_bar = src_node.TabBar != nil && dst_node.TabBar == nil
+	if move_tab_bar {
+		dst_node.TabBar = src_node.TabBar
+		src_node.TabBar = nil
+	}
+
+	var windows = append([]*ImGuiWindow(nil), src_node.Windows...)
+	src_node.Windows = src_node.Windows[:0]
+	for _, window := range windows {
+		window.DockNode = nil
+		window.DockIsActive = false
+		g.DockNodeAddWindow(dst_node, window)
+	}
+	if !move_tab_bar && src_node.TabBar != nil {
+		if dst_node.TabBar != nil {
+			dst_node.TabBar.NextSelectedTabId = src_node.TabBar.SelectedTabId
+		}
+		src_node.TabBar = nil
+	}
+	if src_node.VisibleWindow != nil && dst_node.VisibleWindow == nil {
+		dst_node.VisibleWindow = src_node.VisibleWindow
+	}
+	src_node.VisibleWindow = nil
+}
+
+// DockNodeTreeMoveWindowsAndDelete moves the windows of every leaf of the src_root tree into dst_node, then deletes the tree.
+func DockNodeTreeMoveWindowsAndDelete(ctx *ImGuiContext, dst_node *ImGuiDockNode, src_root *ImGuiDockNode) {
+	var leaves []*ImGuiDockNode
+	var collect func(node *ImGuiDockNode)
+	collect = func(node *ImGuiDockNode) {
+		if node.IsSplitNode() {
+			collect(node.ChildNodes[0])
+			collect(node.ChildNodes[1])
+		} else {
+			leaves = append(leaves, node)
+		}
+	}
+	collect(src_root)
+
+	var nodes []*ImGuiDockNode
+	var gather func(node *ImGuiDockNode)
+	gather = func(node *ImGuiDockNode) {
+		nodes = append(nodes, node)
+		for _, child := range node.ChildNodes {
+			if child != nil {
+				gather(child)
+			}
+		}
+	}
+	gather(src_root)
+
+	for _, leaf := range leaves {
+		if len(leaf.Windows) > 0 {
+			ctx.DockNodeMoveWindows(dst_node, leaf)
+		}
+		ctx.DockSettingsRenameNodeReferences(leaf.ID, dst_node.ID)
+	}
+	for _, node := range nodes {
+		if node.HostWindow != nil && node.HostWindow.DockNodeAsHost == node {
+			node.HostWindow.DockNodeAsHost = nil
+		}
+		delete(ctx.DockContext.Nodes, node.ID)
+	}
+}
+
+// DockNodeTreeSplit splits a leaf node into two, moving its windows into the child at split_inheritor_child_idx.
+// When new_node is set it is used as the other child (e.g. a floating node being docked), otherwise a new empty node is created.
+func DockNodeTreeSplit(ctx *ImGuiContext, parent_node *ImGuiDockNode, split_axis ImGuiAxis, split_inheritor_child_idx int, split_ratio float, new_node *ImGuiDockNode) {
+	var g = ctx
+	IM_ASSERT(split_axis != ImGuiAxis_None)
+
+	var child_0, child_1 *ImGuiDockNode
+	if new_node != nil && split_inheritor_child_idx != 0 {
+		child_0 = new_node
+	} else {
+		child_0 = DockContextAddNode(ctx, 0)
+	}
+	child_0.ParentNode = parent_node
+	if new_node != nil && split_inheritor_child_idx != 1 {
+		child_1 = new_node
+	} else {
+		child_1 = DockContextAddNode(ctx, 0)
+	}
+	child_1.ParentNode = parent_node
+
+	var child_inheritor = child_0
+	if split_inheritor_child_idx == 1 {
+		child_inheritor = child_1
+	}
+	DockNodeMoveChildNodes(child_inheritor, parent_node)
+	parent_node.ChildNodes[0] = child_0
+	parent_node.ChildNodes[1] = child_1
+	child_inheritor.VisibleWindow = parent_node.VisibleWindow
+	parent_node.SplitAxis = split_axis
+	parent_node.VisibleWindow = nil
+
+	var size_avail = parent_node.Size.Axis(split_axis) - DOCKING_SPLITTER_SIZE
+	size_avail = ImMax(size_avail, g.Style.WindowMinSize.Axis(split_axis)*2.0)
+	IM_ASSERT(size_avail > 0.0) // If you created a node manually with DockBuilderAddNode(), you need to also call DockBuilderSetNodeSize() before splitting.
+	child_0.SizeRef = parent_node.Size
+	child_1.SizeRef = parent_node.Size
+	child_0.SizeRef.SetAxis(split_axis, ImFloor(size_avail*split_ratio))
+	child_1.SizeRef.SetAxis(split_axis, ImFloor(size_avail-child_0.SizeRef.Axis(split_axis)))
+
+	ctx.DockNodeMoveWindows(child_inheritor, parent_node)
+	ctx.DockSettingsRenameNodeReferences(parent_node.ID, child_inheritor.ID)
+	ctx.DockNodeTreeUpdatePosSize(parent_node, parent_node.Pos, parent_node.Size)
+
+	// Flags transfer (e.g. this is where we transfer the ImGuiDockNodeFlags_CentralNode property)
+	child_0.SharedFlags = parent_node.SharedFlags & ImGuiDockNodeFlags_SharedFlagsInheritMask_
+	child_1.SharedFlags = parent_node.SharedFlags & ImGuiDockNodeFlags_SharedFlagsInheritMask_
+	child_inheritor.LocalFlags = parent_node.LocalFlags & ImGuiDockNodeFlags_LocalFlagsTransferMask_
+	parent_node.LocalFlags &= ^ImGuiDockNodeFlags_LocalFlagsTransferMask_
+	ctx.MarkIniSettingsDirty()
+}
+
+// DockNodeTreeMerge merges both children of parent_node back into it. The windows of both children are moved into the parent.
+func DockNodeTreeMerge(ctx *ImGuiContext, parent_node *ImGuiDockNode, merge_lead_child *ImGuiDockNode) {
+	// When called from DockContextProcessUndockNode() it is possible that one of the child is NULL.
+	var child_0 = parent_node.ChildNodes[0]
+	var child_1 = parent_node.ChildNodes[1]
+	IM_ASSERT(child_0 != nil || child_1 != nil)
+	IM_ASSERT(merge_lead_child == child_0 || merge_lead_child == child_1)
+	if (child_0 != nil && len(child_0.Windows) > 0) || (child_1 != nil && len(child_1.Windows) > 0) {
+		IM_ASSERT(parent_node.TabBar == nil)
+		IM_ASSERT(len(parent_node.Windows) == 0)
+	}
+
+	var backup_last_explicit_size = parent_node.SizeRef
+	DockNodeMoveChildNodes(parent_node, merge_lead_child)
+	if child_0 != nil {
+		ctx.DockNodeMoveWindows(parent_node, child_0) // Generally only 1 of the 2 child node will have windows
+		ctx.DockSettingsRenameNodeReferences(child_0.ID, parent_node.ID)
+	}
+	if child_1 != nil {
+		ctx.DockNodeMoveWindows(parent_node, child_1)
+		ctx.DockSettingsRenameNodeReferences(child_1.ID, parent_node.ID)
+	}
+	parent_node.SizeRef = backup_last_explicit_size
+
+	// Flags transfer
+	parent_node.LocalFlags &= ^ImGuiDockNodeFlags_LocalFlagsTransferMask_ // Preserve Dockspace flag
+	if child_0 != nil {
+		parent_node.LocalFlags |= child_0.LocalFlags & ImGuiDockNodeFlags_LocalFlagsTransferMask_
+		delete(ctx.DockContext.Nodes, child_0.ID)
+	}
+	if child_1 != nil {
+		parent_node.LocalFlags |= child_1.LocalFlags & ImGuiDockNodeFlags_LocalFlagsTransferMask_
+		delete(ctx.DockContext.Nodes, child_1.ID)
+	}
+}
+
+// DockSettingsRenameNodeReferences updates the DockId of windows and window settings not currently bound to a node.
+func (g *ImGuiContext) DockSettingsRenameNodeReferences(old_node_id ImGuiID, new_node_id ImGuiID) {
+	for _, window := range g.Windows {
+		if window.DockId == old_node_id && window.DockNode == nil {
+			window.DockId = new_node_id
+		}
+	}
+	for i := range g.SettingsWindows {
+		if g.SettingsWindows[i].DockId == old_node_id {
+			g.SettingsWindows[i].DockId = new_node_id
+		}
+	}
+}
+
+func (g *ImGuiContext) DockNodeTreeUpdateVisibility(node *ImGuiDockNode, host_window *ImGuiWindow) {
+	node.HostWindow = host_window
+	node.LastFrameActive = g.FrameCount
+	node.LastFrameAlive = g.FrameCount
+	if node.ParentNode != nil {
+		node.SharedFlags = node.ParentNode.SharedFlags & ImGuiDockNodeFlags_SharedFlagsInheritMask_
+	}
+	if node.IsSplitNode() {
+		g.DockNodeTreeUpdateVisibility(node.ChildNodes[0], host_window)
+		g.DockNodeTreeUpdateVisibility(node.ChildNodes[1], host_window)
+		node.IsVisible = node.ChildNodes[0].IsVisible || node.ChildNodes[1].IsVisible
+	} else {
+		node.IsVisible = node.IsCentralNode() || g.DockNodeCountAliveWindows(node) > 0
+	}
+	if node.IsRootNode() && node.IsDockSpace() {
+		node.IsVisible = true
+	}
+}
+
+// DockNodeTreeUpdatePosSize distributes the node space between its children.
+// A child holding the central node only receives the space remaining from its sibling, a hidden child gives all the space to its sibling.
+func (g *ImGuiContext) DockNodeTreeUpdatePosSize(node *ImGuiDockNode, pos ImVec2, size ImVec2) {
+	node.Pos = pos
+	node.Size = size
+	if node.IsLeafNode() {
+		return
+	}
+
+	var child_0 = node.ChildNodes[0]
+	var child_1 = node.ChildNodes[1]
+	var child_0_pos, child_1_pos = pos, pos
+	var child_0_size, child_1_size = size, size
+
+	// Layout both children when neither was updated yet (e.g. right after a DockBuilderSplitNode() call)
+	var child_0_visible = child_0.IsVisible || !child_1.IsVisible
+	var child_1_visible = child_1.IsVisible || !child_0.IsVisible
+	if child_0_visible && child_1_visible {
+		var spacing float = DOCKING_SPLITTER_SIZE
+		var axis = node.SplitAxis
+		var size_avail = ImMax(size.Axis(axis)-spacing, 0.0)
+
+		// The first 0..WindowMinSize[axis]*2 are allocated evenly to both windows.
+		var size_min_each = ImFloor(ImMin(size_avail, g.Style.WindowMinSize.Axis(axis)*2.0) * 0.5)
+
+		if child_0.SizeRef.Axis(axis) != 0.0 && DockNodeTreeHasCentralNode(child_1) {
+			// If one window is the central node, use explicit size from the other, and remainder for the central node
+			child_0_size.SetAxis(axis, ImMin(size_avail-size_min_each, child_0.SizeRef.Axis(axis)))
+			child_1_size.SetAxis(axis, size_avail-child_0_size.Axis(axis))
+		} else if child_1.SizeRef.Axis(axis) != 0.0 && DockNodeTreeHasCentralNode(child_0) {
+			child_1_size.SetAxis(axis, ImMin(size_avail-size_min_each, child_1.SizeRef.Axis(axis)))
+			child_0_size.SetAxis(axis, size_avail-child_1_size.Axis(axis))
+		} else {
+			// Otherwise distribute according to the relative ratio of each SizeRef value
+			var split_ratio float = 0.5
+			if total := child_0.SizeRef.Axis(axis) + child_1.SizeRef.Axis(axis); total > 0.0 {
+				split_ratio = child_0.SizeRef.Axis(axis) / total
+			}
+			child_0_size.SetAxis(axis, ImMax(size_min_each, ImFloor(size_avail*split_ratio+0.5)))
+			child_1_size.SetAxis(axis, size_avail-child_0_size.Axis(axis))
+		}
+		child_1_pos.SetAxis(axis, child_1_pos.Axis(axis)+spacing+child_0_size.Axis(axis))
+	}
+
+	if child_0_visible {
+		g.DockNodeTreeUpdatePosSize(child_0, child_0_pos, child_0_size)
+	}
+	if child_1_visible {
+		g.DockNodeTreeUpdatePosSize(child_1, child_1_pos, child_1_size)
+	}
+}
+
+func (g *ImGuiContext) DockNodeTreeUpdateSplitter(node *ImGuiDockNode) {
+	if node.IsLeafNode() {
+		return
+	}
+
+	var child_0 = node.ChildNodes[0]
+	var child_1 = node.ChildNodes[1]
+	if child_0.IsVisible && child_1.IsVisible && node.GetMergedFlags()&ImGuiDockNodeFlags_NoResize == 0 {
+		// Bounding box of the splitter cover the space between both nodes (w = Spacing, h = Size[xy^1] for when splitting horizontally)
+		var axis = node.SplitAxis
+		IM_ASSERT(axis != ImGuiAxis_None)
+		var bb ImRect
+		bb.Min = node.Pos
+		bb.Max = node.Pos.Add(node.Size)
+		bb.Min.SetAxis(axis, child_0.Pos.Axis(axis)+child_0.Size.Axis(axis))
+		bb.Max.SetAxis(axis, child_1.Pos.Axis(axis))
+
+		var size_0 = child_0.Size.Axis(axis)
+		var size_1 = child_1.Size.Axis(axis)
+		var min_size = g.Style.WindowMinSize.Axis(axis)
+		var id = g.GetIDWithSeed("##Splitter", node.ID)
+		if g.SplitterBehavior(&bb, id, axis, &size_0, &size_1, min_size, min_size, WINDOWS_HOVER_PADDING, WINDOWS_RESIZE_FROM_EDGES_FEEDBACK_TIMER) {
+			// Write back both sizes, the layout keeps the ratio (or keeps the non-central side size)
+			child_0.SizeRef.SetAxis(axis, size_0)
+			child_1.SizeRef.SetAxis(axis, size_1)
+			g.DockNodeTreeUpdatePosSize(node, node.Pos, node.Size)
+			g.MarkIniSettingsDirty()
+		}
+	}
+
+	if child_0.IsVisible {
+		g.DockNodeTreeUpdateSplitter(child_0)
+	}
+	if child_1.IsVisible {
+		g.DockNodeTreeUpdateSplitter(child_1)
+	}
+}
+
+// DockNodeCalcTabBarHeight returns the height of the tab bar displayed at the top of a leaf node.
+func (g *ImGuiContext) DockNodeCalcTabBarHeight(node *ImGuiDockNode) float {
+	if node.GetMergedFlags()&ImGuiDockNodeFlags_AutoHideTabBar != 0 && g.DockNodeCountAliveWindows(node) <= 1 {
+		return 0.0
+	}
+	return g.FontBaseSize + g.Style.FramePadding.y*2.0 // Also called outside of any window, where g.FontSize isn't set
+}
+
+// DockNodeBeginHostWindow submits the window hosting a floating dock node tree.
+func (g *ImGuiContext) DockNodeBeginHostWindow(node *ImGuiDockNode) {
+	IM_ASSERT(node.IsFloatingNode())
+
+	// The host window is authoritative over the node pos/size, unless the node was just (re)created
+	if node.HostWindow == nil || node.HostWindow.DockNodeAsHost != node {
+		var size = ImMaxVec2(&node.Size, &g.Style.WindowMinSize)
+		g.SetNextWindowPos(&node.Pos, 0, ImVec2{})
+		g.SetNextWindowSize(&size, 0)
+	}
+
+	var window_flags = ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoScrollbar | ImGuiWindowFlags_NoScrollWithMouse | ImGuiWindowFlags_NoCollapse |
+		ImGuiWindowFlags_NoSavedSettings | ImGuiWindowFlags_NoNavFocus | ImGuiWindowFlags_DockNodeHost
+	g.PushStyleVec(ImGuiStyleVar_WindowPadding, ImVec2{})
+	g.Begin(fmt.Sprintf("##DockNode_%08X", node.ID), nil, window_flags)
+	g.PopStyleVar(1)
+
+	var host_window = g.CurrentWindow
+	node.Pos = host_window.Pos
+	node.Size = host_window.Size
+	g.DockNodeUpdate(node)
+	g.End()
+}
+
+// DockNodeUpdate updates a dock node tree. Must be called with the host window of the root node as the current window.
+func (g *ImGuiContext) DockNodeUpdate(node *ImGuiDockNode) {
+	IM_ASSERT(node.IsRootNode())
+
+	var host_window = g.CurrentWindow
+	host_window.DockNodeAsHost = node
+
+	g.DockNodeTreeUpdateVisibility(node, host_window)
+	g.DockNodeTreeUpdatePosSize(node, node.Pos, node.Size)
+	g.DockNodeTreeUpdateSplitter(node)
+	g.DockNodeTreeUpdateLeaves(node)
+}
+
+func (g *ImGuiContext) DockNodeTreeUpdateLeaves(node *ImGuiDockNode) {
+	if !node.IsVisible {
+		return
+	}
+	if node.IsSplitNode() {
+		g.DockNodeTreeUpdateLeaves(node.ChildNodes[0])
+		g.DockNodeTreeUpdateLeaves(node.ChildNodes[1])
+		return
+	}
+
+	var host_window = node.HostWindow
+	if g.DockNodeCountAliveWindows(node) == 0 {
+		node.VisibleWindow = nil
+
+		// Empty central node: either let the inputs pass through to the windows behind, or draw its background
+		if node.IsCentralNode() {
+			if node.GetMergedFlags()&ImGuiDockNodeFlags_PassthruCentralNode != 0 {
+				SetWindowHitTestHole(host_window, &node.Pos, &node.Size)
+				if host_window.Flags&ImGuiWindowFlags_ChildWindow != 0 && host_window.ParentWindow != nil && host_window.ParentWindow.HitTestHoleSize.x == 0 {
+					SetWindowHitTestHole(host_window.ParentWindow, &node.Pos, &node.Size)
+				}
+			} else {
+				var r = node.Rect()
+				host_window.DrawList.AddRectFilled(r.Min, r.Max, g.GetColorU32FromID(ImGuiCol_DockingEmptyBg, 1), 0, 0)
+			}
+		}
+		return
+	}
+
+	g.DockNodeUpdateTabBar(node, host_window)
+}
+
+func (g *ImGuiContext) DockNodeUpdateTabBar(node *ImGuiDockNode, host_window *ImGuiWindow) {
+	if node.TabBar == nil {
+		var tab_bar = NewImGuiTabBar()
+		node.TabBar = &tab_bar
+	}
+	var tab_bar = node.TabBar
+
+	// Keep the tab of the focused window selected
+	var is_focused = g.NavWindow != nil && g.NavWindow.RootWindow.DockNode == node
+	if is_focused && node.LastFocusedTabId != g.NavWindow.RootWindow.TabId {
+		node.LastFocusedTabId = g.NavWindow.RootWindow.TabId
+		tab_bar.NextSelectedTabId = node.LastFocusedTabId
+	}
+
+	// Restore the selection saved in the .ini file
+	if tab_bar.SelectedTabId == 0 && tab_bar.NextSelectedTabId == 0 && node.SelectedTabId != 0 {
+		tab_bar.NextSelectedTabId = node.SelectedTabId
+	}
+
+	var tab_bar_height = g.DockNodeCalcTabBarHeight(node)
+	if tab_bar_height > 0.0 {
+		var bar_rect = ImRect{node.Pos, ImVec2{node.Pos.x + node.Size.x, node.Pos.y + tab_bar_height}}
+		var title_bar_col = ImGuiCol_TitleBg
+		if is_focused {
+			title_bar_col = ImGuiCol_TitleBgActive
+		}
+		host_window.DrawList.AddRectFilled(bar_rect.Min, bar_rect.Max, g.GetColorU32FromID(title_bar_col, 1), 0, 0)
+
+		// Dock node tab bars keep their names buffer across frames (the layout happens before the tabs are submitted):
+		// rebuild it from the windows of the node so it doesn't grow
+		tab_bar.TabsNames = tab_bar.TabsNames[:0]
+		for n := range tab_bar.Tabs {
+			var tab = &tab_bar.Tabs[n]
+			var name string
+			for _, window := range node.Windows {
+				if window.TabId == tab.ID {
+					name = window.Name
+					break
+				}
+			}
+			tab.NameOffset = int(len(tab_bar.TabsNames))
+			tab_bar.TabsNames = append(tab_bar.TabsNames, name)
+		}
+		var tab_bar_flags = ImGuiTabBarFlags_Reorderable | ImGuiTabBarFlags_AutoSelectNewTabs | ImGuiTabBarFlags_DockNode
+		if is_focused {
+			tab_bar_flags |= ImGuiTabBarFlags_IsFocused
+		}
+		var select_tab_id = tab_bar.NextSelectedTabId
+		if g.BeginTabBarEx(tab_bar, &bar_rect, tab_bar_flags) {
+			for _, window := range node.Windows {
+				if !g.DockNodeIsWindowAlive(window) {
+					continue
+				}
+				var tab_item_flags ImGuiTabItemFlags
+				if window.TabId == select_tab_id {
+					tab_item_flags |= ImGuiTabItemFlags_SetSelected // Wins over the automatic selection of new tabs
+				}
+				if window.Flags&ImGuiWindowFlags_UnsavedDocument != 0 {
+					tab_item_flags |= ImGuiTabItemFlags_UnsavedDocument
+				}
+				var open = true
+				var p_open *bool
+				if window.HasCloseButton {
+					p_open = &open
+				}
+				g.TabItemEx(tab_bar, window.Name, p_open, tab_item_flags)
+				if !open {
+					node.WantCloseTabId = window.TabId
+				}
+
+				if g.ActiveId == window.TabId {
+					// Clicking a tab focuses its window, without losing the tab active id (so the tab can be dragged)
+					if g.ActiveIdIsJustActivated {
+						g.ActiveIdNoClearOnFocusLoss = true
+						g.FocusWindow(window)
+					}
+
+					// Dragging a tab away from the tab bar undocks its window
+					if g.IsMouseDragging(0, -1) {
+						var undock_rect = bar_rect
+						undock_rect.ExpandVec(ImVec2{g.FontSize * 2.2, g.FontSize * 1.5})
+						if !undock_rect.ContainsVec(g.IO.MousePos) {
+							DockContextQueueUndockWindow(g, window)
+						}
+					}
+				}
+			}
+			g.EndTabBar()
+		}
+
+		// Windows are kept in tab order (this is also the order saved in the .ini file)
+		var tab_order = func(window *ImGuiWindow) golang.Int {
+			for n := range tab_bar.Tabs {
+				if tab_bar.Tabs[n].ID == window.TabId {
+					return n
+				}
+			}
+			return len(tab_bar.Tabs)
+		}
+		sort.SliceStable(node.Windows, func(i, j golang.Int) bool {
+			return tab_order(node.Windows[i]) < tab_order(node.Windows[j])
+		})
+		for n, window := range node.Windows {
+			window.DockOrder = short(n)
+		}
+	}
+
+	// Select the visible window
+	var visible_tab_id = tab_bar.VisibleTabId
+	if visible_tab_id == 0 {
+		visible_tab_id = tab_bar.SelectedTabId
+	}
+	if visible_tab_id == 0 {
+		visible_tab_id = node.SelectedTabId // The tab bar selection isn't settled yet on the frame its tabs are added
+	}
+	node.VisibleWindow = nil
+	for _, window := range node.Windows {
+		if g.DockNodeIsWindowAlive(window) && (tab_bar_height == 0.0 || window.TabId == visible_tab_id) {
+			node.VisibleWindow = window
+			break
+		}
+	}
+	if node.VisibleWindow == nil {
+		for _, window := range node.Windows {
+			if g.DockNodeIsWindowAlive(window) {
+				node.VisibleWindow = window
+				break
+			}
+		}
+	}
+	if tab_bar.SelectedTabId != 0 && node.SelectedTabId != tab_bar.SelectedTabId {
+		node.SelectedTabId = tab_bar.SelectedTabId
+		g.MarkIniSettingsDirty()
+	}
+}
+
+//-----------------------------------------------------------------------------
+// Docking: Begin() integration
+//-----------------------------------------------------------------------------
+
+// DockWindowIsDockable returns true for regular windows which can be docked or be docked into.
+func DockWindowIsDockable(window *ImGuiWindow) bool {
+	return window.Flags&(ImGuiWindowFlags_NoDocking|ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_Popup|ImGuiWindowFlags_Tooltip|ImGuiWindowFlags_Modal|ImGuiWindowFlags_ChildMenu|ImGuiWindowFlags_DockNodeHost) == 0
+}
+
+// SetWindowDock sets the dock node of a window, which is bound to it on its next Begin().
+func (g *ImGuiContext) SetWindowDock(window *ImGuiWindow, dock_id ImGuiID, cond ImGuiCond) {
+	// Test condition (NB: bit 0 is always true) and clear flags for next time
+	if cond != 0 && (window.SetWindowDockAllowFlags&cond) == 0 {
+		return
+	}
+	window.SetWindowDockAllowFlags &= ^(ImGuiCond_Once | ImGuiCond_FirstUseEver | ImGuiCond_Appearing)
+
+	if window.DockId == dock_id {
+		return
+	}
+
+	// If the user attempt to set a dock id that is a split node, we'll dig within to find a suitable docking spot
+	if new_node := DockContextFindNodeByID(g, dock_id); new_node != nil && new_node.IsSplitNode() {
+		dock_id = DockNodeTreeFindFallbackLeafNode(DockNodeGetRootNode(new_node)).ID
+		if window.DockId == dock_id {
+			return
+		}
+	}
+
+	if window.DockNode != nil {
+		g.DockNodeRemoveWindow(window.DockNode, window, 0)
+	}
+	window.DockId = dock_id
+}
+
+// BeginDocked binds a window to its dock node, called from Begin() on the first call of the frame.
+// When the node is visible this frame, the window is placed in the node and the returned flags turn it into a child of the node host window.
+func (g *ImGuiContext) BeginDocked(window *ImGuiWindow, p_open *bool, flags ImGuiWindowFlags) ImGuiWindowFlags {
+	window.DockIsActive = false
+	window.DockTabIsVisible = false
+	if g.IO.ConfigFlags&ImGuiConfigFlags_DockingEnable == 0 {
+		return flags
+	}
+	if flags&(ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_Popup|ImGuiWindowFlags_Tooltip|ImGuiWindowFlags_DockNodeHost) != 0 {
+		return flags
+	}
+	if flags&ImGuiWindowFlags_NoDocking != 0 {
+		if window.DockNode != nil {
+			g.DockNodeRemoveWindow(window.DockNode, window, 0)
+		}
+		window.DockId = 0
+		return flags
+	}
+
+	// Bind to our dock node
+	var node = window.DockNode
+	if node == nil && window.DockId != 0 {
+		node = DockContextFindNodeByID(g, window.DockId)
+		if node == nil {
+			// Create a floating node for an id that isn't known yet (e.g. from SetNextWindowDockID())
+			node = DockContextAddNode(g, window.DockId)
+			node.Pos = window.Pos
+			node.Size = window.SizeFull
+			node.SizeRef = node.Size
+		}
+		if node.IsSplitNode() {
+			node = DockNodeTreeFindFallbackLeafNode(node)
+		}
+		g.DockNodeAddWindow(node, window)
+	}
+	if node == nil {
+		return flags
+	}
+
+	// Handle a close request from the tab bar
+	if node.WantCloseTabId == window.TabId {
+		node.WantCloseTabId = 0
+		if p_open != nil {
+			*p_open = false
+		}
+	}
+
+	// The node wasn't updated this frame (e.g. DockSpace() not submitted yet or kept alive only): hide the window
+	if node.LastFrameActive != g.FrameCount || !node.IsVisible || node.HostWindow == nil || !node.HostWindow.Active {
+		window.HiddenFramesCanSkipItems = 2
+		return flags
+	}
+
+	window.DockIsActive = true
+	window.DockTabIsVisible = node.VisibleWindow == window
+	window.Collapsed = false
+
+	// Position the window below the node tab bar
+	var tab_bar_height = g.DockNodeCalcTabBarHeight(node)
+	var pos = ImVec2{node.Pos.x, node.Pos.y + tab_bar_height}
+	var size = ImVec2{ImMax(node.Size.x, 1.0), ImMax(node.Size.y-tab_bar_height, 1.0)}
+	g.SetNextWindowPos(&pos, ImGuiCond_Always, ImVec2{})
+	g.SetNextWindowSize(&size, ImGuiCond_Always)
+	if !window.DockTabIsVisible {
+		window.HiddenFramesCanSkipItems = 2
+	}
+
+	flags |= ImGuiWindowFlags_ChildWindow | ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoResize | ImGuiWindowFlags_NoCollapse | ImGuiWindowFlags_AlwaysUseWindowPadding
+	flags &= ^ImGuiWindowFlags_AlwaysAutoResize
+	return flags
+}
+
+//-----------------------------------------------------------------------------
+// Docking: Drag and drop preview
+//-----------------------------------------------------------------------------
+
+// DockContextNewFrameUpdateDropTarget finds the docking target under the window being moved, draws the preview and queues the docking on release.
+func DockContextNewFrameUpdateDropTarget(ctx *ImGuiContext) {
+	var g = ctx
+	var dc = &g.DockContext
+	dc.Preview = ImGuiDockPreviewData{}
+	if g.IO.ConfigFlags&ImGuiConfigFlags_DockingEnable == 0 || g.MovingWindow == nil || g.MovingWindow.RootWindowDockTree == nil {
+		return
+	}
+
+	// The payload is either a loose window or a floating dock node moved as a whole
+	var payload = g.MovingWindow.RootWindowDockTree
+	if !DockWindowIsDockable(payload) && (payload.Flags&ImGuiWindowFlags_DockNodeHost == 0 || payload.DockNodeAsHost == nil || !payload.DockNodeAsHost.IsFloatingNode()) {
+		return
+	}
+	if g.IO.ConfigDockingWithShift != g.IO.KeyShift {
+		return
+	}
+
+	// Find the target under the mouse: a dock node (docked window or empty dockspace) or a loose window
+	var target_window *ImGuiWindow
+	var target_node *ImGuiDockNode
+	for window := g.HoveredWindowUnderMovingWindow; window != nil; window = window.ParentWindow {
+		if window.DockIsActive {
+			target_node = window.DockNode
+			break
+		}
+		if window.Flags&ImGuiWindowFlags_DockNodeHost != 0 {
+			if window.DockNodeAsHost != nil {
+				target_node = DockNodeTreeFindVisibleNodeByPos(window.DockNodeAsHost, g.IO.MousePos)
+			}
+			break
+		}
+		if window.Flags&(ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_Popup) == 0 {
+			if DockWindowIsDockable(window) && window != payload {
+				target_window = window
+			}
+			break
+		}
+	}
+	if target_node == nil && target_window == nil {
+		return
+	}
+
+	var data = &dc.Preview
+	data.Payload = payload
+	data.TargetWindow = target_window
+	data.TargetNode = target_node
+	ctx.DockNodePreviewDockSetup(data, g.IO.MousePos)
+	ctx.DockNodePreviewDockRender(data)
+
+	// Queue the docking request on release
+	if data.IsDropAllowed && !g.IO.MouseDown[0] {
+		DockContextQueueDock(ctx, target_window, target_node, payload, data.SplitDir, data.SplitRatio, data.OuterDocking)
+	}
+}
+
+func (g *ImGuiContext) DockNodePreviewDockSetup(data *ImGuiDockPreviewData, mouse_pos ImVec2) {
+	var target_node = data.TargetNode
+
+	var title_rect ImRect
+	var merged_flags ImGuiDockNodeFlags
+	if target_node != nil {
+		data.TargetRect = target_node.Rect()
+		title_rect = ImRect{target_node.Pos, ImVec2{target_node.Pos.x + target_node.Size.x, target_node.Pos.y + g.DockNodeCalcTabBarHeight(target_node)}}
+		merged_flags = target_node.GetMergedFlags()
+	} else {
+		data.TargetRect = data.TargetWindow.Rect()
+		title_rect = data.TargetWindow.TitleBarRect()
+	}
+
+	data.IsCenterAvailable = !(target_node != nil && target_node.IsCentralNode() && merged_flags&ImGuiDockNodeFlags_NoDockingInCentralNode != 0)
+	data.IsSidesAvailable = !g.IO.ConfigDockingNoSplit && merged_flags&ImGuiDockNodeFlags_NoSplit == 0
+	data.IsOuterAvailable = false
+	if target_node != nil && data.IsSidesAvailable {
+		var root_node = DockNodeGetRootNode(target_node)
+		data.IsOuterAvailable = root_node != target_node && root_node.IsSplitNode() && root_node.GetMergedFlags()&ImGuiDockNodeFlags_NoSplit == 0
+	}
+
+	// Hit test the drop markers
+	data.SplitDir = ImGuiDir_None
+	data.IsDropAllowed = false
+	for dir := ImGuiDir_None; dir < ImGuiDir_COUNT; dir++ {
+		if (dir == ImGuiDir_None && !data.IsCenterAvailable) || (dir != ImGuiDir_None && !data.IsSidesAvailable) {
+			continue
+		}
+		if g.DockNodeCalcDropRectsAndTestMousePos(&data.TargetRect, dir, &data.DropRects[dir+1], false, &mouse_pos) {
+			data.SplitDir = dir
+			data.IsDropAllowed = true
+		}
+	}
+	data.OuterDocking = false
+	if data.IsOuterAvailable && !data.IsDropAllowed {
+		var root_rect = DockNodeGetRootNode(target_node).Rect()
+		for dir := ImGuiDir_Left; dir < ImGuiDir_COUNT; dir++ {
+			if g.DockNodeCalcDropRectsAndTestMousePos(&root_rect, dir, &data.OuterDropRects[dir+1], true, &mouse_pos) {
+				data.SplitDir = dir
+				data.IsDropAllowed = true
+				data.OuterDocking = true
+			}
+		}
+	}
+
+	// Hovering the tab bar or title bar docks as a new tab
+	if !data.IsDropAllowed && data.IsCenterAvailable && title_rect.ContainsVec(mouse_pos) {
+		data.SplitDir = ImGuiDir_None
+		data.IsDropAllowed = true
+	}
+
+	// Split ratio: the payload keeps its size, up to half of the target
+	data.SplitRatio = 0.0
+	if data.SplitDir != ImGuiDir_None {
+		var split_axis = ImGuiAxis_Y
+		if data.SplitDir == ImGuiDir_Left || data.SplitDir == ImGuiDir_Right {
+			split_axis = ImGuiAxis_X
+		}
+		var target_rect = data.TargetRect
+		if data.OuterDocking {
+			target_rect = DockNodeGetRootNode(target_node).Rect()
+		}
+		var target_size = target_rect.GetSize().Axis(split_axis)
+		var payload_size = data.Payload.Size.Axis(split_axis)
+		if target_size > 0.0 {
+			data.SplitRatio = ImClamp(ImMin(payload_size, target_size*0.5)/target_size, 0.1, 0.5)
+		} else {
+			data.SplitRatio = 0.5
+		}
+	}
+}
+
+func (g *ImGuiContext) DockNodePreviewDockRender(data *ImGuiDockPreviewData) {
+	var overlay_draw_list = g.GetForegroundDrawList(nil)
+
+	// Preview the area the payload will take
+	if data.IsDropAllowed {
+		var r = data.TargetRect
+		if data.OuterDocking {
+			r = DockNodeGetRootNode(data.TargetNode).Rect()
+		}
+		switch data.SplitDir {
+		case ImGuiDir_Left:
+			r.Max.x = r.Min.x + r.GetWidth()*data.SplitRatio
+		case ImGuiDir_Right:
+			r.Min.x = r.Max.x - r.GetWidth()*data.SplitRatio
+		case ImGuiDir_Up:
+			r.Max.y = r.Min.y + r.GetHeight()*data.SplitRatio
+		case ImGuiDir_Down:
+			r.Min.y = r.Max.y - r.GetHeight()*data.SplitRatio
+		}
+		overlay_draw_list.AddRectFilled(r.Min, r.Max, g.GetColorU32FromID(ImGuiCol_DockingPreview, 1), g.Style.WindowRounding, 0)
+	}
+
+	// Drop markers
+	var col_marker = g.GetColorU32FromID(ImGuiCol_Button, 1)
+	var col_marker_hovered = g.GetColorU32FromID(ImGuiCol_ButtonHovered, 1)
+	var col_lines = g.GetColorU32FromID(ImGuiCol_NavWindowingHighlight, 0.60)
+	for dir := ImGuiDir_None; dir < ImGuiDir_COUNT; dir++ {
+		if (dir == ImGuiDir_None && !data.IsCenterAvailable) || (dir != ImGuiDir_None && !data.IsSidesAvailable) {
+			continue
+		}
+		var r = data.DropRects[dir+1]
+		var col = col_marker
+		if data.IsDropAllowed && !data.OuterDocking && data.SplitDir == dir {
+			col = col_marker_hovered
+		}
+		overlay_draw_list.AddRectFilled(r.Min, r.Max, col, g.Style.FrameRounding, 0)
+		overlay_draw_list.AddRect(r.Min, r.Max, col_lines, g.Style.FrameRounding, 0, 1.0)
+	}
+	if data.IsOuterAvailable {
+		for dir := ImGuiDir_Left; dir < ImGuiDir_COUNT; dir++ {
+			var r = data.OuterDropRects[dir+1]
+			var col = col_marker
+			if data.IsDropAllowed && data.OuterDocking && data.SplitDir == dir {
+				col = col_marker_hovered
+			}
+			overlay_draw_list.AddRectFilled(r.Min, r.Max, col, g.Style.FrameRounding, 0)
+			overlay_draw_list.AddRect(r.Min, r.Max, col_lines, g.Style.FrameRounding, 0, 1.0)
+		}
+	}
+}
+
+// DockNodeCalcDropRectsAndTestMousePos computes the drop marker for a direction, and optionally tests the mouse position against it.
+// Outer docking markers are placed near the edges of the root node rather than around its center.
+func (g *ImGuiContext) DockNodeCalcDropRectsAndTestMousePos(parent *ImRect, dir ImGuiDir, out_r *ImRect, outer_docking bool, test_mouse_pos *ImVec2) bool {
+	var parent_smaller_axis = ImMin(parent.GetWidth(), parent.GetHeight())
+	var hs_for_central_nodes = ImMin(g.FontBaseSize*1.5, ImMax(g.FontBaseSize*0.5, parent_smaller_axis/8.0))
+	var hs_w float // Half-size, longer axis
+	var hs_h float // Half-size, smaller axis
+	var off ImVec2 // Distance from edge or center
+	if outer_docking {
+		hs_w = ImFloor(hs_for_central_nodes * 1.50)
+		hs_h = ImFloor(hs_for_central_nodes * 0.80)
+		off = ImVec2{ImFloor(parent.GetWidth()*0.5 - hs_h), ImFloor(parent.GetHeight()*0.5 - hs_h)}
+	} else {
+		hs_w = ImFloor(hs_for_central_nodes)
+		hs_h = ImFloor(hs_for_central_nodes * 0.90)
+		off = ImVec2{ImFloor(hs_w * 2.40), ImFloor(hs_w * 2.40)}
+	}
+
+	var center = parent.GetCenter()
+	var c = *ImFloorVec(&center)
+	switch dir {
+	case ImGuiDir_None:
+		*out_r = ImRect{ImVec2{c.x - hs_w, c.y - hs_w}, ImVec2{c.x + hs_w, c.y + hs_w}}
+	case ImGuiDir_Up:
+		*out_r = ImRect{ImVec2{c.x - hs_w, c.y - off.y - hs_h}, ImVec2{c.x + hs_w, c.y - off.y + hs_h}}
+	case ImGuiDir_Down:
+		*out_r = ImRect{ImVec2{c.x - hs_w, c.y + off.y - hs_h}, ImVec2{c.x + hs_w, c.y + off.y + hs_h}}
+	case ImGuiDir_Left:
+		*out_r = ImRect{ImVec2{c.x - off.x - hs_h, c.y - hs_w}, ImVec2{c.x - off.x + hs_h, c.y + hs_w}}
+	case ImGuiDir_Right:
+		*out_r = ImRect{ImVec2{c.x + off.x - hs_h, c.y - hs_w}, ImVec2{c.x + off.x + hs_h, c.y + hs_w}}
+	}
+
+	if test_mouse_pos == nil {
+		return false
+	}
+
+	var hit_r = *out_r
+	if !outer_docking {
+		// Custom hit testing for the 5-way selection, designed to reduce flickering when moving diagonally between sides
+		hit_r.Expand(ImFloor(hs_w * 0.30))
+		var mouse_delta = test_mouse_pos.Sub(c)
+		var mouse_delta_len2 = ImLengthSqrVec2(mouse_delta)
+		var r_threshold_center = hs_w * 1.4
+		var r_threshold_sides = hs_w * (1.4 + 1.2)
+		if mouse_delta_len2 < r_threshold_center*r_threshold_center {
+			return dir == ImGuiDir_None
+		}
+		if mouse_delta_len2 < r_threshold_sides*r_threshold_sides {
+			return dir == ImGetDirQuadrantFromDelta(mouse_delta.x, mouse_delta.y)
+		}
+	}
+	return hit_r.ContainsVec(*test_mouse_pos)
+}
+
+//-----------------------------------------------------------------------------
+// Docking: Public API
+//-----------------------------------------------------------------------------
+
+// DockSpace creates an explicit dock node within the current window, and returns its id.
+// The size defaults to the available content region. DockSpace() needs to be submitted before any window it can host.
+func (g *ImGuiContext) DockSpace(id ImGuiID, size_arg ImVec2, flags ImGuiDockNodeFlags) ImGuiID {
+	if g.IO.ConfigFlags&ImGuiConfigFlags_DockingEnable == 0 {
+		return 0
+	}
+
+	IM_ASSERT(flags&ImGuiDockNodeFlags_DockSpace == 0)
+	IM_ASSERT(id != 0)
+
+	var node = DockContextFindNodeByID(g, id)
+	if node == nil {
+		node = DockContextAddNode(g, id)
+		node.LocalFlags |= ImGuiDockNodeFlags_CentralNode
+	}
+	node.SharedFlags = flags
+	node.LocalFlags |= ImGuiDockNodeFlags_DockSpace
+	node.LastFrameAlive = g.FrameCount
+
+	// Keep the node alive: windows docked into it stay docked, but hidden
+	if flags&ImGuiDockNodeFlags_KeepAliveOnly != 0 {
+		return id
+	}
+
+	var content_avail = g.GetContentRegionAvail()
+	var size = *ImFloorVec(&size_arg)
+	if size.x <= 0.0 {
+		size.x = ImMax(content_avail.x+size.x, 4.0) // Arbitrary minimum child size (0.0f causing too much issues)
+	}
+	if size.y <= 0.0 {
+		size.y = ImMax(content_avail.y+size.y, 4.0)
+	}
+	IM_ASSERT(size.x > 0.0 && size.y > 0.0)
+
+	var window_flags = ImGuiWindowFlags_DockNodeHost | ImGuiWindowFlags_NoCollapse | ImGuiWindowFlags_NoScrollbar | ImGuiWindowFlags_NoScrollWithMouse | ImGuiWindowFlags_NoBackground
+	g.PushStyleFloat(ImGuiStyleVar_ChildBorderSize, 0.0)
+	g.PushStyleVec(ImGuiStyleVar_WindowPadding, ImVec2{})
+	g.BeginChildEx("DockSpace", id, &size, false, window_flags)
+	g.PopStyleVar(2)
+
+	var host_window = g.CurrentWindow
+	node.Pos = host_window.Pos
+	node.Size = host_window.Size
+	if node.ParentNode == nil {
+		g.DockNodeUpdate(node)
+	}
+	g.EndChild()
+
+	return id
+}
+
+// DockSpaceOverViewport creates a window covering the viewport work area with a dockspace, and returns the dockspace id.
+// Pass nil to use the main viewport.
+func (g *ImGuiContext) DockSpaceOverViewport(viewport *ImGuiViewport, flags ImGuiDockNodeFlags) ImGuiID {
+	if viewport == nil {
+		viewport = g.GetMainViewport()
+	}
+
+	g.SetNextWindowPos(&viewport.WorkPos, 0, ImVec2{})
+	g.SetNextWindowSize(&viewport.WorkSize, 0)
+
+	var host_window_flags = ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoCollapse | ImGuiWindowFlags_NoResize | ImGuiWindowFlags_NoMove | ImGuiWindowFlags_NoDocking |
+		ImGuiWindowFlags_NoBringToFrontOnFocus | ImGuiWindowFlags_NoNavFocus | ImGuiWindowFlags_NoSavedSettings
+	if flags&ImGuiDockNodeFlags_PassthruCentralNode != 0 {
+		host_window_flags |= ImGuiWindowFlags_NoBackground
+	}
+
+	g.PushStyleFloat(ImGuiStyleVar_WindowRounding, 0.0)
+	g.PushStyleFloat(ImGuiStyleVar_WindowBorderSize, 0.0)
+	g.PushStyleVec(ImGuiStyleVar_WindowPadding, ImVec2{})
+	g.Begin("DockSpaceViewport", nil, host_window_flags)
+	g.PopStyleVar(3)
+
+	var dockspace_id = g.GetIDs("DockSpace")
+	g.DockSpace(dockspace_id, ImVec2{}, flags)
+	g.End()
+
+	return dockspace_id
+}
+
+// SetNextWindowDockID sets the dock node of the next window. Use a DockSpace() or DockBuilder node id.
+func (g *ImGuiContext) SetNextWindowDockID(dock_id ImGuiID, cond ImGuiCond) {
+	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasDock
+	if cond != 0 {
+		g.NextWindowData.DockCond = cond
+	} else {
+		g.NextWindowData.DockCond = ImGuiCond_Always
+	}
+	g.NextWindowData.DockId = dock_id
+}
+
+// GetWindowDockID returns the dock node id of the current window, or 0 when it isn't docked.
+func (g *ImGuiContext) GetWindowDockID() ImGuiID {
+	return g.CurrentWindow.DockId
+}
+
+// IsWindowDocked returns true when the current window is displayed in a dock node.
+func (g *ImGuiContext) IsWindowDocked() bool {
+	return g.CurrentWindow.DockIsActive
+}
+
+//-----------------------------------------------------------------------------
+// Docking: Settings
+//-----------------------------------------------------------------------------
+
+func DockSettingsHandler_ClearAll(ctx *ImGuiContext, _ *ImGuiSettingsHandler) {
+	var dc = &ctx.DockContext
+	dc.NodesSettings = dc.NodesSettings[:0]
+	DockContextClearNodes(ctx, true)
+}
+
+func DockSettingsHandler_ReadOpen(_ *ImGuiContext, _ *ImGuiSettingsHandler, name string) any {
+	if name != "Data" {
+		return nil
+	}
+	return name
+}
+
+func DockSettingsHandler_ReadLine(ctx *ImGuiContext, _ *ImGuiSettingsHandler, entry any, line string) {
+	if entry == nil {
+		return
+	}
+
+	var fields = strings.Fields(line)
+	if len(fields) == 0 {
+		return
+	}
+
+	// Parse "DockNode" or "DockSpace"
+	var node ImGuiDockNodeSettings
+	node.SplitAxis = ImGuiAxis_None
+	switch fields[0] {
+	case "DockNode":
+	case "DockSpace":
+		node.Flags |= ImGuiDockNodeFlags_DockSpace
+	default:
+		return
+	}
+
+	for _, field := range fields[1:] {
+		var x, y, i int
+		var id ImGuiID
+		var c rune
+		if n, _ := fmt.Sscanf(field, "ID=0x%X", &id); n == 1 {
+			node.ID = id
+		} else if n, _ := fmt.Sscanf(field, "Parent=0x%X", &id); n == 1 {
+			node.ParentNodeId = id
+		} else if n, _ := fmt.Sscanf(field, "SizeRef=%d,%d", &x, &y); n == 2 {
+			node.SizeRef = ImVec2ih{short(x), short(y)}
+		} else if n, _ := fmt.Sscanf(field, "Pos=%d,%d", &x, &y); n == 2 {
+			node.Pos = ImVec2ih{short(x), short(y)}
+		} else if n, _ := fmt.Sscanf(field, "Size=%d,%d", &x, &y); n == 2 {
+			node.Size = ImVec2ih{short(x), short(y)}
+		} else if n, _ := fmt.Sscanf(field, "Split=%c", &c); n == 1 {
+			if c == 'X' {
+				node.SplitAxis = ImGuiAxis_X
+			} else if c == 'Y' {
+				node.SplitAxis = ImGuiAxis_Y
+			}
+		} else if n, _ := fmt.Sscanf(field, "CentralNode=%d", &i); n == 1 && i != 0 {
+			node.Flags |= ImGuiDockNodeFlags_CentralNode
+		} else if n, _ := fmt.Sscanf(field, "NoResize=%d", &i); n == 1 && i != 0 {
+			node.Flags |= ImGuiDockNodeFlags_NoResize
+		} else if n, _ := fmt.Sscanf(field, "Selected=0x%X", &id); n == 1 {
+			node.SelectedTabId = id
+		}
+	}
+	if node.ID == 0 {
+		return
+	}
+
+	var dc = &ctx.DockContext
+	if node.ParentNodeId != 0 {
+		for i := range dc.NodesSettings {
+			if dc.NodesSettings[i].ID == node.ParentNodeId {
+				node.Depth = dc.NodesSettings[i].Depth + 1
+				break
+			}
+		}
+	}
+	dc.NodesSettings = append(dc.NodesSettings, node)
+}
+
+// DockSettingsHandler_ApplyAll builds the dock nodes from the settings read from the .ini file (parents are always listed before their children).
+func DockSettingsHandler_ApplyAll(ctx *ImGuiContext, _ *ImGuiSettingsHandler) {
+	var dc = &ctx.DockContext
+	for i := range dc.NodesSettings {
+		var settings = &dc.NodesSettings[i]
+		if DockContextFindNodeByID(ctx, settings.ID) != nil {
+			continue
+		}
+		var node = DockContextAddNode(ctx, settings.ID)
+		if settings.ParentNodeId != 0 {
+			// The extra children listed for a parent by a malformed .ini file are kept as root nodes, like the orphan ones
+			if parent_node := DockContextFindNodeByID(ctx, settings.ParentNodeId); parent_node != nil {
+				if parent_node.ChildNodes[0] == nil {
+					parent_node.ChildNodes[0] = node
+					node.ParentNode = parent_node
+				} else if parent_node.ChildNodes[1] == nil {
+					parent_node.ChildNodes[1] = node
+					node.ParentNode = parent_node
+				}
+			}
+		}
+		node.Pos = ImVec2{float(settings.Pos.x), float(settings.Pos.y)}
+		node.Size = ImVec2{float(settings.Size.x), float(settings.Size.y)}
+		node.SizeRef = ImVec2{float(settings.SizeRef.x), float(settings.SizeRef.y)}
+		node.SplitAxis = settings.SplitAxis
+		node.LocalFlags = settings.Flags & ImGuiDockNodeFlags_SavedFlagsMask_
+		node.SelectedTabId = settings.SelectedTabId
+	}
+
+	// A split node whose children weren't both saved is turned back into a leaf
+	for _, node := range dc.Nodes {
+		if (node.ChildNodes[0] == nil) != (node.ChildNodes[1] == nil) {
+			var child = node.ChildNodes[0]
+			if child == nil {
+				child = node.ChildNodes[1]
+			}
+			node.ChildNodes = [2]*ImGuiDockNode{}
+			node.SplitAxis = ImGuiAxis_None
+			child.ParentNode = nil
+		}
+	}
+}
+
+func DockSettingsHandler_DockNodeToSettings(dc *ImGuiDockContext, node *ImGuiDockNode, depth int) {
+	var node_settings ImGuiDockNodeSettings
+	IM_ASSERT(depth < (1 << 8))
+	node_settings.ID = node.ID
+	if node.ParentNode != nil {
+		node_settings.ParentNodeId = node.ParentNode.ID
+	}
+	if node.IsLeafNode() {
+		node_settings.SelectedTabId = node.SelectedTabId
+	}
+	node_settings.SplitAxis = node.SplitAxis
+	node_settings.Depth = depth
+	node_settings.Flags = node.LocalFlags & ImGuiDockNodeFlags_SavedFlagsMask_
+	node_settings.Pos = ImVec2ih{short(node.Pos.x), short(node.Pos.y)}
+	node_settings.Size = ImVec2ih{short(node.Size.x), short(node.Size.y)}
+	node_settings.SizeRef = ImVec2ih{short(node.SizeRef.x), short(node.SizeRef.y)}
+	dc.NodesSettings = append(dc.NodesSettings, node_settings)
+	if node.ChildNodes[0] != nil {
+		DockSettingsHandler_DockNodeToSettings(dc, node.ChildNodes[0], depth+1)
+	}
+	if node.ChildNodes[1] != nil {
+		DockSettingsHandler_DockNodeToSettings(dc, node.ChildNodes[1], depth+1)
+	}
+}
+
+func DockSettingsHandler_WriteAll(ctx *ImGuiContext, handler *ImGuiSettingsHandler, buf *ImGuiTextBuffer) {
+	var dc = &ctx.DockContext
+
+	// Gather settings data (roots in a stable order)
+	var roots []*ImGuiDockNode
+	for _, node := range dc.Nodes {
+		if node.IsRootNode() {
+			roots = append(roots, node)
+		}
+	}
+	sort.Slice(roots, func(i, j golang.Int) bool { return roots[i].ID < roots[j].ID })
+	dc.NodesSettings = dc.NodesSettings[:0]
+	for _, node := range roots {
+		DockSettingsHandler_DockNodeToSettings(dc, node, 0)
+	}
+	if len(dc.NodesSettings) == 0 {
+		return
+	}
+
+	// Write to text buffer
+	*buf = append(*buf, []byte(fmt.Sprintf("[%s][Data]\n", handler.TypeName))...)
+	for i := range dc.NodesSettings {
+		var node_settings = &dc.NodesSettings[i]
+		var line strings.Builder
+		line.WriteString(strings.Repeat(" ", golang.Int(node_settings.Depth*2)))
+		if node_settings.Flags&ImGuiDockNodeFlags_DockSpace != 0 {
+			line.WriteString("DockSpace")
+		} else {
+			line.WriteString("DockNode")
+		}
+		fmt.Fprintf(&line, " ID=0x%08X", node_settings.ID)
+		if node_settings.ParentNodeId != 0 {
+			fmt.Fprintf(&line, " Parent=0x%08X SizeRef=%d,%d", node_settings.ParentNodeId, node_settings.SizeRef.x, node_settings.SizeRef.y)
+		} else {
+			fmt.Fprintf(&line, " Pos=%d,%d Size=%d,%d", node_settings.Pos.x, node_settings.Pos.y, node_settings.Size.x, node_settings.Size.y)
+		}
+		if node_settings.SplitAxis == ImGuiAxis_X {
+			line.WriteString(" Split=X")
+		} else if node_settings.SplitAxis == ImGuiAxis_Y {
+			line.WriteString(" Split=Y")
+		}
+		if node_settings.Flags&ImGuiDockNodeFlags_CentralNode != 0 {
+			line.WriteString(" CentralNode=1")
+		}
+		if node_settings.Flags&ImGuiDockNodeFlags_NoResize != 0 {
+			line.WriteString(" NoResize=1")
+		}
+		if node_settings.SelectedTabId != 0 {
+			fmt.Fprintf(&line, " Selected=0x%08X", node_settings.SelectedTabId)
+		}
+		line.WriteString("\n")
+		*buf = append(*buf, []byte(line.String())...)
+	}
+	*buf = append(*buf, []byte("\n")...)
+}
@@ -0,0 +1,213 @@
+package imgui
+
+import (
+	"strings"
+	"testing"
+)
+
+func newDockingTestContext() *ImGuiContext {
+	return newTestContext(func(io *ImGuiIO) {
+		io.DisplaySize = ImVec2{800, 600}
+		io.ConfigFlags |= ImGuiConfigFlags_DockingEnable
+		io.MouseDoubleClickTime = 0
+	})
+}
+
+func TestDockBuilderLayout(t *testing.T) {
+	var ctx = newDockingTestContext()
+	defer DestroyContext(ctx)
+
+	var dockspace_id, left_id, main_id ImGuiID
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{0, 0}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{800, 600}, ImGuiCond_Always)
+			ui.Begin("Host", nil, ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoDocking)
+			dockspace_id = ui.GetIDs("DockSpace")
+			if ui.DockBuilderGetNode(dockspace_id) == nil {
+				ui.DockBuilderAddNode(dockspace_id, ImGuiDockNodeFlags_DockSpace)
+				ui.DockBuilderSetNodeSize(dockspace_id, ImVec2{800, 600})
+				main_id = dockspace_id
+				ui.DockBuilderSplitNode(main_id, ImGuiDir_Left, 0.25, &left_id, &main_id)
+				ui.DockBuilderDockWindow("Tools", left_id)
+				ui.DockBuilderDockWindow("Document", main_id)
+				ui.DockBuilderDockWindow("Other", main_id)
+				ui.DockBuilderFinish(dockspace_id)
+			}
+			ui.DockSpace(dockspace_id, ImVec2{}, 0)
+			ui.End()
+
+			for _, name := range []string{"Tools", "Document", "Other"} {
+				ui.Begin(name, nil, 0)
+				ui.Text(name)
+				ui.End()
+			}
+		})
+	}
+	for i := 0; i < 3; i++ {
+		frame()
+	}
+
+	var ui = ctx.Lock()
+	var tools, document, other = FindWindowByName("Tools"), FindWindowByName("Document"), FindWindowByName("Other")
+	if !tools.DockIsActive || !document.DockIsActive || !other.DockIsActive {
+		ui.Unlock()
+		t.Fatalf("windows not docked: Tools=%v Document=%v Other=%v", tools.DockIsActive, document.DockIsActive, other.DockIsActive)
+	}
+	if tools.DockNode.ID != left_id || document.DockNode.ID != main_id || other.DockNode != document.DockNode {
+		t.Errorf("windows docked into the wrong nodes")
+	}
+	if document.DockTabIsVisible == other.DockTabIsVisible {
+		t.Errorf("exactly one of the tabs sharing a node should be visible")
+	}
+	if tools.Pos.x > 10 || tools.Size.x < 150 || tools.Size.x > 250 {
+		t.Errorf("left node at x=%v with width %v, want about a quarter of the dockspace", tools.Pos.x, tools.Size.x)
+	}
+	if document.Pos.x < tools.Pos.x+tools.Size.x {
+		t.Errorf("main node at x=%v overlaps the left node ending at %v", document.Pos.x, tools.Pos.x+tools.Size.x)
+	}
+	if central := ui.DockBuilderGetCentralNode(dockspace_id); central == nil || central.ID != main_id {
+		t.Errorf("central node is %v, want the node opposite to the split direction", central)
+	}
+
+	// The layout is persisted and restored in a new context
+	var ini = string(ui.SaveIniSettingsToMemory(nil))
+	for _, want := range []string{"[Docking][Data]", "DockSpace ID=", "Split=X", "CentralNode=1", "DockId="} {
+		if !strings.Contains(ini, want) {
+			t.Errorf("saved settings are missing %q:\n%s", want, ini)
+		}
+	}
+	ui.Unlock()
+
+	var ctx2 = newDockingTestContext()
+	defer DestroyContext(ctx2)
+	var ui2 = ctx2.Lock()
+	ui2.LoadIniSettingsFromMemory([]byte(ini), 0)
+	var node = ui2.DockBuilderGetNode(dockspace_id)
+	if node == nil || !node.IsSplitNode() || node.SplitAxis != ImGuiAxis_X || node.ChildNodes[0].ID != left_id {
+		ui2.Unlock()
+		t.Fatalf("dock tree not restored from:\n%s", ini)
+	}
+	if settings := FindWindowSettings(ImHashStr("Document", 0, 0)); settings == nil || settings.DockId != main_id {
+		t.Errorf("window settings not bound to the restored node")
+	}
+	ui2.Unlock()
+}
+
+func TestDockDragAndUndock(t *testing.T) {
+	var ctx = newDockingTestContext()
+	defer DestroyContext(ctx)
+
+	var ui = ctx.Lock()
+	var io = ui.GetIO()
+	ui.Unlock()
+
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{50, 50}, ImGuiCond_FirstUseEver, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{300, 300}, ImGuiCond_FirstUseEver)
+			ui.Begin("A", nil, 0)
+			ui.Text("A")
+			ui.End()
+			ui.SetNextWindowPos(&ImVec2{450, 50}, ImGuiCond_FirstUseEver, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{200, 200}, ImGuiCond_FirstUseEver)
+			ui.Begin("B", nil, 0)
+			ui.Text("B")
+			ui.End()
+		})
+	}
+	var drag = func(from, to ImVec2) {
+		mouseDrag(io, frame, from, to, 5)
+		frame()
+		frame()
+	}
+	frame()
+	frame()
+
+	// Drop B on the center marker of A: both windows share a tab bar
+	drag(ImVec2{500, 55}, ImVec2{200, 200})
+	ui = ctx.Lock()
+	var a, b = FindWindowByName("A"), FindWindowByName("B")
+	if a.DockNode == nil || a.DockNode != b.DockNode || !a.DockIsActive || !b.DockIsActive {
+		ui.Unlock()
+		t.Fatalf("B dropped on A: not docked together")
+	}
+	var node = a.DockNode
+	if !b.DockTabIsVisible || node.TabBar == nil || len(node.TabBar.Tabs) != 2 {
+		t.Errorf("B should be the selected tab of a two tabs node")
+	}
+	var tab_b ImVec2
+	for _, tab := range node.TabBar.Tabs {
+		if tab.ID == b.TabId {
+			tab_b = ImVec2{node.TabBar.BarRect.Min.x + tab.Offset + tab.Width*0.5, node.TabBar.BarRect.GetCenter().y}
+		}
+	}
+	ui.Unlock()
+
+	// Drag the tab of B away from the tab bar: B is undocked and the node dissolves
+	drag(tab_b, ImVec2{600, 450})
+	ui = ctx.Lock()
+	if b.DockNode != nil || b.DockIsActive {
+		t.Errorf("B dragged out of the tab bar: still docked")
+	}
+	if a.DockNode != nil || a.DockIsActive || len(ctx.DockContext.Nodes) != 0 {
+		t.Errorf("node left with a single window should be dissolved, %d nodes left", len(ctx.DockContext.Nodes))
+	}
+	if b.Pos.y < 300 {
+		t.Errorf("undocked window should follow the mouse, at %v", b.Pos)
+	}
+	ui.Unlock()
+
+	// Drop A on the right marker of B: the node is split
+	ui = ctx.Lock()
+	var b_rect = b.Rect()
+	var right_marker ImRect
+	DockNodeCalcDropRectsAndTestMousePos(&b_rect, ImGuiDir_Right, &right_marker, false, nil)
+	ui.Unlock()
+	drag(ImVec2{a.Pos.x + 50, a.Pos.y + 5}, right_marker.GetCenter())
+	ui = ctx.Lock()
+	defer ui.Unlock()
+	if a.DockNode == nil || b.DockNode == nil || a.DockNode == b.DockNode {
+		t.Fatalf("A dropped on the right side of B: want two split nodes")
+	}
+	var root = DockNodeGetRootNode(a.DockNode)
+	if root.SplitAxis != ImGuiAxis_X || root.ChildNodes[0] != b.DockNode || root.ChildNodes[1] != a.DockNode {
+		t.Errorf("A should be docked on the right of B")
+	}
+}
+
+func TestDockSettingsExtraChildren(t *testing.T) {
+	var ctx = newDockingTestContext()
+	defer DestroyContext(ctx)
+
+	// A malformed .ini listing three children for a split node
+	var ini = "[Window][Extra]\nPos=100,100\nSize=200,200\nDockId=0x00000004,0\n\n" +
+		"[Docking][Data]\n" +
+		"DockSpace ID=0x00000001 Pos=0,0 Size=800,600 Split=X\n" +
+		"  DockNode ID=0x00000002 Parent=0x00000001 SizeRef=400,600\n" +
+		"  DockNode ID=0x00000003 Parent=0x00000001 SizeRef=400,600 CentralNode=1\n" +
+		"  DockNode ID=0x00000004 Parent=0x00000001 SizeRef=400,600\n"
+	var ui = ctx.Lock()
+	ui.LoadIniSettingsFromMemory([]byte(ini), 0)
+	var root, extra = ui.DockBuilderGetNode(0x1), ui.DockBuilderGetNode(0x4)
+	if root == nil || root.ChildNodes[0] == nil || root.ChildNodes[0].ID != 0x2 || root.ChildNodes[1] == nil || root.ChildNodes[1].ID != 0x3 {
+		t.Errorf("split node children not restored from:\n%s", ini)
+	}
+	if extra == nil || extra.ParentNode != nil {
+		t.Errorf("extra child node %+v, want a root node", extra)
+	}
+	ui.Unlock()
+
+	for i := 0; i < 3; i++ {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.Begin("Extra", nil, 0)
+			ui.Text("Extra")
+			ui.End()
+		})
+	}
+	ui = ctx.Lock()
+	if window := FindWindowByName("Extra"); window.DockNode == nil || window.DockNode.ID != 0x4 || window.DockNode.ParentNode != nil {
+		t.Errorf("window docked into %+v, want the re-rooted node 0x00000004", window.DockNode)
+	}
+	ui.Unlock()
+}
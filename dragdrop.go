@@ -1,8 +1,12 @@
 package imgui
 
+import (
+	"fmt"
+	"unsafe"
+)
+
 // BeginDragDropTargetCustom Drag and Drop
-func BeginDragDropTargetCustom(bb *ImRect, id ImGuiID) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginDragDropTargetCustom(bb *ImRect, id ImGuiID) bool {
 	if !g.DragDropActive {
 		return false
 	}
@@ -13,7 +17,7 @@ func BeginDragDropTargetCustom(bb *ImRect, id ImGuiID) bool {
 		return false
 	}
 	IM_ASSERT(id != 0)
-	if !IsMouseHoveringRect(bb.Min, bb.Max, true) || (id == g.DragDropPayload.SourceId) {
+	if !g.IsMouseHoveringRect(bb.Min, bb.Max, true) || (id == g.DragDropPayload.SourceId) {
 		return false
 	}
 	if window.SkipItems {
@@ -27,10 +31,9 @@ func BeginDragDropTargetCustom(bb *ImRect, id ImGuiID) bool {
 	return true
 }
 
-func ClearDragDrop() {
-	var g = GImGui
+func (g *ImGuiContext) ClearDragDrop() {
 	g.DragDropActive = false
-	g.DragDropPayload = ImGuiPayload{}
+	g.DragDropPayload = NewImGuiPayload()
 	g.DragDropAcceptFlags = ImGuiDragDropFlags_None
 	g.DragDropAcceptIdCurr = 0
 	g.DragDropAcceptIdPrev = 0
@@ -41,8 +44,25 @@ func ClearDragDrop() {
 	g.DragDropPayloadBufLocal = [len(g.DragDropPayloadBufLocal)]byte{}
 }
 
-func IsDragDropPayloadBeingAccepted() bool {
-	var g = GImGui
+// UpdateDragDropExternPaths starts an external drag and drop source for the paths queued by io.AddExternalDrop().
+// The mouse button is already released, so the payload is delivered on the next frame to the target which
+// accepted it on this frame, then elapses as any other payload whose source isn't submitted anymore.
+// Targets don't draw their default highlight for this one-frame preview.
+func (g *ImGuiContext) UpdateDragDropExternPaths() {
+	if g.DragDropExternPaths == nil {
+		return
+	}
+	var paths = g.DragDropExternPaths
+	g.DragDropExternPaths = nil
+
+	g.ClearDragDrop()
+	if g.BeginDragDropSource(ImGuiDragDropFlags_SourceExtern | ImGuiDragDropFlags_SourceNoPreviewTooltip | ImGuiDragDropFlags_AcceptNoDrawDefaultRect) {
+		SetDragDropPayloadItemsTContext(g, IMGUI_PAYLOAD_TYPE_FILES, paths, ImGuiCond_Always)
+		g.EndDragDropSource()
+	}
+}
+
+func (g *ImGuiContext) IsDragDropPayloadBeingAccepted() bool {
 	return g.DragDropActive && g.DragDropAcceptIdPrev != 0
 }
 
@@ -60,8 +80,7 @@ func IsDragDropPayloadBeingAccepted() bool {
 // If the item has no identifier:
 // - Currently always assume left mouse button.
 // call after submitting an item which may be dragged. when this return true, you can call SetDragDropPayload() + EndDragDropSource()
-func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginDragDropSource(flags ImGuiDragDropFlags) bool {
 	var window = g.CurrentWindow
 
 	// FIXME-DRAGDROP: While in the common-most "drag from non-zero active id" case we can tell the mouse button,
@@ -110,10 +129,10 @@ func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
 			// Rely on keeping other window.LastItemXXX fields intact.
 			source_id = window.GetIDFromRectangle(g.LastItemData.Rect)
 			g.LastItemData.ID = source_id
-			var is_hovered = ItemHoverable(&g.LastItemData.Rect, source_id)
+			var is_hovered = g.ItemHoverable(&g.LastItemData.Rect, source_id)
 			if is_hovered && g.IO.MouseClicked[mouse_button] {
-				SetActiveID(source_id, window)
-				FocusWindow(window)
+				g.SetActiveID(source_id, window)
+				g.FocusWindow(window)
 			}
 			if g.ActiveId == source_id { // Allow the underlying widget to display/return hovered during the mouse release frame, else we would get a flicker.
 				g.ActiveIdAllowOverlap = is_hovered
@@ -123,10 +142,10 @@ func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
 			return false
 		}
 		source_parent_id = window.IDStack[len(window.IDStack)-1]
-		source_drag_active = IsMouseDragging(mouse_button, -1)
+		source_drag_active = g.IsMouseDragging(mouse_button, -1)
 
 		// Disable navigation and key inputs while dragging + cancel existing request if any
-		SetActiveIdUsingNavAndKeys()
+		g.SetActiveIdUsingNavAndKeys()
 	} else {
 		window = nil
 		source_id = ImHashStr("#SourceExtern", uintptr(len("#SourceExtern")), 0)
@@ -136,7 +155,7 @@ func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
 	if source_drag_active {
 		if !g.DragDropActive {
 			IM_ASSERT(source_id != 0)
-			ClearDragDrop()
+			g.ClearDragDrop()
 			var payload = &g.DragDropPayload
 			payload.SourceId = source_id
 			payload.SourceParentId = source_parent_id
@@ -153,7 +172,7 @@ func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
 		if flags&ImGuiDragDropFlags_SourceNoPreviewTooltip == 0 {
 			// Target can request the Source to not display its tooltip (we use a dedicated flag to make this request explicit)
 			// We unfortunately can't just modify the source flags and skip the call to BeginTooltip, as caller may be emitting contents.
-			BeginTooltip()
+			g.BeginTooltip()
 			if g.DragDropAcceptIdPrev != 0 && (g.DragDropAcceptFlags&ImGuiDragDropFlags_AcceptNoPreviewTooltip != 0) {
 				var tooltip_window = g.CurrentWindow
 				tooltip_window.Hidden = true
@@ -173,44 +192,92 @@ func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
 
 // SetDragDropPayload Use 'cond' to choose to submit payload on drag start or every frame
 // type is a user defined string of maximum 32 characters. Strings starting with '_' are reserved for dear imgui internal types. Data is copied and held by imgui.
-func SetDragDropPayload(ptype string, data any, data_size uintptr, cond ImGuiCond) bool {
-	var g = GImGui
+func (g *ImGuiContext) SetDragDropPayload(ptype string, data any, data_size uintptr, cond ImGuiCond) bool {
+	var items_count int
+	if data != nil {
+		items_count = 1
+	}
+	return g.setDragDropPayload(ptype, data, data_size, items_count, cond)
+}
+
+func (g *ImGuiContext) setDragDropPayload(ptype string, data any, data_size uintptr, items_count int, cond ImGuiCond) bool {
 	var payload = &g.DragDropPayload
 	if cond == 0 {
 		cond = ImGuiCond_Always
 	}
 
 	IM_ASSERT(ptype != "")
-	IM_ASSERT_USER_ERROR(len(ptype) < len(payload.DataType), "Payload type can be at most 32 characters long")
+	IM_ASSERT_USER_ERROR(len(ptype) <= 32, "Payload type can be at most 32 characters long")
 	IM_ASSERT((data != nil && data_size > 0) || (data == nil && data_size == 0))
 	IM_ASSERT(cond == ImGuiCond_Always || cond == ImGuiCond_Once)
 	IM_ASSERT(payload.SourceId != 0) // Not called between BeginDragDropSource() and EndDragDropSource()
 
 	if cond == ImGuiCond_Always || payload.DataFrameCount == -1 {
 		// Copy payload
-		copy(payload.DataType[:], ptype[len(payload.DataType):])
+		payload.DataType = ptype
 		g.DragDropPayloadBufHeap = g.DragDropPayloadBufHeap[:0]
 		payload.Data = data
 		payload.DataSize = (int)(data_size)
+		payload.ItemsCount = items_count
 	}
 	payload.DataFrameCount = g.FrameCount
 
 	return (g.DragDropAcceptFrameCount == g.FrameCount) || (g.DragDropAcceptFrameCount == g.FrameCount-1)
 }
 
+// SetDragDropPayloadT is SetDragDropPayload() for a Go value, which is held as is (no copy through a byte buffer).
+// Targets get it back with AcceptDragDropPayloadT[T](), using the same type tag and type.
+// A nil interface value sets an empty payload, and a zero-size value such as struct{} counts as 1 byte.
+func SetDragDropPayloadT[T any](ptype string, value T, cond ImGuiCond) bool {
+	return SetDragDropPayloadTContext[T](GImGui, ptype, value, cond)
+}
+
+// SetDragDropPayloadTContext is SetDragDropPayloadT() for the context g.
+func SetDragDropPayloadTContext[T any](g *ImGuiContext, ptype string, value T, cond ImGuiCond) bool {
+	if any(value) == nil {
+		return g.SetDragDropPayload(ptype, nil, 0, cond)
+	}
+	var size = unsafe.Sizeof(value)
+	if size == 0 {
+		size = 1
+	}
+	return g.SetDragDropPayload(ptype, value, size, cond)
+}
+
+// SetDragDropPayloadItemsT sets a payload carrying several items, e.g. the selected items of a list.
+// The slice is copied, so the source may reorder its items while they are being dragged. Unless the source
+// draws its own preview, the tooltip shows a stack of the items and their count (see ImGuiDragDropFlags_SourceNoItemsPreview).
+// Targets get the items with AcceptDragDropPayloadItemsT[T]().
+func SetDragDropPayloadItemsT[T any](ptype string, items []T, cond ImGuiCond) bool {
+	return SetDragDropPayloadItemsTContext[T](GImGui, ptype, items, cond)
+}
+
+// SetDragDropPayloadItemsTContext is SetDragDropPayloadItemsT() for the context g.
+func SetDragDropPayloadItemsTContext[T any](g *ImGuiContext, ptype string, items []T, cond ImGuiCond) bool {
+	IM_ASSERT(len(items) > 0)
+	var data = append([]T(nil), items...)
+	var size = unsafe.Sizeof(data[0]) * uintptr(len(data))
+	if size == 0 {
+		size = uintptr(len(data))
+	}
+	return g.setDragDropPayload(ptype, data, size, int(len(data)), cond)
+}
+
 // EndDragDropSource only call EndDragDropSource() if BeginDragDropSource() returns true!
-func EndDragDropSource() {
-	var g = GImGui
+func (g *ImGuiContext) EndDragDropSource() {
 	IM_ASSERT(g.DragDropActive)
 	IM_ASSERT_USER_ERROR(g.DragDropWithinSource, "Not after a BeginDragDropSource()?")
 
-	if g.DragDropSourceFlags&ImGuiDragDropFlags_SourceNoPreviewTooltip != 0 {
-		EndTooltip()
+	if g.DragDropSourceFlags&ImGuiDragDropFlags_SourceNoPreviewTooltip == 0 {
+		if g.DragDropPayload.ItemsCount > 1 && g.DragDropSourceFlags&ImGuiDragDropFlags_SourceNoItemsPreview == 0 {
+			g.RenderDragDropItemsPreview(g.DragDropPayload.ItemsCount)
+		}
+		g.EndTooltip()
 	}
 
 	// Discard the drag if have not called SetDragDropPayload()
 	if g.DragDropPayload.DataFrameCount == -1 {
-		ClearDragDrop()
+		g.ClearDragDrop()
 	}
 	g.DragDropWithinSource = false
 }
@@ -220,8 +287,7 @@ func EndDragDropSource() {
 // 1) we use LastItemRectHoveredRect which handles items that pushes a temporarily clip rectangle in their code. Calling BeginDragDropTargetCustom(LastItemRect) would not handle them.
 // 2) and it's faster. as this code may be very frequently called, we want to early out as fast as we can.
 // Also note how the HoveredWindow test is positioned differently in both functions (in both functions we optimize for the cheapest early out case)
-func BeginDragDropTarget() bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginDragDropTarget() bool {
 	if !g.DragDropActive {
 		return false
 	}
@@ -255,10 +321,9 @@ func BeginDragDropTarget() bool {
 }
 
 // AcceptDragDropPayload accept contents of a given type. If ImGuiDragDropFlags_AcceptBeforeDelivery is set you can peek into the payload before the mouse button is released.
-func AcceptDragDropPayload(ptype string, flags ImGuiDragDropFlags) *ImGuiPayload {
-	var g = GImGui
+func (g *ImGuiContext) AcceptDragDropPayload(ptype string, flags ImGuiDragDropFlags) *ImGuiPayload {
 	var window = g.CurrentWindow
-	var payload = g.DragDropPayload
+	var payload = &g.DragDropPayload
 	IM_ASSERT(g.DragDropActive)             // Not called between BeginDragDropTarget() and EndDragDropTarget() ?
 	IM_ASSERT(payload.DataFrameCount != -1) // Forgot to call EndDragDropTarget() ?
 	if ptype != "" && !payload.IsDataType(ptype) {
@@ -279,32 +344,186 @@ func AcceptDragDropPayload(ptype string, flags ImGuiDragDropFlags) *ImGuiPayload
 	// Render default drop visuals
 	// FIXME-DRAGDROP: Settle on a proper default visuals for drop target.
 	payload.Preview = was_accepted_previously
+	payload.InsertPosition = ImGuiDropPosition_None
+	if flags&(ImGuiDragDropFlags_AcceptInsertBeforeAfter|ImGuiDragDropFlags_AcceptInsertInside) != 0 {
+		payload.InsertPosition = CalcDragDropInsertPosition(&r, g.IO.MousePos.y, flags)
+	}
 	flags |= (g.DragDropSourceFlags & ImGuiDragDropFlags_AcceptNoDrawDefaultRect) // Source can also inhibit the preview (useful for external sources that lives for 1 frame)
 	if (flags&ImGuiDragDropFlags_AcceptNoDrawDefaultRect == 0) && payload.Preview {
-		window.DrawList.AddRect(r.Min.Sub(ImVec2{3.5, 3.5}), r.Max.Add(ImVec2{3.5, 3.5}), GetColorU32FromID(ImGuiCol_DragDropTarget, 1), 0.0, 0, 2.0)
+		if payload.InsertPosition != ImGuiDropPosition_None {
+			g.RenderDragDropInsertHighlight(&r, payload.InsertPosition)
+		} else {
+			window.DrawList.AddRect(r.Min.Sub(ImVec2{3.5, 3.5}), r.Max.Add(ImVec2{3.5, 3.5}), g.GetColorU32FromID(ImGuiCol_DragDropTarget, 1), 0.0, 0, 2.0)
+		}
 	}
 
 	g.DragDropAcceptFrameCount = g.FrameCount
-	payload.Delivery = was_accepted_previously && !IsMouseDown(g.DragDropMouseButton) // For extern drag sources affecting os window focus, it's easier to just test !IsMouseDown() instead of IsMouseReleased()
+	payload.Delivery = was_accepted_previously && !g.IsMouseDown(g.DragDropMouseButton) // For extern drag sources affecting os window focus, it's easier to just test !IsMouseDown() instead of IsMouseReleased()
 	if !payload.Delivery && (flags&ImGuiDragDropFlags_AcceptBeforeDelivery == 0) {
 		return nil
 	}
 
-	return &payload
+	return payload
+}
+
+// AcceptDragDropPayloadT is AcceptDragDropPayload() for payloads set with SetDragDropPayloadT[T]().
+// ok is set when the payload is delivered (or while previewing with ImGuiDragDropFlags_AcceptBeforeDelivery), preview is set while
+// the payload hovers the target; value is valid whenever either is set. A target may accept several types by calling this once per type.
+// With an empty ptype any payload holding a T is accepted, whatever its tag. A payload with the requested tag but holding another type
+// is not accepted, and both types are logged when it is dropped on the target.
+func AcceptDragDropPayloadT[T any](ptype string, flags ImGuiDragDropFlags) (value T, ok bool, preview bool) {
+	return AcceptDragDropPayloadTContext[T](GImGui, ptype, flags)
+}
+
+// AcceptDragDropPayloadTContext is AcceptDragDropPayloadT() for the context g.
+func AcceptDragDropPayloadTContext[T any](g *ImGuiContext, ptype string, flags ImGuiDragDropFlags) (value T, ok bool, preview bool) {
+	if _, is_t := g.DragDropPayload.Data.(T); !is_t {
+		if ptype != "" {
+			g.dragDropLogTypeMismatch(ptype, fmt.Sprintf("%T", value))
+		}
+		return value, false, false
+	}
+	var payload = g.AcceptDragDropPayload(ptype, flags|ImGuiDragDropFlags_AcceptBeforeDelivery)
+	if payload == nil {
+		return value, false, false
+	}
+	value = payload.Data.(T)
+	ok = payload.Delivery || (payload.Preview && flags&ImGuiDragDropFlags_AcceptBeforeDelivery != 0)
+	return value, ok, payload.Preview
+}
+
+// AcceptDragDropPayloadItemsT is AcceptDragDropPayloadT() for targets receiving several items at once: the items of a
+// payload set with SetDragDropPayloadItemsT[T](), or a single item set with SetDragDropPayloadT[T]() as a slice of one.
+// With ImGuiDragDropFlags_AcceptInsertXXX flags, use GetDragDropPayload().InsertIndex() to find where the items go.
+func AcceptDragDropPayloadItemsT[T any](ptype string, flags ImGuiDragDropFlags) (items []T, ok bool, preview bool) {
+	return AcceptDragDropPayloadItemsTContext[T](GImGui, ptype, flags)
+}
+
+// AcceptDragDropPayloadItemsTContext is AcceptDragDropPayloadItemsT() for the context g.
+func AcceptDragDropPayloadItemsTContext[T any](g *ImGuiContext, ptype string, flags ImGuiDragDropFlags) (items []T, ok bool, preview bool) {
+	switch g.DragDropPayload.Data.(type) {
+	case T, []T:
+	default:
+		if ptype != "" {
+			g.dragDropLogTypeMismatch(ptype, fmt.Sprintf("%T or %T", *new(T), items))
+		}
+		return nil, false, false
+	}
+	var payload = g.AcceptDragDropPayload(ptype, flags|ImGuiDragDropFlags_AcceptBeforeDelivery)
+	if payload == nil {
+		return nil, false, false
+	}
+	switch data := payload.Data.(type) {
+	case []T:
+		items = data
+	case T:
+		items = []T{data}
+	}
+	ok = payload.Delivery || (payload.Preview && flags&ImGuiDragDropFlags_AcceptBeforeDelivery != 0)
+	return items, ok, payload.Preview
+}
+
+// dragDropLogTypeMismatch logs a payload tagged ptype dropped on a target expecting another Go type.
+func (g *ImGuiContext) dragDropLogTypeMismatch(ptype string, want string) {
+	if g.DragDropPayload.IsDataType(ptype) && g.IsMouseReleased(g.DragDropMouseButton) {
+		g.IMGUI_DEBUG_LOG("drag and drop: payload %q holds a %T, the target expects a %s\n", ptype, g.DragDropPayload.Data, want)
+	}
+}
+
+// CalcDragDropInsertPosition returns where a payload hovering the target rectangle r at mouse_y goes, see ImGuiDragDropFlags_AcceptInsertXXX flags.
+func CalcDragDropInsertPosition(r *ImRect, mouse_y float, flags ImGuiDragDropFlags) ImGuiDropPosition {
+	if flags&ImGuiDragDropFlags_AcceptInsertBeforeAfter == 0 {
+		return ImGuiDropPosition_Inside
+	}
+	var t float = 0.5
+	if r.GetHeight() > 0.0 {
+		t = (mouse_y - r.Min.y) / r.GetHeight()
+	}
+	if flags&ImGuiDragDropFlags_AcceptInsertInside != 0 {
+		if t < 0.25 {
+			return ImGuiDropPosition_Before
+		}
+		if t >= 0.75 {
+			return ImGuiDropPosition_After
+		}
+		return ImGuiDropPosition_Inside
+	}
+	if t < 0.5 {
+		return ImGuiDropPosition_Before
+	}
+	return ImGuiDropPosition_After
+}
+
+// RenderDragDropInsertHighlight highlights where a payload goes relative to the target rectangle bb: a line in the
+// middle of the spacing above or below it, or a frame around it when dropped inside (like RenderNavHighlight()).
+func (g *ImGuiContext) RenderDragDropInsertHighlight(bb *ImRect, position ImGuiDropPosition) {
+	var window = g.CurrentWindow
+	var col = g.GetColorU32FromID(ImGuiCol_DragDropTarget, 1)
+	const THICKNESS float = 2.0
+	switch position {
+	case ImGuiDropPosition_Before, ImGuiDropPosition_After:
+		var y = bb.Min.y - IM_FLOOR(g.Style.ItemSpacing.y*0.5)
+		if position == ImGuiDropPosition_After {
+			y = bb.Max.y + IM_FLOOR(g.Style.ItemSpacing.y*0.5)
+		}
+		var radius = THICKNESS * 1.5
+		window.DrawList.AddCircleFilled(ImVec2{bb.Min.x + radius, y}, radius, col, 0)
+		window.DrawList.AddLine(&ImVec2{bb.Min.x + radius, y}, &ImVec2{bb.Max.x, y}, col, THICKNESS)
+	case ImGuiDropPosition_Inside:
+		var DISTANCE = 3.0 + THICKNESS*0.5
+		var display_rect = *bb
+		display_rect.ExpandVec(ImVec2{DISTANCE, DISTANCE})
+		window.DrawList.AddRect(display_rect.Min.Add(ImVec2{THICKNESS * 0.5, THICKNESS * 0.5}), display_rect.Max.Sub(ImVec2{THICKNESS * 0.5, THICKNESS * 0.5}), col, g.Style.FrameRounding, 0, THICKNESS)
+	}
+}
+
+// RenderDragDropItemsPreview completes the tooltip of a drag source carrying items_count items: a stack of cards with the
+// count when the source did not submit anything, else a count badge next to what it submitted.
+func (g *ImGuiContext) RenderDragDropItemsPreview(items_count int) {
+	var window = g.CurrentWindow
+	var label = fmt.Sprintf("%d", items_count)
+	var label_size = g.CalcTextSize(label, false, -1)
+
+	if window.DC.CursorMaxPos == window.DC.CursorStartPos {
+		// Nothing submitted: stack of cards followed by the count
+		var card_size = ImVec2{g.FontSize * 1.5, g.FontSize * 1.5}
+		var offset = IM_FLOOR(g.FontSize * 0.25)
+		var stack_count = ImMinInt(items_count, 3)
+		var pos = window.DC.CursorPos
+		for n := stack_count - 1; n >= 0; n-- {
+			var card_min = pos.Add(ImVec2{offset * float(n), offset * float(stack_count-1-n)})
+			var card_max = card_min.Add(card_size)
+			window.DrawList.AddRectFilled(card_min, card_max, g.GetColorU32FromID(ImGuiCol_FrameBg, 1), g.Style.FrameRounding, 0)
+			window.DrawList.AddRect(card_min, card_max, g.GetColorU32FromID(ImGuiCol_Border, 1), g.Style.FrameRounding, 0, 1.0)
+		}
+		var stack_size = card_size.Add(ImVec2{offset * float(stack_count-1), offset * float(stack_count-1)})
+		g.Dummy(stack_size)
+		g.SameLine(0, -1)
+		g.SetCursorPosY(g.GetCursorPosY() + IM_FLOOR((stack_size.y-g.FontSize)*0.5))
+		g.Text("%d items", items_count)
+		return
+	}
+
+	// Count badge next to the preview submitted by the source
+	g.SameLine(0, -1)
+	var badge_size = ImVec2{ImMax(label_size.x+g.FontSize*0.5, g.FontSize), g.FontSize}
+	var badge_min = window.DC.CursorPos
+	var badge_max = badge_min.Add(badge_size)
+	window.DrawList.AddRectFilled(badge_min, badge_max, g.GetColorU32FromID(ImGuiCol_DragDropTarget, 1), badge_size.y*0.5, 0)
+	window.DrawList.AddText(ImVec2{badge_min.x + IM_FLOOR((badge_size.x-label_size.x)*0.5), badge_min.y}, g.GetColorU32FromID(ImGuiCol_Text, 1), label)
+	g.Dummy(badge_size)
 }
 
 // EndDragDropTarget We don't really use/need this now, but added it for the sake of consistency and because we might need it later.
 // only call EndDragDropTarget() if BeginDragDropTarget() returns true!
-func EndDragDropTarget() {
-	var g = GImGui
+func (g *ImGuiContext) EndDragDropTarget() {
 	IM_ASSERT(g.DragDropActive)
 	IM_ASSERT(g.DragDropWithinTarget)
 	g.DragDropWithinTarget = false
 }
 
 // GetDragDropPayload peek directly into the current payload from anywhere. may return NULL. use ImGuiPayload::IsDataType() to test for the payload type.
-func GetDragDropPayload() *ImGuiPayload {
-	var g = GImGui
+func (g *ImGuiContext) GetDragDropPayload() *ImGuiPayload {
 	if g.DragDropActive {
 		return &g.DragDropPayload
 	}
@@ -0,0 +1,300 @@
+package imgui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDragDropPayloadT(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	type asset struct {
+		Name string
+		Size int
+	}
+	var (
+		source    = asset{Name: "rock.png", Size: 42}
+		dropped   []asset
+		previews  int
+		byType    []asset
+		wrongType = false
+		rects     = map[string]ImRect{}
+	)
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{400, 300}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			ui.Button("Source")
+			rects["Source"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			if BeginDragDropSource(0) {
+				SetDragDropPayloadT("ASSET", source, ImGuiCond_Once)
+				Text("%s", source.Name)
+				EndDragDropSource()
+			}
+
+			// A target accepting two payload types
+			ui.Button("Target")
+			rects["Target"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			if BeginDragDropTarget() {
+				if _, ok, _ := AcceptDragDropPayloadT[[3]float](IMGUI_PAYLOAD_TYPE_COLOR_3F, 0); ok {
+					t.Error("color payload accepted from an asset source")
+				}
+				if value, ok, preview := AcceptDragDropPayloadT[asset]("ASSET", 0); ok {
+					dropped = append(dropped, value)
+				} else if preview {
+					previews++
+				}
+				EndDragDropTarget()
+			}
+
+			// A target accepting any payload holding an asset
+			ui.Button("Any")
+			rects["Any"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			if BeginDragDropTarget() {
+				if value, ok, _ := AcceptDragDropPayloadT[asset]("", 0); ok {
+					byType = append(byType, value)
+				}
+				if _, ok, _ := AcceptDragDropPayloadT[string]("", 0); ok {
+					t.Error("asset payload accepted as a string")
+				}
+				EndDragDropTarget()
+			}
+
+			// A target expecting another Go type for the same tag
+			ui.Button("Wrong")
+			rects["Wrong"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			if BeginDragDropTarget() {
+				if _, ok, preview := AcceptDragDropPayloadT[string]("ASSET", 0); ok || preview {
+					wrongType = true
+				}
+				EndDragDropTarget()
+			}
+			ui.End()
+		})
+	}
+	var drag = func(from, to string) {
+		var from_rect, to_rect = rects[from], rects[to]
+		mouseDrag(io, frame, from_rect.GetCenter(), to_rect.GetCenter(), 4)
+		frame()
+	}
+	frame()
+	frame()
+
+	drag("Source", "Target")
+	if len(dropped) != 1 || dropped[0] != source {
+		t.Errorf("dropped %v on the target, want %v once", dropped, source)
+	}
+	if previews == 0 {
+		t.Error("target never reported a preview while hovered")
+	}
+	if GetDragDropPayload() != nil {
+		t.Error("payload still active after delivery")
+	}
+
+	drag("Source", "Any")
+	if len(byType) != 1 || byType[0] != source {
+		t.Errorf("dropped %v on the untagged target, want %v once", byType, source)
+	}
+
+	drag("Source", "Wrong")
+	if wrongType {
+		t.Error("payload accepted as the wrong type")
+	}
+}
+
+func TestDragDropPayloadZeroSize(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	type marker struct{}
+	var (
+		dropped int
+		rects   = map[string]ImRect{}
+	)
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{400, 300}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			ui.Button("Source")
+			rects["Source"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			if BeginDragDropSource(0) {
+				SetDragDropPayloadT("MARKER", marker{}, ImGuiCond_Once)
+				EndDragDropSource()
+			}
+			ui.Button("Target")
+			rects["Target"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			if BeginDragDropTarget() {
+				if _, ok, _ := AcceptDragDropPayloadT[marker]("MARKER", 0); ok {
+					dropped++
+				}
+				EndDragDropTarget()
+			}
+			ui.End()
+		})
+	}
+	frame()
+	frame()
+	var from, to = rects["Source"], rects["Target"]
+	mouseDrag(io, frame, from.GetCenter(), to.GetCenter(), 4)
+	frame()
+	if dropped != 1 {
+		t.Errorf("zero-size payload dropped %d times, want once", dropped)
+	}
+}
+
+func TestDragDropItems(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	var (
+		names    = []string{"A", "B", "C", "D", "E", "F"}
+		selected = []bool{false, true, false, true, false, false}
+		rects    = make([]ImRect, len(names))
+		preview  ImVec2
+		drops    []ImGuiDropPosition
+	)
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{400, 300}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			for n := range names {
+				Selectable(names[n], selected[n], 0, ImVec2{})
+				rects[n] = ImRect{GetItemRectMin(), GetItemRectMax()}
+				if BeginDragDropSource(0) {
+					var dragged []int
+					for i := range selected {
+						if selected[i] {
+							dragged = append(dragged, int(i))
+						}
+					}
+					SetDragDropPayloadItemsT("ITEMS", dragged, ImGuiCond_Once)
+					EndDragDropSource()
+				}
+				if BeginDragDropTarget() {
+					if dragged, ok, _ := AcceptDragDropPayloadItemsT[int]("ITEMS", ImGuiDragDropFlags_AcceptInsertBeforeAfter); ok {
+						var payload = GetDragDropPayload()
+						drops = append(drops, payload.InsertPosition)
+						demoMoveItems(names, selected, dragged, payload.InsertIndex(int(n)))
+					}
+					EndDragDropTarget()
+				}
+			}
+			ui.End()
+			if tooltip := FindWindowByName("##Tooltip_00"); tooltip != nil && tooltip.Active {
+				preview = tooltip.ContentSize
+			}
+		})
+	}
+	var drag = func(from int, to ImVec2) {
+		var from_rect = rects[from]
+		mouseDrag(io, frame, from_rect.GetCenter(), to, 4)
+		frame()
+	}
+	frame()
+	frame()
+
+	// Bottom half of "E": after it
+	drag(1, ImVec2{rects[4].GetCenter().x, rects[4].Max.y - 1})
+	if got := strings.Join(names, ""); got != "ACEBDF" {
+		t.Errorf("moved B and D after E: %s, want ACEBDF", got)
+	}
+	if len(drops) != 1 || drops[0] != ImGuiDropPosition_After {
+		t.Errorf("drop positions %v, want [After]", drops)
+	}
+	if !selected[3] || !selected[4] || selected[1] {
+		t.Errorf("selection did not follow the moved items: %v", selected)
+	}
+	if preview.x < GetFontSize()*1.5 || preview.y < GetFontSize()*1.5 {
+		t.Errorf("default preview of 2 items has size %v", preview)
+	}
+
+	// Top half of "A": before it
+	drop := rects[0]
+	drag(3, ImVec2{drop.GetCenter().x, drop.Min.y + 1})
+	if got := strings.Join(names, ""); got != "BDACEF" {
+		t.Errorf("moved B and D before A: %s, want BDACEF", got)
+	}
+}
+
+func TestCalcDragDropInsertPosition(t *testing.T) {
+	var r = ImRect{ImVec2{0, 100}, ImVec2{50, 120}}
+	var tests = []struct {
+		y     float
+		flags ImGuiDragDropFlags
+		want  ImGuiDropPosition
+	}{
+		{104, ImGuiDragDropFlags_AcceptInsertBeforeAfter, ImGuiDropPosition_Before},
+		{112, ImGuiDragDropFlags_AcceptInsertBeforeAfter, ImGuiDropPosition_After},
+		{104, ImGuiDragDropFlags_AcceptInsertInside, ImGuiDropPosition_Inside},
+		{104, ImGuiDragDropFlags_AcceptInsertBeforeAfter | ImGuiDragDropFlags_AcceptInsertInside, ImGuiDropPosition_Before},
+		{110, ImGuiDragDropFlags_AcceptInsertBeforeAfter | ImGuiDragDropFlags_AcceptInsertInside, ImGuiDropPosition_Inside},
+		{116, ImGuiDragDropFlags_AcceptInsertBeforeAfter | ImGuiDragDropFlags_AcceptInsertInside, ImGuiDropPosition_After},
+	}
+	for _, test := range tests {
+		if got := CalcDragDropInsertPosition(&r, test.y, test.flags); got != test.want {
+			t.Errorf("CalcDragDropInsertPosition(y=%v, flags=%#x) = %v, want %v", test.y, test.flags, got, test.want)
+		}
+	}
+}
+
+func TestExternalDrop(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	var (
+		dropped [][]string
+		target  ImRect
+	)
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{400, 300}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			ui.Button("Drop files here")
+			target = ImRect{GetItemRectMin(), GetItemRectMax()}
+			if BeginDragDropTarget() {
+				if paths, ok, _ := AcceptDragDropPayloadItemsT[string](IMGUI_PAYLOAD_TYPE_FILES, 0); ok {
+					dropped = append(dropped, paths)
+				}
+				EndDragDropTarget()
+			}
+			ui.End()
+		})
+	}
+	frame()
+	frame()
+
+	var paths = []string{"/tmp/a.png", "/tmp/b.png"}
+	var center = target.GetCenter()
+	io.AddExternalDrop(paths, center.x, center.y)
+	paths[0] = "/tmp/changed.png"
+	for i := 0; i < 4; i++ {
+		frame()
+	}
+	if len(dropped) != 1 || strings.Join(dropped[0], ",") != "/tmp/a.png,/tmp/b.png" {
+		t.Errorf("dropped %q on the target, want the two paths once", dropped)
+	}
+	if GetDragDropPayload() != nil {
+		t.Error("payload still active after delivery")
+	}
+
+	// Dropped outside of any target: the payload elapses
+	io.AddExternalDrop([]string{"/tmp/c.png"}, 500, 400)
+	for i := 0; i < 4; i++ {
+		frame()
+	}
+	if len(dropped) != 1 {
+		t.Errorf("drop outside of the target was delivered: %q", dropped)
+	}
+	if GetDragDropPayload() != nil {
+		t.Error("undelivered external payload did not elapse")
+	}
+}
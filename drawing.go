@@ -5,8 +5,7 @@ import (
 )
 
 // GetDrawData Pass this to your backend rendering function! Valid after Render() and until the next call to NewFrame()
-func GetDrawData() *ImDrawData {
-	var g = GImGui
+func (g *ImGuiContext) GetDrawData() *ImDrawData {
 	var viewport = g.Viewports[0]
 
 	if viewport.DrawDataP.Valid {
@@ -113,17 +112,12 @@ func (l *ImDrawList) _OnChangedTextureID() {
 	IM_ASSERT(curr_cmd.UserCallback == nil)
 
 	// Try to merge with previous command if it matches, else use current command
-	var prev_cmd = &l.CmdBuffer[len(l.CmdBuffer)-1]
-
-	prevHeader := ImDrawCmdHeader{
-		ClipRect:  prev_cmd.ClipRect,
-		TextureId: prev_cmd.TextureId,
-		VtxOffset: prev_cmd.ElemCount,
-	}
-
-	if curr_cmd.ElemCount == 0 && len(l.CmdBuffer) > 1 && l._CmdHeader == prevHeader && prev_cmd.UserCallback == nil {
-		l.CmdBuffer = l.CmdBuffer[len(l.CmdBuffer)-1:]
-		return
+	if curr_cmd.ElemCount == 0 && len(l.CmdBuffer) > 1 {
+		var prev_cmd = &l.CmdBuffer[len(l.CmdBuffer)-2]
+		if prev_cmd.HeaderEqualsHeader(&l._CmdHeader) && prev_cmd.UserCallback == nil {
+			l.CmdBuffer = l.CmdBuffer[:len(l.CmdBuffer)-1]
+			return
+		}
 	}
 
 	curr_cmd.TextureId = l._CmdHeader.TextureId
@@ -142,17 +136,12 @@ func (l *ImDrawList) _OnChangedClipRect() {
 	IM_ASSERT(curr_cmd.UserCallback == nil)
 
 	// Try to merge with previous command if it matches, else use current command
-	var prev_cmd = &l.CmdBuffer[len(l.CmdBuffer)-1]
-
-	prevHeader := ImDrawCmdHeader{
-		ClipRect:  prev_cmd.ClipRect,
-		TextureId: prev_cmd.TextureId,
-		VtxOffset: prev_cmd.ElemCount,
-	}
-
-	if curr_cmd.ElemCount == 0 && len(l.CmdBuffer) > 1 && l._CmdHeader == prevHeader && prev_cmd.UserCallback == nil {
-		l.CmdBuffer = l.CmdBuffer[len(l.CmdBuffer)-1:]
-		return
+	if curr_cmd.ElemCount == 0 && len(l.CmdBuffer) > 1 {
+		var prev_cmd = &l.CmdBuffer[len(l.CmdBuffer)-2]
+		if prev_cmd.HeaderEqualsHeader(&l._CmdHeader) && prev_cmd.UserCallback == nil {
+			l.CmdBuffer = l.CmdBuffer[:len(l.CmdBuffer)-1]
+			return
+		}
 	}
 
 	curr_cmd.ClipRect = l._CmdHeader.ClipRect
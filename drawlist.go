@@ -67,7 +67,7 @@ func (l *ImDrawList) PushClipRectFullScreen() {
 }
 
 func (l *ImDrawList) PopClipRect() {
-	l._ClipRectStack = l._ClipRectStack[len(l._ClipRectStack)-1:]
+	l._ClipRectStack = l._ClipRectStack[:len(l._ClipRectStack)-1]
 	if len(l._ClipRectStack) == 0 {
 		l._CmdHeader.ClipRect = l._Data.ClipRectFullscreen
 	} else {
@@ -26,6 +26,7 @@ const (
 	ImGuiWindowFlags_AlwaysUseWindowPadding    ImGuiWindowFlags = 1 << 16 // Ensure child windows without border uses style.WindowPadding (ignored by default for non-bordered child windows, because more convenient)
 	ImGuiWindowFlags_NoNavInputs               ImGuiWindowFlags = 1 << 18 // No gamepad/keyboard navigation within the window
 	ImGuiWindowFlags_NoNavFocus                ImGuiWindowFlags = 1 << 19 // No focusing toward this window with gamepad/keyboard navigation (e.g. skipped by CTRL+TAB)
+	ImGuiWindowFlags_NoDocking                 ImGuiWindowFlags = 1 << 21 // Disable docking of this window
 	ImGuiWindowFlags_UnsavedDocument           ImGuiWindowFlags = 1 << 20 // Display a dot next to the title. When used in a tab/docking context, tab is selected when clicking the X + closure is not assumed (will wait for user to stop submitting the tab). Otherwise closure is assumed when pressing the X, so if you keep submitting the tab may reappear at end of tab bar.
 	ImGuiWindowFlags_NoNav                                      = ImGuiWindowFlags_NoNavInputs | ImGuiWindowFlags_NoNavFocus
 	ImGuiWindowFlags_NoDecoration                               = ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoResize | ImGuiWindowFlags_NoScrollbar | ImGuiWindowFlags_NoCollapse
@@ -38,6 +39,7 @@ const (
 	ImGuiWindowFlags_Popup        ImGuiWindowFlags = 1 << 26 // Don't use! For internal use by BeginPopup()
 	ImGuiWindowFlags_Modal        ImGuiWindowFlags = 1 << 27 // Don't use! For internal use by BeginPopupModal()
 	ImGuiWindowFlags_ChildMenu    ImGuiWindowFlags = 1 << 28 // Don't use! For internal use by BeginMenu()
+	ImGuiWindowFlags_DockNodeHost ImGuiWindowFlags = 1 << 29 // Don't use! For internal use by Begin()/NewFrame()
 
 	// [Obsolete]
 	//ImGuiWindowFlags_ResizeFromAnySide    = 1 << 17// --> Set io.ConfigWindowsResizeFromEdges=true and make sure mouse cursors are supported by backend (io.BackendFlags & ImGuiBackendFlags_HasMouseCursors)
@@ -121,6 +123,34 @@ const (
 	ImGuiSelectableFlags_AllowItemOverlap ImGuiSelectableFlags = 1 << 4 // (WIP) Hit testing to allow subsequent widgets to overlap this one
 )
 
+// Flags for BeginMultiSelect()
+const (
+	ImGuiMultiSelectFlags_None                 ImGuiMultiSelectFlags = 0
+	ImGuiMultiSelectFlags_SingleSelect         ImGuiMultiSelectFlags = 1 << 0  // Disable selecting more than one item. Allows single-selection code to share the same logic.
+	ImGuiMultiSelectFlags_NoSelectAll          ImGuiMultiSelectFlags = 1 << 1  // Disable CTRL+A shortcut to select all.
+	ImGuiMultiSelectFlags_NoRangeSelect        ImGuiMultiSelectFlags = 1 << 2  // Disable Shift+selection mouse/keyboard support (useful for unordered 2D selection). With box-selection, SetRange requests are also not merged and always hold a single item.
+	ImGuiMultiSelectFlags_NoAutoSelect         ImGuiMultiSelectFlags = 1 << 3  // Disable selecting items when navigating (useful for e.g. supporting range-select in a list of checkboxes).
+	ImGuiMultiSelectFlags_NoAutoClear          ImGuiMultiSelectFlags = 1 << 4  // Disable clearing selection when navigating or selecting another one (generally used with ImGuiMultiSelectFlags_NoAutoSelect).
+	ImGuiMultiSelectFlags_BoxSelect1d          ImGuiMultiSelectFlags = 1 << 5  // Enable box-selection with same width and same x pos items (e.g. full row Selectable()). Works better with a little spacing between items to aim at empty space.
+	ImGuiMultiSelectFlags_BoxSelect2d          ImGuiMultiSelectFlags = 1 << 6  // Enable box-selection with varying width or x pos items (e.g. 2D layout/grid). Slower: horizontal movements update the selection of normally clipped items.
+	ImGuiMultiSelectFlags_BoxSelectNoScroll    ImGuiMultiSelectFlags = 1 << 7  // Disable scrolling when box-selecting near edges of scope.
+	ImGuiMultiSelectFlags_ClearOnEscape        ImGuiMultiSelectFlags = 1 << 8  // Clear selection when pressing Escape while scope is focused.
+	ImGuiMultiSelectFlags_ClearOnClickVoid     ImGuiMultiSelectFlags = 1 << 9  // Clear selection when clicking on empty location within scope.
+	ImGuiMultiSelectFlags_ScopeWindow          ImGuiMultiSelectFlags = 1 << 10 // Scope for _BoxSelect and _ClearOnClickVoid is whole window (Default). Use if BeginMultiSelect() covers a whole window or is used a single time in the same window.
+	ImGuiMultiSelectFlags_ScopeRect            ImGuiMultiSelectFlags = 1 << 11 // Scope for _BoxSelect and _ClearOnClickVoid is the rectangle encompassing BeginMultiSelect()/EndMultiSelect(). Use if BeginMultiSelect() is called multiple times in the same window.
+	ImGuiMultiSelectFlags_SelectOnClickRelease ImGuiMultiSelectFlags = 1 << 12 // Apply selection on mouse release when clicking an unselected item, allowing to drag it without altering selection. By default unselected items are selected on mouse down, selected ones on mouse release.
+)
+
+// Type of ImGuiSelectionRequest
+const (
+	ImGuiSelectionRequestType_None     ImGuiSelectionRequestType = iota
+	ImGuiSelectionRequestType_SetAll                             // Request app to clear selection (if Selected==false) or select all items (if Selected==true).
+	ImGuiSelectionRequestType_SetRange                           // Request app to select/unselect [RangeFirstItem..RangeLastItem] items (inclusive) based on value of Selected. Only EndMultiSelect() requests this.
+)
+
+// ImGuiSelectionUserData_Invalid is the value of ImGuiMultiSelectIO.RangeSrcItem and NavIdItem when there is no such item.
+const ImGuiSelectionUserData_Invalid ImGuiSelectionUserData = -1
+
 // Flags for ImGui::BeginCombo()
 const (
 	ImGuiComboFlags_None           ImGuiComboFlags = 0
@@ -331,16 +361,40 @@ const (
 	ImGuiDragDropFlags_SourceAllowNullID        ImGuiDragDropFlags = 1 << 3 // Allow items such as Text(), Image() that have no unique identifier to be used as drag source, by manufacturing a temporary identifier based on their window-relative position. This is extremely unusual within the dear imgui ecosystem and so we made it explicit.
 	ImGuiDragDropFlags_SourceExtern             ImGuiDragDropFlags = 1 << 4 // External source (from outside of dear imgui), won't attempt to read current item/window info. Will always return true. Only one Extern source can be active simultaneously.
 	ImGuiDragDropFlags_SourceAutoExpirePayload  ImGuiDragDropFlags = 1 << 5 // Automatically expire the payload if the source cease to be submitted (otherwise payloads are persisting while being dragged)
+	ImGuiDragDropFlags_SourceNoItemsPreview     ImGuiDragDropFlags = 1 << 6 // Do not draw the default stack/count badge in the tooltip of a payload carrying several items (see SetDragDropPayloadItemsT()).
 	// AcceptDragDropPayload() flags
 	ImGuiDragDropFlags_AcceptBeforeDelivery    ImGuiDragDropFlags = 1 << 10                                                                              // AcceptDragDropPayload() will returns true even before the mouse button is released. You can then call IsDelivery() to test if the payload needs to be delivered.
 	ImGuiDragDropFlags_AcceptNoDrawDefaultRect ImGuiDragDropFlags = 1 << 11                                                                              // Do not draw the default highlight rectangle when hovering over target.
 	ImGuiDragDropFlags_AcceptNoPreviewTooltip  ImGuiDragDropFlags = 1 << 12                                                                              // Request hiding the BeginDragDropSource tooltip from the BeginDragDropTarget site.
+	ImGuiDragDropFlags_AcceptInsertBeforeAfter ImGuiDragDropFlags = 1 << 13                                                                              // Target is an item of a list or tree: hovering the top/bottom half of the item inserts before/after it (see ImGuiPayload::InsertPosition), an insertion line is drawn instead of the default rectangle.
+	ImGuiDragDropFlags_AcceptInsertInside      ImGuiDragDropFlags = 1 << 14                                                                              // Target can receive the payload inside it (e.g. a tree node). Combined with ImGuiDragDropFlags_AcceptInsertBeforeAfter, only the top/bottom quarters of the item insert before/after it.
 	ImGuiDragDropFlags_AcceptPeekOnly                             = ImGuiDragDropFlags_AcceptBeforeDelivery | ImGuiDragDropFlags_AcceptNoDrawDefaultRect // For peeking ahead and inspecting the payload before delivery.
 )
 
+// ImGuiDropPosition Where a payload is dropped relative to a target item, see ImGuiDragDropFlags_AcceptInsertXXX flags
+const (
+	ImGuiDropPosition_None   ImGuiDropPosition = iota
+	ImGuiDropPosition_Before                   // Insert before the target item
+	ImGuiDropPosition_After                    // Insert after the target item
+	ImGuiDropPosition_Inside                   // Insert inside the target item (e.g. as a child of a tree node)
+)
+
+// Flags for ImGui::DockSpace(), shared/inherited by child nodes.
+// (Some flags can be applied to individual nodes directly)
+const (
+	ImGuiDockNodeFlags_None                   ImGuiDockNodeFlags = 0
+	ImGuiDockNodeFlags_KeepAliveOnly          ImGuiDockNodeFlags = 1 << 0 // Shared       // Don't display the dockspace node but keep it alive. Windows docked into this dockspace node won't be undocked.
+	ImGuiDockNodeFlags_NoDockingInCentralNode ImGuiDockNodeFlags = 1 << 2 // Shared       // Disable docking inside the Central Node, which will be always kept empty.
+	ImGuiDockNodeFlags_PassthruCentralNode    ImGuiDockNodeFlags = 1 << 3 // Shared       // Enable passthru dockspace: the Central Node doesn't draw a DockingEmptyBg background when empty and lets inputs pass through to the windows behind it.
+	ImGuiDockNodeFlags_NoSplit                ImGuiDockNodeFlags = 1 << 4 // Shared/Local // Disable splitting the node into smaller nodes. Useful e.g. when embedding dockspaces into a main root one. Note: when turned off, existing splits will be preserved.
+	ImGuiDockNodeFlags_NoResize               ImGuiDockNodeFlags = 1 << 5 // Shared/Local // Disable resizing node using the splitter/separators. Useful with programmatically setup dockspaces.
+	ImGuiDockNodeFlags_AutoHideTabBar         ImGuiDockNodeFlags = 1 << 6 // Shared/Local // Tab bar will automatically hide when there is a single window in the dock node.
+)
+
 // Standard Drag and Drop payload types. You can define you own payload types using short strings. Types starting with '_' are defined by Dear ImGui.
 const IMGUI_PAYLOAD_TYPE_COLOR_3F = "_COL3F" // float[3]: Standard type for colors, without alpha. User code may use this type.
 const IMGUI_PAYLOAD_TYPE_COLOR_4F = "_COL4F" // float[4]: Standard type for colors. User code may use this type.
+const IMGUI_PAYLOAD_TYPE_FILES = "_FILES"    // []string: Paths of files dropped from outside of the application, see io.AddExternalDrop().
 
 // A primary data type
 const (
@@ -374,9 +428,12 @@ const (
 	ImGuiSortDirection_Descending ImGuiSortDirection = 2 // Descending = 9->0, Z->A etc.
 )
 
-// User fill ImGuiIO.KeyMap[] array with indices into the ImGuiIO.KeysDown[512] array
+// Keys value 0 to 511 are left unused as legacy native/opaque key values (< 1.87)
+// Keys value >= 512 are named keys (>= 1.87)
 const (
-	ImGuiKey_Tab ImGuiKey = iota
+	// Keyboard
+	ImGuiKey_None ImGuiKey = 0
+	ImGuiKey_Tab  ImGuiKey = iota + 511 // == ImGuiKey_NamedKey_BEGIN
 	ImGuiKey_LeftArrow
 	ImGuiKey_RightArrow
 	ImGuiKey_UpArrow
@@ -391,14 +448,153 @@ const (
 	ImGuiKey_Space
 	ImGuiKey_Enter
 	ImGuiKey_Escape
-	ImGuiKey_KeyPadEnter
-	ImGuiKey_A // for text edit CTRL+A: select all
-	ImGuiKey_C // for text edit CTRL+C: copy
-	ImGuiKey_V // for text edit CTRL+V: paste
-	ImGuiKey_X // for text edit CTRL+X: cut
-	ImGuiKey_Y // for text edit CTRL+Y: redo
-	ImGuiKey_Z // for text edit CTRL+Z: undo
-	ImGuiKey_COUNT
+	ImGuiKey_LeftCtrl
+	ImGuiKey_LeftShift
+	ImGuiKey_LeftAlt
+	ImGuiKey_LeftSuper
+	ImGuiKey_RightCtrl
+	ImGuiKey_RightShift
+	ImGuiKey_RightAlt
+	ImGuiKey_RightSuper
+	ImGuiKey_Menu
+	ImGuiKey_0
+	ImGuiKey_1
+	ImGuiKey_2
+	ImGuiKey_3
+	ImGuiKey_4
+	ImGuiKey_5
+	ImGuiKey_6
+	ImGuiKey_7
+	ImGuiKey_8
+	ImGuiKey_9
+	ImGuiKey_A
+	ImGuiKey_B
+	ImGuiKey_C
+	ImGuiKey_D
+	ImGuiKey_E
+	ImGuiKey_F
+	ImGuiKey_G
+	ImGuiKey_H
+	ImGuiKey_I
+	ImGuiKey_J
+	ImGuiKey_K
+	ImGuiKey_L
+	ImGuiKey_M
+	ImGuiKey_N
+	ImGuiKey_O
+	ImGuiKey_P
+	ImGuiKey_Q
+	ImGuiKey_R
+	ImGuiKey_S
+	ImGuiKey_T
+	ImGuiKey_U
+	ImGuiKey_V
+	ImGuiKey_W
+	ImGuiKey_X
+	ImGuiKey_Y
+	ImGuiKey_Z
+	ImGuiKey_F1
+	ImGuiKey_F2
+	ImGuiKey_F3
+	ImGuiKey_F4
+	ImGuiKey_F5
+	ImGuiKey_F6
+	ImGuiKey_F7
+	ImGuiKey_F8
+	ImGuiKey_F9
+	ImGuiKey_F10
+	ImGuiKey_F11
+	ImGuiKey_F12
+	ImGuiKey_Apostrophe   // '
+	ImGuiKey_Comma        // ,
+	ImGuiKey_Minus        // -
+	ImGuiKey_Period       // .
+	ImGuiKey_Slash        // /
+	ImGuiKey_Semicolon    // ;
+	ImGuiKey_Equal        // =
+	ImGuiKey_LeftBracket  // [
+	ImGuiKey_Backslash    // \
+	ImGuiKey_RightBracket // ]
+	ImGuiKey_GraveAccent  // `
+	ImGuiKey_CapsLock
+	ImGuiKey_ScrollLock
+	ImGuiKey_NumLock
+	ImGuiKey_PrintScreen
+	ImGuiKey_Pause
+	ImGuiKey_Keypad0
+	ImGuiKey_Keypad1
+	ImGuiKey_Keypad2
+	ImGuiKey_Keypad3
+	ImGuiKey_Keypad4
+	ImGuiKey_Keypad5
+	ImGuiKey_Keypad6
+	ImGuiKey_Keypad7
+	ImGuiKey_Keypad8
+	ImGuiKey_Keypad9
+	ImGuiKey_KeypadDecimal
+	ImGuiKey_KeypadDivide
+	ImGuiKey_KeypadMultiply
+	ImGuiKey_KeypadSubtract
+	ImGuiKey_KeypadAdd
+	ImGuiKey_KeypadEnter
+	ImGuiKey_KeypadEqual
+
+	// Gamepad (some of those are analog values, 0.0f to 1.0f)                              // NAVIGATION action
+	ImGuiKey_GamepadStart       // Menu (Xbox)          + (Switch)   Start/Options (PS) // --
+	ImGuiKey_GamepadBack        // View (Xbox)          - (Switch)   Share (PS)         // --
+	ImGuiKey_GamepadFaceUp      // Y (Xbox)             X (Switch)   Triangle (PS)      // -> ImGuiNavInput_Input
+	ImGuiKey_GamepadFaceDown    // A (Xbox)             B (Switch)   Cross (PS)         // -> ImGuiNavInput_Activate
+	ImGuiKey_GamepadFaceLeft    // X (Xbox)             Y (Switch)   Square (PS)        // -> ImGuiNavInput_Menu
+	ImGuiKey_GamepadFaceRight   // B (Xbox)             A (Switch)   Circle (PS)        // -> ImGuiNavInput_Cancel
+	ImGuiKey_GamepadDpadUp      // D-pad Up                                             // -> ImGuiNavInput_DpadUp
+	ImGuiKey_GamepadDpadDown    // D-pad Down                                           // -> ImGuiNavInput_DpadDown
+	ImGuiKey_GamepadDpadLeft    // D-pad Left                                           // -> ImGuiNavInput_DpadLeft
+	ImGuiKey_GamepadDpadRight   // D-pad Right                                          // -> ImGuiNavInput_DpadRight
+	ImGuiKey_GamepadL1          // L Bumper (Xbox)      L (Switch)   L1 (PS)            // -> ImGuiNavInput_FocusPrev + ImGuiNavInput_TweakSlow
+	ImGuiKey_GamepadR1          // R Bumper (Xbox)      R (Switch)   R1 (PS)            // -> ImGuiNavInput_FocusNext + ImGuiNavInput_TweakFast
+	ImGuiKey_GamepadL2          // L Trigger (Xbox)     ZL (Switch)  L2 (PS) [Analog]
+	ImGuiKey_GamepadR2          // R Trigger (Xbox)     ZR (Switch)  R2 (PS) [Analog]
+	ImGuiKey_GamepadL3          // L Thumbstick (Xbox)  L3 (Switch)  L3 (PS)
+	ImGuiKey_GamepadR3          // R Thumbstick (Xbox)  R3 (Switch)  R3 (PS)
+	ImGuiKey_GamepadLStickUp    // [Analog]                                             // -> ImGuiNavInput_LStickUp
+	ImGuiKey_GamepadLStickDown  // [Analog]                                             // -> ImGuiNavInput_LStickDown
+	ImGuiKey_GamepadLStickLeft  // [Analog]                                             // -> ImGuiNavInput_LStickLeft
+	ImGuiKey_GamepadLStickRight // [Analog]                                             // -> ImGuiNavInput_LStickRight
+	ImGuiKey_GamepadRStickUp    // [Analog]
+	ImGuiKey_GamepadRStickDown  // [Analog]
+	ImGuiKey_GamepadRStickLeft  // [Analog]
+	ImGuiKey_GamepadRStickRight // [Analog]
+
+	// Keyboard Modifiers
+	// - This is mirroring the data also written to io.KeyCtrl, io.KeyShift, io.KeyAlt, io.KeySuper, in a format allowing
+	//   them to be accessed via standard key API, allowing calls such as IsKeyPressed(), IsKeyReleased(), querying duration etc.
+	// - Code polling every keys (e.g. an interface to detect a key press for input mapping) might want to ignore those
+	//   and prefer using the real keys (e.g. ImGuiKey_LeftCtrl, ImGuiKey_RightCtrl instead of ImGuiKey_ModCtrl).
+	// - In theory the value of keyboard modifiers should be roughly equivalent to a logical or of the equivalent left/right keys.
+	//   In practice: it's complicated; mods are often provided from different sources. Keyboard layout, IME, sticky keys and
+	//   backends tend to interfere and break that equivalence. The safer decision is to relay that ambiguity down to the end-user...
+	ImGuiKey_ModCtrl
+	ImGuiKey_ModShift
+	ImGuiKey_ModAlt
+	ImGuiKey_ModSuper
+
+	// End of list
+	ImGuiKey_COUNT // No valid ImGuiKey is ever greater than this value
+)
+
+// [Internal] Prior to 1.87 we required user to fill io.KeysDown[512] using their own native index + a io.KeyMap[] array.
+// We are ditching this method but keeping a legacy path for user code doing e.g. IsKeyPressed(MY_NATIVE_KEY_CODE)
+const (
+	ImGuiKey_NamedKey_BEGIN                 = 512
+	ImGuiKey_NamedKey_END                   = ImGuiKey_COUNT
+	ImGuiKey_NamedKey_COUNT                 = ImGuiKey_NamedKey_END - ImGuiKey_NamedKey_BEGIN
+	ImGuiKey_KeysData_SIZE                  = ImGuiKey_COUNT // Size of KeysData[]: hold legacy 0..512 keycodes + named keys
+	ImGuiKey_KeysData_OFFSET                = 0              // First key stored in KeysData[0]
+	ImGuiKey_LegacyNativeKey_BEGIN          = 0
+	ImGuiKey_LegacyNativeKey_END            = 512
+	ImGuiKey_Gamepad_BEGIN                  = ImGuiKey_GamepadStart
+	ImGuiKey_Gamepad_END                    = ImGuiKey_GamepadRStickRight + 1
+	ImGuiKey_KeyPadEnter           ImGuiKey = ImGuiKey_KeypadEnter // Renamed in 1.87
 )
 
 // To test io.KeyMods (which is a combination of individual fields io.KeyCtrl, io.KeyShift, io.KeyAlt set by user/backend)
@@ -410,6 +606,33 @@ const (
 	ImGuiKeyModFlags_Super ImGuiKeyModFlags = 1 << 3
 )
 
+// Modifiers of a key chord: ImGuiKey_XXX combined with ImGuiMod_XXX, e.g. ImGuiMod_Ctrl | ImGuiKey_S.
+// Shifted by 12 bits, ImGuiMod_Ctrl..ImGuiMod_Super match ImGuiKeyModFlags_Ctrl..ImGuiKeyModFlags_Super.
+const (
+	ImGuiMod_None     ImGuiKey = 0
+	ImGuiMod_Ctrl     ImGuiKey = 1 << 12 // Ctrl
+	ImGuiMod_Shift    ImGuiKey = 1 << 13 // Shift
+	ImGuiMod_Alt      ImGuiKey = 1 << 14 // Option/Menu
+	ImGuiMod_Super    ImGuiKey = 1 << 15 // Cmd/Super/Windows
+	ImGuiMod_Shortcut ImGuiKey = 1 << 11 // Alias for Ctrl (non-macOS) _or_ Super (macOS), following io.ConfigMacOSXBehaviors
+	ImGuiMod_Mask_    ImGuiKey = 0xF800
+)
+
+// Flags for Shortcut(), SetShortcutRouting()
+// Routing: a key chord is given to a single owner per frame, the one which registered the route with the best score on the previous frame.
+const (
+	ImGuiInputFlags_None                 ImGuiInputFlags = 0
+	ImGuiInputFlags_Repeat               ImGuiInputFlags = 1 << 0  // Return true on successive repeats, using io.KeyRepeatDelay / io.KeyRepeatRate.
+	ImGuiInputFlags_RouteFocused         ImGuiInputFlags = 1 << 8  // (Default for Shortcut()) Accept inputs if the window is in the focus stack: the deep-most focused window takes inputs, then its parents. ActiveId takes inputs over the deep-most focused window. Can be combined with RouteGlobalLow to fall back to a global route when the window is not focused.
+	ImGuiInputFlags_RouteGlobalLow       ImGuiInputFlags = 1 << 9  // Register route globally (lowest priority: unless a focused window or active item registered the route) -> recommended Global priority.
+	ImGuiInputFlags_RouteGlobal          ImGuiInputFlags = 1 << 10 // Register route globally (medium priority: unless an active item registered the route, e.g. CTRL+A registered by InputText).
+	ImGuiInputFlags_RouteGlobalHigh      ImGuiInputFlags = 1 << 11 // (Default for SetShortcutRouting()) Register route globally (highest priority: unlikely you need to use that: will interfere with every active items)
+	ImGuiInputFlags_RouteAlways          ImGuiInputFlags = 1 << 12 // Do not register route, poll keys directly.
+	ImGuiInputFlags_RouteUnlessBgFocused ImGuiInputFlags = 1 << 13 // Global routes will not be applied if underlying background/void is focused (== no Dear ImGui windows are focused). Useful for overlay applications.
+
+	ImGuiInputFlags_RouteMask_ ImGuiInputFlags = ImGuiInputFlags_RouteFocused | ImGuiInputFlags_RouteGlobal | ImGuiInputFlags_RouteGlobalLow | ImGuiInputFlags_RouteGlobalHigh // _Always not part of this!
+)
+
 // Gamepad/Keyboard navigation
 // Keyboard: Set io.ConfigFlags |= ImGuiConfigFlags_NavEnableKeyboard to enable. NewFrame() will automatically fill io.NavInputs[] based on your io.KeysDown[] + io.KeyMap[] arrays.
 // Gamepad:  Set io.ConfigFlags |= ImGuiConfigFlags_NavEnableGamepad to enable. Backend: set ImGuiBackendFlags_HasGamepad and fill the io.NavInputs[] fields before calling NewFrame(). Note that io.NavInputs[] is cleared by EndFrame().
@@ -454,6 +677,13 @@ const (
 	ImGuiConfigFlags_NoMouse              ImGuiConfigFlags = 1 << 4 // Instruct imgui to clear mouse position/buttons in NewFrame(). This allows ignoring the mouse information set by the backend.
 	ImGuiConfigFlags_NoMouseCursorChange  ImGuiConfigFlags = 1 << 5 // Instruct backend to not alter mouse cursor shape and visibility. Use if the backend cursor changes are interfering with yours and you don't want to use SetMouseCursor() to change mouse cursor. You may want to honor requests from imgui by reading GetMouseCursor() yourself instead.
 
+	// [BETA] Docking
+	ImGuiConfigFlags_DockingEnable ImGuiConfigFlags = 1 << 6 // Docking enable flags.
+
+	// [BETA] Viewports
+	// When using viewports it is recommended that your default value for ImGuiCol_WindowBg is opaque (Alpha=1.0) so transition to a viewport won't be noticeable.
+	ImGuiConfigFlags_ViewportsEnable ImGuiConfigFlags = 1 << 10 // Viewport enable flags (require both ImGuiBackendFlags_PlatformHasViewports + ImGuiBackendFlags_RendererHasViewports set by the respective backends)
+
 	// User storage (to allow your backend/engine to communicate to code that may be shared between multiple projects. Those flags are not used by core Dear ImGui)
 	ImGuiConfigFlags_IsSRGB        = 1 << 20 // Application is SRGB-aware.
 	ImGuiConfigFlags_IsTouchScreen = 1 << 21 // Application is using a touch screen instead of a mouse.
@@ -466,6 +696,10 @@ const (
 	ImGuiBackendFlags_HasMouseCursors      ImGuiBackendFlags = 1 << 1 // Backend Platform supports honoring GetMouseCursor() value to change the OS cursor shape.
 	ImGuiBackendFlags_HasSetMousePos       ImGuiBackendFlags = 1 << 2 // Backend Platform supports io.WantSetMousePos requests to reposition the OS mouse position (only used if ImGuiConfigFlags_NavEnableSetMousePos is set).
 	ImGuiBackendFlags_RendererHasVtxOffset ImGuiBackendFlags = 1 << 3 // Backend Renderer supports ImDrawCmd::VtxOffset. This enables output of large meshes (64K+ vertices) while still using 16-bit indices.
+
+	// [BETA] Viewports
+	ImGuiBackendFlags_PlatformHasViewports ImGuiBackendFlags = 1 << 10 // Backend Platform supports multiple viewports.
+	ImGuiBackendFlags_RendererHasViewports ImGuiBackendFlags = 1 << 12 // Backend Renderer supports multiple viewports.
 )
 
 // Enumeration for PushStyleColor() / PopStyleColor()
@@ -508,6 +742,8 @@ const (
 	ImGuiCol_TabActive
 	ImGuiCol_TabUnfocused
 	ImGuiCol_TabUnfocusedActive
+	ImGuiCol_DockingPreview // Preview overlay color when about to docking something
+	ImGuiCol_DockingEmptyBg // Background color for empty node (e.g. CentralNode with no window docked into it)
 	ImGuiCol_PlotLines
 	ImGuiCol_PlotLinesHovered
 	ImGuiCol_PlotHistogram
@@ -663,10 +899,20 @@ const (
 
 // Flags stored in ImGuiViewport::Flags, giving indications to the platform backends.
 const (
-	ImGuiViewportFlags_None              ImGuiViewportFlags = 0
-	ImGuiViewportFlags_IsPlatformWindow  ImGuiViewportFlags = 1 << 0 // Represent a Platform Window
-	ImGuiViewportFlags_IsPlatformMonitor ImGuiViewportFlags = 1 << 1 // Represent a Platform Monitor (unused yet)
-	ImGuiViewportFlags_OwnedByApp        ImGuiViewportFlags = 1 << 2 // Platform Window: is created/managed by the application (rather than a dear imgui backend)
+	ImGuiViewportFlags_None                ImGuiViewportFlags = 0
+	ImGuiViewportFlags_IsPlatformWindow    ImGuiViewportFlags = 1 << 0  // Represent a Platform Window
+	ImGuiViewportFlags_IsPlatformMonitor   ImGuiViewportFlags = 1 << 1  // Represent a Platform Monitor (unused yet)
+	ImGuiViewportFlags_OwnedByApp          ImGuiViewportFlags = 1 << 2  // Platform Window: is created/managed by the application (rather than a dear imgui backend)
+	ImGuiViewportFlags_NoDecoration        ImGuiViewportFlags = 1 << 3  // Platform Window: Disable platform decorations: title bar, borders, etc. (set on all windows unless io.ConfigViewportsNoDecoration is cleared)
+	ImGuiViewportFlags_NoTaskBarIcon       ImGuiViewportFlags = 1 << 4  // Platform Window: Disable platform task bar icon (set on all windows when io.ConfigViewportsNoTaskBarIcon is set)
+	ImGuiViewportFlags_NoFocusOnAppearing  ImGuiViewportFlags = 1 << 5  // Platform Window: Don't take focus when created.
+	ImGuiViewportFlags_NoFocusOnClick      ImGuiViewportFlags = 1 << 6  // Platform Window: Don't take focus when clicked on.
+	ImGuiViewportFlags_NoInputs            ImGuiViewportFlags = 1 << 7  // Platform Window: Make mouse pass through so we can drag this window while peaking behind it.
+	ImGuiViewportFlags_NoRendererClear     ImGuiViewportFlags = 1 << 8  // Platform Window: Renderer doesn't need to clear the framebuffer ahead (because we will fill it entirely).
+	ImGuiViewportFlags_TopMost             ImGuiViewportFlags = 1 << 9  // Platform Window: Display on top (for tooltips only).
+	ImGuiViewportFlags_Minimized           ImGuiViewportFlags = 1 << 10 // Platform Window: Window is minimized, can skip render. When minimized we tend to avoid using the viewport pos/size for clipping window or testing if they are contained in the viewport.
+	ImGuiViewportFlags_NoAutoMerge         ImGuiViewportFlags = 1 << 11 // Platform Window: Avoid merging this window into another host window (set on all windows when io.ConfigViewportsNoAutoMerge is set).
+	ImGuiViewportFlags_CanHostOtherWindows ImGuiViewportFlags = 1 << 12 // Main viewport: can host multiple imgui windows (secondary viewports are associated to a single window).
 )
 
 //-----------------------------------------------------------------------------
@@ -677,15 +923,17 @@ const (
 // This is going to be exposed in imgui.h when stabilized enough.
 const (
 	ImGuiItemFlags_None                     ImGuiItemFlags = 0
-	ImGuiItemFlags_NoTabStop                ImGuiItemFlags = 1 << 0 // false     // Disable keyboard tabbing (FIXME: should merge with _NoNav)
-	ImGuiItemFlags_ButtonRepeat             ImGuiItemFlags = 1 << 1 // false     // Button() will return true multiple times based on io.KeyRepeatDelay and io.KeyRepeatRate settings.
-	ImGuiItemFlags_Disabled                 ImGuiItemFlags = 1 << 2 // false     // Disable interactions but doesn't affect visuals. See BeginDisabled()/EndDisabled(). See github.com/ocornut/imgui/issues/211
-	ImGuiItemFlags_NoNav                    ImGuiItemFlags = 1 << 3 // false     // Disable keyboard/gamepad directional navigation (FIXME: should merge with _NoTabStop)
-	ImGuiItemFlags_NoNavDefaultFocus        ImGuiItemFlags = 1 << 4 // false     // Disable item being a candidate for default focus (e.g. used by title bar items)
-	ImGuiItemFlags_SelectableDontClosePopup ImGuiItemFlags = 1 << 5 // false     // Disable MenuItem/Selectable() automatically closing their popup window
-	ImGuiItemFlags_MixedValue               ImGuiItemFlags = 1 << 6 // false     // [BETA] Represent a mixed/indeterminate value, generally multi-selection where values differ. Currently only supported by Checkbox() (later should support all sorts of widgets)
-	ImGuiItemFlags_ReadOnly                 ImGuiItemFlags = 1 << 7 // false     // [ALPHA] Allow hovering interactions but underlying value is not changed.
-	ImGuiItemFlags_Inputable                ImGuiItemFlags = 1 << 8 // false     // [WIP] Auto-activate item when focused. Currently only used and supported by a few items before it becomes a generic feature.
+	ImGuiItemFlags_NoTabStop                ImGuiItemFlags = 1 << 0  // false     // Disable keyboard tabbing (FIXME: should merge with _NoNav)
+	ImGuiItemFlags_ButtonRepeat             ImGuiItemFlags = 1 << 1  // false     // Button() will return true multiple times based on io.KeyRepeatDelay and io.KeyRepeatRate settings.
+	ImGuiItemFlags_Disabled                 ImGuiItemFlags = 1 << 2  // false     // Disable interactions but doesn't affect visuals. See BeginDisabled()/EndDisabled(). See github.com/ocornut/imgui/issues/211
+	ImGuiItemFlags_NoNav                    ImGuiItemFlags = 1 << 3  // false     // Disable keyboard/gamepad directional navigation (FIXME: should merge with _NoTabStop)
+	ImGuiItemFlags_NoNavDefaultFocus        ImGuiItemFlags = 1 << 4  // false     // Disable item being a candidate for default focus (e.g. used by title bar items)
+	ImGuiItemFlags_SelectableDontClosePopup ImGuiItemFlags = 1 << 5  // false     // Disable MenuItem/Selectable() automatically closing their popup window
+	ImGuiItemFlags_MixedValue               ImGuiItemFlags = 1 << 6  // false     // [BETA] Represent a mixed/indeterminate value, generally multi-selection where values differ. Currently only supported by Checkbox() (later should support all sorts of widgets)
+	ImGuiItemFlags_ReadOnly                 ImGuiItemFlags = 1 << 7  // false     // [ALPHA] Allow hovering interactions but underlying value is not changed.
+	ImGuiItemFlags_Inputable                ImGuiItemFlags = 1 << 8  // false     // [WIP] Auto-activate item when focused. Currently only used and supported by a few items before it becomes a generic feature.
+	ImGuiItemFlags_HasSelectionUserData     ImGuiItemFlags = 1 << 9  // false     // Set by SetNextItemSelectionUserData()
+	ImGuiItemFlags_IsMultiSelect            ImGuiItemFlags = 1 << 10 // false     // Set by SetNextItemSelectionUserData() within a BeginMultiSelect()/EndMultiSelect() scope
 )
 
 // Storage for LastItem data
@@ -702,6 +950,12 @@ const (
 	ImGuiItemStatusFlags_FocusedByCode    ImGuiItemStatusFlags = 1 << 8 // Set when the Focusable item just got focused from code.
 	ImGuiItemStatusFlags_FocusedByTabbing ImGuiItemStatusFlags = 1 << 9 // Set when the Focusable item just got focused by Tabbing.
 	ImGuiItemStatusFlags_Focused                               = ImGuiItemStatusFlags_FocusedByCode | ImGuiItemStatusFlags_FocusedByTabbing
+
+	// Additional status + semantic for the test engine
+	ImGuiItemStatusFlags_Openable  ImGuiItemStatusFlags = 1 << 20 //
+	ImGuiItemStatusFlags_Opened    ImGuiItemStatusFlags = 1 << 21 //
+	ImGuiItemStatusFlags_Checkable ImGuiItemStatusFlags = 1 << 22 //
+	ImGuiItemStatusFlags_Checked   ImGuiItemStatusFlags = 1 << 23 //
 )
 
 // Extend ImGuiInputTextFlags_
@@ -795,6 +1049,26 @@ const (
 	ImGuiLogType_File
 	ImGuiLogType_Buffer
 	ImGuiLogType_Clipboard
+	ImGuiLogType_Writer
+	ImGuiLogType_Capture
+)
+
+// ImGuiCaptureNodeType is the kind of an ImGuiCaptureNode recorded by LogToCapture()
+type ImGuiCaptureNodeType int
+
+const (
+	ImGuiCaptureNodeType_Window         ImGuiCaptureNodeType = iota // Root of a capture, Label is the window name
+	ImGuiCaptureNodeType_Text                                       // Label is the text
+	ImGuiCaptureNodeType_Button                                     // Label is the button label
+	ImGuiCaptureNodeType_Value                                      // Value of a drag/slider/input/combo, Label is the widget label rendered after it
+	ImGuiCaptureNodeType_Checkbox                                   // Value is "true", "false" or "mixed"
+	ImGuiCaptureNodeType_RadioButton                                // Value is "true" or "false"
+	ImGuiCaptureNodeType_TreeNode                                   // Tree node or collapsing header, Children are the items submitted while it is open
+	ImGuiCaptureNodeType_Separator                                  // Horizontal separator
+	ImGuiCaptureNodeType_Table                                      // Label is the table name, Columns are the column names
+	ImGuiCaptureNodeType_TableRow                                   // Children are the cells
+	ImGuiCaptureNodeType_TableHeaderRow                             // Row submitted with ImGuiTableRowFlags_Headers
+	ImGuiCaptureNodeType_TableCell                                  // Children are the items submitted in the cell
 )
 
 type ImGuiAxis int
@@ -825,6 +1099,21 @@ const (
 	ImGuiInputSource_COUNT
 )
 
+type ImGuiInputEventType int
+
+const (
+	ImGuiInputEventType_None ImGuiInputEventType = iota
+	ImGuiInputEventType_MousePos
+	ImGuiInputEventType_MouseWheel
+	ImGuiInputEventType_MouseButton
+	ImGuiInputEventType_Key
+	ImGuiInputEventType_KeyMods
+	ImGuiInputEventType_Text
+	ImGuiInputEventType_Focus
+	ImGuiInputEventType_Drop
+	ImGuiInputEventType_COUNT
+)
+
 type ImGuiInputReadMode int
 
 // FIXME-NAV: Clarify/expose various repeat delay/rate
@@ -862,12 +1151,15 @@ const (
 	ImGuiNextWindowDataFlags_HasFocus          ImGuiNextWindowDataFlags = 1 << 5
 	ImGuiNextWindowDataFlags_HasBgAlpha        ImGuiNextWindowDataFlags = 1 << 6
 	ImGuiNextWindowDataFlags_HasScroll         ImGuiNextWindowDataFlags = 1 << 7
+	ImGuiNextWindowDataFlags_HasDock           ImGuiNextWindowDataFlags = 1 << 8
+	ImGuiNextWindowDataFlags_HasViewport       ImGuiNextWindowDataFlags = 1 << 9
 )
 
 const (
-	ImGuiNextItemDataFlags_None     ImGuiNextItemDataFlags = 0
-	ImGuiNextItemDataFlags_HasWidth ImGuiNextItemDataFlags = 1 << 0
-	ImGuiNextItemDataFlags_HasOpen  ImGuiNextItemDataFlags = 1 << 1
+	ImGuiNextItemDataFlags_None                 ImGuiNextItemDataFlags = 0
+	ImGuiNextItemDataFlags_HasWidth             ImGuiNextItemDataFlags = 1 << 0
+	ImGuiNextItemDataFlags_HasOpen              ImGuiNextItemDataFlags = 1 << 1
+	ImGuiNextItemDataFlags_HasSelectionUserData ImGuiNextItemDataFlags = 1 << 2
 )
 
 //-----------------------------------------------------------------------------
@@ -933,6 +1225,27 @@ const (
 	ImGuiContextHookType_PendingRemoval_
 )
 
+//-----------------------------------------------------------------------------
+// [SECTION] Docking support
+//-----------------------------------------------------------------------------
+
+// Extend ImGuiDockNodeFlags_
+const (
+	ImGuiDockNodeFlags_DockSpace               ImGuiDockNodeFlags = 1 << 10 // Local, Saved  // A dockspace is a node that occupy space within an existing user window. Otherwise the node is floating and create its own window.
+	ImGuiDockNodeFlags_CentralNode             ImGuiDockNodeFlags = 1 << 11 // Local, Saved  // The central node has 2 main properties: stay visible when empty, only use "remaining" spaces from its neighbor.
+	ImGuiDockNodeFlags_SharedFlagsInheritMask_                    = ^ImGuiDockNodeFlags(0)
+	ImGuiDockNodeFlags_LocalFlagsMask_                            = ImGuiDockNodeFlags_NoSplit | ImGuiDockNodeFlags_NoResize | ImGuiDockNodeFlags_AutoHideTabBar | ImGuiDockNodeFlags_DockSpace | ImGuiDockNodeFlags_CentralNode
+	ImGuiDockNodeFlags_LocalFlagsTransferMask_                    = ImGuiDockNodeFlags_LocalFlagsMask_ & ^ImGuiDockNodeFlags_DockSpace // When splitting those flags are moved to the inheriting child, never duplicated
+	ImGuiDockNodeFlags_SavedFlagsMask_                            = ImGuiDockNodeFlags_NoResize | ImGuiDockNodeFlags_DockSpace | ImGuiDockNodeFlags_CentralNode
+)
+
+// ImGuiDockRequestType The type of a queued ImGuiDockRequest
+const (
+	ImGuiDockRequestType_None ImGuiDockRequestType = iota
+	ImGuiDockRequestType_Dock
+	ImGuiDockRequestType_Undock
+)
+
 //-----------------------------------------------------------------------------
 // [SECTION] Tab bar, Tab item support
 //-----------------------------------------------------------------------------
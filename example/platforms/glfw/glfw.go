@@ -46,8 +46,9 @@ type GLFW struct {
 
 	window *glfw.Window
 
-	time             float64
-	mouseJustPressed [3]bool
+	time            float64
+	lastValidMouseX float64
+	lastValidMouseY float64
 }
 
 // NewGLFW attempts to initialize a GLFW context.
@@ -85,7 +86,6 @@ func NewGLFW(io *imgui.ImGuiIO, clientAPI GLFWClientAPI) (*GLFW, error) {
 		imguiIO: io,
 		window:  window,
 	}
-	platform.setKeyMapping()
 	platform.installCallbacks()
 
 	return platform, nil
@@ -132,19 +132,7 @@ func (platform *GLFW) NewFrame() {
 	}
 	platform.time = currentTime
 
-	// Setup inputs
-	if platform.window.GetAttrib(glfw.Focused) != 0 {
-		x, y := platform.window.GetCursorPos()
-		platform.imguiIO.MousePos = *imgui.NewImVec2(float32(x), float32(y))
-	} else {
-		platform.imguiIO.MousePos = *imgui.NewImVec2(-math.MaxFloat32, -math.MaxFloat32)
-	}
-
-	for i := 0; i < len(platform.mouseJustPressed); i++ {
-		down := platform.mouseJustPressed[i] || (platform.window.GetMouseButton(glfwButtonIDByIndex[i]) == glfw.Press)
-		platform.imguiIO.MouseDown[i] = down
-		platform.mouseJustPressed[i] = false
-	}
+	// Inputs are queued as events by the callbacks, imgui processes them in imgui.NewFrame().
 }
 
 // PostRender performs a buffer swap.
@@ -157,36 +145,15 @@ func (platform *GLFW) SwapInterval(interval int) {
 	glfw.SwapInterval(interval)
 }
 
-func (platform *GLFW) setKeyMapping() {
-	// Keyboard mapping. ImGui will use those indices to peek into the io.KeysDown[] array.
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Tab] = int32(glfw.KeyTab)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_LeftArrow] = int32(glfw.KeyLeft)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_RightArrow] = int32(glfw.KeyRight)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_UpArrow] = int32(glfw.KeyUp)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_DownArrow] = int32(glfw.KeyDown)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_PageUp] = int32(glfw.KeyPageUp)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_PageDown] = int32(glfw.KeyPageDown)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Home] = int32(glfw.KeyHome)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_End] = int32(glfw.KeyEnd)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Insert] = int32(glfw.KeyInsert)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Delete] = int32(glfw.KeyDelete)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Backspace] = int32(glfw.KeyBackspace)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Space] = int32(glfw.KeySpace)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Enter] = int32(glfw.KeyEnter)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Escape] = int32(glfw.KeyEscape)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_A] = int32(glfw.KeyA)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_C] = int32(glfw.KeyC)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_V] = int32(glfw.KeyV)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_X] = int32(glfw.KeyX)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Y] = int32(glfw.KeyY)
-	platform.imguiIO.KeyMap[imgui.ImGuiKey_Z] = int32(glfw.KeyZ)
-}
-
 func (platform *GLFW) installCallbacks() {
 	platform.window.SetMouseButtonCallback(platform.mouseButtonChange)
+	platform.window.SetCursorPosCallback(platform.cursorPosChange)
+	platform.window.SetCursorEnterCallback(platform.cursorEnterChange)
+	platform.window.SetFocusCallback(platform.focusChange)
 	platform.window.SetScrollCallback(platform.mouseScrollChange)
 	platform.window.SetKeyCallback(platform.keyChange)
 	platform.window.SetCharCallback(platform.charChange)
+	platform.window.SetDropCallback(platform.dropChange)
 }
 
 var glfwButtonIndexByID = map[glfw.MouseButton]int{
@@ -195,43 +162,182 @@ var glfwButtonIndexByID = map[glfw.MouseButton]int{
 	glfw.MouseButton3: mouseButtonTertiary,
 }
 
-var glfwButtonIDByIndex = map[int]glfw.MouseButton{
-	mouseButtonPrimary:   glfw.MouseButton1,
-	mouseButtonSecondary: glfw.MouseButton2,
-	mouseButtonTertiary:  glfw.MouseButton3,
+var glfwKeyToImGuiKey = map[glfw.Key]imgui.ImGuiKey{
+	glfw.KeyTab:          imgui.ImGuiKey_Tab,
+	glfw.KeyLeft:         imgui.ImGuiKey_LeftArrow,
+	glfw.KeyRight:        imgui.ImGuiKey_RightArrow,
+	glfw.KeyUp:           imgui.ImGuiKey_UpArrow,
+	glfw.KeyDown:         imgui.ImGuiKey_DownArrow,
+	glfw.KeyPageUp:       imgui.ImGuiKey_PageUp,
+	glfw.KeyPageDown:     imgui.ImGuiKey_PageDown,
+	glfw.KeyHome:         imgui.ImGuiKey_Home,
+	glfw.KeyEnd:          imgui.ImGuiKey_End,
+	glfw.KeyInsert:       imgui.ImGuiKey_Insert,
+	glfw.KeyDelete:       imgui.ImGuiKey_Delete,
+	glfw.KeyBackspace:    imgui.ImGuiKey_Backspace,
+	glfw.KeySpace:        imgui.ImGuiKey_Space,
+	glfw.KeyEnter:        imgui.ImGuiKey_Enter,
+	glfw.KeyEscape:       imgui.ImGuiKey_Escape,
+	glfw.KeyApostrophe:   imgui.ImGuiKey_Apostrophe,
+	glfw.KeyComma:        imgui.ImGuiKey_Comma,
+	glfw.KeyMinus:        imgui.ImGuiKey_Minus,
+	glfw.KeyPeriod:       imgui.ImGuiKey_Period,
+	glfw.KeySlash:        imgui.ImGuiKey_Slash,
+	glfw.KeySemicolon:    imgui.ImGuiKey_Semicolon,
+	glfw.KeyEqual:        imgui.ImGuiKey_Equal,
+	glfw.KeyLeftBracket:  imgui.ImGuiKey_LeftBracket,
+	glfw.KeyBackslash:    imgui.ImGuiKey_Backslash,
+	glfw.KeyRightBracket: imgui.ImGuiKey_RightBracket,
+	glfw.KeyGraveAccent:  imgui.ImGuiKey_GraveAccent,
+	glfw.KeyCapsLock:     imgui.ImGuiKey_CapsLock,
+	glfw.KeyScrollLock:   imgui.ImGuiKey_ScrollLock,
+	glfw.KeyNumLock:      imgui.ImGuiKey_NumLock,
+	glfw.KeyPrintScreen:  imgui.ImGuiKey_PrintScreen,
+	glfw.KeyPause:        imgui.ImGuiKey_Pause,
+	glfw.KeyKP0:          imgui.ImGuiKey_Keypad0,
+	glfw.KeyKP1:          imgui.ImGuiKey_Keypad1,
+	glfw.KeyKP2:          imgui.ImGuiKey_Keypad2,
+	glfw.KeyKP3:          imgui.ImGuiKey_Keypad3,
+	glfw.KeyKP4:          imgui.ImGuiKey_Keypad4,
+	glfw.KeyKP5:          imgui.ImGuiKey_Keypad5,
+	glfw.KeyKP6:          imgui.ImGuiKey_Keypad6,
+	glfw.KeyKP7:          imgui.ImGuiKey_Keypad7,
+	glfw.KeyKP8:          imgui.ImGuiKey_Keypad8,
+	glfw.KeyKP9:          imgui.ImGuiKey_Keypad9,
+	glfw.KeyKPDecimal:    imgui.ImGuiKey_KeypadDecimal,
+	glfw.KeyKPDivide:     imgui.ImGuiKey_KeypadDivide,
+	glfw.KeyKPMultiply:   imgui.ImGuiKey_KeypadMultiply,
+	glfw.KeyKPSubtract:   imgui.ImGuiKey_KeypadSubtract,
+	glfw.KeyKPAdd:        imgui.ImGuiKey_KeypadAdd,
+	glfw.KeyKPEnter:      imgui.ImGuiKey_KeypadEnter,
+	glfw.KeyKPEqual:      imgui.ImGuiKey_KeypadEqual,
+	glfw.KeyLeftShift:    imgui.ImGuiKey_LeftShift,
+	glfw.KeyLeftControl:  imgui.ImGuiKey_LeftCtrl,
+	glfw.KeyLeftAlt:      imgui.ImGuiKey_LeftAlt,
+	glfw.KeyLeftSuper:    imgui.ImGuiKey_LeftSuper,
+	glfw.KeyRightShift:   imgui.ImGuiKey_RightShift,
+	glfw.KeyRightControl: imgui.ImGuiKey_RightCtrl,
+	glfw.KeyRightAlt:     imgui.ImGuiKey_RightAlt,
+	glfw.KeyRightSuper:   imgui.ImGuiKey_RightSuper,
+	glfw.KeyMenu:         imgui.ImGuiKey_Menu,
+	glfw.Key0:            imgui.ImGuiKey_0,
+	glfw.Key1:            imgui.ImGuiKey_1,
+	glfw.Key2:            imgui.ImGuiKey_2,
+	glfw.Key3:            imgui.ImGuiKey_3,
+	glfw.Key4:            imgui.ImGuiKey_4,
+	glfw.Key5:            imgui.ImGuiKey_5,
+	glfw.Key6:            imgui.ImGuiKey_6,
+	glfw.Key7:            imgui.ImGuiKey_7,
+	glfw.Key8:            imgui.ImGuiKey_8,
+	glfw.Key9:            imgui.ImGuiKey_9,
+	glfw.KeyA:            imgui.ImGuiKey_A,
+	glfw.KeyB:            imgui.ImGuiKey_B,
+	glfw.KeyC:            imgui.ImGuiKey_C,
+	glfw.KeyD:            imgui.ImGuiKey_D,
+	glfw.KeyE:            imgui.ImGuiKey_E,
+	glfw.KeyF:            imgui.ImGuiKey_F,
+	glfw.KeyG:            imgui.ImGuiKey_G,
+	glfw.KeyH:            imgui.ImGuiKey_H,
+	glfw.KeyI:            imgui.ImGuiKey_I,
+	glfw.KeyJ:            imgui.ImGuiKey_J,
+	glfw.KeyK:            imgui.ImGuiKey_K,
+	glfw.KeyL:            imgui.ImGuiKey_L,
+	glfw.KeyM:            imgui.ImGuiKey_M,
+	glfw.KeyN:            imgui.ImGuiKey_N,
+	glfw.KeyO:            imgui.ImGuiKey_O,
+	glfw.KeyP:            imgui.ImGuiKey_P,
+	glfw.KeyQ:            imgui.ImGuiKey_Q,
+	glfw.KeyR:            imgui.ImGuiKey_R,
+	glfw.KeyS:            imgui.ImGuiKey_S,
+	glfw.KeyT:            imgui.ImGuiKey_T,
+	glfw.KeyU:            imgui.ImGuiKey_U,
+	glfw.KeyV:            imgui.ImGuiKey_V,
+	glfw.KeyW:            imgui.ImGuiKey_W,
+	glfw.KeyX:            imgui.ImGuiKey_X,
+	glfw.KeyY:            imgui.ImGuiKey_Y,
+	glfw.KeyZ:            imgui.ImGuiKey_Z,
+	glfw.KeyF1:           imgui.ImGuiKey_F1,
+	glfw.KeyF2:           imgui.ImGuiKey_F2,
+	glfw.KeyF3:           imgui.ImGuiKey_F3,
+	glfw.KeyF4:           imgui.ImGuiKey_F4,
+	glfw.KeyF5:           imgui.ImGuiKey_F5,
+	glfw.KeyF6:           imgui.ImGuiKey_F6,
+	glfw.KeyF7:           imgui.ImGuiKey_F7,
+	glfw.KeyF8:           imgui.ImGuiKey_F8,
+	glfw.KeyF9:           imgui.ImGuiKey_F9,
+	glfw.KeyF10:          imgui.ImGuiKey_F10,
+	glfw.KeyF11:          imgui.ImGuiKey_F11,
+	glfw.KeyF12:          imgui.ImGuiKey_F12,
 }
 
 func (platform *GLFW) mouseButtonChange(window *glfw.Window, rawButton glfw.MouseButton, action glfw.Action, mods glfw.ModifierKey) {
+	platform.updateKeyMods(mods)
+
 	buttonIndex, known := glfwButtonIndexByID[rawButton]
+	if known && (action == glfw.Press || action == glfw.Release) {
+		platform.imguiIO.AddMouseButtonEvent(int32(buttonIndex), action == glfw.Press)
+	}
+}
+
+func (platform *GLFW) cursorPosChange(window *glfw.Window, x, y float64) {
+	platform.imguiIO.AddMousePosEvent(float32(x), float32(y))
+	platform.lastValidMouseX, platform.lastValidMouseY = x, y
+}
 
-	if known && (action == glfw.Press) {
-		platform.mouseJustPressed[buttonIndex] = true
+func (platform *GLFW) cursorEnterChange(window *glfw.Window, entered bool) {
+	if entered {
+		platform.imguiIO.AddMousePosEvent(float32(platform.lastValidMouseX), float32(platform.lastValidMouseY))
+	} else {
+		platform.imguiIO.AddMousePosEvent(-math.MaxFloat32, -math.MaxFloat32)
 	}
 }
 
+func (platform *GLFW) focusChange(window *glfw.Window, focused bool) {
+	platform.imguiIO.AddFocusEvent(focused)
+}
+
 func (platform *GLFW) mouseScrollChange(window *glfw.Window, x, y float64) {
-	platform.imguiIO.MouseWheelH += float32(x)
-	platform.imguiIO.MouseWheel += float32(y)
+	platform.imguiIO.AddMouseWheelEvent(float32(x), float32(y))
 }
 
 func (platform *GLFW) keyChange(window *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mods glfw.ModifierKey) {
-	if action == glfw.Press {
-		platform.imguiIO.KeysDown[key] = true
+	if action != glfw.Press && action != glfw.Release {
+		return
 	}
-	if action == glfw.Release {
-		platform.imguiIO.KeysDown[key] = false
+	platform.updateKeyMods(mods)
+
+	if imguiKey, known := glfwKeyToImGuiKey[key]; known {
+		platform.imguiIO.AddKeyEvent(imguiKey, action == glfw.Press)
 	}
+}
 
-	platform.imguiIO.KeyCtrl = (mods & glfw.ModControl) != 0
-	platform.imguiIO.KeyShift = (mods & glfw.ModShift) != 0
-	platform.imguiIO.KeyAlt = (mods & glfw.ModAlt) != 0
-	platform.imguiIO.KeySuper = (mods & glfw.ModSuper) != 0
+func (platform *GLFW) updateKeyMods(mods glfw.ModifierKey) {
+	var keyMods imgui.ImGuiKeyModFlags
+	if (mods & glfw.ModControl) != 0 {
+		keyMods |= imgui.ImGuiKeyModFlags_Ctrl
+	}
+	if (mods & glfw.ModShift) != 0 {
+		keyMods |= imgui.ImGuiKeyModFlags_Shift
+	}
+	if (mods & glfw.ModAlt) != 0 {
+		keyMods |= imgui.ImGuiKeyModFlags_Alt
+	}
+	if (mods & glfw.ModSuper) != 0 {
+		keyMods |= imgui.ImGuiKeyModFlags_Super
+	}
+	platform.imguiIO.AddKeyModsEvent(keyMods)
 }
 
 func (platform *GLFW) charChange(window *glfw.Window, char rune) {
 	platform.imguiIO.AddInputCharacter(char)
 }
 
+func (platform *GLFW) dropChange(window *glfw.Window, names []string) {
+	// GLFW doesn't report where the files were dropped, use the cursor position at the time of the drop.
+	x, y := window.GetCursorPos()
+	platform.imguiIO.AddExternalDrop(names, float32(x), float32(y))
+}
+
 // ClipboardText returns the current clipboard text, if available.
 func (platform *GLFW) ClipboardText() (string, error) {
 	return platform.window.GetClipboardString()
@@ -0,0 +1,257 @@
+// Package headless implements a platform without any window or display server.
+// Inputs are provided programmatically and frames are driven at a fixed timestep,
+// so that a whole imgui session can be scripted from a Go test or a batch tool.
+package headless
+
+import (
+	"math"
+
+	"github.com/Splizard/imgui"
+)
+
+const (
+	defaultDeltaTime = 1.0 / 60.0
+
+	mouseButtonCount = 3
+)
+
+// InputState describes the input of the platform for a single frame.
+// KeysDown is indexed by the named imgui.ImGuiKey values, e.g. KeysDown[imgui.ImGuiKey_Enter].
+type InputState struct {
+	MousePos    [2]float32 // Mouse position, use NoMousePos when the mouse is unavailable.
+	MouseDown   [mouseButtonCount]bool
+	MouseWheel  float32 // Vertical wheel delta, cleared after each frame.
+	MouseWheelH float32 // Horizontal wheel delta, cleared after each frame.
+
+	KeysDown [imgui.ImGuiKey_COUNT]bool
+	KeyCtrl  bool
+	KeyShift bool
+	KeyAlt   bool
+	KeySuper bool
+
+	Chars []rune // Text input, cleared after each frame.
+}
+
+// NoMousePos is the mouse position reported when the mouse is unavailable.
+var NoMousePos = [2]float32{-math.MaxFloat32, -math.MaxFloat32}
+
+// InputSource provides the input of the platform.
+type InputSource interface {
+	// Input is called once per frame, before imgui.NewFrame(), to update the state
+	// carried over from the previous frame.
+	Input(frame int, state *InputState)
+}
+
+// InputSourceFunc adapts an ordinary function to an InputSource.
+type InputSourceFunc func(frame int, state *InputState)
+
+// Input calls f(frame, state).
+func (f InputSourceFunc) Input(frame int, state *InputState) {
+	f(frame, state)
+}
+
+// Renderer covers rendering imgui draw data.
+type Renderer interface {
+	// PreRender causes the display buffer to be prepared for new output.
+	PreRender(clearColor [3]float32)
+	// Render draws the provided imgui draw data.
+	Render(displaySize [2]float32, framebufferSize [2]float32, drawData *imgui.ImDrawData)
+}
+
+// Headless implements a platform that has no window.
+type Headless struct {
+	imguiIO *imgui.ImGuiIO
+
+	displaySize      [2]float32
+	framebufferScale [2]float32
+	deltaTime        float32
+	clearColor       [3]float32
+
+	source InputSource
+	state  InputState
+	sent   InputState // State forwarded to imgui by the previous frame.
+
+	frame int
+	time  float64
+	stop  bool
+
+	viewports bool      // Set by EnableViewports.
+	windows   []*Window // Platform windows of the secondary viewports.
+}
+
+// NewHeadless initializes a platform with a virtual display of the given size.
+func NewHeadless(io *imgui.ImGuiIO, width, height int) *Headless {
+	platform := &Headless{
+		imguiIO:          io,
+		displaySize:      [2]float32{float32(width), float32(height)},
+		framebufferScale: [2]float32{1, 1},
+		deltaTime:        defaultDeltaTime,
+		state:            InputState{MousePos: NoMousePos},
+	}
+	io.BackendPlatformName = "imgui_impl_headless"
+	return platform
+}
+
+// Dispose cleans up the resources.
+func (platform *Headless) Dispose() {
+	platform.source = nil
+}
+
+// SetInputSource sets the source polled for input on every frame, nil to only modify Input() directly.
+func (platform *Headless) SetInputSource(source InputSource) {
+	platform.source = source
+}
+
+// SetDeltaTime sets the fixed timestep between two frames, in seconds.
+func (platform *Headless) SetDeltaTime(seconds float32) {
+	platform.deltaTime = seconds
+}
+
+// SetDisplaySize resizes the virtual display.
+func (platform *Headless) SetDisplaySize(width, height int) {
+	platform.displaySize = [2]float32{float32(width), float32(height)}
+}
+
+// SetFramebufferScale sets the amount of framebuffer pixels per display unit, (2,2) to emulate a retina display.
+func (platform *Headless) SetFramebufferScale(x, y float32) {
+	platform.framebufferScale = [2]float32{x, y}
+}
+
+// SetClearColor sets the color passed to Renderer.PreRender by Run.
+func (platform *Headless) SetClearColor(clearColor [3]float32) {
+	platform.clearColor = clearColor
+}
+
+// Input returns the input state that will be forwarded to imgui on the next frame.
+func (platform *Headless) Input() *InputState {
+	return &platform.state
+}
+
+// Frame returns the number of frames started so far.
+func (platform *Headless) Frame() int {
+	return platform.frame
+}
+
+// Time returns the simulated time, in seconds.
+func (platform *Headless) Time() float64 {
+	return platform.time
+}
+
+// ShouldStop returns true once Stop has been called.
+func (platform *Headless) ShouldStop() bool {
+	return platform.stop
+}
+
+// Stop requests the frame loop to end after the current frame.
+func (platform *Headless) Stop() {
+	platform.stop = true
+}
+
+// ProcessEvents polls the input source for the input of the next frame.
+func (platform *Headless) ProcessEvents() {
+	if platform.source != nil {
+		platform.source.Input(platform.frame, &platform.state)
+	}
+}
+
+// DisplaySize returns the dimension of the display.
+func (platform *Headless) DisplaySize() [2]float32 {
+	return platform.displaySize
+}
+
+// FramebufferSize returns the dimension of the framebuffer.
+func (platform *Headless) FramebufferSize() [2]float32 {
+	return [2]float32{
+		platform.displaySize[0] * platform.framebufferScale[0],
+		platform.displaySize[1] * platform.framebufferScale[1],
+	}
+}
+
+// NewFrame marks the begin of a render pass. It forwards all current state to imgui IO.
+func (platform *Headless) NewFrame() {
+	io := platform.imguiIO
+
+	// Setup display size and time step
+	io.DisplaySize = *imgui.NewImVec2(platform.displaySize[0], platform.displaySize[1])
+	io.DisplayFramebufferScale = *imgui.NewImVec2(platform.framebufferScale[0], platform.framebufferScale[1])
+	io.DeltaTime = platform.deltaTime
+	platform.time += float64(platform.deltaTime)
+	platform.frame++
+
+	// Setup inputs, the changes since the previous frame are queued as input events
+	state, sent := &platform.state, &platform.sent
+	if state.MousePos != sent.MousePos {
+		io.AddMousePosEvent(state.MousePos[0], state.MousePos[1])
+	}
+	for i := 0; i < mouseButtonCount; i++ {
+		if state.MouseDown[i] != sent.MouseDown[i] {
+			io.AddMouseButtonEvent(int32(i), state.MouseDown[i])
+		}
+	}
+	io.AddMouseWheelEvent(state.MouseWheelH, state.MouseWheel)
+	state.MouseWheel, state.MouseWheelH = 0, 0
+
+	for key := imgui.ImGuiKey(imgui.ImGuiKey_NamedKey_BEGIN); key < imgui.ImGuiKey_NamedKey_END; key++ {
+		if state.KeysDown[key] != sent.KeysDown[key] {
+			io.AddKeyEvent(key, state.KeysDown[key])
+		}
+	}
+	if state.KeyCtrl != sent.KeyCtrl || state.KeyShift != sent.KeyShift || state.KeyAlt != sent.KeyAlt || state.KeySuper != sent.KeySuper {
+		io.AddKeyModsEvent(platform.keyMods())
+	}
+
+	for _, char := range state.Chars {
+		io.AddInputCharacter(char)
+	}
+	state.Chars = state.Chars[:0]
+
+	*sent = *state
+	sent.Chars = nil
+}
+
+func (platform *Headless) keyMods() imgui.ImGuiKeyModFlags {
+	var mods imgui.ImGuiKeyModFlags
+	state := &platform.state
+	if state.KeyCtrl {
+		mods |= imgui.ImGuiKeyModFlags_Ctrl
+	}
+	if state.KeyShift {
+		mods |= imgui.ImGuiKeyModFlags_Shift
+	}
+	if state.KeyAlt {
+		mods |= imgui.ImGuiKeyModFlags_Alt
+	}
+	if state.KeySuper {
+		mods |= imgui.ImGuiKeyModFlags_Super
+	}
+	return mods
+}
+
+// PostRender completes a render pass, there is no buffer to swap.
+func (platform *Headless) PostRender() {}
+
+// Run drives the frame loop for the given number of frames, or until Stop is called if frames is negative.
+// Each frame polls the input source, calls gui between imgui.NewFrame() and imgui.Render()
+// and passes the draw data to the renderer, which may be nil. When viewports are enabled,
+// the platform windows are then updated and rendered.
+func (platform *Headless) Run(frames int, renderer Renderer, gui func()) {
+	for n := 0; (frames < 0 || n < frames) && !platform.ShouldStop(); n++ {
+		platform.ProcessEvents()
+
+		platform.NewFrame()
+		imgui.NewFrame()
+		if gui != nil {
+			gui()
+		}
+		imgui.Render()
+
+		if renderer != nil {
+			renderer.PreRender(platform.clearColor)
+			renderer.Render(platform.DisplaySize(), platform.FramebufferSize(), imgui.GetDrawData())
+		}
+		if platform.viewports {
+			platform.UpdatePlatformWindows()
+		}
+		platform.PostRender()
+	}
+}
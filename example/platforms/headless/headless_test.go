@@ -0,0 +1,138 @@
+package headless
+
+import (
+	"testing"
+
+	"github.com/Splizard/imgui"
+)
+
+func TestScriptedClick(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+
+	io := imgui.GetIO()
+	io.IniFilename = ""
+	var pixels []uint32
+	io.Fonts.GetTexDataAsRGBA32(&pixels, nil, nil, nil)
+
+	p := NewHeadless(io, 640, 480)
+	defer p.Dispose()
+
+	var clicks int
+	var buttonMin, buttonMax imgui.ImVec2
+	p.SetInputSource(InputSourceFunc(func(frame int, state *InputState) {
+		switch frame {
+		case 2:
+			state.MousePos = [2]float32{(buttonMin.X() + buttonMax.X()) / 2, (buttonMin.Y() + buttonMax.Y()) / 2}
+		case 3:
+			state.MouseDown[0] = true
+		case 4:
+			state.MouseDown[0] = false
+		case 6:
+			p.Stop()
+		}
+	}))
+
+	p.Run(-1, nil, func() {
+		imgui.SetNextWindowPos(imgui.NewImVec2(10, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+		imgui.Begin("Headless", nil, 0)
+		if imgui.Button("Click me") {
+			clicks++
+		}
+		buttonMin, buttonMax = imgui.GetItemRectMin(), imgui.GetItemRectMax()
+		imgui.End()
+	})
+
+	if clicks != 1 {
+		t.Errorf("button clicked %d times, want 1", clicks)
+	}
+	if p.Frame() != 7 {
+		t.Errorf("ran %d frames, want 7", p.Frame())
+	}
+	if got := io.DeltaTime; got != defaultDeltaTime {
+		t.Errorf("DeltaTime = %v, want %v", got, defaultDeltaTime)
+	}
+}
+
+func TestViewportDragOut(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+
+	io := imgui.GetIO()
+	io.IniFilename = ""
+	io.MouseDoubleClickTime = 0
+	var pixels []uint32
+	io.Fonts.GetTexDataAsRGBA32(&pixels, nil, nil, nil)
+
+	p := NewHeadless(io, 640, 480)
+	defer p.Dispose()
+	p.EnableViewports()
+
+	var tool *imgui.ImGuiWindow
+	gui := func() {
+		imgui.SetNextWindowPos(imgui.NewImVec2(50, 50), imgui.ImGuiCond_FirstUseEver, imgui.ImVec2{})
+		imgui.SetNextWindowSize(imgui.NewImVec2(200, 100), imgui.ImGuiCond_FirstUseEver)
+		imgui.Begin("Tool##viewport", nil, 0)
+		imgui.Text("Hello")
+		tool = imgui.GetCurrentWindow()
+		imgui.End()
+	}
+	drag := func(from, to [2]float32) {
+		in := p.Input()
+		in.MousePos = from
+		p.Run(1, nil, gui)
+		in.MouseDown[0] = true
+		p.Run(1, nil, gui)
+		for i := float32(1); i <= 4; i++ {
+			in.MousePos = [2]float32{from[0] + (to[0]-from[0])*i/4, from[1] + (to[1]-from[1])*i/4}
+			p.Run(1, nil, gui)
+		}
+		in.MouseDown[0] = false
+		p.Run(3, nil, gui)
+	}
+	p.Run(2, nil, gui)
+	if len(p.Windows()) != 0 {
+		t.Fatalf("%d platform windows created for a window inside the main viewport", len(p.Windows()))
+	}
+
+	// Drag the window by its title bar outside of the main viewport
+	drag([2]float32{100, 55}, [2]float32{800, 155})
+	window := p.FindWindow("Tool")
+	if window == nil {
+		t.Fatalf("no platform window created for the window dragged out, got %d windows", len(p.Windows()))
+	}
+	if tool.Viewport == imgui.GetMainViewport() || window.Viewport() != tool.Viewport {
+		t.Errorf("window dragged out should own the viewport of the platform window")
+	}
+	if window.Pos != [2]float32{tool.Pos.X(), tool.Pos.Y()} || window.Size != [2]float32{200, 100} || tool.Pos.X() != 750 {
+		t.Errorf("platform window at %v size %v, imgui window at %v", window.Pos, window.Size, tool.Pos)
+	}
+	if !window.Visible || window.Renders == 0 || window.Swaps != window.Renders {
+		t.Errorf("platform window visible=%v renders=%d swaps=%d", window.Visible, window.Renders, window.Swaps)
+	}
+	if dd := window.DrawData; dd == nil || dd.CmdListsCount != 1 || dd.CmdLists[0] != tool.DrawList || dd.DisplayPos != tool.Pos {
+		t.Errorf("platform window should render the draw list of its window only")
+	}
+	for _, list := range imgui.GetDrawData().CmdLists {
+		if list == tool.DrawList {
+			t.Errorf("window dragged out is still rendered in the main viewport")
+		}
+	}
+
+	// The window manager moves the platform window: the imgui window follows
+	window.Move(700, 300)
+	p.Run(2, nil, gui)
+	if tool.Pos != *imgui.NewImVec2(700, 300) || window.Pos != [2]float32{700, 300} {
+		t.Errorf("platform window moved to %v, imgui window at %v", window.Pos, tool.Pos)
+	}
+
+	// Drag the window back inside: it merges into the main viewport and the platform window is destroyed
+	drag([2]float32{750, 305}, [2]float32{150, 105})
+	p.Run(3, nil, gui)
+	if tool.Viewport != imgui.GetMainViewport() {
+		t.Errorf("window dragged back should be merged into the main viewport")
+	}
+	if len(p.Windows()) != 0 || len(imgui.GetPlatformIO().Viewports) != 1 {
+		t.Errorf("%d platform windows and %d viewports left", len(p.Windows()), len(imgui.GetPlatformIO().Viewports))
+	}
+}
@@ -0,0 +1,171 @@
+package headless
+
+import (
+	"github.com/Splizard/imgui"
+)
+
+// Window is a simulated platform window, created for each secondary imgui viewport once
+// viewports are enabled. Its fields reflect the last values set by imgui, and its methods
+// simulate the actions of a window manager (moving, resizing, closing the window...).
+type Window struct {
+	Title     string
+	Pos       [2]float32
+	Size      [2]float32
+	Alpha     float32
+	Visible   bool
+	Focused   bool
+	Minimized bool
+
+	Renders  int               // Number of frames rendered into the window.
+	Swaps    int               // Number of buffer swaps.
+	DrawData *imgui.ImDrawData // Draw data of the last rendered frame.
+
+	platform *Headless
+	viewport *imgui.ImGuiViewport
+}
+
+// Viewport returns the imgui viewport displayed by the window.
+func (window *Window) Viewport() *imgui.ImGuiViewport {
+	return window.viewport
+}
+
+// Move moves the window as if the user dragged it with the window manager.
+// The imgui window follows on the next frame.
+func (window *Window) Move(x, y float32) {
+	window.Pos = [2]float32{x, y}
+	window.viewport.PlatformRequestMove = true
+}
+
+// Resize resizes the window as if the user dragged its border with the window manager.
+func (window *Window) Resize(width, height float32) {
+	window.Size = [2]float32{width, height}
+	window.viewport.PlatformRequestResize = true
+}
+
+// Close requests the window to be closed, as if the user clicked the close button of its decorations.
+func (window *Window) Close() {
+	window.viewport.PlatformRequestClose = true
+}
+
+// Minimize minimizes or restores the window.
+func (window *Window) Minimize(minimized bool) {
+	window.Minimized = minimized
+}
+
+// Focus brings the window to the front and gives it the input focus.
+func (window *Window) Focus() {
+	for _, other := range window.platform.windows {
+		other.Focused = false
+	}
+	window.Focused = true
+}
+
+// EnableViewports enables multi-viewports on the current context and installs the platform
+// and renderer functions of the fake in-process window manager. Run then updates and renders
+// the platform windows after every frame. The platform windows only record their draw data,
+// the Renderer passed to Run is only used for the main viewport.
+func (platform *Headless) EnableViewports() {
+	io := platform.imguiIO
+	io.ConfigFlags |= imgui.ImGuiConfigFlags_ViewportsEnable
+	io.BackendFlags |= imgui.ImGuiBackendFlags_PlatformHasViewports | imgui.ImGuiBackendFlags_RendererHasViewports
+	platform.viewports = true
+
+	pio := imgui.GetPlatformIO()
+	pio.Platform_CreateWindow = func(vp *imgui.ImGuiViewport) {
+		window := &Window{
+			Pos:      [2]float32{vp.Pos.X(), vp.Pos.Y()},
+			Size:     [2]float32{vp.Size.X(), vp.Size.Y()},
+			Alpha:    1,
+			platform: platform,
+			viewport: vp,
+		}
+		vp.PlatformUserData = window
+		vp.PlatformHandle = window
+		platform.windows = append(platform.windows, window)
+	}
+	pio.Platform_DestroyWindow = func(vp *imgui.ImGuiViewport) {
+		if window := platformWindow(vp); window != nil {
+			for i, other := range platform.windows {
+				if other == window {
+					platform.windows = append(platform.windows[:i], platform.windows[i+1:]...)
+					break
+				}
+			}
+		}
+		vp.PlatformUserData = nil
+		vp.PlatformHandle = nil
+	}
+	pio.Platform_ShowWindow = func(vp *imgui.ImGuiViewport) {
+		window := platformWindow(vp)
+		window.Visible = true
+		if vp.Flags&imgui.ImGuiViewportFlags_NoFocusOnAppearing == 0 {
+			window.Focus()
+		}
+	}
+	pio.Platform_SetWindowPos = func(vp *imgui.ImGuiViewport, pos imgui.ImVec2) {
+		platformWindow(vp).Pos = [2]float32{pos.X(), pos.Y()}
+	}
+	pio.Platform_GetWindowPos = func(vp *imgui.ImGuiViewport) imgui.ImVec2 {
+		window := platformWindow(vp)
+		return *imgui.NewImVec2(window.Pos[0], window.Pos[1])
+	}
+	pio.Platform_SetWindowSize = func(vp *imgui.ImGuiViewport, size imgui.ImVec2) {
+		platformWindow(vp).Size = [2]float32{size.X(), size.Y()}
+	}
+	pio.Platform_GetWindowSize = func(vp *imgui.ImGuiViewport) imgui.ImVec2 {
+		window := platformWindow(vp)
+		return *imgui.NewImVec2(window.Size[0], window.Size[1])
+	}
+	pio.Platform_SetWindowFocus = func(vp *imgui.ImGuiViewport) {
+		platformWindow(vp).Focus()
+	}
+	pio.Platform_GetWindowFocus = func(vp *imgui.ImGuiViewport) bool {
+		window := platformWindow(vp)
+		return window != nil && window.Focused
+	}
+	pio.Platform_GetWindowMinimized = func(vp *imgui.ImGuiViewport) bool {
+		window := platformWindow(vp)
+		return window != nil && window.Minimized
+	}
+	pio.Platform_SetWindowTitle = func(vp *imgui.ImGuiViewport, title string) {
+		platformWindow(vp).Title = title
+	}
+	pio.Platform_SetWindowAlpha = func(vp *imgui.ImGuiViewport, alpha float32) {
+		platformWindow(vp).Alpha = alpha
+	}
+	pio.Renderer_RenderWindow = func(vp *imgui.ImGuiViewport, _ any) {
+		window := platformWindow(vp)
+		window.Renders++
+		window.DrawData = vp.DrawData
+	}
+	pio.Platform_SwapBuffers = func(vp *imgui.ImGuiViewport, _ any) {
+		platformWindow(vp).Swaps++
+	}
+}
+
+// Windows returns the platform windows currently open, in creation order.
+func (platform *Headless) Windows() []*Window {
+	return platform.windows
+}
+
+// FindWindow returns the platform window with the given title, or nil.
+func (platform *Headless) FindWindow(title string) *Window {
+	for _, window := range platform.windows {
+		if window.Title == title {
+			return window
+		}
+	}
+	return nil
+}
+
+// UpdatePlatformWindows creates, updates and renders the platform windows of the secondary viewports.
+// It must be called after imgui.Render(), Run calls it when viewports are enabled.
+func (platform *Headless) UpdatePlatformWindows() {
+	imgui.UpdatePlatformWindows()
+	imgui.RenderPlatformWindowsDefault(nil, nil)
+}
+
+func platformWindow(vp *imgui.ImGuiViewport) *Window {
+	window, _ := vp.PlatformUserData.(*Window)
+	return window
+}
@@ -0,0 +1,305 @@
+// Package software implements a pure Go renderer that rasterizes imgui draw
+// data into an image.RGBA. It requires no GPU or display server, which makes it
+// suitable for screenshots, CI machines and headless applications.
+package software
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/Splizard/imgui"
+)
+
+// Software implements a renderer that rasterizes imgui draw data on the CPU.
+type Software struct {
+	imguiIO *imgui.ImGuiIO
+
+	target     *image.RGBA
+	clearColor [3]float32
+	clear      bool
+
+	textures      map[imgui.ImTextureID]*image.NRGBA
+	nextTextureID imgui.ImTextureID
+	fontTexture   imgui.ImTextureID
+}
+
+// NewSoftware initializes a software renderer and uploads the font atlas of io
+// into its texture registry.
+func NewSoftware(io *imgui.ImGuiIO) (*Software, error) {
+	renderer := &Software{
+		imguiIO:       io,
+		textures:      make(map[imgui.ImTextureID]*image.NRGBA),
+		nextTextureID: 1,
+	}
+	io.BackendRendererName = "imgui_impl_software"
+	io.BackendFlags |= imgui.ImGuiBackendFlags_RendererHasVtxOffset
+	renderer.createFontsTexture()
+	return renderer, nil
+}
+
+// Dispose cleans up the resources.
+func (renderer *Software) Dispose() {
+	if renderer.fontTexture != 0 {
+		renderer.UnregisterTexture(renderer.fontTexture)
+		renderer.imguiIO.Fonts.SetTexID(0)
+		renderer.fontTexture = 0
+	}
+	renderer.target = nil
+}
+
+// PreRender requests the framebuffer to be cleared to clearColor before the next Render.
+func (renderer *Software) PreRender(clearColor [3]float32) {
+	renderer.clearColor = clearColor
+	renderer.clear = true
+}
+
+// Render rasterizes the imgui draw data into the framebuffer image.
+// The framebuffer is (re)allocated to framebufferSize when its dimensions change.
+func (renderer *Software) Render(displaySize [2]float32, framebufferSize [2]float32, drawData *imgui.ImDrawData) {
+	fbWidth, fbHeight := int(framebufferSize[0]), int(framebufferSize[1])
+	if fbWidth <= 0 || fbHeight <= 0 {
+		return
+	}
+	if renderer.target == nil || renderer.target.Rect.Dx() != fbWidth || renderer.target.Rect.Dy() != fbHeight {
+		renderer.target = image.NewRGBA(image.Rect(0, 0, fbWidth, fbHeight))
+		renderer.clear = true
+	}
+	if renderer.clear {
+		c := color.RGBA{
+			R: uint8(clamp01(renderer.clearColor[0])*255 + 0.5),
+			G: uint8(clamp01(renderer.clearColor[1])*255 + 0.5),
+			B: uint8(clamp01(renderer.clearColor[2])*255 + 0.5),
+			A: 255,
+		}
+		draw.Draw(renderer.target, renderer.target.Rect, &image.Uniform{C: c}, image.Point{}, draw.Src)
+		renderer.clear = false
+	}
+	if drawData == nil || displaySize[0] <= 0 || displaySize[1] <= 0 {
+		return
+	}
+
+	// Our visible imgui space lies from drawData.DisplayPos (top left) to drawData.DisplayPos+drawData.DisplaySize (bottom right).
+	// Scale coordinates for retina displays (screen coordinates != framebuffer coordinates).
+	origin := [2]float32{drawData.DisplayPos.X(), drawData.DisplayPos.Y()}
+	scale := [2]float32{framebufferSize[0] / displaySize[0], framebufferSize[1] / displaySize[1]}
+
+	for _, list := range drawData.CmdLists {
+		for i := range list.CmdBuffer {
+			cmd := &list.CmdBuffer[i]
+			if cmd.UserCallback != nil {
+				cmd.UserCallback(list, cmd)
+				continue
+			}
+
+			// Project scissor/clipping rectangles into framebuffer space
+			clip := image.Rect(
+				int(math.Floor(float64((cmd.ClipRect.X()-origin[0])*scale[0]))),
+				int(math.Floor(float64((cmd.ClipRect.Y()-origin[1])*scale[1]))),
+				int(math.Ceil(float64((cmd.ClipRect.Z()-origin[0])*scale[0]))),
+				int(math.Ceil(float64((cmd.ClipRect.W()-origin[1])*scale[1]))),
+			).Intersect(renderer.target.Rect)
+			if clip.Empty() {
+				continue
+			}
+
+			texture := renderer.textures[cmd.TextureId]
+			for n := uint32(0); n+2 < cmd.ElemCount; n += 3 {
+				var tri [3]vertex
+				for k := range tri {
+					v := &list.VtxBuffer[cmd.VtxOffset+uint32(list.IdxBuffer[cmd.IdxOffset+n+uint32(k)])]
+					tri[k] = vertex{
+						x: (v.Pos.X() - origin[0]) * scale[0],
+						y: (v.Pos.Y() - origin[1]) * scale[1],
+						u: v.Uv.X(),
+						v: v.Uv.Y(),
+						c: unpackColor(v.Col),
+					}
+				}
+				renderer.rasterizeTriangle(&tri, clip, texture)
+			}
+		}
+	}
+}
+
+// Image returns the framebuffer that was rendered into by the last call to Render.
+// The image is owned by the renderer and is reused between frames.
+func (renderer *Software) Image() *image.RGBA {
+	return renderer.target
+}
+
+// RegisterTexture makes img available to imgui.Image() and friends, and returns its texture identifier.
+func (renderer *Software) RegisterTexture(img image.Image) imgui.ImTextureID {
+	id := renderer.nextTextureID
+	renderer.nextTextureID++
+	renderer.textures[id] = toNRGBA(img)
+	return id
+}
+
+// UnregisterTexture removes a texture previously added with RegisterTexture.
+func (renderer *Software) UnregisterTexture(id imgui.ImTextureID) {
+	delete(renderer.textures, id)
+}
+
+func (renderer *Software) createFontsTexture() {
+	// Build texture atlas
+	var pixels []uint32
+	var width, height int32
+	renderer.imguiIO.Fonts.GetTexDataAsRGBA32(&pixels, &width, &height, nil)
+
+	// Pixels are packed with IM_COL32, red in the lowest byte.
+	font := image.NewNRGBA(image.Rect(0, 0, int(width), int(height)))
+	for i := 0; i < int(width*height); i++ {
+		p := pixels[i]
+		font.Pix[i*4+0] = uint8(p)
+		font.Pix[i*4+1] = uint8(p >> 8)
+		font.Pix[i*4+2] = uint8(p >> 16)
+		font.Pix[i*4+3] = uint8(p >> 24)
+	}
+
+	// Store our identifier
+	renderer.fontTexture = renderer.nextTextureID
+	renderer.nextTextureID++
+	renderer.textures[renderer.fontTexture] = font
+	renderer.imguiIO.Fonts.SetTexID(renderer.fontTexture)
+}
+
+type vertex struct {
+	x, y float32
+	u, v float32
+	c    [4]float32
+}
+
+// edge returns twice the signed area of the triangle (a, b, (px, py)).
+func edge(a, b *vertex, px, py float32) float32 {
+	return (b.x-a.x)*(py-a.y) - (b.y-a.y)*(px-a.x)
+}
+
+// isTopLeft implements the top-left fill rule, so that pixels on edges shared
+// by two triangles are only blended once.
+func isTopLeft(a, b *vertex) bool {
+	dx, dy := b.x-a.x, b.y-a.y
+	return (dy == 0 && dx > 0) || dy < 0
+}
+
+func (renderer *Software) rasterizeTriangle(tri *[3]vertex, clip image.Rectangle, texture *image.NRGBA) {
+	area := edge(&tri[0], &tri[1], tri[2].x, tri[2].y)
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		tri[1], tri[2] = tri[2], tri[1]
+		area = -area
+	}
+	v0, v1, v2 := &tri[0], &tri[1], &tri[2]
+
+	minX := int(math.Floor(float64(min3(v0.x, v1.x, v2.x))))
+	minY := int(math.Floor(float64(min3(v0.y, v1.y, v2.y))))
+	maxX := int(math.Ceil(float64(max3(v0.x, v1.x, v2.x))))
+	maxY := int(math.Ceil(float64(max3(v0.y, v1.y, v2.y))))
+	bounds := image.Rect(minX, minY, maxX, maxY).Intersect(clip)
+	if bounds.Empty() {
+		return
+	}
+
+	topLeft0, topLeft1, topLeft2 := isTopLeft(v1, v2), isTopLeft(v2, v0), isTopLeft(v0, v1)
+	invArea := 1 / area
+	target := renderer.target
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		py := float32(y) + 0.5
+		row := target.Pix[target.PixOffset(0, y):]
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			px := float32(x) + 0.5
+			w0 := edge(v1, v2, px, py)
+			w1 := edge(v2, v0, px, py)
+			w2 := edge(v0, v1, px, py)
+			if w0 < 0 || w1 < 0 || w2 < 0 {
+				continue
+			}
+			if (w0 == 0 && !topLeft0) || (w1 == 0 && !topLeft1) || (w2 == 0 && !topLeft2) {
+				continue
+			}
+			w0, w1, w2 = w0*invArea, w1*invArea, w2*invArea
+
+			var c [4]float32
+			for k := range c {
+				c[k] = w0*v0.c[k] + w1*v1.c[k] + w2*v2.c[k]
+			}
+			if texture != nil {
+				t := sample(texture, w0*v0.u+w1*v1.u+w2*v2.u, w0*v0.v+w1*v1.v+w2*v2.v)
+				for k := range c {
+					c[k] *= t[k]
+				}
+			}
+			if c[3] <= 0 {
+				continue
+			}
+
+			// Alpha blending: src*srcAlpha + dst*(1-srcAlpha), the target is alpha-premultiplied.
+			dst := row[x*4 : x*4+4 : x*4+4]
+			inv := 1 - c[3]
+			dst[0] = uint8(c[0]*c[3]*255 + float32(dst[0])*inv + 0.5)
+			dst[1] = uint8(c[1]*c[3]*255 + float32(dst[1])*inv + 0.5)
+			dst[2] = uint8(c[2]*c[3]*255 + float32(dst[2])*inv + 0.5)
+			dst[3] = uint8(c[3]*255 + float32(dst[3])*inv + 0.5)
+		}
+	}
+}
+
+// sample fetches the texel nearest to (u, v), with clamp-to-edge addressing.
+func sample(texture *image.NRGBA, u, v float32) [4]float32 {
+	w, h := texture.Rect.Dx(), texture.Rect.Dy()
+	x := int(u * float32(w))
+	y := int(v * float32(h))
+	if x < 0 {
+		x = 0
+	} else if x >= w {
+		x = w - 1
+	}
+	if y < 0 {
+		y = 0
+	} else if y >= h {
+		y = h - 1
+	}
+	p := texture.Pix[y*texture.Stride+x*4:]
+	return [4]float32{float32(p[0]) / 255, float32(p[1]) / 255, float32(p[2]) / 255, float32(p[3]) / 255}
+}
+
+func unpackColor(col imgui.ImU32) [4]float32 {
+	return [4]float32{
+		float32((col>>imgui.IM_COL32_R_SHIFT)&0xFF) / 255,
+		float32((col>>imgui.IM_COL32_G_SHIFT)&0xFF) / 255,
+		float32((col>>imgui.IM_COL32_B_SHIFT)&0xFF) / 255,
+		float32((col>>imgui.IM_COL32_A_SHIFT)&0xFF) / 255,
+	}
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) {
+		return nrgba
+	}
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Rect, img, bounds.Min, draw.Src)
+	return nrgba
+}
+
+func clamp01(f float32) float32 {
+	if f < 0 {
+		return 0
+	}
+	if f > 1 {
+		return 1
+	}
+	return f
+}
+
+func min3(a, b, c float32) float32 {
+	return float32(math.Min(float64(a), math.Min(float64(b), float64(c))))
+}
+
+func max3(a, b, c float32) float32 {
+	return float32(math.Max(float64(a), math.Max(float64(b), float64(c))))
+}
@@ -0,0 +1,71 @@
+package software
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/Splizard/imgui"
+)
+
+func TestRender(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+
+	io := imgui.GetIO()
+	io.IniFilename = ""
+	io.DisplaySize = *imgui.NewImVec2(320, 240)
+
+	r, err := NewSoftware(io)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Dispose()
+
+	texture := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	texture.SetNRGBA(0, 0, color.NRGBA{0, 0, 255, 255})
+	blue := r.RegisterTexture(texture)
+
+	// New windows are hidden on their first frame while they are auto-fitted.
+	for frame := 0; frame < 2; frame++ {
+		imgui.NewFrame()
+		imgui.Begin("Window", nil, 0)
+		imgui.Text("Hello, world!")
+		imgui.End()
+		fg := imgui.GetForegroundDrawList(nil)
+		fg.AddRectFilled(*imgui.NewImVec2(300, 220), *imgui.NewImVec2(310, 230), imgui.IM_COL32(255, 0, 0, 255), 0, 0)
+		fg.AddImage(blue, *imgui.NewImVec2(280, 220), *imgui.NewImVec2(290, 230), imgui.NewImVec2(0, 0), imgui.NewImVec2(1, 1), imgui.IM_COL32_WHITE)
+		imgui.Render()
+	}
+
+	r.PreRender([3]float32{0, 0, 0})
+	r.Render([2]float32{320, 240}, [2]float32{320, 240}, imgui.GetDrawData())
+
+	img := r.Image()
+	if img.Rect.Dx() != 320 || img.Rect.Dy() != 240 {
+		t.Fatalf("unexpected framebuffer size %v", img.Rect)
+	}
+	if got := img.RGBAAt(305, 225); got != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("filled rect: got %v, want opaque red", got)
+	}
+	if got := img.RGBAAt(285, 225); got != (color.RGBA{0, 0, 255, 255}) {
+		t.Errorf("image: got %v, want opaque blue", got)
+	}
+	if got := img.RGBAAt(315, 235); got != (color.RGBA{0, 0, 0, 255}) {
+		t.Errorf("background: got %v, want clear color", got)
+	}
+
+	// The title bar of the window, placed at the default position (60, 60).
+	var drawn bool
+	for y := 62; y < 78 && !drawn; y++ {
+		for x := 70; x < 150; x++ {
+			if img.RGBAAt(x, y) != (color.RGBA{0, 0, 0, 255}) {
+				drawn = true
+				break
+			}
+		}
+	}
+	if !drawn {
+		t.Error("window was not rasterized")
+	}
+}
@@ -0,0 +1,288 @@
+package imgui
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Splizard/imgui/golang"
+)
+
+// ImFileHandle is a file opened by ImFileOpen(). *os.File implements it.
+type ImFileHandle interface {
+	io.Reader
+	io.Writer
+	io.Closer
+	Stat() (fs.FileInfo, error)
+}
+
+// ImGuiFileSystem is the file system used by ImFileOpen() for the .ini file, the log files and the fonts loaded from files.
+// Set io.FileSystem to redirect them, e.g. to an embedded or in-memory file system in tests. nil uses the OS file system.
+type ImGuiFileSystem interface {
+	// OpenFile opens the named file with the os.O_XXX flags, creating it with perm when os.O_CREATE is set.
+	OpenFile(name string, flag golang.Int, perm fs.FileMode) (ImFileHandle, error)
+}
+
+// ImGuiOSFileSystem is the default file system, backed by the os package.
+type ImGuiOSFileSystem struct{}
+
+func (ImGuiOSFileSystem) OpenFile(name string, flag golang.Int, perm fs.FileMode) (ImFileHandle, error) {
+	f, err := os.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// NewImGuiFileSystemFS wraps a read-only fs.FS (e.g. an embed.FS or a fstest.MapFS), writing files fails with fs.ErrPermission.
+// Settings can be loaded from it, but not saved.
+func NewImGuiFileSystemFS(fsys fs.FS) ImGuiFileSystem {
+	return readOnlyFileSystem{fsys}
+}
+
+type readOnlyFileSystem struct {
+	fsys fs.FS
+}
+
+func (r readOnlyFileSystem) OpenFile(name string, flag golang.Int, perm fs.FileMode) (ImFileHandle, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_APPEND|os.O_TRUNC) != 0 {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+	}
+	f, err := r.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return readOnlyFile{f}, nil
+}
+
+type readOnlyFile struct {
+	fs.File
+}
+
+func (f readOnlyFile) Write(p []byte) (golang.Int, error) {
+	return 0, fs.ErrPermission
+}
+
+// ImGuiMemoryFileSystem is a writable file system keeping its files in memory, for tests.
+type ImGuiMemoryFileSystem struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func NewImGuiMemoryFileSystem() *ImGuiMemoryFileSystem {
+	return &ImGuiMemoryFileSystem{files: make(map[string][]byte)}
+}
+
+// WriteFile replaces the content of the named file.
+func (m *ImGuiMemoryFileSystem) WriteFile(name string, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[name] = append([]byte{}, data...)
+}
+
+// ReadFile returns a copy of the content of the named file, or nil when it doesn't exist.
+func (m *ImGuiMemoryFileSystem) ReadFile(name string) []byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if data, ok := m.files[name]; ok {
+		return append([]byte{}, data...)
+	}
+	return nil
+}
+
+// Remove deletes the named file.
+func (m *ImGuiMemoryFileSystem) Remove(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.files, name)
+}
+
+// Names returns the names of the files, sorted.
+func (m *ImGuiMemoryFileSystem) Names() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var names = make([]string, 0, len(m.files))
+	for name := range m.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *ImGuiMemoryFileSystem) OpenFile(name string, flag golang.Int, perm fs.FileMode) (ImFileHandle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var data, exists = m.files[name]
+	if !exists {
+		if flag&os.O_CREATE == 0 {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+		}
+		data = []byte{}
+		m.files[name] = data
+	} else if flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0 {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrExist}
+	}
+	if flag&os.O_TRUNC != 0 {
+		data = []byte{}
+		m.files[name] = data
+	}
+	var f = &memoryFile{fs: m, name: name, flag: flag}
+	f.reader.Reset(data)
+	return f, nil
+}
+
+type memoryFile struct {
+	fs     *ImGuiMemoryFileSystem
+	name   string
+	flag   golang.Int
+	reader bytes.Reader // Content at the time the file was opened
+	closed bool
+}
+
+func (f *memoryFile) Read(p []byte) (golang.Int, error) {
+	if f.closed {
+		return 0, fs.ErrClosed
+	}
+	if f.flag&(os.O_WRONLY|os.O_RDWR) == os.O_WRONLY {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrPermission}
+	}
+	return f.reader.Read(p)
+}
+
+// Write appends to the file, the file system only supports sequential writes.
+func (f *memoryFile) Write(p []byte) (golang.Int, error) {
+	if f.closed {
+		return 0, fs.ErrClosed
+	}
+	if f.flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+		return 0, &fs.PathError{Op: "write", Path: f.name, Err: fs.ErrPermission}
+	}
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	f.fs.files[f.name] = append(f.fs.files[f.name], p...)
+	return len(p), nil
+}
+
+func (f *memoryFile) Close() error {
+	if f.closed {
+		return fs.ErrClosed
+	}
+	f.closed = true
+	return nil
+}
+
+func (f *memoryFile) Stat() (fs.FileInfo, error) {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	return memoryFileInfo{name: f.name[strings.LastIndexByte(f.name, '/')+1:], size: int64(len(f.fs.files[f.name]))}, nil
+}
+
+type memoryFileInfo struct {
+	name string
+	size int64
+}
+
+func (fi memoryFileInfo) Name() string       { return fi.name }
+func (fi memoryFileInfo) Size() int64        { return fi.size }
+func (fi memoryFileInfo) Mode() fs.FileMode  { return 0666 }
+func (fi memoryFileInfo) ModTime() time.Time { return time.Time{} }
+func (fi memoryFileInfo) IsDir() bool        { return false }
+func (fi memoryFileInfo) Sys() any           { return nil }
+
+// imFileOpenFlags converts a C fopen() mode to os.O_XXX flags.
+func imFileOpenFlags(mode string) golang.Int {
+	var flag golang.Int
+	switch {
+	case strings.HasPrefix(mode, "r"):
+		flag = os.O_RDONLY
+	case strings.HasPrefix(mode, "w"):
+		flag = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	case strings.HasPrefix(mode, "a"):
+		flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+	if strings.ContainsRune(mode, '+') {
+		flag = flag&^os.O_WRONLY | os.O_RDWR
+	}
+	return flag
+}
+
+// ImFileOpen opens a file with a C fopen() mode ("rb", "wb", "ab"...) on io.FileSystem, returns nil on failure.
+// The text/binary distinction is ignored, files are always opened in binary mode.
+func (g *ImGuiContext) ImFileOpen(filename string, mode string) ImFileHandle {
+	var file_system ImGuiFileSystem = ImGuiOSFileSystem{}
+	if g != nil && g.IO.FileSystem != nil {
+		file_system = g.IO.FileSystem
+	}
+	f, err := file_system.OpenFile(filename, imFileOpenFlags(mode), 0666)
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// ImFileClose returns false when the file could not be closed (e.g. when buffered data couldn't be written).
+func ImFileClose(file ImFileHandle) bool {
+	return file.Close() == nil
+}
+
+// ImFileGetSize returns (ImU64)-1 on failure.
+func ImFileGetSize(file ImFileHandle) ImU64 {
+	info, err := file.Stat()
+	if err != nil {
+		return ^ImU64(0)
+	}
+	return ImU64(info.Size())
+}
+
+// ImFileRead reads up to count items of size bytes into data, returns the number of complete items read.
+func ImFileRead(data []byte, size, count ImU64, file ImFileHandle) ImU64 {
+	if size == 0 {
+		return 0
+	}
+	n, _ := io.ReadFull(file, data[:size*count])
+	return ImU64(n) / size
+}
+
+// ImFileWrite writes count items of size bytes from data, returns the number of complete items written.
+func ImFileWrite(data []byte, size, count ImU64, file ImFileHandle) ImU64 {
+	if size == 0 {
+		return 0
+	}
+	n, _ := file.Write(data[:size*count])
+	return ImU64(n) / size
+}
+
+// ImFileLoadToMemory Helper: Load file content into memory
+// padding_bytes zero bytes are appended after the content, out_file_size receives the size of the file.
+// This can't really be used with "rt" because fseek size won't match read size.
+func (g *ImGuiContext) ImFileLoadToMemory(filename, mode string, out_file_size *size_t, padding_bytes int) []byte {
+	IM_ASSERT(filename != "" && mode != "")
+	if out_file_size != nil {
+		*out_file_size = 0
+	}
+
+	var f = g.ImFileOpen(filename, mode)
+	if f == nil {
+		return nil
+	}
+	defer ImFileClose(f)
+
+	var file_size = ImFileGetSize(f)
+	if file_size == ^ImU64(0) {
+		return nil
+	}
+
+	var file_data = make([]byte, file_size+ImU64(padding_bytes))
+	if ImFileRead(file_data, 1, file_size, f) != file_size {
+		return nil
+	}
+	if out_file_size != nil {
+		*out_file_size = size_t(file_size)
+	}
+	return file_data
+}
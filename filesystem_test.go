@@ -0,0 +1,70 @@
+package imgui
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// newFileSystemTestContext loads and saves imgui.ini through file_system.
+func newFileSystemTestContext(file_system ImGuiFileSystem) *ImGuiContext {
+	return newTestContext(func(io *ImGuiIO) {
+		io.IniFilename = "imgui.ini"
+		io.FileSystem = file_system
+	})
+}
+
+func TestMemoryFileSystem(t *testing.T) {
+	var memfs = NewImGuiMemoryFileSystem()
+	memfs.WriteFile("imgui.ini", []byte("[Window][Saved]\nPos=123,45\nSize=200,100\n"))
+
+	var ctx = newFileSystemTestContext(memfs)
+	var pos ImVec2
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.Begin("Saved", nil, 0)
+		pos = ui.GetWindowPos()
+		ui.LogToFile(-1, "log.txt")
+		ui.Text("logged line")
+		ui.LogFinish()
+		ui.End()
+		ui.Begin("Created", nil, 0)
+		ui.End()
+	})
+	if pos != (ImVec2{123, 45}) {
+		t.Errorf("window at %v, want the position loaded from the .ini file", pos)
+	}
+	if log := string(memfs.ReadFile("log.txt")); !strings.Contains(log, "logged line") {
+		t.Errorf("log file = %q", log)
+	}
+
+	DestroyContext(ctx)
+	var ini = string(memfs.ReadFile("imgui.ini"))
+	if !strings.Contains(ini, "[Window][Saved]\nPos=123,45") || !strings.Contains(ini, "[Window][Created]") {
+		t.Errorf("settings not saved to the file system:\n%s", ini)
+	}
+}
+
+func TestReadOnlyFileSystem(t *testing.T) {
+	var fsys = fstest.MapFS{"imgui.ini": &fstest.MapFile{Data: []byte("[Window][Saved]\nPos=60,70\nSize=200,100\n")}}
+
+	var ctx = newFileSystemTestContext(NewImGuiFileSystemFS(fsys))
+	var pos ImVec2
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.Begin("Saved", nil, 0)
+		pos = ui.GetWindowPos()
+		ui.End()
+	})
+	if pos != (ImVec2{60, 70}) {
+		t.Errorf("window at %v, want the position loaded from the .ini file", pos)
+	}
+
+	var ui = ctx.Lock()
+	if f := ImFileOpen("imgui.ini", "wb"); f != nil {
+		t.Errorf("opened a read-only file for writing")
+	}
+	ui.Unlock()
+	DestroyContext(ctx) // Saving the settings fails silently
+	if string(fsys["imgui.ini"].Data) != "[Window][Saved]\nPos=60,70\nSize=200,100\n" {
+		t.Errorf("read-only file modified")
+	}
+}
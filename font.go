@@ -16,32 +16,30 @@ var FONT_ATLAS_DEFAULT_TEX_CURSOR_DATA = [ImGuiMouseCursor_COUNT][3]ImVec2{
 }
 
 // GetFont get current font
-func GetFont() *ImFont { return GImGui.Font }
+func (g *ImGuiContext) GetFont() *ImFont { return g.Font }
 
 // GetFontSize get current font size (= height in pixels) of current font with current scale applied
-func GetFontSize() float { return GImGui.FontSize }
+func (g *ImGuiContext) GetFontSize() float { return g.FontSize }
 
-func GetFontTexUvWhitePixel() ImVec2 { return GImGui.DrawListSharedData.TexUvWhitePixel } // get UV coordinate for a while pixel, useful to draw custom shapes via the ImDrawList API
+func (g *ImGuiContext) GetFontTexUvWhitePixel() ImVec2 { return g.DrawListSharedData.TexUvWhitePixel } // get UV coordinate for a while pixel, useful to draw custom shapes via the ImDrawList API
 
 // PushFont Parameters stacks (shared)
 // use NULL as a shortcut to push default font
-func PushFont(font *ImFont) {
-	var g = GImGui
+func (g *ImGuiContext) PushFont(font *ImFont) {
 	if font == nil {
-		font = GetDefaultFont()
+		font = g.GetDefaultFont()
 	}
-	SetCurrentFont(font)
+	g.SetCurrentFont(font)
 	g.FontStack = append(g.FontStack, font)
 	g.CurrentWindow.DrawList.PushTextureID(font.ContainerAtlas.TexID)
 }
-func PopFont() {
-	var g = GImGui
+func (g *ImGuiContext) PopFont() {
 	g.CurrentWindow.DrawList.PopTextureID()
 	g.FontStack = g.FontStack[:len(g.FontStack)-1]
 	if len(g.FontStack) == 0 {
-		SetCurrentFont(GetDefaultFont())
+		g.SetCurrentFont(g.GetDefaultFont())
 	} else {
-		SetCurrentFont(g.FontStack[len(g.FontStack)-1])
+		g.SetCurrentFont(g.FontStack[len(g.FontStack)-1])
 	}
 }
 
@@ -248,8 +246,7 @@ func (f *ImFont) CalcTextSizeA(size, max_width, wrap_width float, text string, r
 	return text_size
 }
 
-func SetCurrentFont(font *ImFont) {
-	var g = GImGui
+func (g *ImGuiContext) SetCurrentFont(font *ImFont) {
 	IM_ASSERT(font != nil && font.IsLoaded()) // Font Atlas not created. Did you call io.Fonts.GetTexDataAsRGBA32 / GetTexDataAsAlpha8 ?
 	IM_ASSERT(font.Scale > 0.0)
 
@@ -269,8 +266,7 @@ func SetCurrentFont(font *ImFont) {
 	g.DrawListSharedData.FontSize = g.FontSize
 }
 
-func GetDefaultFont() *ImFont {
-	var g = GImGui
+func (g *ImGuiContext) GetDefaultFont() *ImFont {
 	if g.IO.FontDefault != nil {
 		return g.IO.FontDefault
 	}
@@ -1,8 +1,6 @@
 package imgui
 
-func ErrorCheckNewFrameSanityChecks() {
-	var g = GImGui
-
+func (g *ImGuiContext) ErrorCheckNewFrameSanityChecks() {
 	// Check user IM_ASSERT macro
 	// (IF YOU GET A WARNING OR COMPILE ERROR HERE: it means your assert macro is incorrectly defined!
 	//  If your macro uses multiple statements, it NEEDS to be surrounded by a 'do { ... } while (0)' block.
@@ -27,12 +25,16 @@ func ErrorCheckNewFrameSanityChecks() {
 	IM_ASSERT_USER_ERROR(g.Style.Alpha >= 0.0 && g.Style.Alpha <= 1.0, "Invalid style setting!") // Allows us to avoid a few clamps in color computations
 	IM_ASSERT_USER_ERROR(g.Style.WindowMinSize.x >= 1.0 && g.Style.WindowMinSize.y >= 1.0, "Invalid style setting.")
 	IM_ASSERT(g.Style.WindowMenuButtonPosition == ImGuiDir_None || g.Style.WindowMenuButtonPosition == ImGuiDir_Left || g.Style.WindowMenuButtonPosition == ImGuiDir_Right)
-	for n := ImGuiKey(0); n < ImGuiKey_COUNT; n++ {
-		IM_ASSERT_USER_ERROR(g.IO.KeyMap[n] >= -1 && g.IO.KeyMap[n] < int(len(g.IO.KeysDown)), "io.KeyMap[] contains an out of bound value (need to be 0..512, or -1 for unmapped key)")
+
+	// Check: io.KeyMap[] (legacy)
+	if g.IO.BackendUsingLegacyKeyArrays == 1 {
+		for n := ImGuiKey(ImGuiKey_NamedKey_BEGIN); n < ImGuiKey_NamedKey_END; n++ {
+			IM_ASSERT_USER_ERROR(g.IO.KeyMap[n] >= -1 && g.IO.KeyMap[n] < ImGuiKey_LegacyNativeKey_END, "io.KeyMap[] contains an out of bound value (need to be 0..511, or -1 for unmapped key)")
+		}
 	}
 
 	// Check: required key mapping (we intentionally do NOT check all keys to not pressure user into setting up everything, but Space is required and was only added in 1.60 WIP)
-	if g.IO.ConfigFlags&ImGuiConfigFlags_NavEnableKeyboard != 0 {
+	if g.IO.ConfigFlags&ImGuiConfigFlags_NavEnableKeyboard != 0 && g.IO.BackendUsingLegacyKeyArrays == 1 {
 		IM_ASSERT_USER_ERROR(g.IO.KeyMap[ImGuiKey_Space] != -1, "ImGuiKey_Space is not mapped, required for keyboard navigation.")
 	}
 
@@ -43,9 +45,8 @@ func ErrorCheckNewFrameSanityChecks() {
 }
 
 // start a new Dear ImGui frame, you can submit any command from this pountil int Render()/EndFrame().
-func NewFrame() {
-	IM_ASSERT_USER_ERROR(GImGui != nil, "No current context. Did you call ImGui::CreateContext() and ImGui::SetCurrentContext() ?")
-	var g = GImGui
+func (g *ImGuiContext) NewFrame() {
+	IM_ASSERT_USER_ERROR(g != nil, "No current context. Did you call ImGui::CreateContext() and ImGui::SetCurrentContext() ?")
 
 	// Remove pending delete hooks before frame start.
 	// This deferred removal avoid issues of removal while iterating the hook vector
@@ -60,10 +61,10 @@ func NewFrame() {
 	CallContextHooks(g, ImGuiContextHookType_NewFramePre)
 
 	// Check and assert for various common IO and Configuration mistakes
-	ErrorCheckNewFrameSanityChecks()
+	g.ErrorCheckNewFrameSanityChecks()
 
 	// Load settings on first frame, save settings when modified (after a delay)
-	UpdateSettings()
+	g.UpdateSettings()
 
 	g.Time += double(g.IO.DeltaTime)
 	g.WithinFrameScope = true
@@ -71,6 +72,11 @@ func NewFrame() {
 	g.TooltipOverrideCount = 0
 	g.WindowsActiveCount = 0
 	g.MenusIdSubmittedThisFrame = g.MenusIdSubmittedThisFrame[:0]
+	for id, shortcuts := range g.MenusShortcuts {
+		if shortcuts.LastFrameSubmitted < g.FrameCount-1 {
+			delete(g.MenusShortcuts, id) // The menu was not submitted on the previous frame
+		}
+	}
 
 	// Calculate frame-rate for the user, as a purely luxurious feature
 	g.FramerateSecPerFrameAccum += g.IO.DeltaTime - g.FramerateSecPerFrame[g.FramerateSecPerFrameIdx]
@@ -83,11 +89,11 @@ func NewFrame() {
 		g.IO.Framerate = FLT_MAX
 	}
 
-	UpdateViewportsNewFrame()
+	g.UpdateViewportsNewFrame()
 
 	// Setup current font and draw list shared data
 	g.IO.Fonts.Locked = true
-	SetCurrentFont(GetDefaultFont())
+	g.SetCurrentFont(g.GetDefaultFont())
 	IM_ASSERT(g.Font.IsLoaded())
 	var virtual_space = ImRect{ImVec2{FLT_MAX, FLT_MAX}, ImVec2{-FLT_MAX, -FLT_MAX}}
 	for n := range g.Viewports {
@@ -118,7 +124,7 @@ func NewFrame() {
 
 	// Drag and drop keep the source ID alive so even if the source disappear our state is consistent
 	if g.DragDropActive && g.DragDropPayload.SourceId == g.ActiveId {
-		KeepAliveID(g.DragDropPayload.SourceId)
+		g.KeepAliveID(g.DragDropPayload.SourceId)
 	}
 
 	// Update HoveredId data
@@ -143,7 +149,7 @@ func NewFrame() {
 
 	// Update ActiveId data (clear reference to active widget if the widget isn't alive anymore)
 	if g.ActiveIdIsAlive != g.ActiveId && g.ActiveIdPreviousFrame == g.ActiveId && g.ActiveId != 0 {
-		ClearActiveID()
+		g.ClearActiveID()
 	}
 	if g.ActiveId != 0 {
 		g.ActiveIdTimer += g.IO.DeltaTime
@@ -162,7 +168,7 @@ func NewFrame() {
 	if g.ActiveId == 0 {
 		g.ActiveIdUsingNavDirMask = 0x00
 		g.ActiveIdUsingNavInputMask = 0x00
-		g.ActiveIdUsingKeyInputMask = 0x00
+		g.ActiveIdUsingKeyInputMask.ClearAllBits()
 	}
 
 	// Drag and drop
@@ -173,37 +179,42 @@ func NewFrame() {
 	g.DragDropWithinTarget = false
 	g.DragDropHoldJustPressedId = 0
 
+	// Process input queue (trickle as many events as possible)
+	g.InputEventsTrail = g.InputEventsTrail[:0]
+	g.UpdateInputEvents(g.IO.ConfigInputTrickleEventQueue)
+
 	// Update keyboard input state
-	// Synchronize io.KeyMods with individual modifiers io.KeyXXX bools
-	g.IO.KeyMods = GetMergedKeyModFlags()
-	copy(g.IO.KeysDownDurationPrev[:], g.IO.KeysDownDuration[:])
-	for i := 0; i < len(g.IO.KeysDown); i++ {
-		if g.IO.KeysDown[i] {
-			if g.IO.KeysDownDuration[i] < 0.0 {
-				g.IO.KeysDownDuration[i] = 0.0
-			} else {
-				g.IO.KeysDownDuration[i] += g.IO.DeltaTime
-			}
-		} else {
-			g.IO.KeysDownDuration[i] = -1.0
-		}
-	}
+	g.UpdateKeyboardInputs()
+
+	// Apply the shortcut routes registered on the previous frame
+	g.UpdateKeyRoutingTable()
 
 	// Update gamepad/keyboard navigation
-	NavUpdate()
+	g.NavUpdate()
 
 	// Update mouse input state
-	UpdateMouseInputs()
+	g.UpdateMouseInputs()
+
+	// Turn files dropped from outside of the application into a drag and drop payload
+	g.UpdateDragDropExternPaths()
+
+	// Undocking
+	// (needs to be before UpdateMouseMovingWindowNewFrame so the window is already undocked when moved)
+	DockContextNewFrameUpdateUndocking(g)
 
 	// Find hovered window
 	// (needs to be before UpdateMouseMovingWindowNewFrame so we fill g.HoveredWindowUnderMovingWindow on the mouse release frame)
-	UpdateHoveredWindowAndCaptureFlags()
+	g.UpdateHoveredWindowAndCaptureFlags()
+
+	// Docking preview and drop of the window being moved
+	// (needs to be before UpdateMouseMovingWindowNewFrame which clears g.MovingWindow on the mouse release frame)
+	DockContextNewFrameUpdateDropTarget(g)
 
 	// Handle user moving window with mouse (at the beginning of the frame to avoid input lag or sheering)
-	UpdateMouseMovingWindowNewFrame()
+	g.UpdateMouseMovingWindowNewFrame()
 
 	// Background darkening/whitening
-	if GetTopMostPopupModal() != nil || (g.NavWindowingTarget != nil && g.NavWindowingHighlightAlpha > 0.0) {
+	if g.GetTopMostPopupModal() != nil || (g.NavWindowingTarget != nil && g.NavWindowingHighlightAlpha > 0.0) {
 		g.DimBgRatio = ImMin(g.DimBgRatio+g.IO.DeltaTime*6.0, 1.0)
 	} else {
 		g.DimBgRatio = ImMax(g.DimBgRatio-g.IO.DeltaTime*10.0, 0.0)
@@ -216,10 +227,10 @@ func NewFrame() {
 	g.PlatformImePos = ImVec2{1.0, 1.0} // OS Input Method Editor showing on top-left of our window by default
 
 	// Mouse wheel scrolling, scale
-	UpdateMouseWheel()
+	g.UpdateMouseWheel()
 
 	// Update legacy TAB focus
-	UpdateTabFocus()
+	g.UpdateTabFocus()
 
 	// Mark all windows as not visible and compact unused memory.
 	IM_ASSERT(len(g.WindowsFocusOrder) <= len(g.Windows))
@@ -254,13 +265,13 @@ func NewFrame() {
 		}
 	}*/
 	if g.GcCompactAll {
-		GcCompactTransientMiscBuffers()
+		g.GcCompactTransientMiscBuffers()
 	}
 	g.GcCompactAll = false
 
 	// Closing the focused window restore focus to the first active root window in descending z-order
 	if g.NavWindow != nil && !g.NavWindow.WasActive {
-		FocusTopMostWindowUnderOne(nil, nil)
+		g.FocusTopMostWindowUnderOne(nil, nil)
 	}
 
 	// No window should be open at the beginning of the frame.
@@ -272,33 +283,34 @@ func NewFrame() {
 	g.GroupStack = g.GroupStack[:0]
 
 	// [DEBUG] Item picker tool - start with DebugStartItemPicker() - useful to visually select an item and break into its call-stack.
-	UpdateDebugToolItemPicker()
+	g.UpdateDebugToolItemPicker()
 
 	// Create implicit/fallback window - which we will only render it if the user has added something to it.
 	// We don't use "Debug" to avoid colliding with user trying to create a "Debug" window with custom flags.
 	// This fallback is particularly important as it avoid ImGui:: calls from crashing.
 	g.WithinFrameScopeWithImplicitWindow = true
-	SetNextWindowSize(&ImVec2{400, 400}, ImGuiCond_FirstUseEver)
-	Begin("Debug##Default", nil, 0)
+	g.SetNextWindowSize(&ImVec2{400, 400}, ImGuiCond_FirstUseEver)
+	g.Begin("Debug##Default", nil, 0)
 	IM_ASSERT(g.CurrentWindow.IsFallbackWindow)
 
+	// Process docking requests and submit the floating dock nodes host windows
+	DockContextNewFrameUpdateDocking(g)
+
 	CallContextHooks(g, ImGuiContextHookType_NewFramePost)
 }
 
-func ErrorCheckEndFrameSanityChecks() {
-	var g = GImGui
-
+func (g *ImGuiContext) ErrorCheckEndFrameSanityChecks() {
 	// Verify that io.KeyXXX fields haven't been tampered with. Key mods should not be modified between NewFrame() and EndFrame()
 	// One possible reason leading to this assert is that your backends update inputs _AFTER_ NewFrame().
 	// It is known that when some modal native windows called mid-frame takes focus away, some backends such as GLFW will
 	// send key release events mid-frame. This would normally trigger this assertion and lead to sheared inputs.
 	// We silently accommodate for this case by ignoring/ the case where all io.KeyXXX modifiers were released (aka key_mod_flags == 0),
 	// while still correctly asserting on mid-frame key press events.
-	var key_mod_flags = GetMergedKeyModFlags()
+	var key_mod_flags = g.GetMergedKeyModFlags()
 	IM_ASSERT_USER_ERROR((key_mod_flags == 0 || g.IO.KeyMods == key_mod_flags), "Mismatching io.KeyCtrl/io.KeyShift/io.KeyAlt/io.KeySuper vs io.KeyMods")
 
 	// Recover from errors
-	ErrorCheckEndFrameRecover(nil, nil)
+	g.ErrorCheckEndFrameRecover(nil, nil)
 
 	// Report when there is a mismatch of Begin/BeginChild vs End/EndChild calls. Important: Remember that the Begin/BeginChild API requires you
 	// to always call End/EndChild even if Begin/BeginChild returns false! (this is unfortunately inconsistent with most other Begin* API).
@@ -306,7 +318,7 @@ func ErrorCheckEndFrameSanityChecks() {
 		if len(g.CurrentWindowStack) > 1 {
 			IM_ASSERT_USER_ERROR(len(g.CurrentWindowStack) == 1, "Mismatched Begin/BeginChild vs End/EndChild calls: did you forget to call End/EndChild?")
 			for len(g.CurrentWindowStack) > 1 {
-				End()
+				g.End()
 			}
 		} else {
 			IM_ASSERT_USER_ERROR(len(g.CurrentWindowStack) == 1, "Mismatched Begin/BeginChild vs End/EndChild calls: did you call End/EndChild too much?")
@@ -317,8 +329,7 @@ func ErrorCheckEndFrameSanityChecks() {
 }
 
 // ends the Dear ImGui frame. automatically called by Render(). If you don't need to render data (skipping rendering) you may call EndFrame() without Render()... but you'll have wasted CPU already! If you don't need to render, better to not create any windows and not call NewFrame() at all!
-func EndFrame() {
-	var g = GImGui
+func (g *ImGuiContext) EndFrame() {
 	IM_ASSERT(g.Initialized)
 
 	// Don't process EndFrame() multiple times.
@@ -329,7 +340,7 @@ func EndFrame() {
 
 	CallContextHooks(g, ImGuiContextHookType_EndFramePre)
 
-	ErrorCheckEndFrameSanityChecks()
+	g.ErrorCheckEndFrameSanityChecks()
 
 	// Notify OS when our Input Method Editor cursor has moved (e.g. CJK inputs using Microsoft IME)
 	if g.IO.ImeSetInputScreenPosFn != nil && (g.PlatformImeLastPos.x == FLT_MAX || ImLengthSqrVec2(g.PlatformImeLastPos.Sub(g.PlatformImePos)) > 0.0001) {
@@ -342,24 +353,24 @@ func EndFrame() {
 	if g.CurrentWindow != nil && !g.CurrentWindow.WriteAccessed {
 		g.CurrentWindow.Active = false
 	}
-	End()
+	g.End()
 
 	// Update navigation: CTRL+Tab, wrap-around requests
-	NavEndFrame()
+	g.NavEndFrame()
 
 	// Drag and Drop: Elapse payload (if delivered, or if source stops being submitted)
 	if g.DragDropActive {
 		var is_delivered = g.DragDropPayload.Delivery
-		var is_elapsed = (g.DragDropPayload.DataFrameCount+1 < g.FrameCount) && ((g.DragDropSourceFlags&ImGuiDragDropFlags_SourceAutoExpirePayload != 0) || !IsMouseDown(g.DragDropMouseButton))
+		var is_elapsed = (g.DragDropPayload.DataFrameCount+1 < g.FrameCount) && ((g.DragDropSourceFlags&ImGuiDragDropFlags_SourceAutoExpirePayload != 0) || !g.IsMouseDown(g.DragDropMouseButton))
 		if is_delivered || is_elapsed {
-			ClearDragDrop()
+			g.ClearDragDrop()
 		}
 	}
 
 	// Drag and Drop: Fallback for source tooltip. This is not ideal but better than nothing.
 	if g.DragDropActive && g.DragDropSourceFrameCount < g.FrameCount && g.DragDropSourceFlags&ImGuiDragDropFlags_SourceNoPreviewTooltip == 0 {
 		g.DragDropWithinSource = true
-		SetTooltip("...")
+		g.SetTooltip("...")
 		g.DragDropWithinSource = false
 	}
 
@@ -368,7 +379,7 @@ func EndFrame() {
 	g.FrameCountEnded = g.FrameCount
 
 	// Initiate moving window + handle left-click and right-click focus
-	UpdateMouseMovingWindowEndFrame()
+	g.UpdateMouseMovingWindowEndFrame()
 
 	// Sort the window list so that all child windows are after their parent
 	// We cannot do that on FocusWindow() because children may not exist yet
@@ -1,11 +1,10 @@
 package imgui
 
 // GcCompactTransientMiscBuffers Garbage collection
-func GcCompactTransientMiscBuffers() {
-	var g = GImGui
+func (g *ImGuiContext) GcCompactTransientMiscBuffers() {
 	g.ItemFlagsStack = nil
 	g.GroupStack = nil
-	TableGcCompactSettings()
+	g.TableGcCompactSettings()
 }
 
 // GcCompactTransientWindowBuffers Free up/compact internal window buffers, we can use this when a window becomes unused.
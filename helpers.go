@@ -47,29 +47,16 @@ var GCrc32LookupTable = [256]ImU32{
 func ImHashStr(data_p string, data_size size_t, seed ImU32) ImGuiID {
 	seed = ^seed
 	var crc = seed
-	var data = data_p
 	var crc32_lut = GCrc32LookupTable
-	if data_size != 0 {
-		for {
-			data_size--
-			if data_size == 0 {
-				break
-			}
-			data = data[1:]
-			var c = data[0]
-			if c == '#' && data_size >= 2 && data[0] == '#' && data[1] == '#' {
-				crc = seed
-			}
-			crc = (crc >> 8) ^ crc32_lut[(crc&0xFF)^uint(c)]
-		}
-	} else {
-		for i := 0; i < len(data_p); i++ {
-			c := data_p[i]
-			if c == '#' && data[0] == '#' && data[1] == '#' {
-				crc = seed
-			}
-			crc = (crc >> 8) ^ crc32_lut[(crc&0xFF)^uint(c)]
+	if data_size == 0 || data_size > size_t(len(data_p)) {
+		data_size = size_t(len(data_p))
+	}
+	for i := size_t(0); i < data_size; i++ {
+		var c = data_p[i]
+		if c == '#' && i+2 < data_size && data_p[i+1] == '#' && data_p[i+2] == '#' {
+			crc = seed
 		}
+		crc = (crc >> 8) ^ crc32_lut[(crc&0xFF)^uint(c)]
 	}
 	return ^crc
 }
@@ -0,0 +1,34 @@
+package imgui
+
+// newTestContext creates a context for the tests: no .ini file, a 640x480 display and a built font atlas.
+// configure, when not nil, adjusts io before the font atlas is built.
+func newTestContext(configure func(io *ImGuiIO)) *ImGuiContext {
+	var ctx = CreateContext(nil)
+	var ui = ctx.Lock()
+	var io = ui.GetIO()
+	io.IniFilename = ""
+	io.DisplaySize = ImVec2{640, 480}
+	if configure != nil {
+		configure(io)
+	}
+	io.Fonts.Build()
+	ui.Unlock()
+	return ctx
+}
+
+// mouseDrag drags the mouse with the left button from one position to another in steps moves, running frame after each input event.
+// A last frame runs over the destination before the button is released, so that drop targets see the payload before delivery.
+func mouseDrag(io *ImGuiIO, frame func(), from, to ImVec2, steps int) {
+	io.AddMousePosEvent(from.x, from.y)
+	frame()
+	io.AddMouseButtonEvent(0, true)
+	frame()
+	for i := int(1); i <= steps; i++ {
+		var t = float(i) / float(steps)
+		io.AddMousePosEvent(from.x+(to.x-from.x)*t, from.y+(to.y-from.y)*t)
+		frame()
+	}
+	frame()
+	io.AddMouseButtonEvent(0, false)
+	frame()
+}
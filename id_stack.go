@@ -13,8 +13,7 @@ package imgui
 //   whereas "str_id" denote a string that is only used as an ID and not normally displayed.
 
 // PushOverrideID Push given value as-is at the top of the ID stack (whereas PushID combines old and new hashes)
-func PushOverrideID(id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) PushOverrideID(id ImGuiID) {
 	var window = g.CurrentWindow
 	window.IDStack = append(window.IDStack, id)
 }
@@ -23,51 +22,48 @@ func PushOverrideID(id ImGuiID) {
 // (note that when using this pattern, TestEngine's "Stack Tool" will tend to not display the intermediate stack level.
 //
 //	for that to work we would need to do PushOverrideID() . ItemAdd() . PopID() which would alter widget code a little more)
-func GetIDWithSeed(str string, seed ImGuiID) ImGuiID {
+func (g *ImGuiContext) GetIDWithSeed(str string, seed ImGuiID) ImGuiID {
 	var id = ImHashStr(str, uintptr(len(str)), seed)
-	KeepAliveID(id)
+	g.KeepAliveID(id)
 	return id
 }
 
-func PushString(str_id string) {
-	var g = GImGui
+func (g *ImGuiContext) PushString(str_id string) {
 	var window = g.CurrentWindow
 	var id = window.GetIDNoKeepAlive(str_id)
 	window.IDStack = append(window.IDStack, id)
 }
 
 // PushInterface push pointer into the ID stack (will hash pointer).
-func PushInterface(ptr_id any) {
-	var g = GImGui
+func (g *ImGuiContext) PushInterface(ptr_id any) {
 	var window = g.CurrentWindow
 	var id = window.GetIDNoKeepAliveInterface(ptr_id)
 	window.IDStack = append(window.IDStack, id)
 }
 
 // PushID push integer into the ID stack (will hash integer).
-func PushID(int_id int) {
-	var g = GImGui
+func (g *ImGuiContext) PushID(int_id int) {
 	var window = g.CurrentWindow
 	var id = window.GetIDNoKeepAliveInt(int_id)
 	window.IDStack = append(window.IDStack, id)
 }
 
-func PopID() {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) PopID() {
+	var window = g.CurrentWindow
 	IM_ASSERT(len(window.IDStack) > 1) // Too many PopID(), or could be popping in a wrong/different window?
 	window.IDStack = window.IDStack[:len(window.IDStack)-1]
 } // pop from the ID stack.
 
-func GetIDFromString(str_id string) ImGuiID {
-	return GImGui.CurrentWindow.GetIDs(str_id)
+func (g *ImGuiContext) GetIDFromString(str_id string) ImGuiID {
+	return g.CurrentWindow.GetIDs(str_id)
 
 } // calculate unique ID (hash of whole ID stack + given parameter). e.g. if you want to query into ImGuiStorage yourself
 
-func GetIDs(str_id_begin string) ImGuiID {
-	return GImGui.CurrentWindow.GetIDs(str_id_begin)
+func (g *ImGuiContext) GetIDs(str_id_begin string) ImGuiID {
+	return g.CurrentWindow.GetIDs(str_id_begin)
 }
 
-func GetIDFromInterface(ptr_id any) ImGuiID {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetIDFromInterface(ptr_id any) ImGuiID {
+	var window = g.CurrentWindow
 	return window.GetIDInterface(ptr_id)
 }
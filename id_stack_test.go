@@ -11,6 +11,21 @@ func TestIDs(t *testing.T) {
 	fmt.Println(ImHashStr("Beep Boop", 0, 0))
 }
 
+func TestHashStr(t *testing.T) {
+	if ImHashStr("Hello World", 0, 0) == ImHashStr("ello World", 0, 0) {
+		t.Error("the first byte is not hashed")
+	}
+	if ImHashStr("Hello World", 5, 0) != ImHashStr("Hello", 0, 0) {
+		t.Error("data_size doesn't limit the hashed bytes")
+	}
+	if ImHashStr("Label###ID", 0, 0) != ImHashStr("Other###ID", 0, 0) {
+		t.Error("\"###\" doesn't reset the hash")
+	}
+	if ImHashStr("##Label", 0, 0) == ImHashStr("##Other", 0, 0) {
+		t.Error("\"##\" resets the hash")
+	}
+}
+
 func BenchmarkImguiIDs(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		ImHashStr("Hello World", 0, 0)
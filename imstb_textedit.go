@@ -34,10 +34,10 @@ func STB_TEXTEDIT_STRINGLEN(obj *STB_TEXTEDIT_STRING) int {
 	return obj.CurLenW
 }
 
-func STB_TEXTEDIT_LAYOUTROW(r *StbTexteditRow, obj *STB_TEXTEDIT_STRING, line_start_idx int) {
+func (g *ImGuiContext) STB_TEXTEDIT_LAYOUTROW(r *StbTexteditRow, obj *STB_TEXTEDIT_STRING, line_start_idx int) {
 	var text = obj.TextW
 	var text_remaining []ImWchar
-	var size = InputTextCalcTextSizeW(text[line_start_idx:obj.CurLenW], &text_remaining, nil, true)
+	var size = g.InputTextCalcTextSizeW(text[line_start_idx:obj.CurLenW], &text_remaining, nil, true)
 	r.x0 = 0.0
 	r.x1 = size.x
 	r.baseline_y_delta = size.y
@@ -46,12 +46,11 @@ func STB_TEXTEDIT_LAYOUTROW(r *StbTexteditRow, obj *STB_TEXTEDIT_STRING, line_st
 	r.num_chars = int(len(text) - len(text[line_start_idx:]))
 }
 
-func STB_TEXTEDIT_GETWIDTH(obj *STB_TEXTEDIT_STRING, line_start_idx, char_idx int) float {
+func (g *ImGuiContext) STB_TEXTEDIT_GETWIDTH(obj *STB_TEXTEDIT_STRING, line_start_idx, char_idx int) float {
 	var c = obj.TextW[line_start_idx+char_idx]
 	if c == '\n' {
 		return STB_TEXTEDIT_GETWIDTH_NEWLINE
 	}
-	var g = GImGui
 	return g.Font.GetCharAdvance(c) * (g.FontSize / g.Font.FontSize)
 }
 
@@ -70,6 +69,9 @@ func STB_TEXTEDIT_DELETECHARS(obj *STB_TEXTEDIT_STRING, pos, n int) {
 	// Offset remaining text (FIXME-OPT: Use memmove)
 	src := obj.TextW[pos+n:]
 	copy(dst, src)
+	for i := len(src); i < len(dst); i++ {
+		dst[i] = 0
+	}
 }
 
 func STB_TEXTEDIT_INSERTCHARS(obj *STB_TEXTEDIT_STRING, pos int, new_text []STB_TEXTEDIT_CHARTYPE, new_text_len int) bool {
@@ -95,7 +97,7 @@ func STB_TEXTEDIT_INSERTCHARS(obj *STB_TEXTEDIT_STRING, pos int, new_text []STB_
 	if pos != text_len {
 		copy(text[pos+new_text_len:], text[pos:text_len])
 	}
-	copy(text[pos:], new_text[new_text_len:])
+	copy(text[pos:], new_text[:new_text_len])
 
 	obj.Edited = true
 	obj.CurLenW += new_text_len
@@ -246,7 +248,7 @@ type StbTexteditRow struct {
 }
 
 // traverse the layout to locate the nearest character to a display position
-func stb_text_locate_coord(str *STB_TEXTEDIT_STRING, x, y float) int {
+func (g *ImGuiContext) stb_text_locate_coord(str *STB_TEXTEDIT_STRING, x, y float) int {
 	var r StbTexteditRow
 	var n = STB_TEXTEDIT_STRINGLEN(str)
 	var base_y, prev_x float
@@ -260,7 +262,7 @@ func stb_text_locate_coord(str *STB_TEXTEDIT_STRING, x, y float) int {
 
 	// search rows to find one that straddles 'y'
 	for i < n {
-		STB_TEXTEDIT_LAYOUTROW(&r, str, i)
+		g.STB_TEXTEDIT_LAYOUTROW(&r, str, i)
 		if r.num_chars <= 0 {
 			return n
 		}
@@ -292,7 +294,7 @@ func stb_text_locate_coord(str *STB_TEXTEDIT_STRING, x, y float) int {
 		// search characters in row for one that straddles 'x'
 		prev_x = r.x0
 		for k = 0; k < r.num_chars; k++ {
-			var w = STB_TEXTEDIT_GETWIDTH(str, i, k)
+			var w = g.STB_TEXTEDIT_GETWIDTH(str, i, k)
 			if x < prev_x+w {
 				if x < prev_x+w/2 {
 					return k + i
@@ -314,30 +316,30 @@ func stb_text_locate_coord(str *STB_TEXTEDIT_STRING, x, y float) int {
 }
 
 // API click: on mouse down, move the cursor to the clicked location, and reset the selection
-func stb_textedit_click(str *STB_TEXTEDIT_STRING, state *STB_TexteditState, x, y float) {
+func (g *ImGuiContext) stb_textedit_click(str *STB_TEXTEDIT_STRING, state *STB_TexteditState, x, y float) {
 	// In single-line mode, just always make y = 0. This lets the drag keep working if the mouse
 	// goes off the top or bottom of the text
 	if state.single_line != 0 {
 		var r StbTexteditRow
-		STB_TEXTEDIT_LAYOUTROW(&r, str, 0)
+		g.STB_TEXTEDIT_LAYOUTROW(&r, str, 0)
 		y = r.ymin
 	}
 
-	state.cursor = stb_text_locate_coord(str, x, y)
+	state.cursor = g.stb_text_locate_coord(str, x, y)
 	state.select_start = state.cursor
 	state.select_end = state.cursor
 	state.has_preferred_x = 0
 }
 
 // API drag: on mouse drag, move the cursor and selection endpoint to the clicked location
-func stb_textedit_drag(str *STB_TEXTEDIT_STRING, state *STB_TexteditState, x, y float) {
+func (g *ImGuiContext) stb_textedit_drag(str *STB_TEXTEDIT_STRING, state *STB_TexteditState, x, y float) {
 	var p int = 0
 
 	// In single-line mode, just always make y = 0. This lets the drag keep working if the mouse
 	// goes off the top or bottom of the text
 	if state.single_line != 0 {
 		var r StbTexteditRow
-		STB_TEXTEDIT_LAYOUTROW(&r, str, 0)
+		g.STB_TEXTEDIT_LAYOUTROW(&r, str, 0)
 		y = r.ymin
 	}
 
@@ -345,7 +347,7 @@ func stb_textedit_drag(str *STB_TEXTEDIT_STRING, state *STB_TexteditState, x, y
 		state.select_start = state.cursor
 	}
 
-	p = stb_text_locate_coord(str, x, y)
+	p = g.stb_text_locate_coord(str, x, y)
 	state.cursor = p
 	state.select_end = p
 }
@@ -503,7 +505,7 @@ type StbFindState struct {
 
 // find the x/y location of a character, and remember info about the previous row in
 // case we get a move-up event (for page up, we'll have to rescan)
-func stb_textedit_find_charpos(find *StbFindState, str *STB_TEXTEDIT_STRING, n, single_line int) {
+func (g *ImGuiContext) stb_textedit_find_charpos(find *StbFindState, str *STB_TEXTEDIT_STRING, n, single_line int) {
 	var r StbTexteditRow
 	var prev_start int = 0
 	var z = STB_TEXTEDIT_STRINGLEN(str)
@@ -513,7 +515,7 @@ func stb_textedit_find_charpos(find *StbFindState, str *STB_TEXTEDIT_STRING, n,
 		// if it's at the end, then find the last line -- simpler than trying to
 		// explicitly handle this case in the regular code
 		if single_line != 0 {
-			STB_TEXTEDIT_LAYOUTROW(&r, str, 0)
+			g.STB_TEXTEDIT_LAYOUTROW(&r, str, 0)
 			find.y = 0
 			find.first_char = 0
 			find.length = z
@@ -524,7 +526,7 @@ func stb_textedit_find_charpos(find *StbFindState, str *STB_TEXTEDIT_STRING, n,
 			find.x = 0
 			find.height = 1
 			for i < z {
-				STB_TEXTEDIT_LAYOUTROW(&r, str, i)
+				g.STB_TEXTEDIT_LAYOUTROW(&r, str, i)
 				prev_start = i
 				i += r.num_chars
 			}
@@ -539,7 +541,7 @@ func stb_textedit_find_charpos(find *StbFindState, str *STB_TEXTEDIT_STRING, n,
 	find.y = 0
 
 	for {
-		STB_TEXTEDIT_LAYOUTROW(&r, str, i)
+		g.STB_TEXTEDIT_LAYOUTROW(&r, str, i)
 		if n < i+r.num_chars {
 			break
 		}
@@ -557,7 +559,7 @@ func stb_textedit_find_charpos(find *StbFindState, str *STB_TEXTEDIT_STRING, n,
 	// now scan to find xpos
 	find.x = r.x0
 	for i = 0; first+i < n; i++ {
-		find.x += STB_TEXTEDIT_GETWIDTH(str, first, i)
+		find.x += g.STB_TEXTEDIT_GETWIDTH(str, first, i)
 	}
 }
 
@@ -716,7 +718,7 @@ func stb_textedit_paste_internal(str *STB_TEXTEDIT_STRING, state *STB_TexteditSt
 type STB_TEXTEDIT_KEYTYPE int
 
 // API key: process a keyboard input
-func stb_textedit_key(str *STB_TEXTEDIT_STRING, state *STB_TexteditState, key STB_TEXTEDIT_KEYTYPE) {
+func (g *ImGuiContext) stb_textedit_key(str *STB_TEXTEDIT_STRING, state *STB_TexteditState, key STB_TEXTEDIT_KEYTYPE) {
 retry:
 	switch key {
 	default:
@@ -857,7 +859,7 @@ retry:
 
 			// compute current position of cursor point
 			stb_textedit_clamp(str, state)
-			stb_textedit_find_charpos(&find, str, state.cursor, int(state.single_line))
+			g.stb_textedit_find_charpos(&find, str, state.cursor, int(state.single_line))
 
 			for j = 0; j < row_count; j++ {
 				var x, goal_x float
@@ -880,10 +882,10 @@ retry:
 
 				// now find character position down a row
 				state.cursor = start
-				STB_TEXTEDIT_LAYOUTROW(&row, str, state.cursor)
+				g.STB_TEXTEDIT_LAYOUTROW(&row, str, state.cursor)
 				x = row.x0
 				for i = 0; i < row.num_chars; i++ {
-					var dx = STB_TEXTEDIT_GETWIDTH(str, start, i)
+					var dx = g.STB_TEXTEDIT_GETWIDTH(str, start, i)
 					if dx == STB_TEXTEDIT_GETWIDTH_NEWLINE {
 						break
 					}
@@ -942,7 +944,7 @@ retry:
 
 			// compute current position of cursor point
 			stb_textedit_clamp(str, state)
-			stb_textedit_find_charpos(&find, str, state.cursor, int(state.single_line))
+			g.stb_textedit_find_charpos(&find, str, state.cursor, int(state.single_line))
 
 			for j = 0; j < row_count; j++ {
 				var x, goal_x float
@@ -959,10 +961,10 @@ retry:
 
 				// now find character position up a row
 				state.cursor = find.prev_first
-				STB_TEXTEDIT_LAYOUTROW(&row, str, state.cursor)
+				g.STB_TEXTEDIT_LAYOUTROW(&row, str, state.cursor)
 				x = row.x0
 				for i = 0; i < row.num_chars; i++ {
-					var dx = STB_TEXTEDIT_GETWIDTH(str, find.prev_first, i)
+					var dx = g.STB_TEXTEDIT_GETWIDTH(str, find.prev_first, i)
 					if dx == STB_TEXTEDIT_GETWIDTH_NEWLINE {
 						break
 					}
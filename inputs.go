@@ -0,0 +1,232 @@
+package imgui
+
+// Input Functions
+// - Input events are queued with the io.AddXXXEvent() functions and processed by the next NewFrame(),
+//   so a backend can call them at any time, e.g. from its platform callbacks.
+// - Writing to the legacy io.MousePos, io.MouseDown[], io.KeysDown[], io.KeyCtrl... fields before NewFrame() still works,
+//   events are applied on top of them.
+
+// Queue a new key down/up event. Key should be "translated" (as in, generally ImGuiKey_A matches the key end-user would use to emit an 'A' character)
+func (io *ImGuiIO) AddKeyEvent(key ImGuiKey, down bool) {
+	var v float
+	if down {
+		v = 1.0
+	}
+	io.AddKeyAnalogEvent(key, down, v)
+}
+
+// Queue a new key down/up event for analog values (e.g. ImGuiKey_Gamepad_ values). Dead-zones should be handled by the backend.
+func (io *ImGuiIO) AddKeyAnalogEvent(key ImGuiKey, down bool, analog_value float) {
+	IM_ASSERT_USER_ERROR(IsNamedKey(key), "Backend needs to pass a valid ImGuiKey_ constant. 0..511 values are legacy native key codes which are not accepted by this API.")
+
+	// Verify that backend isn't mixing up using new io.AddKeyEvent() api and old io.KeysDown[] + io.KeyMap[] data.
+	IM_ASSERT_USER_ERROR(io.BackendUsingLegacyKeyArrays == -1 || io.BackendUsingLegacyKeyArrays == 0, "Backend needs to either only use io.AddKeyEvent(), either only fill legacy io.KeysDown[] + io.KeyMap[]. Not both!")
+	if io.BackendUsingLegacyKeyArrays == -1 {
+		for n := ImGuiKey(ImGuiKey_NamedKey_BEGIN); n < ImGuiKey_NamedKey_END; n++ {
+			IM_ASSERT_USER_ERROR(io.KeyMap[n] == -1, "Backend needs to either only use io.AddKeyEvent(), either only fill legacy io.KeysDown[] + io.KeyMap[]. Not both!")
+		}
+	}
+	io.BackendUsingLegacyKeyArrays = 0
+
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_Key
+	if IsGamepadKey(key) {
+		e.Source = ImGuiInputSource_Gamepad
+	} else {
+		e.Source = ImGuiInputSource_Keyboard
+	}
+	e.Key.Key = key
+	e.Key.Down = down
+	e.Key.AnalogValue = analog_value
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
+}
+
+// Queue a change of the keyboard modifiers (Ctrl, Shift, Alt, Super).
+func (io *ImGuiIO) AddKeyModsEvent(modifiers ImGuiKeyModFlags) {
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_KeyMods
+	e.Source = ImGuiInputSource_Keyboard
+	e.KeyMods.Mods = modifiers
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
+}
+
+// Queue a mouse position update. Use -FLT_MAX,-FLT_MAX to signify no mouse (e.g. app not focused and not hovered)
+func (io *ImGuiIO) AddMousePosEvent(x, y float) {
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_MousePos
+	e.Source = ImGuiInputSource_Mouse
+	e.MousePos.PosX = x
+	e.MousePos.PosY = y
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
+}
+
+// Queue a mouse button change
+func (io *ImGuiIO) AddMouseButtonEvent(mouse_button int, down bool) {
+	IM_ASSERT(mouse_button >= 0 && mouse_button < int(ImGuiMouseButton_COUNT))
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_MouseButton
+	e.Source = ImGuiInputSource_Mouse
+	e.MouseButton.Button = mouse_button
+	e.MouseButton.Down = down
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
+}
+
+// Queue a mouse wheel update
+func (io *ImGuiIO) AddMouseWheelEvent(wheel_x, wheel_y float) {
+	if wheel_x == 0.0 && wheel_y == 0.0 {
+		return
+	}
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_MouseWheel
+	e.Source = ImGuiInputSource_Mouse
+	e.MouseWheel.WheelX = wheel_x
+	e.MouseWheel.WheelY = wheel_y
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
+}
+
+// Queue a drop of files coming from outside of the application (e.g. dragged from the OS file manager), at position x,y.
+// The next NewFrame() starts an external drag and drop source holding the paths as an IMGUI_PAYLOAD_TYPE_FILES payload,
+// which is delivered on the following frame to the drag and drop target under the mouse, if any.
+func (io *ImGuiIO) AddExternalDrop(paths []string, x, y float) {
+	if len(paths) == 0 {
+		return
+	}
+	io.AddMousePosEvent(x, y)
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_Drop
+	e.Source = ImGuiInputSource_Mouse
+	e.Drop.Paths = append([]string(nil), paths...)
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
+}
+
+// Process input events queued in io.InputEventsQueue[].
+// With trickle_fast_inputs, events that would be lost within a single frame (e.g. a button down + up) are spread
+// over multiple frames: processing stops at the first event conflicting with one already applied this frame,
+// and the remaining events are kept for the next frame.
+func (g *ImGuiContext) UpdateInputEvents(trickle_fast_inputs bool) {
+	var io = &g.IO
+
+	var mouse_moved, mouse_wheeled, key_changed, text_inputed bool
+	var mouse_button_changed int
+	var key_mods_changed ImGuiKeyModFlags
+	var key_changed_mask [ImGuiKey_KeysData_SIZE]bool
+
+	var event_n int
+trickle:
+	for ; event_n < int(len(io.InputEventsQueue)); event_n++ {
+		var e = &io.InputEventsQueue[event_n]
+		switch e.Type {
+		case ImGuiInputEventType_MousePos:
+			var event_pos = ImVec2{e.MousePos.PosX, e.MousePos.PosY}
+			if g.IsMousePosValid(&event_pos) {
+				event_pos = *ImFloorVec(&event_pos) // Apply same flooring as UpdateMouseInputs()
+			}
+			if io.MousePos.x != event_pos.x || io.MousePos.y != event_pos.y {
+				// Trickling Rule: Stop processing queued events if we already handled a mouse button change
+				if trickle_fast_inputs && (mouse_button_changed != 0 || mouse_wheeled || key_changed || text_inputed) {
+					break trickle
+				}
+				io.MousePos = event_pos
+				mouse_moved = true
+			}
+		case ImGuiInputEventType_MouseButton:
+			var button = e.MouseButton.Button
+			IM_ASSERT(button >= 0 && button < int(ImGuiMouseButton_COUNT))
+			if io.MouseDown[button] != e.MouseButton.Down {
+				// Trickling Rule: Stop processing queued events if we got multiple action on the same button
+				if trickle_fast_inputs && ((mouse_button_changed&(1<<button)) != 0 || mouse_wheeled) {
+					break trickle
+				}
+				io.MouseDown[button] = e.MouseButton.Down
+				mouse_button_changed |= 1 << button
+			}
+		case ImGuiInputEventType_MouseWheel:
+			// Trickling Rule: Stop processing queued events if we got multiple action on the event
+			if trickle_fast_inputs && (mouse_moved || mouse_button_changed != 0) {
+				break trickle
+			}
+			io.MouseWheelH += e.MouseWheel.WheelX
+			io.MouseWheel += e.MouseWheel.WheelY
+			mouse_wheeled = true
+		case ImGuiInputEventType_Key:
+			var key = e.Key.Key
+			IM_ASSERT(key != ImGuiKey_None)
+			var keydata_index = key - ImGuiKey_KeysData_OFFSET
+			var keydata = &io.KeysData[keydata_index]
+			if keydata.Down != e.Key.Down || keydata.AnalogValue != e.Key.AnalogValue {
+				// Trickling Rule: Stop processing queued events if we got multiple action on the same button
+				if trickle_fast_inputs && keydata.Down != e.Key.Down && (key_changed_mask[keydata_index] || text_inputed || mouse_button_changed != 0) {
+					break trickle
+				}
+				keydata.Down = e.Key.Down
+				keydata.AnalogValue = e.Key.AnalogValue
+				key_changed = true
+				key_changed_mask[keydata_index] = true
+
+				switch key {
+				case ImGuiKey_ModCtrl:
+					io.KeyCtrl = keydata.Down
+				case ImGuiKey_ModShift:
+					io.KeyShift = keydata.Down
+				case ImGuiKey_ModAlt:
+					io.KeyAlt = keydata.Down
+				case ImGuiKey_ModSuper:
+					io.KeySuper = keydata.Down
+				}
+
+				// Allow legacy code using io.KeysDown[GetKeyIndex()] with new backends
+				io.KeysDown[key] = keydata.Down
+			}
+		case ImGuiInputEventType_KeyMods:
+			var modifiers = e.KeyMods.Mods
+			var modifiers_that_are_changing = g.GetMergedKeyModFlags() ^ modifiers
+			if modifiers_that_are_changing != 0 {
+				// Trickling Rule: Stop processing queued events if we got multiple action on the same modifier
+				if trickle_fast_inputs && (key_mods_changed&modifiers_that_are_changing) != 0 {
+					break trickle
+				}
+				io.KeyCtrl = (modifiers & ImGuiKeyModFlags_Ctrl) != 0
+				io.KeyShift = (modifiers & ImGuiKeyModFlags_Shift) != 0
+				io.KeyAlt = (modifiers & ImGuiKeyModFlags_Alt) != 0
+				io.KeySuper = (modifiers & ImGuiKeyModFlags_Super) != 0
+				key_mods_changed |= modifiers_that_are_changing
+			}
+		case ImGuiInputEventType_Text:
+			// Trickling Rule: Stop processing queued events if keys/mouse have been interacted with
+			if trickle_fast_inputs && (key_changed || mouse_button_changed != 0 || mouse_moved || mouse_wheeled) {
+				break trickle
+			}
+			var c = e.Text.Char
+			if c > IM_UNICODE_CODEPOINT_MAX {
+				c = IM_UNICODE_CODEPOINT_INVALID
+			}
+			io.InputQueueCharacters = append(io.InputQueueCharacters, c)
+			text_inputed = true
+		case ImGuiInputEventType_Focus:
+			// We intentionally overwrite this and process lower, in order to give a chance
+			// to multi-viewports backends to queue AddFocusEvent(false) + AddFocusEvent(true) in same frame.
+			io.AppFocusLost = !e.AppFocused.Focused
+		case ImGuiInputEventType_Drop:
+			// Trickling Rule: Stop processing queued events if a mouse button changed or another drop was handled
+			if trickle_fast_inputs && (mouse_button_changed != 0 || g.DragDropExternPaths != nil) {
+				break trickle
+			}
+			g.DragDropExternPaths = e.Drop.Paths
+		default:
+			IM_ASSERT_USER_ERROR(false, "Unknown event!")
+		}
+	}
+
+	// Record trail (for domain-specific applications wanting to access a precise trail)
+	g.InputEventsTrail = append(g.InputEventsTrail, io.InputEventsQueue[:event_n]...)
+
+	// Remaining events will be processed on the next frame
+	io.InputEventsQueue = append(io.InputEventsQueue[:0], io.InputEventsQueue[event_n:]...)
+
+	// Clear buttons state when focus is lost
+	// (this is useful so e.g. releasing Alt after focus loss on Alt-Tab doesn't trigger the Alt menu toggle)
+	if io.AppFocusLost {
+		io.ClearInputKeys()
+		io.AppFocusLost = false
+	}
+}
@@ -0,0 +1,299 @@
+package imgui
+
+import "testing"
+
+func TestInputEventsTrickle(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	var clicks int
+	var chars []ImWchar
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.Begin("Window", nil, 0)
+			if ui.Button("Button") {
+				clicks++
+			}
+			ui.End()
+			chars = append(chars, io.InputQueueCharacters...)
+		})
+	}
+	frame()
+	frame()
+
+	// A click faster than a frame: button down and up are spread over two frames.
+	io.AddMousePosEvent(30, 45)
+	io.AddMouseButtonEvent(0, true)
+	io.AddMouseButtonEvent(0, false)
+	io.AddInputCharacters("ab")
+	frame()
+	if !io.MouseDown[0] || len(io.InputEventsQueue) != 3 {
+		t.Fatalf("after one frame: MouseDown = %v, %d events queued, want true and 3", io.MouseDown[0], len(io.InputEventsQueue))
+	}
+	if n := len(ctx.InputEventsTrail); n != 2 {
+		t.Errorf("InputEventsTrail has %d events, want 2", n)
+	}
+	frame()
+	frame()
+	if clicks != 1 {
+		t.Errorf("button clicked %d times, want 1", clicks)
+	}
+	if string(chars) != "ab" {
+		t.Errorf("characters = %q, want %q", string(chars), "ab")
+	}
+	if len(io.InputEventsQueue) != 0 {
+		t.Errorf("%d events left in the queue", len(io.InputEventsQueue))
+	}
+
+	// Without trickling the click is lost.
+	io.ConfigInputTrickleEventQueue = false
+	io.AddMouseButtonEvent(0, true)
+	io.AddMouseButtonEvent(0, false)
+	frame()
+	frame()
+	if clicks != 1 {
+		t.Errorf("button clicked %d times without trickling, want 1", clicks)
+	}
+
+	// Focus loss releases the keys.
+	io.AddKeyEvent(ImGuiKey_A, true)
+	frame()
+	io.AddFocusEvent(false)
+	frame()
+	if io.KeysData[ImGuiKey_A].Down {
+		t.Error("key still down after focus loss")
+	}
+}
+
+func TestNamedKeys(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+	var pressed, down, released bool
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			pressed = ui.IsKeyPressed(ImGuiKey_F1, false)
+			down = ui.IsKeyDown(ImGuiKey_F1)
+			released = ui.IsKeyReleased(ImGuiKey_F1)
+		})
+	}
+	io.AddKeyEvent(ImGuiKey_F1, true)
+	frame()
+	if !pressed || !down {
+		t.Errorf("F1 pressed %v down %v, want true true", pressed, down)
+	}
+	frame()
+	if pressed || !down {
+		t.Errorf("F1 pressed %v down %v on the next frame, want false true", pressed, down)
+	}
+	io.AddKeyEvent(ImGuiKey_F1, false)
+	frame()
+	if !released || down {
+		t.Errorf("F1 released %v down %v, want true false", released, down)
+	}
+
+	// Legacy backends fill io.KeysDown[] with native indices mapped by io.KeyMap[].
+	var legacy = newTestContext(nil)
+	defer DestroyContext(legacy)
+	legacy.IO.KeyMap[ImGuiKey_Tab] = 9
+	legacy.IO.KeysDown[9] = true
+	legacy.Frame(func(ui *ImGuiUI) {
+		if !ui.IsKeyDown(ImGuiKey_Tab) || !ui.IsKeyDown(9) {
+			t.Error("legacy key 9 is not down as ImGuiKey_Tab")
+		}
+		if name := ui.GetKeyName(9); name != "Tab" {
+			t.Errorf("GetKeyName(9) = %q, want %q", name, "Tab")
+		}
+	})
+}
+
+func TestShortcutRouting(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	var focus string
+	var fired []string
+	var autosave bool
+	var open_menu string
+	var menu_frames int
+	var frame = func() {
+		fired = fired[:0]
+		ctx.Frame(func(ui *ImGuiUI) {
+			var more func(name string)
+			more = func(name string) {
+				if ui.MenuItemShortcut("Open", ImGuiMod_Ctrl|ImGuiKey_O, nil, true) {
+					fired = append(fired, name+"/Open")
+				}
+				if ui.BeginMenu("More", true) { // Recursive menu
+					more(name + "/More")
+					ui.EndMenu()
+				}
+			}
+			for _, name := range []string{"A", "B"} {
+				if focus == name {
+					ui.SetNextWindowFocus()
+				}
+				ui.Begin(name, nil, ImGuiWindowFlags_MenuBar)
+				if ui.BeginMenuBar() {
+					if open_menu == name {
+						ui.OpenPopup("File", 0)
+						open_menu = ""
+					}
+					if ui.BeginMenu("File", true) {
+						menu_frames++
+						if ui.MenuItemShortcut("Save", ImGuiMod_Shortcut|ImGuiKey_S, nil, true) {
+							fired = append(fired, name+"/File/Save")
+						}
+						ui.MenuItemShortcut("Autosave", ImGuiMod_Ctrl|ImGuiMod_Shift|ImGuiKey_A, &autosave, name == "A")
+						if ui.BeginMenu("More", true) {
+							more(name + "/File/More")
+							ui.EndMenu()
+						}
+						ui.EndMenu()
+					}
+					ui.EndMenuBar()
+				}
+				if ui.Shortcut(ImGuiMod_Ctrl|ImGuiKey_R, 0) {
+					fired = append(fired, name)
+				}
+				if focus == name+"/Child" {
+					ui.SetNextWindowFocus()
+				}
+				ui.BeginChild("Child", ImVec2{100, 100}, false, 0)
+				if ui.Shortcut(ImGuiMod_Ctrl|ImGuiKey_R, 0) {
+					fired = append(fired, name+"/Child")
+				}
+				ui.EndChild()
+				ui.End()
+			}
+		})
+	}
+	var press = func(mods ImGuiKeyModFlags, key ImGuiKey) []string {
+		io.AddKeyModsEvent(mods)
+		io.AddKeyEvent(key, true)
+		frame()
+		var result = append([]string(nil), fired...)
+		io.AddKeyEvent(key, false)
+		io.AddKeyModsEvent(ImGuiKeyModFlags_None)
+		frame()
+		return result
+	}
+	var check = func(got []string, want string) {
+		t.Helper()
+		if len(got) != 1 || got[0] != want {
+			t.Errorf("with %s focused the shortcut fired in %v, want [%s]", focus, got, want)
+		}
+	}
+
+	// Routes are applied on the frame following their registration.
+	for _, test := range []struct{ focus, want string }{
+		{"A", "A"},
+		{"B", "B"},
+		{"B/Child", "B/Child"},
+		{"A/Child", "A/Child"},
+	} {
+		focus = test.focus
+		frame()
+		frame()
+		check(press(ImGuiKeyModFlags_Ctrl, ImGuiKey_R), test.want)
+	}
+
+	// The closed menus learned the shortcuts of their items on their first frame, and register them in their window.
+	menu_frames = 0
+	check(press(ImGuiKeyModFlags_Ctrl, ImGuiKey_S), "A/File/Save")
+	check(press(ImGuiKeyModFlags_Ctrl, ImGuiKey_O), "A/File/More/Open")
+	focus = "B"
+	frame()
+	check(press(ImGuiKeyModFlags_Ctrl, ImGuiKey_S), "B/File/Save")
+
+	// Autosave is disabled in B: its shortcut is only registered by the menu of A.
+	press(ImGuiKeyModFlags_Ctrl|ImGuiKeyModFlags_Shift, ImGuiKey_A)
+	if autosave {
+		t.Error("Ctrl+Shift+A toggled Autosave with B focused")
+	}
+	focus = "A"
+	frame()
+	press(ImGuiKeyModFlags_Ctrl|ImGuiKeyModFlags_Shift, ImGuiKey_A)
+	if !autosave {
+		t.Error("Ctrl+Shift+A did not toggle Autosave in A")
+	}
+	if menu_frames != 4 {
+		t.Errorf("the closed menus were entered on %d frames, want 4", menu_frames)
+	}
+	if len(ctx.OpenPopupStack) != 0 {
+		t.Errorf("%d menus were opened by their shortcuts", len(ctx.OpenPopupStack))
+	}
+
+	// The items of an open menu take their shortcut over the menu.
+	focus = ""
+	open_menu = "A"
+	frame()
+	frame()
+	if len(ctx.OpenPopupStack) != 1 {
+		t.Fatal("the menu of A did not open")
+	}
+	check(press(ImGuiKeyModFlags_Ctrl, ImGuiKey_S), "A/File/Save")
+	press(ImGuiKeyModFlags_Ctrl|ImGuiKeyModFlags_Shift, ImGuiKey_A)
+	if autosave {
+		t.Error("Ctrl+Shift+A did not toggle the Autosave menu item of A")
+	}
+	if name := GetKeyChordName(ImGuiMod_Ctrl | ImGuiMod_Shift | ImGuiKey_A); name != "Ctrl+Shift+A" {
+		t.Errorf("GetKeyChordName() = %q, want %q", name, "Ctrl+Shift+A")
+	}
+}
+
+func TestMenuShortcutsPassChildWindow(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	var entered, saved bool
+	var frame = func() {
+		entered = false
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowFocus()
+			ui.SetNextWindowSize(&ImVec2{300, 200}, ImGuiCond_Always)
+			ui.Begin("A", nil, ImGuiWindowFlags_MenuBar)
+			if ui.BeginMenuBar() {
+				if ui.BeginMenu("File", true) {
+					entered = true
+					if ui.MenuItemShortcut("Save", ImGuiMod_Ctrl|ImGuiKey_S, nil, true) {
+						saved = true
+					}
+					ui.BeginChild("Preview", ImVec2{100, 60}, true, 0)
+					ui.Text("Preview")
+					ui.EndChild()
+					ui.EndMenu()
+				}
+				ui.EndMenuBar()
+			}
+			ui.End()
+		})
+	}
+
+	// The closed menu is entered without its items on its first frame to learn their shortcuts.
+	frame()
+	if !entered {
+		t.Fatal("the closed menu was not entered on its first frame")
+	}
+	frame()
+	if entered {
+		t.Error("the closed menu was entered without its shortcut pressed")
+	}
+
+	// Child windows submitted by the closed menu stay hidden.
+	io.AddKeyModsEvent(ImGuiKeyModFlags_Ctrl)
+	io.AddKeyEvent(ImGuiKey_S, true)
+	frame()
+	if !entered || !saved {
+		t.Fatal("Ctrl+S did not trigger the item of the closed menu")
+	}
+	for _, window := range ctx.Windows {
+		if window.Flags&ImGuiWindowFlags_ChildWindow != 0 && window.Active && !window.Hidden {
+			t.Errorf("the child window %s of the closed menu is visible", window.Name)
+		}
+	}
+}
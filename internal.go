@@ -3,7 +3,6 @@ package imgui
 import (
 	"fmt"
 	"math"
-	"os"
 	"reflect"
 	"unsafe"
 )
@@ -45,8 +44,8 @@ type ImGuiErrorLogCallback func(user_data any, fmt string, args ...any)
 //   - DLL users: read comments above.
 var GImGui *ImGuiContext
 
-func IMGUI_DEBUG_LOG(format string, args ...any) {
-	fmt.Printf(fmt.Sprintf("[%05d] ", GImGui.FrameCount)+format, args...)
+func (g *ImGuiContext) IMGUI_DEBUG_LOG(format string, args ...any) {
+	fmt.Printf(fmt.Sprintf("[%05d] ", g.FrameCount)+format, args...)
 }
 
 func IM_ASSERT_USER_ERROR(x bool, msg string) {
@@ -80,11 +79,9 @@ func IM_ROUND(val float32) float { return (float)((int)(val + 0.5)) }
 
 func ImHashData(ptr unsafe.Pointer, data_size uintptr, seed ImU32) ImGuiID {
 	var crc = ^seed
-	var data = (*byte)(ptr)
 	var crc32_lut = &GCrc32LookupTable
-	for i := uintptr(0); i < data_size; i++ {
-		crc = (crc >> 8) ^ crc32_lut[(crc&0xFF)^uint(*data)]
-		data = (*byte)(unsafe.Pointer(uintptr(unsafe.Pointer(data)) + 1))
+	for _, c := range unsafe.Slice((*byte)(ptr), data_size) {
+		crc = (crc >> 8) ^ crc32_lut[(crc&0xFF)^uint(c)]
 	}
 	return ^crc
 }
@@ -151,37 +148,54 @@ func ImTextCharToUtf8(out_buf [5]char, c uint) []char {
 }
 
 func ImTextStrToUtf8(out_buf []byte, out_buf_size int, in_text []ImWchar, in_text_end []ImWchar) int {
-	var out_buf_offset int32 = 0
-	var final_buf_offset int32 = 0
-
-	for i := int32(0); i < out_buf_size && i < int32(len(in_text)); i++ {
-		c := in_text[i]
-		final_buf_offset = i + out_buf_offset
+	if in_text_end != nil {
+		in_text = in_text[:len(in_text)-len(in_text_end)]
+	}
+	out_buf_size = ImMinInt(out_buf_size, int(len(out_buf)))
+	var buf_p int = 0
+	for _, c := range in_text {
+		if buf_p >= out_buf_size-1 || c == 0 {
+			break
+		}
 		if c < 0x80 {
-			out_buf[final_buf_offset] = char(c)
+			out_buf[buf_p] = char(c)
+			buf_p++
 		} else {
-			out_buf_offset += ImTextCharToUtf8_inline(
-				out_buf[final_buf_offset:],
-				int32(len(out_buf))-final_buf_offset,
-				uint32(c),
-			)
+			var n = ImTextCharToUtf8_inline(out_buf[buf_p:], out_buf_size-buf_p-1, uint(c))
+			if n == 0 {
+				break
+			}
+			buf_p += n
 		}
 	}
-
-	return int(final_buf_offset)
+	if buf_p < out_buf_size {
+		out_buf[buf_p] = 0
+	}
+	return buf_p
 }
 
+// ImTextStrFromUtf8 decodes text into out_buf up to the first NUL, leaving room for a terminating zero.
+// Return the number of characters written, in_remaining receives the text that was not decoded.
 func ImTextStrFromUtf8(out_buf []ImWchar, out_buf_size int, text string, in_remaining *string) int {
-	var count int
-	for i, char := range text {
-		if count >= int(len(out_buf)) {
-			*in_remaining = text[i:]
-			return int(count)
+	out_buf_size = ImMinInt(out_buf_size, int(len(out_buf)))
+	var count int = 0
+	for len(text) > 0 && count < out_buf_size-1 {
+		var c rune
+		var n = ImTextCharFromUtf8(&c, text)
+		if c == 0 {
+			break
 		}
-		out_buf[i] = char
+		text = text[n:]
+		out_buf[count] = c
 		count++
 	}
-	return int(len(text))
+	if count < out_buf_size {
+		out_buf[count] = 0
+	}
+	if in_remaining != nil {
+		*in_remaining = text
+	}
+	return count
 }
 
 func ImTextCountCharsFromUtf8(in_text string) int {
@@ -205,38 +219,29 @@ func ImTextCountUtf8BytesFromChar(in_text, in_text_end []char) int {
 
 func ImTextCountUtf8BytesFromStr(in_text, in_text_end []ImWchar) int {
 	// return number of bytes to express string in UTF-8
+	if in_text_end != nil {
+		in_text = in_text[:len(in_text)-len(in_text_end)]
+	}
 	var bytes_count int = 0
 	for _, c := range in_text {
-		c_char := char(c)
-		if c_char < 0x80 {
+		switch {
+		case c == 0 && in_text_end == nil:
+			return bytes_count
+		case c < 0x80:
 			bytes_count++
-		} else {
-			bytes_count += ImTextCountUtf8BytesFromChar([]char{c_char}, nil)
+		case c < 0x800:
+			bytes_count += 2
+		case c < 0x10000:
+			bytes_count += 3
+		case c <= 0x10FFFF:
+			bytes_count += 4
+		default:
+			bytes_count += 3
 		}
 	}
 	return bytes_count
 }
 
-type ImFileHandle = *os.File
-
-func ImFileOpen(filename string, mode string) ImFileHandle { panic("not implemented") }
-func ImFileClose(file ImFileHandle) bool                   { panic("not implemented") }
-func ImFileGetSize(file ImFileHandle) ImU64                { panic("not implemented") }
-func ImFileRead(data []byte, size, count ImU64, file ImFileHandle) ImU64 {
-	panic("not implemented")
-}
-func ImFileWrite(data []byte, size, count ImU64, file ImFileHandle) ImU64 {
-	panic("not implemented")
-}
-
-// ImFileLoadToMemory Helper: Load file content into memory
-// Memory allocated with IM_ALLOC(), must be freed by user using IM_FREE() == ImGui::MemFree()
-// This can't really be used with "rt" because fseek size won't match read size.
-func ImFileLoadToMemory(filename, mode string, out_file_size *size_t, padding_bytes int) []byte {
-	b, _ := os.ReadFile(filename)
-	return b
-}
-
 func ImBitArrayTestBit(arr []ImU32, n int) bool {
 	var mask uint32 = 1 << (uint(n) & 31)
 	return (arr[n>>5] & mask) != 0
@@ -268,6 +273,28 @@ func ImBitArraySetBitRange(arr []ImU32, n, n2 int) {
 	}
 }
 
+// ImBitArrayForNamedKeys is a fixed size bit array with one bit per named key, indexed by ImGuiKey.
+type ImBitArrayForNamedKeys [(ImGuiKey_NamedKey_COUNT + 31) >> 5]ImU32
+
+func (this *ImBitArrayForNamedKeys) ClearAllBits() { *this = ImBitArrayForNamedKeys{} }
+func (this *ImBitArrayForNamedKeys) SetAllBits() {
+	for n := range this {
+		this[n] = ^ImU32(0)
+	}
+}
+func (this *ImBitArrayForNamedKeys) TestBit(key ImGuiKey) bool {
+	IM_ASSERT(IsNamedKey(key))
+	return ImBitArrayTestBit(this[:], int(key-ImGuiKey_NamedKey_BEGIN))
+}
+func (this *ImBitArrayForNamedKeys) SetBit(key ImGuiKey) {
+	IM_ASSERT(IsNamedKey(key))
+	ImBitArraySetBit(this[:], int(key-ImGuiKey_NamedKey_BEGIN))
+}
+func (this *ImBitArrayForNamedKeys) ClearBit(key ImGuiKey) {
+	IM_ASSERT(IsNamedKey(key))
+	ImBitArrayClearBit(this[:], int(key-ImGuiKey_NamedKey_BEGIN))
+}
+
 type ImBitVector []ImU32
 
 func (this ImBitVector) SetBitRange(n, n2 int) { // Works on range [n..n2)
@@ -542,8 +569,8 @@ func (s *ImGuiInputTextState) GetRedoAvailCount() int {
 	return int(STB_TEXTEDIT_UNDOSTATECOUNT - s.Stb.undostate.redo_point)
 }
 
-func (s *ImGuiInputTextState) OnKeyPressed(key int) {
-	stb_textedit_key(s, &s.Stb, STB_TEXTEDIT_KEYTYPE(key))
+func (s *ImGuiInputTextState) OnKeyPressed(g *ImGuiContext, key int) {
+	g.stb_textedit_key(s, &s.Stb, STB_TEXTEDIT_KEYTYPE(key))
 	s.CursorFollow = true
 	s.CursorAnimReset()
 }
@@ -612,6 +639,9 @@ type ImGuiNextWindowData struct {
 	SizeCallbackUserData any
 	BgAlphaVal           float
 	MenuBarOffsetMinVal  ImVec2
+	DockCond             ImGuiCond
+	DockId               ImGuiID
+	ViewportId           ImGuiID
 }
 
 func (d *ImGuiNextWindowData) ClearFlags() {
@@ -619,11 +649,12 @@ func (d *ImGuiNextWindowData) ClearFlags() {
 }
 
 type ImGuiNextItemData struct {
-	Flags        ImGuiNextItemDataFlags
-	Width        float     // Set by SetNextItemWidth()
-	FocusScopeId ImGuiID   // Set by SetNextItemMultiSelectData() (!= 0 signify value has been set, so it's an alternate version of HasSelectionData, we don't use Flags for this because they are cleared too early. This is mostly used for debugging)
-	OpenCond     ImGuiCond // Set by SetNextItemOpen()
-	OpenVal      bool      // Set by SetNextItemOpen()
+	Flags             ImGuiNextItemDataFlags
+	Width             float                  // Set by SetNextItemWidth()
+	SelectionUserData ImGuiSelectionUserData // Set by SetNextItemSelectionUserData() (0 is a valid value, ImGuiSelectionUserData_Invalid marks invalid values)
+	FocusScopeId      ImGuiID                // Set by SetNextItemSelectionUserData() (!= 0 signify value has been set, so it's an alternate version of HasSelectionUserData, we don't use Flags for this because they are cleared too early. This is mostly used for debugging)
+	OpenCond          ImGuiCond              // Set by SetNextItemOpen()
+	OpenVal           bool                   // Set by SetNextItemOpen()
 }
 
 func (d *ImGuiNextItemData) ClearFlags() {
@@ -639,6 +670,61 @@ type ImGuiLastItemData struct {
 	DisplayRect ImRect               // Display rectangle (only if ImGuiItemStatusFlags_HasDisplayRect is set)
 }
 
+type ImGuiInputEventMousePos struct{ PosX, PosY float }
+type ImGuiInputEventMouseWheel struct{ WheelX, WheelY float }
+type ImGuiInputEventMouseButton struct {
+	Button int
+	Down   bool
+}
+type ImGuiInputEventKey struct {
+	Key         ImGuiKey
+	Down        bool
+	AnalogValue float
+}
+type ImGuiInputEventKeyMods struct{ Mods ImGuiKeyModFlags }
+type ImGuiInputEventText struct{ Char rune }
+type ImGuiInputEventAppFocused struct{ Focused bool }
+type ImGuiInputEventDrop struct{ Paths []string }
+
+// ImGuiInputEvent is an input event queued by the io.AddXXXEvent() functions, processed by NewFrame().
+// Only the member matching Type is used.
+type ImGuiInputEvent struct {
+	Type        ImGuiInputEventType
+	Source      ImGuiInputSource
+	MousePos    ImGuiInputEventMousePos    // if Type == ImGuiInputEventType_MousePos
+	MouseWheel  ImGuiInputEventMouseWheel  // if Type == ImGuiInputEventType_MouseWheel
+	MouseButton ImGuiInputEventMouseButton // if Type == ImGuiInputEventType_MouseButton
+	Key         ImGuiInputEventKey         // if Type == ImGuiInputEventType_Key
+	KeyMods     ImGuiInputEventKeyMods     // if Type == ImGuiInputEventType_KeyMods
+	Text        ImGuiInputEventText        // if Type == ImGuiInputEventType_Text
+	AppFocused  ImGuiInputEventAppFocused  // if Type == ImGuiInputEventType_Focus
+	Drop        ImGuiInputEventDrop        // if Type == ImGuiInputEventType_Drop
+}
+
+// ImGuiKeyRoutingData Routing of a key chord: the owner allowed to read it on the current frame,
+// and the best candidate registered for the next frame by SetShortcutRouting().
+type ImGuiKeyRoutingData struct {
+	RoutingCurr      ImGuiID
+	RoutingNext      ImGuiID
+	RoutingNextScore ImU8 // Lower is better (0: perfect score)
+}
+
+// ImGuiMenuShortcuts Key chords of the enabled MenuItemShortcut() items of a menu, registered by BeginMenu() while the menu is closed.
+type ImGuiMenuShortcuts struct {
+	KeyChords          []ImGuiKeyChord
+	LastFrameSubmitted int
+	PassFrame          int           // Last frame the menu was submitted while closed, see ImGuiMenuShortcutsPass
+	PassKeyChord       ImGuiKeyChord // Pressed key chord not yet taken by its item during that pass
+}
+
+// ImGuiMenuShortcutsPass Closed menu submitted without its items, to find their shortcuts or to trigger the pressed one.
+type ImGuiMenuShortcutsPass struct {
+	Window             *ImGuiWindow // Window of the menu, its SkipItems is set during the pass
+	MenuIds            []ImGuiID    // Menus entered during the pass, the first one owns the shortcuts
+	SkipItemsBackup    bool
+	LastItemDataBackup ImGuiLastItemData
+}
+
 // ImGuiWindowStackData Data saved for each window pushed into the stack
 type ImGuiWindowStackData struct {
 	Window                   *ImGuiWindow
@@ -664,13 +750,14 @@ func ImGuiIndex(index int) ImGuiPtrOrIndex {
 }
 
 type ImGuiNavItemData struct {
-	Window       *ImGuiWindow // Init,Move    // Best candidate window (result->ItemWindow->RootWindowForNav == request->Window)
-	ID           ImGuiID      // Init,Move    // Best candidate item ID
-	FocusScopeId ImGuiID      // Init,Move    // Best candidate focus scope ID
-	RectRel      ImRect       // Init,Move    // Best candidate bounding box in window relative space
-	DistBox      float        //      Move    // Best candidate box distance to current NavId
-	DistCenter   float        //      Move    // Best candidate center distance to current NavId
-	DistAxial    float        //      Move    // Best candidate axial distance to current NavId
+	Window       *ImGuiWindow   // Init,Move    // Best candidate window (result->ItemWindow->RootWindowForNav == request->Window)
+	ID           ImGuiID        // Init,Move    // Best candidate item ID
+	FocusScopeId ImGuiID        // Init,Move    // Best candidate focus scope ID
+	RectRel      ImRect         // Init,Move    // Best candidate bounding box in window relative space
+	InFlags      ImGuiItemFlags // Init,Move    // Best candidate item flags
+	DistBox      float          //      Move    // Best candidate box distance to current NavId
+	DistCenter   float          //      Move    // Best candidate center distance to current NavId
+	DistAxial    float          //      Move    // Best candidate axial distance to current NavId
 }
 
 func NewImGuiNavItemData() ImGuiNavItemData {
@@ -713,13 +800,24 @@ type ImGuiOldColumns struct {
 	Splitter                 ImDrawListSplitter
 }
 
+const IMGUI_VIEWPORT_DEFAULT_ID ImGuiID = 0x11111111 // Main viewport ID (secondary viewports use the ID of the window owning them)
+
 // ImGuiViewportP ImGuiViewport Private/Internals fields (cardinal sin: we are using inheritance!)
 // Every instance of ImGuiViewport is in fact a ImGuiViewportP.
 type ImGuiViewportP = ImGuiViewport
 
 func NewImGuiViewportP() ImGuiViewportP {
 	return ImGuiViewportP{
-		DrawListsLastFrame: [2]int{-1, -1},
+		Idx:                     -1,
+		LastFrameActive:         -1,
+		LastFrontMostStampCount: -1,
+		DrawListsLastFrame:      [2]int{-1, -1},
+		Alpha:                   1.0,
+		LastAlpha:               1.0,
+		DpiScale:                1.0,
+		LastPlatformPos:         ImVec2{FLT_MAX, FLT_MAX},
+		LastPlatformSize:        ImVec2{FLT_MAX, FLT_MAX},
+		LastRendererSize:        ImVec2{FLT_MAX, FLT_MAX},
 	}
 }
 
@@ -758,7 +856,9 @@ type ImGuiWindowSettings struct {
 	Pos       ImVec2ih
 	Size      ImVec2ih
 	Collapsed bool
-	WantApply bool // Set when loaded from .ini data (to enable merging/loading .ini data into an already running context)
+	DockId    ImGuiID // ID of last known DockNode (even if the DockNode is invisible because it has only 1 active window), or 0 if none.
+	DockOrder short   // Order of the last time the window was visible within its DockNode. This is used to reorder windows that are reappearing on the same frame. -1 if none.
+	WantApply bool    // Set when loaded from .ini data (to enable merging/loading .ini data into an already running context)
 	name      string
 }
 
@@ -769,12 +869,13 @@ func (s *ImGuiWindowSettings) GetName() string {
 type ImGuiSettingsHandler struct {
 	TypeName   string // Short description stored in .ini file. Disallowed characters: '[' ']'
 	TypeHash   ImGuiID
-	ClearAllFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler)                           // Clear all settings data
-	ReadInitFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler)                           // Read: Called before reading (in registration order)
-	ReadOpenFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler, name string) any          // Read: Called when entering into a new ini entry e.g. "[Window][Name]"
-	ReadLineFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler, entry any, line string)   // Read: Called for every line of text within an ini entry
-	ApplyAllFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler)                           // Read: Called after reading (in registration order)
-	WriteAllFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler, out_buf *ImGuiTextBuffer) // Write: Output every entries into 'out_buf'
+	ClearAllFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler)                                                   // Clear all settings data
+	ReadInitFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler)                                                   // Read: Called before reading (in registration order)
+	ReadOpenFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler, name string) any                                  // Read: Called when entering into a new ini entry e.g. "[Window][Name]"
+	ReadLineFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler, entry any, line string)                           // Read: Called for every line of text within an ini entry
+	ApplyAllFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler)                                                   // Read: Called after reading (in registration order)
+	WriteAllFn func(ctx *ImGuiContext, handler *ImGuiSettingsHandler, out_buf *ImGuiTextBuffer)                         // Write: Output every entries into 'out_buf'
+	MigrateFn  func(ctx *ImGuiContext, handler *ImGuiSettingsHandler, entry *ImGuiSettingsEntry, from_version int) bool // Read: Update an entry saved with an older io.SettingsVersion before it is read, return false to discard it (optional)
 	UserData   any
 }
 
@@ -807,8 +908,7 @@ type ImGuiStackSizes struct {
 	SizeOfBeginPopupStack short
 }
 
-func (s *ImGuiStackSizes) SetToCurrentState() {
-	var g = GImGui
+func (s *ImGuiStackSizes) SetToCurrentState(g *ImGuiContext) {
 	var window = g.CurrentWindow
 	s.SizeOfIDStack = (short)(len(window.IDStack))
 	s.SizeOfColorStack = (short)(len(g.ColorStack))
@@ -819,8 +919,7 @@ func (s *ImGuiStackSizes) SetToCurrentState() {
 	s.SizeOfBeginPopupStack = (short)(len(g.BeginPopupStack))
 }
 
-func (s *ImGuiStackSizes) CompareWithCurrentState() {
-	var g = GImGui
+func (s *ImGuiStackSizes) CompareWithCurrentState(g *ImGuiContext) {
 	var window = g.CurrentWindow
 
 	// Window stacks
@@ -839,6 +938,13 @@ func (s *ImGuiStackSizes) CompareWithCurrentState() {
 
 type ImGuiContextHookCallback func(ctx *ImGuiContext, hook *ImGuiContextHook)
 
+// ImGuiTestEngineHooks is implemented by automation/test engines which want to be notified of every submitted item.
+// Set ImGuiContext.TestEngine and ImGuiContext.TestEngineHookItems to enable the hooks.
+type ImGuiTestEngineHooks interface {
+	ItemAdd(ctx *ImGuiContext, bb *ImRect, id ImGuiID)                                // Called by ItemAdd(), including for clipped items
+	ItemInfo(ctx *ImGuiContext, id ImGuiID, label string, flags ImGuiItemStatusFlags) // Called by widgets after ItemAdd() to provide their label and status
+}
+
 type ImGuiContextHook struct {
 	HookId   ImGuiID // A unique ID assigned by AddContextHook()
 	Type     ImGuiContextHookType
@@ -895,6 +1001,7 @@ type ImGuiWindowTempData struct {
 }
 
 type ImGuiWindow struct {
+	Ctx                 *ImGuiContext    // Parent UI context (needs to be set explicitly by parent).
 	Name                string           // Window name, owned by the window.
 	ID                  ImGuiID          // == ImHashStr(Name)
 	Flags               ImGuiWindowFlags // See enum ImGuiWindowFlags_
@@ -984,11 +1091,28 @@ type ImGuiWindow struct {
 	MemoryDrawListIdxCapacity int // Backup of last idx/vtx count, so when waking up the window we can preallocate and avoid iterative alloc/copy
 	MemoryDrawListVtxCapacity int
 	MemoryCompacted           bool // Set when window extraneous data have been garbage collected
+
+	// Viewports
+	Viewport            *ImGuiViewportP // Always set in Begin(). Inherited from parent window if needed, or the window owns its viewport.
+	ViewportOwned       bool            // Set when the window owns its viewport (Viewport.Window == window)
+	ViewportAllowExtend bool            // Window may protrude outside of its host viewport this frame (e.g. while being moved), a viewport is then created for it
+
+	// Docking
+	DockNode                *ImGuiDockNode // Which node are we docked into. Important: Prefer testing DockIsActive in many cases as this will still be set when the dock node is hidden.
+	DockNodeAsHost          *ImGuiDockNode // Which node are we owning (for parent windows)
+	DockId                  ImGuiID        // Backup of last valid DockNode.ID, so single window remember their dock node id even when they are not bound any more
+	DockOrder               short          // Order of the last time the window was visible within its DockNode. This is used to reorder windows that are reappearing on the same frame.
+	DockIsActive            bool           // When docking artifacts are actually visible. When this is set, DockNode is guaranteed to be != nil.
+	DockTabIsVisible        bool           // Is our window visible this frame? ~~ is the corresponding tab selected?
+	TabId                   ImGuiID        // == ImHashStr(Name), the ID of our tab in the dock node tab bar
+	RootWindowDockTree      *ImGuiWindow   // Point to ourself or first ancestor that is not a child window. Cross through dock nodes.
+	SetWindowDockAllowFlags ImGuiCond      // store acceptable condition flags for SetNextWindowDockID() use.
 }
 
 func NewImGuiWindow(context *ImGuiContext, name string) *ImGuiWindow {
 	var id = ImHashStr(name, 0, 0)
 	var window = ImGuiWindow{
+		Ctx:                          context,
 		Name:                         name,
 		ID:                           id,
 		IDStack:                      []ImGuiID{id},
@@ -1006,6 +1130,9 @@ func NewImGuiWindow(context *ImGuiContext, name string) *ImGuiWindow {
 		LastTimeActive:               -1.0,
 		FontWindowScale:              1.0,
 		SettingsOffset:               -1,
+		DockOrder:                    -1,
+		TabId:                        ImHashStr(name, uintptr(len(name)), 0),
+		SetWindowDockAllowFlags:      ImGuiCond_Always | ImGuiCond_Once | ImGuiCond_FirstUseEver | ImGuiCond_Appearing,
 	}
 	window.MoveId = window.GetIDs("#MOVE")
 	window.DrawList = &window.DrawListInst
@@ -1017,7 +1144,7 @@ func NewImGuiWindow(context *ImGuiContext, name string) *ImGuiWindow {
 func (w *ImGuiWindow) GetIDs(str string) ImGuiID {
 	var seed = w.IDStack[len(w.IDStack)-1]
 	var id = ImHashStr(str, 0, seed)
-	KeepAliveID(id)
+	w.Ctx.KeepAliveID(id)
 	return id
 }
 
@@ -1036,14 +1163,14 @@ func (w *ImGuiWindow) GetIDInterface(ptr any) ImGuiID {
 
 	var seed = w.IDStack[len(w.IDStack)-1]
 	var id = ImHashData(unsafe.Pointer(rvalue.UnsafeAddr()), rvalue.Type().Size(), seed)
-	KeepAliveID(id)
+	w.Ctx.KeepAliveID(id)
 	return id
 }
 
 func (w *ImGuiWindow) GetIDInt(n int) ImGuiID {
 	var seed = w.IDStack[len(w.IDStack)-1]
 	var id = ImHashData(unsafe.Pointer(&n), unsafe.Sizeof(n), seed)
-	KeepAliveID(id)
+	w.Ctx.KeepAliveID(id)
 	return id
 }
 
@@ -1071,7 +1198,7 @@ func (w *ImGuiWindow) GetIDFromRectangle(r_abs ImRect) ImGuiID {
 	var seed = w.IDStack[len(w.IDStack)-1]
 	var r_rel = [4]int{(int)(r_abs.Min.x - w.Pos.x), (int)(r_abs.Min.y - w.Pos.y), (int)(r_abs.Max.x - w.Pos.x), (int)(r_abs.Max.y - w.Pos.y)}
 	var id = ImHashData(unsafe.Pointer(&r_rel), unsafe.Sizeof(r_rel), seed)
-	KeepAliveID(id)
+	w.Ctx.KeepAliveID(id)
 	return id
 }
 
@@ -1080,7 +1207,7 @@ func (w *ImGuiWindow) Rect() ImRect {
 }
 
 func (w *ImGuiWindow) CalcFontSize() float {
-	var g = GImGui
+	var g = w.Ctx
 	var scale = g.FontBaseSize * w.FontWindowScale
 	if w.ParentWindow != nil {
 		scale *= w.ParentWindow.FontWindowScale
@@ -1090,7 +1217,7 @@ func (w *ImGuiWindow) CalcFontSize() float {
 }
 
 func (w *ImGuiWindow) TitleBarHeight() float {
-	var g = GImGui
+	var g = w.Ctx
 	if w.Flags&ImGuiWindowFlags_NoTitleBar != 0 {
 		return 0.0
 	}
@@ -1102,7 +1229,7 @@ func (w *ImGuiWindow) TitleBarRect() ImRect {
 }
 
 func (w *ImGuiWindow) MenuBarHeight() float {
-	var g = GImGui
+	var g = w.Ctx
 	if w.Flags&ImGuiWindowFlags_MenuBar != 0 {
 		return w.DC.MenuBarOffset.y + w.CalcFontSize() + g.Style.FramePadding.y*2.0
 	}
@@ -1114,6 +1241,81 @@ func (w *ImGuiWindow) MenuBarRect() ImRect {
 	return ImRect{ImVec2{w.Pos.x, y1}, ImVec2{w.Pos.x + w.SizeFull.x, y1 + w.MenuBarHeight()}}
 }
 
+// ImGuiBoxSelectState Current box-selection, started in BeginMultiSelect() and carried over frames while the mouse is held.
+type ImGuiBoxSelectState struct {
+	// Active box-selection data (persistent, 1 active at a time)
+	ID                    ImGuiID
+	IsActive              bool
+	IsStarting            bool
+	IsStartedFromVoid     bool // Starting click was not from an item.
+	IsStartedSetNavIdOnce bool
+	RequestClear          bool
+	KeyMods               ImGuiKeyModFlags // Latched key-mods for box-select logic.
+	StartPosRel           ImVec2           // Start position in window-contents relative space (to support scrolling)
+	EndPosRel             ImVec2           // End position in window-contents relative space
+	ScrollAccum           ImVec2           // Scrolling accumulator (to behave at high-frame spaces)
+	Window                *ImGuiWindow
+
+	// Temporary/transient data
+	UnclipMode        bool   // (Temp/Transient, here in hot area). Set/cleared by the BeginMultiSelect()/EndMultiSelect() owning active box-select.
+	UnclipRect        ImRect // Rectangle where ItemAdd() clipping may be temporarily disabled. Need support by multi-select supporting widgets.
+	BoxSelectRectPrev ImRect // Selection rectangle in absolute coordinates (derived every frame from BoxSelectStartPosRel and MousePos)
+	BoxSelectRectCurr ImRect
+}
+
+// ImGuiMultiSelectTempData Temporary storage for multi-select, only needed between BeginMultiSelect() and EndMultiSelect() (1 per level of stacked multi-select).
+type ImGuiMultiSelectTempData struct {
+	IO                 ImGuiMultiSelectIO // MUST BE FIRST FIELD. Requests are set and returned by BeginMultiSelect()/EndMultiSelect() + written to by user during the loop.
+	Storage            *ImGuiMultiSelectState
+	FocusScopeId       ImGuiID // Copied from g.CurrentFocusScopeId (unless another selection scope was pushed manually)
+	Flags              ImGuiMultiSelectFlags
+	ScopeRectMin       ImVec2
+	BackupCursorMaxPos ImVec2
+	LastSubmittedItem  ImGuiSelectionUserData // Copy of last submitted item data, used to merge output ranges.
+	BoxSelectId        ImGuiID
+	KeyMods            ImGuiKeyModFlags
+	LoopRequestSetAll  ImS8 // -1: no operation, 0: clear all, 1: select all.
+	IsEndIO            bool // Set when switching IO from BeginMultiSelect() to EndMultiSelect() state.
+	IsFocused          bool // Set if currently focusing the selection scope (any item of the selection). May be used if you have custom shortcut associated to selection.
+	IsKeyboardSetRange bool // Set by BeginMultiSelect() when using Shift+Navigation. Because scrolling may be affected we can't afford a frame of lag with Shift+Navigation.
+	NavIdPassedBy      bool
+	RangeSrcPassedBy   bool // Set by the item that matches RangeSrcItem.
+	RangeDstPassedBy   bool // Set by the item that matches NavJustMovedToId when IsSetRange is set.
+}
+
+func (d *ImGuiMultiSelectTempData) Clear() {
+	*d = ImGuiMultiSelectTempData{IO: ImGuiMultiSelectIO{Requests: d.IO.Requests[:0]}}
+}
+
+func (d *ImGuiMultiSelectTempData) ClearIO() {
+	d.IO.Requests = d.IO.Requests[:0]
+	d.IO.RangeSrcItem = ImGuiSelectionUserData_Invalid
+	d.IO.NavIdItem = ImGuiSelectionUserData_Invalid
+	d.IO.NavIdSelected = false
+	d.IO.RangeSrcReset = false
+}
+
+// ImGuiMultiSelectState Persistent storage for multi-select (as long as selection is alive)
+type ImGuiMultiSelectState struct {
+	Window            *ImGuiWindow
+	ID                ImGuiID
+	LastFrameActive   int  // Last used frame-count, for GC.
+	LastSelectionSize int  // Set by BeginMultiSelect() based on optional info provided by user. May be -1 if unknown.
+	RangeSelected     ImS8 // -1 (don't have) or true/false
+	NavIdSelected     ImS8 // -1 (don't have) or true/false
+	RangeSrcItem      ImGuiSelectionUserData
+	NavIdItem         ImGuiSelectionUserData // SetNextItemSelectionUserData() value for NavId (if part of submitted items)
+}
+
+func NewImGuiMultiSelectState() ImGuiMultiSelectState {
+	return ImGuiMultiSelectState{
+		RangeSelected: -1,
+		NavIdSelected: -1,
+		RangeSrcItem:  ImGuiSelectionUserData_Invalid,
+		NavIdItem:     ImGuiSelectionUserData_Invalid,
+	}
+}
+
 var IM_COL32_DISABLE = IM_COL32(0, 0, 0, 1) // Special sentinel code which cannot be used as a regular color.
 
 const IMGUI_TABLE_MAX_COLUMNS = 64               // sizeof(ImU64) * 8. This is solely because we frequently encode columns set in a ImU64.
@@ -1345,7 +1547,10 @@ type ImGuiTableColumnSettings struct {
 
 func NewImGuiTableColumnSettings() ImGuiTableColumnSettings {
 	return ImGuiTableColumnSettings{
-		Index: -1,
+		Index:        -1,
+		DisplayOrder: -1,
+		SortOrder:    -1,
+		IsEnabled:    1,
 	}
 }
 
@@ -2,12 +2,13 @@ package imgui
 
 // Fonts, drawing
 
-func getForegroundDrawList(window *ImGuiWindow) *ImDrawList { return GetForegroundDrawList(nil) } // This seemingly unnecessary wrapper simplifies compatibility between the 'master' and 'docking' branches.
-func getBackgroundDrawList(t *ImGuiViewport) *ImDrawList    { panic("not implemented") }          // get background draw list for the given viewport. this draw list will be the first rendering one. Useful to quickly draw shapes/text behind dear imgui contents.
+// This seemingly unnecessary wrapper simplifies compatibility between the 'master' and 'docking' branches.
+func (g *ImGuiContext) getForegroundDrawList(window *ImGuiWindow) *ImDrawList {
+	return g.GetForegroundDrawList(window.Viewport)
+}
 
-func getForegroundDrawListViewport(viewport *ImGuiViewport, drawlist_no int, drawlist_name string) *ImDrawList {
+func (g *ImGuiContext) getForegroundDrawListViewport(viewport *ImGuiViewport, drawlist_no int, drawlist_name string) *ImDrawList {
 	// Create the draw list on demand, because they are not frequently used for all viewports
-	var g = GImGui
 	IM_ASSERT(drawlist_no < int(len(viewport.DrawLists)))
 	var draw_list = viewport.DrawLists[drawlist_no]
 	if draw_list == nil {
@@ -28,8 +29,8 @@ func getForegroundDrawListViewport(viewport *ImGuiViewport, drawlist_no int, dra
 }
 
 // GetForegroundDrawListViewport get foreground draw list for the given viewport. this draw list will be the last rendered one. Useful to quickly draw shapes/text over dear imgui contents.
-func GetForegroundDrawListViewport(viewport *ImGuiViewport) *ImDrawList {
-	return getForegroundDrawListViewport(viewport, 1, "###Foreground")
+func (g *ImGuiContext) GetForegroundDrawListViewport(viewport *ImGuiViewport) *ImDrawList {
+	return g.getForegroundDrawListViewport(viewport, 1, "###Foreground")
 }
 
 // CallContextHooks Call context hooks (used by e.g. test engine)
@@ -43,18 +44,30 @@ func CallContextHooks(ctx *ImGuiContext, hook_type ImGuiContextHookType) {
 	}
 }
 
+// IMGUI_TEST_ENGINE_ITEM_ADD notifies the test engine, if any, of an item being submitted
+func (g *ImGuiContext) IMGUI_TEST_ENGINE_ITEM_ADD(bb *ImRect, id ImGuiID) {
+	if g.TestEngineHookItems && g.TestEngine != nil {
+		g.TestEngine.ItemAdd(g, bb, id)
+	}
+}
+
+// IMGUI_TEST_ENGINE_ITEM_INFO notifies the test engine, if any, of the label and status of the last submitted item
+func (g *ImGuiContext) IMGUI_TEST_ENGINE_ITEM_INFO(id ImGuiID, label string, flags ImGuiItemStatusFlags) {
+	if g.TestEngineHookItems && g.TestEngine != nil {
+		g.TestEngine.ItemInfo(g, id, label, flags)
+	}
+}
+
 // GetItemID Basic Accessors
-func GetItemID() ImGuiID { var g = GImGui; return g.LastItemData.ID } // Get ID of last item (~~ often same ImGui::GetID(label) beforehand)
-func GetItemStatusFlags() ImGuiItemStatusFlags {
-	var g = GImGui
+func (g *ImGuiContext) GetItemID() ImGuiID { return g.LastItemData.ID } // Get ID of last item (~~ often same ImGui::GetID(label) beforehand)
+func (g *ImGuiContext) GetItemStatusFlags() ImGuiItemStatusFlags {
 	return g.LastItemData.StatusFlags
 }
-func GetItemFlags() ImGuiItemFlags { var g = GImGui; return g.LastItemData.InFlags }
-func GetActiveID() ImGuiID         { var g = GImGui; return g.ActiveId }
-func GetFocusID() ImGuiID          { var g = GImGui; return g.NavId }
+func (g *ImGuiContext) GetItemFlags() ImGuiItemFlags { return g.LastItemData.InFlags }
+func (g *ImGuiContext) GetActiveID() ImGuiID         { return g.ActiveId }
+func (g *ImGuiContext) GetFocusID() ImGuiID          { return g.NavId }
 
-func SetActiveID(id ImGuiID, window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) SetActiveID(id ImGuiID, window *ImGuiWindow) {
 	g.ActiveIdIsJustActivated = g.ActiveId != id
 	if g.ActiveIdIsJustActivated {
 		g.ActiveIdTimer = 0.0
@@ -85,11 +98,10 @@ func SetActiveID(id ImGuiID, window *ImGuiWindow) {
 	g.ActiveIdUsingMouseWheel = false
 	g.ActiveIdUsingNavDirMask = 0x00
 	g.ActiveIdUsingNavInputMask = 0x00
-	g.ActiveIdUsingKeyInputMask = 0x00
+	g.ActiveIdUsingKeyInputMask.ClearAllBits()
 }
 
-func SetFocusID(id ImGuiID, window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) SetFocusID(id ImGuiID, window *ImGuiWindow) {
 	IM_ASSERT(id != 0)
 
 	// Assume that SetFocusID() is called in the context where its window.DC.NavLayerCurrent and window.DC.NavFocusScopeIdCurrent are valid.
@@ -114,20 +126,18 @@ func SetFocusID(id ImGuiID, window *ImGuiWindow) {
 	}
 }
 
-func ClearActiveID() {
-	SetActiveID(0, nil) // g.ActiveId = 0
+func (g *ImGuiContext) ClearActiveID() {
+	g.SetActiveID(0, nil) // g.ActiveId = 0
 }
 
-func GetHoveredID() ImGuiID {
-	var g = GImGui
+func (g *ImGuiContext) GetHoveredID() ImGuiID {
 	if g.HoveredId != 0 {
 		return g.HoveredId
 	}
 	return g.HoveredIdPreviousFrame
 }
 
-func SetHoveredID(id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) SetHoveredID(id ImGuiID) {
 	g.HoveredId = id
 	g.HoveredIdAllowOverlap = false
 	g.HoveredIdUsingMouseWheel = false
@@ -137,8 +147,7 @@ func SetHoveredID(id ImGuiID) {
 	}
 }
 
-func KeepAliveID(id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) KeepAliveID(id ImGuiID) {
 	if g.ActiveId == id {
 		g.ActiveIdIsAlive = id
 	}
@@ -148,11 +157,10 @@ func KeepAliveID(id ImGuiID) {
 }
 
 // MarkItemEdited Mark data associated to given item as "edited", used by IsItemDeactivatedAfterEdit() function.
-func MarkItemEdited(id ImGuiID) {
+func (g *ImGuiContext) MarkItemEdited(id ImGuiID) {
 	// This marking is solely to be able to provide info for IsItemDeactivatedAfterEdit().
 	// ActiveId might have been released by the time we call this (as in the typical press/release button behavior) but still need need to fill the data.
-	var g = GImGui
-	IM_ASSERT(g.ActiveId == id || g.ActiveId == 0 || g.DragDropActive)
+	IM_ASSERT(g.ActiveId == id || g.ActiveId == 0 || g.DragDropActive || (g.CurrentMultiSelect != nil && g.BoxSelectState.IsActive))
 
 	//IM_ASSERT(g.CurrentWindow.DC.LastItemId == id);
 	g.ActiveIdHasBeenEditedThisFrame = true
@@ -161,8 +169,7 @@ func MarkItemEdited(id ImGuiID) {
 }
 
 // PushItemFlag Parameter stacks
-func PushItemFlag(option ImGuiItemFlags, enabled bool) {
-	var g = GImGui
+func (g *ImGuiContext) PushItemFlag(option ImGuiItemFlags, enabled bool) {
 	var item_flags = g.CurrentItemFlags
 	IM_ASSERT(item_flags == g.ItemFlagsStack[len(g.ItemFlagsStack)-1])
 	if enabled {
@@ -174,8 +181,7 @@ func PushItemFlag(option ImGuiItemFlags, enabled bool) {
 	g.ItemFlagsStack = append(g.ItemFlagsStack, item_flags)
 }
 
-func PopItemFlag() {
-	var g = GImGui
+func (g *ImGuiContext) PopItemFlag() {
 	IM_ASSERT(len(g.ItemFlagsStack) > 1) // Too many calls to PopItemFlag() - we always leave a 0 at the bottom of the stack.
 	g.ItemFlagsStack = g.ItemFlagsStack[:len(g.ItemFlagsStack)-1]
 	g.CurrentItemFlags = g.ItemFlagsStack[len(g.ItemFlagsStack)-1]
@@ -208,56 +214,46 @@ func CalcTypematicRepeatAmount(t0, t1, repeat_delay, repeat_rate float) int {
 	return count
 }
 
-func SetActiveIdUsingNavAndKeys() {
-	var g = GImGui
+func (g *ImGuiContext) SetActiveIdUsingNavAndKeys() {
 	IM_ASSERT(g.ActiveId != 0)
 	g.ActiveIdUsingNavDirMask = ^(ImU32)(0)
 	g.ActiveIdUsingNavInputMask = ^(ImU32)(0)
-	g.ActiveIdUsingKeyInputMask = ^(ImU64)(0)
-	NavMoveRequestCancel()
+	g.ActiveIdUsingKeyInputMask.SetAllBits()
+	g.NavMoveRequestCancel()
 }
 
-func IsActiveIdUsingNavDir(dir ImGuiDir) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsActiveIdUsingNavDir(dir ImGuiDir) bool {
 	return (g.ActiveIdUsingNavDirMask & (1 << dir)) != 0
 }
-func IsActiveIdUsingNavInput(input ImGuiNavInput) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsActiveIdUsingNavInput(input ImGuiNavInput) bool {
 	return (g.ActiveIdUsingNavInputMask & (1 << input)) != 0
 }
-func IsActiveIdUsingKey(key ImGuiKey) bool {
-	var g = GImGui
-	IM_ASSERT(key < 64)
-	return (g.ActiveIdUsingKeyInputMask & ((ImU64)(1) << key)) != 0
+func (g *ImGuiContext) IsActiveIdUsingKey(key ImGuiKey) bool {
+	return g.ActiveIdUsingKeyInputMask.TestBit(key)
 }
 
-func IsKeyPressedMap(key ImGuiKey, repeat bool /*= true*/) bool {
-	var g = GImGui
-	var key_index = g.IO.KeyMap[key]
-	if key_index >= 0 {
-		return IsKeyPressed(key_index, repeat)
-	}
-	return false
+func (g *ImGuiContext) IsKeyPressedMap(key ImGuiKey, repeat bool /*= true*/) bool {
+	IM_ASSERT(IsNamedKey(key))
+	return g.IsKeyPressed(key, repeat)
 }
-func IsNavInputDown(n ImGuiNavInput) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsNavInputDown(n ImGuiNavInput) bool {
 	return g.IO.NavInputs[n] > 0.0
 }
-func IsNavInputTest(n ImGuiNavInput, rm ImGuiInputReadMode) bool {
-	return GetNavInputAmount(n, rm) > 0.0
+func (g *ImGuiContext) IsNavInputTest(n ImGuiNavInput, rm ImGuiInputReadMode) bool {
+	return g.GetNavInputAmount(n, rm) > 0.0
 }
 
 // RenderColorRectWithAlphaCheckerboard Helper for ColorPicker4()
 // NB: This is rather brittle and will show artifact when rounding this enabled if rounded corners overlap multiple cells. Caller currently responsible for avoiding that.
 // Spent a non reasonable amount of time trying to getting this right for ColorButton with rounding+anti-aliasing+ImGuiColorEditFlags_HalfAlphaPreview flag + various grid sizes and offsets, and eventually gave up... probably more reasonable to disable rounding altogether.
 // FIXME: uses ImGui::GetColorU32
-func RenderColorRectWithAlphaCheckerboard(draw_list *ImDrawList, p_min ImVec2, p_max ImVec2, col ImU32, grid_step float, grid_off ImVec2, rounding float, flags ImDrawFlags) {
+func (g *ImGuiContext) RenderColorRectWithAlphaCheckerboard(draw_list *ImDrawList, p_min ImVec2, p_max ImVec2, col ImU32, grid_step float, grid_off ImVec2, rounding float, flags ImDrawFlags) {
 	if (flags & ImDrawFlags_RoundCornersMask_) == 0 {
 		flags = ImDrawFlags_RoundCornersDefault_
 	}
 	if ((col & IM_COL32_A_MASK) >> IM_COL32_A_SHIFT) < 0xFF {
-		var col_bg1 = GetColorU32FromInt(ImAlphaBlendColors(IM_COL32(204, 204, 204, 255), col))
-		var col_bg2 = GetColorU32FromInt(ImAlphaBlendColors(IM_COL32(128, 128, 128, 255), col))
+		var col_bg1 = g.GetColorU32FromInt(ImAlphaBlendColors(IM_COL32(204, 204, 204, 255), col))
+		var col_bg2 = g.GetColorU32FromInt(ImAlphaBlendColors(IM_COL32(128, 128, 128, 255), col))
 		draw_list.AddRectFilled(p_min, p_max, col_bg1, rounding, flags)
 
 		var yi int = 0
@@ -306,8 +302,7 @@ func RenderColorRectWithAlphaCheckerboard(draw_list *ImDrawList, p_min ImVec2, p
 }
 
 // RenderNavHighlight Navigation highlight
-func RenderNavHighlight(bb *ImRect, id ImGuiID, flags ImGuiNavHighlightFlags) {
-	var g = GImGui
+func (g *ImGuiContext) RenderNavHighlight(bb *ImRect, id ImGuiID, flags ImGuiNavHighlightFlags) {
 	if id != g.NavId {
 		return
 	}
@@ -334,13 +329,13 @@ func RenderNavHighlight(bb *ImRect, id ImGuiID, flags ImGuiNavHighlightFlags) {
 		if !fully_visible {
 			window.DrawList.PushClipRect(display_rect.Min, display_rect.Max, false)
 		}
-		window.DrawList.AddRect(display_rect.Min.Add(ImVec2{THICKNESS * 0.5, THICKNESS * 0.5}), display_rect.Max.Sub(ImVec2{THICKNESS * 0.5, THICKNESS * 0.5}), GetColorU32FromID(ImGuiCol_NavHighlight, 1), rounding, 0, THICKNESS)
+		window.DrawList.AddRect(display_rect.Min.Add(ImVec2{THICKNESS * 0.5, THICKNESS * 0.5}), display_rect.Max.Sub(ImVec2{THICKNESS * 0.5, THICKNESS * 0.5}), g.GetColorU32FromID(ImGuiCol_NavHighlight, 1), rounding, 0, THICKNESS)
 		if !fully_visible {
 			window.DrawList.PopClipRect()
 		}
 	}
 	if flags&ImGuiNavHighlightFlags_TypeThin != 0 {
-		window.DrawList.AddRect(display_rect.Min, display_rect.Max, GetColorU32FromID(ImGuiCol_NavHighlight, 1), rounding, 0, 1.0)
+		window.DrawList.AddRect(display_rect.Min, display_rect.Max, g.GetColorU32FromID(ImGuiCol_NavHighlight, 1), rounding, 0, 1.0)
 	}
 }
 
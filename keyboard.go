@@ -1,77 +1,129 @@
 package imgui
 
 // Inputs Utilities: Keyboard
-// - For 'user_key_index int' you can use your own indices/enums according to how your backend/engine stored them in io.KeysDown[].
-// - We don't know the meaning of those value. You can use GetKeyIndex() to map a ImGuiKey_ value into the user index.
+// - The ImGuiKey enum contains all possible keyboard, mouse and gamepad inputs (e.g. ImGuiKey_A, ImGuiKey_F1, ImGuiKey_GamepadFaceDown).
+// - Backends submit key state with io.AddKeyEvent(). Legacy backends filling io.KeysDown[] + io.KeyMap[] are still supported,
+//   and values 0..511 are then accepted as native key indices by the functions below.
 
 // == tab stop enable. Allow focusing using TAB/Shift-TAB, enabled by default but you can disable it for certain widgets
-func PushAllowKeyboardFocus(allow_keyboard_focus bool) {
-	PushItemFlag(ImGuiItemFlags_NoTabStop, !allow_keyboard_focus)
+func (g *ImGuiContext) PushAllowKeyboardFocus(allow_keyboard_focus bool) {
+	g.PushItemFlag(ImGuiItemFlags_NoTabStop, !allow_keyboard_focus)
 }
-func PopAllowKeyboardFocus() {
-	PopItemFlag()
+func (g *ImGuiContext) PopAllowKeyboardFocus() {
+	g.PopItemFlag()
 }
 
-// map ImGuiKey_* values into user's key index. == io.KeyMap[key]
-func GetKeyIndex(imgui_key ImGuiKey) int {
-	IM_ASSERT(imgui_key >= 0 && imgui_key < ImGuiKey_COUNT)
-	var g = GImGui
-	return g.IO.KeyMap[imgui_key]
+func IsNamedKey(key ImGuiKey) bool {
+	return key >= ImGuiKey_NamedKey_BEGIN && key < ImGuiKey_NamedKey_END
+}
+func IsLegacyKey(key ImGuiKey) bool {
+	return key >= ImGuiKey_LegacyNativeKey_BEGIN && key < ImGuiKey_LegacyNativeKey_END
+}
+func IsGamepadKey(key ImGuiKey) bool {
+	return key >= ImGuiKey_Gamepad_BEGIN && key < ImGuiKey_Gamepad_END
+}
+
+// Get the state of a key, legacy native key indices are remapped to the named key they are mapped to in io.KeyMap[].
+func (g *ImGuiContext) GetKeyData(key ImGuiKey) *ImGuiKeyData {
+	IM_ASSERT(key >= ImGuiKey_LegacyNativeKey_BEGIN && key < ImGuiKey_NamedKey_END)
+	var index = key
+	if IsLegacyKey(key) && g.IO.KeyMap[key] != -1 {
+		index = ImGuiKey(g.IO.KeyMap[key]) // Remap native->imgui
+	}
+	return &g.IO.KeysData[index-ImGuiKey_KeysData_OFFSET]
 }
 
-// Note that dear imgui doesn't know the semantic of each entry of io.KeysDown[]!
-// Use your own indices/enums according to how your backend/engine stored them into io.KeysDown[]!
-// is key being held. == io.KeysDown[user_key_index].
-func IsKeyDown(user_key_index int) bool {
-	if user_key_index < 0 {
+// [LEGACY] map ImGuiKey_* values into legacy native key index. == io.KeyMap[key]. Since 1.87 this returns the key itself.
+func GetKeyIndex(key ImGuiKey) ImGuiKey {
+	IM_ASSERT(IsNamedKey(key))
+	return key
+}
+
+var keyNames = [ImGuiKey_NamedKey_COUNT]string{
+	"Tab", "LeftArrow", "RightArrow", "UpArrow", "DownArrow", "PageUp", "PageDown",
+	"Home", "End", "Insert", "Delete", "Backspace", "Space", "Enter", "Escape",
+	"LeftCtrl", "LeftShift", "LeftAlt", "LeftSuper", "RightCtrl", "RightShift", "RightAlt", "RightSuper", "Menu",
+	"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "A", "B", "C", "D", "E", "F", "G", "H",
+	"I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
+	"F1", "F2", "F3", "F4", "F5", "F6", "F7", "F8", "F9", "F10", "F11", "F12",
+	"Apostrophe", "Comma", "Minus", "Period", "Slash", "Semicolon", "Equal", "LeftBracket",
+	"Backslash", "RightBracket", "GraveAccent", "CapsLock", "ScrollLock", "NumLock", "PrintScreen",
+	"Pause", "Keypad0", "Keypad1", "Keypad2", "Keypad3", "Keypad4", "Keypad5", "Keypad6",
+	"Keypad7", "Keypad8", "Keypad9", "KeypadDecimal", "KeypadDivide", "KeypadMultiply",
+	"KeypadSubtract", "KeypadAdd", "KeypadEnter", "KeypadEqual",
+	"GamepadStart", "GamepadBack", "GamepadFaceUp", "GamepadFaceDown", "GamepadFaceLeft", "GamepadFaceRight",
+	"GamepadDpadUp", "GamepadDpadDown", "GamepadDpadLeft", "GamepadDpadRight",
+	"GamepadL1", "GamepadR1", "GamepadL2", "GamepadR2", "GamepadL3", "GamepadR3",
+	"GamepadLStickUp", "GamepadLStickDown", "GamepadLStickLeft", "GamepadLStickRight",
+	"GamepadRStickUp", "GamepadRStickDown", "GamepadRStickLeft", "GamepadRStickRight",
+	"ModCtrl", "ModShift", "ModAlt", "ModSuper",
+}
+
+// [DEBUG] returns English name of the key. Those names a provided for debugging purpose and are not meant to be saved persistently not compared.
+func (g *ImGuiContext) GetKeyName(key ImGuiKey) string {
+	if key == ImGuiKey_None {
+		return "None"
+	}
+	if IsLegacyKey(key) {
+		if g.IO.KeyMap[key] == -1 {
+			return "N/A"
+		}
+		key = ImGuiKey(g.IO.KeyMap[key])
+	}
+	if !IsNamedKey(key) {
+		return "Unknown"
+	}
+	return keyNames[key-ImGuiKey_NamedKey_BEGIN]
+}
+
+// is key being held.
+func (g *ImGuiContext) IsKeyDown(key ImGuiKey) bool {
+	if key < 0 {
 		return false
 	}
-	var g = GImGui
-	IM_ASSERT(user_key_index >= 0 && user_key_index < int(len(g.IO.KeysDown)))
-	return g.IO.KeysDown[user_key_index]
+	return g.GetKeyData(key).Down
 }
 
 // was key released (went from Down to !Down)?
-func IsKeyReleased(user_key_index int) bool {
-	var g = GImGui
-	if user_key_index < 0 {
+func (g *ImGuiContext) IsKeyReleased(key ImGuiKey) bool {
+	if key < 0 {
 		return false
 	}
-	IM_ASSERT(user_key_index >= 0 && user_key_index < int(len(g.IO.KeysDown)))
-	return g.IO.KeysDownDurationPrev[user_key_index] >= 0.0 && !g.IO.KeysDown[user_key_index]
+	var key_data = g.GetKeyData(key)
+	return key_data.DownDurationPrev >= 0.0 && !key_data.Down
 }
 
 // uses provided repeat rate/delay. return a count, most often 0 or 1 but might be >1 if RepeatRate is small enough that DeltaTime > RepeatRate
-func GetKeyPressedAmount(key_index int, repeat_delay float, repeat_rate float) int {
-	var g = GImGui
-	if key_index < 0 {
+func (g *ImGuiContext) GetKeyPressedAmount(key ImGuiKey, repeat_delay float, repeat_rate float) int {
+	if key < 0 {
 		return 0
 	}
-	IM_ASSERT(key_index >= 0 && key_index < int(len(g.IO.KeysDown)))
-	var t = g.IO.KeysDownDuration[key_index]
+	var t = g.GetKeyData(key).DownDuration
 	return CalcTypematicRepeatAmount(t-g.IO.DeltaTime, t, repeat_delay, repeat_rate)
 }
 
 // attention: misleading name! manually override io.WantCaptureKeyboard flag next frame (said flag is entirely left for your application to handle). e.g. force capture keyboard when your widget is being hovered. This is equivalent to setting "io.WantCaptureKeyboard = want_capture_keyboard_value"  {panic("not implemented")} after the next NewFrame() call.
-func CaptureKeyboardFromApp(want_capture_keyboard_value bool /*= true*/) {
+func (g *ImGuiContext) CaptureKeyboardFromApp(want_capture_keyboard_value bool /*= true*/) {
 	if want_capture_keyboard_value {
-		GImGui.WantCaptureKeyboardNextFrame = 1
+		g.WantCaptureKeyboardNextFrame = 1
 	} else {
-		GImGui.WantCaptureKeyboardNextFrame = 0
+		g.WantCaptureKeyboardNextFrame = 0
 	}
 }
 
 // Pass in translated ASCII characters for text input.
 // - with glfw you can get those from the callback set in glfwSetCharCallback()
 // - on Windows you can get those using ToAscii+keyboard state, or via the WM_CHAR message
+// - characters are queued as events and moved to io.InputQueueCharacters by NewFrame()
 func (io *ImGuiIO) AddInputCharacter(c rune) {
-	if c != 0 {
-		if c <= IM_UNICODE_CODEPOINT_MAX {
-			io.InputQueueCharacters = append(io.InputQueueCharacters, c)
-		} else {
-			io.AddInputCharacter(IM_UNICODE_CODEPOINT_INVALID)
-		}
+	if c == 0 {
+		return
 	}
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_Text
+	e.Source = ImGuiInputSource_Keyboard
+	e.Text.Char = c
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
 }
 
 func (io *ImGuiIO) AddInputCharacters(chars string) {
@@ -85,8 +137,29 @@ func (io *ImGuiIO) ClearInputCharacters() {
 	io.InputQueueCharacters = io.InputQueueCharacters[:0]
 }
 
-func GetMergedKeyModFlags() ImGuiKeyModFlags {
-	var g = GImGui
+// Clear the current keyboard/mouse/gamepad state + current frame text input buffer. Equivalent to releasing all keys/buttons.
+func (io *ImGuiIO) ClearInputKeys() {
+	io.KeysDown = [len(io.KeysDown)]bool{}
+	for n := range io.KeysData {
+		var key_data = &io.KeysData[n]
+		key_data.Down = false
+		key_data.DownDuration = -1.0
+		key_data.DownDurationPrev = -1.0
+		key_data.AnalogValue = 0.0
+	}
+	io.KeyCtrl = false
+	io.KeyShift = false
+	io.KeyAlt = false
+	io.KeySuper = false
+	io.KeyMods = ImGuiKeyModFlags_None
+	io.KeyModsPrev = ImGuiKeyModFlags_None
+	for n := range io.NavInputsDownDuration {
+		io.NavInputsDownDuration[n] = -1
+		io.NavInputsDownDurationPrev[n] = -1
+	}
+}
+
+func (g *ImGuiContext) GetMergedKeyModFlags() ImGuiKeyModFlags {
 	var key_mod_flags = ImGuiKeyModFlags_None
 	if g.IO.KeyCtrl {
 		key_mod_flags |= ImGuiKeyModFlags_Ctrl
@@ -103,18 +176,90 @@ func GetMergedKeyModFlags() ImGuiKeyModFlags {
 	return key_mod_flags
 }
 
-func IsKeyPressed(user_key_index int, repeat bool /*= true*/) bool {
-	var g = GImGui
-	if user_key_index < 0 {
+// was key pressed (went from !Down to Down)? if repeat=true, uses io.KeyRepeatDelay / KeyRepeatRate
+func (g *ImGuiContext) IsKeyPressed(key ImGuiKey, repeat bool /*= true*/) bool {
+	if key < 0 {
 		return false
 	}
-	IM_ASSERT(user_key_index >= 0 && user_key_index < int(len(g.IO.KeysDown)))
-	var t = g.IO.KeysDownDuration[user_key_index]
+	var t = g.GetKeyData(key).DownDuration
 	if t == 0.0 {
 		return true
 	}
 	if repeat && t > g.IO.KeyRepeatDelay {
-		return GetKeyPressedAmount(user_key_index, g.IO.KeyRepeatDelay, g.IO.KeyRepeatRate) > 0
+		return g.GetKeyPressedAmount(key, g.IO.KeyRepeatDelay, g.IO.KeyRepeatRate) > 0
 	}
 	return false
-} // was key pressed (went from !Down to Down)? if repeat=true, uses io.KeyRepeatDelay / KeyRepeatRate
+}
+
+// Update io.KeysData[] from the legacy io.KeysDown[] array and the modifiers, then update the keys down durations.
+func (g *ImGuiContext) UpdateKeyboardInputs() {
+	var io = &g.IO
+
+	// Import legacy keys or verify they are not used
+	if io.BackendUsingLegacyKeyArrays == 0 {
+		// Backend used new io.AddKeyEvent() API: Good! Verify that old arrays are never written to externally.
+		for n := ImGuiKey(ImGuiKey_LegacyNativeKey_BEGIN); n < ImGuiKey_LegacyNativeKey_END; n++ {
+			IM_ASSERT_USER_ERROR(!io.KeysDown[n] || g.IsKeyDown(n), "Backend needs to either only use io.AddKeyEvent(), either only fill legacy io.KeysDown[] + io.KeyMap[]. Not both!")
+		}
+	} else {
+		if g.FrameCount == 0 {
+			for n := ImGuiKey(ImGuiKey_LegacyNativeKey_BEGIN); n < ImGuiKey_LegacyNativeKey_END; n++ {
+				IM_ASSERT_USER_ERROR(io.KeyMap[n] == -1, "Backend is not allowed to write to io.KeyMap[0..511]!")
+			}
+		}
+
+		// Build reverse KeyMap (Named -> Legacy)
+		for n := ImGuiKey(ImGuiKey_NamedKey_BEGIN); n < ImGuiKey_NamedKey_END; n++ {
+			if io.KeyMap[n] != -1 {
+				IM_ASSERT(IsLegacyKey(ImGuiKey(io.KeyMap[n])))
+				io.KeyMap[io.KeyMap[n]] = int(n)
+			}
+		}
+
+		// Import legacy keys into new ones
+		for n := ImGuiKey(ImGuiKey_LegacyNativeKey_BEGIN); n < ImGuiKey_LegacyNativeKey_END; n++ {
+			if io.KeysDown[n] || io.BackendUsingLegacyKeyArrays == 1 {
+				var key = n
+				if io.KeyMap[n] != -1 {
+					key = ImGuiKey(io.KeyMap[n])
+				}
+				IM_ASSERT(io.KeyMap[n] == -1 || IsNamedKey(key))
+				io.KeysData[key].Down = io.KeysDown[n]
+				if key != n {
+					io.KeysDown[key] = io.KeysDown[n] // Allow legacy code using io.KeysDown[GetKeyIndex()] with old backends
+				}
+				io.BackendUsingLegacyKeyArrays = 1
+			}
+		}
+	}
+
+	// Synchronize io.KeyMods with individual modifiers io.KeyXXX bools, update aliases
+	io.KeyMods = g.GetMergedKeyModFlags()
+	io.KeysData[ImGuiKey_ModCtrl].Down = io.KeyCtrl
+	io.KeysData[ImGuiKey_ModShift].Down = io.KeyShift
+	io.KeysData[ImGuiKey_ModAlt].Down = io.KeyAlt
+	io.KeysData[ImGuiKey_ModSuper].Down = io.KeySuper
+
+	// Clear gamepad data if disabled
+	if (io.BackendFlags & ImGuiBackendFlags_HasGamepad) == 0 {
+		for i := ImGuiKey(ImGuiKey_Gamepad_BEGIN); i < ImGuiKey_Gamepad_END; i++ {
+			io.KeysData[i-ImGuiKey_KeysData_OFFSET].Down = false
+			io.KeysData[i-ImGuiKey_KeysData_OFFSET].AnalogValue = 0.0
+		}
+	}
+
+	// Update keys
+	for i := range io.KeysData {
+		var key_data = &io.KeysData[i]
+		key_data.DownDurationPrev = key_data.DownDuration
+		if key_data.Down {
+			if key_data.DownDuration < 0.0 {
+				key_data.DownDuration = 0.0
+			} else {
+				key_data.DownDuration += io.DeltaTime
+			}
+		} else {
+			key_data.DownDuration = -1.0
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package imgui
+
+// Shortcuts and key chords routing
+// - A key chord is a key combined with modifiers, e.g. ImGuiMod_Ctrl | ImGuiKey_S.
+// - Each frame, a key chord is routed to a single owner: Shortcut() and SetShortcutRouting() register a route
+//   with a score depending on the focus (lower is better), and the best route of a frame is applied on the next frame.
+// - With ImGuiInputFlags_RouteFocused, the focused window takes the shortcut first, then its parent windows.
+//   Global routes are taken by whoever registers them, with a priority set by the ImGuiInputFlags_RouteGlobalXXX flags.
+
+// Replace ImGuiMod_Shortcut by ImGuiMod_Ctrl, or ImGuiMod_Super with io.ConfigMacOSXBehaviors.
+func (g *ImGuiContext) ConvertShortcutMod(key_chord ImGuiKeyChord) ImGuiKeyChord {
+	IM_ASSERT(key_chord&ImGuiMod_Shortcut != 0)
+	key_chord &^= ImGuiMod_Shortcut
+	if g.IO.ConfigMacOSXBehaviors {
+		return key_chord | ImGuiMod_Super
+	}
+	return key_chord | ImGuiMod_Ctrl
+}
+
+// Return the ImGuiKey_ModXXX key of a single ImGuiMod_XXX modifier, used by key chords made of a modifier alone.
+func ConvertSingleModFlagToKey(key_chord ImGuiKeyChord) ImGuiKey {
+	switch key_chord {
+	case ImGuiMod_Ctrl:
+		return ImGuiKey_ModCtrl
+	case ImGuiMod_Shift:
+		return ImGuiKey_ModShift
+	case ImGuiMod_Alt:
+		return ImGuiKey_ModAlt
+	case ImGuiMod_Super:
+		return ImGuiKey_ModSuper
+	}
+	return ImGuiKey_None
+}
+
+// return English name of a key chord, e.g. "Ctrl+S", as displayed by MenuItemShortcut().
+func (g *ImGuiContext) GetKeyChordName(key_chord ImGuiKeyChord) string {
+	if key_chord&ImGuiMod_Shortcut != 0 {
+		key_chord = g.ConvertShortcutMod(key_chord)
+	}
+	var name string
+	if key_chord&ImGuiMod_Ctrl != 0 {
+		name += "Ctrl+"
+	}
+	if key_chord&ImGuiMod_Shift != 0 {
+		name += "Shift+"
+	}
+	if key_chord&ImGuiMod_Alt != 0 {
+		name += "Alt+"
+	}
+	if key_chord&ImGuiMod_Super != 0 {
+		name += "Super+"
+	}
+	var key = ImGuiKey(key_chord &^ ImGuiMod_Mask_)
+	if key == ImGuiKey_None {
+		if name == "" {
+			return "None"
+		}
+		return name[:len(name)-1]
+	}
+	return name + g.GetKeyName(key)
+}
+
+func (g *ImGuiContext) GetShortcutRoutingData(key_chord ImGuiKeyChord) *ImGuiKeyRoutingData {
+	if key_chord&ImGuiMod_Shortcut != 0 {
+		key_chord = g.ConvertShortcutMod(key_chord)
+	}
+	if g.KeysRoutingTable == nil {
+		g.KeysRoutingTable = make(map[ImGuiKeyChord]*ImGuiKeyRoutingData)
+	}
+	var routing_data = g.KeysRoutingTable[key_chord]
+	if routing_data == nil {
+		routing_data = &ImGuiKeyRoutingData{RoutingNextScore: 255}
+		g.KeysRoutingTable[key_chord] = routing_data
+	}
+	return routing_data
+}
+
+// Apply the routes registered during the previous frame. Called by NewFrame().
+func (g *ImGuiContext) UpdateKeyRoutingTable() {
+	for key_chord, routing_data := range g.KeysRoutingTable {
+		routing_data.RoutingCurr = routing_data.RoutingNext
+		routing_data.RoutingNext = 0
+		routing_data.RoutingNextScore = 255
+		if routing_data.RoutingCurr == 0 {
+			delete(g.KeysRoutingTable, key_chord) // Nobody registered the route on the previous frame
+		}
+	}
+}
+
+// Score of a route registered from the location window (lower is better, 255: no access).
+func (g *ImGuiContext) CalcRoutingScore(location *ImGuiWindow, owner_id ImGuiID, flags ImGuiInputFlags) int {
+	if flags&ImGuiInputFlags_RouteFocused != 0 {
+		var focused = g.NavWindow
+
+		// ActiveID gets top priority
+		if owner_id != 0 && g.ActiveId == owner_id {
+			return 1
+		}
+
+		// Score based on distance to focused window (lower is better)
+		// Assuming both windows are submitting a routing request,
+		// - When Window....... is focused -> Window scores 3 (best), Window/ChildB scores 255 (no access)
+		// - When Window/ChildB is focused -> Window scores 4,        Window/ChildB scores 3 (best)
+		// Assuming only WindowA is submitting a routing request,
+		// - When Window/ChildB is focused -> Window scores 4 (best), Window/ChildB doesn't have a score.
+		if focused != nil && location != nil && focused.RootWindow == location.RootWindow {
+			for next_score := int(3); focused != nil; next_score++ {
+				if focused == location {
+					IM_ASSERT(next_score < 255)
+					return next_score
+				}
+				if focused.RootWindow != focused {
+					focused = focused.ParentWindow
+				} else {
+					focused = nil
+				}
+			}
+		}
+		if flags&ImGuiInputFlags_RouteGlobalLow != 0 {
+			return 254 // Fall back to a global route when the window is not focused
+		}
+		return 255
+	}
+
+	if flags&ImGuiInputFlags_RouteGlobal != 0 {
+		return 2
+	}
+	if flags&ImGuiInputFlags_RouteGlobalLow != 0 {
+		return 254
+	}
+	return 0 // ImGuiInputFlags_RouteGlobalHigh
+}
+
+// Register a route for key_chord and return true if owner_id (or the current focus scope or window when 0) owns it on this frame.
+// Routes default to ImGuiInputFlags_RouteGlobalHigh, unlike Shortcut().
+func (g *ImGuiContext) SetShortcutRouting(key_chord ImGuiKeyChord, owner_id ImGuiID, flags ImGuiInputFlags) bool {
+	return g.SetShortcutRoutingEx(key_chord, owner_id, flags, g.CurrentWindow)
+}
+
+// SetShortcutRouting() with the route scored from the location window.
+func (g *ImGuiContext) SetShortcutRoutingEx(key_chord ImGuiKeyChord, owner_id ImGuiID, flags ImGuiInputFlags, location *ImGuiWindow) bool {
+	if flags&ImGuiInputFlags_RouteMask_ == 0 {
+		flags |= ImGuiInputFlags_RouteGlobalHigh // IMPORTANT: This is the default for SetShortcutRouting() but NOT Shortcut()
+	} else {
+		var route = flags & ImGuiInputFlags_RouteMask_
+		IM_ASSERT_USER_ERROR(route&(route-1) == 0 || route == ImGuiInputFlags_RouteFocused|ImGuiInputFlags_RouteGlobalLow, "Only one routing flag can be used, except RouteFocused | RouteGlobalLow")
+	}
+
+	if flags&ImGuiInputFlags_RouteUnlessBgFocused != 0 && g.NavWindow == nil {
+		return false
+	}
+	if flags&ImGuiInputFlags_RouteAlways != 0 {
+		return true
+	}
+
+	var score = g.CalcRoutingScore(location, owner_id, flags)
+	if score == 255 {
+		return false
+	}
+
+	// Submit routing for NEXT frame (assuming score is sufficient)
+	var routing_data = g.GetShortcutRoutingData(key_chord)
+	var routing_id = owner_id
+	if routing_id == 0 {
+		var window = g.CurrentWindow
+		routing_id = g.GetFocusScope()
+		if routing_id == 0 || (window.Flags&ImGuiWindowFlags_ChildWindow != 0 && routing_id == window.ParentWindow.DC.NavFocusScopeIdCurrent) {
+			routing_id = window.ID // Child windows share the focus scope of their parent, but have their own routes
+		}
+	}
+	if score < int(routing_data.RoutingNextScore) {
+		routing_data.RoutingNext = routing_id
+		routing_data.RoutingNextScore = ImU8(score)
+	}
+
+	// Return routing state for CURRENT frame
+	return routing_data.RoutingCurr == routing_id
+}
+
+// Return true when key_chord is pressed and routed to the current window or focus scope, e.g. Shortcut(ImGuiMod_Ctrl | ImGuiKey_S, 0).
+// Routes default to ImGuiInputFlags_RouteFocused: the shortcut is only taken when the window or one of its child windows is focused.
+// A route is applied on the frame following its registration, so call Shortcut() every frame.
+func (g *ImGuiContext) Shortcut(key_chord ImGuiKeyChord, flags ImGuiInputFlags) bool {
+	return g.ShortcutEx(key_chord, 0, flags, g.CurrentWindow)
+}
+
+// Shortcut() registered for owner_id and scored from the location window.
+func (g *ImGuiContext) ShortcutEx(key_chord ImGuiKeyChord, owner_id ImGuiID, flags ImGuiInputFlags, location *ImGuiWindow) bool {
+	IM_ASSERT((flags &^ (ImGuiInputFlags_Repeat | ImGuiInputFlags_RouteMask_ | ImGuiInputFlags_RouteAlways | ImGuiInputFlags_RouteUnlessBgFocused)) == 0) // Passing flags not supported by this function!
+
+	if flags&ImGuiInputFlags_RouteMask_ == 0 {
+		flags |= ImGuiInputFlags_RouteFocused
+	}
+	if !g.SetShortcutRoutingEx(key_chord, owner_id, flags, location) {
+		return false
+	}
+
+	if key_chord&ImGuiMod_Shortcut != 0 {
+		key_chord = g.ConvertShortcutMod(key_chord)
+	}
+	var mods = key_chord & ImGuiMod_Mask_
+	if g.IO.KeyMods != ImGuiKeyModFlags(mods>>12) {
+		return false
+	}
+
+	// Special storage location for mods
+	var key = ImGuiKey(key_chord &^ ImGuiMod_Mask_)
+	if key == ImGuiKey_None {
+		key = ConvertSingleModFlagToKey(mods)
+	}
+	return g.IsKeyPressed(key, flags&ImGuiInputFlags_Repeat != 0)
+}
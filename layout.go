@@ -9,48 +9,46 @@ package imgui
 //    Absolute coordinate:        GetCursorScreenPos(), SetCursorScreenPos(), all ImDrawList:: functions.
 
 // undo a SameLine() or force a new line when in an horizontal-layout context.
-func NewLine() {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) NewLine() {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
 
-	var g = GImGui
 	var backup_layout_type = window.DC.LayoutType
 	window.DC.LayoutType = ImGuiLayoutType_Vertical
 	if window.DC.CurrLineSize.y > 0.0 { // In the event that we are on a line with items that is smaller that FontSize high, we will preserve its height.
-		ItemSizeVec(&ImVec2{}, 0)
+		g.ItemSizeVec(&ImVec2{}, 0)
 	} else {
-		ItemSizeVec(&ImVec2{0.0, g.FontSize}, 0)
+		g.ItemSizeVec(&ImVec2{0.0, g.FontSize}, 0)
 	}
 	window.DC.LayoutType = backup_layout_type
 }
 
 // add vertical spacing.
-func Spacing() {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Spacing() {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
-	ItemSizeVec(&ImVec2{}, 0)
+	g.ItemSizeVec(&ImVec2{}, 0)
 }
 
 // add a dummy item of given size. unlike InvisibleButton(), Dummy() won't take the mouse click or be navigable into.
-func Dummy(size ImVec2) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Dummy(size ImVec2) {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
 
 	var bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(size)}
-	ItemSizeVec(&size, 0)
-	ItemAdd(&bb, 0, nil, 0)
+	g.ItemSizeVec(&size, 0)
+	g.ItemAdd(&bb, 0, nil, 0)
 }
 
 // Lock horizontal starting position + capture group bounding box into one "item" (so you can use IsItemHovered() or layout primitives such as SameLine() on whole group, etc.)
 // Groups are currently a mishmash of functionalities which should perhaps be clarified and separated.
-func BeginGroup() {
-	var g = GImGui
+func (g *ImGuiContext) BeginGroup() {
 	var window = g.CurrentWindow
 
 	g.GroupStack = append(g.GroupStack, ImGuiGroupData{})
@@ -78,8 +76,7 @@ func BeginGroup() {
 }
 
 // unlock horizontal starting position + capture the whole group bounding box into one "item" (so you can use IsItemHovered() or layout primitives such as SameLine() on whole group, etc.)
-func EndGroup() {
-	var g = GImGui
+func (g *ImGuiContext) EndGroup() {
 	var window = g.CurrentWindow
 	IM_ASSERT(len(g.GroupStack) > 0) // Mismatched BeginGroup()/EndGroup() calls
 
@@ -106,8 +103,8 @@ func EndGroup() {
 	window.DC.CurrLineTextBaseOffset = ImMax(window.DC.PrevLineTextBaseOffset, group_data.BackupCurrLineTextBaseOffset) // FIXME: Incorrect, we should grab the base offset from the *first line* of the group but it is hard to obtain now.
 
 	size := group_bb.GetSize()
-	ItemSizeVec(&size, 0)
-	ItemAdd(&group_bb, 0, nil, 0)
+	g.ItemSizeVec(&size, 0)
+	g.ItemAdd(&group_bb, 0, nil, 0)
 
 	// If the current ActiveId was declared within the boundary of our group, we copy it to LastItemId so IsItemActive(), IsItemDeactivated() etc. will be functional on the entire group.
 	// It would be be neater if we replaced window.DC.LastItemId by e.g. 'bool LastItemIsActive', but would put a little more burden on individual widgets.
@@ -144,12 +141,12 @@ func EndGroup() {
 }
 
 // cursor position in window coordinates (relative to window position)
-func GetCursorPos() ImVec2 {
+func (g *ImGuiContext) GetCursorPos() ImVec2 {
 
 	// User generally sees positions in window coordinates. Internally we store CursorPos in absolute screen coordinates because it is more convenient.
 	// Conversion happens as we pass the value to user, but it makes our naming convention confusing because GetCursorPos() == (DC.CursorPos - window.Pos). May want to rename 'DC.CursorPos'.
 
-	var window = GetCurrentWindowRead()
+	var window = g.GetCurrentWindowRead()
 	return window.DC.CursorPos.Sub(window.Pos).Add(window.Scroll)
 }
 
@@ -158,96 +155,90 @@ func GetCursorPos() ImVec2 {
 //    are using the main, absolute coordinate system.
 //    GetWindowPos() + GetCursorPos() == GetCursorScreenPos() etc.)
 
-func GetCursorPosX() float {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetCursorPosX() float {
+	var window = g.GetCurrentWindowRead()
 	return window.DC.CursorPos.x - window.Pos.x + window.Scroll.x
 }
 
-func GetCursorPosY() float {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetCursorPosY() float {
+	var window = g.GetCurrentWindowRead()
 	return window.DC.CursorPos.y - window.Pos.y + window.Scroll.y
 }
 
-func SetCursorPos(local_pos *ImVec2) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) SetCursorPos(local_pos *ImVec2) {
+	var window = g.GetCurrentWindow()
 	window.DC.CursorPos = window.Pos.Sub(window.Scroll).Add(*local_pos)
 	window.DC.CursorMaxPos = ImMaxVec2(&window.DC.CursorMaxPos, &window.DC.CursorPos)
 }
 
-func SetCursorPosX(local_x float) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) SetCursorPosX(local_x float) {
+	var window = g.GetCurrentWindow()
 	window.DC.CursorPos.x = window.Pos.x - window.Scroll.x + local_x
 	window.DC.CursorMaxPos.x = ImMax(window.DC.CursorMaxPos.x, window.DC.CursorPos.x)
 }
 
-func SetCursorPosY(local_y float) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) SetCursorPosY(local_y float) {
+	var window = g.GetCurrentWindow()
 	window.DC.CursorPos.y = window.Pos.y - window.Scroll.y + local_y
 	window.DC.CursorMaxPos.y = ImMax(window.DC.CursorMaxPos.y, window.DC.CursorPos.y)
 }
 
 // initial cursor position in window coordinates
-func GetCursorStartPos() ImVec2 {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetCursorStartPos() ImVec2 {
+	var window = g.GetCurrentWindowRead()
 	return window.DC.CursorStartPos.Sub(window.Pos)
 }
 
 // cursor position in absolute coordinates (useful to work with ImDrawList API). generally top-left == GetMainViewport()->Pos == (0,0) in single viewport mode, and bottom-right == GetMainViewport()->Pos+Size == io.DisplaySize in single-viewport mode.
-func GetCursorScreenPos() ImVec2 {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetCursorScreenPos() ImVec2 {
+	var window = g.GetCurrentWindowRead()
 	return window.DC.CursorPos
 }
 
 // cursor position in absolute coordinates
-func SetCursorScreenPos(pos ImVec2) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) SetCursorScreenPos(pos ImVec2) {
+	var window = g.GetCurrentWindow()
 	window.DC.CursorPos = pos
 	window.DC.CursorMaxPos = ImMaxVec2(&window.DC.CursorMaxPos, &window.DC.CursorPos)
 }
 
 // vertically align upcoming text baseline to FramePadding.y so that it will align properly to regularly framed items (call if you have text on a line before a framed item)
-func AlignTextToFramePadding() {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) AlignTextToFramePadding() {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
 
-	var g = GImGui
 	window.DC.CurrLineSize.y = ImMax(window.DC.CurrLineSize.y, g.FontSize+g.Style.FramePadding.y*2)
 	window.DC.CurrLineTextBaseOffset = ImMax(window.DC.CurrLineTextBaseOffset, g.Style.FramePadding.y)
 }
 
 // ~ FontSize
-func GetTextLineHeight() float {
-	var g = GImGui
+func (g *ImGuiContext) GetTextLineHeight() float {
 	return g.FontSize
 }
 
 // ~ FontSize + style.ItemSpacing.y (distance in pixels between 2 consecutive lines of text)
-func GetTextLineHeightWithSpacing() float {
-	var g = GImGui
+func (g *ImGuiContext) GetTextLineHeightWithSpacing() float {
 	return g.FontSize + g.Style.ItemSpacing.y
 }
 
 // ~ FontSize + style.FramePadding.y * 2
-func GetFrameHeight() float {
-	var g = GImGui
+func (g *ImGuiContext) GetFrameHeight() float {
 	return g.FontSize + g.Style.FramePadding.y*2.0
 }
 
 // ~ FontSize + style.FramePadding.y * 2 + style.ItemSpacing.y (distance in pixels between 2 consecutive lines of framed widgets)
-func GetFrameHeightWithSpacing() float {
-	var g = GImGui
+func (g *ImGuiContext) GetFrameHeightWithSpacing() float {
 	return g.FontSize + g.Style.FramePadding.y*2.0 + g.Style.ItemSpacing.y
 }
 
 // Parameters stacks (current window)
 
 // push width of items for common large "item+label" widgets. >0.0: width in pixels, <0.0 align xx pixels to the right of window (so -FLT_MIN always align width to the right side).
-func PushItemWidth(item_width float) {
+func (g *ImGuiContext) PushItemWidth(item_width float) {
 	// FIXME: Remove the == 0.0f behavior?
 
-	var g = GImGui
 	var window = g.CurrentWindow
 	window.DC.ItemWidthStack = append(window.DC.ItemWidthStack, window.DC.ItemWidth) // Backup current width
 	if item_width == 0 {
@@ -258,8 +249,7 @@ func PushItemWidth(item_width float) {
 	g.NextItemData.Flags &= ^ImGuiNextItemDataFlags_HasWidth
 }
 
-func PushMultiItemsWidths(components int, width_full float) {
-	var g = GImGui
+func (g *ImGuiContext) PushMultiItemsWidths(components int, width_full float) {
 	var window = g.CurrentWindow
 	var style = g.Style
 	var w_item_one = ImMax(1.0, IM_FLOOR((width_full-(style.ItemInnerSpacing.x)*float(components-1))/(float)(components)))
@@ -277,16 +267,15 @@ func PushMultiItemsWidths(components int, width_full float) {
 	g.NextItemData.Flags &= ^ImGuiNextItemDataFlags_HasWidth
 }
 
-func PopItemWidth() {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) PopItemWidth() {
+	var window = g.GetCurrentWindow()
 	window.DC.ItemWidth = window.DC.ItemWidthStack[len(window.DC.ItemWidthStack)-1]
 	window.DC.ItemWidthStack = window.DC.ItemWidthStack[:len(window.DC.ItemWidthStack)-1]
 }
 
 // set width of the _next_ common large "item+label" widget. >0.0: width in pixels, <0.0 align xx pixels to the right of window (so -FLT_MIN always align width to the right side)
 // Affect large frame+labels widgets only.
-func SetNextItemWidth(item_width float) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextItemWidth(item_width float) {
 	g.NextItemData.Flags |= ImGuiNextItemDataFlags_HasWidth
 	g.NextItemData.Width = item_width
 }
@@ -294,8 +283,7 @@ func SetNextItemWidth(item_width float) {
 // Calculate default item width given value passed to PushItemWidth() or SetNextItemWidth().
 // The SetNextItemWidth() data is generally cleared/consumed by ItemAdd() or NextItemData.ClearFlags()
 // width of item given pushed settings and current cursor position. NOT necessarily the width of last item unlike most 'Item' functions.
-func CalcItemWidth() float {
-	var g = GImGui
+func (g *ImGuiContext) CalcItemWidth() float {
 	var window = g.CurrentWindow
 	var w float
 	if g.NextItemData.Flags&ImGuiNextItemDataFlags_HasWidth != 0 {
@@ -304,7 +292,7 @@ func CalcItemWidth() float {
 		w = window.DC.ItemWidth
 	}
 	if w < 0.0 {
-		var region_max_x = GetContentRegionMaxAbs().x
+		var region_max_x = g.GetContentRegionMaxAbs().x
 		w = ImMax(1.0, region_max_x-window.DC.CursorPos.x+w)
 	}
 	w = IM_FLOOR(w)
@@ -318,15 +306,14 @@ func CalcItemWidth() float {
 // - Those functions are bound to be redesigned (they are confusing, incomplete and the Min/Max return values are in local window coordinates which increases confusion)
 
 // == GetContentRegionMax() - GetCursorPos()
-func GetContentRegionAvail() ImVec2 {
-	var window = GImGui.CurrentWindow
-	return GetContentRegionMaxAbs().Sub(window.DC.CursorPos)
+func (g *ImGuiContext) GetContentRegionAvail() ImVec2 {
+	var window = g.CurrentWindow
+	return g.GetContentRegionMaxAbs().Sub(window.DC.CursorPos)
 }
 
 // current content boundaries (typically window boundaries including scrolling, or current column boundaries), in windows coordinates
 // FIXME: This is in window space (not screen space!).
-func GetContentRegionMax() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetContentRegionMax() ImVec2 {
 	var window = g.CurrentWindow
 	var mx = window.ContentRegionRect.Max.Sub(window.Pos)
 	if window.DC.CurrentColumns != nil || g.CurrentTable != nil {
@@ -336,8 +323,7 @@ func GetContentRegionMax() ImVec2 {
 }
 
 // [Internal] Absolute coordinate. Saner. This is not exposed until we finishing refactoring work rect features.
-func GetContentRegionMaxAbs() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetContentRegionMaxAbs() ImVec2 {
 	var window = g.CurrentWindow
 	var mx = window.ContentRegionRect.Max
 	if window.DC.CurrentColumns != nil || g.CurrentTable != nil {
@@ -347,13 +333,13 @@ func GetContentRegionMaxAbs() ImVec2 {
 }
 
 // content boundaries min for the full window (roughly (0,0)-Scroll), in window coordinates
-func GetWindowContentRegionMin() ImVec2 {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetWindowContentRegionMin() ImVec2 {
+	var window = g.CurrentWindow
 	return window.ContentRegionRect.Min.Sub(window.Pos)
 }
 
 // content boundaries max for the full window (roughly (0,0)+Size-Scroll) where Size can be override with SetNextWindowContentSize(), in window coordinates
-func GetWindowContentRegionMax() ImVec2 {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetWindowContentRegionMax() ImVec2 {
+	var window = g.CurrentWindow
 	return window.ContentRegionRect.Max.Sub(window.Pos)
 }
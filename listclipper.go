@@ -1,5 +1,11 @@
 package imgui
 
+import (
+	"sort"
+
+	"github.com/Splizard/imgui/golang"
+)
+
 // Helper: Manually clip large list of items.
 // If you are submitting lots of evenly spaced items and you have a random access to the list, you can perform coarse
 // clipping based on visibility to save yourself from processing those items at all.
@@ -22,6 +28,7 @@ package imgui
 // - Clipper calculate the actual range of elements to display based on the current clipping rectangle, position the cursor before the first visible element.
 // - User code submit visible elements.
 type ImGuiListClipper struct {
+	Ctx          *ImGuiContext // Parent UI context, the current context when Begin() is called if nil
 	DisplayStart int
 	DisplayEnd   int
 
@@ -31,6 +38,14 @@ type ImGuiListClipper struct {
 	ItemsFrozen int
 	ItemsHeight float
 	StartPosY   float
+	Ranges      []ImGuiListClipperRange // Ranges of items to display on top of the visible ones, see IncludeItemsByIndex()
+	RangeNo     int                     // Next range to display in Ranges, once they have been merged with the visible range
+}
+
+// ImGuiListClipperRange [Internal] Range of items [Min, Max) to be displayed by an ImGuiListClipper
+type ImGuiListClipperRange struct {
+	Min int
+	Max int
 }
 
 func NewImGuiListClipper() ImGuiListClipper {
@@ -44,13 +59,16 @@ func NewImGuiListClipper() ImGuiListClipper {
 // FIXME-LEGACY: Ideally we should remove the Begin/End functions but they are part of the legacy API we still support. This is why some of the code in Step() calling Begin() and reassign some fields, spaghetti style.
 // items_count: Use INT_MAX if you don't know how many items you have (in which case the cursor won't be advanced in the final step)
 // items_height: Use -1.0f to be calculated automatically on first step. Otherwise pass in the distance between your items, typically GetTextLineHeightWithSpacing() or GetFrameHeightWithSpacing().
-func (this ImGuiListClipper) Begin(items_count int, items_height float /*= -1.0f*/) {
-	var g = GImGui
+func (this *ImGuiListClipper) Begin(items_count int, items_height float /*= -1.0f*/) {
+	if this.Ctx == nil {
+		this.Ctx = GImGui
+	}
+	var g = this.Ctx
 	var window = g.CurrentWindow
 
 	if table := g.CurrentTable; table != nil {
 		if table.IsInsideRow {
-			TableEndRow(table)
+			g.TableEndRow(table)
 		}
 	}
 
@@ -61,33 +79,51 @@ func (this ImGuiListClipper) Begin(items_count int, items_height float /*= -1.0f
 	this.StepNo = 0
 	this.DisplayStart = -1
 	this.DisplayEnd = 0
+	this.Ranges = this.Ranges[:0]
+	this.RangeNo = 0
+}
+
+// IncludeItemByIndex makes sure an item is displayed even when it is not visible, e.g. the source item of a multi-selection
+// range (see ImGuiMultiSelectIO.RangeSrcItem) or an item you want to scroll to.
+// Call after Begin() and before the first Step(). Items are still submitted in increasing order.
+func (this *ImGuiListClipper) IncludeItemByIndex(item_index int) {
+	this.IncludeItemsByIndex(item_index, item_index+1)
+}
+
+// IncludeItemsByIndex makes sure the items [item_begin, item_end) are displayed, see IncludeItemByIndex().
+func (this *ImGuiListClipper) IncludeItemsByIndex(item_begin, item_end int) {
+	IM_ASSERT(this.DisplayStart < 0) // Only allowed after Begin() and before the first Step()
+	IM_ASSERT(item_begin <= item_end)
+	if item_begin < item_end {
+		this.Ranges = append(this.Ranges, ImGuiListClipperRange{item_begin, item_end})
+	}
 }
 
 // Automatically called on the last call of Step() that returns false.
-func (this ImGuiListClipper) End() {
+func (this *ImGuiListClipper) End() {
 	if this.ItemsCount < 0 { // Already ended
 		return
 	}
 
 	// In theory here we should assert that ImGui::GetCursorPosY() == StartPosY + DisplayEnd * ItemsHeight, but it feels saner to just seek at the end and not assert/crash the user.
 	if this.ItemsCount < INT_MAX && this.DisplayStart >= 0 {
-		SetCursorPosYAndSetupForPrevLine(this.StartPosY+float(this.ItemsCount-this.ItemsFrozen)*this.ItemsHeight, this.ItemsHeight)
+		this.Ctx.SetCursorPosYAndSetupForPrevLine(this.StartPosY+float(this.ItemsCount-this.ItemsFrozen)*this.ItemsHeight, this.ItemsHeight)
 	}
 	this.ItemsCount = -1
 	this.StepNo = 3
 }
 
-func (this ImGuiListClipper) Step() bool {
-	var g = GImGui
+func (this *ImGuiListClipper) Step() bool {
+	var g = this.Ctx
 	var window = g.CurrentWindow
 
 	var table = g.CurrentTable
 	if table != nil && table.IsInsideRow {
-		TableEndRow(table)
+		g.TableEndRow(table)
 	}
 
 	// No items
-	if this.ItemsCount == 0 || GetSkipItemForListClipping() {
+	if this.ItemsCount == 0 || g.GetSkipItemForListClipping() {
 		this.End()
 		return false
 	}
@@ -138,30 +174,42 @@ func (this ImGuiListClipper) Step() bool {
 		return false
 	}
 
-	// Step 2: calculate the actual range of elements to display, and position the cursor before the first element
+	// Step 2: calculate the actual range of elements to display, merge it with the included ranges
 	if this.StepNo == 2 {
 		IM_ASSERT(this.ItemsHeight > 0.0)
 
 		var already_submitted = this.DisplayEnd
-		CalcListClipping(this.ItemsCount-already_submitted, this.ItemsHeight, &this.DisplayStart, &this.DisplayEnd)
-		this.DisplayStart += already_submitted
-		this.DisplayEnd += already_submitted
+		var visible ImGuiListClipperRange
+		g.CalcListClipping(this.ItemsCount-already_submitted, this.ItemsHeight, &visible.Min, &visible.Max)
+		visible.Min += already_submitted
+		visible.Max += already_submitted
+		this.Ranges = append(this.Ranges, visible)
+		this.sortAndFuseRanges(already_submitted)
+		this.RangeNo = 0
+		this.StepNo = 3
+	}
+
+	// Step 3: display the next range in line, positioning the cursor before its first element
+	if this.StepNo == 3 && this.RangeNo < int(len(this.Ranges)) {
+		var r = this.Ranges[this.RangeNo]
+		this.RangeNo++
+		var already_submitted = this.DisplayEnd
+		this.DisplayStart = r.Min
+		this.DisplayEnd = r.Max
 
 		// Seek cursor
 		if this.DisplayStart > already_submitted {
-			SetCursorPosYAndSetupForPrevLine(this.StartPosY+float(this.DisplayStart-this.ItemsFrozen)*this.ItemsHeight, this.ItemsHeight)
+			g.SetCursorPosYAndSetupForPrevLine(this.StartPosY+float(this.DisplayStart-this.ItemsFrozen)*this.ItemsHeight, this.ItemsHeight)
 		}
-
-		this.StepNo = 3
 		return true
 	}
 
-	// Step 3: the clipper validate that we have reached the expected Y position (corresponding to element DisplayEnd),
+	// Step 3 (after the last range): the clipper validate that we have reached the expected Y position (corresponding to element DisplayEnd),
 	// Advance the cursor to the end of the list and then returns 'false' to end the loop.
 	if this.StepNo == 3 {
 		// Seek cursor
 		if this.ItemsCount < INT_MAX {
-			SetCursorPosYAndSetupForPrevLine(this.StartPosY+float(this.ItemsCount-this.ItemsFrozen)*this.ItemsHeight, this.ItemsHeight) // advance cursor
+			g.SetCursorPosYAndSetupForPrevLine(this.StartPosY+float(this.ItemsCount-this.ItemsFrozen)*this.ItemsHeight, this.ItemsHeight) // advance cursor
 		}
 		this.ItemsCount = -1
 		return false
@@ -171,10 +219,32 @@ func (this ImGuiListClipper) Step() bool {
 	return false
 }
 
+// Clamp the ranges to the items not submitted yet, sort them and merge the overlapping or contiguous ones.
+func (this *ImGuiListClipper) sortAndFuseRanges(already_submitted int) {
+	var ranges = this.Ranges[:0]
+	for _, r := range this.Ranges {
+		r.Min = ImMaxInt(r.Min, already_submitted)
+		r.Max = ImMinInt(r.Max, this.ItemsCount)
+		if r.Min < r.Max {
+			ranges = append(ranges, r)
+		}
+	}
+	sort.Slice(ranges, func(i, j golang.Int) bool { return ranges[i].Min < ranges[j].Min })
+	var n = 0
+	for i := range ranges {
+		if n > 0 && ranges[i].Min <= ranges[n-1].Max {
+			ranges[n-1].Max = ImMaxInt(ranges[n-1].Max, ranges[i].Max)
+			continue
+		}
+		ranges[n] = ranges[i]
+		n++
+	}
+	this.Ranges = ranges[:n]
+}
+
 // FIXME-TABLE: This prevents us from using ImGuiListClipper _inside_ a table cell.
 // The problem we have is that without a Begin/End scheme for rows using the clipper is ambiguous.
-func GetSkipItemForListClipping() bool {
-	var g = GImGui
+func (g *ImGuiContext) GetSkipItemForListClipping() bool {
 	if g.CurrentTable != nil {
 		return g.CurrentTable.HostSkipItems
 	}
@@ -184,8 +254,7 @@ func GetSkipItemForListClipping() bool {
 // Helper to calculate coarse clipping of large list of evenly sized items.
 // NB: Prefer using the ImGuiListClipper higher-level helper if you can! Read comments and instructions there on how those use this sort of pattern.
 // NB: 'items_count' is only used to clamp the result, if you don't know your count you can use INT_MAX
-func CalcListClipping(items_count int, items_height float, out_items_display_start *int, out_items_display_end *int) {
-	var g = GImGui
+func (g *ImGuiContext) CalcListClipping(items_count int, items_height float, out_items_display_start *int, out_items_display_end *int) {
 	var window = g.CurrentWindow
 	if g.LogEnabled {
 		// If logging is active, do not perform any clipping
@@ -193,7 +262,7 @@ func CalcListClipping(items_count int, items_height float, out_items_display_sta
 		*out_items_display_end = items_count
 		return
 	}
-	if GetSkipItemForListClipping() {
+	if g.GetSkipItemForListClipping() {
 		*out_items_display_start = 0
 		*out_items_display_end = 0
 		return
@@ -208,6 +277,10 @@ func CalcListClipping(items_count int, items_height float, out_items_display_sta
 		// Could store and use NavJustMovedToRectRe
 		unclipped_rect.AddRect(ImRect{window.Pos.Add(window.NavRectRel[0].Min), window.Pos.Add(window.NavRectRel[0].Max)})
 	}
+	if g.BoxSelectState.UnclipMode && g.BoxSelectState.Window == window {
+		// Box-selection needs to submit the items it moved away from, so they can be unselected
+		unclipped_rect.AddRect(g.BoxSelectState.UnclipRect)
+	}
 
 	var pos = window.DC.CursorPos
 	var start = (int)((unclipped_rect.Min.y - pos.y) / items_height)
@@ -227,11 +300,10 @@ func CalcListClipping(items_count int, items_height float, out_items_display_sta
 	*out_items_display_end = end
 }
 
-func SetCursorPosYAndSetupForPrevLine(pos_y, line_height float) {
+func (g *ImGuiContext) SetCursorPosYAndSetupForPrevLine(pos_y, line_height float) {
 	// Set cursor position and a few other things so that SetScrollHereY() and Columns() can work when seeking cursor.
 	// FIXME: It is problematic that we have to do that here, because custom/equivalent end-user code would stumble on the same issue.
 	// The clipper should probably have a 4th step to display the last item in a regular manner.
-	var g = GImGui
 	var window = g.CurrentWindow
 	var off_y = pos_y - window.DC.CursorPos.y
 	window.DC.CursorPos.y = pos_y
@@ -243,7 +315,7 @@ func SetCursorPosYAndSetupForPrevLine(pos_y, line_height float) {
 	}
 	if table := g.CurrentTable; table != nil {
 		if table.IsInsideRow {
-			TableEndRow(table)
+			g.TableEndRow(table)
 		}
 		table.RowPosY2 = window.DC.CursorPos.y
 		var row_increase = (int)((off_y / line_height) + 0.5)
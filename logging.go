@@ -2,13 +2,14 @@ package imgui
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 )
 
 // LogBegin Logging/Capture
 // . BeginCapture() when we design v2 api, for now stay under the radar by using the old name.
-func LogBegin(ltype ImGuiLogType, auto_open_depth int) {
-	var g = GImGui
+func (g *ImGuiContext) LogBegin(ltype ImGuiLogType, auto_open_depth int) {
 	var window = g.CurrentWindow
 	IM_ASSERT(!g.LogEnabled)
 	IM_ASSERT(g.LogFile == nil)
@@ -27,24 +28,87 @@ func LogBegin(ltype ImGuiLogType, auto_open_depth int) {
 }
 
 // LogToBuffer Start logging/capturing to internal buffer
-func LogToBuffer(auto_open_depth int /*= -1*/) {
-	var g = GImGui
+func (g *ImGuiContext) LogToBuffer(auto_open_depth int /*= -1*/) {
 	if g.LogEnabled {
 		return
 	}
-	LogBegin(ImGuiLogType_Buffer, auto_open_depth)
+	g.LogBegin(ImGuiLogType_Buffer, auto_open_depth)
 }
 
 // LogRenderedText Internal version that takes a position to decide on newline placement and pad items according to their depth.
 // We split text into individual lines to add current tree level padding
 // FIXME: This code is a little complicated perhaps, considering simplifying the whole system.
-func LogRenderedText(ref_pos *ImVec2, text string) {
-	LogText(text)
+func (g *ImGuiContext) LogRenderedText(ref_pos *ImVec2, text string) {
+	var prefix = g.LogNextPrefix
+	var suffix = g.LogNextSuffix
+	g.LogNextPrefix = ""
+	g.LogNextSuffix = ""
+
+	if g.LogType == ImGuiLogType_Capture {
+		g.LogCaptureRenderedText(ref_pos, prefix, text, suffix)
+		return
+	}
+
+	var log_new_line = ref_pos != nil && (ref_pos.y > g.LogLinePosY+g.Style.FramePadding.y+1)
+	if ref_pos != nil {
+		g.LogLinePosY = ref_pos.y
+	}
+	if log_new_line {
+		g.LogText(IM_NEWLINE)
+		g.LogLineFirstItem = true
+	}
+
+	if prefix != "" {
+		g.logRenderedTextLines(prefix) // Skip FindRenderedTextEnd() to ensure "##" are included here.
+	}
+
+	g.logRenderedTextLines(text)
+
+	if suffix != "" {
+		g.logRenderedTextLines(suffix)
+	}
+}
+
+// logRenderedTextLines outputs text, with each new line (after a '\n') followed by indentation corresponding to the current depth of our log entry.
+// We don't add a trailing \n yet to allow a subsequent item on the same line to be captured.
+func (g *ImGuiContext) logRenderedTextLines(text string) {
+	var window = g.CurrentWindow
+
+	// Re-adjust padding if we have popped out of our starting depth
+	if g.LogDepthRef > window.DC.TreeDepth {
+		g.LogDepthRef = window.DC.TreeDepth
+	}
+	var tree_depth = window.DC.TreeDepth - g.LogDepthRef
+
+	var text_remaining = text
+	for {
+		// Split the string. Each new line (after a '\n') is followed by indentation corresponding to the current depth of our log entry.
+		var line_end = int(strings.IndexByte(text_remaining, '\n'))
+		var is_last_line = line_end < 0
+		if is_last_line {
+			line_end = int(len(text_remaining))
+		}
+		if line_end != 0 || !is_last_line {
+			var indentation int = 1
+			if g.LogLineFirstItem {
+				indentation = tree_depth * 4
+			}
+			g.LogText("%*s%s", indentation, "", text_remaining[:line_end])
+			g.LogLineFirstItem = false
+			if !is_last_line {
+				g.LogText(IM_NEWLINE)
+				g.LogLineFirstItem = true
+			}
+		}
+		if is_last_line {
+			break
+		}
+		text_remaining = text_remaining[line_end+1:]
+	}
 }
 
 // LogSetNextTextDecoration Important: doesn't copy underlying data, use carefully (prefix/suffix must be in scope at the time of the next LogRenderedText)
-func LogSetNextTextDecoration(prefix string, suffix string) {
-	var g = GImGui
+func (g *ImGuiContext) LogSetNextTextDecoration(prefix string, suffix string) {
 	g.LogNextPrefix = prefix
 	g.LogNextSuffix = suffix
 }
@@ -53,18 +117,16 @@ func LogSetNextTextDecoration(prefix string, suffix string) {
 // - All text output from the interface can be captured into tty/file/clipboard. By default, tree nodes are automatically opened during logging.
 
 // LogToTTY start logging to tty (stdout)
-func LogToTTY(auto_open_depth int /*= -1*/) {
-	var g = GImGui
+func (g *ImGuiContext) LogToTTY(auto_open_depth int /*= -1*/) {
 	if g.LogEnabled {
 		return
 	}
-	LogBegin(ImGuiLogType_TTY, auto_open_depth)
+	g.LogBegin(ImGuiLogType_TTY, auto_open_depth)
 	g.LogFile = os.Stdout
 }
 
 // LogToFile Start logging/capturing text output to given file
-func LogToFile(auto_open_depth int /*= 1*/, filename string) {
-	var g = GImGui
+func (g *ImGuiContext) LogToFile(auto_open_depth int /*= 1*/, filename string) {
 	if g.LogEnabled {
 		return
 	}
@@ -78,41 +140,54 @@ func LogToFile(auto_open_depth int /*= 1*/, filename string) {
 	if filename == "" || filename[0] == 0 {
 		return
 	}
-	var f = ImFileOpen(filename, "ab")
+	var f = g.ImFileOpen(filename, "ab")
 	if f == nil {
 		IM_ASSERT(false)
 		return
 	}
 
-	LogBegin(ImGuiLogType_File, auto_open_depth)
+	g.LogBegin(ImGuiLogType_File, auto_open_depth)
 	g.LogFile = f
 }
 
+// LogToWriter start logging/capturing text output to w, e.g. a strings.Builder to assert on the contents of a window.
+// The writer is not closed by LogFinish().
+func (g *ImGuiContext) LogToWriter(w io.Writer, auto_open_depth int /*= -1*/) {
+	if g.LogEnabled {
+		return
+	}
+	IM_ASSERT(w != nil)
+	g.LogBegin(ImGuiLogType_Writer, auto_open_depth)
+	g.LogWriter = w
+}
+
 // LogToClipboard start logging to OS clipboard
-func LogToClipboard(auto_open_depth int /*= -1*/) {
-	var g = GImGui
+func (g *ImGuiContext) LogToClipboard(auto_open_depth int /*= -1*/) {
 	if g.LogEnabled {
 		return
 	}
-	LogBegin(ImGuiLogType_Clipboard, auto_open_depth)
+	g.LogBegin(ImGuiLogType_Clipboard, auto_open_depth)
 }
 
-func LogFinish() {
-	var g = GImGui
+func (g *ImGuiContext) LogFinish() {
 	if !g.LogEnabled {
 		return
 	}
 
-	LogText(IM_NEWLINE)
+	g.LogText(IM_NEWLINE)
 	switch g.LogType {
 	case ImGuiLogType_TTY:
 		//g.LogFile
 	case ImGuiLogType_File:
 		ImFileClose(g.LogFile)
 	case ImGuiLogType_Buffer:
+	case ImGuiLogType_Writer:
+	case ImGuiLogType_Capture:
+		g.LogCaptureStack = g.LogCaptureStack[:0]
+		g.LogCaptureLabelTarget = nil
 	case ImGuiLogType_Clipboard:
 		if g.LogBuffer.Len() > 0 {
-			SetClipboardText(g.LogBuffer.String())
+			g.SetClipboardText(g.LogBuffer.String())
 		}
 	case ImGuiLogType_None:
 		IM_ASSERT(false)
@@ -121,47 +196,47 @@ func LogFinish() {
 	g.LogEnabled = false
 	g.LogType = ImGuiLogType_None
 	g.LogFile = nil
+	g.LogWriter = nil
 	g.LogBuffer.Reset()
 } // stop logging (close file, etc.)
 
 // LogButtons helper to display buttons for logging to tty/file/clipboard
-func LogButtons() {
-	var g = GImGui
-
-	PushString("LogButtons")
-	var log_to_tty = Button("Log To TTY")
-	SameLine(0, 0)
-	var log_to_file = Button("Log To File")
-	SameLine(0, 0)
-	var log_to_clipboard = Button("Log To Clipboard")
-	SameLine(0, 0)
-	PushAllowKeyboardFocus(false)
-	SetNextItemWidth(80.0)
-	SliderInt("Default Depth", &g.LogDepthToExpandDefault, 0, 9, "", 0)
-	PopAllowKeyboardFocus()
-	PopID()
+func (g *ImGuiContext) LogButtons() {
+	g.PushString("LogButtons")
+	var log_to_tty = g.Button("Log To TTY")
+	g.SameLine(0, 0)
+	var log_to_file = g.Button("Log To File")
+	g.SameLine(0, 0)
+	var log_to_clipboard = g.Button("Log To Clipboard")
+	g.SameLine(0, 0)
+	g.PushAllowKeyboardFocus(false)
+	g.SetNextItemWidth(80.0)
+	g.SliderInt("Default Depth", &g.LogDepthToExpandDefault, 0, 9, "", 0)
+	g.PopAllowKeyboardFocus()
+	g.PopID()
 
 	// Start logging at the end of the function so that the buttons don't appear in the log
 	if log_to_tty {
-		LogToTTY(-1)
+		g.LogToTTY(-1)
 	}
 	if log_to_file {
-		LogToFile(-1, "")
+		g.LogToFile(-1, "")
 	}
 	if log_to_clipboard {
-		LogToClipboard(-1)
+		g.LogToClipboard(-1)
 	}
 }
 
 // LogText pass text data straight to log (without being displayed)
-func LogText(format string, args ...any) {
-	var g = GImGui
+func (g *ImGuiContext) LogText(format string, args ...any) {
 	if !g.LogEnabled {
 		return
 	}
 
 	if g.LogFile != nil {
 		fmt.Fprintf(g.LogFile, format, args...)
+	} else if g.LogWriter != nil {
+		fmt.Fprintf(g.LogWriter, format, args...)
 	} else {
 		fmt.Fprintf(&g.LogBuffer, format, args...)
 	}
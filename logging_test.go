@@ -0,0 +1,34 @@
+package imgui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogToWriter(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+
+	var out strings.Builder
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.Begin("Log", nil, 0)
+		ui.LogToWriter(&out, -1)
+		ui.Text("Header")
+		ui.Text("a")
+		ui.SameLine(0, -1)
+		ui.Text("b")
+		ui.Button("Click")
+		if ui.TreeNode("Node") {
+			ui.Text("child\nsecond line")
+			ui.TreePop()
+		}
+		ui.Text("100%% done")
+		ui.LogFinish()
+		ui.End()
+	})
+
+	var want = "Header\na b\n[ Click ]\n> Node\n    child\n    second line\n100% done\n"
+	if out.String() != want {
+		t.Errorf("captured:\n%q\nwant:\n%q", out.String(), want)
+	}
+}
@@ -28,6 +28,14 @@ func (v ImVec2) Axis(axis ImGuiAxis) float {
 	return v.y
 }
 
+func (v *ImVec2) SetAxis(axis ImGuiAxis, f float) {
+	if axis == ImGuiAxis_X {
+		v.x = f
+	} else {
+		v.y = f
+	}
+}
+
 func (v ImVec2) Add(b ImVec2) ImVec2 {
 	return ImVec2{v.x + b.x, v.y + b.y}
 }
@@ -282,6 +290,16 @@ func ImClampUint64(v, mn, mx uint64) uint64 {
 	return v
 }
 
+func ImClampT[T ImGuiScalar](v, mn, mx T) T {
+	if v < mn {
+		return mn
+	}
+	if v > mx {
+		return mx
+	}
+	return v
+}
+
 func ImLerp(a, b, t float) float { return a + (b-a)*t }
 func ImSwap(a, b float)          { a, b = b, a }
 
@@ -2,9 +2,8 @@ package imgui
 
 // Miscellaneous Utilities
 
-func GetViewportDrawList(viewport *ImGuiViewportP, drawlist_no size_t, drawlist_name string) *ImDrawList {
+func (g *ImGuiContext) GetViewportDrawList(viewport *ImGuiViewportP, drawlist_no size_t, drawlist_name string) *ImDrawList {
 	// Create the draw list on demand, because they are not frequently used for all viewports
-	var g = GImGui
 	IM_ASSERT(drawlist_no < size_t(len(viewport.DrawLists)))
 	var draw_list = viewport.DrawLists[drawlist_no]
 	if draw_list == nil {
@@ -25,46 +24,44 @@ func GetViewportDrawList(viewport *ImGuiViewportP, drawlist_no size_t, drawlist_
 }
 
 // test if rectangle (of given size, starting from cursor position) is visible / not clipped.
-func IsRectVisible(size ImVec2) bool {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) IsRectVisible(size ImVec2) bool {
+	var window = g.CurrentWindow
 	return window.ClipRect.Overlaps(ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(size)})
 }
 
 // test if rectangle (in screen space) is visible / not clipped. to perform coarse clipping on user's side.
-func IsRectVisibleMinMax(rect_min, rect_max ImVec2) bool {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) IsRectVisibleMinMax(rect_min, rect_max ImVec2) bool {
+	var window = g.CurrentWindow
 	return window.ClipRect.Overlaps(ImRect{rect_min, rect_max})
 }
 
-func GetTime() double    { return GImGui.Time }       // get global imgui time. incremented by io.DeltaTime every frame.
-func GetFrameCount() int { return GImGui.FrameCount } // get global imgui frame count. incremented by 1 every frame.
+func (g *ImGuiContext) GetTime() double    { return g.Time }       // get global imgui time. incremented by io.DeltaTime every frame.
+func (g *ImGuiContext) GetFrameCount() int { return g.FrameCount } // get global imgui frame count. incremented by 1 every frame.
 
 // this draw list will be the first rendering one. Useful to quickly draw shapes/text behind dear imgui contents.
-func GetBackgroundDrawList(viewport *ImGuiViewport) *ImDrawList {
-	var g = GImGui
+func (g *ImGuiContext) GetBackgroundDrawList(viewport *ImGuiViewport) *ImDrawList {
 	if viewport == nil {
 		viewport = g.Viewports[0]
 	}
-	return GetViewportDrawList(viewport, 0, "##Background")
+	return g.GetViewportDrawList(viewport, 0, "##Background")
 }
 
 // this draw list will be the last rendered one. Useful to quickly draw shapes/text over dear imgui contents.
-func GetForegroundDrawList(viewport *ImGuiViewport) *ImDrawList {
-	var g = GImGui
+func (g *ImGuiContext) GetForegroundDrawList(viewport *ImGuiViewport) *ImDrawList {
 	if viewport == nil {
 		viewport = g.Viewports[0]
 	}
-	return GetViewportDrawList(viewport, 1, "##Foreground")
+	return g.GetViewportDrawList(viewport, 1, "##Foreground")
 }
 
 // you may use this when creating your own ImDrawList instances.
-func GetDrawListSharedData() *ImDrawListSharedData {
-	return &GImGui.DrawListSharedData
+func (g *ImGuiContext) GetDrawListSharedData() *ImDrawListSharedData {
+	return &g.DrawListSharedData
 }
 
 // replace current window storage with our own (if you want to manipulate it yourself, typically clear subsection of it)
-func SetStateStorage(storage *ImGuiStorage) {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) SetStateStorage(storage *ImGuiStorage) {
+	var window = g.CurrentWindow
 	if storage != nil {
 		window.DC.StateStorage = *storage
 	} else {
@@ -72,7 +69,7 @@ func SetStateStorage(storage *ImGuiStorage) {
 	}
 }
 
-func GetStateStorage() ImGuiStorage {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetStateStorage() ImGuiStorage {
+	var window = g.CurrentWindow
 	return window.DC.StateStorage
 }
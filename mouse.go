@@ -8,29 +8,25 @@ const WINDOWS_MOUSE_WHEEL_SCROLL_LOCK_TIMER float = 2.00 // Lock scrolled window
 // - Dragging operations are only reported after mouse has moved a certain distance away from the initial clicking position (see 'lock_threshold' and 'io.MouseDraggingThreshold')
 
 // IsMouseDown is mouse button held?
-func IsMouseDown(button ImGuiMouseButton) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsMouseDown(button ImGuiMouseButton) bool {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	return g.IO.MouseDown[button]
 }
 
 // IsMouseReleased did mouse button released? (went from Down to !Down)
-func IsMouseReleased(button ImGuiMouseButton) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsMouseReleased(button ImGuiMouseButton) bool {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	return g.IO.MouseReleased[button]
 }
 
 // IsMouseDoubleClicked did mouse button double-clicked? (note that a double-click will also report IsMouseClicked() == true)
-func IsMouseDoubleClicked(button ImGuiMouseButton) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsMouseDoubleClicked(button ImGuiMouseButton) bool {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	return g.IO.MouseDoubleClicked[button]
 }
 
 // IsAnyMouseDown is any mouse button held?
-func IsAnyMouseDown() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsAnyMouseDown() bool {
 	for n := range g.IO.MouseDown {
 		if g.IO.MouseDown[n] {
 			return true
@@ -40,14 +36,12 @@ func IsAnyMouseDown() bool {
 }
 
 // GetMousePos shortcut to ImGui::GetIO().MousePos provided by user, to be consistent with other calls
-func GetMousePos() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetMousePos() ImVec2 {
 	return g.IO.MousePos
 }
 
 // GetMousePosOnOpeningCurrentPopup retrieve mouse position at the time of opening popup we have BeginPopup() into (helper to a user backing that value themselves)
-func GetMousePosOnOpeningCurrentPopup() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetMousePosOnOpeningCurrentPopup() ImVec2 {
 	if len(g.BeginPopupStack) > 0 {
 		return g.OpenPopupStack[len(g.BeginPopupStack)-1].OpenMousePos
 	}
@@ -58,15 +52,14 @@ func GetMousePosOnOpeningCurrentPopup() ImVec2 {
 // This is locked and return 0.0f until the mouse moves past a distance threshold at least once.
 // NB: This is only valid if IsMousePosValid(). backends in theory should always keep mouse position valid when dragging even outside the client window.
 // return the delta from the initial clicking position while the mouse button is pressed or was just released. This is locked and return 0.0 until the mouse moves past a distance threshold at least once (if lock_threshold < -1.0, uses io.MouseDraggingThreshold)
-func GetMouseDragDelta(button ImGuiMouseButton /*= 0*/, lock_threshold float /*= -1.0*/) ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetMouseDragDelta(button ImGuiMouseButton /*= 0*/, lock_threshold float /*= -1.0*/) ImVec2 {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	if lock_threshold < 0.0 {
 		lock_threshold = g.IO.MouseDragThreshold
 	}
 	if g.IO.MouseDown[button] || g.IO.MouseReleased[button] {
 		if g.IO.MouseDragMaxDistanceSqr[button] >= lock_threshold*lock_threshold {
-			if IsMousePosValid(&g.IO.MousePos) && IsMousePosValid(&g.IO.MouseClickedPos[button]) {
+			if g.IsMousePosValid(&g.IO.MousePos) && g.IsMousePosValid(&g.IO.MouseClickedPos[button]) {
 				return g.IO.MousePos.Sub(g.IO.MouseClickedPos[button])
 			}
 		}
@@ -74,45 +67,43 @@ func GetMouseDragDelta(button ImGuiMouseButton /*= 0*/, lock_threshold float /*=
 	return ImVec2{0.0, 0.0}
 }
 
-func ResetMouseDragDelta(button ImGuiMouseButton) {
-	var g = GImGui
+func (g *ImGuiContext) ResetMouseDragDelta(button ImGuiMouseButton) {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	// NB: We don't need to reset g.IO.MouseDragMaxDistanceSqr
 	g.IO.MouseClickedPos[button] = g.IO.MousePos
 }
 
 // GetMouseCursor get desired cursor type, reset in ImGui::NewFrame(), this is updated during the frame. valid before Render(). If you use software rendering by setting io.MouseDrawCursor ImGui will render those for you
-func GetMouseCursor() ImGuiMouseCursor {
-	return GImGui.MouseCursor
+func (g *ImGuiContext) GetMouseCursor() ImGuiMouseCursor {
+	return g.MouseCursor
 }
 
 // CaptureMouseFromApp attention: misleading name! manually override io.WantCaptureMouse flag next frame (said flag is entirely left for your application to handle). This is equivalent to setting "io.WantCaptureMouse = want_capture_mouse_value  {panic("not implemented")}" after the next NewFrame() call.
-func CaptureMouseFromApp(want_capture_mouse_value bool /*= true*/) {
+func (g *ImGuiContext) CaptureMouseFromApp(want_capture_mouse_value bool /*= true*/) {
 	if want_capture_mouse_value {
-		GImGui.WantCaptureMouseNextFrame = 1
+		g.WantCaptureMouseNextFrame = 1
 	} else {
-		GImGui.WantCaptureMouseNextFrame = 0
+		g.WantCaptureMouseNextFrame = 0
 	}
 }
 
 // IsMousePosValid We typically use ImVec2(-FLT_MAX,-FLT_MAX) to denote an invalid mouse position.
-func IsMousePosValid(mouse_pos *ImVec2) bool {
+func (g *ImGuiContext) IsMousePosValid(mouse_pos *ImVec2) bool {
 	// The assert is only to silence a false-positive in XCode Static Analysis.
 	// Because GImGui is not dereferenced in every code path, the static analyzer assume that it may be nil (which it doesn't for other functions).
-	IM_ASSERT(GImGui != nil)
+	IM_ASSERT(g != nil)
 	var MOUSE_INVALID float = -256000.0
 	var p ImVec2
 	if mouse_pos != nil {
 		p = *mouse_pos
 	} else {
-		p = GImGui.IO.MousePos
+		p = g.IO.MousePos
 	}
 	return p.x >= MOUSE_INVALID && p.y >= MOUSE_INVALID
 }
 
 // IsMouseClicked did mouse button clicked? (went from !Down to Down)
-func IsMouseClicked(button ImGuiMouseButton, repeat bool) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsMouseClicked(button ImGuiMouseButton, repeat bool) bool {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	var t = g.IO.MouseDownDuration[button]
 	if t == 0.0 {
@@ -130,12 +121,11 @@ func IsMouseClicked(button ImGuiMouseButton, repeat bool) bool {
 }
 
 // SetMouseCursor set desired cursor type
-func SetMouseCursor(cursor_type ImGuiMouseCursor) {
-	GImGui.MouseCursor = cursor_type
+func (g *ImGuiContext) SetMouseCursor(cursor_type ImGuiMouseCursor) {
+	g.MouseCursor = cursor_type
 }
 
-func StartLockWheelingWindow(window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) StartLockWheelingWindow(window *ImGuiWindow) {
 	if g.WheelingWindow == window {
 		return
 	}
@@ -145,19 +135,17 @@ func StartLockWheelingWindow(window *ImGuiWindow) {
 }
 
 // IsMouseDragging is mouse dragging? (if lock_threshold < -1.0, uses io.MouseDraggingThreshold)
-func IsMouseDragging(button ImGuiMouseButton, lock_threshold float /*= -1.0*/) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsMouseDragging(button ImGuiMouseButton, lock_threshold float /*= -1.0*/) bool {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	if !g.IO.MouseDown[button] {
 		return false
 	}
-	return IsMouseDragPastThreshold(button, lock_threshold)
+	return g.IsMouseDragPastThreshold(button, lock_threshold)
 }
 
 // IsMouseDragPastThreshold Return if a mouse click/drag went past the given threshold. Valid to call during the MouseReleased frame.
 // [Internal] This doesn't test if the button is pressed
-func IsMouseDragPastThreshold(button ImGuiMouseButton, lock_threshold float /*= -1.0f*/) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsMouseDragPastThreshold(button ImGuiMouseButton, lock_threshold float /*= -1.0f*/) bool {
 	IM_ASSERT(button >= 0 && button < ImGuiMouseButton(len(g.IO.MouseDown)))
 	if lock_threshold < 0.0 {
 		lock_threshold = g.IO.MouseDragThreshold
@@ -165,20 +153,19 @@ func IsMouseDragPastThreshold(button ImGuiMouseButton, lock_threshold float /*=
 	return g.IO.MouseDragMaxDistanceSqr[button] >= lock_threshold*lock_threshold
 }
 
-func StartMouseMovingWindow(window *ImGuiWindow) {
+func (g *ImGuiContext) StartMouseMovingWindow(window *ImGuiWindow) {
 	// Set ActiveId even if the _NoMove flag is set. Without it, dragging away from a window with _NoMove would activate hover on other windows.
 	// We _also_ call this when clicking in a window empty space when io.ConfigWindowsMoveFromTitleBarOnly is set, but clear g.MovingWindow afterward.
 	// This is because we want ActiveId to be set even when the window is not permitted to move.
-	var g = GImGui
-	FocusWindow(window)
-	SetActiveID(window.MoveId, window)
+	g.FocusWindow(window)
+	g.SetActiveID(window.MoveId, window)
 	g.NavDisableHighlight = true
-	g.ActiveIdClickOffset = g.IO.MouseClickedPos[0].Sub(window.RootWindow.Pos)
+	g.ActiveIdClickOffset = g.IO.MouseClickedPos[0].Sub(window.RootWindowDockTree.Pos)
 	g.ActiveIdNoClearOnFocusLoss = true
-	SetActiveIdUsingNavAndKeys()
+	g.SetActiveIdUsingNavAndKeys()
 
 	var can_move_window = true
-	if (window.Flags&ImGuiWindowFlags_NoMove != 0) || (window.RootWindow.Flags&ImGuiWindowFlags_NoMove != 0) {
+	if (window.Flags&ImGuiWindowFlags_NoMove != 0) || (window.RootWindowDockTree.Flags&ImGuiWindowFlags_NoMove != 0) {
 		can_move_window = false
 	}
 	if can_move_window {
@@ -190,9 +177,7 @@ func StartMouseMovingWindow(window *ImGuiWindow) {
 // NB- Rectangle is clipped by our current clip setting
 // NB- Expand the rectangle to be generous on imprecise inputs systems (g.Style.TouchExtraPadding)
 // is mouse hovering given bounding rect (in screen space). clipped by current clipping settings, but disregarding of other consideration of focus/window ordering/popup-block.
-func IsMouseHoveringRect(r_min, r_max ImVec2, clip bool /*= true*/) bool {
-	var g = GImGui
-
+func (g *ImGuiContext) IsMouseHoveringRect(r_min, r_max ImVec2, clip bool /*= true*/) bool {
 	// Clip
 	var rect_clipped = ImRect{r_min, r_max}
 	if clip {
@@ -204,10 +189,9 @@ func IsMouseHoveringRect(r_min, r_max ImVec2, clip bool /*= true*/) bool {
 	return rect_for_touch.ContainsVec(g.IO.MousePos)
 }
 
-func IsWindowContentHoverable(window *ImGuiWindow, flags ImGuiHoveredFlags) bool {
+func (g *ImGuiContext) IsWindowContentHoverable(window *ImGuiWindow, flags ImGuiHoveredFlags) bool {
 	// An active popup disable hovering on other windows (apart from its own children)
 	// FIXME-OPT: This could be cached/stored within the window.
-	var g = GImGui
 	if g.NavWindow != nil {
 		if focused_root_window := g.NavWindow.RootWindow; focused_root_window != nil {
 			if focused_root_window.WasActive && focused_root_window != window.RootWindow {
@@ -225,8 +209,7 @@ func IsWindowContentHoverable(window *ImGuiWindow, flags ImGuiHoveredFlags) bool
 	return true
 }
 
-func UpdateMouseMovingWindowEndFrame() {
-	var g = GImGui
+func (g *ImGuiContext) UpdateMouseMovingWindowEndFrame() {
 	if g.ActiveId != 0 || g.HoveredId != 0 {
 		return
 	}
@@ -245,10 +228,10 @@ func UpdateMouseMovingWindowEndFrame() {
 		if g.HoveredWindow != nil {
 			root_window = g.HoveredWindow.RootWindow
 		}
-		var is_closed_popup = root_window != nil && (root_window.Flags&ImGuiWindowFlags_Popup != 0) && !IsPopupOpenID(root_window.PopupId, ImGuiPopupFlags_AnyPopupLevel)
+		var is_closed_popup = root_window != nil && (root_window.Flags&ImGuiWindowFlags_Popup != 0) && !g.IsPopupOpenID(root_window.PopupId, ImGuiPopupFlags_AnyPopupLevel)
 
 		if root_window != nil && !is_closed_popup {
-			StartMouseMovingWindow(g.HoveredWindow) //-V595
+			g.StartMouseMovingWindow(g.HoveredWindow) //-V595
 
 			// Cancel moving if clicked outside of title bar
 			if g.IO.ConfigWindowsMoveFromTitleBarOnly && root_window.Flags&ImGuiWindowFlags_NoTitleBar == 0 {
@@ -262,9 +245,9 @@ func UpdateMouseMovingWindowEndFrame() {
 			if g.HoveredIdDisabled {
 				g.MovingWindow = nil
 			}
-		} else if root_window == nil && g.NavWindow != nil && GetTopMostPopupModal() == nil {
+		} else if root_window == nil && g.NavWindow != nil && g.GetTopMostPopupModal() == nil {
 			// Clicking on void disable focus
-			FocusWindow(nil)
+			g.FocusWindow(nil)
 		}
 	}
 
@@ -274,25 +257,23 @@ func UpdateMouseMovingWindowEndFrame() {
 	if g.IO.MouseClicked[1] {
 		// Find the top-most window between HoveredWindow and the top-most Modal Window.
 		// This is where we can trim the popup stack.
-		var modal = GetTopMostPopupModal()
-		var hovered_window_above_modal = g.HoveredWindow != nil && IsWindowAbove(g.HoveredWindow, modal)
+		var modal = g.GetTopMostPopupModal()
+		var hovered_window_above_modal = g.HoveredWindow != nil && g.IsWindowAbove(g.HoveredWindow, modal)
 
 		win := modal
 		if hovered_window_above_modal {
 			win = g.HoveredWindow
 		}
 
-		ClosePopupsOverWindow(win, true)
+		g.ClosePopupsOverWindow(win, true)
 	}
 }
 
-func UpdateMouseWheel() {
-	var g = GImGui
-
+func (g *ImGuiContext) UpdateMouseWheel() {
 	// Reset the locked window if we move the mouse or after the timer elapses
 	if g.WheelingWindow != nil {
 		g.WheelingWindowTimer -= g.IO.DeltaTime
-		if IsMousePosValid(nil) && ImLengthSqrVec2(g.IO.MousePos.Sub(g.WheelingWindowRefMousePos)) > g.IO.MouseDragThreshold*g.IO.MouseDragThreshold {
+		if g.IsMousePosValid(nil) && ImLengthSqrVec2(g.IO.MousePos.Sub(g.WheelingWindowRefMousePos)) > g.IO.MouseDragThreshold*g.IO.MouseDragThreshold {
 			g.WheelingWindowTimer = 0.0
 		}
 		if g.WheelingWindowTimer <= 0.0 {
@@ -322,7 +303,7 @@ func UpdateMouseWheel() {
 	// Zoom / Scale window
 	// FIXME-OBSOLETE: This is an old feature, it still works but pretty much nobody is using it and may be best redesigned.
 	if g.IO.MouseWheel != 0.0 && g.IO.KeyCtrl && g.IO.FontAllowUserScaling {
-		StartLockWheelingWindow(window)
+		g.StartLockWheelingWindow(window)
 		var new_font_scale = ImClamp(window.FontWindowScale+g.IO.MouseWheel*0.10, 0.50, 2.50)
 		var scale = new_font_scale / window.FontWindowScale
 		window.FontWindowScale = new_font_scale
@@ -361,7 +342,7 @@ func UpdateMouseWheel() {
 
 	// Vertical Mouse Wheel scrolling
 	if wheel_y != 0.0 {
-		StartLockWheelingWindow(window)
+		g.StartLockWheelingWindow(window)
 		for (window.Flags&ImGuiWindowFlags_ChildWindow != 0) && ((window.ScrollMax.y == 0.0) || ((window.Flags&ImGuiWindowFlags_NoScrollWithMouse != 0) && (window.Flags&ImGuiWindowFlags_NoMouseInputs == 0))) {
 			window = window.ParentWindow
 		}
@@ -374,7 +355,7 @@ func UpdateMouseWheel() {
 
 	// Horizontal Mouse Wheel scrolling, or Vertical Mouse Wheel w/ Shift held
 	if wheel_x != 0.0 {
-		StartLockWheelingWindow(window)
+		g.StartLockWheelingWindow(window)
 		for (window.Flags&ImGuiWindowFlags_ChildWindow != 0) && ((window.ScrollMax.x == 0.0) || ((window.Flags&ImGuiWindowFlags_NoScrollWithMouse != 0) && (window.Flags&ImGuiWindowFlags_NoMouseInputs == 0))) {
 			window = window.ParentWindow
 		}
@@ -386,17 +367,15 @@ func UpdateMouseWheel() {
 	}
 }
 
-func UpdateMouseInputs() {
-	var g = GImGui
-
+func (g *ImGuiContext) UpdateMouseInputs() {
 	// Round mouse position to avoid spreading non-rounded position (e.g. UpdateManualResize doesn't support them well)
-	if IsMousePosValid(&g.IO.MousePos) {
+	if g.IsMousePosValid(&g.IO.MousePos) {
 		g.IO.MousePos = *ImFloorVec(&g.IO.MousePos)
 		g.MouseLastValidPos = *ImFloorVec(&g.IO.MousePos)
 	}
 
 	// If mouse just appeared or disappeared (usually denoted by -FLT_MAX components) we cancel out movement in MouseDelta
-	if IsMousePosValid(&g.IO.MousePos) && IsMousePosValid(&g.IO.MousePosPrev) {
+	if g.IsMousePosValid(&g.IO.MousePos) && g.IsMousePosValid(&g.IO.MousePosPrev) {
 		g.IO.MouseDelta = g.IO.MousePos.Sub(g.IO.MousePosPrev)
 	} else {
 		g.IO.MouseDelta = ImVec2{}
@@ -425,7 +404,7 @@ func UpdateMouseInputs() {
 		if g.IO.MouseClicked[i] {
 			if (float)(g.Time-g.IO.MouseClickedTime[i]) < g.IO.MouseDoubleClickTime {
 				var delta_from_click_pos ImVec2
-				if IsMousePosValid(&g.IO.MousePos) {
+				if g.IsMousePosValid(&g.IO.MousePos) {
 					delta_from_click_pos = (g.IO.MousePos.Sub(g.IO.MouseClickedPos[i]))
 				}
 				if ImLengthSqrVec2(delta_from_click_pos) < g.IO.MouseDoubleClickMaxDist*g.IO.MouseDoubleClickMaxDist {
@@ -442,7 +421,7 @@ func UpdateMouseInputs() {
 		} else if g.IO.MouseDown[i] {
 			// Maintain the maximum distance we reaching from the initial click position, which is used with dragging threshold
 			var delta_from_click_pos ImVec2
-			if IsMousePosValid(&g.IO.MousePos) {
+			if g.IsMousePosValid(&g.IO.MousePos) {
 				delta_from_click_pos = (g.IO.MousePos.Sub(g.IO.MouseClickedPos[i]))
 			}
 			g.IO.MouseDragMaxDistanceSqr[i] = ImMax(g.IO.MouseDragMaxDistanceSqr[i], ImLengthSqrVec2(delta_from_click_pos))
@@ -0,0 +1,222 @@
+package imgui
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMultiSelect(t *testing.T) {
+	var ctx = newTestContext(func(io *ImGuiIO) {
+		io.ConfigFlags |= ImGuiConfigFlags_NavEnableKeyboard
+	})
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	const items_count = 1000000
+	var (
+		selection ImGuiSelectionBasicStorage
+		rects     = map[int]ImRect{}
+		submitted int
+		toggled   []int
+
+		scroll_to_bottom bool
+	)
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{300, 300}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			var ms_io = ui.BeginMultiSelect(ImGuiMultiSelectFlags_ClearOnEscape|ImGuiMultiSelectFlags_BoxSelect1d, selection.Size, items_count)
+			selection.ApplyRequests(ms_io)
+			var clipper = NewImGuiListClipper()
+			clipper.Begin(items_count, -1)
+			if ms_io.RangeSrcItem != ImGuiSelectionUserData_Invalid {
+				clipper.IncludeItemByIndex(int(ms_io.RangeSrcItem))
+			}
+			submitted = 0
+			toggled = toggled[:0]
+			for clipper.Step() {
+				for n := clipper.DisplayStart; n < clipper.DisplayEnd; n++ {
+					ui.SetNextItemSelectionUserData(ImGuiSelectionUserData(n))
+					ui.Selectable(fmt.Sprintf("Item %d", n), selection.Contains(ImGuiID(n)), 0, ImVec2{})
+					rects[int(n)] = ImRect{GetItemRectMin(), GetItemRectMax()}
+					if ui.IsItemToggledSelection() {
+						toggled = append(toggled, int(n))
+					}
+					submitted++
+				}
+			}
+			ms_io = ui.EndMultiSelect()
+			selection.ApplyRequests(ms_io)
+			if scroll_to_bottom {
+				ui.SetScrollY(ui.GetScrollMaxY())
+				scroll_to_bottom = false
+			}
+			ui.End()
+		})
+	}
+	var center = func(n int) ImVec2 {
+		var r = rects[n]
+		return r.GetCenter()
+	}
+	var click = func(n int, mods ImGuiKeyModFlags) {
+		var pos = center(n)
+		io.AddKeyModsEvent(mods)
+		io.AddMousePosEvent(pos.x, pos.y)
+		frame()
+		io.AddMouseButtonEvent(0, true)
+		frame()
+		io.AddMouseButtonEvent(0, false)
+		frame()
+		io.AddKeyModsEvent(ImGuiKeyModFlags_None)
+		frame()
+	}
+	var press = func(mods ImGuiKeyModFlags, key ImGuiKey) {
+		io.AddKeyModsEvent(mods)
+		io.AddKeyEvent(key, true)
+		frame()
+		io.AddKeyEvent(key, false)
+		io.AddKeyModsEvent(ImGuiKeyModFlags_None)
+		frame()
+	}
+	var check = func(what string, want ...ImGuiID) {
+		t.Helper()
+		if got := selection.GetSelectedItems(); !reflect.DeepEqual(got, want) && !(len(got) == 0 && len(want) == 0) {
+			if len(got) > 10 {
+				got = got[:10]
+			}
+			t.Errorf("%s: selection is %v (%d items), want %v", what, got, selection.Size, want)
+		}
+	}
+
+	frame()
+	frame()
+	if submitted == 0 || submitted > 50 {
+		t.Fatalf("submitted %d items out of %d, want only the visible ones", submitted, items_count)
+	}
+
+	click(2, 0)
+	check("click", 2)
+	click(5, ImGuiKeyModFlags_Ctrl)
+	check("ctrl+click", 2, 5)
+	click(2, ImGuiKeyModFlags_Ctrl)
+	check("ctrl+click on a selected item", 5)
+	click(5, 0)
+	click(8, ImGuiKeyModFlags_Shift)
+	check("shift+click", 5, 6, 7, 8)
+	click(3, ImGuiKeyModFlags_Shift)
+	check("shift+click backward", 3, 4, 5)
+	click(1, 0)
+	check("click after a range", 1)
+
+	// Keyboard navigation moves the selection, Shift extends it from the last clicked item.
+	press(0, ImGuiKey_DownArrow)
+	check("down", 2)
+	press(ImGuiKeyModFlags_Shift, ImGuiKey_DownArrow)
+	press(ImGuiKeyModFlags_Shift, ImGuiKey_DownArrow)
+	check("shift+down", 2, 3, 4)
+
+	press(ImGuiKeyModFlags_Ctrl, ImGuiKey_A)
+	if selection.Size != items_count {
+		t.Errorf("ctrl+a selected %d items, want %d", selection.Size, items_count)
+	}
+	press(0, ImGuiKey_Escape)
+	check("escape")
+
+	// The range source item is kept submitted by the clipper once scrolled away, so a shift+click reaches it.
+	click(0, 0)
+	scroll_to_bottom = true
+	frame()
+	rects = map[int]ImRect{}
+	frame()
+	if _, ok := rects[0]; !ok {
+		t.Fatal("range source item was clipped")
+	}
+	var last int
+	for n := range rects {
+		last = ImMaxInt(last, n)
+	}
+	if last < items_count/2 {
+		t.Fatalf("last submitted item is %d after scrolling to the bottom", last)
+	}
+	click(last, ImGuiKeyModFlags_Shift)
+	if selection.Size != last+1 {
+		t.Errorf("shift+click to the end of the list selected %d items, want %d", selection.Size, last+1)
+	}
+	press(0, ImGuiKey_Escape)
+}
+
+func TestMultiSelectBoxSelect(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	const items_count = 10
+	var (
+		selection ImGuiSelectionBasicStorage
+		rects     [items_count]ImRect
+	)
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{300, 400}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			var ms_io = ui.BeginMultiSelect(ImGuiMultiSelectFlags_BoxSelect1d|ImGuiMultiSelectFlags_ClearOnClickVoid, selection.Size, items_count)
+			selection.ApplyRequests(ms_io)
+			for n := 0; n < items_count; n++ {
+				ui.SetNextItemSelectionUserData(ImGuiSelectionUserData(n))
+				ui.Selectable(fmt.Sprintf("Item %d", n), selection.Contains(ImGuiID(n)), 0, ImVec2{})
+				rects[n] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			}
+			ms_io = ui.EndMultiSelect()
+			selection.ApplyRequests(ms_io)
+			ui.End()
+		})
+	}
+	var move = func(pos ImVec2) {
+		io.AddMousePosEvent(pos.x, pos.y)
+		frame()
+	}
+
+	frame()
+	frame()
+	selection.SetItemSelected(0, true)
+
+	// Drag from the empty space below the items up to the middle of the list.
+	var void = ImVec2{100, rects[items_count-1].Max.y + 40}
+	var target = rects[6].GetCenter()
+	move(void)
+	io.AddMouseButtonEvent(0, true)
+	frame()
+	for i := 1; i <= 4; i++ {
+		var f = float(i) / 4
+		move(ImVec2{void.x, void.y + (target.y-void.y)*f})
+	}
+	var want = []ImGuiID{6, 7, 8, 9}
+	if got := selection.GetSelectedItems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("box-select selected %v, want %v", got, want)
+	}
+	if pos := FindWindowByName("Window").Pos; pos != (ImVec2{10, 10}) {
+		t.Errorf("box-select moved the window to %v", pos)
+	}
+
+	// Moving back unselects the items the box left.
+	move(rects[8].GetCenter())
+	want = []ImGuiID{8, 9}
+	if got := selection.GetSelectedItems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("shrinking the box left %v selected, want %v", got, want)
+	}
+	io.AddMouseButtonEvent(0, false)
+	frame()
+
+	// A click in the void clears the selection.
+	move(void)
+	io.AddMouseButtonEvent(0, true)
+	frame()
+	io.AddMouseButtonEvent(0, false)
+	frame()
+	if selection.Size != 0 {
+		t.Errorf("click in the void left %d items selected", selection.Size)
+	}
+}
@@ -5,8 +5,7 @@ const NAV_WINDOWING_LIST_APPEAR_DELAY float = 0.15 // Time before the window lis
 
 const NAV_WINDOWING_HIGHLIGHT_DELAY float = 0.20 // Time before the highlight and screen dimming starts fading in
 
-func FindWindowNavFocusable(i_start, i_stop, dir int) *ImGuiWindow { // FIXME-OPT O(N)
-	var g = GImGui
+func (g *ImGuiContext) FindWindowNavFocusable(i_start, i_stop, dir int) *ImGuiWindow { // FIXME-OPT O(N)
 	for i := i_start; i >= 0 && i < int(len(g.WindowsFocusOrder)) && i != i_stop; i += dir {
 		if IsWindowNavFocusable(g.WindowsFocusOrder[i]) {
 			return g.WindowsFocusOrder[i]
@@ -16,10 +15,9 @@ func FindWindowNavFocusable(i_start, i_stop, dir int) *ImGuiWindow { // FIXME-OP
 }
 
 // NavMoveRequestForward Forward will reuse the move request again on the next frame (generally with modifications done to it)
-func NavMoveRequestForward(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGuiNavMoveFlags) {
-	var g = GImGui
+func (g *ImGuiContext) NavMoveRequestForward(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGuiNavMoveFlags) {
 	IM_ASSERT(!g.NavMoveForwardToNextFrame)
-	NavMoveRequestCancel()
+	g.NavMoveRequestCancel()
 	g.NavMoveForwardToNextFrame = true
 	g.NavMoveDir = move_dir
 	g.NavMoveClipDir = clip_dir
@@ -28,8 +26,7 @@ func NavMoveRequestForward(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGu
 
 // NavMoveRequestTryWrapping Navigation wrap-around logic is delayed to the end of the frame because this operation is only valid after entire
 // popup is assembled and in case of appended popups it is not clear which EndPopup() call is final.
-func NavMoveRequestTryWrapping(window *ImGuiWindow, move_flags ImGuiNavMoveFlags) {
-	var g = GImGui
+func (g *ImGuiContext) NavMoveRequestTryWrapping(window *ImGuiWindow, move_flags ImGuiNavMoveFlags) {
 	IM_ASSERT(move_flags != 0) // Call with _WrapX, _WrapY, _LoopX, _LoopY
 	// In theory we should test for NavMoveRequestButNoResultYet() but there's no point doing it, NavEndFrame() will do the same test
 	if g.NavWindow == window && g.NavMoveScoringItems && g.NavLayer == ImGuiNavLayer_Main {
@@ -39,8 +36,7 @@ func NavMoveRequestTryWrapping(window *ImGuiWindow, move_flags ImGuiNavMoveFlags
 
 // SetNavID FIXME-NAV: The existence of SetNavID vs SetFocusID properly needs to be clarified/reworked.
 // In our terminology those should be interchangeable. Those two functions are merely a legacy artifact, so at minimum naming should be clarified.
-func SetNavID(id ImGuiID, nav_layer ImGuiNavLayer, focus_scope_id ImGuiID, rect_rel *ImRect) {
-	var g = GImGui
+func (g *ImGuiContext) SetNavID(id ImGuiID, nav_layer ImGuiNavLayer, focus_scope_id ImGuiID, rect_rel *ImRect) {
 	IM_ASSERT(g.NavWindow != nil)
 	IM_ASSERT(nav_layer == ImGuiNavLayer_Main || nav_layer == ImGuiNavLayer_Menu)
 	g.NavId = id
@@ -52,8 +48,7 @@ func SetNavID(id ImGuiID, nav_layer ImGuiNavLayer, focus_scope_id ImGuiID, rect_
 	//g.NavDisableMouseHover = g.NavMousePosDirty = true;
 }
 
-func NavUpdateAnyRequestFlag() {
-	var g = GImGui
+func (g *ImGuiContext) NavUpdateAnyRequestFlag() {
 	g.NavAnyRequest = g.NavMoveScoringItems || g.NavInitRequest
 	if g.NavAnyRequest {
 		IM_ASSERT(g.NavWindow != nil)
@@ -69,8 +64,7 @@ func NavRestoreLastChildNavWindow(window *ImGuiWindow) *ImGuiWindow {
 	return window
 }
 
-func GetNavInputAmount(n ImGuiNavInput, mode ImGuiInputReadMode) float {
-	var g = GImGui
+func (g *ImGuiContext) GetNavInputAmount(n ImGuiNavInput, mode ImGuiInputReadMode) float {
 	if mode == ImGuiInputReadMode_Down {
 		return g.IO.NavInputs[n] // Instant, read analog input (0.0f..1.0f, as provided by user)
 	}
@@ -107,24 +101,22 @@ func GetNavInputAmount(n ImGuiNavInput, mode ImGuiInputReadMode) float {
 }
 
 // NavMoveRequestButNoResultYet Gamepad/Keyboard Navigation
-func NavMoveRequestButNoResultYet() bool {
-	var g = GImGui
+func (g *ImGuiContext) NavMoveRequestButNoResultYet() bool {
 	return g.NavMoveScoringItems && g.NavMoveResultLocal.ID == 0 && g.NavMoveResultOther.ID == 0
 }
 
-func NavApplyItemToResult(result *ImGuiNavItemData) {
-	var g = GImGui
+func (g *ImGuiContext) NavApplyItemToResult(result *ImGuiNavItemData) {
 	var window = g.CurrentWindow
 	result.Window = window
 	result.ID = g.LastItemData.ID
 	result.FocusScopeId = window.DC.NavFocusScopeIdCurrent
 	result.RectRel = ImRect{g.LastItemData.NavRect.Min.Sub(window.Pos), g.LastItemData.NavRect.Max.Sub(window.Pos)}
+	result.InFlags = g.LastItemData.InFlags
 }
 
 // NavProcessItem We get there when either NavId == id, or when g.NavAnyRequest is set (which is updated by NavUpdateAnyRequestFlag above)
 // This is called after LastItemData is set.
-func NavProcessItem() {
-	var g = GImGui
+func (g *ImGuiContext) NavProcessItem() {
 	var window = g.CurrentWindow
 	var id = g.LastItemData.ID
 	var nav_bb = g.LastItemData.NavRect
@@ -140,7 +132,7 @@ func NavProcessItem() {
 		}
 		if candidate_for_nav_default_focus {
 			g.NavInitRequest = false // Found a match, clear request
-			NavUpdateAnyRequestFlag()
+			g.NavUpdateAnyRequestFlag()
 		}
 	}
 
@@ -154,16 +146,16 @@ func NavProcessItem() {
 			} else {
 				result = &g.NavMoveResultOther
 			}
-			if NavScoreItem(result) {
-				NavApplyItemToResult(result)
+			if g.NavScoreItem(result) {
+				g.NavApplyItemToResult(result)
 			}
 
 			// Features like PageUp/PageDown need to maintain a separate score for the visible set of items.
 			const VISIBLE_RATIO float = 0.70
 			if (g.NavMoveFlags&ImGuiNavMoveFlags_AlsoScoreVisibleSet) != 0 && window.ClipRect.Overlaps(nav_bb) {
 				if ImClamp(nav_bb.Max.y, window.ClipRect.Min.y, window.ClipRect.Max.y)-ImClamp(nav_bb.Min.y, window.ClipRect.Min.y, window.ClipRect.Max.y) >= (nav_bb.Max.y-nav_bb.Min.y)*VISIBLE_RATIO {
-					if NavScoreItem(&g.NavMoveResultLocalVisible) {
-						NavApplyItemToResult(&g.NavMoveResultLocalVisible)
+					if g.NavScoreItem(&g.NavMoveResultLocalVisible) {
+						g.NavApplyItemToResult(&g.NavMoveResultLocalVisible)
 					}
 				}
 			}
@@ -184,28 +176,25 @@ func NavProcessItem() {
 // Called from NavUpdateCreateMoveRequest() which will use our output to create a move request
 // FIXME-NAV: This doesn't work properly with NavFlattened siblings as we use NavWindow rectangle for reference
 // FIXME-NAV: how to get Home/End to aim at the beginning/end of a 2D grid?
-func NavUpdatePageUpPageDown() float {
-	var g = GImGui
-	var io = g.IO
-
+func (g *ImGuiContext) NavUpdatePageUpPageDown() float {
 	var window = g.NavWindow
 	if (window.Flags&ImGuiWindowFlags_NoNavInputs != 0) || g.NavWindowingTarget != nil || g.NavLayer != ImGuiNavLayer_Main {
 		return 0.0
 	}
 
-	var page_up_held = IsKeyDown(io.KeyMap[ImGuiKey_PageUp]) && !IsActiveIdUsingKey(ImGuiKey_PageUp)
-	var page_down_held = IsKeyDown(io.KeyMap[ImGuiKey_PageDown]) && !IsActiveIdUsingKey(ImGuiKey_PageDown)
-	var home_pressed = IsKeyPressed(io.KeyMap[ImGuiKey_Home], true) && !IsActiveIdUsingKey(ImGuiKey_Home)
-	var end_pressed = IsKeyPressed(io.KeyMap[ImGuiKey_End], true) && !IsActiveIdUsingKey(ImGuiKey_End)
+	var page_up_held = g.IsKeyDown(ImGuiKey_PageUp) && !g.IsActiveIdUsingKey(ImGuiKey_PageUp)
+	var page_down_held = g.IsKeyDown(ImGuiKey_PageDown) && !g.IsActiveIdUsingKey(ImGuiKey_PageDown)
+	var home_pressed = g.IsKeyPressed(ImGuiKey_Home, true) && !g.IsActiveIdUsingKey(ImGuiKey_Home)
+	var end_pressed = g.IsKeyPressed(ImGuiKey_End, true) && !g.IsActiveIdUsingKey(ImGuiKey_End)
 	if page_up_held == page_down_held && home_pressed == end_pressed { // Proceed if either (not both) are pressed, otherwise early out
 		return 0.0
 	}
 
 	if window.DC.NavLayersActiveMask == 0x00 && window.DC.NavHasScroll {
 		// Fallback manual-scroll when window has no navigable item
-		if IsKeyPressed(io.KeyMap[ImGuiKey_PageUp], true) {
+		if g.IsKeyPressed(ImGuiKey_PageUp, true) {
 			setScrollY(window, window.Scroll.y-window.InnerRect.GetHeight())
-		} else if IsKeyPressed(io.KeyMap[ImGuiKey_PageDown], true) {
+		} else if g.IsKeyPressed(ImGuiKey_PageDown, true) {
 			setScrollY(window, window.Scroll.y+window.InnerRect.GetHeight())
 		} else if home_pressed {
 			setScrollY(window, 0.0)
@@ -216,12 +205,12 @@ func NavUpdatePageUpPageDown() float {
 		var nav_rect_rel = &window.NavRectRel[g.NavLayer]
 		var page_offset_y = ImMax(0.0, window.InnerRect.GetHeight()-window.CalcFontSize()*1.0+nav_rect_rel.GetHeight())
 		var nav_scoring_rect_offset_y float = 0.0
-		if IsKeyPressed(io.KeyMap[ImGuiKey_PageUp], true) {
+		if g.IsKeyPressed(ImGuiKey_PageUp, true) {
 			nav_scoring_rect_offset_y = -page_offset_y
 			g.NavMoveDir = ImGuiDir_Down // Because our scoring rect is offset up, we request the down direction (so we can always land on the last item)
 			g.NavMoveClipDir = ImGuiDir_Up
 			g.NavMoveFlags = ImGuiNavMoveFlags_AllowCurrentNavId | ImGuiNavMoveFlags_AlsoScoreVisibleSet
-		} else if IsKeyPressed(io.KeyMap[ImGuiKey_PageDown], true) {
+		} else if g.IsKeyPressed(ImGuiKey_PageDown, true) {
 			nav_scoring_rect_offset_y = +page_offset_y
 			g.NavMoveDir = ImGuiDir_Up // Because our scoring rect is offset down, we request the up direction (so we can always land on the last item)
 			g.NavMoveClipDir = ImGuiDir_Down
@@ -255,8 +244,7 @@ func NavUpdatePageUpPageDown() float {
 	return 0.0
 }
 
-func NavUpdateCreateMoveRequest() {
-	var g = GImGui
+func (g *ImGuiContext) NavUpdateCreateMoveRequest() {
 	var io = g.IO
 	var window = g.NavWindow
 
@@ -272,16 +260,16 @@ func NavUpdateCreateMoveRequest() {
 		g.NavMoveFlags = ImGuiNavMoveFlags_None
 		if window != nil && g.NavWindowingTarget == nil && window.Flags&ImGuiWindowFlags_NoNavInputs == 0 {
 			var read_mode = ImGuiInputReadMode_Repeat
-			if !IsActiveIdUsingNavDir(ImGuiDir_Left) && (IsNavInputTest(ImGuiNavInput_DpadLeft, read_mode) || IsNavInputTest(ImGuiNavInput_KeyLeft_, read_mode)) {
+			if !g.IsActiveIdUsingNavDir(ImGuiDir_Left) && (g.IsNavInputTest(ImGuiNavInput_DpadLeft, read_mode) || g.IsNavInputTest(ImGuiNavInput_KeyLeft_, read_mode)) {
 				g.NavMoveDir = ImGuiDir_Left
 			}
-			if !IsActiveIdUsingNavDir(ImGuiDir_Right) && (IsNavInputTest(ImGuiNavInput_DpadRight, read_mode) || IsNavInputTest(ImGuiNavInput_KeyRight_, read_mode)) {
+			if !g.IsActiveIdUsingNavDir(ImGuiDir_Right) && (g.IsNavInputTest(ImGuiNavInput_DpadRight, read_mode) || g.IsNavInputTest(ImGuiNavInput_KeyRight_, read_mode)) {
 				g.NavMoveDir = ImGuiDir_Right
 			}
-			if !IsActiveIdUsingNavDir(ImGuiDir_Up) && (IsNavInputTest(ImGuiNavInput_DpadUp, read_mode) || IsNavInputTest(ImGuiNavInput_KeyUp_, read_mode)) {
+			if !g.IsActiveIdUsingNavDir(ImGuiDir_Up) && (g.IsNavInputTest(ImGuiNavInput_DpadUp, read_mode) || g.IsNavInputTest(ImGuiNavInput_KeyUp_, read_mode)) {
 				g.NavMoveDir = ImGuiDir_Up
 			}
-			if !IsActiveIdUsingNavDir(ImGuiDir_Down) && (IsNavInputTest(ImGuiNavInput_DpadDown, read_mode) || IsNavInputTest(ImGuiNavInput_KeyDown_, read_mode)) {
+			if !g.IsActiveIdUsingNavDir(ImGuiDir_Down) && (g.IsNavInputTest(ImGuiNavInput_DpadDown, read_mode) || g.IsNavInputTest(ImGuiNavInput_KeyDown_, read_mode)) {
 				g.NavMoveDir = ImGuiDir_Down
 			}
 		}
@@ -293,13 +281,13 @@ func NavUpdateCreateMoveRequest() {
 	var nav_keyboard_active = (io.ConfigFlags & ImGuiConfigFlags_NavEnableKeyboard) != 0
 	var scoring_rect_offset_y float = 0.0
 	if window != nil && g.NavMoveDir == ImGuiDir_None && nav_keyboard_active {
-		scoring_rect_offset_y = NavUpdatePageUpPageDown()
+		scoring_rect_offset_y = g.NavUpdatePageUpPageDown()
 	}
 
 	// Submit
 	g.NavMoveForwardToNextFrame = false
 	if g.NavMoveDir != ImGuiDir_None {
-		NavMoveRequestSubmit(g.NavMoveDir, g.NavMoveClipDir, g.NavMoveFlags)
+		g.NavMoveRequestSubmit(g.NavMoveDir, g.NavMoveClipDir, g.NavMoveFlags)
 	}
 
 	// Moving with no reference triggers a init request (will be used as a fallback if the direction fails to find a match)
@@ -347,32 +335,36 @@ func NavUpdateCreateMoveRequest() {
 // FIXME: In order to support e.g. Escape to clear a selection we'll need:
 // - either to store the equivalent of ActiveIdUsingKeyInputMask for a FocusScope and test for it.
 // - either to move most/all of those tests to the epilogue/end functions of the scope they are dealing with (e.g. exit child window in EndChild()) or in EndFrame(), to allow an earlier intercept
-func NavUpdateCancelRequest() {
-	var g = GImGui
-	if !IsNavInputTest(ImGuiNavInput_Cancel, ImGuiInputReadMode_Pressed) {
+func (g *ImGuiContext) NavUpdateCancelRequest() {
+	if !g.IsNavInputTest(ImGuiNavInput_Cancel, ImGuiInputReadMode_Pressed) {
+		return
+	}
+
+	// Escape is left to the focus scope which claimed it with Shortcut(), e.g. BeginMultiSelect() clearing its selection
+	if routing_data := g.KeysRoutingTable[ImGuiKey_Escape]; routing_data != nil && routing_data.RoutingCurr != 0 && routing_data.RoutingCurr == g.NavFocusScopeId && g.IsKeyPressed(ImGuiKey_Escape, false) {
 		return
 	}
 
 	//IMGUI_DEBUG_LOG_NAV("[nav] ImGuiNavInput_Cancel\n")
 	if g.ActiveId != 0 {
-		if !IsActiveIdUsingNavInput(ImGuiNavInput_Cancel) {
-			ClearActiveID()
+		if !g.IsActiveIdUsingNavInput(ImGuiNavInput_Cancel) {
+			g.ClearActiveID()
 		}
 	} else if g.NavLayer != ImGuiNavLayer_Main {
 		// Leave the "menu" layer
-		NavRestoreLayer(ImGuiNavLayer_Main)
+		g.NavRestoreLayer(ImGuiNavLayer_Main)
 	} else if g.NavWindow != nil && g.NavWindow != g.NavWindow.RootWindow && g.NavWindow.Flags&ImGuiWindowFlags_Popup == 0 && g.NavWindow.ParentWindow != nil {
 		// Exit child window
 		var child_window = g.NavWindow
 		var parent_window = g.NavWindow.ParentWindow
 		IM_ASSERT(child_window.ChildId != 0)
 		var child_rect = child_window.Rect()
-		FocusWindow(parent_window)
-		SetNavID(child_window.ChildId, ImGuiNavLayer_Main, 0, &ImRect{child_rect.Min.Sub(parent_window.Pos), child_rect.Max.Sub(parent_window.Pos)})
+		g.FocusWindow(parent_window)
+		g.SetNavID(child_window.ChildId, ImGuiNavLayer_Main, 0, &ImRect{child_rect.Min.Sub(parent_window.Pos), child_rect.Max.Sub(parent_window.Pos)})
 	} else if len(g.OpenPopupStack) > 0 {
 		// Close open popup/menu
 		if g.OpenPopupStack[len(g.OpenPopupStack)-1].Window.Flags&ImGuiWindowFlags_Modal == 0 {
-			ClosePopupToLevel(int(len(g.OpenPopupStack)-1), true)
+			g.ClosePopupToLevel(int(len(g.OpenPopupStack)-1), true)
 		}
 	} else {
 		// Clear NavLastId for popups but keep it for regular child window so we can leave one and come back where we were
@@ -384,45 +376,42 @@ func NavUpdateCancelRequest() {
 	}
 }
 
-func NavRestoreLayer(layer ImGuiNavLayer) {
-	var g = GImGui
+func (g *ImGuiContext) NavRestoreLayer(layer ImGuiNavLayer) {
 	if layer == ImGuiNavLayer_Main {
 		g.NavWindow = NavRestoreLastChildNavWindow(g.NavWindow)
 	}
 	var window = g.NavWindow
 	if window.NavLastIds[layer] != 0 {
-		SetNavID(window.NavLastIds[layer], layer, 0, &window.NavRectRel[layer])
+		g.SetNavID(window.NavLastIds[layer], layer, 0, &window.NavRectRel[layer])
 	} else {
 		g.NavLayer = layer
-		NavInitWindow(window, true)
+		g.NavInitWindow(window, true)
 	}
 	g.NavDisableHighlight = false
 	g.NavDisableMouseHover = true
 	g.NavMousePosDirty = true
 }
 
-func FindWindowFocusIndex(window *ImGuiWindow) int {
-	var g = GImGui
+func (g *ImGuiContext) FindWindowFocusIndex(window *ImGuiWindow) int {
 	var order = int(window.FocusOrder)
 	IM_ASSERT(g.WindowsFocusOrder[order] == window)
 	return order
 }
 
-func NavUpdateWindowingHighlightWindow(focus_change_dir int) {
-	var g = GImGui
+func (g *ImGuiContext) NavUpdateWindowingHighlightWindow(focus_change_dir int) {
 	IM_ASSERT(g.NavWindowingTarget != nil)
 	if g.NavWindowingTarget.Flags&ImGuiWindowFlags_Modal != 0 {
 		return
 	}
 
-	var i_current = FindWindowFocusIndex(g.NavWindowingTarget)
-	var window_target = FindWindowNavFocusable(i_current+focus_change_dir, -INT_MAX, focus_change_dir)
+	var i_current = g.FindWindowFocusIndex(g.NavWindowingTarget)
+	var window_target = g.FindWindowNavFocusable(i_current+focus_change_dir, -INT_MAX, focus_change_dir)
 	if window_target == nil {
 		var start int
 		if focus_change_dir < 0 {
 			start = int(len(g.WindowsFocusOrder) - 1)
 		}
-		window_target = FindWindowNavFocusable(start, i_current, focus_change_dir)
+		window_target = g.FindWindowNavFocusable(start, i_current, focus_change_dir)
 	}
 	if window_target != nil { // Don't reset windowing target if there's a single window in the list
 		g.NavWindowingTarget = window_target
@@ -431,9 +420,8 @@ func NavUpdateWindowingHighlightWindow(focus_change_dir int) {
 	g.NavWindowingToggleLayer = false
 }
 
-func NavUpdateInitResult() {
+func (g *ImGuiContext) NavUpdateInitResult() {
 	// In very rare cases g.NavWindow may be nil (e.g. clearing focus after requesting an init request, which does happen when releasing Alt while clicking on void)
-	var g = GImGui
 	if g.NavWindow == nil {
 		return
 	}
@@ -441,7 +429,7 @@ func NavUpdateInitResult() {
 	// Apply result from previous navigation init request (will typically select the first item, unless SetItemDefaultFocus() has been called)
 	// FIXME-NAV: On _NavFlattened windows, g.NavWindow will only be updated during subsequent frame. Not a problem currently.
 	//IMGUI_DEBUG_LOG_NAV("[nav] NavInitRequest: result NavID 0x%08X in Layer %d Window \"%s\"\n", g.NavInitResultId, g.NavLayer, g.NavWindow.Name)
-	SetNavID(g.NavInitResultId, g.NavLayer, 0, &g.NavInitResultRectRel)
+	g.SetNavID(g.NavInitResultId, g.NavLayer, 0, &g.NavInitResultRectRel)
 	g.NavIdIsAlive = true // Mark as alive from previous frame as we got a result
 	if g.NavInitRequestFromMove {
 		g.NavDisableHighlight = false
@@ -450,11 +438,10 @@ func NavUpdateInitResult() {
 	}
 }
 
-func NavCalcPreferredRefPos() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) NavCalcPreferredRefPos() ImVec2 {
 	if g.NavDisableHighlight || !g.NavDisableMouseHover || g.NavWindow == nil {
 		// Mouse (we need a fallback in case the mouse becomes invalid after being used)
-		if IsMousePosValid(&g.IO.MousePos) {
+		if g.IsMousePosValid(&g.IO.MousePos) {
 			return g.IO.MousePos
 		}
 		return g.MouseLastValidPos
@@ -462,7 +449,7 @@ func NavCalcPreferredRefPos() ImVec2 {
 		// When navigation is active and mouse is disabled, decide on an arbitrary position around the bottom left of the currently navigated item.
 		var rect_rel = &g.NavWindow.NavRectRel[g.NavLayer]
 		var pos = g.NavWindow.Pos.Add(ImVec2{rect_rel.Min.x + ImMin(g.Style.FramePadding.x*4, rect_rel.GetWidth()), rect_rel.Max.y - ImMin(g.Style.FramePadding.y, rect_rel.GetHeight())})
-		var viewport = GetMainViewport()
+		var viewport = g.GetMainViewport()
 
 		clamped := ImClampVec2(&pos, &viewport.Pos, viewport.Pos.Add(viewport.Size))
 		return *ImFloorVec(&clamped) // ImFloor() is important because non-integer mouse position application in backend might be lossy and result in undesirable non-zero delta.
@@ -484,14 +471,13 @@ func NavSaveLastChildNavWindowIntoParent(nav_window *ImGuiWindow) {
 // NavUpdateWindowing Windowing management mode
 // Keyboard: CTRL+Tab (change focus/move/resize), Alt (toggle menu layer)
 // Gamepad:  Hold Menu/Square (change focus/move/resize), Tap Menu/Square (toggle menu layer)
-func NavUpdateWindowing() {
-	var g = GImGui
+func (g *ImGuiContext) NavUpdateWindowing() {
 	var io = g.IO
 
 	var apply_focus_window *ImGuiWindow = nil
 	var apply_toggle_layer = false
 
-	var modal_window = GetTopMostPopupModal()
+	var modal_window = g.GetTopMostPopupModal()
 	var allow_windowing = (modal_window == nil)
 	if !allow_windowing {
 		g.NavWindowingTarget = nil
@@ -506,14 +492,14 @@ func NavUpdateWindowing() {
 	}
 
 	// Start CTRL-TAB or Square+L/R window selection
-	var start_windowing_with_gamepad = allow_windowing && g.NavWindowingTarget == nil && IsNavInputTest(ImGuiNavInput_Menu, ImGuiInputReadMode_Pressed)
-	var start_windowing_with_keyboard = allow_windowing && g.NavWindowingTarget == nil && io.KeyCtrl && IsKeyPressedMap(ImGuiKey_Tab, true) && (io.ConfigFlags&ImGuiConfigFlags_NavEnableKeyboard) != 0
+	var start_windowing_with_gamepad = allow_windowing && g.NavWindowingTarget == nil && g.IsNavInputTest(ImGuiNavInput_Menu, ImGuiInputReadMode_Pressed)
+	var start_windowing_with_keyboard = allow_windowing && g.NavWindowingTarget == nil && io.KeyCtrl && g.IsKeyPressedMap(ImGuiKey_Tab, true) && (io.ConfigFlags&ImGuiConfigFlags_NavEnableKeyboard) != 0
 	if start_windowing_with_gamepad || start_windowing_with_keyboard {
 		var window *ImGuiWindow
 		if g.NavWindow != nil {
 			window = g.NavWindow
 		} else {
-			window = FindWindowNavFocusable(int(len(g.WindowsFocusOrder)-1), -INT_MAX, -1)
+			window = g.FindWindowNavFocusable(int(len(g.WindowsFocusOrder)-1), -INT_MAX, -1)
 		}
 		if window != nil {
 			g.NavWindowingTarget = window.RootWindow
@@ -540,14 +526,14 @@ func NavUpdateWindowing() {
 		g.NavWindowingHighlightAlpha = ImMax(g.NavWindowingHighlightAlpha, ImSaturate((g.NavWindowingTimer-NAV_WINDOWING_HIGHLIGHT_DELAY)/0.05))
 
 		// Select window to focus
-		var focus_change_dir = bool2int(IsNavInputTest(ImGuiNavInput_FocusPrev, ImGuiInputReadMode_RepeatSlow)) - bool2int(IsNavInputTest(ImGuiNavInput_FocusNext, ImGuiInputReadMode_RepeatSlow))
+		var focus_change_dir = bool2int(g.IsNavInputTest(ImGuiNavInput_FocusPrev, ImGuiInputReadMode_RepeatSlow)) - bool2int(g.IsNavInputTest(ImGuiNavInput_FocusNext, ImGuiInputReadMode_RepeatSlow))
 		if focus_change_dir != 0 {
-			NavUpdateWindowingHighlightWindow(focus_change_dir)
+			g.NavUpdateWindowingHighlightWindow(focus_change_dir)
 			g.NavWindowingHighlightAlpha = 1.0
 		}
 
 		// Single press toggles NavLayer, long press with L/R apply actual focus on release (until then the window was merely rendered top-most)
-		if !IsNavInputDown(ImGuiNavInput_Menu) {
+		if !g.IsNavInputDown(ImGuiNavInput_Menu) {
 			g.NavWindowingToggleLayer = g.NavWindowingToggleLayer && (g.NavWindowingHighlightAlpha < 1.0) // Once button was held long enough we don't consider it a tap-to-toggle-layer press anymore.
 			if g.NavWindowingToggleLayer && g.NavWindow != nil {
 				apply_toggle_layer = true
@@ -562,11 +548,11 @@ func NavUpdateWindowing() {
 	if g.NavWindowingTarget != nil && g.NavInputSource == ImGuiInputSource_Keyboard {
 		// Visuals only appears after a brief time after pressing TAB the first time, so that a fast CTRL+TAB doesn't add visual noise
 		g.NavWindowingHighlightAlpha = ImMax(g.NavWindowingHighlightAlpha, ImSaturate((g.NavWindowingTimer-NAV_WINDOWING_HIGHLIGHT_DELAY)/0.05)) // 1.0f
-		if IsKeyPressedMap(ImGuiKey_Tab, true) {
+		if g.IsKeyPressedMap(ImGuiKey_Tab, true) {
 			if io.KeyShift {
-				NavUpdateWindowingHighlightWindow(+1)
+				g.NavUpdateWindowingHighlightWindow(+1)
 			} else {
-				NavUpdateWindowingHighlightWindow(-1)
+				g.NavUpdateWindowingHighlightWindow(-1)
 			}
 
 		}
@@ -594,7 +580,7 @@ func NavUpdateWindowing() {
 		// Important: as before version <18314 we lacked an explicit IO event for focus gain/loss, we also compare mouse validity to detect old backends clearing mouse pos on focus loss.
 		if io.KeyMods&ImGuiKeyModFlags_Alt == 0 && (io.KeyModsPrev&ImGuiKeyModFlags_Alt != 0) && g.NavWindowingToggleLayer {
 			if g.ActiveId == 0 || g.ActiveIdAllowOverlap {
-				if IsMousePosValid(&io.MousePos) == IsMousePosValid(&io.MousePosPrev) {
+				if g.IsMousePosValid(&io.MousePos) == g.IsMousePosValid(&io.MousePosPrev) {
 					apply_toggle_layer = true
 				}
 			}
@@ -608,10 +594,10 @@ func NavUpdateWindowing() {
 	if g.NavWindowingTarget != nil && g.NavWindowingTarget.Flags&ImGuiWindowFlags_NoMove == 0 {
 		var move_delta ImVec2
 		if g.NavInputSource == ImGuiInputSource_Keyboard && !io.KeyShift {
-			move_delta = GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard, ImGuiInputReadMode_Down, 0, 0)
+			move_delta = g.GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard, ImGuiInputReadMode_Down, 0, 0)
 		}
 		if g.NavInputSource == ImGuiInputSource_Gamepad {
-			move_delta = GetNavInputAmount2d(ImGuiNavDirSourceFlags_PadLStick, ImGuiInputReadMode_Down, 0, 0)
+			move_delta = g.GetNavInputAmount2d(ImGuiNavDirSourceFlags_PadLStick, ImGuiInputReadMode_Down, 0, 0)
 		}
 		if move_delta.x != 0.0 || move_delta.y != 0.0 {
 			const NAV_MOVE_SPEED float = 800.0
@@ -619,21 +605,21 @@ func NavUpdateWindowing() {
 			var moving_window = g.NavWindowingTarget.RootWindow
 			p := moving_window.Pos.Add(move_delta.Scale(move_speed))
 			setWindowPos(moving_window, &p, ImGuiCond_Always)
-			MarkIniSettingsDirtyWindow(moving_window)
+			g.MarkIniSettingsDirtyWindow(moving_window)
 			g.NavDisableMouseHover = true
 		}
 	}
 
 	// Apply final focus
 	if apply_focus_window != nil && (g.NavWindow == nil || apply_focus_window != g.NavWindow.RootWindow) {
-		ClearActiveID()
+		g.ClearActiveID()
 		g.NavDisableHighlight = false
 		g.NavDisableMouseHover = true
 		apply_focus_window = NavRestoreLastChildNavWindow(apply_focus_window)
-		ClosePopupsOverWindow(apply_focus_window, false)
-		FocusWindow(apply_focus_window)
+		g.ClosePopupsOverWindow(apply_focus_window, false)
+		g.FocusWindow(apply_focus_window)
 		if apply_focus_window.NavLastIds[0] == 0 {
-			NavInitWindow(apply_focus_window, false)
+			g.NavInitWindow(apply_focus_window, false)
 		}
 
 		// If the window has ONLY a menu layer (no main layer), select it directly
@@ -653,7 +639,7 @@ func NavUpdateWindowing() {
 
 	// Apply menu/layer toggle
 	if apply_toggle_layer && g.NavWindow != nil {
-		ClearActiveID()
+		g.ClearActiveID()
 
 		// Move to parent menu if necessary
 		var new_nav_window = g.NavWindow
@@ -665,7 +651,7 @@ func NavUpdateWindowing() {
 		}
 		if new_nav_window != g.NavWindow {
 			var old_nav_window = g.NavWindow
-			FocusWindow(new_nav_window)
+			g.FocusWindow(new_nav_window)
 			new_nav_window.NavLastChildNavWindow = old_nav_window
 		}
 
@@ -681,14 +667,13 @@ func NavUpdateWindowing() {
 			if new_nav_layer == ImGuiNavLayer_Menu {
 				g.NavWindow.NavLastIds[new_nav_layer] = 0
 			}
-			NavRestoreLayer(new_nav_layer)
+			g.NavRestoreLayer(new_nav_layer)
 		}
 	}
 }
 
-func NavUpdate() {
-	var g = GImGui
-	var io = g.IO
+func (g *ImGuiContext) NavUpdate() {
+	var io = &g.IO
 
 	io.WantSetMousePos = false
 
@@ -706,7 +691,7 @@ func NavUpdate() {
 	// Update Keyboard.Nav inputs mapping
 	if nav_keyboard_active {
 		var NAV_MAP_KEY = func(key ImGuiKey, input ImGuiNavInput) {
-			if IsKeyDown(io.KeyMap[key]) {
+			if g.IsKeyDown(key) {
 				io.NavInputs[input] = 1.0
 				g.NavInputSource = ImGuiInputSource_Keyboard
 			}
@@ -725,6 +710,35 @@ func NavUpdate() {
 			io.NavInputs[ImGuiNavInput_TweakFast] = 1.0
 		}
 	}
+
+	// Update Gamepad.Nav inputs mapping, for backends submitting the gamepad state with io.AddKeyAnalogEvent()
+	if nav_gamepad_active && io.BackendUsingLegacyKeyArrays == 0 {
+		var NAV_MAP_KEY = func(key ImGuiKey, input ImGuiNavInput, activate_nav bool) {
+			var v = io.KeysData[key-ImGuiKey_KeysData_OFFSET].AnalogValue
+			if v > io.NavInputs[input] {
+				io.NavInputs[input] = v
+			}
+			if activate_nav && v > 0.0 {
+				g.NavInputSource = ImGuiInputSource_Gamepad
+			}
+		}
+		NAV_MAP_KEY(ImGuiKey_GamepadFaceDown, ImGuiNavInput_Activate, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadFaceRight, ImGuiNavInput_Cancel, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadFaceLeft, ImGuiNavInput_Menu, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadFaceUp, ImGuiNavInput_Input, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadDpadLeft, ImGuiNavInput_DpadLeft, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadDpadRight, ImGuiNavInput_DpadRight, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadDpadUp, ImGuiNavInput_DpadUp, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadDpadDown, ImGuiNavInput_DpadDown, true)
+		NAV_MAP_KEY(ImGuiKey_GamepadL1, ImGuiNavInput_FocusPrev, false)
+		NAV_MAP_KEY(ImGuiKey_GamepadR1, ImGuiNavInput_FocusNext, false)
+		NAV_MAP_KEY(ImGuiKey_GamepadL1, ImGuiNavInput_TweakSlow, false)
+		NAV_MAP_KEY(ImGuiKey_GamepadR1, ImGuiNavInput_TweakFast, false)
+		NAV_MAP_KEY(ImGuiKey_GamepadLStickLeft, ImGuiNavInput_LStickLeft, false)
+		NAV_MAP_KEY(ImGuiKey_GamepadLStickRight, ImGuiNavInput_LStickRight, false)
+		NAV_MAP_KEY(ImGuiKey_GamepadLStickUp, ImGuiNavInput_LStickUp, false)
+		NAV_MAP_KEY(ImGuiKey_GamepadLStickDown, ImGuiNavInput_LStickDown, false)
+	}
 	copy(io.NavInputsDownDurationPrev[:], io.NavInputsDownDuration[:])
 	for i := range io.NavInputs {
 
@@ -741,7 +755,7 @@ func NavUpdate() {
 
 	// Process navigation init request (select first/default focus)
 	if g.NavInitResultId != 0 {
-		NavUpdateInitResult()
+		g.NavUpdateInitResult()
 	}
 	g.NavInitRequest = false
 	g.NavInitRequestFromMove = false
@@ -750,7 +764,7 @@ func NavUpdate() {
 
 	// Process navigation move request
 	if g.NavMoveSubmitted {
-		NavMoveRequestApplyResult()
+		g.NavMoveRequestApplyResult()
 	}
 	g.NavMoveSubmitted = false
 	g.NavMoveScoringItems = false
@@ -760,7 +774,7 @@ func NavUpdate() {
 		// Set mouse position given our knowledge of the navigated item position from last frame
 		if (io.ConfigFlags&ImGuiConfigFlags_NavEnableSetMousePos != 0) && (io.BackendFlags&ImGuiBackendFlags_HasSetMousePos != 0) {
 			if !g.NavDisableHighlight && g.NavDisableMouseHover && g.NavWindow != nil {
-				p := NavCalcPreferredRefPos()
+				p := g.NavCalcPreferredRefPos()
 				io.MousePos = p
 				io.MousePosPrev = p
 				io.WantSetMousePos = true
@@ -782,14 +796,14 @@ func NavUpdate() {
 	}
 
 	// Update CTRL+TAB and Windowing features (hold Square to move/resize/etc.)
-	NavUpdateWindowing()
+	g.NavUpdateWindowing()
 
 	// Set output flags for user application
 	io.NavActive = (nav_keyboard_active || nav_gamepad_active) && g.NavWindow != nil && g.NavWindow.Flags&ImGuiWindowFlags_NoNavInputs == 0
 	io.NavVisible = (io.NavActive && g.NavId != 0 && !g.NavDisableHighlight) || (g.NavWindowingTarget != nil)
 
 	// Process NavCancel input (to close a popup, get back to parent, clear focus)
-	NavUpdateCancelRequest()
+	g.NavUpdateCancelRequest()
 
 	// Process manual activation request
 	g.NavActivateId = 0
@@ -797,8 +811,8 @@ func NavUpdate() {
 	g.NavActivatePressedId = 0
 	g.NavInputId = 0
 	if g.NavId != 0 && !g.NavDisableHighlight && g.NavWindowingTarget == nil && g.NavWindow != nil && g.NavWindow.Flags&ImGuiWindowFlags_NoNavInputs == 0 {
-		var activate_down = IsNavInputDown(ImGuiNavInput_Activate)
-		var activate_pressed = activate_down && IsNavInputTest(ImGuiNavInput_Activate, ImGuiInputReadMode_Pressed)
+		var activate_down = g.IsNavInputDown(ImGuiNavInput_Activate)
+		var activate_pressed = activate_down && g.IsNavInputTest(ImGuiNavInput_Activate, ImGuiInputReadMode_Pressed)
 		if g.ActiveId == 0 && activate_pressed {
 			g.NavActivateId = g.NavId
 		}
@@ -808,7 +822,7 @@ func NavUpdate() {
 		if (g.ActiveId == 0 || g.ActiveId == g.NavId) && activate_pressed {
 			g.NavActivatePressedId = g.NavId
 		}
-		if (g.ActiveId == 0 || g.ActiveId == g.NavId) && IsNavInputTest(ImGuiNavInput_Input, ImGuiInputReadMode_Pressed) {
+		if (g.ActiveId == 0 || g.ActiveId == g.NavId) && g.IsNavInputTest(ImGuiNavInput_Input, ImGuiInputReadMode_Pressed) {
 			g.NavInputId = g.NavId
 		}
 	}
@@ -829,8 +843,8 @@ func NavUpdate() {
 	g.NavNextActivateId = 0
 
 	// Process move requests
-	NavUpdateCreateMoveRequest()
-	NavUpdateAnyRequestFlag()
+	g.NavUpdateCreateMoveRequest()
+	g.NavUpdateAnyRequestFlag()
 
 	// Scrolling
 	if g.NavWindow != nil && (g.NavWindow.Flags&ImGuiWindowFlags_NoNavInputs == 0) && g.NavWindowingTarget == nil {
@@ -861,7 +875,7 @@ func NavUpdate() {
 
 		// *Normal* Manual scroll with NavScrollXXX keys
 		// Next movement request will clamp the NavId reference rectangle to the visible area, so navigation will resume within those bounds.
-		var scroll_dir = GetNavInputAmount2d(ImGuiNavDirSourceFlags_PadLStick, ImGuiInputReadMode_Down, 1.0/10.0, 10.0)
+		var scroll_dir = g.GetNavInputAmount2d(ImGuiNavDirSourceFlags_PadLStick, ImGuiInputReadMode_Down, 1.0/10.0, 10.0)
 		if scroll_dir.x != 0.0 && window.ScrollbarX {
 			setScrollX(window, ImFloor(window.Scroll.x+scroll_dir.x*scroll_speed))
 		}
@@ -882,8 +896,7 @@ func NavUpdate() {
 }
 
 // NavInitWindow This needs to be called before we submit any widget (aka in or before Begin)
-func NavInitWindow(window *ImGuiWindow, force_reinit bool) {
-	var g = GImGui
+func (g *ImGuiContext) NavInitWindow(window *ImGuiWindow, force_reinit bool) {
 	IM_ASSERT(window == g.NavWindow)
 
 	if window.Flags&ImGuiWindowFlags_NoNavInputs != 0 {
@@ -899,12 +912,12 @@ func NavInitWindow(window *ImGuiWindow, force_reinit bool) {
 
 	//IMGUI_DEBUG_LOG_NAV("[nav] NavInitRequest: from NavInitWindow(), init_for_nav=%d, window=\"%s\", layer=%d\n", init_for_nav, window.Name, g.NavLayer)
 	if init_for_nav {
-		SetNavID(0, g.NavLayer, 0, &ImRect{})
+		g.SetNavID(0, g.NavLayer, 0, &ImRect{})
 		g.NavInitRequest = true
 		g.NavInitRequestFromMove = false
 		g.NavInitResultId = 0
 		g.NavInitResultRectRel = ImRect{}
-		NavUpdateAnyRequestFlag()
+		g.NavUpdateAnyRequestFlag()
 	} else {
 		g.NavId = window.NavLastIds[0]
 		g.NavFocusScopeId = 0
@@ -922,8 +935,7 @@ func NavScoreItemDistInterval(a0, a1, b0, b1 float) float {
 }
 
 // NavScoreItem Scoring function for gamepad/keyboard directional navigation. Based on https://gist.github.com/rygorous/6981057
-func NavScoreItem(result *ImGuiNavItemData) bool {
-	var g = GImGui
+func (g *ImGuiContext) NavScoreItem(result *ImGuiNavItemData) bool {
 	var window = g.CurrentWindow
 	if g.NavLayer != window.DC.NavLayerCurrent {
 		return false
@@ -1050,12 +1062,10 @@ func NavClampRectToVisibleAreaForMoveDir(move_dir ImGuiDir, r *ImRect, clip_rect
 	}
 }
 
-func NavEndFrame() {
-	var g = GImGui
-
+func (g *ImGuiContext) NavEndFrame() {
 	// Show CTRL+TAB list window
 	if g.NavWindowingTarget != nil {
-		NavUpdateWindowingOverlay()
+		g.NavUpdateWindowingOverlay()
 	}
 
 	// Perform wrap-around in menus
@@ -1063,7 +1073,7 @@ func NavEndFrame() {
 	var window = g.NavWindow
 	var move_flags = g.NavMoveFlags
 	var wanted_flags = ImGuiNavMoveFlags_WrapX | ImGuiNavMoveFlags_LoopX | ImGuiNavMoveFlags_WrapY | ImGuiNavMoveFlags_LoopY
-	if window != nil && NavMoveRequestButNoResultYet() && (g.NavMoveFlags&wanted_flags != 0) && (g.NavMoveFlags&ImGuiNavMoveFlags_Forwarded) == 0 {
+	if window != nil && g.NavMoveRequestButNoResultYet() && (g.NavMoveFlags&wanted_flags != 0) && (g.NavMoveFlags&ImGuiNavMoveFlags_Forwarded) == 0 {
 		var do_forward = false
 		var bb_rel = window.NavRectRel[g.NavLayer]
 		var clip_dir = g.NavMoveDir
@@ -1108,14 +1118,13 @@ func NavEndFrame() {
 		}
 		if do_forward {
 			window.NavRectRel[g.NavLayer] = bb_rel
-			NavMoveRequestForward(g.NavMoveDir, clip_dir, move_flags)
+			g.NavMoveRequestForward(g.NavMoveDir, clip_dir, move_flags)
 		}
 	}
 }
 
 // NavUpdateWindowingOverlay Overlay displayed when using CTRL+TAB. Called by EndFrame().
-func NavUpdateWindowingOverlay() {
-	var g = GImGui
+func (g *ImGuiContext) NavUpdateWindowingOverlay() {
 	IM_ASSERT(g.NavWindowingTarget != nil)
 
 	if g.NavWindowingTimer < NAV_WINDOWING_LIST_APPEAR_DELAY {
@@ -1123,14 +1132,14 @@ func NavUpdateWindowingOverlay() {
 	}
 
 	if g.NavWindowingListWindow == nil {
-		g.NavWindowingListWindow = FindWindowByName("###NavWindowingList")
+		g.NavWindowingListWindow = g.FindWindowByName("###NavWindowingList")
 	}
-	var viewport = GetMainViewport()
-	SetNextWindowSizeConstraints(ImVec2{viewport.Size.x * 0.20, viewport.Size.y * 0.20}, ImVec2{FLT_MAX, FLT_MAX}, nil, nil)
+	var viewport = g.GetMainViewport()
+	g.SetNextWindowSizeConstraints(ImVec2{viewport.Size.x * 0.20, viewport.Size.y * 0.20}, ImVec2{FLT_MAX, FLT_MAX}, nil, nil)
 	center := viewport.GetCenter()
-	SetNextWindowPos(&center, ImGuiCond_Always, ImVec2{0.5, 0.5})
-	PushStyleVec(ImGuiStyleVar_WindowPadding, g.Style.WindowPadding.Scale(2.0))
-	Begin("###NavWindowingList", nil, ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoFocusOnAppearing|ImGuiWindowFlags_NoResize|ImGuiWindowFlags_NoMove|ImGuiWindowFlags_NoInputs|ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoSavedSettings)
+	g.SetNextWindowPos(&center, ImGuiCond_Always, ImVec2{0.5, 0.5})
+	g.PushStyleVec(ImGuiStyleVar_WindowPadding, g.Style.WindowPadding.Scale(2.0))
+	g.Begin("###NavWindowingList", nil, ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoFocusOnAppearing|ImGuiWindowFlags_NoResize|ImGuiWindowFlags_NoMove|ImGuiWindowFlags_NoInputs|ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoSavedSettings)
 	for n := range g.WindowsFocusOrder {
 		var window = g.WindowsFocusOrder[n]
 		IM_ASSERT(window != nil) // Fix static analyzers
@@ -1141,15 +1150,14 @@ func NavUpdateWindowingOverlay() {
 		if label == FindRenderedTextEnd(label) {
 			label = GetFallbackWindowNameForWindowingList(window)
 		}
-		Selectable(label, g.NavWindowingTarget == window, 0, ImVec2{})
+		g.Selectable(label, g.NavWindowingTarget == window, 0, ImVec2{})
 	}
-	End()
-	PopStyleVar(1)
+	g.End()
+	g.PopStyleVar(1)
 }
 
 // NavMoveRequestSubmit FIXME: ScoringRect is not set
-func NavMoveRequestSubmit(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGuiNavMoveFlags) {
-	var g = GImGui
+func (g *ImGuiContext) NavMoveRequestSubmit(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGuiNavMoveFlags) {
 	IM_ASSERT(g.NavWindow != nil)
 	g.NavMoveSubmitted = true
 	g.NavMoveScoringItems = true
@@ -1164,30 +1172,28 @@ func NavMoveRequestSubmit(move_dir ImGuiDir, clip_dir ImGuiDir, move_flags ImGui
 	g.NavMoveResultOther.Clear()
 }
 
-func GetNavInputAmount2d(dir_sources ImGuiNavDirSourceFlags, mode ImGuiInputReadMode, slow_factor float, fast_factor float) ImVec2 {
+func (g *ImGuiContext) GetNavInputAmount2d(dir_sources ImGuiNavDirSourceFlags, mode ImGuiInputReadMode, slow_factor float, fast_factor float) ImVec2 {
 	var delta ImVec2
 	if dir_sources&ImGuiNavDirSourceFlags_Keyboard != 0 {
-		delta = delta.Add(ImVec2{GetNavInputAmount(ImGuiNavInput_KeyRight_, mode) - GetNavInputAmount(ImGuiNavInput_KeyLeft_, mode), GetNavInputAmount(ImGuiNavInput_KeyDown_, mode) - GetNavInputAmount(ImGuiNavInput_KeyUp_, mode)})
+		delta = delta.Add(ImVec2{g.GetNavInputAmount(ImGuiNavInput_KeyRight_, mode) - g.GetNavInputAmount(ImGuiNavInput_KeyLeft_, mode), g.GetNavInputAmount(ImGuiNavInput_KeyDown_, mode) - g.GetNavInputAmount(ImGuiNavInput_KeyUp_, mode)})
 	}
 	if dir_sources&ImGuiNavDirSourceFlags_PadDPad != 0 {
-		delta = delta.Add(ImVec2{GetNavInputAmount(ImGuiNavInput_DpadRight, mode) - GetNavInputAmount(ImGuiNavInput_DpadLeft, mode), GetNavInputAmount(ImGuiNavInput_DpadDown, mode) - GetNavInputAmount(ImGuiNavInput_DpadUp, mode)})
+		delta = delta.Add(ImVec2{g.GetNavInputAmount(ImGuiNavInput_DpadRight, mode) - g.GetNavInputAmount(ImGuiNavInput_DpadLeft, mode), g.GetNavInputAmount(ImGuiNavInput_DpadDown, mode) - g.GetNavInputAmount(ImGuiNavInput_DpadUp, mode)})
 	}
 	if dir_sources&ImGuiNavDirSourceFlags_PadLStick != 0 {
-		delta = delta.Add(ImVec2{GetNavInputAmount(ImGuiNavInput_LStickRight, mode) - GetNavInputAmount(ImGuiNavInput_LStickLeft, mode), GetNavInputAmount(ImGuiNavInput_LStickDown, mode) - GetNavInputAmount(ImGuiNavInput_LStickUp, mode)})
+		delta = delta.Add(ImVec2{g.GetNavInputAmount(ImGuiNavInput_LStickRight, mode) - g.GetNavInputAmount(ImGuiNavInput_LStickLeft, mode), g.GetNavInputAmount(ImGuiNavInput_LStickDown, mode) - g.GetNavInputAmount(ImGuiNavInput_LStickUp, mode)})
 	}
-	if slow_factor != 0.0 && IsNavInputDown(ImGuiNavInput_TweakSlow) {
+	if slow_factor != 0.0 && g.IsNavInputDown(ImGuiNavInput_TweakSlow) {
 		delta = delta.Scale(slow_factor)
 	}
-	if fast_factor != 0.0 && IsNavInputDown(ImGuiNavInput_TweakFast) {
+	if fast_factor != 0.0 && g.IsNavInputDown(ImGuiNavInput_TweakFast) {
 		delta = delta.Scale(fast_factor)
 	}
 	return delta
 }
 
 // NavMoveRequestApplyResult Apply result from previous frame navigation directional move request. Always called from NavUpdate()
-func NavMoveRequestApplyResult() {
-	var g = GImGui
-
+func (g *ImGuiContext) NavMoveRequestApplyResult() {
 	// No result
 	// In a situation when there is no results but NavId != 0, re-enable the Navigation highlight (because g.NavId is not considered as a possible result)
 	if g.NavMoveResultLocal.ID == 0 && g.NavMoveResultOther.ID == 0 {
@@ -1233,7 +1239,7 @@ func NavMoveRequestApplyResult() {
 			setScrollY(result.Window, scroll_target)
 		} else {
 			var rect_abs = ImRect{result.RectRel.Min.Add(result.Window.Pos), result.RectRel.Max.Add(result.Window.Pos)}
-			delta_scroll = ScrollToBringRectIntoView(result.Window, &rect_abs)
+			delta_scroll = g.ScrollToBringRectIntoView(result.Window, &rect_abs)
 		}
 
 		// Offset our result position so mouse position can be applied immediately after in NavUpdate()
@@ -1241,18 +1247,19 @@ func NavMoveRequestApplyResult() {
 		result.RectRel.TranslateY(-delta_scroll.y)
 	}
 
-	ClearActiveID()
+	g.ClearActiveID()
 	g.NavWindow = result.Window
 	if g.NavId != result.ID {
 		// Don't set NavJustMovedToId if just landed on the same spot (which may happen with ImGuiNavMoveFlags_AllowCurrentNavId)
 		g.NavJustMovedToId = result.ID
 		g.NavJustMovedToFocusScopeId = result.FocusScopeId
 		g.NavJustMovedToKeyMods = g.NavMoveKeyMods
+		g.NavJustMovedToHasSelection = result.InFlags&ImGuiItemFlags_HasSelectionUserData != 0
 	}
 
 	// Focus
 	//IMGUI_DEBUG_LOG_NAV("[nav] NavMoveRequest: result NavID 0x%08X in Layer %d Window \"%s\"\n", result.ID, g.NavLayer, g.NavWindow.Name)
-	SetNavID(result.ID, g.NavLayer, result.FocusScopeId, &result.RectRel)
+	g.SetNavID(result.ID, g.NavLayer, result.FocusScopeId, &result.RectRel)
 
 	// Enable nav highlight
 	g.NavDisableHighlight = false
@@ -1260,9 +1267,8 @@ func NavMoveRequestApplyResult() {
 	g.NavMousePosDirty = true
 }
 
-func NavMoveRequestCancel() {
-	var g = GImGui
+func (g *ImGuiContext) NavMoveRequestCancel() {
 	g.NavMoveSubmitted = false
 	g.NavMoveScoringItems = false
-	NavUpdateAnyRequestFlag()
+	g.NavUpdateAnyRequestFlag()
 }
@@ -16,24 +16,22 @@ import "fmt"
 //   - BeginPopupModal(): block every interactions behind the window, cannot be closed by user, add a dimming background, has a title bar.
 //
 // return true if the popup is open, and you can start outputting to it.
-func BeginPopup(str_id string, flags ImGuiWindowFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginPopup(str_id string, flags ImGuiWindowFlags) bool {
 	if len(g.OpenPopupStack) <= len(g.BeginPopupStack) {
 		g.NextWindowData.ClearFlags() // We behave like Begin() and need to consume those values
 		return false
 	}
 	flags |= ImGuiWindowFlags_AlwaysAutoResize | ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoSavedSettings
-	return BeginPopupEx(g.CurrentWindow.GetIDs(str_id), flags)
+	return g.BeginPopupEx(g.CurrentWindow.GetIDs(str_id), flags)
 }
 
 // BeginPopupModal If 'p_open' is specified for a modal popup window, the popup will have a regular close button which will close the popup.
 // Note that popup visibility status is owned by Dear ImGui (and manipulated with e.g. OpenPopup) so the actual value of *p_open is meaningless here.
 // return true if the modal is open, and you can start outputting to it.
-func BeginPopupModal(name string, p_open *bool, flags ImGuiWindowFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginPopupModal(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 	var window = g.CurrentWindow
 	var id = window.GetIDs(name)
-	if !isPopupOpen(id, ImGuiPopupFlags_None) {
+	if !g.isPopupOpen(id, ImGuiPopupFlags_None) {
 		g.NextWindowData.ClearFlags() // We behave like Begin() and need to consume those values
 		return false
 	}
@@ -42,17 +40,17 @@ func BeginPopupModal(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 	// (this won't really last as settings will kick in, and is mostly for backward compatibility. user may do the same themselves)
 	// FIXME: Should test for (PosCond & window.SetWindowPosAllowFlags) with the upcoming window.
 	if (g.NextWindowData.Flags & ImGuiNextWindowDataFlags_HasPos) == 0 {
-		var viewport = GetMainViewport()
+		var viewport = g.GetMainViewport()
 		center := viewport.GetCenter()
-		SetNextWindowPos(&center, ImGuiCond_FirstUseEver, ImVec2{0.5, 0.5})
+		g.SetNextWindowPos(&center, ImGuiCond_FirstUseEver, ImVec2{0.5, 0.5})
 	}
 
 	flags |= ImGuiWindowFlags_Popup | ImGuiWindowFlags_Modal | ImGuiWindowFlags_NoCollapse
-	var is_open = Begin(name, p_open, flags)
+	var is_open = g.Begin(name, p_open, flags)
 	if !is_open || (p_open != nil && !*p_open) { // NB: is_open can be 'false' when the popup is completely clipped (e.g. zero size display)
-		EndPopup()
+		g.EndPopup()
 		if is_open {
-			ClosePopupToLevel(int(len(g.BeginPopupStack)), true)
+			g.ClosePopupToLevel(int(len(g.BeginPopupStack)), true)
 		}
 		return false
 	}
@@ -60,15 +58,14 @@ func BeginPopupModal(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 }
 
 // EndPopup only call EndPopup() if BeginPopupXXX() returns true!
-func EndPopup() {
-	var g = GImGui
+func (g *ImGuiContext) EndPopup() {
 	var window = g.CurrentWindow
 	IM_ASSERT(window.Flags&ImGuiWindowFlags_Popup != 0) // Mismatched BeginPopup()/EndPopup() calls
 	IM_ASSERT(len(g.BeginPopupStack) > 0)
 
 	// Make all menus and popups wrap around for now, may need to expose that policy (e.g. focus scope could include wrap/loop policy flags used by new move requests)
 	if g.NavWindow == window {
-		NavMoveRequestTryWrapping(window, ImGuiNavMoveFlags_LoopY)
+		g.NavMoveRequestTryWrapping(window, ImGuiNavMoveFlags_LoopY)
 	}
 
 	// Child-popups don't need to be laid out
@@ -76,7 +73,7 @@ func EndPopup() {
 	if window.Flags&ImGuiWindowFlags_ChildWindow != 0 {
 		g.WithinEndChild = true
 	}
-	End()
+	g.End()
 	g.WithinEndChild = false
 }
 
@@ -89,36 +86,33 @@ func EndPopup() {
 //   - Use IsWindowAppearing() after BeginPopup() to tell if a window just opened.
 //
 // call to mark popup as open (don't call every frame!).
-func OpenPopup(str_id string, popup_flags ImGuiPopupFlags) {
-	var g = GImGui
-	OpenPopupEx(g.CurrentWindow.GetIDs(str_id), popup_flags)
+func (g *ImGuiContext) OpenPopup(str_id string, popup_flags ImGuiPopupFlags) {
+	g.OpenPopupEx(g.CurrentWindow.GetIDs(str_id), popup_flags)
 }
 
 // OpenPopupID id overload to facilitate calling from nested stacks
-func OpenPopupID(id ImGuiID, popup_flags ImGuiPopupFlags) {
-	OpenPopupEx(id, popup_flags)
+func (g *ImGuiContext) OpenPopupID(id ImGuiID, popup_flags ImGuiPopupFlags) {
+	g.OpenPopupEx(id, popup_flags)
 }
 
 // OpenPopupOnItemClick Helper to open a popup if mouse button is released over the item
 // - This is essentially the same as BeginPopupContextItem() but without the trailing BeginPopup()
 // helper to open popup when clicked on last item. Default to ImGuiPopupFlags_MouseButtonRight == 1. (note: actually triggers on the mouse _released_ event to be consistent with popup behaviors)
-func OpenPopupOnItemClick(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) {
-	var g = GImGui
+func (g *ImGuiContext) OpenPopupOnItemClick(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) {
 	var window = g.CurrentWindow
 	var mouse_button = ImGuiMouseButton(popup_flags & ImGuiPopupFlags_MouseButtonMask_)
-	if IsMouseReleased(mouse_button) && IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup) {
+	if g.IsMouseReleased(mouse_button) && g.IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup) {
 		var id = g.LastItemData.ID // If user hasn't passed an ID, we can use the LastItemID. Using LastItemID as a Popup ID won't conflict!
 		if str_id != "" {
 			id = window.GetIDs(str_id)
 		}
 		IM_ASSERT(id != 0) // You cannot pass a nil str_id if the last item has no identifier (e.g. a Text() item)
-		OpenPopupEx(id, popup_flags)
+		g.OpenPopupEx(id, popup_flags)
 	}
 }
 
 // CloseCurrentPopup manually close the popup we have begin-ed into.
-func CloseCurrentPopup() {
-	var g = GImGui
+func (g *ImGuiContext) CloseCurrentPopup() {
 	var popup_idx = int(len(g.BeginPopupStack)) - 1
 	if popup_idx < 0 || popup_idx >= int(len(g.OpenPopupStack)) || g.BeginPopupStack[popup_idx].PopupId != g.OpenPopupStack[popup_idx].PopupId {
 		return
@@ -140,7 +134,7 @@ func CloseCurrentPopup() {
 		popup_idx--
 	}
 	//IMGUI_DEBUG_LOG_POPUP("CloseCurrentPopup %d . %d\n", g.BeginPopupStack.Size-1, popup_idx)
-	ClosePopupToLevel(popup_idx, true)
+	g.ClosePopupToLevel(popup_idx, true)
 
 	// A common pattern is to close a popup when selecting a menu item/selectable that will open another window.
 	// To improve this usage pattern, we avoid nav highlight for a single frame in the parent window.
@@ -174,8 +168,7 @@ func CloseCurrentPopup() {
 //     The main difference being that this is tweaked to avoid computing the ID twice.
 //
 // open+begin popup when clicked on last item. Use str_id==NULL to associate the popup to previous item. If you want to use that on a non-interactive item such as Text() you need to pass in an explicit ID here. read comments in .cpp!
-func BeginPopupContextItem(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginPopupContextItem(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) bool {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return false
@@ -186,31 +179,41 @@ func BeginPopupContextItem(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*
 	}
 	IM_ASSERT(id != 0) // You cannot pass a nil str_id if the last item has no identifier (e.g. a Text() item)
 	var mouse_button = ImGuiMouseButton(popup_flags & ImGuiPopupFlags_MouseButtonMask_)
-	if IsMouseReleased(mouse_button) && IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup) {
-		OpenPopupEx(id, popup_flags)
+	if g.IsMouseReleased(mouse_button) && g.IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup) {
+		g.OpenPopupEx(id, popup_flags)
 	}
-	return BeginPopupEx(id, ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoSavedSettings)
+	return g.BeginPopupEx(id, ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoSavedSettings)
 }
 
-func BeginPopupContextVoid(str_id string, popup_flags ImGuiPopupFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginPopupContextVoid(str_id string, popup_flags ImGuiPopupFlags) bool {
 	var window = g.CurrentWindow
 	if str_id == "" {
 		str_id = "void_context"
 	}
 	var id = window.GetIDs(str_id)
 	var mouse_button = ImGuiMouseButton(popup_flags & ImGuiPopupFlags_MouseButtonMask_)
-	if IsMouseReleased(mouse_button) && !IsWindowHovered(ImGuiHoveredFlags_AnyWindow) {
-		if GetTopMostPopupModal() == nil {
-			OpenPopupEx(id, popup_flags)
+	if g.IsMouseReleased(mouse_button) && !g.IsWindowHovered(ImGuiHoveredFlags_AnyWindow) {
+		if g.GetTopMostPopupModal() == nil {
+			g.OpenPopupEx(id, popup_flags)
 		}
 	}
-	return BeginPopupEx(id, ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoSavedSettings)
+	return g.BeginPopupEx(id, ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoSavedSettings)
 }
 
 // BeginPopupContextWindow open+begin popup when clicked on current window.
-func BeginPopupContextWindow(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) bool {
-	panic("not implemented")
+func (g *ImGuiContext) BeginPopupContextWindow(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*/) bool {
+	var window = g.CurrentWindow
+	if str_id == "" {
+		str_id = "window_context"
+	}
+	var id = window.GetIDs(str_id)
+	var mouse_button = ImGuiMouseButton(popup_flags & ImGuiPopupFlags_MouseButtonMask_)
+	if g.IsMouseReleased(mouse_button) && g.IsWindowHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup) {
+		if popup_flags&ImGuiPopupFlags_NoOpenOverItems == 0 || !g.IsAnyItemHovered() {
+			g.OpenPopupEx(id, popup_flags)
+		}
+	}
+	return g.BeginPopupEx(id, ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoSavedSettings)
 }
 
 // BeginPopupContext open+begin popup when clicked in  (where there are no windows).
@@ -224,8 +227,7 @@ func BeginPopupContext(str_id string /*= L*/, popup_flags ImGuiPopupFlags /*= 1*
 //   - IsPopupOpen() with ImGuiPopupFlags_AnyPopupId + ImGuiPopupFlags_AnyPopupLevel: return true if any popup is open.
 //
 // return true if the popup is open.
-func IsPopupOpen(str_id string, flags ImGuiPopupFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsPopupOpen(str_id string, flags ImGuiPopupFlags) bool {
 	var id ImGuiID
 	if flags&ImGuiPopupFlags_AnyPopupId == 0 {
 		id = g.CurrentWindow.GetIDs(str_id)
@@ -233,11 +235,10 @@ func IsPopupOpen(str_id string, flags ImGuiPopupFlags) bool {
 	if (flags&ImGuiPopupFlags_AnyPopupLevel != 0) && id != 0 {
 		IM_ASSERT_USER_ERROR(false, "Cannot use IsPopupOpen() with a string id and ImGuiPopupFlags_AnyPopupLevel.") // But non-string version is legal and used internally
 	}
-	return IsPopupOpenID(id, flags)
+	return g.IsPopupOpenID(id, flags)
 }
 
-func GetTopMostPopupModal() *ImGuiWindow {
-	var g = GImGui
+func (g *ImGuiContext) GetTopMostPopupModal() *ImGuiWindow {
 	for n := len(g.OpenPopupStack) - 1; n >= 0; n-- {
 		if popup := g.OpenPopupStack[n].Window; popup != nil {
 			if popup.Flags&ImGuiWindowFlags_Modal != 0 {
@@ -249,9 +250,8 @@ func GetTopMostPopupModal() *ImGuiWindow {
 }
 
 // GetPopupAllowedExtentRect Note that this is used for popups, which can overlap the non work-area of individual viewports.
-func GetPopupAllowedExtentRect(*ImGuiWindow) ImRect {
-	var g = GImGui
-	var r_screen = GetMainViewport().GetMainRect()
+func (g *ImGuiContext) GetPopupAllowedExtentRect(window *ImGuiWindow) ImRect {
+	var r_screen = g.GetMainViewport().GetMainRect()
 	var padding = g.Style.DisplaySafeAreaPadding
 
 	var x, y float
@@ -266,10 +266,8 @@ func GetPopupAllowedExtentRect(*ImGuiWindow) ImRect {
 	return r_screen
 }
 
-func FindBestWindowPosForPopup(window *ImGuiWindow) ImVec2 {
-	var g = GImGui
-
-	var r_outer = GetPopupAllowedExtentRect(window)
+func (g *ImGuiContext) FindBestWindowPosForPopup(window *ImGuiWindow) ImVec2 {
+	var r_outer = g.GetPopupAllowedExtentRect(window)
 	if window.Flags&ImGuiWindowFlags_ChildMenu != 0 {
 		// Child menus typically request _any_ position within the parent menu item, and then we move the new menu outside the parent bounds.
 		// This is how we end up with child menus appearing (most-commonly) on the right of the parent menu.
@@ -291,7 +289,7 @@ func FindBestWindowPosForPopup(window *ImGuiWindow) ImVec2 {
 	if window.Flags&ImGuiWindowFlags_Tooltip != 0 {
 		// Position tooltip (always follows mouse)
 		var sc = g.Style.MouseCursorScale
-		var ref_pos = NavCalcPreferredRefPos()
+		var ref_pos = g.NavCalcPreferredRefPos()
 		var r_avoid ImRect
 		if !g.NavDisableHighlight && g.NavDisableMouseHover && g.IO.ConfigFlags&ImGuiConfigFlags_NavEnableSetMousePos == 0 {
 			r_avoid = ImRect{ImVec2{ref_pos.x - 16, ref_pos.y - 8}, ImVec2{ref_pos.x + 16, ref_pos.y + 8}}
@@ -452,8 +450,7 @@ func FindBestWindowPosForPopupEx(ref_pos *ImVec2, size *ImVec2, last_dir *ImGuiD
 
 // ClosePopupsOverWindow When popups are stacked, clicking on a lower level popups puts focus back to it and close popups above it.
 // This function closes any popups that are over 'ref_window'.
-func ClosePopupsOverWindow(ref_window *ImGuiWindow, restore_focus_to_window_under_popup bool) {
-	var g = GImGui
+func (g *ImGuiContext) ClosePopupsOverWindow(ref_window *ImGuiWindow, restore_focus_to_window_under_popup bool) {
 	if len(g.OpenPopupStack) == 0 {
 		return
 	}
@@ -493,13 +490,12 @@ func ClosePopupsOverWindow(ref_window *ImGuiWindow, restore_focus_to_window_unde
 	}
 	if popup_count_to_keep < int(len(g.OpenPopupStack)) { // This test is not required but it allows to set a convenient breakpoint on the statement below
 		//IMGUI_DEBUG_LOG_POPUP("ClosePopupsOverWindow(\"%s\") . ClosePopupToLevel(%d)\n", ref_window.Name, popup_count_to_keep)
-		ClosePopupToLevel(popup_count_to_keep, restore_focus_to_window_under_popup)
+		g.ClosePopupToLevel(popup_count_to_keep, restore_focus_to_window_under_popup)
 	}
 }
 
 // IsPopupOpenID Supported flags: ImGuiPopupFlags_AnyPopupId, ImGuiPopupFlags_AnyPopupLevel
-func IsPopupOpenID(id ImGuiID, popup_flags ImGuiPopupFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsPopupOpenID(id ImGuiID, popup_flags ImGuiPopupFlags) bool {
 	if popup_flags&ImGuiPopupFlags_AnyPopupId != 0 {
 		// Return true if any popup is open at the current BeginPopup() level of the popup stack
 		// This may be used to e.g. test for another popups already opened to handle popups priorities at the same level.
@@ -530,13 +526,12 @@ func IsPopupOpenID(id ImGuiID, popup_flags ImGuiPopupFlags) bool {
 // Popups are closed when user click outside, or activate a pressable item, or CloseCurrentPopup() is called within a BeginPopup()/EndPopup() block.
 // Popup identifiers are relative to the current ID-stack (so OpenPopup and BeginPopup needs to be at the same level).
 // One open popup per level of the popup hierarchy (NB: when assigning we reset the Window member of ImGuiPopupRef to nil)
-func OpenPopupEx(id ImGuiID, popup_flags ImGuiPopupFlags) {
-	var g = GImGui
+func (g *ImGuiContext) OpenPopupEx(id ImGuiID, popup_flags ImGuiPopupFlags) {
 	var parent_window = g.CurrentWindow
 	var current_stack_size = int(len(g.BeginPopupStack))
 
 	if popup_flags&ImGuiPopupFlags_NoOpenOverExistingPopup != 0 {
-		if IsPopupOpen("", ImGuiPopupFlags_AnyPopupId) {
+		if g.IsPopupOpen("", ImGuiPopupFlags_AnyPopupId) {
 			return
 		}
 	}
@@ -547,8 +542,8 @@ func OpenPopupEx(id ImGuiID, popup_flags ImGuiPopupFlags) {
 	popup_ref.SourceWindow = g.NavWindow
 	popup_ref.OpenFrameCount = g.FrameCount
 	popup_ref.OpenParentId = parent_window.IDStack[len(parent_window.IDStack)-1]
-	popup_ref.OpenPopupPos = NavCalcPreferredRefPos()
-	if IsMousePosValid(&g.IO.MousePos) {
+	popup_ref.OpenPopupPos = g.NavCalcPreferredRefPos()
+	if g.IsMousePosValid(&g.IO.MousePos) {
 		popup_ref.OpenMousePos = g.IO.MousePos
 	} else {
 		popup_ref.OpenMousePos = popup_ref.OpenPopupPos
@@ -565,7 +560,7 @@ func OpenPopupEx(id ImGuiID, popup_flags ImGuiPopupFlags) {
 			g.OpenPopupStack[current_stack_size].OpenFrameCount = popup_ref.OpenFrameCount
 		} else {
 			// Close child popups if any, then flag popup for open/reopen
-			ClosePopupToLevel(current_stack_size, false)
+			g.ClosePopupToLevel(current_stack_size, false)
 			g.OpenPopupStack = append(g.OpenPopupStack, popup_ref)
 		}
 
@@ -576,8 +571,7 @@ func OpenPopupEx(id ImGuiID, popup_flags ImGuiPopupFlags) {
 	}
 }
 
-func ClosePopupToLevel(remaining int, restore_focus_to_window_under_popup bool) {
-	var g = GImGui
+func (g *ImGuiContext) ClosePopupToLevel(remaining int, restore_focus_to_window_under_popup bool) {
 	//IMGUI_DEBUG_LOG_POPUP("ClosePopupToLevel(%d), restore_focus_to_window_under_popup=%d\n", remaining, restore_focus_to_window_under_popup)
 	IM_ASSERT(remaining >= 0 && remaining < int(len(g.OpenPopupStack)))
 
@@ -589,18 +583,17 @@ func ClosePopupToLevel(remaining int, restore_focus_to_window_under_popup bool)
 	if restore_focus_to_window_under_popup {
 		if focus_window != nil && !focus_window.WasActive && popup_window != nil {
 			// Fallback
-			FocusTopMostWindowUnderOne(popup_window, nil)
+			g.FocusTopMostWindowUnderOne(popup_window, nil)
 		} else {
 			if g.NavLayer == ImGuiNavLayer_Main && focus_window != nil {
 				focus_window = NavRestoreLastChildNavWindow(focus_window)
 			}
-			FocusWindow(focus_window)
+			g.FocusWindow(focus_window)
 		}
 	}
 }
 
-func isPopupOpen(id ImGuiID, popup_flags ImGuiPopupFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) isPopupOpen(id ImGuiID, popup_flags ImGuiPopupFlags) bool {
 	if (popup_flags & ImGuiPopupFlags_AnyPopupId) != 0 {
 		// Return true if any popup is open at the current BeginPopup() level of the popup stack
 		// This may be used to e.g. test for another popups already opened to handle popups priorities at the same level.
@@ -627,9 +620,8 @@ func isPopupOpen(id ImGuiID, popup_flags ImGuiPopupFlags) bool {
 }
 
 // BeginPopupEx Attention! BeginPopup() adds default flags which BeginPopupEx()!
-func BeginPopupEx(id ImGuiID, flags ImGuiWindowFlags) bool {
-	var g = GImGui
-	if !isPopupOpen(id, ImGuiPopupFlags_None) {
+func (g *ImGuiContext) BeginPopupEx(id ImGuiID, flags ImGuiWindowFlags) bool {
+	if !g.isPopupOpen(id, ImGuiPopupFlags_None) {
 		g.NextWindowData.ClearFlags() // We behave like Begin() and need to consume those values
 		return false
 	}
@@ -642,9 +634,9 @@ func BeginPopupEx(id ImGuiID, flags ImGuiWindowFlags) bool {
 	}
 
 	flags |= ImGuiWindowFlags_Popup
-	var is_open = Begin(string(name[:]), nil, flags)
+	var is_open = g.Begin(string(name[:]), nil, flags)
 	if !is_open { // NB: Begin can return false when the popup is completely clipped (e.g. zero size display)
-		EndPopup()
+		g.EndPopup()
 	}
 
 	return is_open
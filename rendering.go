@@ -1,12 +1,11 @@
 package imgui
 
-func RenderFrameBorder(p_min ImVec2, p_max ImVec2, rounding float) {
-	var g = GImGui
+func (g *ImGuiContext) RenderFrameBorder(p_min ImVec2, p_max ImVec2, rounding float) {
 	var window = g.CurrentWindow
 	var border_size = g.Style.FrameBorderSize
 	if border_size > 0.0 {
-		window.DrawList.AddRect(p_min.Add(ImVec2{1, 1}), p_max.Add(ImVec2{1, 1}), GetColorU32FromID(ImGuiCol_BorderShadow, 1), rounding, 0, border_size)
-		window.DrawList.AddRect(p_min, p_max, GetColorU32FromID(ImGuiCol_Border, 1), rounding, 0, border_size)
+		window.DrawList.AddRect(p_min.Add(ImVec2{1, 1}), p_max.Add(ImVec2{1, 1}), g.GetColorU32FromID(ImGuiCol_BorderShadow, 1), rounding, 0, border_size)
+		window.DrawList.AddRect(p_min, p_max, g.GetColorU32FromID(ImGuiCol_Border, 1), rounding, 0, border_size)
 	}
 }
 
@@ -54,12 +53,11 @@ func RenderArrow(draw_list *ImDrawList, pos ImVec2, col ImU32, dir ImGuiDir, sca
 // Prepare the data for rendering so you can call GetDrawData()
 // (As with anything within the ImGui:: namspace this doesn't touch your GPU or graphics API at all:
 // it is the role of the ImGui_ImplXXXX_RenderDrawData() function provided by the renderer backend).
-func Render() {
-	var g = GImGui
+func (g *ImGuiContext) Render() {
 	IM_ASSERT(g.Initialized)
 
 	if g.FrameCountEnded != g.FrameCount {
-		EndFrame()
+		g.EndFrame()
 	}
 	g.FrameCountRendered = g.FrameCount
 	g.IO.MetricsRenderWindows = 0
@@ -71,7 +69,7 @@ func Render() {
 		var viewport = g.Viewports[n]
 		viewport.DrawDataBuilder.Clear()
 		if viewport.DrawLists[0] != nil {
-			AddDrawListToDrawData(&viewport.DrawDataBuilder[0], getBackgroundDrawList(viewport))
+			AddDrawListToDrawData(&viewport.DrawDataBuilder[0], g.GetBackgroundDrawList(viewport))
 		}
 	}
 
@@ -87,12 +85,12 @@ func Render() {
 	for n := range g.Windows {
 		var window = g.Windows[n]
 		if IsWindowActiveAndVisible(window) && (window.Flags&ImGuiWindowFlags_ChildWindow) == 0 && window != windows_to_render_top_most[0] && window != windows_to_render_top_most[1] {
-			AddRootWindowToDrawData(window)
+			g.AddRootWindowToDrawData(window)
 		}
 	}
 	for n := range windows_to_render_top_most {
 		if windows_to_render_top_most[n] != nil && IsWindowActiveAndVisible(windows_to_render_top_most[n]) { // NavWindowingTarget is always temporarily displayed as the top-most window
-			AddRootWindowToDrawData(windows_to_render_top_most[n])
+			g.AddRootWindowToDrawData(windows_to_render_top_most[n])
 		}
 	}
 
@@ -105,15 +103,15 @@ func Render() {
 
 		// Draw software mouse cursor if requested by io.MouseDrawCursor flag
 		if g.IO.MouseDrawCursor {
-			RenderMouseCursor(GetForegroundDrawListViewport(viewport), g.IO.MousePos, g.Style.MouseCursorScale, g.MouseCursor, IM_COL32_WHITE, IM_COL32_BLACK, IM_COL32(0, 0, 0, 48))
+			RenderMouseCursor(g.GetForegroundDrawListViewport(viewport), g.IO.MousePos, g.Style.MouseCursorScale, g.MouseCursor, IM_COL32_WHITE, IM_COL32_BLACK, IM_COL32(0, 0, 0, 48))
 		}
 
 		// Add foreground ImDrawList (for each active viewport)
 		if viewport.DrawLists[1] != nil {
-			AddDrawListToDrawData(&viewport.DrawDataBuilder[0], GetForegroundDrawListViewport(viewport))
+			AddDrawListToDrawData(&viewport.DrawDataBuilder[0], g.GetForegroundDrawListViewport(viewport))
 		}
 
-		SetupViewportDrawData(viewport, &viewport.DrawDataBuilder[0])
+		g.SetupViewportDrawData(viewport, &viewport.DrawDataBuilder[0])
 		var draw_data = &viewport.DrawDataP
 		g.IO.MetricsRenderVertices += draw_data.TotalVtxCount
 		g.IO.MetricsRenderIndices += draw_data.TotalIdxCount
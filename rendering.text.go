@@ -1,29 +1,32 @@
 package imgui
 
-import "fmt"
+import (
+	"fmt"
+	"unicode/utf8"
+)
 
 // ImTextCharFromUtf8 read one character. return input UTF-8 bytes count
 func ImTextCharFromUtf8(out_char *rune, text string) int {
-	for i, c := range text {
-		*out_char = rune(c)
-		return int(i) + 1
+	if len(text) == 0 {
+		*out_char = 0
+		return 0
 	}
-	*out_char = 0
-	return 0
+	var c, n = utf8.DecodeRuneInString(text)
+	*out_char = c
+	return int(n)
 }
 
 // TextColored shortcut for PushStyleColor(ImGuiCol_Text, col); Text(fmt, ...); PopStyleColor()  {panic("not implemented")}
-func TextColored(col *ImVec4, format string, args ...any) {
-	PushStyleColorVec(ImGuiCol_Text, col)
-	Text(format, args...)
-	PopStyleColor(1)
+func (g *ImGuiContext) TextColored(col *ImVec4, format string, args ...any) {
+	g.PushStyleColorVec(ImGuiCol_Text, col)
+	g.Text(format, args...)
+	g.PopStyleColor(1)
 }
 
 // RenderText Render helpers
 // AVOID USING OUTSIDE OF IMGUI.CPP! NOT FOR PUBLIC CONSUMPTION. THOSE FUNCTIONS ARE A MESS. THEIR SIGNATURE AND BEHAVIOR WILL CHANGE, THEY NEED TO BE REFACTORED INTO SOMETHING DECENT.
 // NB: All position are in absolute pixels coordinates (we are never using window coordinates internally)
-func RenderText(pos ImVec2, text string, hide_text_after_hash bool /*= true*/) {
-	var g = GImGui
+func (g *ImGuiContext) RenderText(pos ImVec2, text string, hide_text_after_hash bool /*= true*/) {
 	var window = g.CurrentWindow
 
 	// Hide anything after a '##' string
@@ -34,21 +37,20 @@ func RenderText(pos ImVec2, text string, hide_text_after_hash bool /*= true*/) {
 	}
 
 	if text != "" {
-		window.DrawList.AddTextV(g.Font, g.FontSize, pos, GetColorU32FromID(ImGuiCol_Text, 1), text, 0, nil)
+		window.DrawList.AddTextV(g.Font, g.FontSize, pos, g.GetColorU32FromID(ImGuiCol_Text, 1), text, 0, nil)
 		if g.LogEnabled {
-			LogRenderedText(&pos, text)
+			g.LogRenderedText(&pos, text)
 		}
 	}
 }
 
-func RenderTextWrapped(pos ImVec2, text string, wrap_width float) {
-	var g = GImGui
+func (g *ImGuiContext) RenderTextWrapped(pos ImVec2, text string, wrap_width float) {
 	var window = g.CurrentWindow
 
 	if len(text) > 0 {
-		window.DrawList.AddTextV(g.Font, g.FontSize, pos, GetColorU32FromID(ImGuiCol_Text, 1), text, wrap_width, nil)
+		window.DrawList.AddTextV(g.Font, g.FontSize, pos, g.GetColorU32FromID(ImGuiCol_Text, 1), text, wrap_width, nil)
 		if g.LogEnabled {
-			LogRenderedText(&pos, text)
+			g.LogRenderedText(&pos, text)
 		}
 	}
 }
@@ -56,13 +58,12 @@ func RenderTextWrapped(pos ImVec2, text string, wrap_width float) {
 // RenderTextEllipsis Another overly complex function until we reorganize everything into a nice all-in-one helper.
 // This is made more complex because we have dissociated the layout rectangle (pos_min..pos_max) which define _where_ the ellipsis is, from actual clipping of text and limit of the ellipsis display.
 // This is because in the context of tabs we selectively hide part of the text when the Close Button appears, but we don't want the ellipsis to move.
-func RenderTextEllipsis(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2, clip_max_x float, ellipsis_max_x float, text string, text_size_if_known *ImVec2) {
-	var g = GImGui
+func (g *ImGuiContext) RenderTextEllipsis(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2, clip_max_x float, ellipsis_max_x float, text string, text_size_if_known *ImVec2) {
 	var text_size ImVec2
 	if text_size_if_known != nil {
 		text_size = *text_size_if_known
 	} else {
-		CalcTextSize(text, false, 0.0)
+		g.CalcTextSize(text, false, 0.0)
 	}
 
 	//draw_list.AddLine(ImVec2(pos_max.x, pos_min.y - 4), ImVec2(pos_max.x, pos_max.y + 4), IM_COL32(0, 0, 255, 255));
@@ -121,40 +122,38 @@ func RenderTextEllipsis(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2,
 		}
 
 		// Render text, render ellipsis
-		RenderTextClippedEx(draw_list, pos_min, &ImVec2{clip_max_x, pos_max.y}, text, &text_size, &ImVec2{0.0, 0.0}, nil)
+		g.RenderTextClippedEx(draw_list, pos_min, &ImVec2{clip_max_x, pos_max.y}, text, &text_size, &ImVec2{0.0, 0.0}, nil)
 		var ellipsis_x = pos_min.x + text_size_clipped_x
 		if ellipsis_x+ellipsis_total_width <= ellipsis_max_x {
 			for i := 0; int(i) < ellipsis_char_count; i++ {
-				font.RenderChar(draw_list, font_size, ImVec2{ellipsis_x, pos_min.y}, GetColorU32FromID(ImGuiCol_Text, 1), ellipsis_char)
+				font.RenderChar(draw_list, font_size, ImVec2{ellipsis_x, pos_min.y}, g.GetColorU32FromID(ImGuiCol_Text, 1), ellipsis_char)
 				ellipsis_x += ellipsis_glyph_width
 			}
 		}
 	} else {
-		RenderTextClippedEx(draw_list, pos_min, &ImVec2{clip_max_x, pos_max.y}, text, &text_size, &ImVec2{0.0, 0.0}, nil)
+		g.RenderTextClippedEx(draw_list, pos_min, &ImVec2{clip_max_x, pos_max.y}, text, &text_size, &ImVec2{0.0, 0.0}, nil)
 	}
 
 	if g.LogEnabled {
-		LogRenderedText(pos_min, text)
+		g.LogRenderedText(pos_min, text)
 	}
 }
 
 // Text formatted text
-func Text(format string, args ...any) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Text(format string, args ...any) {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
-	TextEx(fmt.Sprintf(format, args...), ImGuiTextFlags_NoWidthForLargeClippedText)
+	g.TextEx(fmt.Sprintf(format, args...), ImGuiTextFlags_NoWidthForLargeClippedText)
 }
 
-func TextEx(text string, flags ImGuiTextFlags) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) TextEx(text string, flags ImGuiTextFlags) {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
 
-	var g = GImGui
-
 	var text_pos = ImVec2{window.DC.CursorPos.x, window.DC.CursorPos.y + window.DC.CurrLineTextBaseOffset}
 	var wrap_pos_x = window.DC.TextWrapPos
 	var wrap_enabled = wrap_pos_x >= 0.0
@@ -165,7 +164,7 @@ func TextEx(text string, flags ImGuiTextFlags) {
 		// - We also don't vertically center the text within the line full height, which is unlikely to matter because we are likely the biggest and only item on the line.
 		// - We use memchr(), pay attention that well optimized versions of those str/mem functions are much faster than a casually written loop.
 		var line int = 0
-		var line_height = GetTextLineHeight()
+		var line_height = g.GetTextLineHeight()
 		var text_size ImVec2
 
 		// Lines to skip (can't skip when logging text)
@@ -179,7 +178,7 @@ func TextEx(text string, flags ImGuiTextFlags) {
 					for line_end = line; line_end < int(len(text)) && text[line_end] != '\n'; line_end++ {
 					}
 					if (flags & ImGuiTextFlags_NoWidthForLargeClippedText) == 0 {
-						text_size.x = ImMax(text_size.x, CalcTextSize(text[line:line_end], false, 0).x)
+						text_size.x = ImMax(text_size.x, g.CalcTextSize(text[line:line_end], false, 0).x)
 					}
 					line = line_end + 1
 					lines_skipped++
@@ -192,15 +191,15 @@ func TextEx(text string, flags ImGuiTextFlags) {
 		if line < int(len(text)) {
 			var line_rect = ImRect{pos, pos.Add(ImVec2{FLT_MAX, line_height})}
 			for line < int(len(text)) {
-				if IsClippedEx(&line_rect, 0, false) {
+				if g.IsClippedEx(&line_rect, 0, false) {
 					break
 				}
 
 				var line_end int
 				for line_end = line; line_end < int(len(text)) && text[line_end] != '\n'; line_end++ {
 				}
-				text_size.x = ImMax(text_size.x, CalcTextSize(text[line:], false, 0).x)
-				RenderText(pos, text[line:line_end], false)
+				text_size.x = ImMax(text_size.x, g.CalcTextSize(text[line:], false, 0).x)
+				g.RenderText(pos, text[line:line_end], false)
 				line = line_end + 1
 				line_rect.Min.y += line_height
 				line_rect.Max.y += line_height
@@ -214,7 +213,7 @@ func TextEx(text string, flags ImGuiTextFlags) {
 				for line_end = line; line_end < int(len(text)) && text[line_end] != '\n'; line_end++ {
 				}
 				if (flags & ImGuiTextFlags_NoWidthForLargeClippedText) == 0 {
-					text_size.x = ImMax(text_size.x, CalcTextSize(text[line:line_end], false, 0).x)
+					text_size.x = ImMax(text_size.x, g.CalcTextSize(text[line:line_end], false, 0).x)
 				}
 				line = line_end + 1
 				lines_skipped++
@@ -224,23 +223,23 @@ func TextEx(text string, flags ImGuiTextFlags) {
 		text_size.y = (pos.Sub(text_pos)).y
 
 		var bb = ImRect{text_pos, text_pos.Add(text_size)}
-		ItemSizeVec(&text_size, 0.0)
-		ItemAdd(&bb, 0, nil, 0)
+		g.ItemSizeVec(&text_size, 0.0)
+		g.ItemAdd(&bb, 0, nil, 0)
 	} else {
 		var wrap_width float
 		if wrap_enabled {
-			wrap_width = CalcWrapWidthForPos(&window.DC.CursorPos, wrap_pos_x)
+			wrap_width = g.CalcWrapWidthForPos(&window.DC.CursorPos, wrap_pos_x)
 		}
-		var text_size = CalcTextSize(text, false, wrap_width)
+		var text_size = g.CalcTextSize(text, false, wrap_width)
 
 		var bb = ImRect{text_pos, text_pos.Add(text_size)}
-		ItemSizeVec(&text_size, 0.0)
-		if !ItemAdd(&bb, 0, nil, 0) {
+		g.ItemSizeVec(&text_size, 0.0)
+		if !g.ItemAdd(&bb, 0, nil, 0) {
 			return
 		}
 
 		// Render (we don't hide text after ## in this end-user function)
-		RenderTextWrapped(bb.Min, text, wrap_width)
+		g.RenderTextWrapped(bb.Min, text, wrap_width)
 	}
 }
 
@@ -290,9 +289,7 @@ func FindRenderedTextEnd(t string) string {
 }
 
 // CalcTextSize Text Utilities
-func CalcTextSize(text string, hide_text_after_double_hash bool /*= true*/, wrap_width float /*= -1.0*/) ImVec2 {
-	var g = GImGui
-
+func (g *ImGuiContext) CalcTextSize(text string, hide_text_after_double_hash bool /*= true*/, wrap_width float /*= -1.0*/) ImVec2 {
 	var text_display_end string
 	if hide_text_after_double_hash {
 		text = FindRenderedTextEnd(text) // Hide anything after a '##' string
@@ -317,7 +314,7 @@ func CalcTextSize(text string, hide_text_after_double_hash bool /*= true*/, wrap
 
 // RenderTextClippedEx Default clip_rect uses (pos_min,pos_max)
 // Handle clipping on CPU immediately (vs typically let the GPU clip the triangles that are overlapping the clipping rectangle edges)
-func RenderTextClippedEx(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2, text string, text_size_if_known *ImVec2, align *ImVec2, clip_rect *ImRect) {
+func (g *ImGuiContext) RenderTextClippedEx(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2, text string, text_size_if_known *ImVec2, align *ImVec2, clip_rect *ImRect) {
 	if align == nil {
 		align = &ImVec2{0.0, 0.0}
 	}
@@ -328,7 +325,7 @@ func RenderTextClippedEx(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2
 	if text_size_if_known != nil {
 		text_size = *text_size_if_known
 	} else {
-		text_size = CalcTextSize(text, false, 0.0)
+		text_size = g.CalcTextSize(text, false, 0.0)
 	}
 
 	var clip_min = pos_min
@@ -353,24 +350,23 @@ func RenderTextClippedEx(draw_list *ImDrawList, pos_min *ImVec2, pos_max *ImVec2
 	// Render
 	if need_clipping {
 		var fine_clip_rect = ImVec4{clip_min.x, clip_min.y, clip_max.x, clip_max.y}
-		draw_list.AddTextV(nil, 0.0, pos, GetColorU32FromID(ImGuiCol_Text, 1), text, 0.0, &fine_clip_rect)
+		draw_list.AddTextV(nil, 0.0, pos, g.GetColorU32FromID(ImGuiCol_Text, 1), text, 0.0, &fine_clip_rect)
 	} else {
-		draw_list.AddTextV(nil, 0.0, pos, GetColorU32FromID(ImGuiCol_Text, 1), text, 0.0, nil)
+		draw_list.AddTextV(nil, 0.0, pos, g.GetColorU32FromID(ImGuiCol_Text, 1), text, 0.0, nil)
 	}
 }
 
-func RenderTextClipped(pos_min *ImVec2, pos_max *ImVec2, text string, text_size_if_known *ImVec2, align *ImVec2, clip_rect *ImRect) {
+func (g *ImGuiContext) RenderTextClipped(pos_min *ImVec2, pos_max *ImVec2, text string, text_size_if_known *ImVec2, align *ImVec2, clip_rect *ImRect) {
 	// Hide anything after a '##' string
 	text = FindRenderedTextEnd(text)
 	if len(text) == 0 {
 		return
 	}
 
-	var g = GImGui
 	var window = g.CurrentWindow
-	RenderTextClippedEx(window.DrawList, pos_min, pos_max, text, text_size_if_known, align, clip_rect)
+	g.RenderTextClippedEx(window.DrawList, pos_min, pos_max, text, text_size_if_known, align, clip_rect)
 	if g.LogEnabled {
-		LogRenderedText(pos_min, text)
+		g.LogRenderedText(pos_min, text)
 	}
 }
 
@@ -0,0 +1,114 @@
+package replay
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Splizard/imgui"
+)
+
+// MismatchError reports a replayed frame whose draw data differs from the recording.
+type MismatchError struct {
+	Frame     int    // Index of the frame in the recording.
+	Want, Got uint64 // Draw data hashes.
+}
+
+func (err *MismatchError) Error() string {
+	return fmt.Sprintf("replay: frame %d: draw data hash %016x, recorded %016x", err.Frame, err.Got, err.Want)
+}
+
+// Player feeds recorded frames into a context, see the package documentation.
+type Player struct {
+	ctx    *imgui.ImGuiContext
+	frames []Frame
+	next   int  // Index of the next frame to play.
+	played bool // A recorded frame was applied by the current frame.
+	hooks  []imgui.ImGuiID
+	err    error
+}
+
+// NewPlayer reads a recording and plays it on ctx: every call to imgui.NewFrame() replaces the inputs of
+// ctx.IO with the next recorded frame, until all of them are played.
+func NewPlayer(ctx *imgui.ImGuiContext, r io.Reader) (*Player, error) {
+	frames, err := ReadFrames(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewPlayerFrames(ctx, frames), nil
+}
+
+// NewPlayerFrames plays frames on ctx, see NewPlayer.
+func NewPlayerFrames(ctx *imgui.ImGuiContext, frames []Frame) *Player {
+	player := &Player{ctx: ctx, frames: frames}
+	player.hooks = append(player.hooks,
+		imgui.AddContextHook(ctx, &imgui.ImGuiContextHook{
+			Type:     imgui.ImGuiContextHookType_NewFramePre,
+			Callback: func(ctx *imgui.ImGuiContext, _ *imgui.ImGuiContextHook) { player.newFramePre(ctx) },
+		}),
+		imgui.AddContextHook(ctx, &imgui.ImGuiContextHook{
+			Type:     imgui.ImGuiContextHookType_RenderPost,
+			Callback: func(*imgui.ImGuiContext, *imgui.ImGuiContextHook) { player.renderPost() },
+		}),
+	)
+	return player
+}
+
+func (player *Player) newFramePre(ctx *imgui.ImGuiContext) {
+	player.played = !player.Done()
+	if !player.played {
+		return
+	}
+	player.frames[player.next].apply(&ctx.IO)
+	player.next++
+}
+
+func (player *Player) renderPost() {
+	if !player.played || player.err != nil {
+		return
+	}
+	n := player.next - 1
+	want := player.frames[n].DrawDataHash
+	if want == 0 {
+		return // The frame was not rendered while recording
+	}
+	if got := HashDrawData(imgui.GetDrawData()); got != want {
+		player.err = &MismatchError{Frame: n, Want: want, Got: got}
+	}
+}
+
+// Len returns the number of frames of the recording.
+func (player *Player) Len() int {
+	return len(player.frames)
+}
+
+// Frame returns the number of frames played so far.
+func (player *Player) Frame() int {
+	return player.next
+}
+
+// Done returns true once all the frames have been played.
+func (player *Player) Done() bool {
+	return player.next >= len(player.frames)
+}
+
+// Err returns a *MismatchError for the first replayed frame whose draw data differs from the recording.
+func (player *Player) Err() error {
+	return player.err
+}
+
+// Run plays the remaining frames, calling gui for each of them between imgui.NewFrame() and imgui.Render(),
+// and returns the first draw data mismatch. It stops at the first mismatch.
+func (player *Player) Run(gui func(ui *imgui.ImGuiUI)) error {
+	for !player.Done() && player.err == nil {
+		player.ctx.Frame(gui)
+	}
+	return player.err
+}
+
+// Close stops playing the recording.
+func (player *Player) Close() {
+	for _, hook := range player.hooks {
+		imgui.RemoveContextHook(player.ctx, hook)
+	}
+	player.hooks = nil
+}
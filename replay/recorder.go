@@ -0,0 +1,82 @@
+package replay
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/Splizard/imgui"
+)
+
+// Recorder writes the inputs of every frame of a context, see the package documentation.
+type Recorder struct {
+	ctx    *imgui.ImGuiContext
+	w      *bufio.Writer
+	hooks  []imgui.ImGuiID
+	enc    encoder
+	frames int
+	err    error
+}
+
+// NewRecorder starts recording the frames of ctx to w, from the next call to imgui.NewFrame().
+func NewRecorder(ctx *imgui.ImGuiContext, w io.Writer) (*Recorder, error) {
+	rec := &Recorder{ctx: ctx, w: bufio.NewWriter(w)}
+	rec.w.WriteString(magic)
+	rec.w.WriteByte(version)
+	if err := rec.w.Flush(); err != nil {
+		return nil, err
+	}
+
+	rec.hooks = append(rec.hooks,
+		imgui.AddContextHook(ctx, &imgui.ImGuiContextHook{
+			Type:     imgui.ImGuiContextHookType_NewFramePre,
+			Callback: func(ctx *imgui.ImGuiContext, _ *imgui.ImGuiContextHook) { rec.newFramePre(ctx) },
+		}),
+		imgui.AddContextHook(ctx, &imgui.ImGuiContextHook{
+			Type:     imgui.ImGuiContextHookType_RenderPost,
+			Callback: func(*imgui.ImGuiContext, *imgui.ImGuiContextHook) { rec.renderPost() },
+		}),
+	)
+	return rec, nil
+}
+
+func (rec *Recorder) newFramePre(ctx *imgui.ImGuiContext) {
+	f := captureFrame(&ctx.IO)
+	rec.write(rec.enc.encodeFrame(&f))
+	rec.frames++
+}
+
+func (rec *Recorder) renderPost() {
+	if rec.frames == 0 {
+		return // Render() of a frame started before the recording
+	}
+	rec.write(rec.enc.encodeHash(HashDrawData(imgui.GetDrawData())))
+}
+
+// write writes a record and flushes it, so that the recording is complete up to the last frame if the program crashes.
+func (rec *Recorder) write(record []byte) {
+	if rec.err != nil {
+		return
+	}
+	rec.w.Write(record)
+	rec.err = rec.w.Flush()
+}
+
+// Frames returns the number of frames recorded so far.
+func (rec *Recorder) Frames() int {
+	return rec.frames
+}
+
+// Err returns the first error met while writing the recording.
+func (rec *Recorder) Err() error {
+	return rec.err
+}
+
+// Close stops the recording and returns the first error met while writing it.
+// It does not close the underlying writer.
+func (rec *Recorder) Close() error {
+	for _, hook := range rec.hooks {
+		imgui.RemoveContextHook(rec.ctx, hook)
+	}
+	rec.hooks = nil
+	return rec.err
+}
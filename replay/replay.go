@@ -0,0 +1,593 @@
+// Package replay records the inputs of an imgui context frame by frame and plays them back,
+// to reproduce a session (e.g. from a bug report) and reach the exact same UI state.
+//
+// A Recorder captures the input state of the ImGuiIO at the start of every frame, through a NewFramePre
+// context hook, and writes it to a compact stream along with a hash of the draw data rendered by the frame.
+// A Player feeds the recorded frames back into the ImGuiIO and compares the hashes of the replayed draw data.
+//
+//	rec, err := replay.NewRecorder(ctx, file)
+//	...
+//	defer rec.Close()
+//
+// Replaying must start from the same state as the recording: a new context, with the same fonts, style,
+// configuration and .ini settings (e.g. io.IniFilename = ""), running the same GUI code. The clipboard and
+// other application data are not recorded.
+package replay
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+
+	"github.com/Splizard/imgui"
+)
+
+const (
+	magic   = "IMGUIREC"
+	version = 1
+)
+
+// Record tags.
+const (
+	tagFrame = 'F' // Input state of a frame.
+	tagHash  = 'H' // Draw data hash of the last frame.
+)
+
+// Fields present in a frame record, the others are unchanged since the previous frame.
+const (
+	fieldDeltaTime = 1 << iota
+	fieldDisplaySize
+	fieldMousePos
+	fieldMouseDown
+	fieldMouseWheel
+	fieldKeyMods
+	fieldKeysDown
+	fieldNavInputs
+	fieldChars
+	fieldEvents
+	fieldLegacyKeys
+)
+
+// Frame is the input state of an ImGuiIO at the start of a frame, before NewFrame() processes it.
+type Frame struct {
+	DeltaTime               float32
+	DisplaySize             imgui.ImVec2
+	DisplayFramebufferScale imgui.ImVec2
+
+	MousePos                            imgui.ImVec2
+	MouseDown                           [5]bool
+	MouseWheel, MouseWheelH             float32
+	KeyCtrl, KeyShift, KeyAlt, KeySuper bool
+	KeysDown                            []imgui.ImGuiKey // Keys down in the legacy io.KeysDown[] array.
+	NavInputs                           [imgui.ImGuiNavInput_COUNT]float32
+	Chars                               []imgui.ImWchar         // io.InputQueueCharacters
+	Events                              []imgui.ImGuiInputEvent // io.InputEventsQueue
+	BackendUsingLegacyKeyArrays         int8
+
+	DrawDataHash uint64 // Hash of the draw data rendered by the frame, 0 when it was not rendered.
+}
+
+// captureFrame reads the input state of io.
+func captureFrame(io *imgui.ImGuiIO) Frame {
+	f := Frame{
+		DeltaTime:                   io.DeltaTime,
+		DisplaySize:                 io.DisplaySize,
+		DisplayFramebufferScale:     io.DisplayFramebufferScale,
+		MousePos:                    io.MousePos,
+		MouseDown:                   io.MouseDown,
+		MouseWheel:                  io.MouseWheel,
+		MouseWheelH:                 io.MouseWheelH,
+		KeyCtrl:                     io.KeyCtrl,
+		KeyShift:                    io.KeyShift,
+		KeyAlt:                      io.KeyAlt,
+		KeySuper:                    io.KeySuper,
+		NavInputs:                   io.NavInputs,
+		Chars:                       append([]imgui.ImWchar(nil), io.InputQueueCharacters...),
+		Events:                      append([]imgui.ImGuiInputEvent(nil), io.InputEventsQueue...),
+		BackendUsingLegacyKeyArrays: io.BackendUsingLegacyKeyArrays,
+	}
+	for key, down := range io.KeysDown {
+		if down {
+			f.KeysDown = append(f.KeysDown, imgui.ImGuiKey(key))
+		}
+	}
+	return f
+}
+
+// apply overwrites the input state of io with the frame.
+func (f *Frame) apply(io *imgui.ImGuiIO) {
+	io.DeltaTime = f.DeltaTime
+	io.DisplaySize = f.DisplaySize
+	io.DisplayFramebufferScale = f.DisplayFramebufferScale
+	io.MousePos = f.MousePos
+	io.MouseDown = f.MouseDown
+	io.MouseWheel = f.MouseWheel
+	io.MouseWheelH = f.MouseWheelH
+	io.KeyCtrl = f.KeyCtrl
+	io.KeyShift = f.KeyShift
+	io.KeyAlt = f.KeyAlt
+	io.KeySuper = f.KeySuper
+	io.KeysDown = [imgui.ImGuiKey_COUNT]bool{}
+	for _, key := range f.KeysDown {
+		io.KeysDown[key] = true
+	}
+	io.NavInputs = f.NavInputs
+	io.InputQueueCharacters = append(io.InputQueueCharacters[:0], f.Chars...)
+	io.InputEventsQueue = append(io.InputEventsQueue[:0], f.Events...)
+	io.BackendUsingLegacyKeyArrays = f.BackendUsingLegacyKeyArrays
+}
+
+// HashDrawData returns a hash of the geometry of the draw data: vertices, indices and commands.
+// Texture identifiers are left out as they are assigned by the renderer and may change between runs.
+func HashDrawData(drawData *imgui.ImDrawData) uint64 {
+	h := fnv.New64a()
+	var buf []byte
+	putFloat := func(v float32) { buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(v)) }
+	putVec2 := func(v imgui.ImVec2) { putFloat(v.X()); putFloat(v.Y()) }
+
+	putVec2(drawData.DisplayPos)
+	putVec2(drawData.DisplaySize)
+	putVec2(drawData.FramebufferScale)
+	for _, list := range drawData.CmdLists[:drawData.CmdListsCount] {
+		buf = binary.AppendUvarint(buf, uint64(len(list.CmdBuffer)))
+		for i := range list.CmdBuffer {
+			cmd := &list.CmdBuffer[i]
+			putFloat(cmd.ClipRect.X())
+			putFloat(cmd.ClipRect.Y())
+			putFloat(cmd.ClipRect.Z())
+			putFloat(cmd.ClipRect.W())
+			buf = binary.AppendUvarint(buf, uint64(cmd.VtxOffset))
+			buf = binary.AppendUvarint(buf, uint64(cmd.IdxOffset))
+			buf = binary.AppendUvarint(buf, uint64(cmd.ElemCount))
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(list.VtxBuffer)))
+		for i := range list.VtxBuffer {
+			v := &list.VtxBuffer[i]
+			putVec2(v.Pos)
+			putVec2(v.Uv)
+			buf = binary.LittleEndian.AppendUint32(buf, v.Col)
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(list.IdxBuffer)))
+		for _, idx := range list.IdxBuffer {
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(idx))
+		}
+		h.Write(buf)
+		buf = buf[:0]
+	}
+	h.Write(buf)
+	return h.Sum64()
+}
+
+// encoder writes frame records, each one only holding the fields changed since the previous frame.
+type encoder struct {
+	prev Frame
+	buf  []byte
+}
+
+func (enc *encoder) appendFloat(v float32) {
+	enc.buf = binary.LittleEndian.AppendUint32(enc.buf, math.Float32bits(v))
+}
+
+func (enc *encoder) appendVec2(v imgui.ImVec2) {
+	enc.appendFloat(v.X())
+	enc.appendFloat(v.Y())
+}
+
+func (enc *encoder) appendUvarint(v uint64) {
+	enc.buf = binary.AppendUvarint(enc.buf, v)
+}
+
+func (enc *encoder) appendString(v string) {
+	enc.appendUvarint(uint64(len(v)))
+	enc.buf = append(enc.buf, v...)
+}
+
+func (enc *encoder) appendBool(v bool) {
+	if v {
+		enc.buf = append(enc.buf, 1)
+	} else {
+		enc.buf = append(enc.buf, 0)
+	}
+}
+
+// encodeFrame returns the frame record of f, valid until the next call.
+func (enc *encoder) encodeFrame(f *Frame) []byte {
+	prev := &enc.prev
+	var fields uint64
+	if f.DeltaTime != prev.DeltaTime {
+		fields |= fieldDeltaTime
+	}
+	if f.DisplaySize != prev.DisplaySize || f.DisplayFramebufferScale != prev.DisplayFramebufferScale {
+		fields |= fieldDisplaySize
+	}
+	if f.MousePos != prev.MousePos {
+		fields |= fieldMousePos
+	}
+	if f.MouseDown != prev.MouseDown {
+		fields |= fieldMouseDown
+	}
+	if f.MouseWheel != prev.MouseWheel || f.MouseWheelH != prev.MouseWheelH {
+		fields |= fieldMouseWheel
+	}
+	if f.KeyCtrl != prev.KeyCtrl || f.KeyShift != prev.KeyShift || f.KeyAlt != prev.KeyAlt || f.KeySuper != prev.KeySuper {
+		fields |= fieldKeyMods
+	}
+	if !equalKeys(f.KeysDown, prev.KeysDown) {
+		fields |= fieldKeysDown
+	}
+	if f.NavInputs != prev.NavInputs {
+		fields |= fieldNavInputs
+	}
+	if len(f.Chars) > 0 {
+		fields |= fieldChars
+	}
+	if len(f.Events) > 0 {
+		fields |= fieldEvents
+	}
+	if f.BackendUsingLegacyKeyArrays != prev.BackendUsingLegacyKeyArrays {
+		fields |= fieldLegacyKeys
+	}
+
+	enc.buf = append(enc.buf[:0], tagFrame)
+	enc.appendUvarint(fields)
+	if fields&fieldDeltaTime != 0 {
+		enc.appendFloat(f.DeltaTime)
+	}
+	if fields&fieldDisplaySize != 0 {
+		enc.appendVec2(f.DisplaySize)
+		enc.appendVec2(f.DisplayFramebufferScale)
+	}
+	if fields&fieldMousePos != 0 {
+		enc.appendVec2(f.MousePos)
+	}
+	if fields&fieldMouseDown != 0 {
+		var bits byte
+		for i, down := range f.MouseDown {
+			if down {
+				bits |= 1 << i
+			}
+		}
+		enc.buf = append(enc.buf, bits)
+	}
+	if fields&fieldMouseWheel != 0 {
+		enc.appendFloat(f.MouseWheel)
+		enc.appendFloat(f.MouseWheelH)
+	}
+	if fields&fieldKeyMods != 0 {
+		var bits byte
+		for i, down := range [...]bool{f.KeyCtrl, f.KeyShift, f.KeyAlt, f.KeySuper} {
+			if down {
+				bits |= 1 << i
+			}
+		}
+		enc.buf = append(enc.buf, bits)
+	}
+	if fields&fieldKeysDown != 0 {
+		enc.appendUvarint(uint64(len(f.KeysDown)))
+		for _, key := range f.KeysDown {
+			enc.appendUvarint(uint64(key))
+		}
+	}
+	if fields&fieldNavInputs != 0 {
+		var n int
+		for _, v := range f.NavInputs {
+			if v != 0 {
+				n++
+			}
+		}
+		enc.appendUvarint(uint64(n))
+		for i, v := range f.NavInputs {
+			if v != 0 {
+				enc.appendUvarint(uint64(i))
+				enc.appendFloat(v)
+			}
+		}
+	}
+	if fields&fieldChars != 0 {
+		enc.appendUvarint(uint64(len(f.Chars)))
+		for _, c := range f.Chars {
+			enc.appendUvarint(uint64(c))
+		}
+	}
+	if fields&fieldEvents != 0 {
+		enc.appendUvarint(uint64(len(f.Events)))
+		for i := range f.Events {
+			enc.appendEvent(&f.Events[i])
+		}
+	}
+	if fields&fieldLegacyKeys != 0 {
+		enc.buf = append(enc.buf, byte(f.BackendUsingLegacyKeyArrays))
+	}
+
+	enc.prev = *f
+	return enc.buf
+}
+
+func (enc *encoder) appendEvent(e *imgui.ImGuiInputEvent) {
+	enc.buf = append(enc.buf, byte(e.Type), byte(e.Source))
+	switch e.Type {
+	case imgui.ImGuiInputEventType_MousePos:
+		enc.appendFloat(e.MousePos.PosX)
+		enc.appendFloat(e.MousePos.PosY)
+	case imgui.ImGuiInputEventType_MouseWheel:
+		enc.appendFloat(e.MouseWheel.WheelX)
+		enc.appendFloat(e.MouseWheel.WheelY)
+	case imgui.ImGuiInputEventType_MouseButton:
+		enc.appendUvarint(uint64(e.MouseButton.Button))
+		enc.appendBool(e.MouseButton.Down)
+	case imgui.ImGuiInputEventType_Key:
+		enc.appendUvarint(uint64(e.Key.Key))
+		enc.appendBool(e.Key.Down)
+		enc.appendFloat(e.Key.AnalogValue)
+	case imgui.ImGuiInputEventType_KeyMods:
+		enc.appendUvarint(uint64(e.KeyMods.Mods))
+	case imgui.ImGuiInputEventType_Text:
+		enc.appendUvarint(uint64(e.Text.Char))
+	case imgui.ImGuiInputEventType_Focus:
+		enc.appendBool(e.AppFocused.Focused)
+	case imgui.ImGuiInputEventType_Drop:
+		enc.appendUvarint(uint64(len(e.Drop.Paths)))
+		for _, path := range e.Drop.Paths {
+			enc.appendString(path)
+		}
+	}
+}
+
+// encodeHash returns the record of the draw data hash of the last frame, valid until the next call.
+func (enc *encoder) encodeHash(hash uint64) []byte {
+	enc.buf = append(enc.buf[:0], tagHash)
+	enc.buf = binary.LittleEndian.AppendUint64(enc.buf, hash)
+	return enc.buf
+}
+
+func equalKeys(a, b []imgui.ImGuiKey) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+var errFormat = errors.New("replay: invalid recording")
+
+// decoder reads the records written by an encoder.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (dec *decoder) fail() {
+	if dec.err == nil {
+		dec.err = errFormat
+	}
+	dec.data = nil
+}
+
+func (dec *decoder) byte() byte {
+	if len(dec.data) < 1 {
+		dec.fail()
+		return 0
+	}
+	b := dec.data[0]
+	dec.data = dec.data[1:]
+	return b
+}
+
+func (dec *decoder) bool() bool {
+	return dec.byte() != 0
+}
+
+func (dec *decoder) float() float32 {
+	if len(dec.data) < 4 {
+		dec.fail()
+		return 0
+	}
+	v := math.Float32frombits(binary.LittleEndian.Uint32(dec.data))
+	dec.data = dec.data[4:]
+	return v
+}
+
+func (dec *decoder) string() string {
+	n := dec.uvarint()
+	if n > uint64(len(dec.data)) {
+		dec.fail()
+		return ""
+	}
+	v := string(dec.data[:n])
+	dec.data = dec.data[n:]
+	return v
+}
+
+func (dec *decoder) vec2() imgui.ImVec2 {
+	x := dec.float()
+	y := dec.float()
+	return *imgui.NewImVec2(x, y)
+}
+
+func (dec *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(dec.data)
+	if n <= 0 {
+		dec.fail()
+		return 0
+	}
+	dec.data = dec.data[n:]
+	return v
+}
+
+// count reads a number of elements, each one using at least one byte.
+func (dec *decoder) count() int {
+	n := dec.uvarint()
+	if n > uint64(len(dec.data)) {
+		dec.fail()
+		return 0
+	}
+	return int(n)
+}
+
+// decodeFrames reads a whole recording.
+func decodeFrames(data []byte) ([]Frame, error) {
+	if len(data) < len(magic)+1 || string(data[:len(magic)]) != magic {
+		return nil, errFormat
+	}
+	if v := data[len(magic)]; v != version {
+		return nil, fmt.Errorf("replay: unsupported recording version %d", v)
+	}
+	dec := decoder{data: data[len(magic)+1:]}
+	var frames []Frame
+	var prev Frame
+	for len(dec.data) > 0 && dec.err == nil {
+		switch dec.byte() {
+		case tagFrame:
+			f := dec.frame(&prev)
+			frames = append(frames, f)
+			prev = f
+		case tagHash:
+			if len(frames) == 0 || len(dec.data) < 8 {
+				dec.fail()
+				break
+			}
+			frames[len(frames)-1].DrawDataHash = binary.LittleEndian.Uint64(dec.data)
+			dec.data = dec.data[8:]
+		default:
+			dec.fail()
+		}
+	}
+	return frames, dec.err
+}
+
+func (dec *decoder) frame(prev *Frame) Frame {
+	f := *prev
+	f.Chars = nil
+	f.Events = nil
+	f.DrawDataHash = 0
+
+	fields := dec.uvarint()
+	if fields&fieldDeltaTime != 0 {
+		f.DeltaTime = dec.float()
+	}
+	if fields&fieldDisplaySize != 0 {
+		f.DisplaySize = dec.vec2()
+		f.DisplayFramebufferScale = dec.vec2()
+	}
+	if fields&fieldMousePos != 0 {
+		f.MousePos = dec.vec2()
+	}
+	if fields&fieldMouseDown != 0 {
+		bits := dec.byte()
+		for i := range f.MouseDown {
+			f.MouseDown[i] = bits&(1<<i) != 0
+		}
+	}
+	if fields&fieldMouseWheel != 0 {
+		f.MouseWheel = dec.float()
+		f.MouseWheelH = dec.float()
+	}
+	if fields&fieldKeyMods != 0 {
+		bits := dec.byte()
+		f.KeyCtrl = bits&1 != 0
+		f.KeyShift = bits&2 != 0
+		f.KeyAlt = bits&4 != 0
+		f.KeySuper = bits&8 != 0
+	}
+	if fields&fieldKeysDown != 0 {
+		n := dec.count()
+		f.KeysDown = make([]imgui.ImGuiKey, 0, n)
+		for i := 0; i < n; i++ {
+			key := dec.uvarint()
+			if key >= uint64(imgui.ImGuiKey_COUNT) {
+				dec.fail()
+				break
+			}
+			f.KeysDown = append(f.KeysDown, imgui.ImGuiKey(key))
+		}
+	}
+	if fields&fieldNavInputs != 0 {
+		f.NavInputs = [imgui.ImGuiNavInput_COUNT]float32{}
+		n := dec.count()
+		for i := 0; i < n; i++ {
+			index := dec.uvarint()
+			if index >= uint64(imgui.ImGuiNavInput_COUNT) {
+				dec.fail()
+				break
+			}
+			f.NavInputs[index] = dec.float()
+		}
+	}
+	if fields&fieldChars != 0 {
+		n := dec.count()
+		f.Chars = make([]imgui.ImWchar, n)
+		for i := range f.Chars {
+			f.Chars[i] = imgui.ImWchar(dec.uvarint())
+		}
+	}
+	if fields&fieldEvents != 0 {
+		n := dec.count()
+		f.Events = make([]imgui.ImGuiInputEvent, n)
+		for i := range f.Events {
+			dec.event(&f.Events[i])
+		}
+	}
+	if fields&fieldLegacyKeys != 0 {
+		f.BackendUsingLegacyKeyArrays = int8(dec.byte())
+	}
+	return f
+}
+
+func (dec *decoder) event(e *imgui.ImGuiInputEvent) {
+	e.Type = imgui.ImGuiInputEventType(dec.byte())
+	e.Source = imgui.ImGuiInputSource(dec.byte())
+	switch e.Type {
+	case imgui.ImGuiInputEventType_MousePos:
+		e.MousePos.PosX = dec.float()
+		e.MousePos.PosY = dec.float()
+	case imgui.ImGuiInputEventType_MouseWheel:
+		e.MouseWheel.WheelX = dec.float()
+		e.MouseWheel.WheelY = dec.float()
+	case imgui.ImGuiInputEventType_MouseButton:
+		button := dec.uvarint()
+		if button >= uint64(imgui.ImGuiMouseButton_COUNT) {
+			dec.fail()
+			return
+		}
+		e.MouseButton.Button = int32(button)
+		e.MouseButton.Down = dec.bool()
+	case imgui.ImGuiInputEventType_Key:
+		key := dec.uvarint()
+		if key >= uint64(imgui.ImGuiKey_COUNT) || !imgui.IsNamedKey(imgui.ImGuiKey(key)) {
+			dec.fail()
+			return
+		}
+		e.Key.Key = imgui.ImGuiKey(key)
+		e.Key.Down = dec.bool()
+		e.Key.AnalogValue = dec.float()
+	case imgui.ImGuiInputEventType_KeyMods:
+		e.KeyMods.Mods = imgui.ImGuiKeyModFlags(dec.uvarint())
+	case imgui.ImGuiInputEventType_Text:
+		e.Text.Char = rune(dec.uvarint())
+	case imgui.ImGuiInputEventType_Focus:
+		e.AppFocused.Focused = dec.bool()
+	case imgui.ImGuiInputEventType_Drop:
+		e.Drop.Paths = make([]string, dec.count())
+		for i := range e.Drop.Paths {
+			e.Drop.Paths[i] = dec.string()
+		}
+	default:
+		dec.fail()
+	}
+}
+
+// ReadFrames reads all the frames of a recording written by a Recorder.
+func ReadFrames(r io.Reader) ([]Frame, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return decodeFrames(data)
+}
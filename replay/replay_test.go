@@ -0,0 +1,189 @@
+package replay
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Splizard/imgui"
+)
+
+func newContext() *imgui.ImGuiContext {
+	ctx := imgui.CreateContext(nil)
+	ctx.IO.IniFilename = ""
+	ctx.IO.DisplaySize = *imgui.NewImVec2(320, 240)
+	ctx.IO.Fonts.Build()
+	return ctx
+}
+
+// session is the GUI of the recorded program, the state of which must be reproduced by the replay.
+type session struct {
+	clicks  int
+	text    []byte
+	label   string
+	dropped []string
+}
+
+func (s *session) gui(ui *imgui.ImGuiUI) {
+	ui.SetNextWindowPos(imgui.NewImVec2(10, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+	ui.Begin("Window", nil, 0)
+	if ui.Button(s.label) {
+		s.clicks++
+	}
+	if ui.BeginDragDropTarget() {
+		if paths, ok, _ := imgui.AcceptDragDropPayloadItemsT[string](imgui.IMGUI_PAYLOAD_TYPE_FILES, 0); ok {
+			s.dropped = append(s.dropped, paths...)
+		}
+		ui.EndDragDropTarget()
+	}
+	ui.InputText("Text", &s.text, 0, nil, nil)
+	ui.End()
+}
+
+func TestRecordReplay(t *testing.T) {
+	var recording bytes.Buffer
+	recorded := session{text: make([]byte, 32), label: "Click"}
+	ctx := newContext()
+	rec, err := NewRecorder(ctx, &recording)
+	if err != nil {
+		t.Fatal(err)
+	}
+	io := &ctx.IO
+	frames := [][]func(){
+		{}, {},
+		{func() { io.AddMousePosEvent(30, 40) }},
+		{func() { io.AddMouseButtonEvent(0, true) }, func() { io.AddMouseButtonEvent(0, false) }},
+		{}, {},
+		{func() { io.AddMousePosEvent(60, 62) }, func() { io.AddMouseButtonEvent(0, true) }},
+		{func() { io.AddMouseButtonEvent(0, false) }},
+		{func() { io.AddKeyModsEvent(imgui.ImGuiKeyModFlags_Ctrl) }, func() { io.AddKeyEvent(imgui.ImGuiKey_A, true) }},
+		{func() { io.AddKeyEvent(imgui.ImGuiKey_A, false) }, func() { io.AddKeyModsEvent(imgui.ImGuiKeyModFlags_None) }},
+		{func() { io.AddInputCharacters("hello") }},
+		{func() { io.AddKeyEvent(imgui.ImGuiKey_Enter, true) }},
+		{func() { io.AddKeyEvent(imgui.ImGuiKey_Enter, false) }},
+		{func() { io.AddExternalDrop([]string{"a.txt", "b.txt"}, 30, 40) }},
+		{}, {},
+	}
+	for _, events := range frames {
+		for _, event := range events {
+			event()
+		}
+		ctx.Frame(recorded.gui)
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	imgui.DestroyContext(ctx)
+	if recorded.clicks != 1 || cstr(recorded.text) != "hello" {
+		t.Fatalf("recorded session: %d clicks and text %q, want 1 and %q", recorded.clicks, cstr(recorded.text), "hello")
+	}
+	if rec.Frames() != len(frames) {
+		t.Errorf("recorded %d frames, want %d", rec.Frames(), len(frames))
+	}
+
+	// Replaying the same program reaches the same state.
+	replayed := session{text: make([]byte, 32), label: "Click"}
+	ctx = newContext()
+	player, err := NewPlayer(ctx, bytes.NewReader(recording.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := player.Run(replayed.gui); err != nil {
+		t.Error(err)
+	}
+	// Frames running after the end of the recording are not checked.
+	ctx.Frame((&session{text: make([]byte, 32), label: "Other"}).gui)
+	if err := player.Err(); err != nil {
+		t.Errorf("frame after the end of the recording: %v", err)
+	}
+	player.Close()
+	imgui.DestroyContext(ctx)
+	if replayed.clicks != recorded.clicks || cstr(replayed.text) != cstr(recorded.text) {
+		t.Errorf("replayed session: %d clicks and text %q, want %d and %q", replayed.clicks, cstr(replayed.text), recorded.clicks, cstr(recorded.text))
+	}
+	if len(recorded.dropped) != 2 || strings.Join(replayed.dropped, ",") != strings.Join(recorded.dropped, ",") {
+		t.Errorf("replayed session: dropped %q, recorded %q", replayed.dropped, recorded.dropped)
+	}
+
+	// A program rendering something else is caught on the first frame showing the window.
+	changed := session{text: make([]byte, 32), label: "Other"}
+	ctx = newContext()
+	defer imgui.DestroyContext(ctx)
+	player, err = NewPlayer(ctx, bytes.NewReader(recording.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer player.Close()
+	var mismatch *MismatchError
+	if err := player.Run(changed.gui); !errors.As(err, &mismatch) || mismatch.Frame != 1 {
+		t.Errorf("replaying a different program: got error %v, want a mismatch on frame 1", err)
+	}
+}
+
+func TestReadFramesInvalid(t *testing.T) {
+	for _, data := range []string{"", "IMGUIREC", "IMGUIREC\x01F", "IMGUIREC\x01H", "IMGUIREC\x01F\x80\x01"} {
+		if _, err := ReadFrames(bytes.NewReader([]byte(data))); err == nil {
+			t.Errorf("ReadFrames(%q) succeeded", data)
+		}
+	}
+}
+
+// recordingOf returns a recording of a single frame queuing events.
+func recordingOf(events ...imgui.ImGuiInputEvent) []byte {
+	var enc encoder
+	data := append([]byte(magic), version)
+	return append(data, enc.encodeFrame(&Frame{Events: events})...)
+}
+
+func TestReadFramesInvalidEvents(t *testing.T) {
+	var button, key, legacyKey imgui.ImGuiInputEvent
+	button.Type = imgui.ImGuiInputEventType_MouseButton
+	button.MouseButton.Button = int32(imgui.ImGuiMouseButton_COUNT)
+	key.Type = imgui.ImGuiInputEventType_Key
+	key.Key.Key = imgui.ImGuiKey_COUNT
+	legacyKey.Type = imgui.ImGuiInputEventType_Key
+	legacyKey.Key.Key = 'A'
+	for _, e := range []imgui.ImGuiInputEvent{button, key, legacyKey} {
+		if _, err := ReadFrames(bytes.NewReader(recordingOf(e))); err == nil {
+			t.Errorf("ReadFrames() of a recording with event %+v succeeded", e)
+		}
+	}
+}
+
+func FuzzReadFrames(f *testing.F) {
+	var button, key imgui.ImGuiInputEvent
+	button.Type = imgui.ImGuiInputEventType_MouseButton
+	button.MouseButton.Button = int32(imgui.ImGuiMouseButton_Right)
+	key.Type = imgui.ImGuiInputEventType_Key
+	key.Key.Key = imgui.ImGuiKey_Enter
+	f.Add(recordingOf(button, key))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		frames, err := ReadFrames(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+		// Events are replayed by imgui.NewFrame(), which indexes io.MouseDown[] and io.KeysData[] with them.
+		for _, frame := range frames {
+			for _, e := range frame.Events {
+				switch e.Type {
+				case imgui.ImGuiInputEventType_MouseButton:
+					if e.MouseButton.Button < 0 || e.MouseButton.Button >= int32(imgui.ImGuiMouseButton_COUNT) {
+						t.Errorf("decoded mouse button %d", e.MouseButton.Button)
+					}
+				case imgui.ImGuiInputEventType_Key:
+					if !imgui.IsNamedKey(e.Key.Key) {
+						t.Errorf("decoded key %d", e.Key.Key)
+					}
+				}
+			}
+		}
+	})
+}
+
+func cstr(buf []byte) string {
+	if i := bytes.IndexByte(buf, 0); i >= 0 {
+		buf = buf[:i]
+	}
+	return string(buf)
+}
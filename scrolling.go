@@ -34,12 +34,11 @@ func GetWindowScrollbarID(window *ImGuiWindow, axis ImGuiAxis) ImGuiID {
 	return 0
 }
 
-func Scrollbar(axis ImGuiAxis) {
-	var g = GImGui
+func (g *ImGuiContext) Scrollbar(axis ImGuiAxis) {
 	var window = g.CurrentWindow
 
 	var id = GetWindowScrollbarID(window, axis)
-	KeepAliveID(id)
+	g.KeepAliveID(id)
 
 	// Calculate scrollbar bounding box
 	var bb = GetWindowScrollbarRect(window, axis)
@@ -71,7 +70,7 @@ func Scrollbar(axis ImGuiAxis) {
 		amount = window.Scroll.y
 	}
 
-	ScrollbarEx(&bb, id, axis, &amount, size_avail, size_contents, rounding_corners)
+	g.ScrollbarEx(&bb, id, axis, &amount, size_avail, size_contents, rounding_corners)
 
 	switch axis {
 	case ImGuiAxis_X:
@@ -87,8 +86,7 @@ func Scrollbar(axis ImGuiAxis) {
 // - We store values as normalized ratio and in a form that allows the window content to change while we are holding on a scrollbar
 // - We handle both horizontal and vertical scrollbars, which makes the terminology not ideal.
 // Still, the code should probably be made simpler..
-func ScrollbarEx(bb_frame *ImRect, id ImGuiID, axis ImGuiAxis, p_scroll_v *float, size_avail_v float, size_contents_v float, flags ImDrawFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) ScrollbarEx(bb_frame *ImRect, id ImGuiID, axis ImGuiAxis, p_scroll_v *float, size_avail_v float, size_contents_v float, flags ImDrawFlags) bool {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return false
@@ -134,7 +132,7 @@ func ScrollbarEx(bb_frame *ImRect, id ImGuiID, axis ImGuiAxis, p_scroll_v *float
 	// Handle input right away. None of the code of Begin() is relying on scrolling position before calling Scrollbar().
 	var held = false
 	var hovered = false
-	ButtonBehavior(&bb, id, &hovered, &held, ImGuiButtonFlags_NoNavFocus)
+	g.ButtonBehavior(&bb, id, &hovered, &held, ImGuiButtonFlags_NoNavFocus)
 
 	var scroll_max = ImMax(1.0, size_contents_v-size_avail_v)
 	var scroll_ratio = ImSaturate(*p_scroll_v / scroll_max)
@@ -153,7 +151,7 @@ func ScrollbarEx(bb_frame *ImRect, id ImGuiID, axis ImGuiAxis, p_scroll_v *float
 
 		// Click position in scrollbar normalized space (0.0f.1.0f)
 		var clicked_v_norm = ImSaturate((mouse_pos_v - scrollbar_pos_v) / scrollbar_size_v)
-		SetHoveredID(id)
+		g.SetHoveredID(id)
 
 		var seek_absolute = false
 		if g.ActiveIdIsJustActivated {
@@ -182,13 +180,13 @@ func ScrollbarEx(bb_frame *ImRect, id ImGuiID, axis ImGuiAxis, p_scroll_v *float
 	}
 
 	// Render
-	var bg_col = GetColorU32FromID(ImGuiCol_ScrollbarBg, 1)
-	var grab_col = GetColorU32FromID(ImGuiCol_ScrollbarGrab, alpha)
+	var bg_col = g.GetColorU32FromID(ImGuiCol_ScrollbarBg, 1)
+	var grab_col = g.GetColorU32FromID(ImGuiCol_ScrollbarGrab, alpha)
 
 	if held {
-		grab_col = GetColorU32FromID(ImGuiCol_ScrollbarGrabActive, 1)
+		grab_col = g.GetColorU32FromID(ImGuiCol_ScrollbarGrabActive, 1)
 	} else if hovered {
-		grab_col = GetColorU32FromID(ImGuiCol_ScrollbarGrabHovered, 1)
+		grab_col = g.GetColorU32FromID(ImGuiCol_ScrollbarGrabHovered, 1)
 	}
 
 	window.DrawList.AddRectFilled(bb_frame.Min, bb_frame.Max, bg_col, window.WindowRounding, flags)
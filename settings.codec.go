@@ -0,0 +1,169 @@
+package imgui
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ImGuiSettingsEntry is one entry written by a settings handler, e.g. "[Window][Debug##Default]" followed by its
+// "Pos=60,60" lines. The lines are in the handler's own format, codecs only change how the entries are stored.
+// The struct can be embedded in an application's own config, see SaveSettingsEntries() and LoadSettingsEntries().
+type ImGuiSettingsEntry struct {
+	Type  string   `json:"type" toml:"type"`   // TypeName of the handler, e.g. "Window", "Table", "Docking"
+	Name  string   `json:"name" toml:"name"`   // Name of the entry, e.g. a window name
+	Lines []string `json:"lines" toml:"lines"` // Lines of the entry, without the trailing new lines
+}
+
+// ImGuiSettingsCodec encodes and decodes the settings entries, set io.SettingsCodec to store the settings in
+// another format than the .ini one. Implement it to use another format, e.g. TOML, with the library of your choice.
+type ImGuiSettingsCodec interface {
+	Encode(w io.Writer, entries []ImGuiSettingsEntry) error
+	Decode(r io.Reader) ([]ImGuiSettingsEntry, error)
+}
+
+// ImGuiSettingsCodecIni is the default codec, the .ini-like format of upstream Dear ImGui.
+type ImGuiSettingsCodecIni struct{}
+
+func (ImGuiSettingsCodecIni) Encode(w io.Writer, entries []ImGuiSettingsEntry) error {
+	var bw = bufio.NewWriter(w)
+	for _, entry := range entries {
+		fmt.Fprintf(bw, "[%s][%s]\n", entry.Type, entry.Name)
+		for _, line := range entry.Lines {
+			bw.WriteString(line + "\n")
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+func (ImGuiSettingsCodecIni) Decode(r io.Reader) ([]ImGuiSettingsEntry, error) {
+	var entries []ImGuiSettingsEntry
+	var scanner = bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var line = strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if line[0] == '[' && line[len(line)-1] == ']' {
+			// Parse "[Type][Name]". Note that 'Name' can itself contains [] characters, which is acceptable with the current format and parsing code.
+			if splits := strings.SplitN(line[1:len(line)-1], "][", 2); len(splits) == 2 {
+				entries = append(entries, ImGuiSettingsEntry{Type: splits[0], Name: splits[1]})
+				continue
+			}
+		}
+		if len(entries) > 0 {
+			var entry = &entries[len(entries)-1]
+			entry.Lines = append(entry.Lines, line)
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// ImGuiSettingsCodecJSON stores the settings as a JSON array of ImGuiSettingsEntry.
+type ImGuiSettingsCodecJSON struct {
+	Indent string // Indentation of the output, "" for compact output
+}
+
+func (c ImGuiSettingsCodecJSON) Encode(w io.Writer, entries []ImGuiSettingsEntry) error {
+	var encoder = json.NewEncoder(w)
+	encoder.SetIndent("", c.Indent)
+	if entries == nil {
+		entries = []ImGuiSettingsEntry{}
+	}
+	return encoder.Encode(entries)
+}
+
+func (ImGuiSettingsCodecJSON) Decode(r io.Reader) ([]ImGuiSettingsEntry, error) {
+	var entries []ImGuiSettingsEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (g *ImGuiContext) getSettingsCodec() ImGuiSettingsCodec {
+	if g.IO.SettingsCodec != nil {
+		return g.IO.SettingsCodec
+	}
+	return ImGuiSettingsCodecIni{}
+}
+
+// SaveSettingsEntries returns the entries of all the settings handlers.
+func (g *ImGuiContext) SaveSettingsEntries() []ImGuiSettingsEntry {
+	g.settingsWriteAll()
+	entries, _ := ImGuiSettingsCodecIni{}.Decode(bytes.NewReader(g.SettingsIniData))
+	return entries
+}
+
+// LoadSettingsEntries passes the entries to their settings handler. The entries of unknown types are saved back as is,
+// or passed to the handler of their type when it is added later with AddSettingsHandler(). The entries are renamed
+// by the aliases, and migrated by their handler when they were saved with an older io.SettingsVersion.
+// Call after CreateContext() and before the first call to NewFrame(), like LoadIniSettingsFromMemory().
+func (g *ImGuiContext) LoadSettingsEntries(entries []ImGuiSettingsEntry) {
+	IM_ASSERT(g.Initialized)
+
+	// Call pre-read handlers
+	// Some types will clear their data (e.g. dock information) some types will allow merge/override (window)
+	for handler_n := range g.SettingsHandlers {
+		if g.SettingsHandlers[handler_n].ReadInitFn != nil {
+			g.SettingsHandlers[handler_n].ReadInitFn(g, &g.SettingsHandlers[handler_n])
+		}
+	}
+
+	var version int // Entries before the first "[Settings][Version]" entry are unversioned
+	var version_read = false
+	g.SettingsVersion = 0
+	for _, entry := range entries {
+		if entry_version, ok := settingsReadVersion(&entry); ok {
+			version = entry_version
+			if !version_read {
+				g.SettingsVersion = version // The first one is the version of the settings, the next ones are for the entries kept from older settings
+				version_read = true
+			}
+			continue
+		}
+		g.settingsApplyAliases(&entry)
+		var entry_handler = g.FindSettingsHandler(entry.Type)
+		if entry_handler == nil {
+			g.settingsKeepUnhandled(entry, version)
+			continue
+		}
+		if !g.settingsMigrate(entry_handler, &entry, version) {
+			continue
+		}
+		var entry_data = entry_handler.ReadOpenFn(g, entry_handler, entry.Name)
+		for _, line := range entry.Lines {
+			entry_handler.ReadLineFn(g, entry_handler, entry_data, line)
+		}
+	}
+
+	g.SettingsLoaded = true
+
+	// Call post-read handlers
+	for handler_n := range g.SettingsHandlers {
+		if g.SettingsHandlers[handler_n].ApplyAllFn != nil {
+			g.SettingsHandlers[handler_n].ApplyAllFn(g, &g.SettingsHandlers[handler_n])
+		}
+	}
+}
+
+// LoadSettings decodes the settings from r with io.SettingsCodec (the .ini format by default) and loads them.
+func (g *ImGuiContext) LoadSettings(r io.Reader) error {
+	entries, err := g.getSettingsCodec().Decode(r)
+	if err != nil {
+		return err
+	}
+	g.LoadSettingsEntries(entries)
+	return nil
+}
+
+// SaveSettings encodes the settings to w with io.SettingsCodec (the .ini format by default).
+// Like SaveIniSettingsToMemory(), it resets the save timer: clear io.WantSaveIniSettings yourself after saving.
+func (g *ImGuiContext) SaveSettings(w io.Writer) error {
+	return g.getSettingsCodec().Encode(w, g.SaveSettingsEntries())
+}
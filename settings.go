@@ -1,10 +1,8 @@
 package imgui
 
 import (
-	"bufio"
 	"bytes"
 	"fmt"
-	"os"
 	"strings"
 )
 
@@ -12,107 +10,75 @@ import (
 // - The disk functions are automatically called if io.IniFilename != NULL (default is "imgui.ini").
 // - Set io.IniFilename to NULL to load/save manually. Read io.WantSaveIniSettings description about handling .ini saving manually.
 // - Important: default value "imgui.ini" is relative to current working dir! Most apps will want to lock this to an absolute path (e.g. same path as executables).
-func LoadIniSettingsFromDisk(ini_filename string) {
-	var file_data_size uintptr = 0
-	var file_data = ImFileLoadToMemory(ini_filename, "rb", &file_data_size, 0)
-	if file_data == nil {
+func (g *ImGuiContext) LoadIniSettingsFromDisk(ini_filename string) {
+	var f = g.ImFileOpen(ini_filename, "rb")
+	if f == nil {
 		return
 	}
-	LoadIniSettingsFromMemory(file_data, (size_t)(file_data_size))
+	defer ImFileClose(f)
+	g.LoadSettings(f)
 } // call after CreateContext() and before the first call to NewFrame(). NewFrame() automatically calls LoadIniSettingsFromDisk(io.IniFilename).
 
-func LoadIniSettingsFromMemory(buf []byte, ini_size uintptr) {
-	var g = GImGui
-	IM_ASSERT(g.Initialized)
-	//IM_ASSERT(!g.WithinFrameScope && "Cannot be called between NewFrame() and EndFrame()");
-	//IM_ASSERT(g.SettingsLoaded == false && g.FrameCount == 0);
-
-	// Call pre-read handlers
-	// Some types will clear their data (e.g. dock information) some types will allow merge/override (window)
-	for handler_n := range g.SettingsHandlers {
-		if g.SettingsHandlers[handler_n].ReadInitFn != nil {
-			g.SettingsHandlers[handler_n].ReadInitFn(g, &g.SettingsHandlers[handler_n])
-		}
-	}
-
-	var reader = bufio.NewReader(bytes.NewReader(buf))
-	var entry_handler *ImGuiSettingsHandler
-	var entry_data any = nil
-
-	for {
-		line, err := reader.ReadString('\n')
-		if err != nil {
-			break
-		}
-		line = line[:len(line)-1]
-
-		if line == "" {
-			continue
-		}
-
-		if line[0] == '[' && line[len(line)-1] == ']' {
-			splits := strings.SplitN(line[1:len(line)-1], "][", 2)
-			if len(splits) == 2 {
-				var settings_type = splits[0]
-				var settings_id = splits[1]
-
-				entry_handler = FindSettingsHandler(settings_type)
-				if entry_handler != nil {
-					entry_data = entry_handler.ReadOpenFn(g, entry_handler, settings_id)
-				}
-			}
-		} else if entry_handler != nil {
-			entry_handler.ReadLineFn(g, entry_handler, entry_data, line)
-		}
+func (g *ImGuiContext) LoadIniSettingsFromMemory(buf []byte, ini_size uintptr) {
+	if ini_size == 0 || ini_size > uintptr(len(buf)) {
+		ini_size = uintptr(len(buf))
 	}
+	g.LoadSettings(bytes.NewReader(buf[:ini_size]))
+} // call after CreateContext() and before the first call to NewFrame() to provide .ini data (in the io.SettingsCodec format) from your own data source.
 
-	g.SettingsLoaded = true
-
-	// Call post-read handlers
-	for handler_n := range g.SettingsHandlers {
-		if g.SettingsHandlers[handler_n].ApplyAllFn != nil {
-			g.SettingsHandlers[handler_n].ApplyAllFn(g, &g.SettingsHandlers[handler_n])
-		}
-	}
-} // call after CreateContext() and before the first call to NewFrame() to provide .ini data from your own data source.
-
-func SaveIniSettingsToDisk(ini_filename string) {
-	var g = GImGui
+// SaveIniSettingsToDisk saves the settings with io.SettingsCodec, in the .ini format by default.
+func (g *ImGuiContext) SaveIniSettingsToDisk(ini_filename string) {
 	g.SettingsDirtyTimer = 0.0
 	if ini_filename == "" {
 		return
 	}
 
-	var ini_data_size size_t = 0
-	var ini_data = SaveIniSettingsToMemory(&ini_data_size)
-
-	os.WriteFile(ini_filename, ini_data, 0666)
+	var f = g.ImFileOpen(ini_filename, "wt")
+	if f == nil {
+		return
+	}
+	g.SaveSettings(f)
+	ImFileClose(f)
 } // this is automatically called (if io.IniFilename is not empty) a few seconds after any modification that should be reflected in the .ini file (and also by DestroyContext).
 
-func SaveIniSettingsToMemory(out_size *uintptr) []byte {
-	var g = GImGui
+func (g *ImGuiContext) SaveIniSettingsToMemory(out_size *uintptr) []byte {
+	g.settingsWriteAll()
+	if codec := g.IO.SettingsCodec; codec != nil {
+		if _, is_ini := codec.(ImGuiSettingsCodecIni); !is_ini {
+			entries, _ := ImGuiSettingsCodecIni{}.Decode(bytes.NewReader(g.SettingsIniData))
+			var out bytes.Buffer
+			codec.Encode(&out, entries)
+			g.SettingsIniData = append(g.SettingsIniData[:0], out.Bytes()...)
+		}
+	}
+	if out_size != nil {
+		*out_size = (size_t)(len(g.SettingsIniData))
+	}
+	return g.SettingsIniData
+} // return a zero-terminated string with the .ini data (in the io.SettingsCodec format) which you can save by your own mean. call when io.WantSaveIniSettings is set, then save data by your own mean and clear io.WantSaveIniSettings.
+
+// settingsWriteAll writes the entries of all the settings handlers to g.SettingsIniData, in the .ini format.
+func (g *ImGuiContext) settingsWriteAll() {
 	g.SettingsDirtyTimer = 0.0
 	g.SettingsIniData = g.SettingsIniData[:0]
+	if g.IO.SettingsVersion != 0 {
+		settingsWriteVersion(&g.SettingsIniData, g.IO.SettingsVersion)
+	}
 	for handler_n := range g.SettingsHandlers {
 		var handler = &g.SettingsHandlers[handler_n]
 		handler.WriteAllFn(g, handler, &g.SettingsIniData)
 	}
-	if out_size != nil {
-		*out_size = (size_t)(len(g.SettingsIniData))
-	}
-	return g.SettingsIniData
-} // return a zero-terminated string with the .ini data which you can save by your own mean. call when io.WantSaveIniSettings is set, then save data by your own mean and clear io.WantSaveIniSettings.
+	g.settingsWriteUnhandled(&g.SettingsIniData)
+}
 
 // MarkIniSettingsDirty Settings
-func MarkIniSettingsDirty() {
-	var g = GImGui
+func (g *ImGuiContext) MarkIniSettingsDirty() {
 	if g.SettingsDirtyTimer <= 0.0 {
 		g.SettingsDirtyTimer = g.IO.IniSavingRate
 	}
 }
 
-func MarkIniSettingsDirtyWindow(window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) MarkIniSettingsDirtyWindow(window *ImGuiWindow) {
 	if window.Flags&ImGuiWindowFlags_NoSavedSettings == 0 {
 		if g.SettingsDirtyTimer <= 0.0 {
 			g.SettingsDirtyTimer = g.IO.IniSavingRate
@@ -120,9 +86,9 @@ func MarkIniSettingsDirtyWindow(window *ImGuiWindow) {
 	}
 }
 
-func ClearIniSettings() {
-	var g = GImGui
+func (g *ImGuiContext) ClearIniSettings() {
 	g.SettingsIniData = g.SettingsIniData[:0]
+	g.SettingsUnhandled = g.SettingsUnhandled[:0]
 	for handler_n := range g.SettingsHandlers {
 		if g.SettingsHandlers[handler_n].ClearAllFn != nil {
 			g.SettingsHandlers[handler_n].ClearAllFn(g, &g.SettingsHandlers[handler_n])
@@ -130,9 +96,7 @@ func ClearIniSettings() {
 	}
 }
 
-func CreateNewWindowSettings(name string) *ImGuiWindowSettings {
-	var g = GImGui
-
+func (g *ImGuiContext) CreateNewWindowSettings(name string) *ImGuiWindowSettings {
 	if index := strings.Index(name, "###"); index != -1 {
 		name = name[index:]
 	}
@@ -141,14 +105,14 @@ func CreateNewWindowSettings(name string) *ImGuiWindowSettings {
 
 	settings.ID = ImHashStr(name, 0, 0)
 	settings.name = name
+	settings.DockOrder = -1
 
 	g.SettingsWindows = append(g.SettingsWindows, settings)
 
 	return &g.SettingsWindows[len(g.SettingsWindows)-1]
 }
 
-func FindWindowSettings(id ImGuiID) *ImGuiWindowSettings {
-	var g = GImGui
+func (g *ImGuiContext) FindWindowSettings(id ImGuiID) *ImGuiWindowSettings {
 	for i := range g.SettingsWindows {
 		settings := &g.SettingsWindows[i]
 		if settings.ID == id {
@@ -158,15 +122,14 @@ func FindWindowSettings(id ImGuiID) *ImGuiWindowSettings {
 	return nil
 }
 
-func FindOrCreateWindowSettings(name string) *ImGuiWindowSettings {
-	if settings := FindWindowSettings(ImHashStr(name, 0, 0)); settings != nil {
+func (g *ImGuiContext) FindOrCreateWindowSettings(name string) *ImGuiWindowSettings {
+	if settings := g.FindWindowSettings(ImHashStr(name, 0, 0)); settings != nil {
 		return settings
 	}
-	return CreateNewWindowSettings(name)
+	return g.CreateNewWindowSettings(name)
 }
 
-func FindSettingsHandler(name string) *ImGuiSettingsHandler {
-	var g = GImGui
+func (g *ImGuiContext) FindSettingsHandler(name string) *ImGuiSettingsHandler {
 	var type_hash = ImHashStr(name, 0, 0)
 	for handler_n := range g.SettingsHandlers {
 		if g.SettingsHandlers[handler_n].TypeHash == type_hash {
@@ -176,14 +139,74 @@ func FindSettingsHandler(name string) *ImGuiSettingsHandler {
 	return nil
 }
 
+// AddSettingsHandler registers a handler for the "[TypeName][Name]" entries of the settings, so the application
+// can save its own data in the same file as the windows. Entries of this type loaded before the handler was added
+// are passed to it right away. See AddSettingsEntryHandler() and AddSettingsStruct() for simpler alternatives.
+func (g *ImGuiContext) AddSettingsHandler(handler *ImGuiSettingsHandler) {
+	IM_ASSERT(g.FindSettingsHandler(handler.TypeName) == nil)
+	IM_ASSERT(handler.ReadOpenFn != nil && handler.ReadLineFn != nil && handler.WriteAllFn != nil)
+	IM_ASSERT(!strings.ContainsAny(handler.TypeName, "[]"))
+	handler.TypeHash = ImHashStr(handler.TypeName, 0, 0)
+	g.SettingsHandlers = append(g.SettingsHandlers, *handler)
+	g.settingsReadUnhandled(&g.SettingsHandlers[len(g.SettingsHandlers)-1])
+}
+
+// RemoveSettingsHandler unregisters the handler of the given type, its entries won't be saved anymore.
+func (g *ImGuiContext) RemoveSettingsHandler(type_name string) {
+	if handler := g.FindSettingsHandler(type_name); handler != nil {
+		for handler_n := range g.SettingsHandlers {
+			if &g.SettingsHandlers[handler_n] == handler {
+				g.SettingsHandlers = append(g.SettingsHandlers[:handler_n], g.SettingsHandlers[handler_n+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// settingsKeepUnhandled stores an entry loaded without a handler with the version it was saved with,
+// replacing a previous entry with the same type and name.
+func (g *ImGuiContext) settingsKeepUnhandled(entry ImGuiSettingsEntry, version int) {
+	var kept = ImGuiSettingsUnhandledEntry{entry, version}
+	for n := range g.SettingsUnhandled {
+		if g.SettingsUnhandled[n].Type == entry.Type && g.SettingsUnhandled[n].Name == entry.Name {
+			g.SettingsUnhandled[n] = kept
+			return
+		}
+	}
+	g.SettingsUnhandled = append(g.SettingsUnhandled, kept)
+}
+
+// settingsReadUnhandled passes the entries loaded before the handler was added to it.
+func (g *ImGuiContext) settingsReadUnhandled(handler *ImGuiSettingsHandler) {
+	var remaining = g.SettingsUnhandled[:0]
+	var read = false
+	for _, entry := range g.SettingsUnhandled {
+		if entry.Type != handler.TypeName {
+			remaining = append(remaining, entry)
+			continue
+		}
+		if !g.settingsMigrate(handler, &entry.ImGuiSettingsEntry, entry.Version) {
+			continue
+		}
+		var entry_data = handler.ReadOpenFn(g, handler, entry.Name)
+		for _, line := range entry.Lines {
+			handler.ReadLineFn(g, handler, entry_data, line)
+		}
+		read = true
+	}
+	g.SettingsUnhandled = remaining
+	if read && handler.ApplyAllFn != nil {
+		handler.ApplyAllFn(g, handler)
+	}
+}
+
 // UpdateSettings Called by NewFrame()
-func UpdateSettings() {
+func (g *ImGuiContext) UpdateSettings() {
 	// Load settings on first frame (if not explicitly loaded manually before)
-	var g = GImGui
 	if !g.SettingsLoaded {
 		IM_ASSERT(len(g.SettingsWindows) == 0)
 		if g.IO.IniFilename != "" {
-			LoadIniSettingsFromDisk(g.IO.IniFilename)
+			g.LoadIniSettingsFromDisk(g.IO.IniFilename)
 		}
 		g.SettingsLoaded = true
 	}
@@ -193,7 +216,7 @@ func UpdateSettings() {
 		g.SettingsDirtyTimer -= g.IO.DeltaTime
 		if g.SettingsDirtyTimer <= 0.0 {
 			if g.IO.IniFilename != "" {
-				SaveIniSettingsToDisk(g.IO.IniFilename)
+				g.SaveIniSettingsToDisk(g.IO.IniFilename)
 			} else {
 				g.IO.WantSaveIniSettings = true // Let user know they can call SaveIniSettingsToMemory(). user will need to clear io.WantSaveIniSettings themselves.
 			}
@@ -208,7 +231,7 @@ func WindowSettingsHandler_ApplyAll(ctx *ImGuiContext, _ *ImGuiSettingsHandler)
 	for i := range g.SettingsWindows {
 		settings := &g.SettingsWindows[i]
 		if settings.WantApply {
-			if window := FindWindowByID(settings.ID); window != nil {
+			if window := ctx.FindWindowByID(settings.ID); window != nil {
 				ApplyWindowSettings(window, settings)
 			}
 			settings.WantApply = false
@@ -223,6 +246,8 @@ func ApplyWindowSettings(window *ImGuiWindow, settings *ImGuiWindowSettings) {
 		window.SizeFull = *ImFloorVec(&ImVec2{float(settings.Size.x), float(settings.Size.y)})
 	}
 	window.Collapsed = settings.Collapsed
+	window.DockId = settings.DockId
+	window.DockOrder = settings.DockOrder
 }
 
 func WindowSettingsHandler_ClearAll(ctx *ImGuiContext, _ *ImGuiSettingsHandler) {
@@ -233,12 +258,13 @@ func WindowSettingsHandler_ClearAll(ctx *ImGuiContext, _ *ImGuiSettingsHandler)
 	g.SettingsWindows = g.SettingsWindows[:0]
 }
 
-func WindowSettingsHandler_ReadOpen(_ *ImGuiContext, _ *ImGuiSettingsHandler, name string) any {
-	var settings = FindOrCreateWindowSettings(name)
+func WindowSettingsHandler_ReadOpen(ctx *ImGuiContext, _ *ImGuiSettingsHandler, name string) any {
+	var settings = ctx.FindOrCreateWindowSettings(name)
 	var id = settings.ID
 	*settings = ImGuiWindowSettings{} // Clear existing if recycling previous entry
 	settings.ID = id
 	settings.name = name
+	settings.DockOrder = -1
 	settings.WantApply = true
 	return settings
 }
@@ -247,6 +273,7 @@ func WindowSettingsHandler_ReadLine(_ *ImGuiContext, _ *ImGuiSettingsHandler, en
 	var settings = entry.(*ImGuiWindowSettings)
 	var x, y int
 	var i bool
+	var dock_id ImGuiID
 
 	if n, _ := fmt.Sscanf(line, "Pos=%v,%v", &x, &y); n == 2 {
 		settings.Pos = ImVec2ih{(short)(x), (short)(y)}
@@ -254,6 +281,12 @@ func WindowSettingsHandler_ReadLine(_ *ImGuiContext, _ *ImGuiSettingsHandler, en
 		settings.Size = ImVec2ih{(short)(x), (short)(y)}
 	} else if n, _ := fmt.Sscanf(line, "Collapsed=%v", &i); n == 1 {
 		settings.Collapsed = i
+	} else if n, _ := fmt.Sscanf(line, "DockId=0x%X,%d", &dock_id, &x); n >= 1 {
+		settings.DockId = dock_id
+		settings.DockOrder = -1
+		if n == 2 {
+			settings.DockOrder = short(x)
+		}
 	}
 }
 
@@ -271,11 +304,11 @@ func WindowSettingsHandler_WriteAll(ctx *ImGuiContext, handler *ImGuiSettingsHan
 		if window.SettingsOffset != -1 {
 			settings = &g.SettingsWindows[window.SettingsOffset]
 		} else {
-			settings = FindOrCreateWindowSettings(window.Name)
+			settings = ctx.FindOrCreateWindowSettings(window.Name)
 		}
 
 		if settings == nil {
-			settings = CreateNewWindowSettings(window.Name)
+			settings = ctx.CreateNewWindowSettings(window.Name)
 
 			window.SettingsOffset = -1
 			for i := range g.SettingsWindows {
@@ -290,6 +323,8 @@ func WindowSettingsHandler_WriteAll(ctx *ImGuiContext, handler *ImGuiSettingsHan
 		settings.Size = ImVec2ih{short(window.Size.x), short(window.Size.y)}
 
 		settings.Collapsed = window.Collapsed
+		settings.DockId = window.DockId
+		settings.DockOrder = window.DockOrder
 	}
 
 	// Write to text buffer
@@ -300,6 +335,13 @@ func WindowSettingsHandler_WriteAll(ctx *ImGuiContext, handler *ImGuiSettingsHan
 		*buf = append(*buf, []byte(fmt.Sprintf("Pos=%d,%d\n", settings.Pos.x, settings.Pos.y))...)
 		*buf = append(*buf, []byte(fmt.Sprintf("Size=%d,%d\n", settings.Size.x, settings.Size.y))...)
 		*buf = append(*buf, []byte(fmt.Sprintf("Collapsed=%v\n", settings.Collapsed))...)
+		if settings.DockId != 0 {
+			if settings.DockOrder == -1 {
+				*buf = append(*buf, []byte(fmt.Sprintf("DockId=0x%08X\n", settings.DockId))...)
+			} else {
+				*buf = append(*buf, []byte(fmt.Sprintf("DockId=0x%08X,%d\n", settings.DockId, settings.DockOrder))...)
+			}
+		}
 		*buf = append(*buf, []byte("\n")...)
 	}
 }
@@ -0,0 +1,171 @@
+package imgui
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Splizard/imgui/golang"
+)
+
+// AddSettingsEntryHandler registers a settings handler working on whole entries, which is simpler than implementing
+// the line based callbacks of ImGuiSettingsHandler: read is called with every "[type_name][Name]" entry once the
+// settings are loaded, write returns the entries to save (their Type is set to type_name).
+func (g *ImGuiContext) AddSettingsEntryHandler(type_name string, read func(entry ImGuiSettingsEntry), write func() []ImGuiSettingsEntry) {
+	var pending []ImGuiSettingsEntry
+	g.AddSettingsHandler(&ImGuiSettingsHandler{
+		TypeName: type_name,
+		ReadInitFn: func(_ *ImGuiContext, _ *ImGuiSettingsHandler) {
+			pending = pending[:0]
+		},
+		ReadOpenFn: func(_ *ImGuiContext, _ *ImGuiSettingsHandler, name string) any {
+			pending = append(pending, ImGuiSettingsEntry{Type: type_name, Name: name})
+			return int(len(pending)) - 1
+		},
+		ReadLineFn: func(_ *ImGuiContext, _ *ImGuiSettingsHandler, entry any, line string) {
+			var e = &pending[entry.(int)]
+			e.Lines = append(e.Lines, line)
+		},
+		ApplyAllFn: func(_ *ImGuiContext, _ *ImGuiSettingsHandler) {
+			for _, e := range pending {
+				read(e)
+			}
+			pending = pending[:0]
+		},
+		WriteAllFn: func(_ *ImGuiContext, handler *ImGuiSettingsHandler, buf *ImGuiTextBuffer) {
+			var entries = write()
+			for n := range entries {
+				entries[n].Type = handler.TypeName
+			}
+			var out bytes.Buffer
+			ImGuiSettingsCodecIni{}.Encode(&out, entries)
+			*buf = append(*buf, out.Bytes()...)
+		},
+	})
+}
+
+// settingsStructs is the UserData of the handlers created by AddSettingsStruct().
+type settingsStructs struct {
+	ptrs   map[string]any      // Registered structs by entry name
+	loaded map[string][]string // Lines of the entries loaded before their struct was registered
+}
+
+// AddSettingsStruct persists the exported fields of the struct pointed to by ptr in the "[type_name][name]" entry of
+// the settings, as one "Field=<JSON value>" line per field (fields tagged `imgui:"-"` are skipped), e.g. to save the
+// selected tab, filters or splitter sizes of a panel along with the windows. The fields are set when the settings are
+// loaded, or right away when they were already loaded. Fields missing from the settings keep their value, and so do the
+// fields which can't be decoded, e.g. after their type changed, which is reported with IMGUI_DEBUG_LOG().
+// Call MarkIniSettingsDirty() after changing the struct so the settings are saved.
+func (g *ImGuiContext) AddSettingsStruct(type_name, name string, ptr any) {
+	var value = reflect.ValueOf(ptr)
+	IM_ASSERT(value.Kind() == reflect.Ptr && value.Elem().Kind() == reflect.Struct)
+
+	var structs *settingsStructs
+	if handler := g.FindSettingsHandler(type_name); handler != nil {
+		structs, _ = handler.UserData.(*settingsStructs)
+		IM_ASSERT_USER_ERROR(structs != nil, "type_name is already used by another settings handler!")
+	} else {
+		structs = &settingsStructs{ptrs: map[string]any{}, loaded: map[string][]string{}}
+		g.AddSettingsHandler(&ImGuiSettingsHandler{
+			TypeName:   type_name,
+			ClearAllFn: settingsStructsHandler_ClearAll,
+			ReadOpenFn: settingsStructsHandler_ReadOpen,
+			ReadLineFn: settingsStructsHandler_ReadLine,
+			ApplyAllFn: settingsStructsHandler_ApplyAll,
+			WriteAllFn: settingsStructsHandler_WriteAll,
+			UserData:   structs,
+		})
+	}
+	structs.ptrs[name] = ptr
+	if lines, ok := structs.loaded[name]; ok {
+		g.settingsStructDecode(type_name, name, ptr, lines)
+		delete(structs.loaded, name)
+	}
+}
+
+func settingsStructsHandler_ClearAll(_ *ImGuiContext, handler *ImGuiSettingsHandler) {
+	var structs = handler.UserData.(*settingsStructs)
+	structs.loaded = map[string][]string{}
+}
+
+func settingsStructsHandler_ReadOpen(_ *ImGuiContext, handler *ImGuiSettingsHandler, name string) any {
+	var structs = handler.UserData.(*settingsStructs)
+	structs.loaded[name] = []string{}
+	return name
+}
+
+func settingsStructsHandler_ReadLine(_ *ImGuiContext, handler *ImGuiSettingsHandler, entry any, line string) {
+	var structs = handler.UserData.(*settingsStructs)
+	var name = entry.(string)
+	structs.loaded[name] = append(structs.loaded[name], line)
+}
+
+func settingsStructsHandler_ApplyAll(ctx *ImGuiContext, handler *ImGuiSettingsHandler) {
+	var structs = handler.UserData.(*settingsStructs)
+	for name, lines := range structs.loaded {
+		if ptr, ok := structs.ptrs[name]; ok {
+			ctx.settingsStructDecode(handler.TypeName, name, ptr, lines)
+			delete(structs.loaded, name)
+		}
+	}
+}
+
+func settingsStructsHandler_WriteAll(_ *ImGuiContext, handler *ImGuiSettingsHandler, buf *ImGuiTextBuffer) {
+	var structs = handler.UserData.(*settingsStructs)
+	var entries []ImGuiSettingsEntry
+	for name, ptr := range structs.ptrs {
+		entries = append(entries, ImGuiSettingsEntry{Type: handler.TypeName, Name: name, Lines: settingsStructEncode(ptr)})
+	}
+	for name, lines := range structs.loaded { // Keep the entries of structs which weren't registered in this session
+		entries = append(entries, ImGuiSettingsEntry{Type: handler.TypeName, Name: name, Lines: lines})
+	}
+	sort.Slice(entries, func(i, j golang.Int) bool { return entries[i].Name < entries[j].Name })
+	var out bytes.Buffer
+	ImGuiSettingsCodecIni{}.Encode(&out, entries)
+	*buf = append(*buf, out.Bytes()...)
+}
+
+func settingsStructFields(ptr any, fn func(name string, field reflect.Value)) {
+	var value = reflect.ValueOf(ptr).Elem()
+	var value_type = value.Type()
+	for n := 0; n < value_type.NumField(); n++ {
+		var field = value_type.Field(n)
+		if field.PkgPath != "" || field.Tag.Get("imgui") == "-" { // Unexported or skipped
+			continue
+		}
+		fn(field.Name, value.Field(n))
+	}
+}
+
+func settingsStructEncode(ptr any) []string {
+	var lines []string
+	settingsStructFields(ptr, func(name string, field reflect.Value) {
+		if data, err := json.Marshal(field.Interface()); err == nil {
+			lines = append(lines, name+"="+string(data))
+		}
+	})
+	return lines
+}
+
+func (g *ImGuiContext) settingsStructDecode(type_name, name string, ptr any, lines []string) {
+	var values = map[string]string{}
+	for _, line := range lines {
+		if key, value, ok := strings.Cut(line, "="); ok {
+			values[key] = value
+		}
+	}
+	settingsStructFields(ptr, func(field_name string, field reflect.Value) {
+		var value, ok = values[field_name]
+		if !ok {
+			return
+		}
+		var decoded = reflect.New(field.Type()) // Decoded apart, so that an error leaves the field untouched
+		if err := json.Unmarshal([]byte(value), decoded.Interface()); err != nil {
+			g.IMGUI_DEBUG_LOG("AddSettingsStruct: can't load %s of [%s][%s]: %v\n", field_name, type_name, name, err)
+			return
+		}
+		field.Set(decoded.Elem())
+	})
+}
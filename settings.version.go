@@ -0,0 +1,134 @@
+package imgui
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ImGuiSettingsAlias renames the entries of a settings handler when they are loaded, see AddSettingsAlias().
+type ImGuiSettingsAlias struct {
+	Type        string
+	OldName     string
+	NewName     string
+	MatchPrefix bool // Only rename the start of the name, e.g. the ID of "[Table][0x<ID>,<columns count>]" entries
+}
+
+// ImGuiSettingsUnhandledEntry is an entry loaded without a handler, kept with the version of the settings it was saved with
+// so that it is migrated from that version once its handler is added, even after being saved back with newer settings.
+type ImGuiSettingsUnhandledEntry struct {
+	ImGuiSettingsEntry
+	Version int
+}
+
+// "[Settings][Version]" entry written first when io.SettingsVersion != 0, and before the entries kept from older settings.
+// It applies to the entries following it.
+const (
+	settingsVersionType = "Settings"
+	settingsVersionName = "Version"
+)
+
+// AddSettingsAlias loads the "[type_name][old_name]" entries of the settings as "[type_name][new_name]", e.g. after
+// renaming an entry of a custom handler. Aliases apply to the settings loaded afterwards, register them before the
+// first call to NewFrame() (or before loading the settings manually).
+func (g *ImGuiContext) AddSettingsAlias(type_name, old_name, new_name string) {
+	g.SettingsAliases = append(g.SettingsAliases, ImGuiSettingsAlias{Type: type_name, OldName: old_name, NewName: new_name})
+}
+
+// AddWindowSettingsAlias loads the settings saved for the window old_name into the window new_name, e.g. after
+// renaming a window. Settings already loaded for old_name are moved too, unless new_name has its own settings.
+func (g *ImGuiContext) AddWindowSettingsAlias(old_name, new_name string) {
+	g.AddSettingsAlias("Window", old_name, new_name)
+	var settings = g.FindWindowSettings(ImHashStr(old_name, 0, 0))
+	if settings == nil || g.FindWindowSettings(ImHashStr(new_name, 0, 0)) != nil {
+		return
+	}
+	settings.ID = ImHashStr(new_name, 0, 0)
+	settings.name = new_name
+	if index := strings.Index(new_name, "###"); index != -1 {
+		settings.name = new_name[index:]
+	}
+	settings.WantApply = true
+	WindowSettingsHandler_ApplyAll(g, nil)
+}
+
+// AddTableSettingsAlias loads the settings saved for the table old_id into the table new_id, e.g. after renaming a
+// table or moving it into another window (the ID of a table is GetIDFromString(str_id) in the window calling BeginTable()).
+// Settings already loaded for old_id are moved too, unless new_id has its own settings.
+func (g *ImGuiContext) AddTableSettingsAlias(old_id, new_id ImGuiID) {
+	g.SettingsAliases = append(g.SettingsAliases, ImGuiSettingsAlias{
+		Type:        "Table",
+		OldName:     fmt.Sprintf("0x%08X,", old_id),
+		NewName:     fmt.Sprintf("0x%08X,", new_id),
+		MatchPrefix: true,
+	})
+	var settings = g.TableSettingsFindByID(old_id)
+	if settings == nil || g.TableSettingsFindByID(new_id) != nil {
+		return
+	}
+	settings.ID = new_id
+	settings.WantApply = true
+	TableSettingsHandler_ApplyAll(g, nil) // Rebind the tables to their settings
+}
+
+// settingsApplyAliases renames a loaded entry.
+func (g *ImGuiContext) settingsApplyAliases(entry *ImGuiSettingsEntry) {
+	for _, alias := range g.SettingsAliases {
+		if alias.Type != entry.Type {
+			continue
+		}
+		if alias.MatchPrefix && strings.HasPrefix(entry.Name, alias.OldName) {
+			entry.Name = alias.NewName + entry.Name[len(alias.OldName):]
+		} else if !alias.MatchPrefix && entry.Name == alias.OldName {
+			entry.Name = alias.NewName
+		}
+	}
+}
+
+// settingsReadVersion returns the version set by a "[Settings][Version]" entry, ok is false for other entries.
+func settingsReadVersion(entry *ImGuiSettingsEntry) (version int, ok bool) {
+	if entry.Type != settingsVersionType || entry.Name != settingsVersionName {
+		return 0, false
+	}
+	for _, line := range entry.Lines {
+		fmt.Sscanf(line, "Version=%d", &version)
+	}
+	return version, true
+}
+
+// settingsWriteVersion writes the "[Settings][Version]" entry.
+func settingsWriteVersion(buf *ImGuiTextBuffer, version int) {
+	*buf = append(*buf, []byte(fmt.Sprintf("[%s][%s]\nVersion=%d\n\n", settingsVersionType, settingsVersionName, version))...)
+}
+
+// settingsWriteUnhandled writes the entries kept without a handler: the ones saved with an older version follow
+// a "[Settings][Version]" entry of their own version, so that they are still migrated when they are loaded again.
+func (g *ImGuiContext) settingsWriteUnhandled(buf *ImGuiTextBuffer) {
+	var version = g.IO.SettingsVersion
+	var entries []ImGuiSettingsEntry
+	var flush = func() {
+		if len(entries) > 0 {
+			var out bytes.Buffer
+			ImGuiSettingsCodecIni{}.Encode(&out, entries)
+			*buf = append(*buf, out.Bytes()...)
+			entries = entries[:0]
+		}
+	}
+	for _, entry := range g.SettingsUnhandled {
+		if entry.Version != version {
+			flush()
+			version = entry.Version
+			settingsWriteVersion(buf, version)
+		}
+		entries = append(entries, entry.ImGuiSettingsEntry)
+	}
+	flush()
+}
+
+// settingsMigrate passes an entry saved with an older version to the MigrateFn of its handler, returns false when it must be discarded.
+func (g *ImGuiContext) settingsMigrate(handler *ImGuiSettingsHandler, entry *ImGuiSettingsEntry, from_version int) bool {
+	if handler.MigrateFn == nil || from_version >= g.IO.SettingsVersion {
+		return true
+	}
+	return handler.MigrateFn(g, handler, entry, from_version)
+}
@@ -0,0 +1,310 @@
+package imgui
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSettingsCodecJSON(t *testing.T) {
+	var codec = ImGuiSettingsCodecJSON{Indent: "  "}
+	var ctx = newTestContext(func(io *ImGuiIO) { io.SettingsCodec = codec })
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.SetNextWindowPos(&ImVec2{123, 45}, 0, ImVec2{})
+		ui.Begin("Saved", nil, 0)
+		if ui.BeginTable("table", 2, ImGuiTableFlags_Sortable, ImVec2{}, 0) {
+			ui.TableSetupColumn("Name", ImGuiTableColumnFlags_DefaultSort, 0, 0)
+			ui.TableNextColumn()
+			ui.Text("cell")
+			ui.EndTable()
+		}
+		ui.End()
+	})
+
+	var out bytes.Buffer
+	var ui = ctx.Lock()
+	if err := ui.SaveSettings(&out); err != nil {
+		t.Fatal(err)
+	}
+	ui.Unlock()
+	DestroyContext(ctx)
+
+	var entries []ImGuiSettingsEntry
+	if err := json.Unmarshal(out.Bytes(), &entries); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out.String())
+	}
+	var types = map[string]string{}
+	for _, entry := range entries {
+		types[entry.Type] = strings.Join(entry.Lines, "\n")
+	}
+	if !strings.Contains(types["Window"], "Pos=123,45") || !strings.Contains(types["Table"], "Sort=0v") {
+		t.Errorf("window or table settings missing:\n%s", out.String())
+	}
+
+	ctx = newTestContext(func(io *ImGuiIO) { io.SettingsCodec = codec })
+	defer DestroyContext(ctx)
+	ui = ctx.Lock()
+	if err := ui.LoadSettings(&out); err != nil {
+		t.Fatal(err)
+	}
+	if tables := GImGui.SettingsTables; len(tables) != 1 || tables[0].Columns[0].SortOrder != 0 || tables[0].Columns[1].SortOrder != -1 {
+		t.Errorf("table settings not loaded: %+v", tables)
+	}
+	ui.Unlock()
+	var pos ImVec2
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.Begin("Saved", nil, 0)
+		pos = ui.GetWindowPos()
+		ui.End()
+	})
+	if pos != (ImVec2{123, 45}) {
+		t.Errorf("window at %v, want the position loaded from the JSON settings", pos)
+	}
+}
+
+func TestSettingsCodecJSONMemory(t *testing.T) {
+	var codec = ImGuiSettingsCodecJSON{}
+	var ctx = newTestContext(func(io *ImGuiIO) { io.SettingsCodec = codec })
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.SetNextWindowPos(&ImVec2{80, 90}, 0, ImVec2{})
+		ui.Begin("Saved", nil, 0)
+		ui.End()
+	})
+	var ui = ctx.Lock()
+	var data = append([]byte(nil), ui.SaveIniSettingsToMemory(nil)...)
+	ui.Unlock()
+	DestroyContext(ctx)
+	if !json.Valid(data) {
+		t.Fatalf("settings not saved to memory as JSON:\n%s", data)
+	}
+
+	ctx = newTestContext(func(io *ImGuiIO) { io.SettingsCodec = codec })
+	defer DestroyContext(ctx)
+	ui = ctx.Lock()
+	ui.LoadIniSettingsFromMemory(data, 0)
+	ui.Unlock()
+	var pos ImVec2
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.Begin("Saved", nil, 0)
+		pos = ui.GetWindowPos()
+		ui.End()
+	})
+	if pos != (ImVec2{80, 90}) {
+		t.Errorf("window at %v, want the position loaded from memory", pos)
+	}
+}
+
+func TestSettingsCodecOnDisk(t *testing.T) {
+	var memfs = NewImGuiMemoryFileSystem()
+	memfs.WriteFile("layout.json", []byte(`[{"type": "Window", "name": "Saved", "lines": ["Pos=60,70", "Size=200,100"]}]`))
+
+	var ctx = CreateContext(nil)
+	var ui = ctx.Lock()
+	var io = ui.GetIO()
+	io.FileSystem = memfs
+	io.IniFilename = "layout.json"
+	io.SettingsCodec = ImGuiSettingsCodecJSON{}
+	io.DisplaySize = ImVec2{640, 480}
+	io.Fonts.Build()
+	ui.Unlock()
+
+	var pos ImVec2
+	ctx.Frame(func(ui *ImGuiUI) {
+		ui.Begin("Saved", nil, 0)
+		pos = ui.GetWindowPos()
+		ui.End()
+	})
+	if pos != (ImVec2{60, 70}) {
+		t.Errorf("window at %v, want the position loaded from layout.json", pos)
+	}
+
+	DestroyContext(ctx)
+	var saved = string(memfs.ReadFile("layout.json"))
+	if !strings.HasPrefix(saved, "[{") || !strings.Contains(saved, `"Pos=60,70"`) {
+		t.Errorf("settings not saved as JSON:\n%s", saved)
+	}
+}
+
+func TestSettingsCodecIni(t *testing.T) {
+	var ini = "[Window][A]\r\nPos=1,2\r\n\r\n[Unknown][B]\nKey=Value\n[Window][C]\nPos=3,4"
+	entries, err := ImGuiSettingsCodecIni{}.Decode(strings.NewReader(ini))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[0].Lines[0] != "Pos=1,2" || entries[1].Type != "Unknown" || entries[2].Lines[0] != "Pos=3,4" {
+		t.Fatalf("decoded %+v", entries)
+	}
+	var out strings.Builder
+	ImGuiSettingsCodecIni{}.Encode(&out, entries[:1])
+	if out.String() != "[Window][A]\nPos=1,2\n\n" {
+		t.Errorf("encoded %q", out.String())
+	}
+}
+
+func TestAddSettingsStruct(t *testing.T) {
+	type Filters struct {
+		Query    string
+		Tab      int
+		Splitter []float32
+		scratch  int
+	}
+	var memfs = NewImGuiMemoryFileSystem()
+	memfs.WriteFile("imgui.ini", []byte("[Panel][Filters]\nQuery=\"abc\"\nTab=2\nUnknown=1\n\n"+
+		"[Panel][Closed]\nTab=7\n\n[Layout][Main]\nSplitter=[0.25,0.75]\n\n[Other][Kept]\nKey=Value\n"))
+
+	var ctx = newFileSystemTestContext(memfs)
+	var filters = Filters{Query: "default", Splitter: []float32{0.5}}
+	var ui = ctx.Lock()
+	ui.AddSettingsStruct("Panel", "Filters", &filters) // Registered before the settings are loaded
+	ui.Unlock()
+	ctx.Frame(func(ui *ImGuiUI) {})
+	if filters.Query != "abc" || filters.Tab != 2 || len(filters.Splitter) != 1 {
+		t.Errorf("struct loaded as %+v", filters)
+	}
+
+	var layout Filters
+	var entries []ImGuiSettingsEntry
+	ui = ctx.Lock()
+	ui.AddSettingsStruct("Layout", "Main", &layout) // Registered after the settings were loaded
+	ui.AddSettingsEntryHandler("Other", func(entry ImGuiSettingsEntry) {
+		entries = append(entries, entry)
+	}, func() []ImGuiSettingsEntry {
+		return []ImGuiSettingsEntry{{Name: "Written", Lines: []string{"A=1"}}}
+	})
+	if len(layout.Splitter) != 2 || layout.Splitter[1] != 0.75 {
+		t.Errorf("late struct loaded as %+v", layout)
+	}
+	if len(entries) != 1 || entries[0].Name != "Kept" || entries[0].Lines[0] != "Key=Value" {
+		t.Errorf("entry handler read %+v", entries)
+	}
+	filters.Tab = 3
+	filters.scratch = 1
+	ui.MarkIniSettingsDirty()
+	ui.Unlock()
+
+	DestroyContext(ctx)
+	var ini = string(memfs.ReadFile("imgui.ini"))
+	for _, want := range []string{
+		"[Panel][Closed]\nTab=7\n",
+		"[Panel][Filters]\nQuery=\"abc\"\nTab=3\nSplitter=[0.5]\n", // Splitter missing from the file kept its value
+		"[Layout][Main]\nQuery=\"\"\nTab=0\nSplitter=[0.25,0.75]\n",
+		"[Other][Written]\nA=1\n",
+	} {
+		if !strings.Contains(ini, want) {
+			t.Errorf("saved settings are missing %q:\n%s", want, ini)
+		}
+	}
+	if strings.Contains(ini, "scratch") {
+		t.Errorf("unexported field saved:\n%s", ini)
+	}
+}
+
+func TestAddSettingsStructInvalidField(t *testing.T) {
+	type Filters struct {
+		Query    string
+		Tab      int
+		Splitter []float32
+	}
+	var memfs = NewImGuiMemoryFileSystem()
+	memfs.WriteFile("imgui.ini", []byte("[Panel][Filters]\nQuery=42\nTab=\"two\"\nSplitter=[0.25,\"x\"]\n"))
+
+	var ctx = newFileSystemTestContext(memfs)
+	defer DestroyContext(ctx)
+	var filters = Filters{Query: "default", Tab: 1, Splitter: []float32{0.5}}
+	var ui = ctx.Lock()
+	ui.AddSettingsStruct("Panel", "Filters", &filters)
+	ui.Unlock()
+	ctx.Frame(func(ui *ImGuiUI) {})
+	if filters.Query != "default" || filters.Tab != 1 || len(filters.Splitter) != 1 || filters.Splitter[0] != 0.5 {
+		t.Errorf("fields with values of another type loaded as %+v", filters)
+	}
+}
+
+func TestSettingsVersionMigration(t *testing.T) {
+	var memfs = NewImGuiMemoryFileSystem()
+	memfs.WriteFile("imgui.ini", []byte("[Settings][Version]\nVersion=1\n\n"+
+		"[Window][Old]\nPos=10,20\nSize=100,100\n\n[Window][Stale]\nPos=1,1\nSize=50,50\n\n[Panel][Layout]\nWidth=1\n\n"))
+
+	var ctx = newFileSystemTestContext(memfs)
+	var ui = ctx.Lock()
+	ui.GetIO().SettingsVersion = 2
+	var from_versions []int
+	FindSettingsHandler("Window").MigrateFn = func(_ *ImGuiContext, _ *ImGuiSettingsHandler, entry *ImGuiSettingsEntry, from_version int) bool {
+		from_versions = append(from_versions, from_version)
+		return entry.Name != "Stale"
+	}
+	ui.AddWindowSettingsAlias("Old", "New")
+	ui.Unlock()
+
+	var pos ImVec2
+	var sort_specs ImGuiTableColumnSortSpecs
+	for frame := 0; frame < 2; frame++ {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.Begin("New", nil, 0)
+			pos = ui.GetWindowPos()
+			if frame == 0 {
+				// Settings of a table loaded from the previous name, the table ID is only known within the window
+				var old_id = ui.GetIDFromString("old")
+				var settings = TableSettingsCreate(old_id, 2)
+				settings.Columns[1] = ImGuiTableColumnSettings{Index: 1, SortOrder: 0, SortDirection: uint8(ImGuiSortDirection_Descending), IsEnabled: 1}
+				settings.SaveFlags = ImGuiTableFlags_Sortable
+				ui.AddTableSettingsAlias(old_id, ui.GetIDFromString("new"))
+			}
+			if ui.BeginTable("new", 2, ImGuiTableFlags_Sortable, ImVec2{}, 0) {
+				ui.TableSetupColumn("A", 0, 0, 0)
+				ui.TableSetupColumn("B", 0, 0, 0)
+				if specs := ui.TableGetSortSpecs(); specs != nil && len(specs.Specs) > 0 {
+					sort_specs = specs.Specs[0]
+				}
+				ui.TableNextColumn()
+				ui.EndTable()
+			}
+			ui.End()
+		})
+	}
+	if pos != (ImVec2{10, 20}) {
+		t.Errorf("window at %v, want the position saved for its previous name", pos)
+	}
+	if len(from_versions) != 2 || from_versions[0] != 1 {
+		t.Errorf("migrations called from versions %v", from_versions)
+	}
+	if sort_specs.ColumnIndex != 1 || sort_specs.SortDirection != ImGuiSortDirection_Descending {
+		t.Errorf("table sorted by %+v, want the sort order saved for its previous ID", sort_specs)
+	}
+
+	ui = ctx.Lock()
+	ui.MarkIniSettingsDirty()
+	ui.Unlock()
+	DestroyContext(ctx)
+	var ini = string(memfs.ReadFile("imgui.ini"))
+	if !strings.HasPrefix(ini, "[Settings][Version]\nVersion=2\n\n") || !strings.Contains(ini, "[Window][New]\nPos=10,20") ||
+		strings.Contains(ini, "[Window][Old]") || strings.Contains(ini, "Stale") ||
+		!strings.Contains(ini, "[Settings][Version]\nVersion=1\n\n[Panel][Layout]\nWidth=1\n") {
+		t.Errorf("saved settings:\n%s", ini)
+	}
+
+	// The entry without a handler was saved back with its own version: it is still migrated from it.
+	ctx = newFileSystemTestContext(memfs)
+	defer DestroyContext(ctx)
+	ctx.IO.SettingsVersion = 2
+	ctx.Frame(func(*ImGuiUI) {})
+	from_versions = from_versions[:0]
+	var width string
+	ui = ctx.Lock()
+	ui.AddSettingsHandler(&ImGuiSettingsHandler{
+		TypeName:   "Panel",
+		ReadOpenFn: func(*ImGuiContext, *ImGuiSettingsHandler, string) any { return nil },
+		ReadLineFn: func(_ *ImGuiContext, _ *ImGuiSettingsHandler, _ any, line string) { width = line },
+		WriteAllFn: func(*ImGuiContext, *ImGuiSettingsHandler, *ImGuiTextBuffer) {},
+		MigrateFn: func(_ *ImGuiContext, _ *ImGuiSettingsHandler, entry *ImGuiSettingsEntry, from_version int) bool {
+			from_versions = append(from_versions, from_version)
+			entry.Lines = []string{"Width=2"}
+			return true
+		},
+	})
+	ui.Unlock()
+	if len(from_versions) != 1 || from_versions[0] != 1 || width != "Width=2" {
+		t.Errorf("entry kept without a handler migrated from versions %v and read as %q", from_versions, width)
+	}
+}
@@ -1,9 +1,8 @@
 package imgui
 
 // move content position toward the right, by indent_w, or style.IndentSpacing if indent_w <= 0
-func Indent(indent_w float) {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Indent(indent_w float) {
+	var window = g.GetCurrentWindow()
 
 	if indent_w == 0 {
 		indent_w = g.Style.IndentSpacing
@@ -14,9 +13,8 @@ func Indent(indent_w float) {
 }
 
 // move content position back to the left, by indent_w, or style.IndentSpacing if indent_w <= 0
-func Unindent(indent_w float) {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Unindent(indent_w float) {
+	var window = g.GetCurrentWindow()
 
 	if indent_w == 0 {
 		indent_w = g.Style.IndentSpacing
@@ -168,14 +168,14 @@ func NewImGuiStyle() ImGuiStyle {
 		CurveTessellationTol:       1.25,             // Tessellation tolerance when using PathBezierCurveTo() without a specific number of segments. Decrease for highly tessellated curves (higher quality, more polygons), increase to reduce quality.
 		CircleTessellationMaxError: 0.30,             // Maximum error (in pixels) allowed when using AddCircle()/AddCircleFilled() or drawing rounded corner rectangles with no explicit segment count specified. Decrease for higher quality but more geometry.
 	}
-	StyleColorsDark(&style)
+	styleColorsDark(&style)
 	return style
 }
 
 // access the Style structure (colors, sizes). Always use PushStyleCol(), PushStyleVar() to modify style mid-frame!
-func GetStyle() *ImGuiStyle {
-	IM_ASSERT_USER_ERROR(GImGui != nil, "No current context. Did you call ImGui::CreateContext() and ImGui::SetCurrentContext() ?")
-	return &GImGui.Style
+func (g *ImGuiContext) GetStyle() *ImGuiStyle {
+	IM_ASSERT_USER_ERROR(g != nil, "No current context. Did you call ImGui::CreateContext() and ImGui::SetCurrentContext() ?")
+	return &g.Style
 }
 
 // To scale your entire UI (e.g. if you want your app to use High DPI or generally be DPI aware) you may use this helper function. Scaling the fonts is done separately and is up to you.
@@ -218,23 +218,20 @@ func (style *ImGuiStyle) ScaleAllSizes(scale_factor float) {
 }
 
 // modify a style variable float. always use this if you modify the style after NewFrame().
-func PushStyleFloat(idx ImGuiStyleVar, val float) {
-	var g = GImGui
+func (g *ImGuiContext) PushStyleFloat(idx ImGuiStyleVar, val float) {
 	var pvar = reflect.ValueOf(&g.Style).Elem().Field(golang.Int(idx)).Addr().Interface().(*float)
 	g.StyleVarStack = append(g.StyleVarStack, NewImGuiStyleModFloat(idx, *pvar))
 	*pvar = val
 }
 
 // modify a style variable ImVec2. always use this if you modify the style after NewFrame().
-func PushStyleVec(idx ImGuiStyleVar, val ImVec2) {
-	var g = GImGui
+func (g *ImGuiContext) PushStyleVec(idx ImGuiStyleVar, val ImVec2) {
 	var pvar = reflect.ValueOf(&g.Style).Elem().Field(golang.Int(idx)).Addr().Interface().(*ImVec2)
 	g.StyleVarStack = append(g.StyleVarStack, NewImGuiStyleModVec(idx, *pvar))
 	*pvar = val
 }
 
-func PopStyleVar(count int /*= 1*/) {
-	var g = GImGui
+func (g *ImGuiContext) PopStyleVar(count int /*= 1*/) {
 	for count > 0 {
 		// We avoid a generic memcpy(data, &backup.Backup.., GDataTypeSize[info.Type] * info.Count), the overhead in Debug is not worth it.
 		var backup = &g.StyleVarStack[len(g.StyleVarStack)-1]
@@ -254,24 +251,24 @@ func PopStyleVar(count int /*= 1*/) {
 }
 
 // retrieve given style color with style alpha applied and optional extra alpha multiplier, packed as a 32-bit value suitable for ImDrawList
-func GetColorU32FromID(idx ImGuiCol, alpha_mul float /*= 1.0*/) ImU32 {
-	var style = GImGui.Style
+func (g *ImGuiContext) GetColorU32FromID(idx ImGuiCol, alpha_mul float /*= 1.0*/) ImU32 {
+	var style = g.Style
 	var c = style.Colors[idx]
 	c.w *= style.Alpha * alpha_mul
 	return ColorConvertFloat4ToU32(c)
 }
 
 // retrieve given color with style alpha applied, packed as a 32-bit value suitable for ImDrawList
-func GetColorU32FromVec(col ImVec4) ImU32 {
-	var style = GImGui.Style
+func (g *ImGuiContext) GetColorU32FromVec(col ImVec4) ImU32 {
+	var style = g.Style
 	var c = col
 	c.w *= style.Alpha
 	return ColorConvertFloat4ToU32(c)
 }
 
 // retrieve given color with style alpha applied, packed as a 32-bit value suitable for ImDrawList
-func GetColorU32FromInt(col ImU32) ImU32 {
-	var style = GImGui.Style
+func (g *ImGuiContext) GetColorU32FromInt(col ImU32) ImU32 {
+	var style = g.Style
 	if style.Alpha >= 1.0 {
 		return col
 	}
@@ -281,14 +278,13 @@ func GetColorU32FromInt(col ImU32) ImU32 {
 }
 
 // retrieve style color as stored in ImGuiStyle structure. use to feed back into PushStyleColor(), otherwise use GetColorU32() to get style color with style alpha baked in.
-func GetStyleColorVec4(idx ImGuiCol) *ImVec4 {
-	var style = GImGui.Style
+func (g *ImGuiContext) GetStyleColorVec4(idx ImGuiCol) *ImVec4 {
+	var style = g.Style
 	return &style.Colors[idx]
 }
 
 // FIXME: This may incur a round-trip (if the end user got their data from a float4) but eventually we aim to store the in-flight colors as ImU32
-func PushStyleColorInt(idx ImGuiCol, col ImU32) {
-	var g = GImGui
+func (g *ImGuiContext) PushStyleColorInt(idx ImGuiCol, col ImU32) {
 	var backup ImGuiColorMod
 	backup.Col = idx
 	backup.BackupValue = g.Style.Colors[idx]
@@ -296,8 +292,7 @@ func PushStyleColorInt(idx ImGuiCol, col ImU32) {
 	g.Style.Colors[idx] = ColorConvertU32ToFloat4(col)
 }
 
-func PushStyleColorVec(idx ImGuiCol, col *ImVec4) {
-	var g = GImGui
+func (g *ImGuiContext) PushStyleColorVec(idx ImGuiCol, col *ImVec4) {
 	var backup ImGuiColorMod
 	backup.Col = idx
 	backup.BackupValue = g.Style.Colors[idx]
@@ -305,8 +300,7 @@ func PushStyleColorVec(idx ImGuiCol, col *ImVec4) {
 	g.Style.Colors[idx] = *col
 }
 
-func PopStyleColor(count int /*= 1*/) {
-	var g = GImGui
+func (g *ImGuiContext) PopStyleColor(count int /*= 1*/) {
 	for count > 0 {
 		var backup = &g.ColorStack[len(g.ColorStack)-1]
 		g.Style.Colors[backup.Col] = backup.BackupValue
@@ -394,6 +388,10 @@ func GetStyleColorName(idx ImGuiCol) string {
 		return "TabUnfocused"
 	case ImGuiCol_TabUnfocusedActive:
 		return "TabUnfocusedActive"
+	case ImGuiCol_DockingPreview:
+		return "DockingPreview"
+	case ImGuiCol_DockingEmptyBg:
+		return "DockingEmptyBg"
 	case ImGuiCol_PlotLines:
 		return "PlotLines"
 	case ImGuiCol_PlotLinesHovered:
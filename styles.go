@@ -1,9 +1,13 @@
 package imgui
 
-func StyleColorsDark(style *ImGuiStyle) {
+func (g *ImGuiContext) StyleColorsDark(style *ImGuiStyle) {
 	if style == nil {
-		style = GetStyle()
+		style = g.GetStyle()
 	}
+	styleColorsDark(style)
+}
+
+func styleColorsDark(style *ImGuiStyle) {
 	style.Colors[ImGuiCol_Text] = ImVec4{1.00, 1.00, 1.00, 1.00}
 	style.Colors[ImGuiCol_TextDisabled] = ImVec4{0.50, 0.50, 0.50, 1.00}
 	style.Colors[ImGuiCol_WindowBg] = ImVec4{0.06, 0.06, 0.06, 0.94}
@@ -42,6 +46,8 @@ func StyleColorsDark(style *ImGuiStyle) {
 	style.Colors[ImGuiCol_TabActive] = ImLerpVec4(&style.Colors[ImGuiCol_HeaderActive], &style.Colors[ImGuiCol_TitleBgActive], 0.60)
 	style.Colors[ImGuiCol_TabUnfocused] = ImLerpVec4(&style.Colors[ImGuiCol_Tab], &style.Colors[ImGuiCol_TitleBg], 0.80)
 	style.Colors[ImGuiCol_TabUnfocusedActive] = ImLerpVec4(&style.Colors[ImGuiCol_TabActive], &style.Colors[ImGuiCol_TitleBg], 0.40)
+	style.Colors[ImGuiCol_DockingPreview] = ImVec4{style.Colors[ImGuiCol_HeaderActive].x, style.Colors[ImGuiCol_HeaderActive].y, style.Colors[ImGuiCol_HeaderActive].z, style.Colors[ImGuiCol_HeaderActive].w * 0.7}
+	style.Colors[ImGuiCol_DockingEmptyBg] = ImVec4{0.20, 0.20, 0.20, 1.00}
 	style.Colors[ImGuiCol_PlotLines] = ImVec4{0.61, 0.61, 0.61, 1.00}
 	style.Colors[ImGuiCol_PlotLinesHovered] = ImVec4{1.00, 0.43, 0.35, 1.00}
 	style.Colors[ImGuiCol_PlotHistogram] = ImVec4{0.90, 0.70, 0.00, 1.00}
@@ -59,11 +65,14 @@ func StyleColorsDark(style *ImGuiStyle) {
 	style.Colors[ImGuiCol_ModalWindowDimBg] = ImVec4{0.80, 0.80, 0.80, 0.35}
 }
 
-func StyleColorsClassic(style *ImGuiStyle) {
+func (g *ImGuiContext) StyleColorsClassic(style *ImGuiStyle) {
 	if style == nil {
-		style = GetStyle()
+		style = g.GetStyle()
 	}
+	styleColorsClassic(style)
+}
 
+func styleColorsClassic(style *ImGuiStyle) {
 	style.Colors[ImGuiCol_Text] = ImVec4{0.9, 0.9, 0.9, 1.0}
 	style.Colors[ImGuiCol_TextDisabled] = ImVec4{0.6, 0.6, 0.6, 1.0}
 	style.Colors[ImGuiCol_WindowBg] = ImVec4{0.0, 0.0, 0.0, 0.8}
@@ -102,6 +111,8 @@ func StyleColorsClassic(style *ImGuiStyle) {
 	style.Colors[ImGuiCol_TabActive] = ImLerpVec4(&style.Colors[ImGuiCol_HeaderActive], &style.Colors[ImGuiCol_TitleBgActive], 0.6)
 	style.Colors[ImGuiCol_TabUnfocused] = ImLerpVec4(&style.Colors[ImGuiCol_Tab], &style.Colors[ImGuiCol_TitleBg], 0.8)
 	style.Colors[ImGuiCol_TabUnfocusedActive] = ImLerpVec4(&style.Colors[ImGuiCol_TabActive], &style.Colors[ImGuiCol_TitleBg], 0.4)
+	style.Colors[ImGuiCol_DockingPreview] = ImVec4{style.Colors[ImGuiCol_HeaderActive].x, style.Colors[ImGuiCol_HeaderActive].y, style.Colors[ImGuiCol_HeaderActive].z, style.Colors[ImGuiCol_HeaderActive].w * 0.7}
+	style.Colors[ImGuiCol_DockingEmptyBg] = ImVec4{0.20, 0.20, 0.20, 1.00}
 	style.Colors[ImGuiCol_PlotLines] = ImVec4{1.0, 1.0, 1.0, 1.0}
 	style.Colors[ImGuiCol_PlotLinesHovered] = ImVec4{0.9, 0.7, 0.0, 1.0}
 	style.Colors[ImGuiCol_PlotHistogram] = ImVec4{0.9, 0.7, 0.0, 1.0}
@@ -120,11 +131,14 @@ func StyleColorsClassic(style *ImGuiStyle) {
 }
 
 // StyleColorsLight Those light colors are better suited with a thicker font than the default one + FrameBorder
-func StyleColorsLight(style *ImGuiStyle) {
+func (g *ImGuiContext) StyleColorsLight(style *ImGuiStyle) {
 	if style == nil {
-		style = GetStyle()
+		style = g.GetStyle()
 	}
+	styleColorsLight(style)
+}
 
+func styleColorsLight(style *ImGuiStyle) {
 	style.Colors[ImGuiCol_Text] = ImVec4{0.0, 0.0, 0.0, 1.0}
 	style.Colors[ImGuiCol_TextDisabled] = ImVec4{0.6, 0.6, 0.6, 1.0}
 	style.Colors[ImGuiCol_WindowBg] = ImVec4{0.9, 0.9, 0.9, 1.0}
@@ -163,6 +177,8 @@ func StyleColorsLight(style *ImGuiStyle) {
 	style.Colors[ImGuiCol_TabActive] = ImLerpVec4(&style.Colors[ImGuiCol_HeaderActive], &style.Colors[ImGuiCol_TitleBgActive], 0.6)
 	style.Colors[ImGuiCol_TabUnfocused] = ImLerpVec4(&style.Colors[ImGuiCol_Tab], &style.Colors[ImGuiCol_TitleBg], 0.8)
 	style.Colors[ImGuiCol_TabUnfocusedActive] = ImLerpVec4(&style.Colors[ImGuiCol_TabActive], &style.Colors[ImGuiCol_TitleBg], 0.4)
+	style.Colors[ImGuiCol_DockingPreview] = ImVec4{style.Colors[ImGuiCol_HeaderActive].x, style.Colors[ImGuiCol_HeaderActive].y, style.Colors[ImGuiCol_HeaderActive].z, style.Colors[ImGuiCol_HeaderActive].w * 0.7}
+	style.Colors[ImGuiCol_DockingEmptyBg] = ImVec4{0.20, 0.20, 0.20, 1.00}
 	style.Colors[ImGuiCol_PlotLines] = ImVec4{0.3, 0.3, 0.3, 1.0}
 	style.Colors[ImGuiCol_PlotLinesHovered] = ImVec4{1.0, 0.4, 0.3, 1.0}
 	style.Colors[ImGuiCol_PlotHistogram] = ImVec4{0.9, 0.7, 0.0, 1.0}
@@ -3,8 +3,8 @@ package imgui
 const COLUMNS_HIT_RECT_HALF_WIDTH float = 4
 
 // Get into the columns background draw command (which is generally the same draw command as before we called BeginColumns)
-func PushColumnsBackground() {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) PushColumnsBackground() {
+	var window = g.GetCurrentWindowRead()
 	var columns = window.DC.CurrentColumns
 	if columns.Count == 1 {
 		return
@@ -30,15 +30,14 @@ func SetWindowClipRectBeforeSetChannel(window *ImGuiWindow, clip_rect *ImRect) {
 }
 
 // setup number of columns. use an identifier to distinguish multiple column sets. close with EndColumns().
-func BeginColumns(str_id string, columns_count int, flags ImGuiOldColumnFlags) {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) BeginColumns(str_id string, columns_count int, flags ImGuiOldColumnFlags) {
+	var window = g.GetCurrentWindow()
 
 	IM_ASSERT(columns_count >= 1)
 	IM_ASSERT(window.DC.CurrentColumns == nil) // Nested columns are currently not supported
 
 	// Acquire storage for the columns set
-	var id = GetColumnsID(str_id, columns_count)
+	var id = g.GetColumnsID(str_id, columns_count)
 	var columns = FindOrCreateColumns(window, id)
 	IM_ASSERT(columns.ID == id)
 	columns.Current = 0
@@ -81,8 +80,8 @@ func BeginColumns(str_id string, columns_count int, flags ImGuiOldColumnFlags) {
 	for n := int(0); n < int(columns_count); n++ {
 		// Compute clipping rectangle
 		var column = &columns.Columns[n]
-		var clip_x1 = IM_ROUND(window.Pos.x + GetColumnOffset(n))
-		var clip_x2 = IM_ROUND(window.Pos.x + GetColumnOffset(n+1) - 1.0)
+		var clip_x1 = IM_ROUND(window.Pos.x + g.GetColumnOffset(n))
+		var clip_x2 = IM_ROUND(window.Pos.x + g.GetColumnOffset(n+1) - 1.0)
 		column.ClipRect = ImRect{ImVec2{clip_x1, -FLT_MAX}, ImVec2{clip_x2, +FLT_MAX}}
 		column.ClipRect.ClipWithFull(window.ClipRect)
 	}
@@ -90,29 +89,28 @@ func BeginColumns(str_id string, columns_count int, flags ImGuiOldColumnFlags) {
 	if columns.Count > 1 {
 		columns.Splitter.Split(window.DrawList, 1+columns.Count)
 		columns.Splitter.SetCurrentChannel(window.DrawList, 1)
-		PushColumnClipRect(0)
+		g.PushColumnClipRect(0)
 	}
 
 	// We don't generally store Indent.x inside ColumnsOffset because it may be manipulated by the user.
-	var offset_0 = GetColumnOffset(columns.Current)
-	var offset_1 = GetColumnOffset(columns.Current + 1)
+	var offset_0 = g.GetColumnOffset(columns.Current)
+	var offset_1 = g.GetColumnOffset(columns.Current + 1)
 	var width = offset_1 - offset_0
-	PushItemWidth(width * 0.65)
+	g.PushItemWidth(width * 0.65)
 	window.DC.ColumnsOffset.x = ImMax(column_padding-window.WindowPadding.x, 0.0)
 	window.DC.CursorPos.x = IM_FLOOR(window.Pos.x + window.DC.Indent.x + window.DC.ColumnsOffset.x)
 	window.WorkRect.Max.x = window.Pos.x + offset_1 - column_padding
 }
 
 // close columns
-func EndColumns() {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) EndColumns() {
+	var window = g.GetCurrentWindow()
 	var columns = window.DC.CurrentColumns
 	IM_ASSERT(columns != nil)
 
-	PopItemWidth()
+	g.PopItemWidth()
 	if columns.Count > 1 {
-		PopClipRect()
+		g.PopClipRect()
 		columns.Splitter.Merge(window.DrawList)
 	}
 
@@ -133,18 +131,18 @@ func EndColumns() {
 		var dragging_column int = -1
 		for n := int(1); n < columns.Count; n++ {
 			var column = &columns.Columns[n]
-			var x = window.Pos.x + GetColumnOffset(n)
+			var x = window.Pos.x + g.GetColumnOffset(n)
 			var column_id = columns.ID + ImGuiID(n)
 			var column_hit_hw = COLUMNS_HIT_RECT_HALF_WIDTH
 			var column_hit_rect = ImRect{ImVec2{x - column_hit_hw, y1}, ImVec2{x + column_hit_hw, y2}}
-			KeepAliveID(column_id)
-			if IsClippedEx(&column_hit_rect, column_id, false) {
+			g.KeepAliveID(column_id)
+			if g.IsClippedEx(&column_hit_rect, column_id, false) {
 				continue
 			}
 
 			var hovered, held = false, false
 			if (flags & ImGuiOldColumnFlags_NoResize) == 0 {
-				ButtonBehavior(&column_hit_rect, column_id, &hovered, &held, 0)
+				g.ButtonBehavior(&column_hit_rect, column_id, &hovered, &held, 0)
 				if hovered || held {
 					g.MouseCursor = ImGuiMouseCursor_ResizeEW
 				}
@@ -156,11 +154,11 @@ func EndColumns() {
 			// Draw column
 			var col ImU32
 			if held {
-				col = GetColorU32FromID(ImGuiCol_SeparatorActive, 1)
+				col = g.GetColorU32FromID(ImGuiCol_SeparatorActive, 1)
 			} else if hovered {
-				col = GetColorU32FromID(ImGuiCol_SeparatorHovered, 1)
+				col = g.GetColorU32FromID(ImGuiCol_SeparatorHovered, 1)
 			} else {
-				col = GetColorU32FromID(ImGuiCol_Separator, 1)
+				col = g.GetColorU32FromID(ImGuiCol_Separator, 1)
 			}
 			var xi = IM_FLOOR(x)
 			window.DrawList.AddLine(&ImVec2{xi, y1 + 1.0}, &ImVec2{xi, y2}, col, 1)
@@ -175,8 +173,8 @@ func EndColumns() {
 			}
 			columns.IsBeingResized = true
 			is_being_resized = true
-			var x = GetDraggedColumnOffset(columns, dragging_column)
-			SetColumnOffset(dragging_column, x)
+			var x = g.GetDraggedColumnOffset(columns, dragging_column)
+			g.SetColumnOffset(dragging_column, x)
 		}
 	}
 	columns.IsBeingResized = is_being_resized
@@ -188,19 +186,19 @@ func EndColumns() {
 	window.DC.CursorPos.x = IM_FLOOR(window.Pos.x + window.DC.Indent.x + window.DC.ColumnsOffset.x)
 }
 
-func PushColumnClipRect(column_index int) {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) PushColumnClipRect(column_index int) {
+	var window = g.GetCurrentWindowRead()
 	var columns = window.DC.CurrentColumns
 	if column_index < 0 {
 		column_index = columns.Current
 	}
 
 	var column = &columns.Columns[column_index]
-	PushClipRect(column.ClipRect.Min, column.ClipRect.Max, false)
+	g.PushClipRect(column.ClipRect.Min, column.ClipRect.Max, false)
 }
 
-func PopColumnsBackground() {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) PopColumnsBackground() {
+	var window = g.GetCurrentWindowRead()
 	var columns = window.DC.CurrentColumns
 	if columns.Count == 1 {
 		return
@@ -211,20 +209,20 @@ func PopColumnsBackground() {
 	columns.Splitter.SetCurrentChannel(window.DrawList, columns.Current+1)
 }
 
-func GetColumnsID(str_id string, columns_count int) ImGuiID {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) GetColumnsID(str_id string, columns_count int) ImGuiID {
+	var window = g.GetCurrentWindow()
 
 	var id ImGuiID
 	// Differentiate column ID with an arbitrary prefix for cases where users name their columns set the same as another widget.
 	// In addition, when an identifier isn't explicitly provided we include the number of columns in the hash to make it uniquer.
 	if str_id != "" {
-		PushID(0x11223347)
+		g.PushID(0x11223347)
 		id = window.GetIDs(str_id)
 	} else {
-		PushID(0x11223347 + columns_count)
+		g.PushID(0x11223347 + columns_count)
 		id = window.GetIDs("columns")
 	}
-	PopID()
+	g.PopID()
 
 	return id
 }
@@ -254,8 +252,8 @@ func GetColumnNormFromOffset(columns *ImGuiOldColumns, offset float) float {
 // Legacy Columns API (prefer using Tables!)
 // - You can also use SameLine(pos_x) to mimic simplified columns.
 
-func Columns(columns_count int /*= 1*/, id string /*= L*/, border bool /*= true*/) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Columns(columns_count int /*= 1*/, id string /*= L*/, border bool /*= true*/) {
+	var window = g.GetCurrentWindow()
 	IM_ASSERT(columns_count >= 1)
 
 	var flags = ImGuiOldColumnFlags_NoBorder
@@ -269,22 +267,21 @@ func Columns(columns_count int /*= 1*/, id string /*= L*/, border bool /*= true*
 	}
 
 	if columns != nil {
-		EndColumns()
+		g.EndColumns()
 	}
 
 	if columns_count != 1 {
-		BeginColumns(id, columns_count, flags)
+		g.BeginColumns(id, columns_count, flags)
 	}
 }
 
 // next column, defaults to current row or next row if the current row is finished
-func NextColumn() {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) NextColumn() {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems || window.DC.CurrentColumns == nil {
 		return
 	}
 
-	var g = GImGui
 	var columns = window.DC.CurrentColumns
 
 	if columns.Count == 1 {
@@ -299,7 +296,7 @@ func NextColumn() {
 		columns.Current = 0
 	}
 
-	PopItemWidth()
+	g.PopItemWidth()
 
 	// Optimization: avoid PopClipRect() + SetCurrentChannel() + PushClipRect()
 	// (which would needlessly attempt to update commands in the wrong channel, then pop or overwrite them),
@@ -312,7 +309,7 @@ func NextColumn() {
 	if columns.Current > 0 {
 		// Columns 1+ ignore IndentX (by canceling it out)
 		// FIXME-COLUMNS: Unnecessary, could be locked?
-		window.DC.ColumnsOffset.x = GetColumnOffset(columns.Current) - window.DC.Indent.x + column_padding
+		window.DC.ColumnsOffset.x = g.GetColumnOffset(columns.Current) - window.DC.Indent.x + column_padding
 	} else {
 		// New row/line: column 0 honor IndentX.
 		window.DC.ColumnsOffset.x = ImMax(column_padding-window.WindowPadding.x, 0.0)
@@ -324,16 +321,16 @@ func NextColumn() {
 	window.DC.CurrLineTextBaseOffset = 0.0
 
 	// FIXME-COLUMNS: Share code with BeginColumns() - move code on columns setup.
-	var offset_0 = GetColumnOffset(columns.Current)
-	var offset_1 = GetColumnOffset(columns.Current + 1)
+	var offset_0 = g.GetColumnOffset(columns.Current)
+	var offset_1 = g.GetColumnOffset(columns.Current + 1)
 	var width = offset_1 - offset_0
-	PushItemWidth(width * 0.65)
+	g.PushItemWidth(width * 0.65)
 	window.WorkRect.Max.x = window.Pos.x + offset_1 - column_padding
 }
 
 // get current column index
-func GetColumnIndex() int {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetColumnIndex() int {
+	var window = g.GetCurrentWindowRead()
 	if window.DC.CurrentColumns != nil {
 		return window.DC.CurrentColumns.Current
 	}
@@ -341,12 +338,11 @@ func GetColumnIndex() int {
 }
 
 // get column width (in pixels). pass -1 to use current column
-func GetColumnWidth(column_index int /*= -1*/) float {
-	var g = GImGui
+func (g *ImGuiContext) GetColumnWidth(column_index int /*= -1*/) float {
 	var window = g.CurrentWindow
 	var columns = window.DC.CurrentColumns
 	if columns == nil {
-		return GetContentRegionAvail().x
+		return g.GetContentRegionAvail().x
 	}
 
 	if column_index < 0 {
@@ -356,20 +352,20 @@ func GetColumnWidth(column_index int /*= -1*/) float {
 }
 
 // set column width (in pixels). pass -1 to use current column
-func SetColumnWidth(column_index int, width float) {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) SetColumnWidth(column_index int, width float) {
+	var window = g.GetCurrentWindowRead()
 	var columns = window.DC.CurrentColumns
 	IM_ASSERT(columns != nil)
 
 	if column_index < 0 {
 		column_index = columns.Current
 	}
-	SetColumnOffset(column_index+1, GetColumnOffset(column_index)+width)
+	g.SetColumnOffset(column_index+1, g.GetColumnOffset(column_index)+width)
 }
 
 // get position of column line (in pixels, from the left side of the contents region). pass -1 to use current column, otherwise 0..GetColumnsCount() inclusive. column 0 is typically 0.0
-func GetColumnOffset(column_index int /*= -1*/) float {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetColumnOffset(column_index int /*= -1*/) float {
+	var window = g.GetCurrentWindowRead()
 	var columns = window.DC.CurrentColumns
 	if columns == nil {
 		return 0.0
@@ -386,8 +382,7 @@ func GetColumnOffset(column_index int /*= -1*/) float {
 }
 
 // set position of column line (in pixels, from the left side of the contents region). pass -1 to use current column
-func SetColumnOffset(column_index int, offset float) {
-	var g = GImGui
+func (g *ImGuiContext) SetColumnOffset(column_index int, offset float) {
 	var window = g.CurrentWindow
 	var columns = window.DC.CurrentColumns
 	IM_ASSERT(columns != nil)
@@ -409,30 +404,29 @@ func SetColumnOffset(column_index int, offset float) {
 	columns.Columns[column_index].OffsetNorm = GetColumnNormFromOffset(columns, offset-columns.OffMinX)
 
 	if preserve_width {
-		SetColumnOffset(column_index+1, offset+ImMax(g.Style.ColumnsMinSpacing, width))
+		g.SetColumnOffset(column_index+1, offset+ImMax(g.Style.ColumnsMinSpacing, width))
 	}
 }
 
-func GetColumnsCount() int {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetColumnsCount() int {
+	var window = g.GetCurrentWindowRead()
 	if window.DC.CurrentColumns != nil {
 		return window.DC.CurrentColumns.Count
 	}
 	return 1
 }
 
-func GetDraggedColumnOffset(columns *ImGuiOldColumns, column_index int) float {
+func (g *ImGuiContext) GetDraggedColumnOffset(columns *ImGuiOldColumns, column_index int) float {
 	// Active (dragged) column always follow mouse. The reason we need this is that dragging a column to the right edge of an auto-resizing
 	// window creates a feedback loop because we store normalized positions. So while dragging we enforce absolute positioning.
-	var g = GImGui
 	var window = g.CurrentWindow
 	IM_ASSERT(column_index > 0) // We are not supposed to drag column 0.
 	IM_ASSERT(g.ActiveId == columns.ID+ImGuiID(column_index))
 
 	var x = g.IO.MousePos.x - g.ActiveIdClickOffset.x + COLUMNS_HIT_RECT_HALF_WIDTH - window.Pos.x
-	x = ImMax(x, GetColumnOffset(column_index-1)+g.Style.ColumnsMinSpacing)
+	x = ImMax(x, g.GetColumnOffset(column_index-1)+g.Style.ColumnsMinSpacing)
 	if (columns.Flags & ImGuiOldColumnFlags_NoPreserveWidths) != 0 {
-		x = ImMin(x, GetColumnOffset(column_index+1)-g.Style.ColumnsMinSpacing)
+		x = ImMin(x, g.GetColumnOffset(column_index+1)-g.Style.ColumnsMinSpacing)
 	}
 
 	return x
@@ -2,6 +2,7 @@ package imgui
 
 import (
 	"fmt"
+	"strings"
 	"unsafe"
 )
 
@@ -21,6 +22,10 @@ func TableSettingsCalcChunkSize(columns_count int) size_t {
 // Clear and initialize empty settings instance
 func TableSettingsInit(settings *ImGuiTableSettings, id ImGuiID, columns_count, columns_count_max int) {
 	*settings = ImGuiTableSettings{}
+	settings.Columns = make([]ImGuiTableColumnSettings, columns_count_max)
+	for n := range settings.Columns {
+		settings.Columns[n] = NewImGuiTableColumnSettings()
+	}
 	settings.ID = id
 	settings.ColumnsCount = (ImGuiTableColumnIdx)(columns_count)
 	settings.ColumnsCountMax = (ImGuiTableColumnIdx)(columns_count_max)
@@ -72,8 +77,7 @@ func TableFixFlags(flags ImGuiTableFlags, outer_window *ImGuiWindow) ImGuiTableF
 }
 
 // Tables: Candidates for public API
-func TableOpenContextMenu(column_n int /*= -1*/) {
-	var g = GImGui
+func (g *ImGuiContext) TableOpenContextMenu(column_n int /*= -1*/) {
 	var table = g.CurrentTable
 	if column_n == -1 && table.CurrentColumn != -1 { // When called within a column automatically use this one (for consistency)
 		column_n = table.CurrentColumn
@@ -87,13 +91,12 @@ func TableOpenContextMenu(column_n int /*= -1*/) {
 		table.ContextPopupColumn = (ImGuiTableColumnIdx)(column_n)
 		table.InstanceInteracted = table.InstanceCurrent
 		var context_menu_id = ImHashStr("##ContextMenu", 0, table.ID)
-		OpenPopupEx(context_menu_id, ImGuiPopupFlags_None)
+		g.OpenPopupEx(context_menu_id, ImGuiPopupFlags_None)
 	}
 }
 
 // 'width' = inner column width, without padding
-func TableSetColumnWidth(column_n int, width float) {
-	var g = GImGui
+func (g *ImGuiContext) TableSetColumnWidth(column_n int, width float) {
 	var table = g.CurrentTable
 	IM_ASSERT(table != nil && !table.IsLayoutLocked)
 	IM_ASSERT(column_n >= 0 && column_n < table.ColumnsCount)
@@ -183,8 +186,7 @@ func TableSetColumnWidth(column_n int, width float) {
 
 // Note that the NoSortAscending/NoSortDescending flags are processed in TableSortSpecsSanitize(), and they may change/revert
 // the value of SortDirection. We could technically also do it here but it would be unnecessary and duplicate code.
-func TableSetColumnSortDirection(column_n int, sort_direction ImGuiSortDirection, append_to_sort_specs bool) {
-	var g = GImGui
+func (g *ImGuiContext) TableSetColumnSortDirection(column_n int, sort_direction ImGuiSortDirection, append_to_sort_specs bool) {
 	var table = g.CurrentTable
 
 	if (table.Flags & ImGuiTableFlags_SortMulti) == 0 {
@@ -226,8 +228,7 @@ func TableSetColumnSortDirection(column_n int, sort_direction ImGuiSortDirection
 
 // May use (TableGetColumnFlags() & ImGuiTableColumnFlags_IsHovered) instead. Return hovered column. return -1 when table is not hovered. return columns_count if the unused space at the right of visible columns is hovered.
 // Return -1 when table is not hovered. return columns_count if the unused space at the right of visible columns is hovered.
-func TableGetHoveredColumn() int {
-	var g = GImGui
+func (g *ImGuiContext) TableGetHoveredColumn() int {
 	var table = g.CurrentTable
 	if table == nil {
 		return -1
@@ -235,27 +236,26 @@ func TableGetHoveredColumn() int {
 	return (int)(table.HoveredColumnBody)
 }
 
-func TableGetHeaderRowHeight() float {
+func (g *ImGuiContext) TableGetHeaderRowHeight() float {
 	// Caring for a minor edge case:
 	// Calculate row height, for the unlikely case that some labels may be taller than others.
 	// If we didn't do that, uneven header height would highlight but smaller one before the tallest wouldn't catch input for all height.
 	// In your custom header row you may omit this all together and just call TableNextRow() without a height...
-	var row_height = GetTextLineHeight()
-	var columns_count = TableGetColumnCount()
+	var row_height = g.GetTextLineHeight()
+	var columns_count = g.TableGetColumnCount()
 	for column_n := int(0); column_n < columns_count; column_n++ {
-		var flags = TableGetColumnFlags(column_n)
+		var flags = g.TableGetColumnFlags(column_n)
 		if (flags&ImGuiTableColumnFlags_IsEnabled) != 0 && (flags&ImGuiTableColumnFlags_NoHeaderLabel) == 0 {
-			row_height = ImMax(row_height, CalcTextSize(TableGetColumnName(column_n), true, -1).y)
+			row_height = ImMax(row_height, g.CalcTextSize(g.TableGetColumnName(column_n), true, -1).y)
 		}
 	}
-	row_height += GetStyle().CellPadding.y * 2.0
+	row_height += g.GetStyle().CellPadding.y * 2.0
 	return row_height
 }
 
 // Bg2 is used by Selectable (and possibly other widgets) to render to the background.
 // Unlike our Bg0/1 channel which we uses for RowBg/CellBg/Borders and where we guarantee all shapes to be CPU-clipped, the Bg2 channel being widgets-facing will rely on regular ClipRect.
-func TablePushBackgroundChannel() {
-	var g = GImGui
+func (g *ImGuiContext) TablePushBackgroundChannel() {
 	var window = g.CurrentWindow
 	var table = g.CurrentTable
 
@@ -265,8 +265,7 @@ func TablePushBackgroundChannel() {
 	table.DrawSplitter.SetCurrentChannel(window.DrawList, int(table.Bg2DrawChannelCurrent))
 }
 
-func TablePopBackgroundChannel() {
-	var g = GImGui
+func (g *ImGuiContext) TablePopBackgroundChannel() {
 	var window = g.CurrentWindow
 	var table = g.CurrentTable
 	var column = &table.Columns[table.CurrentColumn]
@@ -280,16 +279,14 @@ func TablePopBackgroundChannel() {
 }
 
 // Tables: Internals
-func GetCurrentTable() *ImGuiTable { var g = GImGui; return g.CurrentTable }
+func (g *ImGuiContext) GetCurrentTable() *ImGuiTable { return g.CurrentTable }
 
-func TableFindByID(id ImGuiID) *ImGuiTable {
-	var g = GImGui
+func (g *ImGuiContext) TableFindByID(id ImGuiID) *ImGuiTable {
 	return g.Tables[id]
 }
 
-func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFlags, outer_size *ImVec2, inner_width float) bool {
-	var g = GImGui
-	var outer_window = GetCurrentWindow()
+func (g *ImGuiContext) BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFlags, outer_size *ImVec2, inner_width float) bool {
+	var outer_window = g.GetCurrentWindow()
 	if outer_window.SkipItems { // Consistent with other tables + beneficial side effect that assert on miscalling EndTable() will be more visible.
 		return false
 	}
@@ -302,17 +299,17 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 
 	// If an outer size is specified ahead we will be able to early out when not visible. Exact clipping rules may evolve.
 	var use_child_window = (flags & (ImGuiTableFlags_ScrollX | ImGuiTableFlags_ScrollY)) != 0
-	var avail_size = GetContentRegionAvail()
+	var avail_size = g.GetContentRegionAvail()
 
 	var h float
 	if use_child_window {
 		h = ImMax(avail_size.y, 1.0)
 	}
 
-	var actual_outer_size = CalcItemSize(*outer_size, ImMax(avail_size.x, 1.0), h)
+	var actual_outer_size = g.CalcItemSize(*outer_size, ImMax(avail_size.x, 1.0), h)
 	var outer_rect = ImRect{outer_window.DC.CursorPos, outer_window.DC.CursorPos.Add(actual_outer_size)}
-	if use_child_window && IsClippedEx(&outer_rect, 0, false) {
-		ItemSizeRect(&outer_rect, 0)
+	if use_child_window && g.IsClippedEx(&outer_rect, 0, false) {
+		g.ItemSizeRect(&outer_rect, 0)
 		return false
 	}
 
@@ -388,12 +385,12 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 			if override_content_size.y != FLT_MAX {
 				y = override_content_size.y
 			}
-			SetNextWindowContentSize(ImVec2{x, y})
+			g.SetNextWindowContentSize(ImVec2{x, y})
 		}
 
 		// Reset scroll if we are reactivating it
 		if (table_last_flags & (ImGuiTableFlags_ScrollX | ImGuiTableFlags_ScrollY)) == 0 {
-			SetNextWindowScroll(&ImVec2{0.0, 0.0})
+			g.SetNextWindowScroll(&ImVec2{0.0, 0.0})
 		}
 
 		// Create scrolling region (without border and zero window padding)
@@ -402,7 +399,7 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 			child_flags = ImGuiWindowFlags_HorizontalScrollbar
 		}
 		size := outer_rect.GetSize()
-		BeginChildEx(name, instance_id, &size, false, child_flags)
+		g.BeginChildEx(name, instance_id, &size, false, child_flags)
 		table.InnerWindow = g.CurrentWindow
 		table.WorkRect = table.InnerWindow.WorkRect
 		table.OuterRect = table.InnerWindow.Rect()
@@ -417,7 +414,7 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 	}
 
 	// Push a standardized ID for both child-using and not-child-using tables
-	PushOverrideID(instance_id)
+	g.PushOverrideID(instance_id)
 
 	// Backup a copy of host window members we will modify
 	var inner_window = table.InnerWindow
@@ -509,8 +506,8 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 	table.DeclColumnsCount = 0
 
 	// Using opaque colors facilitate overlapping elements of the grid
-	table.BorderColorStrong = GetColorU32FromID(ImGuiCol_TableBorderStrong, 1)
-	table.BorderColorLight = GetColorU32FromID(ImGuiCol_TableBorderLight, 1)
+	table.BorderColorStrong = g.GetColorU32FromID(ImGuiCol_TableBorderStrong, 1)
+	table.BorderColorLight = g.GetColorU32FromID(ImGuiCol_TableBorderLight, 1)
 
 	// Make table current
 	g.CurrentTable = table
@@ -585,7 +582,7 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 
 	// Load settings
 	if table.IsSettingsRequestLoad {
-		TableLoadSettings(table)
+		g.TableLoadSettings(table)
 	}
 
 	// Handle DPI/font resize
@@ -615,7 +612,12 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 	}
 
 	// Apply queued resizing/reordering/hiding requests
-	TableBeginApplyRequests(table)
+	g.TableBeginApplyRequests(table)
+
+	// Logging
+	if g.LogEnabled && g.LogType == ImGuiLogType_Capture {
+		g.LogCaptureBeginTable(table, name)
+	}
 
 	return true
 }
@@ -628,18 +630,26 @@ func BeginTableEx(name string, id ImGuiID, columns_count int, flags ImGuiTableFl
 // + 2 * active_channels_count (for ImDrawCmd and ImDrawIdx buffers inside channels)
 // Where active_channels_count is variable but often == columns_count or columns_count + 1, see TableSetupDrawChannels() for details.
 // Unused channels don't perform their +2 allocations.
-func TableBeginInitMemory(e *ImGuiTable, columns_count int) {
-	// noop, will be handled by span helpers
+func TableBeginInitMemory(table *ImGuiTable, columns_count int) {
+	// Allocate the columns up front so the initialization in BeginTableEx() reaches all of them
+	// (the span helpers only grow the slices on demand), RawData marks the table as allocated.
+	table.Columns = make([]ImGuiTableColumn, columns_count)
+	table.DisplayOrderToIndex = make([]ImGuiTableColumnIdx, columns_count)
+	table.RowCellData = make([]ImGuiTableCellData, columns_count)
+	for n := range table.Columns {
+		table.Columns[n] = NewImGuiTableColumn()
+	}
+	table.RawData = table.Columns
 }
 
 // Apply queued resizing/reordering/hiding requests
-func TableBeginApplyRequests(table *ImGuiTable) {
+func (g *ImGuiContext) TableBeginApplyRequests(table *ImGuiTable) {
 	// Handle resizing request
 	// (We process this at the first TableBegin of the frame)
 	// FIXME-TABLE: Contains columns if our work area doesn't allow for scrolling?
 	if table.InstanceCurrent == 0 {
 		if table.ResizedColumn != -1 && table.ResizedColumnNextWidth != FLT_MAX {
-			TableSetColumnWidth(int(table.ResizedColumn), table.ResizedColumnNextWidth)
+			g.TableSetColumnWidth(int(table.ResizedColumn), table.ResizedColumnNextWidth)
 		}
 		table.LastResizedColumn = table.ResizedColumn
 		table.ResizedColumnNextWidth = FLT_MAX
@@ -648,7 +658,7 @@ func TableBeginApplyRequests(table *ImGuiTable) {
 		// Process auto-fit for single column, which is a special case for stretch columns and fixed columns with FixedSame policy.
 		// FIXME-TABLE: Would be nice to redistribute available stretch space accordingly to other weights, instead of giving it all to siblings.
 		if table.AutoFitSingleColumn != -1 {
-			TableSetColumnWidth(int(table.AutoFitSingleColumn), table.Columns[table.AutoFitSingleColumn].WidthAuto)
+			g.TableSetColumnWidth(int(table.AutoFitSingleColumn), table.Columns[table.AutoFitSingleColumn].WidthAuto)
 			table.AutoFitSingleColumn = -1
 		}
 	}
@@ -916,8 +926,7 @@ func (table *ImGuiTable) spanRowCellData(idx int) {
 // Runs on the first call to TableNextRow(), to give a chance for TableSetupColumn() to be called first.
 // FIXME-TABLE: Our width (and therefore our WorkRect) will be minimal in the first frame for _WidthAuto columns.
 // Increase feedback side-effect with widgets relying on WorkRect.Max.x... Maybe provide a default distribution for _WidthAuto columns?
-func TableUpdateLayout(table *ImGuiTable) {
-	var g = GImGui
+func (g *ImGuiContext) TableUpdateLayout(table *ImGuiTable) {
 	IM_ASSERT(!table.IsLayoutLocked)
 
 	var table_sizing_policy = (table.Flags & ImGuiTableFlags_SizingMask_)
@@ -1179,7 +1188,7 @@ func TableUpdateLayout(table *ImGuiTable) {
 	table.HoveredColumnBody = -1
 	table.HoveredColumnBorder = -1
 	var mouse_hit_rect = ImRect{ImVec2{table.OuterRect.Min.x, table.OuterRect.Min.y}, ImVec2{table.OuterRect.Max.x, ImMax(table.OuterRect.Max.y, table.OuterRect.Min.y+table.LastOuterHeight)}}
-	var is_hovering_table = ItemHoverable(&mouse_hit_rect, 0)
+	var is_hovering_table = g.ItemHoverable(&mouse_hit_rect, 0)
 
 	// [Part 6] Setup final position, offset, skip/clip states and clipping rectangles, detect hovered column
 	// Process columns in their visible orders as we are comparing the visible order and adjusting host_clip_rect while looping.
@@ -1364,7 +1373,7 @@ func TableUpdateLayout(table *ImGuiTable) {
 
 	// [Part 10] Hit testing on borders
 	if table.Flags&ImGuiTableFlags_Resizable != 0 {
-		TableUpdateBorders(table)
+		g.TableUpdateBorders(table)
 	}
 	table.LastFirstRowHeight = 0.0
 	table.IsLayoutLocked = true
@@ -1373,9 +1382,9 @@ func TableUpdateLayout(table *ImGuiTable) {
 	// [Part 11] Context menu
 	if table.IsContextPopupOpen && table.InstanceCurrent == table.InstanceInteracted {
 		var context_menu_id = ImHashStr("##ContextMenu", 0, table.ID)
-		if BeginPopupEx(context_menu_id, ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoSavedSettings) {
-			TableDrawContextMenu(table)
-			EndPopup()
+		if g.BeginPopupEx(context_menu_id, ImGuiWindowFlags_AlwaysAutoResize|ImGuiWindowFlags_NoTitleBar|ImGuiWindowFlags_NoSavedSettings) {
+			g.TableDrawContextMenu(table)
+			g.EndPopup()
 		} else {
 			table.IsContextPopupOpen = false
 		}
@@ -1400,8 +1409,7 @@ func TableUpdateLayout(table *ImGuiTable) {
 //   - Set table.HoveredColumnBorder with a short delay/timer to reduce feedback noise
 //   - Submit ahead of table contents and header, use ImGuiButtonFlags_AllowItemOverlap to prioritize widgets
 //     overlapping the same area.
-func TableUpdateBorders(table *ImGuiTable) {
-	var g = GImGui
+func (g *ImGuiContext) TableUpdateBorders(table *ImGuiTable) {
 	IM_ASSERT(table.Flags&ImGuiTableFlags_Resizable != 0)
 
 	// At this point OuterRect height may be zero or under actual final height, so we rely on temporal coherency and
@@ -1442,13 +1450,13 @@ func TableUpdateBorders(table *ImGuiTable) {
 		var column_id = TableGetColumnResizeID(table, int(column_n), int(table.InstanceCurrent))
 		var hit_rect = ImRect{ImVec2{column.MaxX - hit_half_width, hit_y1}, ImVec2{column.MaxX + hit_half_width, border_y2_hit}}
 		//GetForegroundDrawList().AddRect(hit_rect.Min, hit_rect.Max, IM_COL32(255, 0, 0, 100));
-		KeepAliveID(column_id)
+		g.KeepAliveID(column_id)
 
 		var hovered, held = false, false
-		var pressed = ButtonBehavior(&hit_rect, column_id, &hovered, &held, ImGuiButtonFlags_FlattenChildren|ImGuiButtonFlags_AllowItemOverlap|ImGuiButtonFlags_PressedOnClick|ImGuiButtonFlags_PressedOnDoubleClick)
-		if pressed && IsMouseDoubleClicked(0) {
+		var pressed = g.ButtonBehavior(&hit_rect, column_id, &hovered, &held, ImGuiButtonFlags_FlattenChildren|ImGuiButtonFlags_AllowItemOverlap|ImGuiButtonFlags_PressedOnClick|ImGuiButtonFlags_PressedOnDoubleClick)
+		if pressed && g.IsMouseDoubleClicked(0) {
 			TableSetColumnWidthAutoSingle(table, int(column_n))
-			ClearActiveID()
+			g.ClearActiveID()
 			held = false
 			hovered = false
 		}
@@ -1465,7 +1473,7 @@ func TableUpdateBorders(table *ImGuiTable) {
 		}
 		if (hovered && g.HoveredIdTimer > TABLE_RESIZE_SEPARATOR_FEEDBACK_TIMER) || held {
 			table.HoveredColumnBorder = (ImGuiTableColumnIdx)(column_n)
-			SetMouseCursor(ImGuiMouseCursor_ResizeEW)
+			g.SetMouseCursor(ImGuiMouseCursor_ResizeEW)
 		}
 	}
 }
@@ -1501,7 +1509,7 @@ func TableUpdateColumnsWeightFromWidth(table *ImGuiTable) {
 }
 
 // FIXME-TABLE: This is a mess, need to redesign how we render borders (as some are also done in TableEndRow)
-func TableDrawBorders(table *ImGuiTable) {
+func (g *ImGuiContext) TableDrawBorders(table *ImGuiTable) {
 	var inner_window = table.InnerWindow
 	if !table.OuterWindow.ClipRect.Overlaps(table.OuterRect) {
 		return
@@ -1558,9 +1566,9 @@ func TableDrawBorders(table *ImGuiTable) {
 			if is_hovered || is_resized || is_frozen_separator {
 				draw_y2 = draw_y2_body
 				if is_resized {
-					col = GetColorU32FromID(ImGuiCol_SeparatorActive, 1)
+					col = g.GetColorU32FromID(ImGuiCol_SeparatorActive, 1)
 				} else if is_hovered {
-					col = GetColorU32FromID(ImGuiCol_SeparatorHovered, 1)
+					col = g.GetColorU32FromID(ImGuiCol_SeparatorHovered, 1)
 				} else {
 					col = table.BorderColorStrong
 				}
@@ -1616,8 +1624,7 @@ func TableDrawBorders(table *ImGuiTable) {
 
 // Output context menu into current window (generally a popup)
 // FIXME-TABLE: Ideally this should be writable by the user. Full programmatic access to that data?
-func TableDrawContextMenu(table *ImGuiTable) {
-	var g = GImGui
+func (g *ImGuiContext) TableDrawContextMenu(table *ImGuiTable) {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return
@@ -1640,7 +1647,7 @@ func TableDrawContextMenu(table *ImGuiTable) {
 	if table.Flags&ImGuiTableFlags_Resizable != 0 {
 		if column != nil {
 			var can_resize = (column.Flags&ImGuiTableColumnFlags_NoResize) == 0 && column.IsEnabled
-			if MenuItem("Size column to fit###SizeOne", "", nil, can_resize) {
+			if g.MenuItem("Size column to fit###SizeOne", "", nil, can_resize) {
 				TableSetColumnWidthAutoSingle(table, column_n)
 			}
 		}
@@ -1651,7 +1658,7 @@ func TableDrawContextMenu(table *ImGuiTable) {
 		} else {
 			size_all_desc = "Size all columns to default###SizeAll" // All stretch or mixed
 		}
-		if MenuItem(size_all_desc, "", nil, false) {
+		if g.MenuItem(size_all_desc, "", nil, false) {
 			TableSetColumnWidthAutoAll(table)
 		}
 		want_separator = true
@@ -1659,7 +1666,7 @@ func TableDrawContextMenu(table *ImGuiTable) {
 
 	// Ordering
 	if table.Flags&ImGuiTableFlags_Reorderable != 0 {
-		if MenuItem("Reset order", "", nil, !table.IsDefaultDisplayOrder) {
+		if g.MenuItem("Reset order", "", nil, !table.IsDefaultDisplayOrder) {
 			table.IsResetDisplayOrderRequest = true
 		}
 		want_separator = true
@@ -1668,11 +1675,11 @@ func TableDrawContextMenu(table *ImGuiTable) {
 	// Hiding / Visibility
 	if (table.Flags & ImGuiTableFlags_Hideable) != 0 {
 		if want_separator {
-			Separator()
+			g.Separator()
 		}
 		want_separator = true
 
-		PushItemFlag(ImGuiItemFlags_SelectableDontClosePopup, true)
+		g.PushItemFlag(ImGuiItemFlags_SelectableDontClosePopup, true)
 		for other_column_n := int(0); other_column_n < table.ColumnsCount; other_column_n++ {
 			table.spanColumns(other_column_n)
 			var other_column = &table.Columns[other_column_n]
@@ -1693,11 +1700,11 @@ func TableDrawContextMenu(table *ImGuiTable) {
 			if other_column.IsUserEnabled && table.ColumnsEnabledCount <= 1 {
 				menu_item_active = false
 			}
-			if MenuItem(name, "", &other_column.IsUserEnabled, menu_item_active) {
+			if g.MenuItem(name, "", &other_column.IsUserEnabled, menu_item_active) {
 				other_column.IsUserEnabledNextFrame = !other_column.IsUserEnabled
 			}
 		}
-		PopItemFlag()
+		g.PopItemFlag()
 	}
 }
 
@@ -1731,8 +1738,7 @@ func TableDrawContextMenu(table *ImGuiTable) {
 // Columns for which the draw channel(s) haven't been merged with other will use their own ImDrawCmd.
 //
 // This function is particularly tricky to understand.. take a breath.
-func TableMergeDrawChannels(table *ImGuiTable) {
-	var g = GImGui
+func (g *ImGuiContext) TableMergeDrawChannels(table *ImGuiTable) {
 	var splitter = table.DrawSplitter
 	var has_freeze_v = (table.FreezeRowsCount > 0)
 	var has_freeze_h = (table.FreezeColumnsCount > 0)
@@ -1833,10 +1839,10 @@ func TableMergeDrawChannels(table *ImGuiTable) {
 				}
 				var buf = fmt.Sprintf("MG%d:%d", merge_group_n, merge_group.ChannelsCount)
 				var text_pos = merge_group.ClipRect.Min.Add(ImVec2{4, 4})
-				var text_size = CalcTextSize(buf, true, -1)
-				GetForegroundDrawList(nil).AddRectFilled(text_pos, text_pos.Add(text_size), IM_COL32(0, 0, 0, 255), 0, 0)
-				GetForegroundDrawList(nil).AddText(text_pos, IM_COL32(255, 255, 0, 255), buf)
-				GetForegroundDrawList(nil).AddRect(merge_group.ClipRect.Min, merge_group.ClipRect.Max, IM_COL32(255, 255, 0, 255), 0, 0, 1)
+				var text_size = g.CalcTextSize(buf, true, -1)
+				g.GetForegroundDrawList(nil).AddRectFilled(text_pos, text_pos.Add(text_size), IM_COL32(0, 0, 0, 255), 0, 0)
+				g.GetForegroundDrawList(nil).AddText(text_pos, IM_COL32(255, 255, 0, 255), buf)
+				g.GetForegroundDrawList(nil).AddRect(merge_group.ClipRect.Min, merge_group.ClipRect.Max, IM_COL32(255, 255, 0, 255), 0, 0, 1)
 			}
 		}
 	}
@@ -1845,6 +1851,9 @@ func TableMergeDrawChannels(table *ImGuiTable) {
 	if merge_group_mask != 0 {
 		// We skip channel 0 (Bg0/Bg1) and 1 (Bg2 frozen) from the shuffling since they won't move - see channels allocation in TableSetupDrawChannels().
 		var LEADING_DRAW_CHANNELS int = 2
+		if int(cap(g.DrawChannelsTempMergeBuffer)) < splitter._Count-LEADING_DRAW_CHANNELS {
+			g.DrawChannelsTempMergeBuffer = make([]ImDrawChannel, splitter._Count-LEADING_DRAW_CHANNELS)
+		}
 		g.DrawChannelsTempMergeBuffer = g.DrawChannelsTempMergeBuffer[:splitter._Count-LEADING_DRAW_CHANNELS] // Use shared temporary storage so the allocation gets amortized
 
 		var dst_tmp = g.DrawChannelsTempMergeBuffer
@@ -2038,6 +2047,9 @@ func TableSortSpecsBuild(table *ImGuiTable) {
 			sort_spec.SortOrder = (ImS16)(column.SortOrder)
 			sort_spec.SortDirection = column.SortDirection
 		}
+		if table.SortSpecsCount == 1 {
+			table.SortSpecsSingle = sort_specs[0] // sort_specs is a copy
+		}
 	}
 
 	table.SortSpecs.Specs = sort_specs
@@ -2090,7 +2102,7 @@ func TableGetColumnWidthAuto(table *ImGuiTable, column *ImGuiTableColumn) float
 }
 
 // [Internal] Called by TableNextRow()
-func TableBeginRow(table *ImGuiTable) {
+func (g *ImGuiContext) TableBeginRow(table *ImGuiTable) {
 	var window = table.InnerWindow
 	IM_ASSERT(!table.IsInsideRow)
 
@@ -2118,16 +2130,20 @@ func TableBeginRow(table *ImGuiTable) {
 
 	// Making the header BG color non-transparent will allow us to overlay it multiple times when handling smooth dragging.
 	if table.RowFlags&ImGuiTableRowFlags_Headers != 0 {
-		TableSetBgColor(ImGuiTableBgTarget_RowBg0, GetColorU32FromID(ImGuiCol_TableHeaderBg, 1), 0)
+		g.TableSetBgColor(ImGuiTableBgTarget_RowBg0, g.GetColorU32FromID(ImGuiCol_TableHeaderBg, 1), -1)
 		if table.CurrentRow == 0 {
 			table.IsUsingHeaders = true
 		}
 	}
+
+	// Logging
+	if g.LogEnabled && g.LogType == ImGuiLogType_Capture {
+		g.LogCaptureTableBeginRow(table)
+	}
 }
 
 // [Internal] Called by TableNextRow()
-func TableEndRow(table *ImGuiTable) {
-	var g = GImGui
+func (g *ImGuiContext) TableEndRow(table *ImGuiTable) {
 	var window = g.CurrentWindow
 	IM_ASSERT(window == table.InnerWindow)
 	IM_ASSERT(table.IsInsideRow)
@@ -2137,8 +2153,8 @@ func TableEndRow(table *ImGuiTable) {
 	}
 
 	// Logging
-	if g.LogEnabled {
-		LogRenderedText(nil, "|")
+	if g.LogEnabled && g.LogType != ImGuiLogType_Capture {
+		g.LogRenderedText(nil, "|")
 	}
 
 	// Position cursor at the bottom of our row so it can be used for e.g. clipping calculation. However it is
@@ -2166,7 +2182,7 @@ func TableEndRow(table *ImGuiTable) {
 			if table.RowBgColorCounter&1 != 0 {
 				c = ImGuiCol_TableRowBgAlt
 			}
-			bg_col0 = GetColorU32FromID(c, 1)
+			bg_col0 = g.GetColorU32FromID(c, 1)
 		}
 		if table.RowBgColor[1] != IM_COL32_DISABLE {
 			bg_col1 = table.RowBgColor[1]
@@ -2290,7 +2306,7 @@ func TableEndRow(table *ImGuiTable) {
 // [Internal] Called by TableSetColumnIndex()/TableNextColumn()
 // This is called very frequently, so we need to be mindful of unnecessary overhead.
 // FIXME-TABLE FIXME-OPT: Could probably shortcut some things for non-active or clipped columns.
-func TableBeginCell(table *ImGuiTable, column_n int) {
+func (g *ImGuiContext) TableBeginCell(table *ImGuiTable, column_n int) {
 	table.spanColumns(column_n)
 	var column = &table.Columns[column_n]
 	var window = table.InnerWindow
@@ -2321,7 +2337,6 @@ func TableBeginCell(table *ImGuiTable, column_n int) {
 
 	window.SkipItems = column.IsSkipItems
 	if column.IsSkipItems {
-		var g = GImGui
 		g.LastItemData.ID = 0
 		g.LastItemData.StatusFlags = 0
 	}
@@ -2339,9 +2354,12 @@ func TableBeginCell(table *ImGuiTable, column_n int) {
 	}
 
 	// Logging
-	var g = GImGui
 	if g.LogEnabled && !column.IsSkipItems {
-		LogRenderedText(&window.DC.CursorPos, "|")
+		if g.LogType == ImGuiLogType_Capture {
+			g.LogCaptureTableBeginCell(table)
+		} else {
+			g.LogRenderedText(&window.DC.CursorPos, "|")
+		}
 		g.LogLinePosY = FLT_MAX
 	}
 }
@@ -2459,9 +2477,8 @@ func TableSetColumnWidthAutoAll(table *ImGuiTable) {
 }
 
 // Remove Table (currently only used by TestEngine)
-func TableRemove(table *ImGuiTable) {
+func (g *ImGuiContext) TableRemove(table *ImGuiTable) {
 	//IMGUI_DEBUG_LOG("TableRemove() id=0x%08X\n", table.ID);
-	var g = GImGui
 	var table_idx uint
 	for i := range g.Tables {
 		if g.Tables[i] == table {
@@ -2473,9 +2490,8 @@ func TableRemove(table *ImGuiTable) {
 }
 
 // Free up/compact internal Table buffers for when it gets unused
-func TableGcCompactTransientBuffers(table *ImGuiTable) {
+func (g *ImGuiContext) TableGcCompactTransientBuffers(table *ImGuiTable) {
 	//IMGUI_DEBUG_LOG("TableGcCompactTransientBuffers() id=0x%08X\n", table.ID);
-	var g = GImGui
 	IM_ASSERT(!table.MemoryCompacted)
 	table.SortSpecs.Specs = nil
 	table.SortSpecsMulti = nil
@@ -2502,8 +2518,7 @@ func TableGcCompactTransientBuffersTempData(temp_data *ImGuiTableTempData) {
 }
 
 // Compact and remove unused settings data (currently only used by TestEngine)
-func TableGcCompactSettings() {
-	var g = GImGui
+func (g *ImGuiContext) TableGcCompactSettings() {
 	var required_memory int = 0
 	for _, settings := range g.SettingsTables {
 		if settings.ID != 0 {
@@ -2524,8 +2539,7 @@ func TableGcCompactSettings() {
 }
 
 // Tables: Settings
-func TableLoadSettings(table *ImGuiTable) {
-	var g = GImGui
+func (g *ImGuiContext) TableLoadSettings(table *ImGuiTable) {
 	table.IsSettingsRequestLoad = false
 	if table.Flags&ImGuiTableFlags_NoSavedSettings != 0 {
 		return
@@ -2534,7 +2548,7 @@ func TableLoadSettings(table *ImGuiTable) {
 	// Bind settings
 	var settings *ImGuiTableSettings
 	if table.SettingsOffset == -1 {
-		settings = TableSettingsFindByID(table.ID)
+		settings = g.TableSettingsFindByID(table.ID)
 		if settings == nil {
 			return
 		}
@@ -2544,11 +2558,11 @@ func TableLoadSettings(table *ImGuiTable) {
 		for i := range g.SettingsTables {
 			if &g.SettingsTables[i] == settings {
 				table.SettingsOffset = int(i)
-				return
+				break
 			}
 		}
 	} else {
-		settings = TableGetBoundSettings(table)
+		settings = g.TableGetBoundSettings(table)
 	}
 
 	table.SettingsLoadedFlags = settings.SaveFlags
@@ -2603,17 +2617,16 @@ func TableLoadSettings(table *ImGuiTable) {
 	}
 }
 
-func TableSaveSettings(table *ImGuiTable) {
+func (g *ImGuiContext) TableSaveSettings(table *ImGuiTable) {
 	table.IsSettingsDirty = false
 	if table.Flags&ImGuiTableFlags_NoSavedSettings != 0 {
 		return
 	}
 
 	// Bind or create settings data
-	var g = GImGui
-	var settings = TableGetBoundSettings(table)
+	var settings = g.TableGetBoundSettings(table)
 	if settings == nil {
-		settings = TableSettingsCreate(table.ID, table.ColumnsCount)
+		settings = g.TableSettingsCreate(table.ID, table.ColumnsCount)
 		for i := range g.SettingsTables {
 			if &g.SettingsTables[i] == settings {
 				table.SettingsOffset = int(i)
@@ -2672,7 +2685,7 @@ func TableSaveSettings(table *ImGuiTable) {
 		settings.RefScale = table.RefScale
 	}
 
-	MarkIniSettingsDirty()
+	g.MarkIniSettingsDirty()
 }
 
 // Restore initial state of table (with or without saved settings)
@@ -2685,9 +2698,8 @@ func TableResetSettings(table *ImGuiTable) {
 }
 
 // Get settings for a given table, NULL if none
-func TableGetBoundSettings(table *ImGuiTable) *ImGuiTableSettings {
+func (g *ImGuiContext) TableGetBoundSettings(table *ImGuiTable) *ImGuiTableSettings {
 	if table.SettingsOffset != -1 {
-		var g = GImGui
 		var settings = &g.SettingsTables[table.SettingsOffset]
 		IM_ASSERT(settings.ID == table.ID)
 		if int(settings.ColumnsCountMax) >= table.ColumnsCount {
@@ -2711,19 +2723,16 @@ func TableSettingsInstallHandler(context *ImGuiContext) {
 	g.SettingsHandlers = append(g.SettingsHandlers, ini_handler)
 }
 
-func TableSettingsCreate(id ImGuiID, columns_count int) *ImGuiTableSettings {
-	var g = GImGui
+func (g *ImGuiContext) TableSettingsCreate(id ImGuiID, columns_count int) *ImGuiTableSettings {
 	g.SettingsTables = append(g.SettingsTables, ImGuiTableSettings{})
 	var settings = &g.SettingsTables[len(g.SettingsTables)-1]
 	TableSettingsInit(settings, id, columns_count, columns_count)
-	settings.Columns = make([]ImGuiTableColumnSettings, columns_count)
 	return settings
 }
 
 // Find existing settings
-func TableSettingsFindByID(id ImGuiID) *ImGuiTableSettings {
+func (g *ImGuiContext) TableSettingsFindByID(id ImGuiID) *ImGuiTableSettings {
 	// FIXME-OPT: Might want to store a lookup map for this?
-	var g = GImGui
 	for i, settings := range g.SettingsTables {
 		if settings.ID == id {
 			return &g.SettingsTables[i]
@@ -2756,77 +2765,83 @@ func TableSettingsHandler_ApplyAll(ctx *ImGuiContext, _ *ImGuiSettingsHandler) {
 func TableSettingsHandler_ReadOpen(ctx *ImGuiContext, _ *ImGuiSettingsHandler, name string) any {
 	var id ImGuiID = 0
 	var columns_count int = 0
-	if n, _ := fmt.Scanf(name, "0x%08X,%d", &id, &columns_count); n < 2 {
+	if n, _ := fmt.Sscanf(name, "0x%X,%d", &id, &columns_count); n < 2 {
 		return nil
 	}
 
-	if settings := TableSettingsFindByID(id); settings != nil {
+	if settings := ctx.TableSettingsFindByID(id); settings != nil {
 		if int(settings.ColumnsCountMax) >= columns_count {
 			TableSettingsInit(settings, id, columns_count, int(settings.ColumnsCountMax)) // Recycle
 			return settings
 		}
 		settings.ID = 0 // Invalidate storage, we won't fit because of a count change
 	}
-	return TableSettingsCreate(id, columns_count)
+	return ctx.TableSettingsCreate(id, columns_count)
 }
 
 func TableSettingsHandler_ReadLine(ctx *ImGuiContext, _ *ImGuiSettingsHandler, entry any, line string) {
 	// "Column 0  UserID=0x42AD2D21 Width=100 Visible=1 Order=0 Sort=0v"
-	var settings = entry.(*ImGuiTableSettings)
+	var settings, _ = entry.(*ImGuiTableSettings)
+	if settings == nil {
+		return
+	}
 	var f float = 0.0
-	var column_n, r int
-	var x uint
-
 	if n, _ := fmt.Sscanf(line, "RefScale=%f", &f); n == 1 {
 		settings.RefScale = f
 		return
 	}
 
-	if n, _ := fmt.Sscanf(line, "Column %d%n", &column_n, &r); n == 1 {
-		if column_n < 0 || column_n >= int(settings.ColumnsCount) {
-			return
-		}
-		line = ImStrSkipBlank(line[r:])
-		var c byte = 0
-		var column = settings.Columns[column_n]
-		column.Index = (ImGuiTableColumnIdx)(column_n)
-		if n, _ := fmt.Sscanf(line, "UserID=0x%08X%n", (*ImU32)(&x), &r); n == 1 {
-			line = ImStrSkipBlank(line[r:])
-			column.UserID = (ImGuiID)(n)
-		}
-		if n, _ := fmt.Sscanf(line, "Width=%d%n", &n, &r); n == 1 {
-			line = ImStrSkipBlank(line[r:])
-			column.WidthOrWeight = (float)(n)
-			column.IsStretch = 0
-			settings.SaveFlags |= ImGuiTableFlags_Resizable
-		}
-		if n, _ := fmt.Sscanf(line, "Weight=%f%n", &f, &r); n == 1 {
-			line = ImStrSkipBlank(line[r:])
-			column.WidthOrWeight = f
-			column.IsStretch = 1
-			settings.SaveFlags |= ImGuiTableFlags_Resizable
-		}
-		if n, _ := fmt.Sscanf(line, "Visible=%d%n", &n, &r); n == 1 {
-			line = ImStrSkipBlank(line[r:])
-			column.IsEnabled = (ImU8)(n)
-			settings.SaveFlags |= ImGuiTableFlags_Hideable
-		}
-		if n, _ := fmt.Sscanf(line, "Order=%d%n", &n, &r); n == 1 {
-			line = ImStrSkipBlank(line[r:])
-			column.DisplayOrder = (ImGuiTableColumnIdx)(n)
-			settings.SaveFlags |= ImGuiTableFlags_Reorderable
-		}
-
-		dir := ImGuiSortDirection_Ascending
-		if c == '^' {
-			dir = ImGuiSortDirection_Descending
-		}
-
-		if n, _ := fmt.Sscanf(line, "Sort=%d%c%n", &n, &c, &r); n == 2 {
-			// this value will never be used anymore
-			// line = ImStrSkipBlank(line[r:])
-			column.SortDirection = uint8(dir)
-			settings.SaveFlags |= ImGuiTableFlags_Sortable
+	var fields = strings.Fields(line)
+	var column_n int
+	if len(fields) < 2 || fields[0] != "Column" {
+		return
+	}
+	if n, _ := fmt.Sscanf(fields[1], "%d", &column_n); n != 1 || column_n < 0 || column_n >= int(settings.ColumnsCount) {
+		return
+	}
+	var column = &settings.Columns[column_n]
+	column.Index = (ImGuiTableColumnIdx)(column_n)
+	for _, field := range fields[2:] {
+		var key, value, _ = strings.Cut(field, "=")
+		var v int
+		switch key {
+		case "UserID":
+			var x ImGuiID
+			if n, _ := fmt.Sscanf(strings.TrimPrefix(value, "0x"), "%X", &x); n == 1 {
+				column.UserID = x
+			}
+		case "Width":
+			if n, _ := fmt.Sscanf(value, "%d", &v); n == 1 {
+				column.WidthOrWeight = (float)(v)
+				column.IsStretch = 0
+				settings.SaveFlags |= ImGuiTableFlags_Resizable
+			}
+		case "Weight":
+			if n, _ := fmt.Sscanf(value, "%f", &f); n == 1 {
+				column.WidthOrWeight = f
+				column.IsStretch = 1
+				settings.SaveFlags |= ImGuiTableFlags_Resizable
+			}
+		case "Visible":
+			if n, _ := fmt.Sscanf(value, "%d", &v); n == 1 {
+				column.IsEnabled = (ImU8)(v)
+				settings.SaveFlags |= ImGuiTableFlags_Hideable
+			}
+		case "Order":
+			if n, _ := fmt.Sscanf(value, "%d", &v); n == 1 {
+				column.DisplayOrder = (ImGuiTableColumnIdx)(v)
+				settings.SaveFlags |= ImGuiTableFlags_Reorderable
+			}
+		case "Sort":
+			var c rune
+			if n, _ := fmt.Sscanf(value, "%d%c", &v, &c); n == 2 {
+				column.SortOrder = (ImGuiTableColumnIdx)(v)
+				column.SortDirection = uint8(ImGuiSortDirection_Ascending)
+				if c == '^' {
+					column.SortDirection = uint8(ImGuiSortDirection_Descending)
+				}
+				settings.SaveFlags |= ImGuiTableFlags_Sortable
+			}
 		}
 	}
 }
@@ -2848,7 +2863,6 @@ func TableSettingsHandler_WriteAll(ctx *ImGuiContext, handler *ImGuiSettingsHand
 			continue
 		}
 
-		*buf = append(*buf, make([]byte, 0, 30+settings.ColumnsCount*50)...) // ballpark reserve
 		*buf = append(*buf, []byte(fmt.Sprintf("[%s][0x%08X,%d]\n", handler.TypeName, settings.ID, settings.ColumnsCount))...)
 
 		if settings.RefScale != 0.0 {
@@ -2863,7 +2877,7 @@ func TableSettingsHandler_WriteAll(ctx *ImGuiContext, handler *ImGuiSettingsHand
 			}
 			*buf = append(*buf, []byte(fmt.Sprintf("Column %-2d", column_n))...)
 			if column[0].UserID != 0 {
-				*buf = append(*buf, []byte(fmt.Sprintf(" UserID=%08X", column[0].UserID))...)
+				*buf = append(*buf, []byte(fmt.Sprintf(" UserID=0x%08X", column[0].UserID))...)
 			}
 			if save_size && column[0].IsStretch != 0 {
 				*buf = append(*buf, []byte(fmt.Sprintf(" Weight=%.4f", column[0].WidthOrWeight))...)
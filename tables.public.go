@@ -29,14 +29,13 @@ import "fmt"
 //
 // - 5. Call EndTable()
 // Read about "TABLE SIZING" at the top of this file.
-func BeginTable(str_id string, columns_count int, flags ImGuiTableFlags, outer_size ImVec2, inner_width float) bool {
-	var id = GetIDs(str_id)
-	return BeginTableEx(str_id, id, columns_count, flags, &outer_size, inner_width)
+func (g *ImGuiContext) BeginTable(str_id string, columns_count int, flags ImGuiTableFlags, outer_size ImVec2, inner_width float) bool {
+	var id = g.GetIDs(str_id)
+	return g.BeginTableEx(str_id, id, columns_count, flags, &outer_size, inner_width)
 }
 
 // EndTable only call EndTable() if BeginTable() returns true!
-func EndTable() {
-	var g = GImGui
+func (g *ImGuiContext) EndTable() {
 	var table = g.CurrentTable
 	IM_ASSERT_USER_ERROR(table != nil, "Only call EndTable() if BeginTable() returns true!")
 
@@ -47,7 +46,7 @@ func EndTable() {
 	// If the user never got to call TableNextRow() or TableNextColumn(), we call layout ourselves to ensure all our
 	// code paths are consistent (instead of just hoping that TableBegin/TableEnd will work), get borders drawn, etc.
 	if !table.IsLayoutLocked {
-		TableUpdateLayout(table)
+		g.TableUpdateLayout(table)
 	}
 
 	var flags = table.Flags
@@ -58,13 +57,16 @@ func EndTable() {
 	IM_ASSERT(outer_window == inner_window || outer_window == inner_window.ParentWindow)
 
 	if table.IsInsideRow {
-		TableEndRow(table)
+		g.TableEndRow(table)
+	}
+	if g.LogEnabled && g.LogType == ImGuiLogType_Capture {
+		g.LogCaptureEndTable(table)
 	}
 
 	// Context menu in columns body
 	if (flags & ImGuiTableFlags_ContextMenuInBody) != 0 {
-		if table.HoveredColumnBody != -1 && !IsAnyItemHovered() && IsMouseReleased(ImGuiMouseButton_Right) {
-			TableOpenContextMenu((int)(table.HoveredColumnBody))
+		if table.HoveredColumnBody != -1 && !g.IsAnyItemHovered() && g.IsMouseReleased(ImGuiMouseButton_Right) {
+			g.TableOpenContextMenu((int)(table.HoveredColumnBody))
 		}
 	}
 
@@ -109,7 +111,7 @@ func EndTable() {
 
 	// Draw borders
 	if (flags & ImGuiTableFlags_Borders) != 0 {
-		TableDrawBorders(table)
+		g.TableDrawBorders(table)
 	}
 
 	if false {
@@ -128,7 +130,7 @@ func EndTable() {
 	var splitter = table.DrawSplitter
 	splitter.SetCurrentChannel(inner_window.DrawList, 0)
 	if (table.Flags & ImGuiTableFlags_NoClip) == 0 {
-		TableMergeDrawChannels(table)
+		g.TableMergeDrawChannels(table)
 	}
 	splitter.Merge(inner_window.DrawList)
 
@@ -171,7 +173,7 @@ func EndTable() {
 	// Pop from id stack
 	IM_ASSERT_USER_ERROR(inner_window.IDStack[len(inner_window.IDStack)-1] == table.ID+uint(table.InstanceCurrent), "Mismatching PushID/PopID!")
 	IM_ASSERT_USER_ERROR(int(len(outer_window.DC.ItemWidthStack)) >= temp_data.HostBackupItemWidthStackSize, "Too many PopItemWidth!")
-	PopID()
+	g.PopID()
 
 	// Restore window data that we modified
 	var backup_outer_max_pos = outer_window.DC.CursorMaxPos
@@ -187,11 +189,11 @@ func EndTable() {
 	// (FIXME: To allow auto-fit and allow desirable effect of SameLine() we dissociate 'used' vs 'ideal' size by overriding
 	// CursorPosPrevLine and CursorMaxPos manually. That should be a more general layout feature, see same problem e.g. #3414)
 	if inner_window != outer_window {
-		EndChild()
+		g.EndChild()
 	} else {
 		size := table.OuterRect.GetSize()
-		ItemSizeVec(&size, 0)
-		ItemAdd(&table.OuterRect, 0, nil, 0)
+		g.ItemSizeVec(&size, 0)
+		g.ItemAdd(&table.OuterRect, 0, nil, 0)
 	}
 
 	// Override declared contents width/height to enable auto-resize while not needlessly adding a scrollbar
@@ -224,7 +226,7 @@ func EndTable() {
 
 	// Save settings
 	if table.IsSettingsDirty {
-		TableSaveSettings(table)
+		g.TableSaveSettings(table)
 	}
 	table.IsInitializing = false
 
@@ -258,21 +260,20 @@ func EndTable() {
 }
 
 // TableNextRow [Public] Starts into the first cell of a new row
-func TableNextRow(row_flags ImGuiTableRowFlags /*= 0*/, row_min_height float) {
-	var g = GImGui
+func (g *ImGuiContext) TableNextRow(row_flags ImGuiTableRowFlags /*= 0*/, row_min_height float) {
 	var table = g.CurrentTable
 
 	if !table.IsLayoutLocked {
-		TableUpdateLayout(table)
+		g.TableUpdateLayout(table)
 	}
 	if table.IsInsideRow {
-		TableEndRow(table)
+		g.TableEndRow(table)
 	}
 
 	table.LastRowFlags = table.RowFlags
 	table.RowFlags = row_flags
 	table.RowMinHeight = row_min_height
-	TableBeginRow(table)
+	g.TableBeginRow(table)
 
 	// We honor min_row_height requested by user, but cannot guarantee per-row maximum height,
 	// because that would essentially require a unique clipping rectangle per-cell.
@@ -286,8 +287,7 @@ func TableNextRow(row_flags ImGuiTableRowFlags /*= 0*/, row_min_height float) {
 // TableNextColumn [Public] Append into the next column, wrap and create a new row when already on last column
 // append into the first cell of a new row.
 // append into the next column (or first column of next row if currently in last column). Return true when column is visible.
-func TableNextColumn() bool {
-	var g = GImGui
+func (g *ImGuiContext) TableNextColumn() bool {
 	var table = g.CurrentTable
 	if table == nil {
 		return false
@@ -297,10 +297,10 @@ func TableNextColumn() bool {
 		if table.CurrentColumn != -1 {
 			TableEndCell(table)
 		}
-		TableBeginCell(table, table.CurrentColumn+1)
+		g.TableBeginCell(table, table.CurrentColumn+1)
 	} else {
-		TableNextRow(0, 0)
-		TableBeginCell(table, 0)
+		g.TableNextRow(0, 0)
+		g.TableBeginCell(table, 0)
 	}
 
 	// Return whether the column is visible. User may choose to skip submitting items based on this return value,
@@ -311,8 +311,7 @@ func TableNextColumn() bool {
 
 // TableSetColumnIndex [Public] Append into a specific column
 // append into the specified column. Return true when column is visible.
-func TableSetColumnIndex(column_n int) bool {
-	var g = GImGui
+func (g *ImGuiContext) TableSetColumnIndex(column_n int) bool {
 	var table = g.CurrentTable
 	if table == nil {
 		return false
@@ -323,7 +322,7 @@ func TableSetColumnIndex(column_n int) bool {
 			TableEndCell(table)
 		}
 		IM_ASSERT(column_n >= 0 && table.ColumnsCount != 0)
-		TableBeginCell(table, column_n)
+		g.TableBeginCell(table, column_n)
 	}
 
 	// Return whether the column is visible. User may choose to skip submitting items based on this return value,
@@ -342,8 +341,7 @@ func TableSetColumnIndex(column_n int) bool {
 //
 // See "COLUMN SIZING POLICIES" comments at the top of this file
 // If (init_width_or_weight <= 0.0f) it is ignored
-func TableSetupColumn(label string, flags ImGuiTableColumnFlags, init_width_or_weight float /*= 0*/, user_id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) TableSetupColumn(label string, flags ImGuiTableColumnFlags, init_width_or_weight float /*= 0*/, user_id ImGuiID) {
 	var table = g.CurrentTable
 	IM_ASSERT_USER_ERROR(table != nil, "Need to call TableSetupColumn() after BeginTable()!")
 	IM_ASSERT_USER_ERROR(!table.IsLayoutLocked, "Need to call call TableSetupColumn() before first row!")
@@ -353,6 +351,7 @@ func TableSetupColumn(label string, flags ImGuiTableColumnFlags, init_width_or_w
 		return
 	}
 
+	table.spanColumns(int(table.DeclColumnsCount))
 	var column = &table.Columns[table.DeclColumnsCount]
 	table.DeclColumnsCount++
 
@@ -421,8 +420,7 @@ func TableSetupColumn(label string, flags ImGuiTableColumnFlags, init_width_or_w
 
 // TableSetupScrollFreeze [Public]
 // lock columns/rows so they stay visible when scrolled.
-func TableSetupScrollFreeze(columns int, rows int) {
-	var g = GImGui
+func (g *ImGuiContext) TableSetupScrollFreeze(columns int, rows int) {
 	var table = g.CurrentTable
 	IM_ASSERT_USER_ERROR(table != nil, "Need to call TableSetupColumn() after BeginTable()!")
 	IM_ASSERT_USER_ERROR(!table.IsLayoutLocked, "Need to call TableSetupColumn() before first row!")
@@ -471,27 +469,26 @@ func TableSetupScrollFreeze(columns int, rows int) {
 // This code is constructed to not make much use of internal functions, as it is intended to be a template to copy.
 // FIXME-TABLE: TableOpenContextMenu() and TableGetHeaderRowHeight() are not public.
 // submit all headers cells based on data provided to TableSetupColumn() + submit context menu
-func TableHeadersRow() {
-	var g = GImGui
+func (g *ImGuiContext) TableHeadersRow() {
 	var table = g.CurrentTable
 	IM_ASSERT_USER_ERROR(table != nil, "Need to call TableHeadersRow() after BeginTable()!")
 
 	// Layout if not already done (this is automatically done by TableNextRow, we do it here solely to facilitate stepping in debugger as it is frequent to step in TableUpdateLayout)
 	if !table.IsLayoutLocked {
-		TableUpdateLayout(table)
+		g.TableUpdateLayout(table)
 	}
 
 	// Open row
-	var row_y1 = GetCursorScreenPos().y
-	var row_height = TableGetHeaderRowHeight()
-	TableNextRow(ImGuiTableRowFlags_Headers, row_height)
+	var row_y1 = g.GetCursorScreenPos().y
+	var row_height = g.TableGetHeaderRowHeight()
+	g.TableNextRow(ImGuiTableRowFlags_Headers, row_height)
 	if table.HostSkipItems { // Merely an optimization, you may skip in your own code.
 		return
 	}
 
-	var columns_count = TableGetColumnCount()
+	var columns_count = g.TableGetColumnCount()
 	for column_n := int(0); column_n < columns_count; column_n++ {
-		if !TableSetColumnIndex(column_n) {
+		if !g.TableSetColumnIndex(column_n) {
 			continue
 		}
 
@@ -499,19 +496,19 @@ func TableHeadersRow() {
 		// - in your own code you may omit the PushID/PopID all-together, provided you know they won't collide
 		// - table.InstanceCurrent is only >0 when we use multiple BeginTable/EndTable calls with same identifier.
 		var name string
-		if (TableGetColumnFlags(column_n) & ImGuiTableColumnFlags_NoHeaderLabel) != 0 {
-			name = TableGetColumnName(column_n)
+		if (g.TableGetColumnFlags(column_n) & ImGuiTableColumnFlags_NoHeaderLabel) != 0 {
+			name = g.TableGetColumnName(column_n)
 		}
-		PushID(int(table.InstanceCurrent)*table.ColumnsCount + column_n)
-		TableHeader(name)
-		PopID()
+		g.PushID(int(table.InstanceCurrent)*table.ColumnsCount + column_n)
+		g.TableHeader(name)
+		g.PopID()
 	}
 
 	// Allow opening popup from the right-most section after the last column.
-	var mouse_pos = GetMousePos()
-	if IsMouseReleased(1) && TableGetHoveredColumn() == columns_count {
+	var mouse_pos = g.GetMousePos()
+	if g.IsMouseReleased(1) && g.TableGetHoveredColumn() == columns_count {
 		if mouse_pos.y >= row_y1 && mouse_pos.y < row_y1+row_height {
-			TableOpenContextMenu(-1) // Will open a non-column-specific popup.
+			g.TableOpenContextMenu(-1) // Will open a non-column-specific popup.
 		}
 	}
 }
@@ -520,8 +517,7 @@ func TableHeadersRow() {
 // We cpu-clip text here so that all columns headers can be merged into a same draw call.
 // Note that because of how we cpu-clip and display sorting indicators, you _cannot_ use SameLine() after a TableHeader()
 // submit one header cell manually (rarely used)
-func TableHeader(label string) {
-	var g = GImGui
+func (g *ImGuiContext) TableHeader(label string) {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return
@@ -534,7 +530,7 @@ func TableHeader(label string) {
 	var column = &table.Columns[column_n]
 
 	// Label
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 	var label_pos = window.DC.CursorPos
 
 	// If we already got a row height, there's use that.
@@ -551,7 +547,7 @@ func TableHeader(label string) {
 		w_arrow = ImFloor(g.FontSize*ARROW_SCALE + g.Style.FramePadding.x)
 		if column.SortOrder > 0 {
 			sort_order_suf = fmt.Sprintf("%d", column.SortOrder+1)
-			w_sort_text = g.Style.ItemInnerSpacing.x + CalcTextSize(sort_order_suf, true, -1).x
+			w_sort_text = g.Style.ItemInnerSpacing.x + g.CalcTextSize(sort_order_suf, true, -1).x
 		}
 	}
 
@@ -564,8 +560,8 @@ func TableHeader(label string) {
 	var selected = (table.IsContextPopupOpen && int(table.ContextPopupColumn) == column_n && table.InstanceInteracted == table.InstanceCurrent)
 	var id = window.GetIDs(label)
 	var bb = ImRect{ImVec2{cell_r.Min.x, cell_r.Min.y}, ImVec2{cell_r.Max.x, ImMax(cell_r.Max.y, cell_r.Min.y+label_height+g.Style.CellPadding.y*2.0)}}
-	ItemSizeVec(&ImVec2{0.0, label_height}, 0) // Don't declare unclipped width, it'll be fed ContentMaxPosHeadersIdeal
-	if !ItemAdd(&bb, id, nil, 0) {
+	g.ItemSizeVec(&ImVec2{0.0, label_height}, 0) // Don't declare unclipped width, it'll be fed ContentMaxPosHeadersIdeal
+	if !g.ItemAdd(&bb, id, nil, 0) {
 		return
 	}
 
@@ -574,28 +570,28 @@ func TableHeader(label string) {
 
 	// Using AllowItemOverlap mode because we cover the whole cell, and we want user to be able to submit subsequent items.
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, ImGuiButtonFlags_AllowItemOverlap)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, ImGuiButtonFlags_AllowItemOverlap)
 	if g.ActiveId != id {
-		SetItemAllowOverlap()
+		g.SetItemAllowOverlap()
 	}
 	if held || hovered || selected {
 		var col ImU32
 		if held {
-			col = GetColorU32FromID(ImGuiCol_HeaderActive, 1)
+			col = g.GetColorU32FromID(ImGuiCol_HeaderActive, 1)
 		} else if hovered {
-			col = GetColorU32FromID(ImGuiCol_HeaderHovered, 1)
+			col = g.GetColorU32FromID(ImGuiCol_HeaderHovered, 1)
 		} else {
-			col = GetColorU32FromID(ImGuiCol_Header, 1)
+			col = g.GetColorU32FromID(ImGuiCol_Header, 1)
 		}
 		//RenderFrame(bb.Min, bb.Max, col, false, 0.0f);
-		TableSetBgColor(ImGuiTableBgTarget_CellBg, col, table.CurrentColumn)
+		g.TableSetBgColor(ImGuiTableBgTarget_CellBg, col, table.CurrentColumn)
 	} else {
 		// Submit single cell bg color in the case we didn't submit a full header row
 		if (table.RowFlags & ImGuiTableRowFlags_Headers) == 0 {
-			TableSetBgColor(ImGuiTableBgTarget_CellBg, GetColorU32FromID(ImGuiCol_TableHeaderBg, 1), table.CurrentColumn)
+			g.TableSetBgColor(ImGuiTableBgTarget_CellBg, g.GetColorU32FromID(ImGuiCol_TableHeaderBg, 1), table.CurrentColumn)
 		}
 	}
-	RenderNavHighlight(&bb, id, ImGuiNavHighlightFlags_TypeThin|ImGuiNavHighlightFlags_NoRounding)
+	g.RenderNavHighlight(&bb, id, ImGuiNavHighlightFlags_TypeThin|ImGuiNavHighlightFlags_NoRounding)
 	if held {
 		table.HeldHeaderColumn = (ImGuiTableColumnIdx)(column_n)
 	}
@@ -603,7 +599,7 @@ func TableHeader(label string) {
 
 	// Drag and drop to re-order columns.
 	// FIXME-TABLE: Scroll request while reordering a column and it lands out of the scrolling zone.
-	if held && (table.Flags&ImGuiTableFlags_Reorderable) != 0 && IsMouseDragging(0, -1) && !g.DragDropActive {
+	if held && (table.Flags&ImGuiTableFlags_Reorderable) != 0 && g.IsMouseDragging(0, -1) && !g.DragDropActive {
 		// While moving a column it will jump on the other side of the mouse, so we also test for MouseDelta.x
 		table.ReorderColumn = (ImGuiTableColumnIdx)(column_n)
 		table.InstanceInteracted = table.InstanceCurrent
@@ -648,9 +644,9 @@ func TableHeader(label string) {
 			var x = ImMax(cell_r.Min.x, cell_r.Max.x-w_arrow-w_sort_text)
 			var y = label_pos.y
 			if column.SortOrder > 0 {
-				PushStyleColorInt(ImGuiCol_Text, GetColorU32FromID(ImGuiCol_Text, 0.70))
-				RenderText(ImVec2{x + g.Style.ItemInnerSpacing.x, y}, sort_order_suf, true)
-				PopStyleColor(1)
+				g.PushStyleColorInt(ImGuiCol_Text, g.GetColorU32FromID(ImGuiCol_Text, 0.70))
+				g.RenderText(ImVec2{x + g.Style.ItemInnerSpacing.x, y}, sort_order_suf, true)
+				g.PopStyleColor(1)
 				x += w_sort_text
 			}
 
@@ -659,29 +655,29 @@ func TableHeader(label string) {
 				dir = ImGuiDir_Up
 			}
 
-			RenderArrow(window.DrawList, ImVec2{x, y}, GetColorU32FromID(ImGuiCol_Text, 1), dir, ARROW_SCALE)
+			RenderArrow(window.DrawList, ImVec2{x, y}, g.GetColorU32FromID(ImGuiCol_Text, 1), dir, ARROW_SCALE)
 		}
 
 		// Handle clicking on column header to adjust Sort Order
 		if pressed && int(table.ReorderColumn) != column_n {
 			var sort_direction = TableGetColumnNextSortDirection(column)
-			TableSetColumnSortDirection(column_n, sort_direction, g.IO.KeyShift)
+			g.TableSetColumnSortDirection(column_n, sort_direction, g.IO.KeyShift)
 		}
 	}
 
 	// Render clipped label. Clipping here ensure that in the majority of situations, all our header cells will
 	// be merged into a single draw call.
 	//window.DrawList.AddCircleFilled(ImVec2(ellipsis_max, label_pos.y), 40, IM_COL32_WHITE);
-	RenderTextEllipsis(window.DrawList, &label_pos, &ImVec2{ellipsis_max, label_pos.y + label_height + g.Style.FramePadding.y}, ellipsis_max, ellipsis_max, label, &label_size)
+	g.RenderTextEllipsis(window.DrawList, &label_pos, &ImVec2{ellipsis_max, label_pos.y + label_height + g.Style.FramePadding.y}, ellipsis_max, ellipsis_max, label, &label_size)
 
 	var text_clipped = label_size.x > (ellipsis_max - label_pos.x)
 	if text_clipped && hovered && g.HoveredIdNotActiveTimer > g.TooltipSlowDelay {
-		SetTooltip("%.*s", (int)(len(label)), label)
+		g.SetTooltip("%.*s", (int)(len(label)), label)
 	}
 
 	// We don't use BeginPopupContextItem() because we want the popup to stay up even after the column is hidden
-	if IsMouseReleased(1) && IsItemHovered(0) {
-		TableOpenContextMenu(column_n)
+	if g.IsMouseReleased(1) && g.IsItemHovered(0) {
+		g.TableOpenContextMenu(column_n)
 	}
 }
 
@@ -693,8 +689,7 @@ func TableHeader(label string) {
 //   - Lifetime: don't hold on this pointer over multiple frames or past any subsequent call to BeginTable().
 //
 // get latest sort specs for the table (NULL if not sorting).
-func TableGetSortSpecs() *ImGuiTableSortSpecs {
-	var g = GImGui
+func (g *ImGuiContext) TableGetSortSpecs() *ImGuiTableSortSpecs {
 	var table = g.CurrentTable
 	IM_ASSERT(table != nil)
 
@@ -704,7 +699,7 @@ func TableGetSortSpecs() *ImGuiTableSortSpecs {
 
 	// Require layout (in case TableHeadersRow() hasn't been called) as it may alter IsSortSpecsDirty in some paths.
 	if !table.IsLayoutLocked {
-		TableUpdateLayout(table)
+		g.TableUpdateLayout(table)
 	}
 
 	TableSortSpecsBuild(table)
@@ -720,8 +715,7 @@ func TableGetColumnAvailSortDirection(column *ImGuiTableColumn, n int) ImGuiSort
 // TableGetColumnCount Tables: Miscellaneous functions
 // - Functions args 'column_n int' treat the default value of -1 as the same as passing the current column index.
 // return number of columns (value passed to BeginTable)
-func TableGetColumnCount() int {
-	var g = GImGui
+func (g *ImGuiContext) TableGetColumnCount() int {
 	var table = g.CurrentTable
 	if table != nil {
 		return int(table.ColumnsCount)
@@ -730,8 +724,7 @@ func TableGetColumnCount() int {
 }
 
 // TableGetColumnIndex return current column index.
-func TableGetColumnIndex() int {
-	var g = GImGui
+func (g *ImGuiContext) TableGetColumnIndex() int {
 	var table = g.CurrentTable
 	if table == nil {
 		return 0
@@ -741,8 +734,7 @@ func TableGetColumnIndex() int {
 
 // TableGetRowIndex [Public] Note: for row coloring we use .RowBgColorCounter which is the same value without counting header rows
 // return current row index.
-func TableGetRowIndex() int {
-	var g = GImGui
+func (g *ImGuiContext) TableGetRowIndex() int {
 	var table = g.CurrentTable
 	if table == nil {
 		return 0
@@ -751,8 +743,7 @@ func TableGetRowIndex() int {
 }
 
 // TableGetColumnName return "" if column didn't have a name declared by TableSetupColumn(). Pass -1 to use current column.
-func TableGetColumnName(column_n int /*= -1*/) string {
-	var g = GImGui
+func (g *ImGuiContext) TableGetColumnName(column_n int /*= -1*/) string {
 	var table = g.CurrentTable
 	if table == nil {
 		return ""
@@ -765,8 +756,7 @@ func TableGetColumnName(column_n int /*= -1*/) string {
 
 // TableGetColumnFlags We allow querying for an extra column in order to poll the IsHovered state of the right-most section
 // return column flags so you can query their Enabled/Visible/Sorted/Hovered status flags. Pass -1 to use current column.
-func TableGetColumnFlags(column_n int /*= -1*/) ImGuiTableColumnFlags {
-	var g = GImGui
+func (g *ImGuiContext) TableGetColumnFlags(column_n int /*= -1*/) ImGuiTableColumnFlags {
 	var table = g.CurrentTable
 	if table == nil {
 		return ImGuiTableColumnFlags_None
@@ -790,8 +780,7 @@ func TableGetColumnFlags(column_n int /*= -1*/) ImGuiTableColumnFlags {
 // - For the getter you can test (TableGetColumnFlags() & ImGuiTableColumnFlags_IsEnabled) != 0.
 // - Alternative: the ImGuiTableColumnFlags_Disabled is an overriding/master disable flag which will also hide the column from context menu.
 // change user accessible enabled/disabled state of a column. Set to false to hide the column. User can use the context menu to change this themselves (right-click in headers, or right-click in columns body with ImGuiTableFlags_ContextMenuInBody)
-func TableSetColumnEnabled(column_n int, enabled bool) {
-	var g = GImGui
+func (g *ImGuiContext) TableSetColumnEnabled(column_n int, enabled bool) {
 	var table = g.CurrentTable
 	IM_ASSERT(table != nil)
 	if table == nil {
@@ -807,8 +796,7 @@ func TableSetColumnEnabled(column_n int, enabled bool) {
 }
 
 // TableSetBgColor change the color of a cell, row, or column. See ImGuiTableBgTarget_ flags for details.
-func TableSetBgColor(target ImGuiTableBgTarget, color ImU32, column_n int /*= -1*/) {
-	var g = GImGui
+func (g *ImGuiContext) TableSetBgColor(target ImGuiTableBgTarget, color ImU32, column_n int /*= -1*/) {
 	var table = g.CurrentTable
 	IM_ASSERT(target != ImGuiTableBgTarget_None)
 
@@ -0,0 +1,68 @@
+package imgui
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTableHeadersAndColumns(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+
+	const columns_count = 12
+	var names [columns_count]string
+	for frame := 0; frame < 3; frame++ {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.Begin("Window", nil, 0)
+			if ui.BeginTable("table", columns_count, ImGuiTableFlags_Borders|ImGuiTableFlags_Resizable|ImGuiTableFlags_Reorderable|ImGuiTableFlags_RowBg, ImVec2{}, 0) {
+				for column := 0; column < columns_count; column++ {
+					ui.TableSetupColumn(fmt.Sprintf("Column %d", column), 0, 0, 0)
+				}
+				ui.TableHeadersRow()
+				for row := 0; row < 5; row++ {
+					ui.TableNextRow(0, 0)
+					for column := 0; column < columns_count; column++ {
+						ui.TableNextColumn()
+						ui.Text("%d,%d", row, column)
+					}
+				}
+				if n := ui.TableGetColumnCount(); n != columns_count {
+					t.Errorf("table has %d columns, want %d", n, columns_count)
+				}
+				for column := range names {
+					names[column] = TableGetColumnName(int(column))
+				}
+				ui.EndTable()
+			}
+			ui.End()
+		})
+	}
+	for column, name := range names {
+		if want := fmt.Sprintf("Column %d", column); name != want {
+			t.Errorf("column %d is named %q, want %q", column, name, want)
+		}
+	}
+}
+
+func TestTableSingleSortSpecs(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+
+	for frame := 0; frame < 3; frame++ {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.Begin("Window", nil, 0)
+			if ui.BeginTable("table", 2, ImGuiTableFlags_Sortable, ImVec2{}, 0) {
+				ui.TableSetupColumn("Name", 0, 0, 0)
+				ui.TableSetupColumn("Size", ImGuiTableColumnFlags_DefaultSort, 0, 0)
+				ui.TableHeadersRow()
+				if specs := ui.TableGetSortSpecs(); specs == nil || len(specs.Specs) != 1 {
+					t.Errorf("frame %d: table has no single sort spec", frame)
+				} else if spec := specs.Specs[0]; spec.ColumnIndex != 1 || spec.SortDirection != ImGuiSortDirection_Ascending {
+					t.Errorf("frame %d: sorted by column %d in direction %d, want column 1 ascending", frame, spec.ColumnIndex, spec.SortDirection)
+				}
+				ui.EndTable()
+			}
+			ui.End()
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package testengine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Splizard/imgui"
+)
+
+func TestStyleEditor(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+	var io = imgui.GetIO()
+	io.IniFilename = ""
+	var clipboard string
+	io.SetClipboardTextFn = func(_ any, text string) { clipboard = text }
+
+	var showAbout = true
+	engine := New(ctx, func() {
+		imgui.SetNextWindowPos(imgui.NewImVec2(10, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+		imgui.SetNextWindowSize(imgui.NewImVec2(600, 700), imgui.ImGuiCond_Always)
+		imgui.Begin("Style", nil, 0)
+		imgui.ShowStyleEditor(nil)
+		imgui.End()
+		imgui.SetNextWindowPos(imgui.NewImVec2(620, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+		imgui.ShowAboutWindow(&showAbout)
+	})
+	defer engine.Dispose()
+	engine.YieldFrames(2)
+
+	if err := engine.ItemCheck("About Dear ImGui/Config\\/Build Information"); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.ItemClick("About Dear ImGui/Copy to clipboard"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(clipboard, "Dear ImGui "+imgui.IMGUI_VERSION) || !strings.Contains(clipboard, "style.FrameRounding: 0.00") {
+		t.Errorf("build information copied as:\n%s", clipboard)
+	}
+
+	imgui.GetStyle().Colors[imgui.ImGuiCol_Text] = *imgui.NewImVec4(1, 0.5, 0, 1)
+	if err := engine.TabClick("Style/##tabs/Colors"); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.ItemClick("Style/##tabs/Colors/Export"); err != nil {
+		t.Fatal(err)
+	}
+	var want = "var colors = &imgui.GetStyle().Colors\ncolors[imgui.ImGuiCol_Text]                   = *imgui.NewImVec4(1.00, 0.50, 0.00, 1.00)\n"
+	if !strings.HasPrefix(clipboard, want) || strings.Contains(clipboard, "ImGuiCol_WindowBg") {
+		t.Errorf("exported only the modified colors as:\n%s\nwant:\n%s", clipboard, want)
+	}
+
+	if err := engine.TabClick("Style/##tabs/Fonts"); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.TabClick("Style/##tabs/Rendering"); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.TabClick("Style/##tabs/Sizes"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDemoWindow(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+	imgui.GetIO().IniFilename = ""
+
+	var open = true
+	engine := New(ctx, func() {
+		imgui.SetNextWindowPos(imgui.NewImVec2(10, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+		imgui.SetNextWindowSize(imgui.NewImVec2(600, 700), imgui.ImGuiCond_Always)
+		imgui.ShowDemoWindow(&open)
+	})
+	defer engine.Dispose()
+	engine.YieldFrames(2)
+
+	for _, item := range []string{
+		"Main menu bar", "Console", "Log", "Simple layout", "Property editor",
+		"Long text display", "Auto-resizing window", "Constrained-resizing window",
+		"Simple overlay", "Manipulating window titles", "Custom rendering", "Documents",
+		"Fullscreen window", // Last, as it covers the demo window
+	} {
+		if err := engine.MenuClick("Dear ImGui Demo/Examples/" + item); err != nil {
+			t.Fatal(err)
+		}
+		// Let the new window appear and take focus before opening the menu again.
+		engine.YieldFrames(2)
+	}
+	for _, window := range []string{
+		"Example: Console", "Example: Log", "Example: Simple layout", "Example: Property editor",
+		"Example: Long text display", "Example: Auto-resizing window", "Example: Constrained Resize",
+		"Example: Simple overlay", "Example: Fullscreen window", "Example: Custom rendering",
+		"Example: Documents", "###AnimatedTitle",
+	} {
+		if imgui.FindWindowByName(window) == nil {
+			t.Errorf("window %q was not submitted", window)
+		}
+	}
+
+	if err := engine.ItemClick("Example: Fullscreen window/Close this window"); err != nil {
+		t.Fatal(err)
+	}
+	engine.Yield()
+
+	if err := engine.ItemOpen("Dear ImGui Demo/Tables & Columns"); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.ItemClick("Dear ImGui Demo/Tables/Open all"); err != nil {
+		t.Fatal(err)
+	}
+	engine.YieldFrames(3)
+}
+
+func TestDemoConsole(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+	imgui.GetIO().IniFilename = ""
+
+	var open = true
+	var console = imgui.NewExampleAppConsole()
+	engine := New(ctx, func() {
+		imgui.SetNextWindowPos(imgui.NewImVec2(10, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+		console.Draw("Example: Console", &open)
+	})
+	defer engine.Dispose()
+	engine.YieldFrames(2)
+
+	if err := engine.ItemInput("Example: Console/Input", "HISTORY"); err != nil {
+		t.Fatal(err)
+	}
+	engine.KeyChars("HE")
+	engine.KeyPress(imgui.ImGuiKey_Tab, imgui.ImGuiKeyModFlags_None)
+	engine.KeyPress(imgui.ImGuiKey_Enter, imgui.ImGuiKeyModFlags_None)
+	engine.KeyPress(imgui.ImGuiKey_UpArrow, imgui.ImGuiKeyModFlags_None)
+	engine.KeyPress(imgui.ImGuiKey_UpArrow, imgui.ImGuiKeyModFlags_None)
+	engine.KeyPress(imgui.ImGuiKey_DownArrow, imgui.ImGuiKeyModFlags_None)
+	engine.YieldFrames(2)
+
+	var want = []string{"# HISTORY", "# HELP"}
+	var got []string
+	for _, item := range console.Items {
+		if strings.HasPrefix(item, "# ") {
+			got = append(got, strings.TrimSpace(item))
+		}
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("executed commands %q, want %q", got, want)
+	}
+	if console.InputBuf != "HELP" {
+		t.Errorf("history navigation left input %q, want %q", console.InputBuf, "HELP")
+	}
+}
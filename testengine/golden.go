@@ -0,0 +1,175 @@
+package testengine
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Splizard/imgui/example/renderers/software"
+)
+
+// UpdateGoldenEnv names the environment variable which, when set to a non-empty value,
+// makes CompareGolden write the golden images instead of checking them.
+const UpdateGoldenEnv = "IMGUI_UPDATE_GOLDEN"
+
+// GoldenOptions configures the comparison of a frame against a golden image.
+type GoldenOptions struct {
+	Dir           string     // Directory of the golden images, "testdata" by default.
+	Tolerance     uint8      // Maximum difference allowed on each channel of a pixel.
+	MaxDiffPixels int        // Number of pixels allowed to exceed Tolerance.
+	ClearColor    [3]float32 // Background color of the rendering.
+	Update        bool       // Write the golden image instead of checking it, see also UpdateGoldenEnv.
+}
+
+// Snapshot runs a frame and rasterizes its draw data with the software renderer.
+// The returned image is reused by the next call.
+func (engine *Engine) Snapshot(clearColor [3]float32) (*image.RGBA, error) {
+	if engine.renderer == nil {
+		// The renderer sets the texture of the font atlas, which is picked up by the next frame.
+		renderer, err := software.NewSoftware(&engine.ctx.IO)
+		if err != nil {
+			return nil, err
+		}
+		engine.renderer = renderer
+	}
+	engine.Yield()
+
+	io := &engine.ctx.IO
+	displaySize := [2]float32{io.DisplaySize.X(), io.DisplaySize.Y()}
+	framebufferSize := [2]float32{
+		displaySize[0] * io.DisplayFramebufferScale.X(),
+		displaySize[1] * io.DisplayFramebufferScale.Y(),
+	}
+	drawData := engine.drawData
+	if drawData == nil {
+		return nil, fmt.Errorf("testengine: no frame has been rendered")
+	}
+	engine.renderer.PreRender(clearColor)
+	engine.renderer.Render(displaySize, framebufferSize, drawData)
+	return engine.renderer.Image(), nil
+}
+
+// CheckGolden runs a frame and compares it against the golden image name.png.
+// The test fails on a mismatch and a diff image, name.diff.png, is written next to the golden image.
+// Set opts.Update or the UpdateGoldenEnv environment variable to write the golden image instead.
+func (engine *Engine) CheckGolden(t testing.TB, name string, opts *GoldenOptions) {
+	t.Helper()
+	if opts == nil {
+		opts = &GoldenOptions{}
+	}
+	img, err := engine.Snapshot(opts.ClearColor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareGolden(t, name, img, opts)
+}
+
+// CompareGolden compares img against the golden image name.png, see CheckGolden.
+func CompareGolden(t testing.TB, name string, img image.Image, opts *GoldenOptions) {
+	t.Helper()
+	if opts == nil {
+		opts = &GoldenOptions{}
+	}
+	dir := opts.Dir
+	if dir == "" {
+		dir = "testdata"
+	}
+	goldenPath := filepath.Join(dir, name+".png")
+	diffPath := filepath.Join(dir, name+".diff.png")
+
+	if opts.Update || os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := writePNG(goldenPath, img); err != nil {
+			t.Fatal(err)
+		}
+		os.Remove(diffPath)
+		return
+	}
+
+	golden, err := readPNG(goldenPath)
+	if err != nil {
+		t.Fatalf("%v (set %s=1 to create it)", err, UpdateGoldenEnv)
+	}
+	diff, n := CompareImages(golden, img, opts.Tolerance)
+	if n <= opts.MaxDiffPixels {
+		os.Remove(diffPath)
+		return
+	}
+	if diff != nil {
+		if err := writePNG(diffPath, diff); err != nil {
+			t.Error(err)
+		}
+		t.Errorf("%s: %d pixels differ from the golden image, see %s", name, n, diffPath)
+	} else {
+		t.Errorf("%s: size %v differs from the golden image size %v", name, img.Bounds().Size(), golden.Bounds().Size())
+	}
+}
+
+// CompareImages counts the pixels of got which differ from want by more than tolerance on any channel.
+// The returned diff image shows these pixels in red over a faded copy of want.
+// When the images have different sizes diff is nil and every pixel is counted.
+func CompareImages(want, got image.Image, tolerance uint8) (diff *image.RGBA, n int) {
+	wb, gb := want.Bounds(), got.Bounds()
+	if wb.Size() != gb.Size() {
+		n = wb.Dx() * wb.Dy()
+		if gb.Dx()*gb.Dy() > n {
+			n = gb.Dx() * gb.Dy()
+		}
+		return nil, n
+	}
+
+	diff = image.NewRGBA(image.Rect(0, 0, wb.Dx(), wb.Dy()))
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			w := color.RGBAModel.Convert(want.At(wb.Min.X+x, wb.Min.Y+y)).(color.RGBA)
+			g := color.RGBAModel.Convert(got.At(gb.Min.X+x, gb.Min.Y+y)).(color.RGBA)
+			if channelDiff(w.R, g.R) > tolerance || channelDiff(w.G, g.G) > tolerance ||
+				channelDiff(w.B, g.B) > tolerance || channelDiff(w.A, g.A) > tolerance {
+				diff.SetRGBA(x, y, color.RGBA{255, 0, 0, 255})
+				n++
+				continue
+			}
+			gray := uint8((uint32(w.R) + uint32(w.G) + uint32(w.B)) / 3 / 4)
+			diff.SetRGBA(x, y, color.RGBA{gray, gray, gray, 255})
+		}
+	}
+	return diff, n
+}
+
+func channelDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return img, nil
+}
+
+func writePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,65 @@
+package testengine
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/Splizard/imgui"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "regenerate the golden images instead of checking them")
+
+func TestGoldenTabBar(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+	io := imgui.GetIO()
+	io.IniFilename = ""
+	io.DisplaySize = *imgui.NewImVec2(320, 200)
+
+	engine := New(ctx, func() {
+		imgui.SetNextWindowPos(imgui.NewImVec2(10, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+		imgui.SetNextWindowSize(imgui.NewImVec2(300, 180), imgui.ImGuiCond_Always)
+		imgui.Begin("Tabs", nil, 0)
+		if imgui.BeginTabBar("TabBar", 0) {
+			for _, label := range []string{"Avocado", "Broccoli", "Cucumber"} {
+				if imgui.BeginTabItem(label, nil, 0) {
+					imgui.Text("This is the " + label + " tab!")
+					imgui.EndTabItem()
+				}
+			}
+			imgui.EndTabBar()
+		}
+		imgui.End()
+	})
+	defer engine.Dispose()
+	engine.YieldFrames(2)
+
+	if err := engine.TabClick("Tabs/TabBar/Broccoli"); err != nil {
+		t.Fatal(err)
+	}
+	engine.MouseMoveToPos(*imgui.NewImVec2(-imgui.FLT_MAX, -imgui.FLT_MAX))
+	engine.CheckGolden(t, "tabbar", &GoldenOptions{Tolerance: 2, Update: *updateGolden})
+}
+
+func TestCompareImages(t *testing.T) {
+	want := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	got := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	got.SetRGBA(0, 0, color.RGBA{2, 0, 0, 0})
+	got.SetRGBA(3, 3, color.RGBA{0, 0, 200, 255})
+
+	if _, n := CompareImages(want, got, 2); n != 1 {
+		t.Errorf("%d pixels differ with tolerance 2, want 1", n)
+	}
+	diff, n := CompareImages(want, got, 0)
+	if n != 2 {
+		t.Errorf("%d pixels differ with tolerance 0, want 2", n)
+	}
+	if diff.RGBAAt(3, 3) != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("diff pixel = %v, want red", diff.RGBAAt(3, 3))
+	}
+	if _, n := CompareImages(want, image.NewRGBA(image.Rect(0, 0, 2, 2)), 255); n != 16 {
+		t.Errorf("%d pixels differ for mismatched sizes, want 16", n)
+	}
+}
@@ -0,0 +1,511 @@
+// Package testengine drives an imgui context from Go code, in the spirit of the Dear ImGui Test Engine.
+//
+// Items are located by path, "Window/Child/##label", hashed the same way the ID stack does it,
+// and are interacted with through a simulated mouse and keyboard. The engine records every item
+// submitted during a frame (rect, label, status flags) through the context hooks of the imgui package,
+// so that tests can assert on the state of the widgets.
+//
+//	engine := testengine.New(ctx, gui)
+//	defer engine.Dispose()
+//	if err := engine.ItemClick("Window/Button"); err != nil {
+//		t.Fatal(err)
+//	}
+package testengine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/Splizard/imgui"
+	"github.com/Splizard/imgui/example/renderers/software"
+)
+
+const (
+	defaultDeltaTime = 1.0 / 60.0
+
+	// Number of frames to wait for an item to appear before giving up.
+	itemWaitFrames = 4
+
+	mouseButtonCount = 5
+)
+
+// Item describes an item submitted during the last frame.
+type Item struct {
+	ID          imgui.ImGuiID
+	Window      *imgui.ImGuiWindow
+	Rect        imgui.ImRect
+	Label       string
+	StatusFlags imgui.ImGuiItemStatusFlags
+	Frame       int32 // Frame count at the time the item was submitted.
+
+	clipRect imgui.ImRect // Clipping rectangle of the window at the time the item was submitted.
+}
+
+// IsOpened returns true for an openable item (tree node, menu, combo) in its opened state.
+func (item *Item) IsOpened() bool {
+	return item.StatusFlags&imgui.ImGuiItemStatusFlags_Opened != 0
+}
+
+// IsChecked returns true for a checkable item (checkbox, menu item) in its checked state.
+func (item *Item) IsChecked() bool {
+	return item.StatusFlags&imgui.ImGuiItemStatusFlags_Checked != 0
+}
+
+// IsEdited returns true when the value of the item was modified during the frame.
+func (item *Item) IsEdited() bool {
+	return item.StatusFlags&imgui.ImGuiItemStatusFlags_Edited != 0
+}
+
+// Engine simulates the inputs of an imgui context and records the items it submits.
+// An Engine drives the frames itself: every action calls Yield one or more times.
+type Engine struct {
+	ctx       *imgui.ImGuiContext
+	gui       func()
+	deltaTime float32
+	hooks     []imgui.ImGuiID
+
+	items     map[imgui.ImGuiID]*Item // Items submitted during the last completed frame.
+	itemsNext map[imgui.ImGuiID]*Item // Items being submitted during the current frame.
+
+	mousePos  imgui.ImVec2
+	mouseDown [mouseButtonCount]bool
+	chars     []rune
+
+	drawData *imgui.ImDrawData  // Draw data of the last frame.
+	renderer *software.Software // Created on the first Snapshot.
+}
+
+// New attaches an engine to the given context. gui is called between imgui.NewFrame()
+// and imgui.Render() on every frame driven by the engine.
+//
+// The font atlas is built if needed and the display size defaults to 1280x720.
+func New(ctx *imgui.ImGuiContext, gui func()) *Engine {
+	engine := &Engine{
+		ctx:       ctx,
+		gui:       gui,
+		deltaTime: defaultDeltaTime,
+		items:     make(map[imgui.ImGuiID]*Item),
+		itemsNext: make(map[imgui.ImGuiID]*Item),
+		mousePos:  *imgui.NewImVec2(-imgui.FLT_MAX, -imgui.FLT_MAX),
+	}
+
+	io := &ctx.IO
+	if !io.Fonts.IsBuilt() {
+		io.Fonts.Build()
+	}
+	if io.DisplaySize.X() <= 0 || io.DisplaySize.Y() <= 0 {
+		io.DisplaySize = *imgui.NewImVec2(1280, 720)
+	}
+
+	engine.hooks = append(engine.hooks,
+		imgui.AddContextHook(ctx, &imgui.ImGuiContextHook{
+			Type:     imgui.ImGuiContextHookType_NewFramePre,
+			Callback: func(*imgui.ImGuiContext, *imgui.ImGuiContextHook) { engine.newFramePre() },
+		}),
+		imgui.AddContextHook(ctx, &imgui.ImGuiContextHook{
+			Type:     imgui.ImGuiContextHookType_EndFramePost,
+			Callback: func(*imgui.ImGuiContext, *imgui.ImGuiContextHook) { engine.endFramePost() },
+		}),
+	)
+	ctx.TestEngine = (*hooks)(engine)
+	ctx.TestEngineHookItems = true
+	return engine
+}
+
+// Dispose detaches the engine from its context.
+func (engine *Engine) Dispose() {
+	for _, hook := range engine.hooks {
+		imgui.RemoveContextHook(engine.ctx, hook)
+	}
+	engine.hooks = nil
+	if engine.renderer != nil {
+		engine.renderer.Dispose()
+		engine.renderer = nil
+	}
+	if engine.ctx.TestEngine == imgui.ImGuiTestEngineHooks((*hooks)(engine)) {
+		engine.ctx.TestEngine = nil
+		engine.ctx.TestEngineHookItems = false
+	}
+}
+
+// SetDeltaTime sets the fixed timestep between two frames, in seconds.
+func (engine *Engine) SetDeltaTime(seconds float32) {
+	engine.deltaTime = seconds
+}
+
+// Context returns the context driven by the engine.
+func (engine *Engine) Context() *imgui.ImGuiContext {
+	return engine.ctx
+}
+
+// Yield runs a single frame.
+func (engine *Engine) Yield() {
+	engine.ctx.IO.DeltaTime = engine.deltaTime
+	engine.drawData = engine.ctx.Frame(func(*imgui.ImGuiUI) {
+		if engine.gui != nil {
+			engine.gui()
+		}
+	})
+}
+
+// YieldFrames runs n frames.
+func (engine *Engine) YieldFrames(n int) {
+	for i := 0; i < n; i++ {
+		engine.Yield()
+	}
+}
+
+// hooks receives the item notifications of the context, see imgui.ImGuiTestEngineHooks.
+type hooks Engine
+
+// ItemAdd implements imgui.ImGuiTestEngineHooks.
+func (engine *hooks) ItemAdd(ctx *imgui.ImGuiContext, bb *imgui.ImRect, id imgui.ImGuiID) {
+	item, ok := engine.itemsNext[id]
+	if !ok {
+		item = &Item{ID: id}
+		engine.itemsNext[id] = item
+	}
+	item.Window = ctx.CurrentWindow
+	item.Rect = *bb
+	item.clipRect = ctx.CurrentWindow.ClipRect
+	item.Frame = ctx.FrameCount
+}
+
+// ItemInfo implements imgui.ImGuiTestEngineHooks.
+func (engine *hooks) ItemInfo(ctx *imgui.ImGuiContext, id imgui.ImGuiID, label string, flags imgui.ImGuiItemStatusFlags) {
+	item, ok := engine.itemsNext[id]
+	if !ok {
+		return
+	}
+	item.Label = label
+	item.StatusFlags = flags
+	if ctx.LastItemData.ID == id {
+		item.StatusFlags |= ctx.LastItemData.StatusFlags
+	}
+}
+
+func (engine *Engine) newFramePre() {
+	io := &engine.ctx.IO
+	io.MousePos = engine.mousePos
+	io.MouseDown = engine.mouseDown
+	for _, c := range engine.chars {
+		io.AddInputCharacter(c)
+	}
+	engine.chars = engine.chars[:0]
+}
+
+func (engine *Engine) endFramePost() {
+	engine.items, engine.itemsNext = engine.itemsNext, engine.items
+	for id := range engine.itemsNext {
+		delete(engine.itemsNext, id)
+	}
+}
+
+// GetID returns the ID of the item designated by path.
+//
+// The first element of the path is the name of a window, each following element is hashed
+// with the ID of the previous one, as imgui.GetIDWithSeed does. When an element names a child
+// window of the current window, the hashing continues from the ID of the child window.
+// Use "\/" for a slash which is part of a label and "$$123" for an integer pushed with imgui.PushID.
+func (engine *Engine) GetID(path string) imgui.ImGuiID {
+	id, _ := engine.resolve(path)
+	return id
+}
+
+// resolve returns the ID of the item designated by path and the window it is expected in, if known.
+func (engine *Engine) resolve(path string) (imgui.ImGuiID, *imgui.ImGuiWindow) {
+	ui := engine.ctx.Lock()
+	defer ui.Unlock()
+
+	var (
+		id     imgui.ImGuiID
+		window *imgui.ImGuiWindow
+	)
+	for i, element := range splitPath(path) {
+		if i == 0 {
+			id = imgui.ImHashStr(element, 0, 0)
+			window = imgui.FindWindowByName(element)
+			continue
+		}
+		id = hashPathElement(element, id)
+		if window != nil {
+			if child := imgui.FindWindowByName(fmt.Sprintf("%s/%s_%08X", window.Name, element, id)); child != nil {
+				window = child
+				id = child.ID
+			}
+		}
+	}
+	return id, window
+}
+
+func hashPathElement(element string, seed imgui.ImGuiID) imgui.ImGuiID {
+	if strings.HasPrefix(element, "$$") {
+		if n, err := strconv.ParseInt(element[2:], 10, 32); err == nil {
+			var v = int32(n)
+			return imgui.ImHashData(unsafe.Pointer(&v), unsafe.Sizeof(v), seed)
+		}
+	}
+	return imgui.GetIDWithSeed(element, seed)
+}
+
+// splitPath splits a path on slashes, "\/" being an escaped slash.
+func splitPath(path string) []string {
+	var (
+		elements []string
+		element  strings.Builder
+	)
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path) && path[i+1] == '/':
+			element.WriteByte('/')
+			i++
+		case path[i] == '/':
+			elements = append(elements, element.String())
+			element.Reset()
+		default:
+			element.WriteByte(path[i])
+		}
+	}
+	return append(elements, element.String())
+}
+
+// ItemExists returns true when the item designated by path was submitted during the last frame.
+func (engine *Engine) ItemExists(path string) bool {
+	_, ok := engine.items[engine.GetID(path)]
+	return ok
+}
+
+// ItemInfo returns the item designated by path, waiting a few frames for it to appear.
+func (engine *Engine) ItemInfo(path string) (*Item, error) {
+	id := engine.GetID(path)
+	for i := 0; ; i++ {
+		if item, ok := engine.items[id]; ok {
+			return item, nil
+		}
+		if i == itemWaitFrames {
+			return nil, fmt.Errorf("testengine: item %q (0x%08X) not found", path, id)
+		}
+		engine.Yield()
+	}
+}
+
+// MouseMoveToPos moves the mouse to the given position, in screen coordinates.
+func (engine *Engine) MouseMoveToPos(pos imgui.ImVec2) {
+	engine.mousePos = pos
+	engine.Yield()
+}
+
+// MouseMove moves the mouse over the item designated by path, scrolling its window if the item is clipped.
+func (engine *Engine) MouseMove(path string) error {
+	item, err := engine.ItemInfo(path)
+	if err != nil {
+		return err
+	}
+
+	visible := item.Rect
+	visible.ClipWithFull(item.clipRect)
+	if visible.GetWidth() <= 0 || visible.GetHeight() <= 0 {
+		ui := engine.ctx.Lock()
+		imgui.ScrollToBringRectIntoView(item.Window, &item.Rect)
+		ui.Unlock()
+		engine.YieldFrames(2)
+		if item, err = engine.ItemInfo(path); err != nil {
+			return err
+		}
+		visible = item.Rect
+		visible.ClipWithFull(item.clipRect)
+		if visible.GetWidth() <= 0 || visible.GetHeight() <= 0 {
+			return fmt.Errorf("testengine: item %q is not visible", path)
+		}
+	}
+	engine.MouseMoveToPos(visible.GetCenter())
+
+	if engine.ctx.HoveredIdPreviousFrame != item.ID && engine.ctx.HoveredId != item.ID {
+		return fmt.Errorf("testengine: item %q is not hoverable", path)
+	}
+	return nil
+}
+
+// MouseDown presses a mouse button.
+func (engine *Engine) MouseDown(button imgui.ImGuiMouseButton) {
+	engine.mouseDown[button] = true
+	engine.Yield()
+}
+
+// MouseUp releases a mouse button.
+func (engine *Engine) MouseUp(button imgui.ImGuiMouseButton) {
+	engine.mouseDown[button] = false
+	engine.Yield()
+}
+
+// MouseClick presses and releases a mouse button at the current mouse position.
+func (engine *Engine) MouseClick(button imgui.ImGuiMouseButton) {
+	engine.MouseDown(button)
+	engine.MouseUp(button)
+}
+
+// MouseDoubleClick clicks twice within the double-click time.
+func (engine *Engine) MouseDoubleClick(button imgui.ImGuiMouseButton) {
+	engine.MouseClick(button)
+	engine.MouseClick(button)
+}
+
+// ItemClick moves the mouse over the item designated by path and clicks the left button.
+func (engine *Engine) ItemClick(path string) error {
+	if err := engine.MouseMove(path); err != nil {
+		return err
+	}
+	engine.MouseClick(imgui.ImGuiMouseButton_Left)
+	return nil
+}
+
+// ItemDoubleClick moves the mouse over the item designated by path and double-clicks the left button.
+func (engine *Engine) ItemDoubleClick(path string) error {
+	if err := engine.MouseMove(path); err != nil {
+		return err
+	}
+	engine.MouseDoubleClick(imgui.ImGuiMouseButton_Left)
+	return nil
+}
+
+// ItemOpen opens a tree node, menu or combo designated by path, if it is not already opened.
+func (engine *Engine) ItemOpen(path string) error {
+	return engine.itemToggle(path, imgui.ImGuiItemStatusFlags_Openable, imgui.ImGuiItemStatusFlags_Opened, true)
+}
+
+// ItemClose closes a tree node, menu or combo designated by path, if it is not already closed.
+func (engine *Engine) ItemClose(path string) error {
+	return engine.itemToggle(path, imgui.ImGuiItemStatusFlags_Openable, imgui.ImGuiItemStatusFlags_Opened, false)
+}
+
+// ItemCheck checks a checkbox or menu item designated by path, if it is not already checked.
+func (engine *Engine) ItemCheck(path string) error {
+	return engine.itemToggle(path, imgui.ImGuiItemStatusFlags_Checkable, imgui.ImGuiItemStatusFlags_Checked, true)
+}
+
+// ItemUncheck unchecks a checkbox or menu item designated by path, if it is not already unchecked.
+func (engine *Engine) ItemUncheck(path string) error {
+	return engine.itemToggle(path, imgui.ImGuiItemStatusFlags_Checkable, imgui.ImGuiItemStatusFlags_Checked, false)
+}
+
+func (engine *Engine) itemToggle(path string, able, state imgui.ImGuiItemStatusFlags, want bool) error {
+	item, err := engine.ItemInfo(path)
+	if err != nil {
+		return err
+	}
+	if item.StatusFlags&able == 0 {
+		return fmt.Errorf("testengine: item %q cannot be toggled", path)
+	}
+	if (item.StatusFlags&state != 0) == want {
+		return nil
+	}
+	if err := engine.ItemClick(path); err != nil {
+		return err
+	}
+	if item, err = engine.ItemInfo(path); err != nil {
+		return err
+	}
+	if (item.StatusFlags&state != 0) != want {
+		return fmt.Errorf("testengine: item %q did not change state", path)
+	}
+	return nil
+}
+
+// TabClick selects the tab item designated by path, "Window/TabBar/Tab".
+func (engine *Engine) TabClick(path string) error {
+	if err := engine.ItemClick(path); err != nil {
+		return err
+	}
+	// The selection of a tab is applied on the next call to BeginTabBar().
+	engine.Yield()
+	return nil
+}
+
+// ItemInput activates the text input designated by path, replaces its content with text and validates with Enter.
+func (engine *Engine) ItemInput(path string, text string) error {
+	if err := engine.ItemClick(path); err != nil {
+		return err
+	}
+	if engine.ctx.ActiveId != engine.GetID(path) {
+		return fmt.Errorf("testengine: item %q is not an active text input", path)
+	}
+	engine.KeyPress(imgui.ImGuiKey_A, imgui.ImGuiKeyModFlags_Ctrl)
+	engine.KeyChars(text)
+	engine.KeyPress(imgui.ImGuiKey_Enter, imgui.ImGuiKeyModFlags_None)
+	return nil
+}
+
+// KeyDown presses a key with the given modifiers.
+func (engine *Engine) KeyDown(key imgui.ImGuiKey, mods imgui.ImGuiKeyModFlags) {
+	engine.ctx.IO.AddKeyModsEvent(mods)
+	engine.ctx.IO.AddKeyEvent(key, true)
+	engine.Yield()
+}
+
+// KeyUp releases a key and all modifiers.
+func (engine *Engine) KeyUp(key imgui.ImGuiKey) {
+	engine.ctx.IO.AddKeyEvent(key, false)
+	engine.ctx.IO.AddKeyModsEvent(imgui.ImGuiKeyModFlags_None)
+	engine.Yield()
+}
+
+// KeyPress presses and releases a key with the given modifiers.
+func (engine *Engine) KeyPress(key imgui.ImGuiKey, mods imgui.ImGuiKeyModFlags) {
+	engine.KeyDown(key, mods)
+	engine.KeyUp(key)
+}
+
+// KeyChars types text into the active item.
+func (engine *Engine) KeyChars(text string) {
+	engine.chars = append(engine.chars, []rune(text)...)
+	engine.Yield()
+}
+
+// MenuClick clicks a menu item designated by path, "Window/Menu/Sub-menu/Item",
+// opening the menus of the menu bar of the window along the way.
+// Use "##MainMenuBar" as the window to reach the main menu bar.
+func (engine *Engine) MenuClick(path string) error {
+	elements := splitPath(path)
+	if len(elements) < 3 {
+		return fmt.Errorf("testengine: menu path %q is too short", path)
+	}
+
+	ref := escapePath(elements[0]) + "/##menubar"
+	for i, element := range elements[1:] {
+		ref += "/" + escapePath(element)
+		item, err := engine.ItemInfo(ref)
+		if err != nil {
+			return err
+		}
+		if i == len(elements)-2 || !item.IsOpened() {
+			if err := engine.ItemClick(ref); err != nil {
+				return err
+			}
+		}
+		if i == len(elements)-2 {
+			break
+		}
+		engine.Yield()
+
+		popup := engine.topPopupWindow()
+		if popup == nil {
+			return fmt.Errorf("testengine: menu %q did not open", ref)
+		}
+		ref = escapePath(popup.Name)
+	}
+	return nil
+}
+
+func (engine *Engine) topPopupWindow() *imgui.ImGuiWindow {
+	stack := engine.ctx.OpenPopupStack
+	if len(stack) == 0 {
+		return nil
+	}
+	return stack[len(stack)-1].Window
+}
+
+func escapePath(element string) string {
+	return strings.ReplaceAll(element, "/", `\/`)
+}
@@ -0,0 +1,141 @@
+package testengine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Splizard/imgui"
+)
+
+func TestEngine(t *testing.T) {
+	ctx := imgui.CreateContext(nil)
+	defer imgui.DestroyContext(ctx)
+	imgui.GetIO().IniFilename = ""
+
+	var (
+		clicks, childClicks int
+		saved               bool
+		checked             bool
+		text                = make([]byte, 32)
+		tab                 string
+		treeOpened          bool
+	)
+	engine := New(ctx, func() {
+		imgui.SetNextWindowPos(imgui.NewImVec2(10, 10), imgui.ImGuiCond_Always, imgui.ImVec2{})
+		imgui.SetNextWindowSize(imgui.NewImVec2(400, 400), imgui.ImGuiCond_Always)
+		imgui.Begin("Window", nil, imgui.ImGuiWindowFlags_MenuBar)
+		if imgui.BeginMenuBar() {
+			if imgui.BeginMenu("File", true) {
+				if imgui.MenuItem("Save", "", nil, true) {
+					saved = true
+				}
+				imgui.EndMenu()
+			}
+			imgui.EndMenuBar()
+		}
+		if imgui.Button("Button") {
+			clicks++
+		}
+		imgui.Checkbox("Check", &checked)
+		imgui.InputText("Name", &text, 0, nil, nil)
+		if imgui.BeginTabBar("Tabs", 0) {
+			for _, label := range []string{"One", "Two"} {
+				if imgui.BeginTabItem(label, nil, 0) {
+					tab = label
+					imgui.EndTabItem()
+				}
+			}
+			imgui.EndTabBar()
+		}
+		treeOpened = imgui.TreeNode("Tree")
+		if treeOpened {
+			imgui.TreePop()
+		}
+		imgui.BeginChild("Child", imgui.ImVec2{}, true, 0)
+		if imgui.Button("Inner") {
+			childClicks++
+		}
+		for i := 0; i < 50; i++ {
+			imgui.Text("Filler")
+		}
+		if imgui.Button("Far") {
+			childClicks++
+		}
+		imgui.EndChild()
+		imgui.End()
+	})
+	defer engine.Dispose()
+	engine.YieldFrames(2)
+
+	if err := engine.ItemClick("Window/Button"); err != nil {
+		t.Fatal(err)
+	}
+	if clicks != 1 {
+		t.Errorf("button clicked %d times, want 1", clicks)
+	}
+
+	if err := engine.ItemCheck("Window/Check"); err != nil {
+		t.Fatal(err)
+	}
+	if !checked {
+		t.Error("checkbox not checked")
+	}
+	if item, _ := engine.ItemInfo("Window/Check"); !item.IsChecked() || item.Label != "Check" {
+		t.Errorf("checkbox status: label %q, checked %v", item.Label, item.IsChecked())
+	}
+
+	if err := engine.ItemInput("Window/Name", "hello"); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimRight(string(text), "\x00"); got != "hello" {
+		t.Errorf("input text = %q, want %q", got, "hello")
+	}
+
+	if err := engine.TabClick("Window/Tabs/Two"); err != nil {
+		t.Fatal(err)
+	}
+	if tab != "Two" {
+		t.Errorf("selected tab = %q, want %q", tab, "Two")
+	}
+
+	if err := engine.ItemOpen("Window/Tree"); err != nil {
+		t.Fatal(err)
+	}
+	if !treeOpened {
+		t.Error("tree node not opened")
+	}
+
+	if err := engine.ItemClick("Window/Child/Inner"); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.ItemClick("Window/Child/Far"); err != nil {
+		t.Fatal(err)
+	}
+	if childClicks != 2 {
+		t.Errorf("child buttons clicked %d times, want 2", childClicks)
+	}
+
+	if err := engine.MenuClick("Window/File/Save"); err != nil {
+		t.Fatal(err)
+	}
+	if !saved {
+		t.Error("menu item not clicked")
+	}
+
+	if engine.ItemExists("Window/Missing") {
+		t.Error("unexpected item")
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	got := splitPath(`Window/a\/b/##c`)
+	want := []string{"Window", "a/b", "##c"}
+	if len(got) != len(want) {
+		t.Fatalf("splitPath = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("splitPath = %q, want %q", got, want)
+		}
+	}
+}
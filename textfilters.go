@@ -25,10 +25,15 @@ func NewImGuiTextFilter(default_filter string) ImGuiTextFilter {
 }
 
 func (this *ImGuiTextFilter) Draw(label string /*= "Filter (inc,-exc)"*/, width float) bool {
+	return this.DrawContext(GImGui, label, width)
+}
+
+// DrawContext is Draw() for the context g.
+func (this *ImGuiTextFilter) DrawContext(g *ImGuiContext, label string /*= "Filter (inc,-exc)"*/, width float) bool {
 	if width != 0.0 {
-		SetNextItemWidth(width)
+		g.SetNextItemWidth(width)
 	}
-	var value_changed = InputText(label, &this.InputBuf, 0, nil, nil)
+	var value_changed = g.InputText(label, &this.InputBuf, 0, nil, nil)
 	if value_changed {
 		this.Build()
 	}
@@ -22,7 +22,9 @@ type ImGuiCol int              // -> enum ImGuiCol_             // Enum: A color
 type ImGuiCond int             // -> enum ImGuiCond_            // Enum: A condition for many Set*() functions
 type ImGuiDataType int         // -> enum ImGuiDataType_        // Enum: A primary data type
 type ImGuiDir int              // -> enum ImGuiDir_             // Enum: A cardinal direction
+type ImGuiDropPosition int     // -> enum ImGuiDropPosition_    // Enum: Where a payload is dropped relative to a target item
 type ImGuiKey int              // -> enum ImGuiKey_             // Enum: A key identifier (ImGui-side enum)
+type ImGuiKeyChord = ImGuiKey  // -> ImGuiKey | ImGuiMod_XXX    // Enum: A key identifier optionally combined with modifiers (e.g. ImGuiMod_Ctrl | ImGuiKey_S)
 type ImGuiNavInput int         // -> enum ImGuiNavInput_        // Enum: An input identifier for navigation
 type ImGuiMouseButton int      // -> enum ImGuiMouseButton_     // Enum: A mouse button identifier (0=left, 1=right, 2=middle)
 type ImGuiMouseCursor int      // -> enum ImGuiMouseCursor_     // Enum: A mouse cursor identifier
@@ -37,11 +39,14 @@ type ImGuiButtonFlags int      // -> enum ImGuiButtonFlags_     // Flags: for In
 type ImGuiColorEditFlags int   // -> enum ImGuiColorEditFlags_  // Flags: for ColorEdit4(), ColorPicker4() etc.
 type ImGuiConfigFlags int      // -> enum ImGuiConfigFlags_     // Flags: for io.ConfigFlags
 type ImGuiComboFlags int       // -> enum ImGuiComboFlags_      // Flags: for BeginCombo()
+type ImGuiDockNodeFlags int    // -> enum ImGuiDockNodeFlags_   // Flags: for DockSpace()
 type ImGuiDragDropFlags int    // -> enum ImGuiDragDropFlags_   // Flags: for BeginDragDropSource(), AcceptDragDropPayload()
 type ImGuiFocusedFlags int     // -> enum ImGuiFocusedFlags_    // Flags: for IsWindowFocused()
 type ImGuiHoveredFlags int     // -> enum ImGuiHoveredFlags_    // Flags: for IsItemHovered(), IsWindowHovered() etc.
+type ImGuiInputFlags int       // -> enum ImGuiInputFlags_      // Flags: for Shortcut(), SetShortcutRouting()
 type ImGuiInputTextFlags int   // -> enum ImGuiInputTextFlags_  // Flags: for InputText(), InputTextMultiline()
 type ImGuiKeyModFlags int      // -> enum ImGuiKeyModFlags_     // Flags: for io.KeyMods (Ctrl/Shift/Alt/Super)
+type ImGuiMultiSelectFlags int // -> enum ImGuiMultiSelectFlags_ // Flags: for BeginMultiSelect()
 type ImGuiPopupFlags int       // -> enum ImGuiPopupFlags_      // Flags: for OpenPopup*(), BeginPopupContext*(), IsPopupOpen()
 type ImGuiSelectableFlags int  // -> enum ImGuiSelectableFlags_ // Flags: for Selectable()
 type ImGuiSliderFlags int      // -> enum ImGuiSliderFlags_     // Flags: for DragFloat(), DragInt(), SliderFloat(), SliderInt() etc.
@@ -69,6 +74,18 @@ type ImU32 = uint   // 32-bit uinteger (often used to store packed colors)
 type ImS64 = int64  // 64-bit signed integer (pre and post C++11 with Visual Studio)
 type ImU64 = uint64 // 64-bit uinteger (pre and post C++11 with Visual Studio)
 
+// ImGuiSelectionUserData Value identifying an item of a multi-selection, set with SetNextItemSelectionUserData().
+// Most applications use the index of the item, which ImGuiSelectionBasicStorage and ImGuiListClipper work with.
+type ImGuiSelectionUserData = ImS64
+
+// ImGuiSelectionRequestType Enum: The type of a selection request, see ImGuiSelectionRequestType_
+type ImGuiSelectionRequestType int
+
+// ImGuiScalar The types edited by the generic scalar widgets DragT(), SliderT() and InputT(), one per ImGuiDataType.
+type ImGuiScalar interface {
+	~int8 | ~uint8 | ~int16 | ~uint16 | ~int32 | ~uint32 | ~int64 | ~uint64 | ~float32 | ~float64
+}
+
 // ImWchar16 Character types
 // (we generally use UTF-8 encoded string in the API. This is storage specifically for a decoded character used for keyboard input and display)
 type ImWchar16 = uint16 // A single decoded U16 character/code point. We encode them as multi bytes UTF-8 when used in strings.
@@ -79,6 +96,15 @@ type ImWchar = rune     // ImWchar [configurable type: override in imconfig.h wi
 type ImGuiInputTextCallback func(data *ImGuiInputTextCallbackData) int // Callback function for ImGui::InputText()
 type ImGuiSizeCallback func(data *ImGuiSizeCallbackData)               // Callback function for ImGui::SetNextWindowSizeConstraints()
 
+// [Internal] Storage used by IsKeyDown(), IsKeyPressed() etc functions.
+// If prior to 1.87 you used io.KeysDownDuration[] (which was marked as internal), you should use GetKeyData(key).DownDuration and not io.KeysData[key].DownDuration.
+type ImGuiKeyData struct {
+	Down             bool  // True for if key is down
+	DownDuration     float // Duration the key has been down (<0.0f: not pressed, 0.0f: just pressed, >0.0f: time held)
+	DownDurationPrev float // Last frame duration the key has been down
+	AnalogValue      float // 0.0f..1.0f for gamepad values
+}
+
 //-----------------------------------------------------------------------------
 // [SECTION] ImGuiIO
 //-----------------------------------------------------------------------------
@@ -98,10 +124,13 @@ type ImGuiIO struct {
 	IniSavingRate           float               // = 5.0f           // Minimum time between saving positions/sizes to .ini file, in seconds.
 	IniFilename             string              // = "imgui.ini"    // Path to .ini file (important: default "imgui.ini" is relative to current working dir!). Set NULL to disable automatic .ini loading/saving or if you want to manually call LoadIniSettingsXXX() / SaveIniSettingsXXX() functions.
 	LogFilename             string              // = "imgui_log.txt"// Path to .log file (default parameter to ImGui::LogToFile when no file is specified).
+	FileSystem              ImGuiFileSystem     // = nil            // File system of the .ini and .log files (and fonts loaded from files). nil uses the OS file system, see ImGuiMemoryFileSystem and NewImGuiFileSystemFS().
+	SettingsCodec           ImGuiSettingsCodec  // = nil            // Format of the settings file and of LoadSettings()/SaveSettings(). nil uses the .ini format, see ImGuiSettingsCodecJSON.
+	SettingsVersion         int                 // = 0              // Version of the settings written with them. Bump it when renaming windows or changing table columns, entries saved with an older version go through the MigrateFn of their handler.
 	MouseDoubleClickTime    float               // = 0.30f          // Time for a double-click, in seconds.
 	MouseDoubleClickMaxDist float               // = 6.0f           // Distance threshold to stay in to validate a double-click, in pixels.
 	MouseDragThreshold      float               // = 6.0f           // Distance threshold before considering we are dragging.
-	KeyMap                  [ImGuiKey_COUNT]int // <unset>          // Map of indices into the KeysDown[512] entries array which represent your "native" keyboard state.
+	KeyMap                  [ImGuiKey_COUNT]int // <unset>          // [LEGACY] Map of indices into the KeysDown[512] entries array which represent your "native" keyboard state. The first 512 are now unused and should be kept -1. Prefer io.AddKeyEvent().
 	KeyRepeatDelay          float               // = 0.250f         // When holding a key/button, time before it starts repeating, in seconds (for buttons in Repeat mode, etc.).
 	KeyRepeatRate           float               // = 0.050f         // When holding a key/button, rate at which it repeats, in seconds.
 	UserData                any                 // = NULL           // Store your own data for retrieval by callbacks.
@@ -112,6 +141,15 @@ type ImGuiIO struct {
 	FontDefault             *ImFont      // = NULL           // Font to use on NewFrame(). Use NULL to uses Fonts->Fonts[0].
 	DisplayFramebufferScale ImVec2       // = (1, 1)         // For retina display or other situations where window coordinates are different from framebuffer coordinates. This generally ends up in ImDrawData::FramebufferScale.
 
+	// Docking options (when ImGuiConfigFlags_DockingEnable is set)
+	ConfigDockingNoSplit   bool // = false          // Simplified docking mode: disable window splitting, so docking is limited to merging multiple windows together into tab-bars.
+	ConfigDockingWithShift bool // = false          // Enable docking with holding Shift key (reduce visual noise, allows dropping in wider space)
+
+	// Viewport options (when ImGuiConfigFlags_ViewportsEnable is set)
+	ConfigViewportsNoAutoMerge   bool // = false          // Set to make all floating imgui windows always create their own viewport. Otherwise, they are merged into the main host viewports when overlapping it. May also set ImGuiViewportFlags_NoAutoMerge on individual viewport.
+	ConfigViewportsNoTaskBarIcon bool // = false          // Disable default OS task bar icon flag for secondary viewports. When a viewport doesn't want a task bar icon, ImGuiViewportFlags_NoTaskBarIcon will be set on it.
+	ConfigViewportsNoDecoration  bool // = true           // Disable default OS window decoration flag for secondary viewports. When a viewport doesn't want window decorations, ImGuiViewportFlags_NoDecoration will be set on it. Enabling decoration can create subsequent issues at OS levels (e.g. minimum window size).
+
 	// Miscellaneous options
 	MouseDrawCursor                   bool  // = false          // Request ImGui to draw a mouse cursor for you (if you are on a platform without a mouse cursor). Cannot be easily renamed to 'io.ConfigXXX' because this is frequently used by backend implementations.
 	ConfigMacOSXBehaviors             bool  // = defined(__APPLE__) // OS X style: Text editing cursor movement using Alt instead of Ctrl, Shortcuts using Cmd/Super instead of Ctrl, Line/Text Start and End using Cmd+Arrows instead of Home/End, Double click selects by word instead of selecting whole text, Multi-selection in lists uses Cmd/Super instead of Ctrl.
@@ -120,6 +158,7 @@ type ImGuiIO struct {
 	ConfigWindowsResizeFromEdges      bool  // = true           // Enable resizing of windows from their edges and from the lower-left corner. This requires (io.BackendFlags & ImGuiBackendFlags_HasMouseCursors) because it needs mouse cursor feedback. (This used to be a per-window ImGuiWindowFlags_ResizeFromAnySide flag)
 	ConfigWindowsMoveFromTitleBarOnly bool  // = false       // Enable allowing to move windows only when clicking on their title bar. Does not apply to windows without a title bar.
 	ConfigMemoryCompactTimer          float // = 60.0f          // Timer (in seconds) to free transient windows/tables memory buffers when unused. Set to -1.0f to disable.
+	ConfigInputTrickleEventQueue      bool  // = true           // Enable input queue trickling: some types of events submitted during the same frame (e.g. button down + up) will be spread over multiple frames, improving interactions with low framerates.
 
 	//------------------------------------------------------------------
 	// Platform Functions
@@ -156,7 +195,7 @@ type ImGuiIO struct {
 	KeyShift    bool                       // Keyboard modifier pressed: Shift
 	KeyAlt      bool                       // Keyboard modifier pressed: Alt
 	KeySuper    bool                       // Keyboard modifier pressed: Cmd/Super/Windows
-	KeysDown    [512]bool                  // Keyboard keys that are pressed (ideally left in the "native" order your engine has access to keyboard keys, so you can use your own defines/enums for keys).
+	KeysDown    [ImGuiKey_COUNT]bool       // [LEGACY] Keyboard keys that are pressed (ideally left in the "native" order your engine has access to keyboard keys, so you can use your own defines/enums for keys). This used to be [512] sized, it is now ImGuiKey_COUNT to allow legacy io.KeysDown[GetKeyIndex(...)] to work. Prefer io.AddKeyEvent().
 	NavInputs   [ImGuiNavInput_COUNT]float // Gamepad inputs. Cleared back to zero by EndFrame(). Keyboard keys will be auto-mapped and be written here by NewFrame().
 	// Notifies Dear ImGui when hosting platform windows lose or gain input focus
 
@@ -201,13 +240,17 @@ type ImGuiIO struct {
 	MouseDownDurationPrev            [5]float         // Previous time the mouse button has been down
 	MouseDragMaxDistanceAbs          [5]ImVec2        // Maximum distance, absolute, on each axis, of how much mouse has traveled from the clicking point
 	MouseDragMaxDistanceSqr          [5]float         // Squared maximum distance of how much mouse has traveled from the clicking point
-	KeysDownDuration                 [512]float       // Duration the keyboard key has been down (0.0f == just pressed)
-	KeysDownDurationPrev             [512]float       // Previous duration the key has been down
 	NavInputsDownDuration            [ImGuiNavInput_COUNT]float
 	NavInputsDownDurationPrev        [ImGuiNavInput_COUNT]float
-	PenPressure                      float     // Touch/Pen pressure (0.0f to 1.0f, should be >0.0f only when MouseDown[0] == true). Helper storage currently unused by Dear ImGui.
-	InputQueueSurrogate              ImWchar16 // For AddInputCharacterUTF16
-	InputQueueCharacters             []ImWchar // Queue of _characters_ input (obtained by platform backend). Fill using AddInputCharacter() helper.
+	PenPressure                      float             // Touch/Pen pressure (0.0f to 1.0f, should be >0.0f only when MouseDown[0] == true). Helper storage currently unused by Dear ImGui.
+	InputQueueSurrogate              ImWchar16         // For AddInputCharacterUTF16
+	InputQueueCharacters             []ImWchar         // Queue of _characters_ input (obtained by platform backend). Fill using AddInputCharacter() helper.
+	InputEventsQueue                 []ImGuiInputEvent // Queue of input events, filled using the AddXXXEvent() helpers and processed by NewFrame().
+	AppFocusLost                     bool              // Set by a focus lost event, keys are cleared by NewFrame().
+
+	// Keyboard state, see IsKeyDown(), IsKeyPressed()...
+	KeysData                    [ImGuiKey_KeysData_SIZE]ImGuiKeyData // Key state for all known keys. Use IsKeyXXX() functions to access this.
+	BackendUsingLegacyKeyArrays ImS8                                 // -1: unknown, 0: using AddKeyEvent(), 1: using legacy io.KeysDown[]
 }
 
 func NewImGuiIO() ImGuiIO {
@@ -234,6 +277,15 @@ func NewImGuiIO() ImGuiIO {
 	io.FontAllowUserScaling = false
 	io.DisplayFramebufferScale = ImVec2{1.0, 1.0}
 
+	// Docking options
+	io.ConfigDockingNoSplit = false
+	io.ConfigDockingWithShift = false
+
+	// Viewport options
+	io.ConfigViewportsNoAutoMerge = false
+	io.ConfigViewportsNoTaskBarIcon = false
+	io.ConfigViewportsNoDecoration = true
+
 	// Miscellaneous options
 	io.MouseDrawCursor = false
 	/*#ifdef __APPLE__
@@ -245,6 +297,7 @@ func NewImGuiIO() ImGuiIO {
 	io.ConfigWindowsResizeFromEdges = true
 	io.ConfigWindowsMoveFromTitleBarOnly = false
 	io.ConfigMemoryCompactTimer = 60.0
+	io.ConfigInputTrickleEventQueue = true
 
 	// Platform Functions
 	io.GetClipboardTextFn = GetClipboardTextFn_DefaultImpl // Platform dependent default implementations
@@ -259,10 +312,11 @@ func NewImGuiIO() ImGuiIO {
 		io.MouseDownDuration[i] = -1.0
 		io.MouseDownDurationPrev[i] = -1.0
 	}
-	for i := 0; i < len(io.KeysDownDuration); i++ {
-		io.KeysDownDuration[i] = -1
-		io.KeysDownDurationPrev[i] = -1.0
+	for i := range io.KeysData {
+		io.KeysData[i].DownDuration = -1.0
+		io.KeysData[i].DownDurationPrev = -1.0
 	}
+	io.BackendUsingLegacyKeyArrays = -1
 	for i := 0; i < len(io.NavInputsDownDuration); i++ {
 		io.NavInputsDownDuration[i] = -1.0
 	}
@@ -279,6 +333,7 @@ func NewImGuiIO() ImGuiIO {
 // - ImGuiInputTextFlags_CallbackCharFilter:  Callback on character inputs to replace or discard them. Modify 'EventChar' to replace or discard, or return 1 in callback to discard.
 // - ImGuiInputTextFlags_CallbackResize:      Callback on buffer capacity changes request (beyond 'buf_size' parameter value), allowing the string to grow.
 type ImGuiInputTextCallbackData struct {
+	Ctx       *ImGuiContext       // Parent UI context
 	EventFlag ImGuiInputTextFlags // One ImGuiInputTextFlags_Callback*    // Read-only
 	Flags     ImGuiInputTextFlags // What user passed to InputText()      // Read-only
 	UserData  any                 // What user passed to InputText()      // Read-only
@@ -309,7 +364,8 @@ func (this *ImGuiInputTextCallbackData) DeleteChars(pos, bytes_count int) {
 	var dst = this.Buf[pos:]
 	var src = this.Buf[pos+bytes_count:]
 
-	copy(dst, src)
+	copy(dst, src[:this.BufTextLen-pos-bytes_count])
+	this.Buf[this.BufTextLen-bytes_count] = 0
 
 	if this.CursorPos >= pos+bytes_count {
 		this.CursorPos -= bytes_count
@@ -331,12 +387,14 @@ func (this *ImGuiInputTextCallbackData) InsertChars(pos int, new_text string) {
 		}
 
 		// Contrary to STB_TEXTEDIT_INSERTCHARS() this is working in the UTF8 buffer, hence the mildly similar code (until we remove the U16 buffer altogether!)
-		var g = GImGui
+		var g = this.Ctx
 		var edit_state = &g.InputTextState
 		IM_ASSERT(edit_state.ID != 0 && g.ActiveId == edit_state.ID)
 		//IM_ASSERT(this.Buf == edit_state.TextA)
 		var new_buf_size = this.BufTextLen + ImClampInt(new_text_len*4, 32, ImMaxInt(256, new_text_len)) + 1
-		edit_state.TextA = append(edit_state.TextA, make([]byte, new_buf_size-int(len(edit_state.TextA)))...)
+		if int(len(edit_state.TextA)) < new_buf_size {
+			edit_state.TextA = append(edit_state.TextA, make([]byte, new_buf_size-int(len(edit_state.TextA)))...)
+		}
 
 		this.Buf = edit_state.TextA
 		this.BufSize = new_buf_size
@@ -344,9 +402,10 @@ func (this *ImGuiInputTextCallbackData) InsertChars(pos int, new_text string) {
 	}
 
 	if this.BufTextLen != pos {
-		copy(this.Buf[pos+new_text_len:], this.Buf[pos:])
+		copy(this.Buf[pos+new_text_len:], this.Buf[pos:this.BufTextLen])
 	}
 	copy(this.Buf[pos:], new_text)
+	this.Buf[this.BufTextLen+new_text_len] = 0
 
 	if this.CursorPos >= pos {
 		this.CursorPos += new_text_len
@@ -378,6 +437,26 @@ type ImGuiSizeCallbackData struct {
 	DesiredSize ImVec2 // Read-write.  Desired size, based on user's mouse position. Write to this field to restrain resizing.
 }
 
+// ImGuiMultiSelectIO Selection requests and state returned by BeginMultiSelect() and EndMultiSelect().
+// Don't keep the pointer over multiple frames or past a following call to BeginMultiSelect() or EndMultiSelect().
+type ImGuiMultiSelectIO struct {
+	Requests      []ImGuiSelectionRequest // Requests to apply to your selection data, in order.
+	RangeSrcItem  ImGuiSelectionUserData  // Begin: source item of Shift ranges (often the last clicked item). When using a clipper, make sure it is submitted with clipper.IncludeItemByIndex().
+	NavIdItem     ImGuiSelectionUserData  // Begin: SetNextItemSelectionUserData() value of the item having the navigation focus, if known.
+	NavIdSelected bool                    // Begin: selection state of the item having the navigation focus, if known.
+	RangeSrcReset bool                    // End: set before EndMultiSelect() to reset RangeSrcItem, e.g. after deleting the selected items.
+	ItemsCount    int                     // Copy of the items_count parameter of BeginMultiSelect(), for convenience. Not used internally.
+}
+
+// ImGuiSelectionRequest A request to apply to the selection of the application
+type ImGuiSelectionRequest struct {
+	Type           ImGuiSelectionRequestType
+	Selected       bool                   // Value to set for SetAll/SetRange requests (true = select, false = unselect)
+	RangeDirection ImS8                   // SetRange: +1 when RangeFirstItem was submitted before RangeLastItem, -1 otherwise. Useful to preserve the selection order on a backward Shift+Click.
+	RangeFirstItem ImGuiSelectionUserData // SetRange: first item of the range, in submission order
+	RangeLastItem  ImGuiSelectionUserData // SetRange: last item of the range, in submission order (inclusive)
+}
+
 // ImGuiPayload Data payload for Drag and Drop operations: AcceptDragDropPayload(), GetDragDropPayload()
 type ImGuiPayload struct {
 	// Members
@@ -385,12 +464,15 @@ type ImGuiPayload struct {
 	DataSize int // Data size
 
 	// [Internal]
-	SourceId       ImGuiID      // Source item id
-	SourceParentId ImGuiID      // Source parent id (if available)
-	DataFrameCount int          // Data timestamp
-	DataType       [32 + 1]byte // Data type tag (short user-supplied string, 32 characters max)
-	Preview        bool         // Set when AcceptDragDropPayload() was called and mouse has been hovering the target item (nb: handle overlapping drag targets)
-	Delivery       bool         // Set when AcceptDragDropPayload() was called and mouse button is released over the target item.
+	SourceId       ImGuiID // Source item id
+	SourceParentId ImGuiID // Source parent id (if available)
+	DataFrameCount int     // Data timestamp
+	DataType       string  // Data type tag (short user-supplied string, 32 characters max)
+	Preview        bool    // Set when AcceptDragDropPayload() was called and mouse has been hovering the target item (nb: handle overlapping drag targets)
+	Delivery       bool    // Set when AcceptDragDropPayload() was called and mouse button is released over the target item.
+
+	ItemsCount     int               // Number of items carried: the length of the slice given to SetDragDropPayloadItemsT(), else 1
+	InsertPosition ImGuiDropPosition // Set when AcceptDragDropPayload() was called with ImGuiDragDropFlags_AcceptInsertXXX flags
 }
 
 func NewImGuiPayload() ImGuiPayload {
@@ -400,11 +482,20 @@ func NewImGuiPayload() ImGuiPayload {
 }
 
 func (this ImGuiPayload) IsDataType(dtype string) bool {
-	return this.DataFrameCount != -1 && dtype == string(this.DataType[:])
+	return this.DataFrameCount != -1 && dtype == this.DataType
 }
 func (this ImGuiPayload) IsPreview() bool  { return this.Preview }
 func (this ImGuiPayload) IsDelivery() bool { return this.Delivery }
 
+// InsertIndex returns where the payload goes in the list holding the target item at index item_index:
+// item_index when dropped before or inside it, item_index+1 when dropped after it.
+func (this ImGuiPayload) InsertIndex(item_index int) int {
+	if this.InsertPosition == ImGuiDropPosition_After {
+		return item_index + 1
+	}
+	return item_index
+}
+
 // ImGuiTableColumnSortSpecs Sorting specification for one column of a table (sizeof == 12 bytes)
 type ImGuiTableColumnSortSpecs struct {
 	ColumnUserID  ImGuiID            // User id of the column (if specified by a TableSetupColumn() call)
@@ -463,8 +554,8 @@ func IM_COL32(R, G, B, A byte) ImU32 {
 	return ((ImU32)(A) << IM_COL32_A_SHIFT) | ((ImU32)(B) << IM_COL32_B_SHIFT) | ((ImU32)(G) << IM_COL32_G_SHIFT) | ((ImU32)(R) << IM_COL32_R_SHIFT)
 }
 
-const IM_COL32_WHITE = 0xFFFFFFF
-const IM_COL32_BLACK = 0xFF00000
+const IM_COL32_WHITE = 0xFFFFFFFF
+const IM_COL32_BLACK = 0xFF000000
 const IM_COL32_BLACK_TRANS = 0x0000000
 
 // IM_DRAWLIST_TEX_LINES_WIDTH_MAX The maximum line width to bake anti-aliased textures for. Build atlas with ImFontAtlasFlags_NoBakedLines to disable baking.
@@ -697,11 +788,40 @@ func (f *ImFont) IsGlyphRangeUnused(c_begin, c_last uint) bool {
 //   - Work Area = entire viewport minus sections used by main menu bars (for platform windows), or by task bar (for platform monitor).
 //   - Windows are generally trying to stay within the Work Area of their host viewport.
 type ImGuiViewport struct {
-	Flags    ImGuiViewportFlags // See ImGuiViewportFlags_
-	Pos      ImVec2             // Main Area: Position of the viewport (Dear ImGui coordinates are the same as OS desktop/native coordinates)
-	Size     ImVec2             // Main Area: Size of the viewport.
-	WorkPos  ImVec2             // Work Area: Position of the viewport minus task bars, menus bars, status bars (>= Pos)
-	WorkSize ImVec2             // Work Area: Size of the viewport minus task bars, menu bars, status bars (<= Size)
+	ID               ImGuiID            // Unique identifier for the viewport
+	Flags            ImGuiViewportFlags // See ImGuiViewportFlags_
+	Pos              ImVec2             // Main Area: Position of the viewport (Dear ImGui coordinates are the same as OS desktop/native coordinates)
+	Size             ImVec2             // Main Area: Size of the viewport.
+	WorkPos          ImVec2             // Work Area: Position of the viewport minus task bars, menus bars, status bars (>= Pos)
+	WorkSize         ImVec2             // Work Area: Size of the viewport minus task bars, menu bars, status bars (<= Size)
+	DpiScale         float              // 1.0f = 96 DPI = No extra scale.
+	ParentViewportId ImGuiID            // (Advanced) 0: no parent. Instruct the platform backend to setup a parent/child relationship between platform windows.
+	DrawData         *ImDrawData        // The ImDrawData corresponding to this viewport. Valid after Render() and until the next call to NewFrame().
+
+	// Platform/Backend Dependent Data
+	// Our design separate the Renderer and Platform backends to facilitate combining default backends with each others.
+	// When our create your own backend for a custom engine, it is possible that both Renderer and Platform will be handled
+	// by the same system and you may not need to use all the UserData/Handle fields.
+	// The library never uses those fields, they are merely storage to facilitate backend implementation.
+	RendererUserData      any  // void* to hold custom data structure for the renderer (e.g. swap chain, framebuffers etc.). generally set by your Renderer_CreateWindow function.
+	PlatformUserData      any  // void* to hold custom data structure for the OS / platform (e.g. windowing info, render context). generally set by your Platform_CreateWindow function.
+	PlatformHandle        any  // void* for FindViewportByPlatformHandle(). (e.g. suggested to use natural platform handle such as HWND, GLFWWindow*, SDL_Window*)
+	PlatformRequestMove   bool // Platform window requested move (e.g. window was moved by the OS / host window manager, authoritative position will be OS window position)
+	PlatformRequestResize bool // Platform window requested resize (e.g. window was resized by the OS / host window manager, authoritative size will be OS window size)
+	PlatformRequestClose  bool // Platform window requested closure (e.g. window was moved by the OS / host window manager, e.g. pressing ALT-F4)
+
+	// Internal
+	Idx                     int
+	LastFrameActive         int          // Last frame number this viewport was activated by a window
+	LastFrontMostStampCount int          // Last stamp number from when a window hosted by this viewport was made front-most (by comparing this value between two viewport we have an implicit viewport z-order
+	LastNameHash            ImGuiID      // Hash of the title last sent to the platform window
+	Alpha                   float        // Window opacity (when dragging dockable windows/viewports we make them transparent)
+	LastAlpha               float        // Window opacity last sent to the platform window
+	PlatformWindowCreated   bool         // Set once Platform_CreateWindow() was called for this viewport
+	Window                  *ImGuiWindow // Set when the viewport is owned by a window (and ImGuiViewportFlags_CanHostOtherWindows is NOT set)
+	LastPlatformPos         ImVec2       // Position last sent to (or read from) the platform window
+	LastPlatformSize        ImVec2       // Size last sent to (or read from) the platform window
+	LastRendererSize        ImVec2       // Size last sent to the renderer
 
 	DrawListsLastFrame [2]int         // Last frame number the background (0) and foreground (1) draw lists were used
 	DrawLists          [2]*ImDrawList // Convenience background (0) and foreground (1) draw lists. We use them to draw software mouser cursor when io.MouseDrawCursor is set and to draw most debug overlays.
@@ -714,6 +834,47 @@ type ImGuiViewport struct {
 	BuildWorkOffsetMax ImVec2 // Work Area: Offset being built during current frame. Generally <= 0.0f.
 }
 
+// ImGuiPlatformIO is the interface between the library and the platform/renderer backends when multi-viewports are enabled.
+// Access via GetPlatformIO(). The backends fill the Platform_XXX and Renderer_XXX functions, the library calls them from
+// UpdatePlatformWindows() and RenderPlatformWindowsDefault() to create, update and render one platform window per secondary viewport.
+//
+//	Platform_CreateWindow  ── create a platform window for a viewport (hidden until Platform_ShowWindow)
+//	Platform_SetWindowPos  ── the viewport moved (Dear ImGui coordinates are the same as OS desktop/native coordinates)
+//	Platform_SetWindowSize ── the viewport was resized
+//	Platform_RenderWindow  ── (optional) setup the platform window for rendering, e.g. make its GL context current
+//	Renderer_RenderWindow  ── render viewport.DrawData
+//	Platform_SwapBuffers   ── (optional) present the platform window
+//	Platform_DestroyWindow ── the viewport is gone
+type ImGuiPlatformIO struct {
+	// Platform functions (e.g. Win32, GLFW, SDL2)
+	Platform_CreateWindow       func(vp *ImGuiViewport)                 // . . U . .  // Create a new platform window for the given viewport
+	Platform_DestroyWindow      func(vp *ImGuiViewport)                 // N . U . D  //
+	Platform_ShowWindow         func(vp *ImGuiViewport)                 // . . U . .  // Newly created windows are initially hidden so SetWindowPos/Size/Title can be called on them before showing the window
+	Platform_SetWindowPos       func(vp *ImGuiViewport, pos ImVec2)     // . . U . .  // Set platform window position (given the upper-left corner of client area)
+	Platform_GetWindowPos       func(vp *ImGuiViewport) ImVec2          // N . . . .  //
+	Platform_SetWindowSize      func(vp *ImGuiViewport, size ImVec2)    // . . U . .  // Set platform window client area size (ignoring OS decorations such as OS title bar etc.)
+	Platform_GetWindowSize      func(vp *ImGuiViewport) ImVec2          // N . . . .  // Get platform window client area size
+	Platform_SetWindowFocus     func(vp *ImGuiViewport)                 // N . . . .  // Move window to front and set input focus
+	Platform_GetWindowFocus     func(vp *ImGuiViewport) bool            // . . U . .  //
+	Platform_GetWindowMinimized func(vp *ImGuiViewport) bool            // N . . . .  // Get platform window minimized state. When minimized, we generally won't attempt to get/set size and contents will be culled more easily
+	Platform_SetWindowTitle     func(vp *ImGuiViewport, title string)   // . . U . .  // Set platform window title (given an UTF-8 string)
+	Platform_SetWindowAlpha     func(vp *ImGuiViewport, alpha float)    // . . U . .  // (Optional) Setup global transparency (not per-pixel transparency)
+	Platform_UpdateWindow       func(vp *ImGuiViewport)                 // . . U . .  // (Optional) Called by UpdatePlatformWindows(). Optional hook to allow the platform backend from doing general book-keeping every frame.
+	Platform_RenderWindow       func(vp *ImGuiViewport, render_arg any) // . . . R .  // (Optional) Main rendering (platform side! This is often unused, or just setting a "current" context for OpenGL bindings). 'render_arg' is the value passed to RenderPlatformWindowsDefault().
+	Platform_SwapBuffers        func(vp *ImGuiViewport, render_arg any) // . . . R .  // (Optional) Call Present/SwapBuffers (platform side! This is often unused!). 'render_arg' is the value passed to RenderPlatformWindowsDefault().
+
+	// Renderer functions (e.g. DirectX, OpenGL, Vulkan)
+	Renderer_CreateWindow  func(vp *ImGuiViewport)                 // . . U . .  // Create swap chain, frame buffers etc. (called after Platform_CreateWindow)
+	Renderer_DestroyWindow func(vp *ImGuiViewport)                 // N . U . D  // Destroy swap chain, frame buffers etc. (called before Platform_DestroyWindow)
+	Renderer_SetWindowSize func(vp *ImGuiViewport, size ImVec2)    // . . U . .  // Resize swap chain, frame buffers etc. (called after Platform_SetWindowSize)
+	Renderer_RenderWindow  func(vp *ImGuiViewport, render_arg any) // . . . R .  // (Optional) Clear framebuffer, setup render target, then render the viewport->DrawData. 'render_arg' is the value passed to RenderPlatformWindowsDefault().
+	Renderer_SwapBuffers   func(vp *ImGuiViewport, render_arg any) // . . . R .  // (Optional) Call Present/SwapBuffers. 'render_arg' is the value passed to RenderPlatformWindowsDefault().
+
+	// Viewports list (the list is updated by calling ImGui::EndFrame or ImGui::Render)
+	// (in the future we will attempt to organize this feature to remove the need for a "main viewport")
+	Viewports []*ImGuiViewport // Main viewports, followed by all secondary viewports.
+}
+
 // GetCenter Helpers
 func (p *ImGuiViewport) GetCenter() ImVec2 {
 	return ImVec2{p.Pos.x + p.Size.x*0.5, p.Pos.y + p.Size.y*0.5}
@@ -1,19 +1,237 @@
 package imgui
 
+// Viewports
+// - The main viewport is created by the application and hosts all the windows by default.
+// - With ImGuiConfigFlags_ViewportsEnable, a window moved outside of the main viewport gets its own viewport,
+//   which the platform backend turns into an OS window through the ImGuiPlatformIO functions.
+// - Call UpdatePlatformWindows() and RenderPlatformWindowsDefault() after Render() to create, update and render them.
+
+// GetPlatformIO platform/renderer functions, for backend to setup + viewports list.
+func (g *ImGuiContext) GetPlatformIO() *ImGuiPlatformIO {
+	IM_ASSERT_USER_ERROR(g != nil, "No current context. Did you call ImGui::CreateContext() or ImGui::SetCurrentContext()?")
+	return &g.PlatformIO
+}
+
+// FindViewportByID this is a helper for backends.
+func (g *ImGuiContext) FindViewportByID(id ImGuiID) *ImGuiViewport {
+	for _, viewport := range g.Viewports {
+		if viewport.ID == id {
+			return viewport
+		}
+	}
+	return nil
+}
+
+// FindViewportByPlatformHandle this is a helper for backends. the type platform_handle is decided by the backend (e.g. HWND, MyWindow*, GLFWwindow* etc.)
+func (g *ImGuiContext) FindViewportByPlatformHandle(platform_handle any) *ImGuiViewport {
+	for _, viewport := range g.Viewports {
+		if viewport.PlatformHandle == platform_handle {
+			return viewport
+		}
+	}
+	return nil
+}
+
+// SetNextWindowViewport set next window viewport
+func (g *ImGuiContext) SetNextWindowViewport(viewport_id ImGuiID) {
+	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasViewport
+	g.NextWindowData.ViewportId = viewport_id
+}
+
+// GetWindowViewport get viewport currently associated to the current window.
+func (g *ImGuiContext) GetWindowViewport() *ImGuiViewport {
+	IM_ASSERT(g.CurrentViewport != nil && g.CurrentViewport == g.CurrentWindow.Viewport)
+	return g.CurrentViewport
+}
+
+func (g *ImGuiContext) SetCurrentViewport(current_window *ImGuiWindow, viewport *ImGuiViewportP) {
+	if viewport != nil {
+		viewport.LastFrameActive = g.FrameCount
+	}
+	g.CurrentViewport = viewport
+}
+
+func SetWindowViewport(window *ImGuiWindow, viewport *ImGuiViewportP) {
+	// Abandon viewport
+	if window.ViewportOwned && window.Viewport.Window == window {
+		window.Viewport.Size = ImVec2{}
+	}
+	window.Viewport = viewport
+	window.ViewportOwned = viewport.Window == window
+}
+
+// AddUpdateViewport creates the viewport owned by a window (or updates it when it already exists).
+func (g *ImGuiContext) AddUpdateViewport(window *ImGuiWindow, id ImGuiID, pos ImVec2, size ImVec2, flags ImGuiViewportFlags) *ImGuiViewportP {
+	IM_ASSERT(id != 0)
+
+	flags |= ImGuiViewportFlags_IsPlatformWindow
+	if window != nil {
+		if g.MovingWindow != nil && g.MovingWindow.RootWindowDockTree == window {
+			flags |= ImGuiViewportFlags_NoInputs | ImGuiViewportFlags_NoFocusOnAppearing
+		}
+		if window.Flags&ImGuiWindowFlags_NoMouseInputs != 0 && window.Flags&ImGuiWindowFlags_NoNavInputs != 0 {
+			flags |= ImGuiViewportFlags_NoInputs
+		}
+		if window.Flags&ImGuiWindowFlags_NoFocusOnAppearing != 0 {
+			flags |= ImGuiViewportFlags_NoFocusOnAppearing
+		}
+	}
+
+	var viewport = g.FindViewportByID(id)
+	if viewport != nil {
+		// Always update for main viewport as we are already pulling correct platform pos/size (see #4900)
+		if !viewport.PlatformRequestMove || viewport.ID == IMGUI_VIEWPORT_DEFAULT_ID {
+			viewport.Pos = pos
+		}
+		if !viewport.PlatformRequestResize || viewport.ID == IMGUI_VIEWPORT_DEFAULT_ID {
+			viewport.Size = size
+		}
+		viewport.Flags = flags | (viewport.Flags & ImGuiViewportFlags_Minimized) // Preserve existing flags
+	} else {
+		// New viewport
+		var v = NewImGuiViewportP()
+		viewport = &v
+		viewport.ID = id
+		viewport.Idx = int(len(g.Viewports))
+		viewport.Pos = pos
+		viewport.Size = size
+		viewport.Flags = flags
+		g.Viewports = append(g.Viewports, viewport)
+		g.PlatformIO.Viewports = append(g.PlatformIO.Viewports, viewport)
+
+		// New viewports are displayed on top of the existing ones
+		g.ViewportFrontMostStampCount++
+		viewport.LastFrontMostStampCount = g.ViewportFrontMostStampCount
+	}
+
+	viewport.Window = window
+	viewport.LastFrameActive = g.FrameCount
+	viewport.UpdateWorkRect()
+	IM_ASSERT(window == nil || viewport.ID == window.ID)
+
+	if window != nil {
+		window.ViewportOwned = true
+	}
+
+	return viewport
+}
+
+// DestroyViewport removes a viewport which is not used by any window anymore.
+func (g *ImGuiContext) DestroyViewport(viewport *ImGuiViewportP) {
+	// Clear references to this viewport in windows (window.ViewportId becomes the master data)
+	for _, window := range g.Windows {
+		if window.Viewport != viewport {
+			continue
+		}
+		window.Viewport = nil
+		window.ViewportOwned = false
+	}
+	if viewport == g.MouseViewport {
+		g.MouseViewport = nil
+	}
+	if viewport == g.CurrentViewport {
+		g.CurrentViewport = nil
+	}
+
+	// Destroy the platform window if the backend didn't get a chance to do it (e.g. UpdatePlatformWindows() isn't called)
+	g.DestroyPlatformWindow(viewport)
+
+	// Remove from the list
+	IM_ASSERT(g.Viewports[viewport.Idx] == viewport)
+	g.Viewports = append(g.Viewports[:viewport.Idx], g.Viewports[viewport.Idx+1:]...)
+	for i := viewport.Idx; i < int(len(g.Viewports)); i++ {
+		g.Viewports[i].Idx = i
+	}
+	g.PlatformIO.Viewports = append(g.PlatformIO.Viewports[:0], g.Viewports...)
+}
+
+// FindHoveredViewportFromPlatformWindowStack returns the front-most viewport containing mouse_platform_pos.
+func (g *ImGuiContext) FindHoveredViewportFromPlatformWindowStack(mouse_platform_pos ImVec2) *ImGuiViewportP {
+	var best_candidate *ImGuiViewportP
+	for _, viewport := range g.Viewports {
+		if viewport.Flags&(ImGuiViewportFlags_NoInputs|ImGuiViewportFlags_Minimized) != 0 {
+			continue
+		}
+		var viewport_rect = viewport.GetMainRect()
+		if !viewport_rect.ContainsVec(mouse_platform_pos) {
+			continue
+		}
+		if best_candidate == nil || best_candidate.LastFrontMostStampCount < viewport.LastFrontMostStampCount {
+			best_candidate = viewport
+		}
+	}
+	return best_candidate
+}
+
 // Update viewports and monitor infos
-func UpdateViewportsNewFrame() {
-	var g = GImGui
-	IM_ASSERT(len(g.Viewports) == 1)
+func (g *ImGuiContext) UpdateViewportsNewFrame() {
+	IM_ASSERT(len(g.PlatformIO.Viewports) <= len(g.Viewports))
 
 	// Update main viewport with current platform position.
 	// FIXME-VIEWPORT: Size is driven by backend/user code for backward-compatibility but we should aim to make this more consistent.
 	var main_viewport = g.Viewports[0]
-	main_viewport.Flags = ImGuiViewportFlags_IsPlatformWindow | ImGuiViewportFlags_OwnedByApp
+	main_viewport.Flags = ImGuiViewportFlags_IsPlatformWindow | ImGuiViewportFlags_OwnedByApp | ImGuiViewportFlags_CanHostOtherWindows
 	main_viewport.Pos = ImVec2{}
 	main_viewport.Size = g.IO.DisplaySize
+	main_viewport.LastFrameActive = g.FrameCount
 
-	for n := range g.Viewports {
+	var viewports_enabled = g.IO.ConfigFlags&ImGuiConfigFlags_ViewportsEnable != 0
+	if viewports_enabled {
+		// Update our own z-order in the case the platform focused a window
+		if g.PlatformIO.Platform_GetWindowFocus != nil {
+			var focused_viewport *ImGuiViewportP
+			for _, viewport := range g.Viewports {
+				if viewport.PlatformWindowCreated && g.PlatformIO.Platform_GetWindowFocus(viewport) {
+					focused_viewport = viewport
+				}
+			}
+			if focused_viewport != nil && g.PlatformLastFocusedViewportId != focused_viewport.ID {
+				if focused_viewport.LastFrontMostStampCount != g.ViewportFrontMostStampCount {
+					g.ViewportFrontMostStampCount++
+					focused_viewport.LastFrontMostStampCount = g.ViewportFrontMostStampCount
+				}
+				g.PlatformLastFocusedViewportId = focused_viewport.ID
+			}
+		}
+	}
+
+	for n := 0; n < len(g.Viewports); n++ {
 		var viewport = g.Viewports[n]
+		viewport.Idx = int(n)
+
+		// Erase unused viewports
+		if n > 0 && viewport.LastFrameActive < g.FrameCount-2 {
+			g.DestroyViewport(viewport)
+			n--
+			continue
+		}
+
+		if viewports_enabled && viewport.PlatformWindowCreated && n > 0 {
+			// Update Minimized status (we need it first in order to decide if we'll apply Pos/Size of the main viewport)
+			if g.PlatformIO.Platform_GetWindowMinimized != nil {
+				if g.PlatformIO.Platform_GetWindowMinimized(viewport) {
+					viewport.Flags |= ImGuiViewportFlags_Minimized
+				} else {
+					viewport.Flags &= ^ImGuiViewportFlags_Minimized
+				}
+			}
+
+			// Update Position and Size (from Platform Window to ImGui) if requested.
+			// We do it early in the frame instead of waiting for UpdatePlatformWindows() to avoid a frame of lag when moving/resizing using OS facilities.
+			if viewport.Flags&ImGuiViewportFlags_Minimized == 0 {
+				if viewport.PlatformRequestMove && g.PlatformIO.Platform_GetWindowPos != nil {
+					viewport.Pos = g.PlatformIO.Platform_GetWindowPos(viewport)
+					viewport.LastPlatformPos = viewport.Pos
+				}
+				if viewport.PlatformRequestResize && g.PlatformIO.Platform_GetWindowSize != nil {
+					viewport.Size = g.PlatformIO.Platform_GetWindowSize(viewport)
+					viewport.LastPlatformSize = viewport.Size
+				}
+			}
+		}
+
+		// Reset alpha every frame. Users of transparency (docking) needs to request a lower alpha back.
+		viewport.Alpha = 1.0
 
 		// Lock down space taken by menu bars and status bars, reset the offset for fucntions like BeginMainMenuBar() to alter them again.
 		viewport.WorkOffsetMin = viewport.BuildWorkOffsetMin
@@ -22,11 +240,407 @@ func UpdateViewportsNewFrame() {
 		viewport.BuildWorkOffsetMax = ImVec2{}
 		viewport.UpdateWorkRect()
 	}
+
+	if !viewports_enabled {
+		g.MouseViewport = main_viewport
+		return
+	}
+
+	// Mouse handling: decide on the actual mouse viewport for this frame between the active/focused viewport and the hovered viewport.
+	// Note that 'viewport_hovered' should skip over any viewport that has the ImGuiViewportFlags_NoInputs flags set.
+	var viewport_hovered *ImGuiViewportP
+	if g.IsMousePosValid(&g.IO.MousePos) {
+		viewport_hovered = g.FindHoveredViewportFromPlatformWindowStack(g.IO.MousePos)
+	}
+	if g.MouseViewport == nil {
+		g.MouseViewport = main_viewport
+	}
+
+	// When dragging something, always refer to the last hovered viewport.
+	// - when releasing a moving window we will revert to aiming behind (at viewport_hovered)
+	// - consider the case of holding on a menu item to browse child menus: even thou a mouse button is held, there's no active id because menu items only react on mouse release.
+	var is_mouse_dragging_with_an_expected_destination = g.DragDropActive
+	if is_mouse_dragging_with_an_expected_destination || g.ActiveId == 0 || !g.IsAnyMouseDown() {
+		if viewport_hovered != nil && viewport_hovered != g.MouseViewport {
+			g.MouseViewport = viewport_hovered
+		}
+	}
+}
+
+// GetWindowAlwaysWantOwnViewport returns true when the window gets its own viewport even while inside of the main viewport.
+func (g *ImGuiContext) GetWindowAlwaysWantOwnViewport(window *ImGuiWindow) bool {
+	// Tooltips and menus are not automatically forced into their own viewport when the NoMerge flag is set, however the multiplication of viewports makes them more likely to protrude and create their own.
+	if g.IO.ConfigViewportsNoAutoMerge {
+		if g.IO.ConfigFlags&ImGuiConfigFlags_ViewportsEnable != 0 {
+			if !window.DockIsActive {
+				if window.Flags&(ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_ChildMenu|ImGuiWindowFlags_Tooltip) == 0 {
+					if window.Flags&ImGuiWindowFlags_Popup == 0 || window.Flags&ImGuiWindowFlags_Modal != 0 {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// UpdateTryMergeWindowIntoHostViewport merges a window back into a host viewport once it fits entirely inside of it.
+func (g *ImGuiContext) UpdateTryMergeWindowIntoHostViewport(window *ImGuiWindow, viewport *ImGuiViewportP) bool {
+	if window.Viewport == viewport {
+		return false
+	}
+	if viewport.Flags&ImGuiViewportFlags_CanHostOtherWindows == 0 {
+		return false
+	}
+	if viewport.Flags&ImGuiViewportFlags_Minimized != 0 {
+		return false
+	}
+	var viewport_rect = viewport.GetMainRect()
+	if !viewport_rect.ContainsRect(window.Rect()) {
+		return false
+	}
+	if g.GetWindowAlwaysWantOwnViewport(window) {
+		return false
+	}
+
+	// FIXME: Can't use g.WindowsFocusOrder[] for root windows only as we care about Z order. If we maintained a DisplayOrder along with FocusOrder we could..
+	for _, window_behind := range g.Windows {
+		if window_behind == window {
+			break
+		}
+		if window_behind.WasActive && window_behind.ViewportOwned && window_behind.Flags&ImGuiWindowFlags_ChildWindow == 0 {
+			var behind_rect = window_behind.Viewport.GetMainRect()
+			if behind_rect.Overlaps(window.Rect()) {
+				return false
+			}
+		}
+	}
+
+	// Move to the existing viewport, Move child/hosted windows as well (FIXME-OPT: iterate child)
+	var old_viewport = window.Viewport
+	if old_viewport.Window == window {
+		for _, other := range g.Windows {
+			if other.Viewport == old_viewport {
+				SetWindowViewport(other, viewport)
+			}
+		}
+	}
+	SetWindowViewport(window, viewport)
+	g.BringWindowToDisplayFront(window)
+
+	return true
+}
+
+// WindowSelectViewport selects the viewport of a window in Begin(), before its position is locked for the frame.
+func (g *ImGuiContext) WindowSelectViewport(window *ImGuiWindow) {
+	var flags = window.Flags
+	window.ViewportAllowExtend = false
+
+	// Restore main viewport if multi-viewport is not supported by the backend
+	var main_viewport = g.GetMainViewport()
+	if g.IO.ConfigFlags&ImGuiConfigFlags_ViewportsEnable == 0 {
+		SetWindowViewport(window, main_viewport)
+		return
+	}
+	window.ViewportOwned = false
+
+	// Appearing popups reset their viewport so they can inherit again
+	if flags&(ImGuiWindowFlags_Popup|ImGuiWindowFlags_Tooltip) != 0 && window.Appearing {
+		window.Viewport = nil
+	}
+
+	if g.NextWindowData.Flags&ImGuiNextWindowDataFlags_HasViewport != 0 {
+		// Code explicitly request a viewport
+		window.Viewport = g.FindViewportByID(g.NextWindowData.ViewportId)
+		if window.Viewport != nil && window.Viewport.Window != nil && window.Viewport.Window != window {
+			window.Viewport = nil // The requested viewport is owned by another window
+		}
+	} else if flags&ImGuiWindowFlags_ChildWindow != 0 || flags&ImGuiWindowFlags_ChildMenu != 0 || (flags&ImGuiWindowFlags_Popup != 0 && window.ParentWindow != nil) {
+		// Always inherit viewport from parent window
+		if window.DockNode != nil && window.DockNode.HostWindow != nil {
+			IM_ASSERT(window.DockNode.HostWindow.Viewport == window.ParentWindow.Viewport)
+		}
+		window.Viewport = window.ParentWindow.Viewport
+	} else if window.DockIsActive {
+		// Docked windows are hosted by the viewport of their dock node host window
+		window.Viewport = window.DockNode.HostWindow.Viewport
+	} else if flags&ImGuiWindowFlags_Tooltip != 0 {
+		window.Viewport = g.MouseViewport
+	} else if g.GetWindowAlwaysWantOwnViewport(window) {
+		window.Viewport = g.AddUpdateViewport(window, window.ID, window.Pos, window.Size, ImGuiViewportFlags_None)
+	} else {
+		// Merge into host viewport?
+		// We cannot test window.ViewportOwned as it set lower in the function.
+		// (the window being moved keeps its viewport, as g.ActiveId is set while moving)
+		var try_to_merge_into_host_viewport = window.Viewport != nil && window == window.Viewport.Window && g.ActiveId == 0
+		if try_to_merge_into_host_viewport {
+			g.UpdateTryMergeWindowIntoHostViewport(window, main_viewport)
+		}
+	}
+
+	// Fallback: merge in default viewport if z-order matching logic/monitor change failed
+	if window.Viewport == nil {
+		window.Viewport = main_viewport
+	}
+
+	// Mark window as allowed to protrude outside of its viewport and get its own viewport
+	if flags&(ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_Popup|ImGuiWindowFlags_Tooltip) == 0 && !window.DockIsActive {
+		if g.MovingWindow != nil && g.MovingWindow.RootWindowDockTree == window {
+			window.ViewportAllowExtend = true
+		}
+	}
+
+	// Update flags
+	window.ViewportOwned = window == window.Viewport.Window
+	if window.ViewportOwned {
+		var viewport_flags = ImGuiViewportFlags_None
+		if g.IO.ConfigViewportsNoDecoration {
+			viewport_flags |= ImGuiViewportFlags_NoDecoration
+		}
+		if g.IO.ConfigViewportsNoTaskBarIcon {
+			viewport_flags |= ImGuiViewportFlags_NoTaskBarIcon
+		}
+		if g.IO.ConfigViewportsNoAutoMerge {
+			viewport_flags |= ImGuiViewportFlags_NoAutoMerge
+		}
+		window.Viewport = g.AddUpdateViewport(window, window.ID, window.Pos, window.Size, viewport_flags)
+	}
+	window.Viewport.LastFrameActive = g.FrameCount
+}
+
+// WindowSyncOwnedViewport applies the position/size requests of the platform window to the window owning it,
+// then creates a viewport for a window moved outside of its host viewport.
+func (g *ImGuiContext) WindowSyncOwnedViewport(window *ImGuiWindow) {
+	if window.ViewportOwned {
+		var viewport = window.Viewport
+		if viewport.PlatformRequestMove {
+			window.Pos = viewport.Pos
+			g.MarkIniSettingsDirtyWindow(window)
+		} else if viewport.Pos.x != window.Pos.x || viewport.Pos.y != window.Pos.y {
+			viewport.Pos = window.Pos
+		}
+		if viewport.PlatformRequestResize {
+			window.Size = viewport.Size
+			window.SizeFull = viewport.Size
+			g.MarkIniSettingsDirtyWindow(window)
+		} else if viewport.Size.x != window.Size.x || viewport.Size.y != window.Size.y {
+			viewport.Size = window.Size
+		}
+		viewport.UpdateWorkRect()
+		return
+	}
+
+	// Late create viewport if we don't fit within our current host viewport.
+	if window.ViewportAllowExtend && window.Viewport.Flags&ImGuiViewportFlags_Minimized == 0 {
+		var host_rect = window.Viewport.GetMainRect()
+		if !host_rect.ContainsRect(window.Rect()) {
+			var viewport_flags = ImGuiViewportFlags_NoFocusOnAppearing
+			if g.IO.ConfigViewportsNoDecoration {
+				viewport_flags |= ImGuiViewportFlags_NoDecoration
+			}
+			if g.IO.ConfigViewportsNoTaskBarIcon {
+				viewport_flags |= ImGuiViewportFlags_NoTaskBarIcon
+			}
+			window.Viewport = g.AddUpdateViewport(window, window.ID, window.Pos, window.Size, viewport_flags)
+			g.SetCurrentViewport(window, window.Viewport)
+		}
+	}
+}
+
+// UpdatePlatformWindows call in main loop. will call CreateWindow/ResizeWindow/etc. platform functions for each secondary viewport, and DestroyWindow for each inactive viewport.
+func (g *ImGuiContext) UpdatePlatformWindows() {
+	IM_ASSERT_USER_ERROR(g.FrameCountEnded == g.FrameCount, "Forgot to call Render() or EndFrame() before UpdatePlatformWindows()?")
+	IM_ASSERT_USER_ERROR(g.FrameCountPlatformEnded < g.FrameCount, "UpdatePlatformWindows() was called twice in the same frame")
+	g.FrameCountPlatformEnded = g.FrameCount
+	if g.IO.ConfigFlags&ImGuiConfigFlags_ViewportsEnable == 0 {
+		return
+	}
+	var pio = &g.PlatformIO
+
+	// Create/resize/destroy platform windows to match each active viewport.
+	// Skip the main viewport (index 0), which is always fully handled by the application!
+	for i := 1; i < len(g.Viewports); i++ {
+		var viewport = g.Viewports[i]
+
+		// Destroy platform window if the viewport hasn't been submitted or if it is hosting a hidden window
+		// (the visibility of our window doesn't reflect the visibility of the platform window)
+		var destroy_platform_window = viewport.LastFrameActive < g.FrameCount-1
+		if viewport.Window != nil && !IsWindowActiveAndVisible(viewport.Window) {
+			destroy_platform_window = true
+		}
+		if destroy_platform_window {
+			g.DestroyPlatformWindow(viewport)
+			continue
+		}
+
+		// New windows that appears directly in a new viewport won't always have a size on their first frame
+		if viewport.LastFrameActive < g.FrameCount || viewport.Size.x <= 0 || viewport.Size.y <= 0 {
+			continue
+		}
+
+		// Create window
+		var is_new_platform_window = !viewport.PlatformWindowCreated
+		if is_new_platform_window {
+			if pio.Platform_CreateWindow != nil {
+				pio.Platform_CreateWindow(viewport)
+			}
+			if pio.Renderer_CreateWindow != nil {
+				pio.Renderer_CreateWindow(viewport)
+			}
+			viewport.LastNameHash = 0
+			viewport.LastPlatformPos = ImVec2{FLT_MAX, FLT_MAX}
+			viewport.LastPlatformSize = ImVec2{FLT_MAX, FLT_MAX} // By clearing those we'll enforce a call to Platform_SetWindowPos/Size below, before Platform_ShowWindow (FIXME: Is that necessary?)
+			viewport.LastRendererSize = viewport.Size            // We don't need to call Renderer_SetWindowSize() as it is expected Renderer_CreateWindow() already did it.
+			viewport.PlatformWindowCreated = true
+		}
+
+		// Apply Position and Size (from ImGui to Platform/Renderer backends)
+		if (viewport.LastPlatformPos.x != viewport.Pos.x || viewport.LastPlatformPos.y != viewport.Pos.y) && !viewport.PlatformRequestMove {
+			if pio.Platform_SetWindowPos != nil {
+				pio.Platform_SetWindowPos(viewport, viewport.Pos)
+			}
+		}
+		if (viewport.LastPlatformSize.x != viewport.Size.x || viewport.LastPlatformSize.y != viewport.Size.y) && !viewport.PlatformRequestResize {
+			if pio.Platform_SetWindowSize != nil {
+				pio.Platform_SetWindowSize(viewport, viewport.Size)
+			}
+		}
+		if (viewport.LastRendererSize.x != viewport.Size.x || viewport.LastRendererSize.y != viewport.Size.y) && pio.Renderer_SetWindowSize != nil {
+			pio.Renderer_SetWindowSize(viewport, viewport.Size)
+		}
+		viewport.LastPlatformPos = viewport.Pos
+		viewport.LastPlatformSize = viewport.Size
+		viewport.LastRendererSize = viewport.Size
+
+		// Update title bar (if it changed)
+		if window_for_title := viewport.Window; window_for_title != nil {
+			var title = FindRenderedTextEnd(window_for_title.Name)
+			var title_hash = ImHashStr(title, 0, 0)
+			if title_hash != viewport.LastNameHash {
+				if pio.Platform_SetWindowTitle != nil {
+					pio.Platform_SetWindowTitle(viewport, title)
+				}
+				viewport.LastNameHash = title_hash
+			}
+		}
+
+		// Update alpha (if it changed)
+		if viewport.LastAlpha != viewport.Alpha && pio.Platform_SetWindowAlpha != nil {
+			pio.Platform_SetWindowAlpha(viewport, viewport.Alpha)
+		}
+		viewport.LastAlpha = viewport.Alpha
+
+		// Optional, general purpose call to allow the backend to perform general book-keeping even if things haven't changed.
+		if pio.Platform_UpdateWindow != nil {
+			pio.Platform_UpdateWindow(viewport)
+		}
+
+		if is_new_platform_window {
+			// On startup ensure new platform window don't steal focus (give it a few frames, as nested contents may lead to viewport being created a few frames late)
+			if g.FrameCount < 3 {
+				viewport.Flags |= ImGuiViewportFlags_NoFocusOnAppearing
+			}
+
+			// Show window
+			if pio.Platform_ShowWindow != nil {
+				pio.Platform_ShowWindow(viewport)
+			}
+
+			// Even without focus, we assume the window becomes front-most.
+			// This is useful for our platform z-order heuristic when io.MouseHoveredViewport is not available.
+			if viewport.LastFrontMostStampCount != g.ViewportFrontMostStampCount {
+				g.ViewportFrontMostStampCount++
+				viewport.LastFrontMostStampCount = g.ViewportFrontMostStampCount
+			}
+		}
+
+		// Clear request flags
+		viewport.PlatformRequestClose = false
+		viewport.PlatformRequestMove = false
+		viewport.PlatformRequestResize = false
+	}
+}
+
+// RenderPlatformWindowsDefault call in main loop. will call RenderWindow/SwapBuffers platform functions for each secondary viewport which doesn't have the ImGuiViewportFlags_Minimized flag set. May be reimplemented by user for custom rendering needs.
+// This is a default/basic function for performing the rendering/swap of multiple Platform Windows.
+// Custom renderers may prefer to not call this function at all, and instead iterate the publicly exposed platform data and handle rendering/sync themselves.
+// The Render/Swap functions stored in ImGuiPlatformIO are merely here to allow for this helper to exist, but you can do it yourself:
+//
+//	var platform_io = GetPlatformIO()
+//	for i := 1; i < len(platform_io.Viewports); i++ {
+//	    if platform_io.Viewports[i].Flags&ImGuiViewportFlags_Minimized == 0 {
+//	        MyRenderFunction(platform_io.Viewports[i], my_args)
+//	    }
+//	}
+func (g *ImGuiContext) RenderPlatformWindowsDefault(platform_render_arg any, renderer_render_arg any) {
+	// Skip the main viewport (index 0), which is always fully handled by the application!
+	var platform_io = g.GetPlatformIO()
+	for i := 1; i < len(platform_io.Viewports); i++ {
+		var viewport = platform_io.Viewports[i]
+		if viewport.Flags&ImGuiViewportFlags_Minimized != 0 || !viewport.PlatformWindowCreated {
+			continue
+		}
+		if platform_io.Platform_RenderWindow != nil {
+			platform_io.Platform_RenderWindow(viewport, platform_render_arg)
+		}
+		if platform_io.Renderer_RenderWindow != nil {
+			platform_io.Renderer_RenderWindow(viewport, renderer_render_arg)
+		}
+	}
+	for i := 1; i < len(platform_io.Viewports); i++ {
+		var viewport = platform_io.Viewports[i]
+		if viewport.Flags&ImGuiViewportFlags_Minimized != 0 || !viewport.PlatformWindowCreated {
+			continue
+		}
+		if platform_io.Platform_SwapBuffers != nil {
+			platform_io.Platform_SwapBuffers(viewport, platform_render_arg)
+		}
+		if platform_io.Renderer_SwapBuffers != nil {
+			platform_io.Renderer_SwapBuffers(viewport, renderer_render_arg)
+		}
+	}
+}
+
+func (g *ImGuiContext) DestroyPlatformWindow(viewport *ImGuiViewportP) {
+	if viewport.PlatformWindowCreated {
+		if g.PlatformIO.Renderer_DestroyWindow != nil {
+			g.PlatformIO.Renderer_DestroyWindow(viewport)
+		}
+		if g.PlatformIO.Platform_DestroyWindow != nil {
+			g.PlatformIO.Platform_DestroyWindow(viewport)
+		}
+
+		// Don't clear PlatformWindowCreated for the main viewport, as we initially set that up to true in Initialize()
+		// The righter way may be to leave it to the backend to set this flag all-together, and made the flag public.
+		if viewport.ID != IMGUI_VIEWPORT_DEFAULT_ID {
+			viewport.PlatformWindowCreated = false
+		}
+	}
+	viewport.RendererUserData = nil
+	viewport.PlatformUserData = nil
+	viewport.PlatformHandle = nil
+	viewport.PlatformRequestMove = false
+	viewport.PlatformRequestResize = false
+	viewport.PlatformRequestClose = false
+}
+
+// DestroyPlatformWindows call DestroyWindow platform functions for all viewports. call from backend Shutdown() if you need to close platform windows before imgui shutdown. otherwise will be called by DestroyContext().
+func (g *ImGuiContext) DestroyPlatformWindows() {
+	// We call the destroy window on every viewport (including the main viewport, index 0) to give a chance to the backend
+	// to clear any data they may have stored in e.g. PlatformUserData, RendererUserData.
+	// It is convenient for the platform backend code to store something in the main viewport, in order for e.g. the mouse handling
+	// code to operator a consistent manner.
+	// It is expected that the backend can handle calls to Renderer_DestroyWindow/Platform_DestroyWindow without
+	// crashing if it doesn't have data stored.
+	for _, viewport := range g.Viewports {
+		g.DestroyPlatformWindow(viewport)
+	}
 }
 
-func SetupViewportDrawData(viewport *ImGuiViewportP, draw_lists *[]*ImDrawList) {
-	var io = GetIO()
+func (g *ImGuiContext) SetupViewportDrawData(viewport *ImGuiViewportP, draw_lists *[]*ImDrawList) {
+	var io = g.GetIO()
 	var draw_data = &viewport.DrawDataP
+	viewport.DrawData = draw_data // Make publicly accessible
 	draw_data.Valid = true
 	if len(*draw_lists) > 0 {
 		draw_data.CmdLists = *draw_lists
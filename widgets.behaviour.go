@@ -1,12 +1,13 @@
 package imgui
 
-func SplitterBehavior(bb *ImRect, id ImGuiID, axis ImGuiAxis, size1 *float, size2 *float, min_size1 float, min_size2 float, hover_extend float, hover_visibility_delay float) bool {
-	var g = GImGui
+import "math"
+
+func (g *ImGuiContext) SplitterBehavior(bb *ImRect, id ImGuiID, axis ImGuiAxis, size1 *float, size2 *float, min_size1 float, min_size2 float, hover_extend float, hover_visibility_delay float) bool {
 	var window = g.CurrentWindow
 
 	var item_flags_backup = g.CurrentItemFlags
 	g.CurrentItemFlags |= ImGuiItemFlags_NoNav | ImGuiItemFlags_NoNavDefaultFocus
-	var item_add = ItemAdd(bb, id, nil, 0)
+	var item_add = g.ItemAdd(bb, id, nil, 0)
 	g.CurrentItemFlags = item_flags_backup
 	if !item_add {
 		return false
@@ -19,19 +20,19 @@ func SplitterBehavior(bb *ImRect, id ImGuiID, axis ImGuiAxis, size1 *float, size
 	} else {
 		bb_interact.ExpandVec(ImVec2{hover_extend, 0.0})
 	}
-	ButtonBehavior(&bb_interact, id, &hovered, &held, ImGuiButtonFlags_FlattenChildren|ImGuiButtonFlags_AllowItemOverlap)
+	g.ButtonBehavior(&bb_interact, id, &hovered, &held, ImGuiButtonFlags_FlattenChildren|ImGuiButtonFlags_AllowItemOverlap)
 	if hovered {
 		g.LastItemData.StatusFlags |= ImGuiItemStatusFlags_HoveredRect // for IsItemHovered(), because bb_interact is larger than bb
 	}
 	if g.ActiveId != id {
-		SetItemAllowOverlap()
+		g.SetItemAllowOverlap()
 	}
 
 	if held || (hovered && g.HoveredIdPreviousFrame == id && g.HoveredIdTimer >= hover_visibility_delay) {
 		if axis == ImGuiAxis_Y {
-			SetMouseCursor(ImGuiMouseCursor_ResizeNS)
+			g.SetMouseCursor(ImGuiMouseCursor_ResizeNS)
 		} else {
-			SetMouseCursor(ImGuiMouseCursor_ResizeEW)
+			g.SetMouseCursor(ImGuiMouseCursor_ResizeEW)
 		}
 	}
 
@@ -68,7 +69,7 @@ func SplitterBehavior(bb *ImRect, id ImGuiID, axis ImGuiAxis, size1 *float, size
 			} else {
 				bb_render.Translate(ImVec2{0.0, mouse_delta})
 			}
-			MarkItemEdited(id)
+			g.MarkItemEdited(id)
 		}
 	}
 
@@ -80,15 +81,14 @@ func SplitterBehavior(bb *ImRect, id ImGuiID, axis ImGuiAxis, size1 *float, size
 	}
 
 	// Render
-	var col = GetColorU32FromID(c, 1)
+	var col = g.GetColorU32FromID(c, 1)
 	window.DrawList.AddRectFilled(bb_render.Min, bb_render.Max, col, 0.0, 0)
 
 	return held
 }
 
 // FIXME: Move more of the code into SliderBehavior()
-func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float, format string, flags ImGuiSliderFlags, out_grab_bb *ImRect) bool {
-	var g = GImGui
+func SliderBehaviorT[T ImGuiScalar](g *ImGuiContext, bb *ImRect, id ImGuiID, v *T, v_min T, v_max T, format string, flags ImGuiSliderFlags, out_grab_bb *ImRect) bool {
 	var style = g.Style
 
 	var axis = ImGuiAxis_X
@@ -97,16 +97,13 @@ func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float,
 	}
 
 	var is_logarithmic = (flags & ImGuiSliderFlags_Logarithmic) != 0
-	var is_floating_point = true
+	var is_floating_point = DataTypeIsFloatingPoint[T]()
 
 	var grab_padding float = 2.0
 	var slider_sz = (bb.Max.Axis(axis) - bb.Min.Axis(axis)) - grab_padding*2.0
 	var grab_sz = style.GrabMinSize
-	var v_range = v_min - v_max
-	if v_min < v_max {
-		v_range = v_max - v_min
-	}
-	if !is_floating_point && v_range >= 0 { // v_range < 0 may happen on integer overflows
+	var v_range = float(math.Abs(float64(v_max) - float64(v_min))) // Computed in double precision, so that integer ranges don't overflow
+	if !is_floating_point {
 		grab_sz = ImMax((float)(slider_sz/(v_range+1)), style.GrabMinSize) // For integer sliders: if possible have the grab size represent 1 unit
 	}
 	grab_sz = ImMin(grab_sz, slider_sz)
@@ -133,7 +130,7 @@ func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float,
 		var clicked_t float = 0.0
 		if g.ActiveIdSource == ImGuiInputSource_Mouse {
 			if !g.IO.MouseDown[0] {
-				ClearActiveID()
+				g.ClearActiveID()
 			} else {
 				var mouse_abs_pos = g.IO.MousePos.Axis(axis)
 				clicked_t = 0.0
@@ -151,7 +148,7 @@ func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float,
 				g.SliderCurrentAccumDirty = false
 			}
 
-			var input_delta2 = GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard|ImGuiNavDirSourceFlags_PadDPad, ImGuiInputReadMode_RepeatFast, 0.0, 0.0)
+			var input_delta2 = g.GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard|ImGuiNavDirSourceFlags_PadDPad, ImGuiInputReadMode_RepeatFast, 0.0, 0.0)
 			var input_delta = -input_delta2.y
 			if axis == ImGuiAxis_X {
 				input_delta = input_delta2.x
@@ -163,11 +160,11 @@ func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float,
 				}
 				if decimal_precision > 0 {
 					input_delta /= 100.0 // Gamepad/keyboard tweak speeds in % of slider bounds
-					if IsNavInputDown(ImGuiNavInput_TweakSlow) {
+					if g.IsNavInputDown(ImGuiNavInput_TweakSlow) {
 						input_delta /= 10.0
 					}
 				} else {
-					if (v_range >= -100.0 && v_range <= 100.0) || IsNavInputDown(ImGuiNavInput_TweakSlow) {
+					if (v_range >= -100.0 && v_range <= 100.0) || g.IsNavInputDown(ImGuiNavInput_TweakSlow) {
 						// Gamepad/keyboard tweak speeds in integer steps
 						if input_delta < 0.0 {
 							input_delta = -1.0 / v_range
@@ -178,7 +175,7 @@ func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float,
 						input_delta /= 100.0
 					}
 				}
-				if IsNavInputDown(ImGuiNavInput_TweakFast) {
+				if g.IsNavInputDown(ImGuiNavInput_TweakFast) {
 					input_delta *= 10.0
 				}
 
@@ -188,7 +185,7 @@ func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float,
 
 			var delta = g.SliderCurrentAccum
 			if g.NavActivatePressedId == id && !g.ActiveIdIsJustActivated {
-				ClearActiveID()
+				g.ClearActiveID()
 			} else if g.SliderCurrentAccumDirty {
 				clicked_t = ScaleRatioFromValueT(*v, v_min, v_max, is_logarithmic, logarithmic_zero_epsilon, zero_deadzone_halfsize)
 
@@ -253,37 +250,48 @@ func sliderBehaviour(bb *ImRect, id ImGuiID, v *float, v_min float, v_max float,
 	return value_changed
 }
 
-// For 32-bit and larger types, slider bounds are limited to half the natural type range.
-// So e.g. an integer Slider between INT_MAX-10 and INT_MAX will fail, but an integer Slider between INT_MAX/2-10 and INT_MAX/2 will be ok.
-// It would be possible to lift that limitation with some work but it doesn't seem to be worth it for sliders.
-func SliderBehavior(bb *ImRect, id ImGuiID, data_type ImGuiDataType, p_v any, p_min any, p_max any, format string, flags ImGuiSliderFlags, out_grab_bb *ImRect) bool {
+func sliderBehavior[T ImGuiScalar](g *ImGuiContext, bb *ImRect, id ImGuiID, v *T, v_min T, v_max T, format string, flags ImGuiSliderFlags, out_grab_bb *ImRect) bool {
 	// Read imgui.cpp "API BREAKING CHANGES" section for 1.78 if you hit this assert.
 	IM_ASSERT_USER_ERROR((flags == 1 || (flags&ImGuiSliderFlags_InvalidMask_) == 0), "Invalid ImGuiSliderFlags flag!  Has the 'float power' argument been mistakenly cast to flags? Call function with ImGuiSliderFlags_Logarithmic flags instead.")
 
-	var g = GImGui
 	if (g.LastItemData.InFlags&ImGuiItemFlags_ReadOnly != 0) || (flags&ImGuiSliderFlags_ReadOnly != 0) {
 		return false
 	}
 
+	if DataTypeGetInfo(DataTypeOf[T]()).Size >= 4 {
+		var lo, hi = DataTypeGetRange[T]()
+		IM_ASSERT(float64(v_min) >= float64(lo)/2 && float64(v_max) <= float64(hi)/2)
+	}
+	return SliderBehaviorT(g, bb, id, v, v_min, v_max, format, flags, out_grab_bb)
+}
+
+// For 32-bit and larger types, slider bounds are limited to half the natural type range.
+// So e.g. an integer Slider between INT_MAX-10 and INT_MAX will fail, but an integer Slider between INT_MAX/2-10 and INT_MAX/2 will be ok.
+// It would be possible to lift that limitation with some work but it doesn't seem to be worth it for sliders.
+// The pointers must be of the type of data_type, see SliderScalar().
+func (g *ImGuiContext) SliderBehavior(bb *ImRect, id ImGuiID, data_type ImGuiDataType, p_v any, p_min any, p_max any, format string, flags ImGuiSliderFlags, out_grab_bb *ImRect) bool {
 	switch data_type {
+	case ImGuiDataType_S8:
+		return sliderBehavior(g, bb, id, p_v.(*int8), *p_min.(*int8), *p_max.(*int8), format, flags, out_grab_bb)
+	case ImGuiDataType_U8:
+		return sliderBehavior(g, bb, id, p_v.(*uint8), *p_min.(*uint8), *p_max.(*uint8), format, flags, out_grab_bb)
+	case ImGuiDataType_S16:
+		return sliderBehavior(g, bb, id, p_v.(*int16), *p_min.(*int16), *p_max.(*int16), format, flags, out_grab_bb)
+	case ImGuiDataType_U16:
+		return sliderBehavior(g, bb, id, p_v.(*uint16), *p_min.(*uint16), *p_max.(*uint16), format, flags, out_grab_bb)
 	case ImGuiDataType_S32:
-		IM_ASSERT(*p_min.(*int) >= IM_S32_MIN/2 && *p_max.(*int) <= IM_S32_MAX/2)
-		// Convert int32 to float for slider calculations, then convert back
-		v_float := float(*p_v.(*int))
-		v_min_float := float(*p_min.(*int))
-		v_max_float := float(*p_max.(*int))
-		result := sliderBehaviour(bb, id, &v_float, v_min_float, v_max_float, format, flags, out_grab_bb)
-		if result {
-			*p_v.(*int) = int(v_float)
-		}
-		return result
+		return sliderBehavior(g, bb, id, p_v.(*int32), *p_min.(*int32), *p_max.(*int32), format, flags, out_grab_bb)
+	case ImGuiDataType_U32:
+		return sliderBehavior(g, bb, id, p_v.(*uint32), *p_min.(*uint32), *p_max.(*uint32), format, flags, out_grab_bb)
+	case ImGuiDataType_S64:
+		return sliderBehavior(g, bb, id, p_v.(*int64), *p_min.(*int64), *p_max.(*int64), format, flags, out_grab_bb)
+	case ImGuiDataType_U64:
+		return sliderBehavior(g, bb, id, p_v.(*uint64), *p_min.(*uint64), *p_max.(*uint64), format, flags, out_grab_bb)
 	case ImGuiDataType_Float:
-		IM_ASSERT(*p_min.(*float) >= -FLT_MAX/2.0 && *p_max.(*float) <= FLT_MAX/2.0)
-		return sliderBehaviour(bb, id, p_v.(*float), *p_min.(*float), *p_max.(*float), format, flags, out_grab_bb)
+		return sliderBehavior(g, bb, id, p_v.(*float32), *p_min.(*float32), *p_max.(*float32), format, flags, out_grab_bb)
+	case ImGuiDataType_Double:
+		return sliderBehavior(g, bb, id, p_v.(*float64), *p_min.(*float64), *p_max.(*float64), format, flags, out_grab_bb)
 	}
-
-	// FIXME: support other slider types
-	// https://github.com/ocornut/imgui/blob/5ee40c8d34bea3009cf462ec963225bd22067e5e/imgui_widgets.cpp#L2959
 	IM_ASSERT(false)
 	return false
 }
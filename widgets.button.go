@@ -1,48 +1,46 @@
 package imgui
 
 // in 'repeat' mode, Button*() functions return repeated true in a typematic manner (using io.KeyRepeatDelay/io.KeyRepeatRate setting). Note that you can call IsItemActive() after any Button() to tell if the button is held in the current frame.
-func PushButtonRepeat(repeat bool) {
-	PushItemFlag(ImGuiItemFlags_ButtonRepeat, repeat)
+func (g *ImGuiContext) PushButtonRepeat(repeat bool) {
+	g.PushItemFlag(ImGuiItemFlags_ButtonRepeat, repeat)
 }
 
-func PopButtonRepeat() { PopItemFlag() }
+func (g *ImGuiContext) PopButtonRepeat() { g.PopItemFlag() }
 
 // button
-func Button(label string) bool {
-	return ButtonEx(label, &ImVec2{}, ImGuiButtonFlags_None)
+func (g *ImGuiContext) Button(label string) bool {
+	return g.ButtonEx(label, &ImVec2{}, ImGuiButtonFlags_None)
 }
 
 // Small buttons fits within text without additional vertical spacing.
 // button with FramePadding=(0,0) to easily embed within text
-func SmallButton(label string) bool {
-	var g = GImGui
+func (g *ImGuiContext) SmallButton(label string) bool {
 	var backup_padding_y = g.Style.FramePadding.y
 	g.Style.FramePadding.y = 0.0
-	var pressed = ButtonEx(label, &ImVec2{}, ImGuiButtonFlags_AlignTextBaseLine)
+	var pressed = g.ButtonEx(label, &ImVec2{}, ImGuiButtonFlags_AlignTextBaseLine)
 	g.Style.FramePadding.y = backup_padding_y
 	return pressed
 }
 
-func ButtonEx(label string, size_arg *ImVec2, flags ImGuiButtonFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) ButtonEx(label string, size_arg *ImVec2, flags ImGuiButtonFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 	var id = window.GetIDs(label)
-	var label_size = CalcTextSize(label, true, 0)
+	var label_size = g.CalcTextSize(label, true, 0)
 
 	var pos = window.DC.CursorPos
 	if (flags&ImGuiButtonFlags_AlignTextBaseLine) != 0 && style.FramePadding.y < window.DC.CurrLineTextBaseOffset { // Try to vertically align buttons that are smaller/have no padding so that text baseline matches (bit hacky, since it shouldn't be a flag)
 		pos.y += window.DC.CurrLineTextBaseOffset - style.FramePadding.y
 	}
-	var size = CalcItemSize(*size_arg, label_size.x+style.FramePadding.x*2.0, label_size.y+style.FramePadding.y*2.0)
+	var size = g.CalcItemSize(*size_arg, label_size.x+style.FramePadding.x*2.0, label_size.y+style.FramePadding.y*2.0)
 
 	var bb = ImRect{pos, pos.Add(size)}
-	ItemSizeVec(&size, style.FramePadding.y)
-	if !ItemAdd(&bb, id, nil, 0) {
+	g.ItemSizeVec(&size, style.FramePadding.y)
+	if !g.ItemAdd(&bb, id, nil, 0) {
 		return false
 	}
 
@@ -51,26 +49,27 @@ func ButtonEx(label string, size_arg *ImVec2, flags ImGuiButtonFlags) bool {
 	}
 
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, flags)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, flags)
 
 	// Render
 	var col ImU32
 	if held && hovered {
-		col = GetColorU32FromID(ImGuiCol_ButtonActive, 1)
+		col = g.GetColorU32FromID(ImGuiCol_ButtonActive, 1)
 	} else if hovered {
-		col = GetColorU32FromID(ImGuiCol_ButtonHovered, 1)
+		col = g.GetColorU32FromID(ImGuiCol_ButtonHovered, 1)
 	} else {
-		col = GetColorU32FromID(ImGuiCol_Button, 1)
+		col = g.GetColorU32FromID(ImGuiCol_Button, 1)
 	}
-	RenderNavHighlight(&bb, id, 0)
-	RenderFrame(bb.Min, bb.Max, col, true, style.FrameRounding)
+	g.RenderNavHighlight(&bb, id, 0)
+	g.RenderFrame(bb.Min, bb.Max, col, true, style.FrameRounding)
 
 	if g.LogEnabled {
-		LogSetNextTextDecoration("[", "]")
+		g.LogSetNextTextDecoration("[", "]")
 	}
 	min, max := bb.Min.Add(style.FramePadding), bb.Max.Sub(style.FramePadding)
-	RenderTextClipped(&min, &max, label, &label_size, &style.ButtonTextAlign, &bb)
+	g.RenderTextClipped(&min, &max, label, &label_size, &style.ButtonTextAlign, &bb)
 
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags)
 	return pressed
 }
 
@@ -139,9 +138,8 @@ func ButtonEx(label string, size_arg *ImVec2, flags ImGuiButtonFlags) bool {
 //	Frame N + RepeatDelay + RepeatRate*N   true                     true              -                   true
 //
 // -------------------------------------------------------------------------------------------------------------------------------------------------
-func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, flags ImGuiButtonFlags) bool {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, flags ImGuiButtonFlags) bool {
+	var window = g.GetCurrentWindow()
 
 	// Default only reacts to left mouse button
 	if (flags & ImGuiButtonFlags_MouseButtonMask_) == 0 {
@@ -154,13 +152,13 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 	}
 
 	var backup_hovered_window = g.HoveredWindow
-	var flatten_hovered_children = (flags&ImGuiButtonFlags_FlattenChildren != 0) && g.HoveredWindow != nil && g.HoveredWindow.RootWindow == window
+	var flatten_hovered_children = (flags&ImGuiButtonFlags_FlattenChildren != 0) && g.HoveredWindow != nil && g.HoveredWindow.RootWindowDockTree == window.RootWindowDockTree
 	if flatten_hovered_children {
 		g.HoveredWindow = window
 	}
 
 	var pressed = false
-	var hovered = ItemHoverable(bb, id)
+	var hovered = g.ItemHoverable(bb, id)
 
 	// Drag source doesn't report as hovered
 	if hovered && g.DragDropActive && g.DragDropPayload.SourceId == id && g.DragDropSourceFlags&ImGuiDragDropFlags_SourceNoDisableHover == 0 {
@@ -169,13 +167,13 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 
 	// Special mode for Drag and Drop where holding button pressed for a long time while dragging another item triggers the button
 	if g.DragDropActive && (flags&ImGuiButtonFlags_PressedOnDragDropHold != 0) && g.DragDropSourceFlags&ImGuiDragDropFlags_SourceNoHoldToOpenOthers == 0 {
-		if IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByActiveItem) {
+		if g.IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByActiveItem) {
 			hovered = true
-			SetHoveredID(id)
+			g.SetHoveredID(id)
 			if g.HoveredIdTimer-g.IO.DeltaTime <= DRAGDROP_HOLD_TO_OPEN_TIMER && g.HoveredIdTimer >= DRAGDROP_HOLD_TO_OPEN_TIMER {
 				pressed = true
 				g.DragDropHoldJustPressedId = id
-				FocusWindow(window)
+				g.FocusWindow(window)
 			}
 		}
 	}
@@ -212,22 +210,22 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 
 			if mouse_button_clicked != -1 && g.ActiveId != id {
 				if flags&(ImGuiButtonFlags_PressedOnClickRelease|ImGuiButtonFlags_PressedOnClickReleaseAnywhere) != 0 {
-					SetActiveID(id, window)
+					g.SetActiveID(id, window)
 					g.ActiveIdMouseButton = mouse_button_clicked
 					if flags&ImGuiButtonFlags_NoNavFocus == 0 {
-						SetFocusID(id, window)
+						g.SetFocusID(id, window)
 					}
-					FocusWindow(window)
+					g.FocusWindow(window)
 				}
 				if (flags&ImGuiButtonFlags_PressedOnClick != 0) || ((flags&ImGuiButtonFlags_PressedOnDoubleClick != 0) && g.IO.MouseDoubleClicked[mouse_button_clicked]) {
 					pressed = true
 					if flags&ImGuiButtonFlags_NoHoldingActiveId != 0 {
-						ClearActiveID()
+						g.ClearActiveID()
 					} else {
-						SetActiveID(id, window) // Hold on ID
+						g.SetActiveID(id, window) // Hold on ID
 					}
 					g.ActiveIdMouseButton = mouse_button_clicked
-					FocusWindow(window)
+					g.FocusWindow(window)
 				}
 			}
 			if (flags&ImGuiButtonFlags_PressedOnRelease != 0) && mouse_button_released != -1 {
@@ -236,13 +234,13 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 				if !has_repeated_at_least_once {
 					pressed = true
 				}
-				ClearActiveID()
+				g.ClearActiveID()
 			}
 
 			// 'Repeat' mode acts when held regardless of _PressedOn flags (see table above).
 			// Relies on repeat logic of IsMouseClicked() but we may as well do it ourselves if we end up exposing finer RepeatDelay/RepeatRate settings.
 			if g.ActiveId == id && (flags&ImGuiButtonFlags_Repeat != 0) {
-				if g.IO.MouseDownDuration[g.ActiveIdMouseButton] > 0.0 && IsMouseClicked(g.ActiveIdMouseButton, true) {
+				if g.IO.MouseDownDuration[g.ActiveIdMouseButton] > 0.0 && g.IsMouseClicked(g.ActiveIdMouseButton, true) {
 					pressed = true
 				}
 			}
@@ -265,9 +263,9 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 		var nav_activated_by_inputs bool
 
 		if (flags & ImGuiButtonFlags_Repeat) != 0 {
-			nav_activated_by_inputs = IsNavInputTest(ImGuiNavInput_Activate, ImGuiInputReadMode_Repeat)
+			nav_activated_by_inputs = g.IsNavInputTest(ImGuiNavInput_Activate, ImGuiInputReadMode_Repeat)
 		} else {
-			nav_activated_by_inputs = IsNavInputTest(ImGuiNavInput_Activate, ImGuiInputReadMode_Pressed)
+			nav_activated_by_inputs = g.IsNavInputTest(ImGuiNavInput_Activate, ImGuiInputReadMode_Pressed)
 		}
 
 		if nav_activated_by_code || nav_activated_by_inputs {
@@ -276,9 +274,9 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 		if nav_activated_by_code || nav_activated_by_inputs || g.ActiveId == id {
 			// Set active id so it can be queried by user via IsItemActive(), equivalent of holding the mouse button.
 			g.NavActivateId = id // This is so SetActiveId assign a Nav source
-			SetActiveID(id, window)
+			g.SetActiveID(id, window)
 			if (nav_activated_by_code || nav_activated_by_inputs) && flags&ImGuiButtonFlags_NoNavFocus == 0 {
-				SetFocusID(id, window)
+				g.SetFocusID(id, window)
 			}
 		}
 	}
@@ -306,7 +304,7 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 						pressed = true
 					}
 				}
-				ClearActiveID()
+				g.ClearActiveID()
 			}
 			if flags&ImGuiButtonFlags_NoNavFocus == 0 {
 				g.NavDisableHighlight = true
@@ -314,7 +312,7 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 		} else if g.ActiveIdSource == ImGuiInputSource_Nav {
 			// When activated using Nav, we hold on the ActiveID until activation button is released
 			if g.NavActivateDownId != id {
-				ClearActiveID()
+				g.ClearActiveID()
 			}
 		}
 		if pressed {
@@ -332,24 +330,23 @@ func ButtonBehavior(bb *ImRect, id ImGuiID, out_hovered *bool, out_held *bool, f
 	return pressed
 }
 
-func CollapseButton(id ImGuiID, pos *ImVec2) bool {
-	var g = GImGui
+func (g *ImGuiContext) CollapseButton(id ImGuiID, pos *ImVec2) bool {
 	var window = g.CurrentWindow
 
 	var bb = ImRect{*pos, pos.Add(ImVec2{g.FontSize, g.FontSize}).Add(g.Style.FramePadding.Scale(2.0))}
-	ItemAdd(&bb, id, nil, 0)
+	g.ItemAdd(&bb, id, nil, 0)
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, ImGuiButtonFlags_None)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, ImGuiButtonFlags_None)
 
 	// Render
-	var bg_col = GetColorU32FromID(ImGuiCol_Button, 1)
+	var bg_col = g.GetColorU32FromID(ImGuiCol_Button, 1)
 	if held && hovered {
-		bg_col = GetColorU32FromID(ImGuiCol_ButtonActive, 1)
+		bg_col = g.GetColorU32FromID(ImGuiCol_ButtonActive, 1)
 	} else if hovered {
-		bg_col = GetColorU32FromID(ImGuiCol_ButtonHovered, 1)
+		bg_col = g.GetColorU32FromID(ImGuiCol_ButtonHovered, 1)
 	}
 
-	var text_col = GetColorU32FromID(ImGuiCol_Text, 1)
+	var text_col = g.GetColorU32FromID(ImGuiCol_Text, 1)
 	var center = bb.GetCenter()
 	if hovered || held {
 		window.DrawList.AddCircleFilled(center /*+ ImVec2(0.0f, -0.5f)*/, g.FontSize*0.5+1.0, bg_col, 12)
@@ -363,8 +360,8 @@ func CollapseButton(id ImGuiID, pos *ImVec2) bool {
 	RenderArrow(window.DrawList, bb.Min.Add(g.Style.FramePadding), text_col, arrow, 1.0)
 
 	// Switch to moving the window after mouse is moved beyond the initial drag threshold
-	if IsItemActive() && IsMouseDragging(0, -1) {
-		StartMouseMovingWindow(window)
+	if g.IsItemActive() && g.IsMouseDragging(0, -1) {
+		g.StartMouseMovingWindow(window)
 	}
 
 	return pressed
@@ -373,8 +370,8 @@ func CollapseButton(id ImGuiID, pos *ImVec2) bool {
 // flexible button behavior without the visuals, frequently useful to build custom behaviors using the public api (along with IsItemActive, IsItemHovered, etc.)
 // Tip: use ImGui::PushID()/PopID() to push indices or pointers in the ID stack.
 // Then you can keep 'str_id' empty or the same for all your buttons (instead of creating a string based on a non-string id)
-func InvisibleButton(str_id string, size_arg ImVec2, flags ImGuiButtonFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) InvisibleButton(str_id string, size_arg ImVec2, flags ImGuiButtonFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
@@ -383,38 +380,38 @@ func InvisibleButton(str_id string, size_arg ImVec2, flags ImGuiButtonFlags) boo
 	IM_ASSERT(size_arg.x != 0.0 && size_arg.y != 0.0)
 
 	var id = window.GetIDs(str_id)
-	var size = CalcItemSize(size_arg, 0.0, 0.0)
+	var size = g.CalcItemSize(size_arg, 0.0, 0.0)
 	var bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(size)}
-	ItemSizeVec(&size, 0)
-	if !ItemAdd(&bb, id, nil, 0) {
+	g.ItemSizeVec(&size, 0)
+	if !g.ItemAdd(&bb, id, nil, 0) {
 		return false
 	}
 
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, flags)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, flags)
 
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, str_id, g.LastItemData.StatusFlags)
 	return pressed
 }
 
 // square button with an arrow shape
-func ArrowButton(str_id string, dir ImGuiDir) bool {
-	var sz = GetFrameHeight()
-	return ArrowButtonEx(str_id, dir, ImVec2{sz, sz}, ImGuiButtonFlags_None)
+func (g *ImGuiContext) ArrowButton(str_id string, dir ImGuiDir) bool {
+	var sz = g.GetFrameHeight()
+	return g.ArrowButtonEx(str_id, dir, ImVec2{sz, sz}, ImGuiButtonFlags_None)
 }
 
 // use with e.g. if (RadioButton("one", my_value==1)) { my_value = 1 bool {panic("not implemented")} }
-func RadioButtonBool(label string, active bool) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) RadioButtonBool(label string, active bool) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 	var id = window.GetIDs(label)
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 
-	var square_sz = GetFrameHeight()
+	var square_sz = g.GetFrameHeight()
 	var pos = window.DC.CursorPos
 	var check_bb = ImRect{pos, pos.Add(ImVec2{square_sz, square_sz})}
 
@@ -424,8 +421,8 @@ func RadioButtonBool(label string, active bool) bool {
 	}
 
 	var total_bb = ImRect{pos, pos.Add(ImVec2{square_sz + padding, label_size.y + style.FramePadding.y*2.0})}
-	ItemSizeRect(&total_bb, style.FramePadding.y)
-	if !ItemAdd(&total_bb, id, nil, 0) {
+	g.ItemSizeRect(&total_bb, style.FramePadding.y)
+	if !g.ItemAdd(&total_bb, id, nil, 0) {
 		return false
 	}
 
@@ -435,12 +432,12 @@ func RadioButtonBool(label string, active bool) bool {
 	var radius = (square_sz - 1.0) * 0.5
 
 	var hovered, held bool
-	var pressed = ButtonBehavior(&total_bb, id, &hovered, &held, 0)
+	var pressed = g.ButtonBehavior(&total_bb, id, &hovered, &held, 0)
 	if pressed {
-		MarkItemEdited(id)
+		g.MarkItemEdited(id)
 	}
 
-	RenderNavHighlight(&total_bb, id, 0)
+	g.RenderNavHighlight(&total_bb, id, 0)
 
 	var c = ImGuiCol_FrameBg
 	if held && hovered {
@@ -449,15 +446,15 @@ func RadioButtonBool(label string, active bool) bool {
 		c = ImGuiCol_FrameBgHovered
 	}
 
-	window.DrawList.AddCircleFilled(center, radius, GetColorU32FromID(c, 1), 16)
+	window.DrawList.AddCircleFilled(center, radius, g.GetColorU32FromID(c, 1), 16)
 	if active {
 		var pad = ImMax(1.0, IM_FLOOR(square_sz/6.0))
-		window.DrawList.AddCircleFilled(center, radius-pad, GetColorU32FromID(ImGuiCol_CheckMark, 1), 16)
+		window.DrawList.AddCircleFilled(center, radius-pad, g.GetColorU32FromID(ImGuiCol_CheckMark, 1), 16)
 	}
 
 	if style.FrameBorderSize > 0.0 {
-		window.DrawList.AddCircle(center.Add(ImVec2{1, 1}), radius, GetColorU32FromID(ImGuiCol_BorderShadow, 1), 16, style.FrameBorderSize)
-		window.DrawList.AddCircle(center, radius, GetColorU32FromID(ImGuiCol_Border, 1), 16, style.FrameBorderSize)
+		window.DrawList.AddCircle(center.Add(ImVec2{1, 1}), radius, g.GetColorU32FromID(ImGuiCol_BorderShadow, 1), 16, style.FrameBorderSize)
+		window.DrawList.AddCircle(center, radius, g.GetColorU32FromID(ImGuiCol_Border, 1), 16, style.FrameBorderSize)
 	}
 
 	var label_pos = ImVec2{check_bb.Max.x + style.ItemInnerSpacing.x, check_bb.Min.y + style.FramePadding.y}
@@ -466,19 +463,20 @@ func RadioButtonBool(label string, active bool) bool {
 		if active {
 			s = "(X)"
 		}
-		LogRenderedText(&label_pos, s)
+		g.LogRenderedText(&label_pos, s)
 	}
 	if label_size.x > 0.0 {
-		RenderText(label_pos, label, true)
+		g.RenderText(label_pos, label, true)
 	}
 
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags)
 	return pressed
 }
 
 // shortcut to handle the above pattern when value is an integer
-func RadioButtonInt(label string, v *int, v_button int) bool {
+func (g *ImGuiContext) RadioButtonInt(label string, v *int, v_button int) bool {
 	// FIXME: This would work nicely if it was a public template, e.g. 'template<T> RadioButton(const char* label, T* v, T v_button)', but I'm not sure how we would expose it..
-	var pressed = RadioButtonBool(label, *v == v_button)
+	var pressed = g.RadioButtonBool(label, *v == v_button)
 	if pressed {
 		*v = v_button
 	}
@@ -486,8 +484,7 @@ func RadioButtonInt(label string, v *int, v_button int) bool {
 }
 
 // Button to close a window
-func CloseButton(id ImGuiID, pos *ImVec2) bool {
-	var g = GImGui
+func (g *ImGuiContext) CloseButton(id ImGuiID, pos *ImVec2) bool {
 	var window = g.CurrentWindow
 
 	// Tweak 1: Shrink hit-testing area if button covers an abnormally large proportion of the visible region. That's in order to facilitate moving the window away. (#3825)
@@ -502,10 +499,10 @@ func CloseButton(id ImGuiID, pos *ImVec2) bool {
 
 	// Tweak 2: We intentionally allow interaction when clipped so that a mechanical Alt,Right,Activate sequence can always close a window.
 	// (this isn't the regular behavior of buttons, but it doesn't affect the user much because navigation tends to keep items visible).
-	var is_clipped = !ItemAdd(&bb_interact, id, nil, 0)
+	var is_clipped = !g.ItemAdd(&bb_interact, id, nil, 0)
 
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb_interact, id, &hovered, &held, 0)
+	var pressed = g.ButtonBehavior(&bb_interact, id, &hovered, &held, 0)
 	if is_clipped {
 		return pressed
 	}
@@ -517,14 +514,14 @@ func CloseButton(id ImGuiID, pos *ImVec2) bool {
 		c = ImGuiCol_ButtonActive
 	}
 
-	var col = GetColorU32FromID(c, 1)
+	var col = g.GetColorU32FromID(c, 1)
 	var center = bb.GetCenter()
 	if hovered {
 		window.DrawList.AddCircleFilled(center, ImMax(2.0, g.FontSize*0.5+1.0), col, 12)
 	}
 
 	var cross_extent = g.FontSize*0.5*0.7071 - 1.0
-	var cross_col = GetColorU32FromID(ImGuiCol_Text, 1)
+	var cross_col = g.GetColorU32FromID(ImGuiCol_Text, 1)
 	center = center.Sub(ImVec2{0.5, 0.5})
 	a, b := center.Add(ImVec2{+cross_extent, +cross_extent}), center.Add(ImVec2{-cross_extent, -cross_extent})
 	window.DrawList.AddLine(&a, &b, cross_col, 1.0)
@@ -534,24 +531,23 @@ func CloseButton(id ImGuiID, pos *ImVec2) bool {
 	return pressed
 }
 
-func ArrowButtonEx(str_id string, dir ImGuiDir, size ImVec2, flags ImGuiButtonFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) ArrowButtonEx(str_id string, dir ImGuiDir, size ImVec2, flags ImGuiButtonFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var id = window.GetIDs(str_id)
 	var bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(size)}
-	var default_size = GetFrameHeight()
+	var default_size = g.GetFrameHeight()
 
 	var baseline float = -1
 	if size.y >= default_size {
 		baseline = g.Style.FramePadding.y
 	}
 
-	ItemSizeVec(&size, baseline)
-	if !ItemAdd(&bb, id, nil, 0) {
+	g.ItemSizeVec(&size, baseline)
+	if !g.ItemAdd(&bb, id, nil, 0) {
 		return false
 	}
 
@@ -560,7 +556,7 @@ func ArrowButtonEx(str_id string, dir ImGuiDir, size ImVec2, flags ImGuiButtonFl
 	}
 
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, flags)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, flags)
 
 	var c = ImGuiCol_Button
 	if held && hovered {
@@ -570,11 +566,12 @@ func ArrowButtonEx(str_id string, dir ImGuiDir, size ImVec2, flags ImGuiButtonFl
 	}
 
 	// Render
-	var bg_col = GetColorU32FromID(c, 1.0)
-	var text_col = GetColorU32FromID(ImGuiCol_Text, 1)
-	RenderNavHighlight(&bb, id, 0)
-	RenderFrame(bb.Min, bb.Max, bg_col, true, g.Style.FrameRounding)
+	var bg_col = g.GetColorU32FromID(c, 1.0)
+	var text_col = g.GetColorU32FromID(ImGuiCol_Text, 1)
+	g.RenderNavHighlight(&bb, id, 0)
+	g.RenderFrame(bb.Min, bb.Max, bg_col, true, g.Style.FrameRounding)
 	RenderArrow(window.DrawList, bb.Min.Add(ImVec2{ImMax(0.0, (size.x-g.FontSize)*0.5), ImMax(0.0, (size.y-g.FontSize)*0.5)}), text_col, dir, 1)
 
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, str_id, g.LastItemData.StatusFlags)
 	return pressed
 }
@@ -1,17 +1,16 @@
 package imgui
 
-func CheckboxFlagsInt(label string, flags *int, flags_value int) bool {
+func (g *ImGuiContext) CheckboxFlagsInt(label string, flags *int, flags_value int) bool {
 	var all_on = (*flags & flags_value) == flags_value
 	var any_on = (*flags & flags_value) != 0
 	var pressed bool
 	if !all_on && any_on {
-		var g = GImGui
 		var backup_item_flags = g.CurrentItemFlags
 		g.CurrentItemFlags |= ImGuiItemFlags_MixedValue
-		pressed = Checkbox(label, &all_on)
+		pressed = g.Checkbox(label, &all_on)
 		g.CurrentItemFlags = backup_item_flags
 	} else {
-		pressed = Checkbox(label, &all_on)
+		pressed = g.Checkbox(label, &all_on)
 
 	}
 	if pressed {
@@ -24,18 +23,17 @@ func CheckboxFlagsInt(label string, flags *int, flags_value int) bool {
 	return pressed
 }
 
-func CheckboxFlagsUint(label string, flags *uint, flags_value uint) bool {
+func (g *ImGuiContext) CheckboxFlagsUint(label string, flags *uint, flags_value uint) bool {
 	var all_on = (*flags & flags_value) == flags_value
 	var any_on = (*flags & flags_value) != 0
 	var pressed bool
 	if !all_on && any_on {
-		var g = GImGui
 		var backup_item_flags = g.CurrentItemFlags
 		g.CurrentItemFlags |= ImGuiItemFlags_MixedValue
-		pressed = Checkbox(label, &all_on)
+		pressed = g.Checkbox(label, &all_on)
 		g.CurrentItemFlags = backup_item_flags
 	} else {
-		pressed = Checkbox(label, &all_on)
+		pressed = g.Checkbox(label, &all_on)
 
 	}
 	if pressed {
@@ -48,18 +46,17 @@ func CheckboxFlagsUint(label string, flags *uint, flags_value uint) bool {
 	return pressed
 }
 
-func Checkbox(label string, v *bool) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Checkbox(label string, v *bool) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 	var id = window.GetIDs(label)
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 
-	var square_sz = GetFrameHeight()
+	var square_sz = g.GetFrameHeight()
 	var pos = window.DC.CursorPos
 
 	var x float
@@ -68,18 +65,18 @@ func Checkbox(label string, v *bool) bool {
 	}
 
 	var total_bb = ImRect{pos, pos.Add(ImVec2{square_sz + x, label_size.y + style.FramePadding.y*2.0})}
-	ItemSizeRect(&total_bb, style.FramePadding.y)
-	if !ItemAdd(&total_bb, id, nil, 0) {
+	g.ItemSizeRect(&total_bb, style.FramePadding.y)
+	if !g.ItemAdd(&total_bb, id, nil, 0) {
 		return false
 	}
 
 	var hovered, held bool
-	var pressed = ButtonBehavior(&total_bb, id, &hovered, &held, 0)
+	var pressed = g.ButtonBehavior(&total_bb, id, &hovered, &held, 0)
 	if pressed {
 		if v != nil {
 			*v = !(*v)
 		}
-		MarkItemEdited(id)
+		g.MarkItemEdited(id)
 	}
 
 	c := ImGuiCol_FrameBg
@@ -90,9 +87,9 @@ func Checkbox(label string, v *bool) bool {
 	}
 
 	var check_bb = ImRect{pos, pos.Add(ImVec2{square_sz, square_sz})}
-	RenderNavHighlight(&total_bb, id, 0)
-	RenderFrame(check_bb.Min, check_bb.Max, GetColorU32FromID(c, 1), true, style.FrameRounding)
-	var check_col = GetColorU32FromID(ImGuiCol_CheckMark, 1)
+	g.RenderNavHighlight(&total_bb, id, 0)
+	g.RenderFrame(check_bb.Min, check_bb.Max, g.GetColorU32FromID(c, 1), true, style.FrameRounding)
+	var check_col = g.GetColorU32FromID(ImGuiCol_CheckMark, 1)
 	var mixed_value = (g.LastItemData.InFlags & ImGuiItemFlags_MixedValue) != 0
 	if mixed_value {
 		// Undocumented tristate/mixed/indeterminate checkbox (#2644)
@@ -112,12 +109,17 @@ func Checkbox(label string, v *bool) bool {
 		} else if *v {
 			s = "[x]"
 		}
-		LogRenderedText(&label_pos, s)
+		g.LogRenderedText(&label_pos, s)
 	}
 	if label_size.x > 0.0 {
-		RenderText(label_pos, label, true)
+		g.RenderText(label_pos, label, true)
 	}
 
+	var checked = ImGuiItemStatusFlags_None
+	if v != nil && *v {
+		checked = ImGuiItemStatusFlags_Checked
+	}
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags|ImGuiItemStatusFlags_Checkable|checked)
 	return pressed
 }
 
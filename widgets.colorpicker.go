@@ -2,7 +2,6 @@ package imgui
 
 import (
 	"fmt"
-	"unsafe"
 )
 
 // Helper for ColorPicker4()
@@ -17,9 +16,9 @@ func RenderArrowsForVerticalBar(draw_list *ImDrawList, pos, half_sz ImVec2, bar_
 // Widgets: Color Editor/Picker (tip: the ColorEdit* functions have a little color square that can be left-clicked to open a picker, and right-clicked to open an option menu.)
 // - Note that in C++ a 'v float[X]' function argument is the _same_ as 'float* v', the array syntax is just a way to document the number of elements that are expected to be accessible.
 // - You can pass the address of a first element float out of a contiguous structure, e.g. &myvector.x
-func ColorEdit3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
+func (g *ImGuiContext) ColorEdit3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
 	var col4 = [4]float{col[0], col[1], col[2], 1.0}
-	result := ColorEdit4(label, &col4, flags|ImGuiColorEditFlags_NoAlpha)
+	result := g.ColorEdit4(label, &col4, flags|ImGuiColorEditFlags_NoAlpha)
 	col[0] = col4[0]
 	col[1] = col4[1]
 	col[2] = col4[2]
@@ -29,16 +28,15 @@ func ColorEdit3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
 // Edit colors components (each component in 0.0f..1.0f range).
 // See enum ImGuiColorEditFlags_ for available options. e.g. Only access 3 floats if ImGuiColorEditFlags_NoAlpha flag is set.
 // With typical options: Left-click on color square to open color picker. Right-click to open option menu. CTRL-Click over input fields to edit them and TAB to go to next item.
-func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
-	var square_sz = GetFrameHeight()
-	var w_full = CalcItemWidth()
+	var square_sz = g.GetFrameHeight()
+	var w_full = g.CalcItemWidth()
 	var w_button float = 0.0
 	if (flags & ImGuiColorEditFlags_NoSmallPreview) == 0 {
 		w_button = (square_sz + style.ItemInnerSpacing.x)
@@ -46,8 +44,8 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 	var w_inputs = w_full - w_button
 	g.NextItemData.ClearFlags()
 
-	BeginGroup()
-	PushString(label)
+	g.BeginGroup()
+	g.PushString(label)
 
 	// If we're not showing any slider there's no point in doing any HSV conversions
 	var flags_untouched = flags
@@ -57,7 +55,7 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 
 	// Context menu: display and modify options (before defaults are applied)
 	if flags&ImGuiColorEditFlags_NoOptions != 0 {
-		ColorEditOptionsPopup(*col, flags)
+		g.ColorEditOptionsPopup(*col, flags)
 	}
 
 	// Read stored options
@@ -128,7 +126,7 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 			mformat = "M:0.000"
 		}
 
-		var hide_prefix = (w_item_one <= CalcTextSize(mformat, true, -1).x)
+		var hide_prefix = (w_item_one <= g.CalcTextSize(mformat, true, -1).x)
 		var ids = [4]string{"##X", "##Y", "##Z", "##W"}
 		var fmt_table_int = [3][4]string{
 			{"%3d", "%3d", "%3d", "%3d"},         // Short display
@@ -153,12 +151,12 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 
 		for n := int(0); n < components; n++ {
 			if n > 0 {
-				SameLine(0, style.ItemInnerSpacing.x)
+				g.SameLine(0, style.ItemInnerSpacing.x)
 			}
 			if n+1 < components {
-				SetNextItemWidth(w_item_one)
+				g.SetNextItemWidth(w_item_one)
 			} else {
-				SetNextItemWidth(w_item_last)
+				g.SetNextItemWidth(w_item_last)
 			}
 
 			var h1 float = 1
@@ -172,13 +170,13 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 
 			// FIXME: When ImGuiColorEditFlags_HDR flag is passed HS values snap in weird ways when SV values go below 0.
 			if (flags & ImGuiColorEditFlags_Float) != 0 {
-				value_changed = DragFloat(ids[n], &f[n], 1.0/255.0, 0.0, h1, fmt_table_float[fmt_idx][n], 0) || value_changed
+				value_changed = g.DragFloat(ids[n], &f[n], 1.0/255.0, 0.0, h1, fmt_table_float[fmt_idx][n], 0) || value_changed
 				value_changed_as_float = value_changed_as_float || value_changed
 			} else {
-				value_changed = DragInt(ids[n], &i[n], 1.0, 0, h2, fmt_table_int[fmt_idx][n], 0) || value_changed
+				value_changed = g.DragInt(ids[n], &i[n], 1.0, 0, h2, fmt_table_int[fmt_idx][n], 0) || value_changed
 			}
 			if flags&ImGuiColorEditFlags_NoOptions == 0 {
-				OpenPopupOnItemClick("context", 0)
+				g.OpenPopupOnItemClick("context", 0)
 			}
 		}
 	} else if (flags&ImGuiColorEditFlags_DisplayHex) != 0 && (flags&ImGuiColorEditFlags_NoInputs) == 0 {
@@ -189,8 +187,8 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 		} else {
 			buf = []byte(fmt.Sprintf("#%02X%02X%02X", ImClampInt(i[0], 0, 255), ImClampInt(i[1], 0, 255), ImClampInt(i[2], 0, 255)))
 		}
-		SetNextItemWidth(w_inputs)
-		if InputText("##Text", &buf, ImGuiInputTextFlags_CharsHexadecimal|ImGuiInputTextFlags_CharsUppercase, nil, NewImDrawListSharedData()) {
+		g.SetNextItemWidth(w_inputs)
+		if g.InputText("##Text", &buf, ImGuiInputTextFlags_CharsHexadecimal|ImGuiInputTextFlags_CharsUppercase, nil, NewImDrawListSharedData()) {
 			value_changed = true
 			var p = buf
 			for p[0] == '#' || ImCharIsBlankA(p[0]) {
@@ -207,7 +205,7 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 			}
 		}
 		if flags&ImGuiColorEditFlags_NoOptions == 0 {
-			OpenPopupOnItemClick("context", 0)
+			g.OpenPopupOnItemClick("context", 0)
 		}
 	}
 
@@ -225,30 +223,30 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 		}
 
 		var col_v4 = ImVec4{col[0], col[1], col[2], a}
-		if ColorButton("##ColorButton", col_v4, flags, ImVec2{}) {
+		if g.ColorButton("##ColorButton", col_v4, flags, ImVec2{}) {
 			if flags&ImGuiColorEditFlags_NoPicker == 0 {
 				// Store current color and open a picker
 				g.ColorPickerRef = col_v4
-				OpenPopup("picker", 0)
+				g.OpenPopup("picker", 0)
 				pos := g.LastItemData.Rect.GetBL().Add(ImVec2{-1, style.ItemSpacing.y})
-				SetNextWindowPos(&pos, 0, ImVec2{})
+				g.SetNextWindowPos(&pos, 0, ImVec2{})
 			}
 		}
 		if flags&ImGuiColorEditFlags_NoOptions == 0 {
-			OpenPopupOnItemClick("context", 0)
+			g.OpenPopupOnItemClick("context", 0)
 		}
 
-		if BeginPopup("picker", 0) {
+		if g.BeginPopup("picker", 0) {
 			picker_active_window = g.CurrentWindow
 			if len(label) > 0 {
-				TextEx(label, 0)
-				Spacing()
+				g.TextEx(label, 0)
+				g.Spacing()
 			}
 			var picker_flags_to_forward = ImGuiColorEditFlags_DataTypeMask_ | ImGuiColorEditFlags_PickerMask_ | ImGuiColorEditFlags_InputMask_ | ImGuiColorEditFlags_HDR | ImGuiColorEditFlags_NoAlpha | ImGuiColorEditFlags_AlphaBar
 			var picker_flags = (flags_untouched & picker_flags_to_forward) | ImGuiColorEditFlags_DisplayMask_ | ImGuiColorEditFlags_NoLabel | ImGuiColorEditFlags_AlphaPreviewHalf
-			SetNextItemWidth(square_sz * 12.0) // Use 256 + bar sizes?
-			value_changed = ColorPicker4("##picker", col, picker_flags, []float{g.ColorPickerRef.x, g.ColorPickerRef.y, g.ColorPickerRef.z, g.ColorPickerRef.w}) || value_changed
-			EndPopup()
+			g.SetNextItemWidth(square_sz * 12.0) // Use 256 + bar sizes?
+			value_changed = g.ColorPicker4("##picker", col, picker_flags, []float{g.ColorPickerRef.x, g.ColorPickerRef.y, g.ColorPickerRef.z, g.ColorPickerRef.w}) || value_changed
+			g.EndPopup()
 		}
 	}
 
@@ -260,7 +258,7 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 			text_offset_x = w_full + style.ItemInnerSpacing.x
 		}
 		window.DC.CursorPos = ImVec2{pos.x + text_offset_x, pos.y + style.FramePadding.y}
-		TextEx(label, 0)
+		g.TextEx(label, 0)
 	}
 
 	// Convert back
@@ -288,21 +286,19 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 		}
 	}
 
-	PopID()
-	EndGroup()
+	g.PopID()
+	g.EndGroup()
 
 	// Drag and Drop Target
 	// NB: The flag test is merely an optional micro-optimization, BeginDragDropTarget() does the same test.
-	if (g.LastItemData.StatusFlags&ImGuiItemStatusFlags_HoveredRect != 0) && (flags&ImGuiColorEditFlags_NoDragDrop) == 0 && BeginDragDropTarget() {
+	if (g.LastItemData.StatusFlags&ImGuiItemStatusFlags_HoveredRect != 0) && (flags&ImGuiColorEditFlags_NoDragDrop) == 0 && g.BeginDragDropTarget() {
 		var accepted_drag_drop = false
-		if payload := AcceptDragDropPayload(IMGUI_PAYLOAD_TYPE_COLOR_3F, 0); payload != nil {
-			data := payload.Data.([3]float32)
+		if data, ok, _ := AcceptDragDropPayloadTContext[[3]float](g, IMGUI_PAYLOAD_TYPE_COLOR_3F, 0); ok {
 			copy(col[:], data[:3]) // Preserve alpha if any //-V512
 			value_changed = true
 			accepted_drag_drop = true
 		}
-		if payload := AcceptDragDropPayload(IMGUI_PAYLOAD_TYPE_COLOR_4F, 0); payload != nil {
-			data := payload.Data.([4]float32)
+		if data, ok, _ := AcceptDragDropPayloadTContext[[4]float](g, IMGUI_PAYLOAD_TYPE_COLOR_4F, 0); ok {
 			copy(col[:], data[:components])
 			value_changed = true
 			accepted_drag_drop = true
@@ -312,7 +308,7 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 		if accepted_drag_drop && flags&ImGuiColorEditFlags_InputHSV != 0 {
 			ColorConvertRGBtoHSV(col[0], col[1], col[2], &col[0], &col[1], &col[2])
 		}
-		EndDragDropTarget()
+		g.EndDragDropTarget()
 	}
 
 	// When picker is being actively used, use its active id so IsItemActive() will function on ColorEdit4().
@@ -321,15 +317,15 @@ func ColorEdit4(label string, col *[4]float, flags ImGuiColorEditFlags) bool {
 	}
 
 	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
+		g.MarkItemEdited(g.LastItemData.ID)
 	}
 
 	return value_changed
 }
 
-func ColorPicker3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
+func (g *ImGuiContext) ColorPicker3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
 	var col4 = [4]float{col[0], col[1], col[2], 1.0}
-	if !ColorPicker4(label, &col4, flags|ImGuiColorEditFlags_NoAlpha, nil) {
+	if !g.ColorPicker4(label, &col4, flags|ImGuiColorEditFlags_NoAlpha, nil) {
 		return false
 	}
 	col[0] = col4[0]
@@ -342,9 +338,8 @@ func ColorPicker3(label string, col *[3]float, flags ImGuiColorEditFlags) bool {
 // (In C++ the 'float col[4]' notation for a function argument is equivalent to 'float* col', we only specify a size to facilitate understanding of the code.)
 // FIXME: we adjust the big color square height based on item width, which may cause a flickering feedback loop (if automatic height makes a vertical scrollbar appears, affecting automatic width..)
 // FIXME: this is trying to be aware of style.Alpha but not fully correct. Also, the color wheel will have overlapping glitches with (style.Alpha < 1.0)
-func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_col []float) bool {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_col []float) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
@@ -353,11 +348,11 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 	var style = g.Style
 	var io = g.IO
 
-	var width = CalcItemWidth()
+	var width = g.CalcItemWidth()
 	g.NextItemData.ClearFlags()
 
-	PushString(label)
-	BeginGroup()
+	g.PushString(label)
+	g.BeginGroup()
 
 	if (flags & ImGuiColorEditFlags_NoSidePreview) == 0 {
 		flags |= ImGuiColorEditFlags_NoSmallPreview
@@ -365,7 +360,7 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 
 	// Context menu: display and store options.
 	if flags&ImGuiColorEditFlags_NoOptions == 0 {
-		ColorPickerOptionsPopup(col, flags)
+		g.ColorPickerOptionsPopup(col, flags)
 	}
 
 	// Read stored options
@@ -400,7 +395,7 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 		bar = 2
 	}
 	var picker_pos = window.DC.CursorPos
-	var square_sz = GetFrameHeight()
+	var square_sz = g.GetFrameHeight()
 	var bars_width = square_sz                                                                // Arbitrary smallish width of Hue/Alpha picking bars
 	var sv_picker_size = ImMax(bars_width*1, width-bar*(bars_width+style.ItemInnerSpacing.x)) // Saturation/Value picking box
 	var bar0_pos_x = picker_pos.x + sv_picker_size + style.ItemInnerSpacing.x
@@ -440,11 +435,11 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 
 	var value_changed, value_changed_h, value_changed_sv bool
 
-	PushItemFlag(ImGuiItemFlags_NoNav, true)
+	g.PushItemFlag(ImGuiItemFlags_NoNav, true)
 	if (flags & ImGuiColorEditFlags_PickerHueWheel) != 0 {
 		// Hue wheel + SV triangle logic
-		InvisibleButton("hsv", ImVec2{sv_picker_size + style.ItemInnerSpacing.x + bars_width, sv_picker_size}, 0)
-		if IsItemActive() {
+		g.InvisibleButton("hsv", ImVec2{sv_picker_size + style.ItemInnerSpacing.x + bars_width, sv_picker_size}, 0)
+		if g.IsItemActive() {
 			var initial_off = g.IO.MouseClickedPos[0].Sub(wheel_center)
 			var current_off = g.IO.MousePos.Sub(wheel_center)
 			var initial_dist2 = ImLengthSqrVec2(initial_off)
@@ -474,25 +469,25 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 			}
 		}
 		if flags&ImGuiColorEditFlags_NoOptions != 0 {
-			OpenPopupOnItemClick("context", 0)
+			g.OpenPopupOnItemClick("context", 0)
 		}
 	} else if flags&ImGuiColorEditFlags_PickerHueBar != 0 {
 		// SV rectangle logic
-		InvisibleButton("sv", ImVec2{sv_picker_size, sv_picker_size}, 0)
-		if IsItemActive() {
+		g.InvisibleButton("sv", ImVec2{sv_picker_size, sv_picker_size}, 0)
+		if g.IsItemActive() {
 			S = ImSaturate((io.MousePos.x - picker_pos.x) / (sv_picker_size - 1))
 			V = 1.0 - ImSaturate((io.MousePos.y-picker_pos.y)/(sv_picker_size-1))
 			value_changed = true
 			value_changed_sv = true
 		}
 		if flags&ImGuiColorEditFlags_NoOptions != 0 {
-			OpenPopupOnItemClick("context", 0)
+			g.OpenPopupOnItemClick("context", 0)
 		}
 
 		// Hue bar logic
-		SetCursorScreenPos(ImVec2{bar0_pos_x, picker_pos.y})
-		InvisibleButton("hue", ImVec2{bars_width, sv_picker_size}, 0)
-		if IsItemActive() {
+		g.SetCursorScreenPos(ImVec2{bar0_pos_x, picker_pos.y})
+		g.InvisibleButton("hue", ImVec2{bars_width, sv_picker_size}, 0)
+		if g.IsItemActive() {
 			H = ImSaturate((io.MousePos.y - picker_pos.y) / (sv_picker_size - 1))
 			value_changed = true
 			value_changed_h = true
@@ -501,31 +496,31 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 
 	// Alpha bar logic
 	if alpha_bar {
-		SetCursorScreenPos(ImVec2{bar1_pos_x, picker_pos.y})
-		InvisibleButton("alpha", ImVec2{bars_width, sv_picker_size}, 0)
-		if IsItemActive() {
+		g.SetCursorScreenPos(ImVec2{bar1_pos_x, picker_pos.y})
+		g.InvisibleButton("alpha", ImVec2{bars_width, sv_picker_size}, 0)
+		if g.IsItemActive() {
 			col[3] = 1.0 - ImSaturate((io.MousePos.y-picker_pos.y)/(sv_picker_size-1))
 			value_changed = true
 		}
 	}
-	PopItemFlag() // ImGuiItemFlags_NoNav
+	g.PopItemFlag() // ImGuiItemFlags_NoNav
 
 	if flags&ImGuiColorEditFlags_NoSidePreview == 0 {
-		SameLine(0, style.ItemInnerSpacing.x)
-		BeginGroup()
+		g.SameLine(0, style.ItemInnerSpacing.x)
+		g.BeginGroup()
 	}
 
 	if flags&ImGuiColorEditFlags_NoLabel == 0 {
 		if len(label) > 0 {
 			if (flags & ImGuiColorEditFlags_NoSidePreview) != 0 {
-				SameLine(0, style.ItemInnerSpacing.x)
+				g.SameLine(0, style.ItemInnerSpacing.x)
 			}
-			TextEx(label, 0)
+			g.TextEx(label, 0)
 		}
 	}
 
 	if flags&ImGuiColorEditFlags_NoSidePreview == 0 {
-		PushItemFlag(ImGuiItemFlags_NoNavDefaultFocus, true)
+		g.PushItemFlag(ImGuiItemFlags_NoNavDefaultFocus, true)
 
 		a := col[3]
 		if (flags & ImGuiColorEditFlags_NoAlpha) != 0 {
@@ -534,13 +529,13 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 
 		var col_v4 = ImVec4{col[0], col[1], col[2], a}
 		if flags&ImGuiColorEditFlags_NoLabel != 0 {
-			Text("Current")
+			g.Text("Current")
 		}
 
 		var sub_flags_to_forward = ImGuiColorEditFlags_InputMask_ | ImGuiColorEditFlags_HDR | ImGuiColorEditFlags_AlphaPreview | ImGuiColorEditFlags_AlphaPreviewHalf | ImGuiColorEditFlags_NoTooltip
-		ColorButton("##current", col_v4, (flags & sub_flags_to_forward), ImVec2{square_sz * 3, square_sz * 2})
+		g.ColorButton("##current", col_v4, (flags & sub_flags_to_forward), ImVec2{square_sz * 3, square_sz * 2})
 		if ref_col != nil {
-			Text("Original")
+			g.Text("Original")
 
 			a := ref_col[3]
 			if (flags & ImGuiColorEditFlags_NoAlpha) != 0 {
@@ -548,13 +543,13 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 			}
 
 			var ref_col_v4 = ImVec4{ref_col[0], ref_col[1], ref_col[2], a}
-			if ColorButton("##original", ref_col_v4, (flags & sub_flags_to_forward), ImVec2{square_sz * 3, square_sz * 2}) {
+			if g.ColorButton("##original", ref_col_v4, (flags & sub_flags_to_forward), ImVec2{square_sz * 3, square_sz * 2}) {
 				copy(col[:], ref_col[:components])
 				value_changed = true
 			}
 		}
-		PopItemFlag()
-		EndGroup()
+		g.PopItemFlag()
+		g.EndGroup()
 	}
 
 	// Convert back color to RGB
@@ -593,11 +588,11 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 			bar = bar1_pos_x
 		}
 
-		PushItemWidth(bar + bars_width - picker_pos.x)
+		g.PushItemWidth(bar + bars_width - picker_pos.x)
 		var sub_flags_to_forward = ImGuiColorEditFlags_DataTypeMask_ | ImGuiColorEditFlags_InputMask_ | ImGuiColorEditFlags_HDR | ImGuiColorEditFlags_NoAlpha | ImGuiColorEditFlags_NoOptions | ImGuiColorEditFlags_NoSmallPreview | ImGuiColorEditFlags_AlphaPreview | ImGuiColorEditFlags_AlphaPreviewHalf
 		var sub_flags = (flags & sub_flags_to_forward) | ImGuiColorEditFlags_NoPicker
 		if flags&ImGuiColorEditFlags_DisplayRGB != 0 || (flags&ImGuiColorEditFlags_DisplayMask_) == 0 {
-			if ColorEdit4("##rgb", col, sub_flags|ImGuiColorEditFlags_DisplayRGB) {
+			if g.ColorEdit4("##rgb", col, sub_flags|ImGuiColorEditFlags_DisplayRGB) {
 				// FIXME: Hackily differentiating using the DragInt (ActiveId != 0 && !ActiveIdAllowOverlap) vs. using the InputText or DropTarget.
 				// For the later we don't want to run the hue-wrap canceling code. If you are well versed in HSV picker please provide your input! (See #2050)
 				value_changed_fix_hue_wrap = (g.ActiveId != 0 && !g.ActiveIdAllowOverlap)
@@ -605,12 +600,12 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 			}
 		}
 		if flags&ImGuiColorEditFlags_DisplayHSV != 0 || (flags&ImGuiColorEditFlags_DisplayMask_) == 0 {
-			value_changed = value_changed || (ColorEdit4("##hsv", col, sub_flags|ImGuiColorEditFlags_DisplayHSV))
+			value_changed = value_changed || (g.ColorEdit4("##hsv", col, sub_flags|ImGuiColorEditFlags_DisplayHSV))
 		}
 		if flags&ImGuiColorEditFlags_DisplayHex != 0 || (flags&ImGuiColorEditFlags_DisplayMask_) == 0 {
-			value_changed = value_changed || (ColorEdit4("##hex", col, sub_flags|ImGuiColorEditFlags_DisplayHex))
+			value_changed = value_changed || (g.ColorEdit4("##hex", col, sub_flags|ImGuiColorEditFlags_DisplayHex))
 		}
-		PopItemWidth()
+		g.PopItemWidth()
 	}
 
 	// Try to cancel hue wrap (after ColorEdit4 call), if any
@@ -706,7 +701,7 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 		var tra = wheel_center.Add(*ImRotate(&triangle_pa, cos_hue_angle, sin_hue_angle))
 		var trb = wheel_center.Add(*ImRotate(&triangle_pb, cos_hue_angle, sin_hue_angle))
 		var trc = wheel_center.Add(*ImRotate(&triangle_pc, cos_hue_angle, sin_hue_angle))
-		var uv_white = GetFontTexUvWhitePixel()
+		var uv_white = g.GetFontTexUvWhitePixel()
 		draw_list.PrimReserve(6, 6)
 		draw_list.PrimVtx(tra, &uv_white, hue_color32)
 		draw_list.PrimVtx(trb, &uv_white, hue_color32)
@@ -721,7 +716,7 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 		// Render SV Square
 		draw_list.AddRectFilledMultiColor(picker_pos, picker_pos.Add(ImVec2{sv_picker_size, sv_picker_size}), col_white, hue_color32, hue_color32, col_white)
 		draw_list.AddRectFilledMultiColor(picker_pos, picker_pos.Add(ImVec2{sv_picker_size, sv_picker_size}), 0, 0, col_black, col_black)
-		RenderFrameBorder(picker_pos, picker_pos.Add(ImVec2{sv_picker_size, sv_picker_size}), 0.0)
+		g.RenderFrameBorder(picker_pos, picker_pos.Add(ImVec2{sv_picker_size, sv_picker_size}), 0.0)
 		sv_cursor_pos.x = ImClamp(IM_ROUND(picker_pos.x+ImSaturate(S)*sv_picker_size), picker_pos.x+2, picker_pos.x+sv_picker_size-2) // Sneakily prevent the circle to stick out too much
 		sv_cursor_pos.y = ImClamp(IM_ROUND(picker_pos.y+ImSaturate(1-V)*sv_picker_size), picker_pos.y+2, picker_pos.y+sv_picker_size-2)
 
@@ -730,7 +725,7 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 			draw_list.AddRectFilledMultiColor(ImVec2{bar0_pos_x, picker_pos.y + float(i)*(sv_picker_size/6)}, ImVec2{bar0_pos_x + bars_width, picker_pos.y + float(i+1)*(sv_picker_size/6)}, col_hues[i], col_hues[i], col_hues[i+1], col_hues[i+1])
 		}
 		var bar0_line_y = IM_ROUND(picker_pos.y + H*sv_picker_size)
-		RenderFrameBorder(ImVec2{bar0_pos_x, picker_pos.y}, ImVec2{bar0_pos_x + bars_width, picker_pos.y + sv_picker_size}, 0.0)
+		g.RenderFrameBorder(ImVec2{bar0_pos_x, picker_pos.y}, ImVec2{bar0_pos_x + bars_width, picker_pos.y + sv_picker_size}, 0.0)
 		RenderArrowsForVerticalBar(draw_list, ImVec2{bar0_pos_x - 1, bar0_line_y}, ImVec2{bars_triangles_half_sz + 1, bars_triangles_half_sz}, bars_width+2.0, style.Alpha)
 	}
 
@@ -747,23 +742,23 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 	if alpha_bar {
 		var alpha = ImSaturate(col[3])
 		var bar1_bb = ImRect{ImVec2{bar1_pos_x, picker_pos.y}, ImVec2{bar1_pos_x + bars_width, picker_pos.y + sv_picker_size}}
-		RenderColorRectWithAlphaCheckerboard(draw_list, bar1_bb.Min, bar1_bb.Max, 0, bar1_bb.GetWidth()/2.0, ImVec2{}, 0, 0)
+		g.RenderColorRectWithAlphaCheckerboard(draw_list, bar1_bb.Min, bar1_bb.Max, 0, bar1_bb.GetWidth()/2.0, ImVec2{}, 0, 0)
 		draw_list.AddRectFilledMultiColor(bar1_bb.Min, bar1_bb.Max, user_col32_striped_of_alpha, user_col32_striped_of_alpha, user_col32_striped_of_alpha&^IM_COL32_A_MASK, user_col32_striped_of_alpha&^IM_COL32_A_MASK)
 		var bar1_line_y = IM_ROUND(picker_pos.y + (1.0-alpha)*sv_picker_size)
-		RenderFrameBorder(bar1_bb.Min, bar1_bb.Max, 0.0)
+		g.RenderFrameBorder(bar1_bb.Min, bar1_bb.Max, 0.0)
 		RenderArrowsForVerticalBar(draw_list, ImVec2{bar1_pos_x - 1, bar1_line_y}, ImVec2{bars_triangles_half_sz + 1, bars_triangles_half_sz}, bars_width+2.0, style.Alpha)
 	}
 
-	EndGroup()
+	g.EndGroup()
 
 	if value_changed && backup_initial_col == [4]float{col[0], col[1], col[2], col[3]} {
 		value_changed = false
 	}
 	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
+		g.MarkItemEdited(g.LastItemData.ID)
 	}
 
-	PopID()
+	g.PopID()
 
 	return value_changed
 }
@@ -773,15 +768,14 @@ func ColorPicker4(label string, col *[4]float, flags ImGuiColorEditFlags, ref_co
 // FIXME: May want to display/ignore the alpha component in the color display? Yet show it in the tooltip.
 // 'desc_id' is not called 'label' because we don't display it next to the button, but only in the tooltip.
 // Note that 'col' may be encoded in HSV if ImGuiColorEditFlags_InputHSV is set.
-func ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImVec2) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImVec2) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var id = window.GetIDs(desc_id)
-	var default_size = GetFrameHeight()
+	var default_size = g.GetFrameHeight()
 	if size.x == 0.0 {
 		size.x = default_size
 	}
@@ -795,13 +789,13 @@ func ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImV
 		padding = g.Style.FramePadding.y
 	}
 
-	ItemSizeRect(&bb, padding)
-	if !ItemAdd(&bb, id, nil, 0) {
+	g.ItemSizeRect(&bb, padding)
+	if !g.ItemAdd(&bb, id, nil, 0) {
 		return false
 	}
 
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, 0)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, 0)
 
 	if flags&ImGuiColorEditFlags_NoAlpha != 0 {
 		flags &= ^(ImGuiColorEditFlags_AlphaPreview | ImGuiColorEditFlags_AlphaPreviewHalf)
@@ -823,8 +817,8 @@ func ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImV
 	}
 	if (flags&ImGuiColorEditFlags_AlphaPreviewHalf) != 0 && col_rgb.w < 1.0 {
 		var mid_x = IM_ROUND((bb_inner.Min.x + bb_inner.Max.x) * 0.5)
-		RenderColorRectWithAlphaCheckerboard(window.DrawList, ImVec2{bb_inner.Min.x + grid_step, bb_inner.Min.y}, bb_inner.Max, GetColorU32FromVec(col_rgb), grid_step, ImVec2{-grid_step + off, off}, rounding, ImDrawFlags_RoundCornersRight)
-		window.DrawList.AddRectFilled(bb_inner.Min, ImVec2{mid_x, bb_inner.Max.y}, GetColorU32FromVec(col_rgb_without_alpha), rounding, ImDrawFlags_RoundCornersLeft)
+		g.RenderColorRectWithAlphaCheckerboard(window.DrawList, ImVec2{bb_inner.Min.x + grid_step, bb_inner.Min.y}, bb_inner.Max, g.GetColorU32FromVec(col_rgb), grid_step, ImVec2{-grid_step + off, off}, rounding, ImDrawFlags_RoundCornersRight)
+		window.DrawList.AddRectFilled(bb_inner.Min, ImVec2{mid_x, bb_inner.Max.y}, g.GetColorU32FromVec(col_rgb_without_alpha), rounding, ImDrawFlags_RoundCornersLeft)
 	} else {
 		// Because GetColorU32() multiplies by the global style Alpha and we don't want to display a checkerboard if the source code had no alpha
 		var col_source = col_rgb_without_alpha
@@ -832,37 +826,37 @@ func ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImV
 			col_source = col_rgb
 		}
 		if col_source.w < 1.0 {
-			RenderColorRectWithAlphaCheckerboard(window.DrawList, bb_inner.Min, bb_inner.Max, GetColorU32FromVec(col_source), grid_step, ImVec2{off, off}, rounding, 0)
+			g.RenderColorRectWithAlphaCheckerboard(window.DrawList, bb_inner.Min, bb_inner.Max, g.GetColorU32FromVec(col_source), grid_step, ImVec2{off, off}, rounding, 0)
 		} else {
-			window.DrawList.AddRectFilled(bb_inner.Min, bb_inner.Max, GetColorU32FromVec(col_source), rounding, 0)
+			window.DrawList.AddRectFilled(bb_inner.Min, bb_inner.Max, g.GetColorU32FromVec(col_source), rounding, 0)
 		}
 	}
-	RenderNavHighlight(&bb, id, 0)
+	g.RenderNavHighlight(&bb, id, 0)
 	if (flags & ImGuiColorEditFlags_NoBorder) == 0 {
 		if g.Style.FrameBorderSize > 0.0 {
-			RenderFrameBorder(bb.Min, bb.Max, rounding)
+			g.RenderFrameBorder(bb.Min, bb.Max, rounding)
 		} else {
-			window.DrawList.AddRect(bb.Min, bb.Max, GetColorU32FromID(ImGuiCol_FrameBg, 1), rounding, 0, 1) // Color button are often in need of some sort of border
+			window.DrawList.AddRect(bb.Min, bb.Max, g.GetColorU32FromID(ImGuiCol_FrameBg, 1), rounding, 0, 1) // Color button are often in need of some sort of border
 		}
 	}
 
 	// Drag and Drop Source
 	// NB: The ActiveId test is merely an optional micro-optimization, BeginDragDropSource() does the same test.
-	if g.ActiveId == id && (flags&ImGuiColorEditFlags_NoDragDrop) == 0 && BeginDragDropSource(0) {
+	if g.ActiveId == id && (flags&ImGuiColorEditFlags_NoDragDrop) == 0 && g.BeginDragDropSource(0) {
 		if flags&ImGuiColorEditFlags_NoAlpha != 0 {
-			SetDragDropPayload(IMGUI_PAYLOAD_TYPE_COLOR_3F, &col_rgb, unsafe.Sizeof(float(0))*3, ImGuiCond_Once)
+			SetDragDropPayloadTContext(g, IMGUI_PAYLOAD_TYPE_COLOR_3F, [3]float{col_rgb.x, col_rgb.y, col_rgb.z}, ImGuiCond_Once)
 		} else {
-			SetDragDropPayload(IMGUI_PAYLOAD_TYPE_COLOR_4F, &col_rgb, unsafe.Sizeof(float(0))*4, ImGuiCond_Once)
+			SetDragDropPayloadTContext(g, IMGUI_PAYLOAD_TYPE_COLOR_4F, [4]float{col_rgb.x, col_rgb.y, col_rgb.z, col_rgb.w}, ImGuiCond_Once)
 		}
-		ColorButton(desc_id, col, flags, ImVec2{})
-		SameLine(0, 0)
-		TextEx("Color", 0)
-		EndDragDropSource()
+		g.ColorButton(desc_id, col, flags, ImVec2{})
+		g.SameLine(0, 0)
+		g.TextEx("Color", 0)
+		g.EndDragDropSource()
 	}
 
 	// Tooltip
 	if flags&ImGuiColorEditFlags_NoTooltip == 0 && hovered {
-		ColorTooltip(desc_id, [4]float32{col.x, col.y, col.z, col.w}, flags&(ImGuiColorEditFlags_InputMask_|ImGuiColorEditFlags_NoAlpha|ImGuiColorEditFlags_AlphaPreview|ImGuiColorEditFlags_AlphaPreviewHalf))
+		g.ColorTooltip(desc_id, [4]float32{col.x, col.y, col.z, col.w}, flags&(ImGuiColorEditFlags_InputMask_|ImGuiColorEditFlags_NoAlpha|ImGuiColorEditFlags_AlphaPreview|ImGuiColorEditFlags_AlphaPreviewHalf))
 	}
 
 	return pressed
@@ -870,8 +864,7 @@ func ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImV
 
 // initialize current options (generally on application startup) if you want to select a default format, picker type, etc.
 // User will be able to change many settings, unless you pass the _NoOptions flag to your calls.
-func SetColorEditOptions(flags ImGuiColorEditFlags) {
-	var g = GImGui
+func (g *ImGuiContext) SetColorEditOptions(flags ImGuiColorEditFlags) {
 	if (flags & ImGuiColorEditFlags_DisplayMask_) == 0 {
 		flags |= ImGuiColorEditFlags_DefaultOptions_ & ImGuiColorEditFlags_DisplayMask_
 	}
@@ -893,13 +886,11 @@ func SetColorEditOptions(flags ImGuiColorEditFlags) {
 
 // Color
 // Note: only access 3 floats if ImGuiColorEditFlags_NoAlpha flag is set.
-func ColorTooltip(text string, col [4]float, flags ImGuiColorEditFlags) {
-	var g = GImGui
-
-	BeginTooltipEx(0, ImGuiTooltipFlags_OverridePreviousTooltip)
+func (g *ImGuiContext) ColorTooltip(text string, col [4]float, flags ImGuiColorEditFlags) {
+	g.BeginTooltipEx(0, ImGuiTooltipFlags_OverridePreviousTooltip)
 	if len(text) > 0 {
-		TextEx(text, 0)
-		Separator()
+		g.TextEx(text, 0)
+		g.Separator()
 	}
 
 	var a = col[3]
@@ -913,64 +904,63 @@ func ColorTooltip(text string, col [4]float, flags ImGuiColorEditFlags) {
 	if (flags & ImGuiColorEditFlags_NoAlpha) != 0 {
 		ca = 255
 	}
-	ColorButton("##preview", cf, (flags&(ImGuiColorEditFlags_InputMask_|ImGuiColorEditFlags_NoAlpha|ImGuiColorEditFlags_AlphaPreview|ImGuiColorEditFlags_AlphaPreviewHalf))|ImGuiColorEditFlags_NoTooltip, sz)
-	SameLine(0, 0)
+	g.ColorButton("##preview", cf, (flags&(ImGuiColorEditFlags_InputMask_|ImGuiColorEditFlags_NoAlpha|ImGuiColorEditFlags_AlphaPreview|ImGuiColorEditFlags_AlphaPreviewHalf))|ImGuiColorEditFlags_NoTooltip, sz)
+	g.SameLine(0, 0)
 	if (flags&ImGuiColorEditFlags_InputRGB) != 0 || (flags&ImGuiColorEditFlags_InputMask_) == 0 {
 		if (flags & ImGuiColorEditFlags_NoAlpha) != 0 {
-			Text("#%02X%02X%02X\nR: %d, G: %d, B: %d\n(%.3f, %.3f, %.3f)", cr, cg, cb, cr, cg, cb, col[0], col[1], col[2])
+			g.Text("#%02X%02X%02X\nR: %d, G: %d, B: %d\n(%.3f, %.3f, %.3f)", cr, cg, cb, cr, cg, cb, col[0], col[1], col[2])
 		} else {
-			Text("#%02X%02X%02X%02X\nR:%d, G:%d, B:%d, A:%d\n(%.3f, %.3f, %.3f, %.3f)", cr, cg, cb, ca, cr, cg, cb, ca, col[0], col[1], col[2], col[3])
+			g.Text("#%02X%02X%02X%02X\nR:%d, G:%d, B:%d, A:%d\n(%.3f, %.3f, %.3f, %.3f)", cr, cg, cb, ca, cr, cg, cb, ca, col[0], col[1], col[2], col[3])
 		}
 	} else if flags&ImGuiColorEditFlags_InputHSV != 0 {
 		if flags&ImGuiColorEditFlags_NoAlpha != 0 {
-			Text("H: %.3f, S: %.3f, V: %.3f", col[0], col[1], col[2])
+			g.Text("H: %.3f, S: %.3f, V: %.3f", col[0], col[1], col[2])
 		} else {
-			Text("H: %.3f, S: %.3f, V: %.3f, A: %.3f", col[0], col[1], col[2], col[3])
+			g.Text("H: %.3f, S: %.3f, V: %.3f, A: %.3f", col[0], col[1], col[2], col[3])
 		}
 	}
-	EndTooltip()
+	g.EndTooltip()
 }
 
-func ColorEditOptionsPopup(col [4]float, flags ImGuiColorEditFlags) {
+func (g *ImGuiContext) ColorEditOptionsPopup(col [4]float, flags ImGuiColorEditFlags) {
 	var allow_opt_inputs = (flags & ImGuiColorEditFlags_DisplayMask_) == 0
 	var allow_opt_datatype = (flags & ImGuiColorEditFlags_DataTypeMask_) == 0
-	if (!allow_opt_inputs && !allow_opt_datatype) || !BeginPopup("context", 0) {
+	if (!allow_opt_inputs && !allow_opt_datatype) || !g.BeginPopup("context", 0) {
 		return
 	}
-	var g = GImGui
 	var opts = g.ColorEditOptions
 	if allow_opt_inputs {
-		if RadioButtonBool("RGB", (opts&ImGuiColorEditFlags_DisplayRGB) != 0) {
+		if g.RadioButtonBool("RGB", (opts&ImGuiColorEditFlags_DisplayRGB) != 0) {
 			opts = (opts & ^ImGuiColorEditFlags_DisplayMask_) | ImGuiColorEditFlags_DisplayRGB
 		}
-		if RadioButtonBool("HSV", (opts&ImGuiColorEditFlags_DisplayHSV) != 0) {
+		if g.RadioButtonBool("HSV", (opts&ImGuiColorEditFlags_DisplayHSV) != 0) {
 			opts = (opts & ^ImGuiColorEditFlags_DisplayMask_) | ImGuiColorEditFlags_DisplayHSV
 		}
-		if RadioButtonBool("Hex", (opts&ImGuiColorEditFlags_DisplayHex) != 0) {
+		if g.RadioButtonBool("Hex", (opts&ImGuiColorEditFlags_DisplayHex) != 0) {
 			opts = (opts & ^ImGuiColorEditFlags_DisplayMask_) | ImGuiColorEditFlags_DisplayHex
 		}
 	}
 	if allow_opt_datatype {
 		if allow_opt_inputs {
-			Separator()
+			g.Separator()
 		}
-		if RadioButtonBool("0..255", (opts&ImGuiColorEditFlags_Uint8) != 0) {
+		if g.RadioButtonBool("0..255", (opts&ImGuiColorEditFlags_Uint8) != 0) {
 			opts = (opts & ^ImGuiColorEditFlags_DataTypeMask_) | ImGuiColorEditFlags_Uint8
 		}
-		if RadioButtonBool("0.00..1.00", (opts&ImGuiColorEditFlags_Float) != 0) {
+		if g.RadioButtonBool("0.00..1.00", (opts&ImGuiColorEditFlags_Float) != 0) {
 			opts = (opts & ^ImGuiColorEditFlags_DataTypeMask_) | ImGuiColorEditFlags_Float
 		}
 	}
 
 	if allow_opt_inputs || allow_opt_datatype {
-		Separator()
+		g.Separator()
 	}
 
-	if (ButtonEx("Copy as..", &ImVec2{-1, 0}, 0)) {
-		OpenPopup("Copy", 0)
+	if (g.ButtonEx("Copy as..", &ImVec2{-1, 0}, 0)) {
+		g.OpenPopup("Copy", 0)
 	}
 
-	if BeginPopup("Copy", 0) {
+	if g.BeginPopup("Copy", 0) {
 		var cr, cg, cb, ca = IM_F32_TO_INT8_SAT(col[0]), IM_F32_TO_INT8_SAT(col[1]), IM_F32_TO_INT8_SAT(col[2]), IM_F32_TO_INT8_SAT(col[3])
 		if flags&ImGuiColorEditFlags_NoAlpha != 0 {
 			ca = 255
@@ -982,46 +972,45 @@ func ColorEditOptionsPopup(col [4]float, flags ImGuiColorEditFlags) {
 		}
 
 		var buf = fmt.Sprintf("%v,%v,%v,%v", col[0], col[1], col[2], a)
-		if Selectable(buf, false, 0, ImVec2{}) {
-			SetClipboardText(buf)
+		if g.Selectable(buf, false, 0, ImVec2{}) {
+			g.SetClipboardText(buf)
 		}
 		buf = fmt.Sprintf("%v,%v,%v,%v", cr, cg, cb, ca)
-		if Selectable(buf, false, 0, ImVec2{}) {
-			SetClipboardText(buf)
+		if g.Selectable(buf, false, 0, ImVec2{}) {
+			g.SetClipboardText(buf)
 		}
 		buf = fmt.Sprintf("%v,%v,%v", cr, cg, cb)
-		if Selectable(buf, false, 0, ImVec2{}) {
-			SetClipboardText(buf)
+		if g.Selectable(buf, false, 0, ImVec2{}) {
+			g.SetClipboardText(buf)
 		}
 		if (flags & ImGuiColorEditFlags_NoAlpha) == 0 {
 			buf = fmt.Sprintf("%v,%v,%v,%v", cr, cg, cb, ca)
-			if Selectable(buf, false, 0, ImVec2{}) {
-				SetClipboardText(buf)
+			if g.Selectable(buf, false, 0, ImVec2{}) {
+				g.SetClipboardText(buf)
 			}
 		}
-		EndPopup()
+		g.EndPopup()
 	}
 
 	g.ColorEditOptions = opts
-	EndPopup()
+	g.EndPopup()
 }
 
-func ColorPickerOptionsPopup(ref_col *[4]float, flags ImGuiColorEditFlags) {
+func (g *ImGuiContext) ColorPickerOptionsPopup(ref_col *[4]float, flags ImGuiColorEditFlags) {
 	var allow_opt_picker = flags&ImGuiColorEditFlags_PickerMask_ == 0
 	var allow_opt_alpha_bar = flags&ImGuiColorEditFlags_NoAlpha == 0 && flags&ImGuiColorEditFlags_AlphaBar == 0
-	if (!allow_opt_picker && !allow_opt_alpha_bar) || !BeginPopup("context", 0) {
+	if (!allow_opt_picker && !allow_opt_alpha_bar) || !g.BeginPopup("context", 0) {
 		return
 	}
-	var g = GImGui
 	if allow_opt_picker {
-		var picker_size = ImVec2{g.FontSize * 8, ImMax(g.FontSize*8-(GetFrameHeight()+g.Style.ItemInnerSpacing.x), 1.0)} // FIXME: Picker size copied from main picker function
-		PushItemWidth(picker_size.x)
+		var picker_size = ImVec2{g.FontSize * 8, ImMax(g.FontSize*8-(g.GetFrameHeight()+g.Style.ItemInnerSpacing.x), 1.0)} // FIXME: Picker size copied from main picker function
+		g.PushItemWidth(picker_size.x)
 		for picker_type := 0; picker_type < 2; picker_type++ {
 			// Draw small/thumbnail version of each picker type (over an invisible button for selection)
 			if picker_type > 0 {
-				Separator()
+				g.Separator()
 			}
-			PushInterface(picker_type)
+			g.PushInterface(picker_type)
 			var picker_flags = ImGuiColorEditFlags_NoInputs | ImGuiColorEditFlags_NoOptions | ImGuiColorEditFlags_NoLabel | ImGuiColorEditFlags_NoSidePreview | (flags & ImGuiColorEditFlags_NoAlpha)
 			if picker_type == 0 {
 				picker_flags |= ImGuiColorEditFlags_PickerHueBar
@@ -1029,11 +1018,11 @@ func ColorPickerOptionsPopup(ref_col *[4]float, flags ImGuiColorEditFlags) {
 			if picker_type == 1 {
 				picker_flags |= ImGuiColorEditFlags_PickerHueWheel
 			}
-			var backup_pos = GetCursorScreenPos()
-			if Selectable("##selectable", false, 0, picker_size) { // By default, Selectable() is closing popup
+			var backup_pos = g.GetCursorScreenPos()
+			if g.Selectable("##selectable", false, 0, picker_size) { // By default, Selectable() is closing popup
 				g.ColorEditOptions = (g.ColorEditOptions & ^ImGuiColorEditFlags_PickerMask_) | (picker_flags & ImGuiColorEditFlags_PickerMask_)
 			}
-			SetCursorScreenPos(backup_pos)
+			g.SetCursorScreenPos(backup_pos)
 			var previewing_ref_col [4]float32
 
 			var components int = 4
@@ -1042,16 +1031,16 @@ func ColorPickerOptionsPopup(ref_col *[4]float, flags ImGuiColorEditFlags) {
 			}
 
 			copy(previewing_ref_col[:], ref_col[:components])
-			ColorPicker4("##previewing_picker", &previewing_ref_col, picker_flags, nil)
-			PopID()
+			g.ColorPicker4("##previewing_picker", &previewing_ref_col, picker_flags, nil)
+			g.PopID()
 		}
-		PopItemWidth()
+		g.PopItemWidth()
 	}
 	if allow_opt_alpha_bar {
 		if allow_opt_picker {
-			Separator()
+			g.Separator()
 		}
-		CheckboxFlagsInt("Alpha Bar", (*int)(&g.ColorEditOptions), int(ImGuiColorEditFlags_AlphaBar))
+		g.CheckboxFlagsInt("Alpha Bar", (*int)(&g.ColorEditOptions), int(ImGuiColorEditFlags_AlphaBar))
 	}
-	EndPopup()
+	g.EndPopup()
 }
@@ -6,9 +6,8 @@ import "fmt"
 // - The BeginCombo()/EndCombo() api allows you to manage your contents and selection state however you want it, by creating e.g. Selectable() items.
 // - The old Combo() api are helpers over BeginCombo()/EndCombo() which are kept available for convenience purpose. This is analogous to how ListBox are created.
 
-func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool {
+	var window = g.GetCurrentWindow()
 
 	var backup_next_window_data_flags = g.NextWindowData.Flags
 	g.NextWindowData.ClearFlags() // We behave like Begin() and need to consume those values
@@ -22,12 +21,12 @@ func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool
 
 	var arrow_size float = 0.0
 	if (flags & ImGuiComboFlags_NoArrowButton) == 0 {
-		arrow_size = GetFrameHeight()
+		arrow_size = g.GetFrameHeight()
 	}
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 	var w = arrow_size
 	if (flags & ImGuiComboFlags_NoPreview) == 0 {
-		w = CalcItemWidth()
+		w = g.CalcItemWidth()
 	}
 	var bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(ImVec2{w, label_size.y + style.FramePadding.y*2.0})}
 
@@ -37,18 +36,18 @@ func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool
 	}
 
 	var total_bb = ImRect{bb.Min, bb.Max.Add(ImVec2{add, 0.0})}
-	ItemSizeRect(&total_bb, style.FramePadding.y)
-	if !ItemAdd(&total_bb, id, &bb, 0) {
+	g.ItemSizeRect(&total_bb, style.FramePadding.y)
+	if !g.ItemAdd(&total_bb, id, &bb, 0) {
 		return false
 	}
 
 	// Open on click
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, 0)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, 0)
 	var popup_id = ImHashStr("##ComboPopup", 0, id)
-	var popup_open = isPopupOpen(popup_id, ImGuiPopupFlags_None)
+	var popup_open = g.isPopupOpen(popup_id, ImGuiPopupFlags_None)
 	if (pressed || g.NavActivateId == id) && !popup_open {
-		OpenPopupEx(popup_id, ImGuiPopupFlags_None)
+		g.OpenPopupEx(popup_id, ImGuiPopupFlags_None)
 		popup_open = true
 	}
 
@@ -63,9 +62,9 @@ func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool
 	}
 
 	// Render shape
-	var frame_col = GetColorU32FromID(c, 1)
+	var frame_col = g.GetColorU32FromID(c, 1)
 	var value_x2 = ImMax(bb.Min.x, bb.Max.x-arrow_size)
-	RenderNavHighlight(&bb, id, 0)
+	g.RenderNavHighlight(&bb, id, 0)
 	if flags&ImGuiComboFlags_NoPreview == 0 {
 		window.DrawList.AddRectFilled(bb.Min, ImVec2{value_x2, bb.Max.y}, frame_col, style.FrameRounding, rounding)
 	}
@@ -75,8 +74,8 @@ func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool
 			c = ImGuiCol_ButtonHovered
 		}
 
-		var bg_col = GetColorU32FromID(c, 1)
-		var text_col = GetColorU32FromID(ImGuiCol_Text, 1)
+		var bg_col = g.GetColorU32FromID(c, 1)
+		var text_col = g.GetColorU32FromID(ImGuiCol_Text, 1)
 
 		var rounding = ImDrawFlags_RoundCornersRight
 		if w <= arrow_size {
@@ -88,7 +87,7 @@ func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool
 			RenderArrow(window.DrawList, ImVec2{value_x2 + style.FramePadding.y, bb.Min.y + style.FramePadding.y}, text_col, ImGuiDir_Down, 1.0)
 		}
 	}
-	RenderFrameBorder(bb.Min, bb.Max, style.FrameRounding)
+	g.RenderFrameBorder(bb.Min, bb.Max, style.FrameRounding)
 
 	// Custom preview
 	if flags&ImGuiComboFlags_CustomPreview != 0 {
@@ -100,27 +99,31 @@ func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool
 	// Render preview and label
 	if preview_value != "" && (flags&ImGuiComboFlags_NoPreview == 0) {
 		if g.LogEnabled {
-			LogSetNextTextDecoration("{", "}")
+			g.LogSetNextTextDecoration("{", "}")
 		}
 		min := bb.Min.Add(style.FramePadding)
-		RenderTextClipped(&min, &ImVec2{value_x2, bb.Max.y}, preview_value, nil, nil, nil)
+		g.RenderTextClipped(&min, &ImVec2{value_x2, bb.Max.y}, preview_value, nil, nil, nil)
 	}
 	if label_size.x > 0 {
-		RenderText(ImVec2{bb.Max.x + style.ItemInnerSpacing.x, bb.Min.y + style.FramePadding.y}, label, true)
+		g.RenderText(ImVec2{bb.Max.x + style.ItemInnerSpacing.x, bb.Min.y + style.FramePadding.y}, label, true)
 	}
 
+	var opened = ImGuiItemStatusFlags_None
+	if popup_open {
+		opened = ImGuiItemStatusFlags_Opened
+	}
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags|ImGuiItemStatusFlags_Openable|opened)
 	if !popup_open {
 		return false
 	}
 
 	g.NextWindowData.Flags = backup_next_window_data_flags
-	return BeginComboPopup(popup_id, &bb, flags)
+	return g.BeginComboPopup(popup_id, &bb, flags)
 }
 
 // Call directly after the BeginCombo/EndCombo block. The preview is designed to only host non-interactive elements
 // (Experimental, see GitHub issues: #1658, #4168)
-func BeginComboPreview() bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginComboPreview() bool {
 	var window = g.CurrentWindow
 	var preview_data = &g.ComboPreviewData
 
@@ -141,13 +144,12 @@ func BeginComboPreview() bool {
 	window.DC.CursorPos = preview_data.PreviewRect.Min.Add(g.Style.FramePadding)
 	window.DC.CursorMaxPos = window.DC.CursorPos
 	window.DC.LayoutType = ImGuiLayoutType_Horizontal
-	PushClipRect(preview_data.PreviewRect.Min, preview_data.PreviewRect.Max, true)
+	g.PushClipRect(preview_data.PreviewRect.Min, preview_data.PreviewRect.Max, true)
 
 	return true
 }
 
-func EndComboPreview() {
-	var g = GImGui
+func (g *ImGuiContext) EndComboPreview() {
 	var window = g.CurrentWindow
 	var preview_data = &g.ComboPreviewData
 
@@ -160,7 +162,7 @@ func EndComboPreview() {
 			draw_list._TryMergeDrawCmds()
 		}
 	}
-	PopClipRect()
+	g.PopClipRect()
 	window.DC.CursorPos = preview_data.BackupCursorPos
 	window.DC.CursorMaxPos = ImMaxVec2(&window.DC.CursorMaxPos, &preview_data.BackupCursorMaxPos)
 	window.DC.CursorPosPrevLine = preview_data.BackupCursorPosPrevLine
@@ -169,9 +171,8 @@ func EndComboPreview() {
 	preview_data.PreviewRect = ImRect{}
 }
 
-func BeginComboPopup(popup_id ImGuiID, bb *ImRect, flags ImGuiComboFlags) bool {
-	var g = GImGui
-	if !isPopupOpen(popup_id, ImGuiPopupFlags_None) {
+func (g *ImGuiContext) BeginComboPopup(popup_id ImGuiID, bb *ImRect, flags ImGuiComboFlags) bool {
+	if !g.isPopupOpen(popup_id, ImGuiPopupFlags_None) {
 		g.NextWindowData.ClearFlags()
 		return false
 	}
@@ -193,7 +194,7 @@ func BeginComboPopup(popup_id ImGuiID, bb *ImRect, flags ImGuiComboFlags) bool {
 		} else if flags&ImGuiComboFlags_HeightLarge != 0 {
 			popup_max_height_in_items = 20
 		}
-		SetNextWindowSizeConstraints(ImVec2{w, 0.0}, ImVec2{FLT_MAX, CalcMaxPopupHeightFromItemCount(popup_max_height_in_items)}, nil, nil)
+		g.SetNextWindowSizeConstraints(ImVec2{w, 0.0}, ImVec2{FLT_MAX, g.CalcMaxPopupHeightFromItemCount(popup_max_height_in_items)}, nil, nil)
 	}
 
 	// This is essentially a specialized version of BeginPopupEx()
@@ -202,10 +203,10 @@ func BeginComboPopup(popup_id ImGuiID, bb *ImRect, flags ImGuiComboFlags) bool {
 	// Set position given a custom constraint (peak into expected window size so we can position it)
 	// FIXME: This might be easier to express with an hypothetical SetNextWindowPosConstraints() function?
 	// FIXME: This might be moved to Begin() or at least around the same spot where Tooltips and other Popups are calling FindBestWindowPosForPopupEx()?
-	if popup_window := FindWindowByName(string(name[:])); popup_window != nil {
+	if popup_window := g.FindWindowByName(string(name[:])); popup_window != nil {
 		if popup_window.WasActive {
 			// Always override 'AutoPosLastDirection' to not leave a chance for a past value to affect us.
-			var size_expected = CalcWindowNextAutoFitSize(popup_window)
+			var size_expected = g.CalcWindowNextAutoFitSize(popup_window)
 
 			dir := ImGuiDir_Down
 			if (flags & ImGuiComboFlags_PopupAlignLeft) != 0 {
@@ -213,20 +214,20 @@ func BeginComboPopup(popup_id ImGuiID, bb *ImRect, flags ImGuiComboFlags) bool {
 			}
 
 			popup_window.AutoPosLastDirection = dir // Left = "Below, Toward Left", Down = "Below, Toward Right (default)"
-			var r_outer = GetPopupAllowedExtentRect(popup_window)
+			var r_outer = g.GetPopupAllowedExtentRect(popup_window)
 			var bl = bb.GetBL()
 			var pos = FindBestWindowPosForPopupEx(&bl, &size_expected, &popup_window.AutoPosLastDirection, &r_outer, bb, ImGuiPopupPositionPolicy_ComboBox)
-			SetNextWindowPos(&pos, 0, ImVec2{})
+			g.SetNextWindowPos(&pos, 0, ImVec2{})
 		}
 	}
 
 	// We don't use BeginPopupEx() solely because we have a custom name string, which we could make an argument to BeginPopupEx()
 	var window_flags = ImGuiWindowFlags_AlwaysAutoResize | ImGuiWindowFlags_Popup | ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoResize | ImGuiWindowFlags_NoSavedSettings | ImGuiWindowFlags_NoMove
-	PushStyleVec(ImGuiStyleVar_WindowPadding, ImVec2{g.Style.FramePadding.x, g.Style.WindowPadding.y}) // Horizontally align ourselves with the framed text
-	var ret = Begin(string(name[:]), nil, window_flags)
-	PopStyleVar(1)
+	g.PushStyleVec(ImGuiStyleVar_WindowPadding, ImVec2{g.Style.FramePadding.x, g.Style.WindowPadding.y}) // Horizontally align ourselves with the framed text
+	var ret = g.Begin(string(name[:]), nil, window_flags)
+	g.PopStyleVar(1)
 	if !ret {
-		EndPopup()
+		g.EndPopup()
 		IM_ASSERT(false) // This should never happen as we tested for IsPopupOpen() above
 		return false
 	}
@@ -234,12 +235,11 @@ func BeginComboPopup(popup_id ImGuiID, bb *ImRect, flags ImGuiComboFlags) bool {
 }
 
 // only call EndCombo() if BeginCombo() returns true!
-func EndCombo() {
-	EndPopup()
+func (g *ImGuiContext) EndCombo() {
+	g.EndPopup()
 }
 
-func CalcMaxPopupHeightFromItemCount(items_count int) float32 {
-	var g = GImGui
+func (g *ImGuiContext) CalcMaxPopupHeightFromItemCount(items_count int) float32 {
 	if items_count <= 0 {
 		return FLT_MAX
 	}
@@ -247,8 +247,8 @@ func CalcMaxPopupHeightFromItemCount(items_count int) float32 {
 }
 
 // Combo box helper allowing to pass an array of strings.
-func Combo(label string, current_item *int, items []string, items_count int, popup_max_height_in_items int /*= -1*/) bool {
-	var value_changed = ComboFunc(label, current_item, func(slice any, idx int, val *string) bool {
+func (g *ImGuiContext) Combo(label string, current_item *int, items []string, items_count int, popup_max_height_in_items int /*= -1*/) bool {
+	var value_changed = g.ComboFunc(label, current_item, func(slice any, idx int, val *string) bool {
 		*val = slice.([]string)[idx]
 		return true
 	}, items, items_count, popup_max_height_in_items)
@@ -256,9 +256,7 @@ func Combo(label string, current_item *int, items []string, items_count int, pop
 }
 
 // Old API, prefer using BeginCombo() nowadays if you can.
-func ComboFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count, popup_max_height_in_items int /*= -1*/) bool {
-	var g = GImGui
-
+func (g *ImGuiContext) ComboFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count, popup_max_height_in_items int /*= -1*/) bool {
 	// Call the getter to obtain the preview string which is a parameter to BeginCombo()
 	var preview_value string
 	if *current_item >= 0 && *current_item < items_count {
@@ -267,10 +265,10 @@ func ComboFunc(label string, current_item *int, items_getter func(data any, idx
 
 	// The old Combo() API exposed "popup_max_height_in_items". The new more general BeginCombo() API doesn't have/need it, but we emulate it here.
 	if popup_max_height_in_items != -1 && (g.NextWindowData.Flags&ImGuiNextWindowDataFlags_HasSizeConstraint == 0) {
-		SetNextWindowSizeConstraints(ImVec2{}, ImVec2{FLT_MAX, CalcMaxPopupHeightFromItemCount(popup_max_height_in_items)}, nil, nil)
+		g.SetNextWindowSizeConstraints(ImVec2{}, ImVec2{FLT_MAX, g.CalcMaxPopupHeightFromItemCount(popup_max_height_in_items)}, nil, nil)
 	}
 
-	if !BeginCombo(label, preview_value, ImGuiComboFlags_None) {
+	if !g.BeginCombo(label, preview_value, ImGuiComboFlags_None) {
 		return false
 	}
 
@@ -278,26 +276,26 @@ func ComboFunc(label string, current_item *int, items_getter func(data any, idx
 	// FIXME-OPT: Use clipper (but we need to disable it on the appearing frame to make sure our call to SetItemDefaultFocus() is processed)
 	var value_changed = false
 	for i := int(0); i < items_count; i++ {
-		PushID(i)
+		g.PushID(i)
 		var item_selected = (i == *current_item)
 		var item_text string
 		if !items_getter(data, i, &item_text) {
 			item_text = "*Unknown item*"
 		}
-		if Selectable(item_text, item_selected, 0, ImVec2{}) {
+		if g.Selectable(item_text, item_selected, 0, ImVec2{}) {
 			value_changed = true
 			*current_item = i
 		}
 		if item_selected {
-			SetItemDefaultFocus()
+			g.SetItemDefaultFocus()
 		}
-		PopID()
+		g.PopID()
 	}
 
-	EndCombo()
+	g.EndCombo()
 
 	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
+		g.MarkItemEdited(g.LastItemData.ID)
 	}
 
 	return value_changed
@@ -3,7 +3,6 @@ package imgui
 import (
 	"fmt"
 	"math"
-	"reflect"
 	"strconv"
 	"strings"
 )
@@ -19,33 +18,32 @@ import (
 //   - We use the same sets of flags for DragXXX() and SliderXXX() functions as the features are the same and it makes it easier to swap them.
 //   - Legacy: Pre-1.78 there are DragXXX() function signatures that takes a final `power float=1.0' argument instead of the `ImGuiSliderFlags flags=0' argument.
 //     If you get a warning converting a to float ImGuiSliderFlags, read https://github.com/ocornut/imgui/issues/3361
-func DragFloat(label string, v *float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
-	return DragScalar(label, ImGuiDataType_Float, v, v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragFloat(label string, v *float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalar(label, ImGuiDataType_Float, v, v_speed, &v_min, &v_max, format, flags)
 }
 
-func DragFloat2(label string, v *[2]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
-	return DragScalarFloats(label, ImGuiDataType_Float, v[:], v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragFloat2(label string, v *[2]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalarFloats(label, ImGuiDataType_Float, v[:], v_speed, &v_min, &v_max, format, flags)
 }
 
-func DragFloat3(label string, v *[3]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
-	return DragScalarFloats(label, ImGuiDataType_Float, v[:], v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragFloat3(label string, v *[3]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalarFloats(label, ImGuiDataType_Float, v[:], v_speed, &v_min, &v_max, format, flags)
 }
 
-func DragFloat4(label string, v *[4]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
-	return DragScalarFloats(label, ImGuiDataType_Float, v[:], v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragFloat4(label string, v *[4]float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "%.3f"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalarFloats(label, ImGuiDataType_Float, v[:], v_speed, &v_min, &v_max, format, flags)
 }
 
 // NB: You likely want to specify the ImGuiSliderFlags_AlwaysClamp when using this.
-func DragFloatRange2(label string, v_current_min *float, v_current_max *float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "*/, format_max string, flags ImGuiSliderFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) DragFloatRange2(label string, v_current_min *float, v_current_max *float, v_speed float /*= 0*/, v_min float /*= 0*/, v_max float /*= 0*/, format string /*= "*/, format_max string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
-	PushString(label)
-	BeginGroup()
-	PushMultiItemsWidths(2, CalcItemWidth())
+	g.PushString(label)
+	g.BeginGroup()
+	g.PushMultiItemsWidths(2, g.CalcItemWidth())
 
 	var min_min = v_min
 	if v_min >= v_max {
@@ -59,9 +57,9 @@ func DragFloatRange2(label string, v_current_min *float, v_current_max *float, v
 	if min_min == min_max {
 		min_flags |= ImGuiSliderFlags_ReadOnly
 	}
-	var value_changed = DragScalar("##min", ImGuiDataType_Float, v_current_min, v_speed, &min_min, &min_max, format, min_flags)
-	PopItemWidth()
-	SameLine(0, g.Style.ItemInnerSpacing.x)
+	var value_changed = g.DragScalar("##min", ImGuiDataType_Float, v_current_min, v_speed, &min_min, &min_max, format, min_flags)
+	g.PopItemWidth()
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
 
 	var max_min = ImMax(v_min, *v_current_min)
 	if v_min >= v_max {
@@ -75,40 +73,39 @@ func DragFloatRange2(label string, v_current_min *float, v_current_max *float, v
 	if max_min == max_max {
 		max_flags |= ImGuiSliderFlags_ReadOnly
 	}
-	value_changed = DragScalar("##max", ImGuiDataType_Float, v_current_max, v_speed, &max_min, &max_max, format, max_flags) || value_changed
-	PopItemWidth()
-	SameLine(0, g.Style.ItemInnerSpacing.x)
+	value_changed = g.DragScalar("##max", ImGuiDataType_Float, v_current_max, v_speed, &max_min, &max_max, format, max_flags) || value_changed
+	g.PopItemWidth()
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
 
-	TextEx(label, 0)
-	EndGroup()
-	PopID()
+	g.TextEx(label, 0)
+	g.EndGroup()
+	g.PopID()
 
 	return value_changed
 }
 
-func DragInt(label string, v *int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
-	return DragScalarInt(label, ImGuiDataType_S32, v, v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragInt(label string, v *int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalarInt(label, ImGuiDataType_S32, v, v_speed, &v_min, &v_max, format, flags)
 }
 
-func DragInt2(label string, v [2]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
-	return DragScalarInts(label, ImGuiDataType_S32, v[:], v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragInt2(label string, v [2]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalarInts(label, ImGuiDataType_S32, v[:], v_speed, &v_min, &v_max, format, flags)
 }
-func DragInt3(label string, v [3]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
-	return DragScalarInts(label, ImGuiDataType_S32, v[:], v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragInt3(label string, v [3]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalarInts(label, ImGuiDataType_S32, v[:], v_speed, &v_min, &v_max, format, flags)
 }
-func DragInt4(label string, v [4]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
-	return DragScalarInts(label, ImGuiDataType_S32, v[:], v_speed, &v_min, &v_max, format, flags)
+func (g *ImGuiContext) DragInt4(label string, v [4]int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "%d"*/, flags ImGuiSliderFlags) bool {
+	return g.DragScalarInts(label, ImGuiDataType_S32, v[:], v_speed, &v_min, &v_max, format, flags)
 }
-func DragIntRange2(label string, v_current_min *int, v_current_max *int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "*/, format_max string, flags ImGuiSliderFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) DragIntRange2(label string, v_current_min *int, v_current_max *int, v_speed float /*= 0*/, v_min int /*= 0*/, v_max int /*= 0*/, format string /*= "*/, format_max string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
-	PushString(label)
-	BeginGroup()
-	PushMultiItemsWidths(2, CalcItemWidth())
+	g.PushString(label)
+	g.BeginGroup()
+	g.PushMultiItemsWidths(2, g.CalcItemWidth())
 
 	var min_min = v_min
 	if v_min >= v_max {
@@ -122,9 +119,9 @@ func DragIntRange2(label string, v_current_min *int, v_current_max *int, v_speed
 	if min_min == min_max {
 		min_flags |= ImGuiSliderFlags_ReadOnly
 	}
-	var value_changed = DragScalar("##min", ImGuiDataType_S32, v_current_min, v_speed, &min_min, &min_max, format, min_flags)
-	PopItemWidth()
-	SameLine(0, g.Style.ItemInnerSpacing.x)
+	var value_changed = g.DragScalar("##min", ImGuiDataType_S32, v_current_min, v_speed, &min_min, &min_max, format, min_flags)
+	g.PopItemWidth()
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
 
 	var max_min = ImMaxInt(v_min, *v_current_min)
 	if v_min >= v_max {
@@ -138,13 +135,13 @@ func DragIntRange2(label string, v_current_min *int, v_current_max *int, v_speed
 	if max_min == max_max {
 		max_flags |= ImGuiSliderFlags_ReadOnly
 	}
-	value_changed = DragScalar("##max", ImGuiDataType_S32, v_current_max, v_speed, &max_min, &max_max, format, max_flags) || value_changed
-	PopItemWidth()
-	SameLine(0, g.Style.ItemInnerSpacing.x)
+	value_changed = g.DragScalar("##max", ImGuiDataType_S32, v_current_max, v_speed, &max_min, &max_max, format, max_flags) || value_changed
+	g.PopItemWidth()
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
 
-	TextEx(label, 0)
-	EndGroup()
-	PopID()
+	g.TextEx(label, 0)
+	g.EndGroup()
+	g.PopID()
 
 	return value_changed
 }
@@ -163,18 +160,52 @@ func GetMinimumStepAtDecimalPrecision(decimal_precision int) float {
 
 // Note: p_data, p_min and p_max are _pointers_ to a memory address holding the data. For a Drag widget, p_min and p_max are optional.
 // Read code of e.g. DragFloat(), DragInt() etc. or examples in 'Demo.Widgets.Data Types' to understand how to use this function directly.
-func DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float /*= 0*/, p_min any /*= L*/, p_max any /*= L*/, format string, flags ImGuiSliderFlags) bool {
-	var window = GetCurrentWindow()
+// The pointers must be of the type of data_type (e.g. *int16 for ImGuiDataType_S16), DragT() checks this at compile time.
+func (g *ImGuiContext) DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float /*= 0*/, p_min any /*= L*/, p_max any /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	switch data_type {
+	case ImGuiDataType_S8:
+		return DragTContext(g, label, p_data.(*int8), v_speed, dataTypeMin[int8](p_min), dataTypeMax[int8](p_max), format, flags)
+	case ImGuiDataType_U8:
+		return DragTContext(g, label, p_data.(*uint8), v_speed, dataTypeMin[uint8](p_min), dataTypeMax[uint8](p_max), format, flags)
+	case ImGuiDataType_S16:
+		return DragTContext(g, label, p_data.(*int16), v_speed, dataTypeMin[int16](p_min), dataTypeMax[int16](p_max), format, flags)
+	case ImGuiDataType_U16:
+		return DragTContext(g, label, p_data.(*uint16), v_speed, dataTypeMin[uint16](p_min), dataTypeMax[uint16](p_max), format, flags)
+	case ImGuiDataType_S32:
+		return DragTContext(g, label, p_data.(*int32), v_speed, dataTypeMin[int32](p_min), dataTypeMax[int32](p_max), format, flags)
+	case ImGuiDataType_U32:
+		return DragTContext(g, label, p_data.(*uint32), v_speed, dataTypeMin[uint32](p_min), dataTypeMax[uint32](p_max), format, flags)
+	case ImGuiDataType_S64:
+		return DragTContext(g, label, p_data.(*int64), v_speed, dataTypeMin[int64](p_min), dataTypeMax[int64](p_max), format, flags)
+	case ImGuiDataType_U64:
+		return DragTContext(g, label, p_data.(*uint64), v_speed, dataTypeMin[uint64](p_min), dataTypeMax[uint64](p_max), format, flags)
+	case ImGuiDataType_Float:
+		return DragTContext(g, label, p_data.(*float32), v_speed, dataTypeMin[float32](p_min), dataTypeMax[float32](p_max), format, flags)
+	case ImGuiDataType_Double:
+		return DragTContext(g, label, p_data.(*float64), v_speed, dataTypeMin[float64](p_min), dataTypeMax[float64](p_max), format, flags)
+	}
+	IM_ASSERT(false)
+	return false
+}
+
+// DragT is DragScalar() for any of the ImGuiDataType types, e.g. DragT("Volume", &volume_u8, 1.0, 0, 100, "%d%%", 0).
+// Use v_min < v_max to clamp edits to given limits.
+func DragT[T ImGuiScalar](label string, v *T, v_speed float /*= 0*/, v_min T /*= 0*/, v_max T /*= 0*/, format string, flags ImGuiSliderFlags) bool {
+	return DragTContext[T](GImGui, label, v, v_speed, v_min, v_max, format, flags)
+}
+
+// DragTContext is DragT() for the context g.
+func DragTContext[T ImGuiScalar](g *ImGuiContext, label string, v *T, v_speed float /*= 0*/, v_min T /*= 0*/, v_max T /*= 0*/, format string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 	var id = window.GetIDs(label)
-	var w = CalcItemWidth()
+	var w = g.CalcItemWidth()
 
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 	var frame_bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(ImVec2{w, label_size.y + style.FramePadding.y*2.0})}
 
 	var padding float
@@ -185,33 +216,33 @@ func DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float
 	var total_bb = ImRect{frame_bb.Min, frame_bb.Max.Add(ImVec2{padding, 0.0})}
 
 	var temp_input_allowed = (flags & ImGuiSliderFlags_NoInput) == 0
-	ItemSizeRect(&total_bb, style.FramePadding.y)
+	g.ItemSizeRect(&total_bb, style.FramePadding.y)
 
 	var inputable_flags ImGuiItemFlags
 	if temp_input_allowed {
 		inputable_flags = ImGuiItemFlags_Inputable
 	}
 
-	if !ItemAdd(&total_bb, id, &frame_bb, inputable_flags) {
+	if !g.ItemAdd(&total_bb, id, &frame_bb, inputable_flags) {
 		return false
 	}
 
 	// Default format string when passing nil
 	if format == "" {
-		format = DataTypeGetInfo(data_type).PrintFmt
+		format = DataTypeGetInfo(DataTypeOf[T]()).PrintFmt
 	}
 
 	// Tabbing or CTRL-clicking on Drag turns it into an InputText
-	var hovered = ItemHoverable(&frame_bb, id)
-	var temp_input_is_active = temp_input_allowed && TempInputIsActive(id)
+	var hovered = g.ItemHoverable(&frame_bb, id)
+	var temp_input_is_active = temp_input_allowed && g.TempInputIsActive(id)
 	if !temp_input_is_active {
 		var focus_requested = temp_input_allowed && (g.LastItemData.StatusFlags&ImGuiItemStatusFlags_Focused) != 0
 		var clicked = (hovered && g.IO.MouseClicked[0])
 		var double_clicked = (hovered && g.IO.MouseDoubleClicked[0])
 		if focus_requested || clicked || double_clicked || g.NavActivateId == id || g.NavInputId == id {
-			SetActiveID(id, window)
-			SetFocusID(id, window)
-			FocusWindow(window)
+			g.SetActiveID(id, window)
+			g.SetFocusID(id, window)
+			g.FocusWindow(window)
 			g.ActiveIdUsingNavDirMask = (1 << ImGuiDir_Left) | (1 << ImGuiDir_Right)
 			if temp_input_allowed && (focus_requested || (clicked && g.IO.KeyCtrl) || double_clicked || g.NavInputId == id) {
 				temp_input_is_active = true
@@ -220,7 +251,7 @@ func DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float
 		// Experimental: simple click (without moving) turns Drag into an InputText
 		// FIXME: Currently polling ImGuiConfigFlags_IsTouchScreen, may either poll an hypothetical ImGuiBackendFlags_HasKeyboard and/or an explicit drag settings.
 		if g.IO.ConfigDragClickToInputText && temp_input_allowed && !temp_input_is_active {
-			if g.ActiveId == id && hovered && g.IO.MouseReleased[0] && !IsMouseDragPastThreshold(0, g.IO.MouseDragThreshold*DRAG_MOUSE_THRESHOLD_FACTOR) {
+			if g.ActiveId == id && hovered && g.IO.MouseReleased[0] && !g.IsMouseDragPastThreshold(0, g.IO.MouseDragThreshold*DRAG_MOUSE_THRESHOLD_FACTOR) {
 				g.NavInputId = id
 				temp_input_is_active = true
 			}
@@ -229,14 +260,14 @@ func DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float
 
 	if temp_input_is_active {
 		// Only clamp CTRL+Click input when ImGuiSliderFlags_AlwaysClamp is set
-		var is_clamp_input = (flags&ImGuiSliderFlags_AlwaysClamp) != 0 && (p_min == nil || p_max == nil || DataTypeCompare(data_type, p_min, p_max) < 0)
+		var is_clamp_input = (flags&ImGuiSliderFlags_AlwaysClamp) != 0 && v_min < v_max
 
-		var x, y any
+		var p_min, p_max *T
 		if is_clamp_input {
-			x, y = p_min, p_max
+			p_min, p_max = &v_min, &v_max
 		}
 
-		return TempInputScalar(&frame_bb, id, label, data_type, p_data, format, x, y)
+		return TempInputScalarT(g, &frame_bb, id, label, v, format, p_min, p_max)
 	}
 
 	var c = ImGuiCol_FrameBg
@@ -248,142 +279,174 @@ func DragScalar(label string, data_type ImGuiDataType, p_data any, v_speed float
 	}
 
 	// Draw frame
-	var frame_col = GetColorU32FromID(c, 1)
-	RenderNavHighlight(&frame_bb, id, 0)
-	RenderFrame(frame_bb.Min, frame_bb.Max, frame_col, true, style.FrameRounding)
+	var frame_col = g.GetColorU32FromID(c, 1)
+	g.RenderNavHighlight(&frame_bb, id, 0)
+	g.RenderFrame(frame_bb.Min, frame_bb.Max, frame_col, true, style.FrameRounding)
 
 	// Drag behavior
-	var value_changed = DragBehavior(id, data_type, p_data, v_speed, p_min, p_max, format, flags)
+	var value_changed = dragBehavior(g, id, v, v_speed, v_min, v_max, format, flags)
 	if value_changed {
-		MarkItemEdited(id)
+		g.MarkItemEdited(id)
 	}
 
 	// Display value using user-provided display format so user can add prefix/suffix/decorations to the value.
-	p_data_val := reflect.ValueOf(p_data).Elem() // get actual value of the interface, not a pointer
-	var value_buf = fmt.Sprintf(format, p_data_val)
+	var value_buf = DataTypeFormatStringT(*v, format)
 	if g.LogEnabled {
-		LogSetNextTextDecoration("{", "}")
+		g.LogSetNextTextDecoration("{", "}")
 	}
-	RenderTextClipped(&frame_bb.Min, &frame_bb.Max, value_buf, nil, &ImVec2{0.5, 0.5}, nil)
+	g.RenderTextClipped(&frame_bb.Min, &frame_bb.Max, value_buf, nil, &ImVec2{0.5, 0.5}, nil)
 
 	if label_size.x > 0.0 {
-		RenderText(ImVec2{frame_bb.Max.x + style.ItemInnerSpacing.x, frame_bb.Min.y + style.FramePadding.y}, label, true)
+		g.RenderText(ImVec2{frame_bb.Max.x + style.ItemInnerSpacing.x, frame_bb.Min.y + style.FramePadding.y}, label, true)
+	}
+
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags)
+	return value_changed
+}
+
+// DragNT edits the components of v with one DragT() each, on a single line.
+func DragNT[T ImGuiScalar](label string, v []T, v_speed float /*= 0*/, v_min T /*= 0*/, v_max T /*= 0*/, format string, flags ImGuiSliderFlags) bool {
+	return DragNTContext[T](GImGui, label, v, v_speed, v_min, v_max, format, flags)
+}
+
+// DragNTContext is DragNT() for the context g.
+func DragNTContext[T ImGuiScalar](g *ImGuiContext, label string, v []T, v_speed float /*= 0*/, v_min T /*= 0*/, v_max T /*= 0*/, format string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
+	if window.SkipItems {
+		return false
+	}
+
+	var value_changed = false
+	g.BeginGroup()
+	g.PushString(label)
+	g.PushMultiItemsWidths(int(len(v)), g.CalcItemWidth())
+	for i := range v {
+		g.PushID(int(i))
+		if i > 0 {
+			g.SameLine(0, g.Style.ItemInnerSpacing.x)
+		}
+		value_changed = DragTContext(g, "", &v[i], v_speed, v_min, v_max, format, flags) || value_changed
+		g.PopID()
+		g.PopItemWidth()
 	}
+	g.PopID()
+
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
+	g.TextEx(FindRenderedTextEnd(label), 0)
 
+	g.EndGroup()
 	return value_changed
 }
 
-func DragScalarFloat(label string, data_type ImGuiDataType, p_data *float, v_speed float /*= 0*/, p_min *float /*= L*/, p_max *float /*= L*/, format string, flags ImGuiSliderFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) DragScalarFloat(label string, data_type ImGuiDataType, p_data *float, v_speed float /*= 0*/, p_min *float /*= L*/, p_max *float /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(1, CalcItemWidth())
-	value_changed = DragScalar("", data_type, p_data, v_speed, p_min, p_max, format, flags) || value_changed
-	PopItemWidth()
-	PopID()
+	g.BeginGroup()
+	g.PushString(label)
+	g.PushMultiItemsWidths(1, g.CalcItemWidth())
+	value_changed = g.DragScalar("", data_type, p_data, v_speed, p_min, p_max, format, flags) || value_changed
+	g.PopItemWidth()
+	g.PopID()
 
-	SameLine(0, g.Style.ItemInnerSpacing.x)
-	TextEx(label, 0)
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
+	g.TextEx(label, 0)
 
-	EndGroup()
+	g.EndGroup()
 	return value_changed
 }
 
-func DragScalarFloats(label string, data_type ImGuiDataType, p_data []float, v_speed float /*= 0*/, p_min *float /*= L*/, p_max *float /*= L*/, format string, flags ImGuiSliderFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) DragScalarFloats(label string, data_type ImGuiDataType, p_data []float, v_speed float /*= 0*/, p_min *float /*= L*/, p_max *float /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(int(len(p_data)), CalcItemWidth())
+	g.BeginGroup()
+	g.PushString(label)
+	g.PushMultiItemsWidths(int(len(p_data)), g.CalcItemWidth())
 	for i := range p_data {
-		PushID(int(i))
+		g.PushID(int(i))
 		if i > 0 {
-			SameLine(0, g.Style.ItemInnerSpacing.x)
+			g.SameLine(0, g.Style.ItemInnerSpacing.x)
 		}
-		value_changed = DragScalar("", data_type, &p_data[i], v_speed, p_min, p_max, format, flags) || value_changed
-		PopID()
-		PopItemWidth()
+		value_changed = g.DragScalar("", data_type, &p_data[i], v_speed, p_min, p_max, format, flags) || value_changed
+		g.PopID()
+		g.PopItemWidth()
 	}
-	PopID()
+	g.PopID()
 
-	SameLine(0, g.Style.ItemInnerSpacing.x)
-	TextEx(label, 0)
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
+	g.TextEx(label, 0)
 
-	EndGroup()
+	g.EndGroup()
 	return value_changed
 }
 
-func DragScalarInt(label string, data_type ImGuiDataType, p_data *int, v_speed float /*= 0*/, p_min *int /*= L*/, p_max *int /*= L*/, format string, flags ImGuiSliderFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) DragScalarInt(label string, data_type ImGuiDataType, p_data *int, v_speed float /*= 0*/, p_min *int /*= L*/, p_max *int /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(1, CalcItemWidth())
-	value_changed = DragScalar("", data_type, p_data, v_speed, p_min, p_max, format, flags) || value_changed
-	PopItemWidth()
-	PopID()
+	g.BeginGroup()
+	g.PushString(label)
+	g.PushMultiItemsWidths(1, g.CalcItemWidth())
+	value_changed = g.DragScalar("", data_type, p_data, v_speed, p_min, p_max, format, flags) || value_changed
+	g.PopItemWidth()
+	g.PopID()
 
-	SameLine(0, g.Style.ItemInnerSpacing.x)
-	TextEx(FindRenderedTextEnd(label), 0)
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
+	g.TextEx(FindRenderedTextEnd(label), 0)
 
-	EndGroup()
+	g.EndGroup()
 	return value_changed
 }
 
-func DragScalarInts(label string, data_type ImGuiDataType, p_data []int, v_speed float /*= 0*/, p_min *int /*= L*/, p_max *int /*= L*/, format string, flags ImGuiSliderFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) DragScalarInts(label string, data_type ImGuiDataType, p_data []int, v_speed float /*= 0*/, p_min *int /*= L*/, p_max *int /*= L*/, format string, flags ImGuiSliderFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(int(len(p_data)), CalcItemWidth())
+	g.BeginGroup()
+	g.PushString(label)
+	g.PushMultiItemsWidths(int(len(p_data)), g.CalcItemWidth())
 	for i := range p_data {
-		PushID(int(i))
+		g.PushID(int(i))
 		if i > 0 {
-			SameLine(0, g.Style.ItemInnerSpacing.x)
+			g.SameLine(0, g.Style.ItemInnerSpacing.x)
 		}
-		value_changed = DragScalar("", data_type, &p_data[i], v_speed, p_min, p_max, format, flags) || value_changed
-		PopID()
-		PopItemWidth()
+		value_changed = g.DragScalar("", data_type, &p_data[i], v_speed, p_min, p_max, format, flags) || value_changed
+		g.PopID()
+		g.PopItemWidth()
 	}
-	PopID()
+	g.PopID()
 
-	SameLine(0, g.Style.ItemInnerSpacing.x)
-	TextEx(label, 0)
+	g.SameLine(0, g.Style.ItemInnerSpacing.x)
+	g.TextEx(label, 0)
 
-	EndGroup()
+	g.EndGroup()
 	return value_changed
 }
 
 // Convert a value v in the output space of a slider into a parametric position on the slider itself (the logical opposite of ScaleValueFromRatioT)
-func ScaleRatioFromValueT(v, v_min, v_max float, is_logarithmic bool, logarithmic_zero_epsilon, zero_deadzone_halfsize float) float {
+func ScaleRatioFromValueT[T ImGuiScalar](v, v_min, v_max T, is_logarithmic bool, logarithmic_zero_epsilon, zero_deadzone_halfsize float) float {
 	if v_min == v_max {
 		return 0.0
 	}
 
-	var v_clamped = ImClamp(v, v_max, v_min)
+	var v_clamped = v
 	if v_min < v_max {
-		v_clamped = ImClamp(v, v_min, v_max)
+		v_clamped = ImClampT(v, v_min, v_max)
+	} else {
+		v_clamped = ImClampT(v, v_max, v_min)
 	}
 	if is_logarithmic {
 		var flipped = v_max < v_min
@@ -392,149 +455,144 @@ func ScaleRatioFromValueT(v, v_min, v_max float, is_logarithmic bool, logarithmi
 			v_min, v_max = v_max, v_min
 		}
 
+		var epsilon = float64(logarithmic_zero_epsilon)
+		var v_min_f, v_max_f, v_clamped_f = float64(v_min), float64(v_max), float64(v_clamped)
+
 		// Fudge min/max to avoid getting close to log(0)
-		var v_min_fudged = v_min
-		if ImAbs(v_min) < logarithmic_zero_epsilon {
-			if v_min < 0.0 {
-				v_min_fudged = -logarithmic_zero_epsilon
+		var v_min_fudged = v_min_f
+		if math.Abs(v_min_f) < epsilon {
+			if v_min_f < 0.0 {
+				v_min_fudged = -epsilon
 			} else {
-				v_min_fudged = logarithmic_zero_epsilon
+				v_min_fudged = epsilon
 			}
 		}
-		var v_max_fudged = v_max
-		if ImAbs(v_max) < logarithmic_zero_epsilon {
-			if v_max < 0.0 {
-				v_max_fudged = -logarithmic_zero_epsilon
+		var v_max_fudged = v_max_f
+		if math.Abs(v_max_f) < epsilon {
+			if v_max_f < 0.0 {
+				v_max_fudged = -epsilon
 			} else {
-				v_max_fudged = logarithmic_zero_epsilon
+				v_max_fudged = epsilon
 			}
 		}
 
 		// Awkward special cases - we need ranges of the form (-100 .. 0) to convert to (-100 .. -epsilon), not (-100 .. epsilon)
-		if (v_min == 0.0) && (v_max < 0.0) {
-			v_min_fudged = -logarithmic_zero_epsilon
-		} else if (v_max == 0.0) && (v_min < 0.0) {
-			v_max_fudged = -logarithmic_zero_epsilon
+		if (v_min_f == 0.0) && (v_max_f < 0.0) {
+			v_min_fudged = -epsilon
+		} else if (v_max_f == 0.0) && (v_min_f < 0.0) {
+			v_max_fudged = -epsilon
 		}
 
-		var result float
+		var result float64
 
-		if v_clamped <= v_min_fudged {
+		if v_clamped_f <= v_min_fudged {
 			result = 0.0 // Workaround for values that are in-range but below our fudge
-		} else if v_clamped >= v_max_fudged {
+		} else if v_clamped_f >= v_max_fudged {
 			result = 1.0 // Workaround for values that are in-range but above our fudge
-		} else if (v_min * v_max) < 0.0 { // Range crosses zero, so split into two portions
-
-			var zero_point_center = (-(float)(v_min)) / ((float)(v_max) - (float)(v_min)) // The zero point in parametric space.  There's an argument we should take the logarithmic nature into account when calculating this, but for now this should do (and the most common case of a symmetrical range works fine)
-			var zero_point_snap_L = zero_point_center - zero_deadzone_halfsize
-			var zero_point_snap_R = zero_point_center + zero_deadzone_halfsize
-			if v == 0.0 {
+		} else if (v_min_f * v_max_f) < 0.0 { // Range crosses zero, so split into two portions
+			var zero_point_center = -v_min_f / (v_max_f - v_min_f) // The zero point in parametric space.  There's an argument we should take the logarithmic nature into account when calculating this, but for now this should do (and the most common case of a symmetrical range works fine)
+			var zero_point_snap_L = zero_point_center - float64(zero_deadzone_halfsize)
+			var zero_point_snap_R = zero_point_center + float64(zero_deadzone_halfsize)
+			if v == 0 {
 				result = zero_point_center // Special case for exactly zero
-			} else if v < 0.0 {
-				result = (1.0 - (float)(ImLog(-v_clamped/logarithmic_zero_epsilon)/ImLog(-v_min_fudged/logarithmic_zero_epsilon))) * zero_point_snap_L
+			} else if v_clamped_f < 0.0 {
+				result = (1.0 - math.Log(-v_clamped_f/epsilon)/math.Log(-v_min_fudged/epsilon)) * zero_point_snap_L
 			} else {
-				result = zero_point_snap_R + ((float)(ImLog(v_clamped/logarithmic_zero_epsilon)/ImLog(v_max_fudged/logarithmic_zero_epsilon)) * (1.0 - zero_point_snap_R))
+				result = zero_point_snap_R + (math.Log(v_clamped_f/epsilon)/math.Log(v_max_fudged/epsilon))*(1.0-zero_point_snap_R)
 			}
-		} else if (v_min < 0.0) || (v_max < 0.0) { // Entirely negative slider
-			result = 1.0 - (float)(ImLog(-v_clamped/-v_max_fudged)/ImLog(-v_min_fudged/-v_max_fudged))
+		} else if (v_min_f < 0.0) || (v_max_f < 0.0) { // Entirely negative slider
+			result = 1.0 - math.Log(-v_clamped_f/-v_max_fudged)/math.Log(-v_min_fudged/-v_max_fudged)
 		} else {
-			result = (float)(ImLog(v_clamped/v_min_fudged) / ImLog(v_max_fudged/v_min_fudged))
+			result = math.Log(v_clamped_f/v_min_fudged) / math.Log(v_max_fudged/v_min_fudged)
 		}
 		if flipped {
 			result = 1.0 - result
 		}
-		return result
+		return float(result)
 	}
 
 	// Linear slider
-	return (v_clamped - v_min) / (v_max - v_min)
+	return float((float64(v_clamped) - float64(v_min)) / (float64(v_max) - float64(v_min)))
 }
 
 // Convert a parametric position on a slider into a value v in the output space (the logical opposite of ScaleRatioFromValueT)
-func ScaleValueFromRatioT(t, v_min, v_max float, is_logarithmic bool, logarithmic_zero_epsilon, zero_deadzone_halfsize float) float {
-	if v_min == v_max {
+func ScaleValueFromRatioT[T ImGuiScalar](t float, v_min, v_max T, is_logarithmic bool, logarithmic_zero_epsilon, zero_deadzone_halfsize float) T {
+	// We special-case the extents because otherwise our fudging can lead to "mathematically correct" but non-intuitive behaviors like a fully-left slider not actually reaching the minimum value. Also generally simpler.
+	if t <= 0.0 || v_min == v_max {
 		return v_min
 	}
+	if t >= 1.0 {
+		return v_max
+	}
 
-	var is_floating_point = true
-
-	var result float
+	var result T
 	if is_logarithmic {
-		// We special-case the extents because otherwise our fudging can lead to "mathematically correct" but non-intuitive behaviors like a fully-left slider not actually reaching the minimum value
-		if t <= 0.0 {
-			result = v_min
-		} else if t >= 1.0 {
-			result = v_max
-		} else {
-			var flipped = v_max < v_min // Check if range is "backwards"
-
-			// Fudge min/max to avoid getting silly results close to zero
-			var v_min_fudged = v_min
-			if ImAbs(v_min) < logarithmic_zero_epsilon {
-				if v_min < 0.0 {
-					v_min_fudged = -logarithmic_zero_epsilon
-				} else {
-					v_min_fudged = logarithmic_zero_epsilon
-				}
+		var epsilon = float64(logarithmic_zero_epsilon)
+		var v_min_f, v_max_f = float64(v_min), float64(v_max)
+
+		// Fudge min/max to avoid getting silly results close to zero
+		var v_min_fudged = v_min_f
+		if math.Abs(v_min_f) < epsilon {
+			if v_min_f < 0.0 {
+				v_min_fudged = -epsilon
+			} else {
+				v_min_fudged = epsilon
 			}
-			var v_max_fudged = v_max
-			if ImAbs(v_max) < logarithmic_zero_epsilon {
-				if v_max < 0.0 {
-					v_min_fudged = -logarithmic_zero_epsilon
-				} else {
-					v_min_fudged = logarithmic_zero_epsilon
-				}
+		}
+		var v_max_fudged = v_max_f
+		if math.Abs(v_max_f) < epsilon {
+			if v_max_f < 0.0 {
+				v_max_fudged = -epsilon
+			} else {
+				v_max_fudged = epsilon
 			}
+		}
 
-			if flipped {
-				v_min_fudged, v_max_fudged = v_max_fudged, v_min_fudged
-			}
+		var flipped = v_max < v_min // Check if range is "backwards"
+		if flipped {
+			v_min_fudged, v_max_fudged = v_max_fudged, v_min_fudged
+		}
 
-			// Awkward special case - we need ranges of the form (-100 .. 0) to convert to (-100 .. -epsilon), not (-100 .. epsilon)
-			if (v_max == 0.0) && (v_min < 0.0) {
-				v_max_fudged = -logarithmic_zero_epsilon
-			}
+		// Awkward special case - we need ranges of the form (-100 .. 0) to convert to (-100 .. -epsilon), not (-100 .. epsilon)
+		if (v_max_f == 0.0) && (v_min_f < 0.0) {
+			v_max_fudged = -epsilon
+		}
 
-			var t_with_flip = t // t, but flipped if necessary to account for us flipping the range
-			if flipped {
-				t_with_flip = (1.0 - t)
-			}
+		var t_with_flip = float64(t) // t, but flipped if necessary to account for us flipping the range
+		if flipped {
+			t_with_flip = 1.0 - t_with_flip
+		}
 
-			if (v_min * v_max) < 0.0 { // Range crosses zero, so we have to do this in two parts
-				var zero_point_center = (-ImMin(v_min, v_max)) / ImAbs(v_max-v_min) // The zero point in parametric space
-				var zero_point_snap_L = zero_point_center - zero_deadzone_halfsize
-				var zero_point_snap_R = zero_point_center + zero_deadzone_halfsize
-				if t_with_flip >= zero_point_snap_L && t_with_flip <= zero_point_snap_R {
-					result = 0.0 // Special case to make getting exactly zero possible (the epsilon prevents it otherwise)
-				} else if t_with_flip < zero_point_center {
-					result = -(logarithmic_zero_epsilon * ImPow(-v_min_fudged/logarithmic_zero_epsilon, (float)(1.0-(t_with_flip/zero_point_snap_L))))
-				} else {
-					result = (logarithmic_zero_epsilon * ImPow(v_max_fudged/logarithmic_zero_epsilon, (float)((t_with_flip-zero_point_snap_R)/(1.0-zero_point_snap_R))))
-				}
-			} else if (v_min < 0.0) || (v_max < 0.0) { // Entirely negative slider
-				result = -(-v_max_fudged * ImPow(-v_min_fudged/-v_max_fudged, (float)(1.0-t_with_flip)))
+		if (v_min_f * v_max_f) < 0.0 { // Range crosses zero, so we have to do this in two parts
+			var zero_point_center = -math.Min(v_min_f, v_max_f) / math.Abs(v_max_f-v_min_f) // The zero point in parametric space
+			var zero_point_snap_L = zero_point_center - float64(zero_deadzone_halfsize)
+			var zero_point_snap_R = zero_point_center + float64(zero_deadzone_halfsize)
+			if t_with_flip >= zero_point_snap_L && t_with_flip <= zero_point_snap_R {
+				result = 0 // Special case to make getting exactly zero possible (the epsilon prevents it otherwise)
+			} else if t_with_flip < zero_point_center {
+				result = T(-(epsilon * math.Pow(-v_min_fudged/epsilon, 1.0-(t_with_flip/zero_point_snap_L))))
 			} else {
-				result = (v_min_fudged * ImPow(v_max_fudged/v_min_fudged, (float)(t_with_flip)))
+				result = T(epsilon * math.Pow(v_max_fudged/epsilon, (t_with_flip-zero_point_snap_R)/(1.0-zero_point_snap_R)))
 			}
+		} else if (v_min_f < 0.0) || (v_max_f < 0.0) { // Entirely negative slider
+			result = T(-(-v_max_fudged * math.Pow(-v_min_fudged/-v_max_fudged, 1.0-t_with_flip)))
+		} else {
+			result = T(v_min_fudged * math.Pow(v_max_fudged/v_min_fudged, t_with_flip))
 		}
 	} else {
 		// Linear slider
-		if is_floating_point {
-			result = ImLerp(v_min, v_max, t)
+		if DataTypeIsFloatingPoint[T]() {
+			result = T(float64(v_min) + (float64(v_max)-float64(v_min))*float64(t))
 		} else {
 			// - For integer values we want the clicking position to match the grab box so we round above
 			//   This code is carefully tuned to work with large values (e.g. high ranges of U64) while preserving this property..
 			// - Not doing a *1.0 multiply at the end of a range as it tends to be lossy. While absolute aiming at a large s64/u64
 			//   range is going to be imprecise anyway, with this check we at least make the edge values matches expected limits.
-			if t < 1.0 {
-				var v_new_off_f = (v_max - v_min) * t
-				if v_min > v_max {
-					result = v_min + (v_new_off_f + -0.5)
-				} else {
-					result = v_min + (v_new_off_f + 0.5)
-				}
+			var v_new_off_f = (float64(v_max) - float64(v_min)) * float64(t)
+			if v_min > v_max {
+				result = v_min + T(int64(v_new_off_f-0.5))
 			} else {
-				result = v_max
+				result = v_min + T(int64(v_new_off_f+0.5))
 			}
 		}
 	}
@@ -596,7 +654,11 @@ func isIntegerFormatSpecifier(format string) bool {
 	return false
 }
 
-func RoundScalarWithFormatT(format string, v float) float {
+func RoundScalarWithFormatT[T ImGuiScalar](format string, v T) T {
+	if !DataTypeIsFloatingPoint[T]() {
+		return v
+	}
+
 	// Find the actual format specifier (e.g., "%.3f" in "ratio = %.3f")
 	fmt_start := ImParseFormatFindStart(format)
 	if len(fmt_start) == 0 || fmt_start[0] != '%' || (len(fmt_start) > 1 && fmt_start[1] == '%') {
@@ -608,40 +670,40 @@ func RoundScalarWithFormatT(format string, v float) float {
 	fmt_end := ImParseFormatFindEnd(fmt_start)
 	fmt_spec := fmt_start[:fmt_end]
 
-	// Check if this is an integer format specifier
+	// Integer formats display the value truncated
 	if isIntegerFormatSpecifier(fmt_spec) {
-		// For integer formats, convert to int first, format, then parse back
-		// Use TrimSpace because format specifiers like "%3d" produce leading spaces
-		// which strconv.ParseInt cannot handle
-		var v_str = strings.TrimSpace(fmt.Sprintf(fmt_spec, int(v)))
-		i, _ := strconv.ParseInt(v_str, 0, 32)
-		return float(i)
+		return T(math.Trunc(float64(v)))
 	}
 
 	// Format value with our rounding, and read back
-	// Use TrimSpace for consistency with integer handling
+	// Use TrimSpace because format specifiers like "%8.3f" produce leading spaces
 	var v_str = strings.TrimSpace(fmt.Sprintf(fmt_spec, v))
-	f, _ := strconv.ParseFloat(v_str, 32)
-	return float(f)
+	f, err := strconv.ParseFloat(v_str, 64)
+	if err != nil {
+		return v
+	}
+	return T(f)
 }
 
-func DragBehaviorT(v *float, v_speed float, v_min, v_max *float, format string, flags ImGuiSliderFlags) bool {
-	var g = GImGui
+// This is called by DragBehavior() when the widget is active (held by mouse or being manipulated with Nav controls)
+func DragBehaviorT[T ImGuiScalar](g *ImGuiContext, v *T, v_speed float, v_min, v_max T, format string, flags ImGuiSliderFlags) bool {
 	var axis = ImGuiAxis_X
 	if (flags & ImGuiSliderFlags_Vertical) != 0 {
 		axis = ImGuiAxis_Y
 	}
-	var is_clamped = (*v_min < *v_max)
+	var is_clamped = (v_min < v_max)
 	var is_logarithmic = (flags & ImGuiSliderFlags_Logarithmic) != 0
-	var is_floating_point = true
+	var is_floating_point = DataTypeIsFloatingPoint[T]()
+	var v_range = float64(v_max) - float64(v_min)
 
 	// Default tweak speed
-	if v_speed == 0.0 && is_clamped && (*v_max-*v_min < FLT_MAX) {
-		v_speed = (float)((*v_max - *v_min) * g.DragSpeedDefaultRatio)
+	if v_speed == 0.0 && is_clamped && (v_range < FLT_MAX) {
+		v_speed = (float)(v_range * float64(g.DragSpeedDefaultRatio))
 	}
+
 	// Inputs accumulates into g.DragCurrentAccum, which is flushed into the current value as soon as it makes a difference with our precision settings
 	var adjust_delta float = 0.0
-	if g.ActiveIdSource == ImGuiInputSource_Mouse && IsMousePosValid(nil) && IsMouseDragPastThreshold(0, g.IO.MouseDragThreshold*DRAG_MOUSE_THRESHOLD_FACTOR) {
+	if g.ActiveIdSource == ImGuiInputSource_Mouse && g.IsMousePosValid(nil) && g.IsMouseDragPastThreshold(0, g.IO.MouseDragThreshold*DRAG_MOUSE_THRESHOLD_FACTOR) {
 		switch axis {
 		case ImGuiAxis_X:
 			adjust_delta = g.IO.MouseDelta.x
@@ -655,8 +717,11 @@ func DragBehaviorT(v *float, v_speed float, v_min, v_max *float, format string,
 			adjust_delta *= 10.0
 		}
 	} else if g.ActiveIdSource == ImGuiInputSource_Nav {
-		var decimal_precision int = 3
-		amount := GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard|ImGuiNavDirSourceFlags_PadDPad, ImGuiInputReadMode_RepeatFast, 1.0/10.0, 10.0)
+		var decimal_precision int = 0
+		if is_floating_point {
+			decimal_precision = 3
+		}
+		amount := g.GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard|ImGuiNavDirSourceFlags_PadDPad, ImGuiInputReadMode_RepeatFast, 1.0/10.0, 10.0)
 		switch axis {
 		case ImGuiAxis_X:
 			adjust_delta = amount.x
@@ -674,14 +739,14 @@ func DragBehaviorT(v *float, v_speed float, v_min, v_max *float, format string,
 	}
 
 	// For logarithmic use our range is effectively 0..1 so scale the delta into that range
-	if is_logarithmic && (*v_max-*v_min < FLT_MAX) && ((*v_max - *v_min) > 0.000001) { // Epsilon to avoid /0
-		adjust_delta /= (float)(*v_max - *v_min)
+	if is_logarithmic && (v_range < FLT_MAX) && (v_range > 0.000001) { // Epsilon to avoid /0
+		adjust_delta /= (float)(v_range)
 	}
 
 	// Clear current value on activation
 	// Avoid altering values and clamping when we are _already_ past the limits and heading in the same direction, so e.g. if range is 0..255, current value is 300 and we are pushing to the right side, keep the 300.
 	var is_just_activated = g.ActiveIdIsJustActivated
-	var is_already_past_limits_and_pushing_outward = is_clamped && ((*v >= *v_max && adjust_delta > 0.0) || (*v <= *v_min && adjust_delta < 0.0))
+	var is_already_past_limits_and_pushing_outward = is_clamped && ((*v >= v_max && adjust_delta > 0.0) || (*v <= v_min && adjust_delta < 0.0))
 	if is_just_activated || is_already_past_limits_and_pushing_outward {
 		g.DragCurrentAccum = 0.0
 		g.DragCurrentAccumDirty = false
@@ -701,16 +766,21 @@ func DragBehaviorT(v *float, v_speed float, v_min, v_max *float, format string,
 	var zero_deadzone_halfsize float   // Drag widgets have no deadzone (as it doesn't make sense)
 	if is_logarithmic {
 		// When using logarithmic sliders, we need to clamp to avoid hitting zero, but our choice of clamp value greatly affects slider precision. We attempt to use the specified precision to estimate a good lower bound.
-		var decimal_precision = 3
+		var decimal_precision = 1
+		if is_floating_point {
+			decimal_precision = 3
+		}
 		logarithmic_zero_epsilon = ImPow(0.1, (float)(decimal_precision))
 
 		// Convert to parametric space, apply delta, convert back
-		var v_old_parametric = ScaleRatioFromValueT(v_cur, *v_min, *v_max, is_logarithmic, logarithmic_zero_epsilon, zero_deadzone_halfsize)
+		var v_old_parametric = ScaleRatioFromValueT(v_cur, v_min, v_max, is_logarithmic, logarithmic_zero_epsilon, zero_deadzone_halfsize)
 		var v_new_parametric = v_old_parametric + g.DragCurrentAccum
-		v_cur = ScaleValueFromRatioT(v_new_parametric, *v_min, *v_max, is_logarithmic, logarithmic_zero_epsilon, zero_deadzone_halfsize)
+		v_cur = ScaleValueFromRatioT(v_new_parametric, v_min, v_max, is_logarithmic, logarithmic_zero_epsilon, zero_deadzone_halfsize)
 		v_old_ref_for_accum_remainder = v_old_parametric
+	} else if is_floating_point {
+		v_cur += T(g.DragCurrentAccum)
 	} else {
-		v_cur += (float)(g.DragCurrentAccum)
+		v_cur += T(int64(g.DragCurrentAccum)) // Wraps around on overflow, which is handled by the clamping below
 	}
 
 	// Round to user desired precision based on format string
@@ -722,24 +792,37 @@ func DragBehaviorT(v *float, v_speed float, v_min, v_max *float, format string,
 	g.DragCurrentAccumDirty = false
 	if is_logarithmic {
 		// Convert to parametric space, apply delta, convert back
-		var v_new_parametric = ScaleRatioFromValueT(v_cur, *v_min, *v_max, is_logarithmic, logarithmic_zero_epsilon, zero_deadzone_halfsize)
+		var v_new_parametric = ScaleRatioFromValueT(v_cur, v_min, v_max, is_logarithmic, logarithmic_zero_epsilon, zero_deadzone_halfsize)
 		g.DragCurrentAccum -= (float)(v_new_parametric - v_old_ref_for_accum_remainder)
+	} else if is_floating_point {
+		g.DragCurrentAccum -= (float)(float64(v_cur) - float64(*v))
 	} else {
-		g.DragCurrentAccum -= (float)(v_cur - *v)
+		g.DragCurrentAccum -= (float)(int64(g.DragCurrentAccum)) // The integer part was applied, even if it wrapped around
 	}
 
 	// Lose zero sign for float/double
-	if v_cur == (float)(-0) {
-		v_cur = (float)(0)
+	if is_floating_point && v_cur == 0 {
+		v_cur = 0
 	}
 
 	// Clamp values (+ handle overflow/wrap-around for integer types)
 	if *v != v_cur && is_clamped {
-		if v_cur < *v_min || (v_cur > *v && adjust_delta < 0.0 && !is_floating_point) {
-			v_cur = *v_min
+		if v_cur < v_min || (v_cur > *v && adjust_delta < 0.0 && !is_floating_point) {
+			v_cur = v_min
+		}
+		if v_cur > v_max || (v_cur < *v && adjust_delta > 0.0 && !is_floating_point) {
+			v_cur = v_max
+		}
+	}
+
+	// Unbounded integers saturate instead of wrapping around, e.g. an unsigned value dragged below 0
+	if *v != v_cur && !is_clamped && !is_floating_point {
+		var lo, hi = DataTypeGetRange[T]()
+		if v_cur > *v && adjust_delta < 0.0 {
+			v_cur = lo
 		}
-		if v_cur > *v_max || (v_cur < *v && adjust_delta > 0.0 && !is_floating_point) {
-			v_cur = *v_max
+		if v_cur < *v && adjust_delta > 0.0 {
+			v_cur = hi
 		}
 	}
 
@@ -752,16 +835,15 @@ func DragBehaviorT(v *float, v_speed float, v_min, v_max *float, format string,
 	return true
 }
 
-func DragBehavior(id ImGuiID, data_type ImGuiDataType, v any, v_speed float, n any, x any, t string, flags ImGuiSliderFlags) bool {
+func dragBehavior[T ImGuiScalar](g *ImGuiContext, id ImGuiID, v *T, v_speed float, v_min, v_max T, format string, flags ImGuiSliderFlags) bool {
 	// Read imgui.cpp "API BREAKING CHANGES" section for 1.78 if you hit this assert.
 	IM_ASSERT_USER_ERROR((flags == 1 || (flags&ImGuiSliderFlags_InvalidMask_) == 0), "Invalid ImGuiSliderFlags flags! Has the 'float power' argument been mistakenly cast to flags? Call function with ImGuiSliderFlags_Logarithmic flags instead.")
 
-	var g = GImGui
 	if g.ActiveId == id {
 		if g.ActiveIdSource == ImGuiInputSource_Mouse && !g.IO.MouseDown[0] {
-			ClearActiveID()
+			g.ClearActiveID()
 		} else if g.ActiveIdSource == ImGuiInputSource_Nav && g.NavActivatePressedId == id && !g.ActiveIdIsJustActivated {
-			ClearActiveID()
+			g.ClearActiveID()
 		}
 	}
 	if g.ActiveId != id {
@@ -771,65 +853,33 @@ func DragBehavior(id ImGuiID, data_type ImGuiDataType, v any, v_speed float, n a
 		return false
 	}
 
-	// FIXME: this is kinda hacky, we can't just convert a float
-	// interface to an int interface, but we can use reflection
-	// to get the value as a float, pass it to DragBehaviourT,
-	// and set the value back
-	// convert v to float
-	v_value := reflect.ValueOf(v).Elem()
-	var v_float float
-	if !v_value.CanFloat() {
-		v_float = float(v_value.Int())
-	} else {
-		v_float = float(v_value.Float())
-	}
-
-	// convert n to float
-	n_value := reflect.ValueOf(n).Elem()
-	var n_float float
-	if !n_value.CanFloat() {
-		n_float = float(n_value.Int())
-	} else {
-		n_float = float(n_value.Float())
-	}
-
-	// convert x to float
-	x_value := reflect.ValueOf(x).Elem()
-	var x_float float
-	if !x_value.CanFloat() {
-		x_float = float(x_value.Int())
-	} else {
-		x_float = float(x_value.Float())
-	}
-
-	dragRet := DragBehaviorT(&v_float, v_speed, &n_float, &x_float, t, flags)
-
-	// set new value of v
-	if v_value.CanSet() {
-		if v_value.CanFloat() {
-			v_value.SetFloat(float64(v_float))
-		} else {
-			v_value.SetInt(int64(v_float))
-		}
-	}
-
-	// set new value of n
-	if n_value.CanSet() {
-		if n_value.CanFloat() {
-			n_value.SetFloat(float64(n_float))
-		} else {
-			n_value.SetInt(int64(n_float))
-		}
-	}
-
-	// set new value of x
-	if x_value.CanSet() {
-		if x_value.CanFloat() {
-			x_value.SetFloat(float64(x_float))
-		} else {
-			x_value.SetInt(int64(x_float))
-		}
-	}
+	return DragBehaviorT(g, v, v_speed, v_min, v_max, format, flags)
+}
 
-	return dragRet
+// The pointers must be of the type of data_type, see DragScalar(). p_min and p_max are optional.
+func (g *ImGuiContext) DragBehavior(id ImGuiID, data_type ImGuiDataType, p_v any, v_speed float, p_min any, p_max any, format string, flags ImGuiSliderFlags) bool {
+	switch data_type {
+	case ImGuiDataType_S8:
+		return dragBehavior(g, id, p_v.(*int8), v_speed, dataTypeMin[int8](p_min), dataTypeMax[int8](p_max), format, flags)
+	case ImGuiDataType_U8:
+		return dragBehavior(g, id, p_v.(*uint8), v_speed, dataTypeMin[uint8](p_min), dataTypeMax[uint8](p_max), format, flags)
+	case ImGuiDataType_S16:
+		return dragBehavior(g, id, p_v.(*int16), v_speed, dataTypeMin[int16](p_min), dataTypeMax[int16](p_max), format, flags)
+	case ImGuiDataType_U16:
+		return dragBehavior(g, id, p_v.(*uint16), v_speed, dataTypeMin[uint16](p_min), dataTypeMax[uint16](p_max), format, flags)
+	case ImGuiDataType_S32:
+		return dragBehavior(g, id, p_v.(*int32), v_speed, dataTypeMin[int32](p_min), dataTypeMax[int32](p_max), format, flags)
+	case ImGuiDataType_U32:
+		return dragBehavior(g, id, p_v.(*uint32), v_speed, dataTypeMin[uint32](p_min), dataTypeMax[uint32](p_max), format, flags)
+	case ImGuiDataType_S64:
+		return dragBehavior(g, id, p_v.(*int64), v_speed, dataTypeMin[int64](p_min), dataTypeMax[int64](p_max), format, flags)
+	case ImGuiDataType_U64:
+		return dragBehavior(g, id, p_v.(*uint64), v_speed, dataTypeMin[uint64](p_min), dataTypeMax[uint64](p_max), format, flags)
+	case ImGuiDataType_Float:
+		return dragBehavior(g, id, p_v.(*float32), v_speed, dataTypeMin[float32](p_min), dataTypeMax[float32](p_max), format, flags)
+	case ImGuiDataType_Double:
+		return dragBehavior(g, id, p_v.(*float64), v_speed, dataTypeMin[float64](p_min), dataTypeMax[float64](p_max), format, flags)
+	}
+	IM_ASSERT(false)
+	return false
 }
@@ -3,8 +3,7 @@ package imgui
 const DRAGDROP_HOLD_TO_OPEN_TIMER float = 0.70 // Time for drag-hold to activate items accepting the ImGuiButtonFlags_PressedOnDragDropHold button behavior.
 const DRAG_MOUSE_THRESHOLD_FACTOR float = 0.50 // Multiplier for the default value of io.MouseDragThreshold to make DragFloat/DragInt react faster to mouse drags.
 
-func IsClippedEx(bb *ImRect, id ImGuiID, clip_even_when_logged bool) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsClippedEx(bb *ImRect, id ImGuiID, clip_even_when_logged bool) bool {
 	var window = g.CurrentWindow
 	if !bb.Overlaps(window.ClipRect) {
 		if id == 0 || (id != g.ActiveId && id != g.NavId) {
@@ -17,8 +16,7 @@ func IsClippedEx(bb *ImRect, id ImGuiID, clip_even_when_logged bool) bool {
 }
 
 // is the last item active? (e.g. button being held, text field being edited. This will continuously return true while holding mouse button on an item. Items that don't interact will always return false)
-func IsItemActive() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemActive() bool {
 	if g.ActiveId != 0 {
 		return g.ActiveId == g.LastItemData.ID
 	}
@@ -26,8 +24,7 @@ func IsItemActive() bool {
 }
 
 // Internal facing ItemHoverable() used when submitting widgets. Differs slightly from IsItemHovered().
-func ItemHoverable(bb *ImRect, id ImGuiID) bool {
-	var g = GImGui
+func (g *ImGuiContext) ItemHoverable(bb *ImRect, id ImGuiID) bool {
 	if g.HoveredId != 0 && g.HoveredId != id && !g.HoveredIdAllowOverlap {
 		return false
 	}
@@ -39,13 +36,13 @@ func ItemHoverable(bb *ImRect, id ImGuiID) bool {
 	if g.ActiveId != 0 && g.ActiveId != id && !g.ActiveIdAllowOverlap {
 		return false
 	}
-	if !IsMouseHoveringRect(bb.Min, bb.Max, true) {
+	if !g.IsMouseHoveringRect(bb.Min, bb.Max, true) {
 		return false
 	}
 	if g.NavDisableMouseHover {
 		return false
 	}
-	if !IsWindowContentHoverable(window, ImGuiHoveredFlags_None) {
+	if !g.IsWindowContentHoverable(window, ImGuiHoveredFlags_None) {
 		g.HoveredIdDisabled = true
 		return false
 	}
@@ -53,7 +50,7 @@ func ItemHoverable(bb *ImRect, id ImGuiID) bool {
 	// We exceptionally allow this function to be called with id==0 to allow using it for easy high-level
 	// hover test in widgets code. We could also decide to split this function is two.
 	if id != 0 {
-		SetHoveredID(id)
+		g.SetHoveredID(id)
 	}
 
 	// When disabled we'll return false but still set HoveredId
@@ -64,7 +61,7 @@ func ItemHoverable(bb *ImRect, id ImGuiID) bool {
 	if item_flags&ImGuiItemFlags_Disabled != 0 {
 		// Release active id if turning disabled
 		if g.ActiveId == id {
-			ClearActiveID()
+			g.ClearActiveID()
 		}
 		g.HoveredIdDisabled = true
 		return false
@@ -91,12 +88,12 @@ func ItemHoverable(bb *ImRect, id ImGuiID) bool {
 // Those two functions CalcItemWidth vs CalcItemSize are awkwardly named because they are not fully symmetrical.
 // Note that only CalcItemWidth() is publicly exposed.
 // The 4.0f here may be changed to match CalcItemWidth() and/or BeginChild() (right now we have a mismatch which is harmless but undesirable)
-func CalcItemSize(size ImVec2, default_w float, default_h float) ImVec2 {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) CalcItemSize(size ImVec2, default_w float, default_h float) ImVec2 {
+	var window = g.CurrentWindow
 
 	var region_max ImVec2
 	if size.x < 0.0 || size.y < 0.0 {
-		region_max = GetContentRegionMaxAbs()
+		region_max = g.GetContentRegionMaxAbs()
 	}
 
 	if size.x == 0.0 {
@@ -117,8 +114,7 @@ func CalcItemSize(size ImVec2, default_w float, default_h float) ImVec2 {
 // Declare item bounding box for clipping and interaction.
 // Note that the size can be different than the one provided to ItemSize(). Typically, widgets that spread over available surface
 // declare their minimum size requirement to ItemSize() and provide a larger region to ItemAdd() which is used drawing/interaction.
-func ItemAdd(bb *ImRect, id ImGuiID, nav_bb_arg *ImRect, extra_flags ImGuiItemFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) ItemAdd(bb *ImRect, id ImGuiID, nav_bb_arg *ImRect, extra_flags ImGuiItemFlags) bool {
 	var window = g.CurrentWindow
 
 	// Set item data
@@ -132,6 +128,14 @@ func ItemAdd(bb *ImRect, id ImGuiID, nav_bb_arg *ImRect, extra_flags ImGuiItemFl
 	}
 	g.LastItemData.InFlags = g.CurrentItemFlags | extra_flags
 	g.LastItemData.StatusFlags = ImGuiItemStatusFlags_None
+	if g.NextItemData.Flags&ImGuiNextItemDataFlags_HasSelectionUserData != 0 {
+		// SelectionUserData itself is left in NextItemData, Selectable() and TreeNode() read it after ItemAdd()
+		g.LastItemData.InFlags |= ImGuiItemFlags_HasSelectionUserData
+		if g.CurrentMultiSelect != nil {
+			g.LastItemData.InFlags |= ImGuiItemFlags_IsMultiSelect
+		}
+		g.NextItemData.Flags &^= ImGuiNextItemDataFlags_HasSelectionUserData
+	}
 
 	// Directional navigation processing
 	if id != 0 {
@@ -148,13 +152,21 @@ func ItemAdd(bb *ImRect, id ImGuiID, nav_bb_arg *ImRect, extra_flags ImGuiItemFl
 		if g.NavId == id || g.NavAnyRequest {
 			if g.NavWindow.RootWindowForNav == window.RootWindowForNav {
 				if window == g.NavWindow || ((window.Flags|g.NavWindow.Flags)&ImGuiWindowFlags_NavFlattened != 0) {
-					NavProcessItem()
+					g.NavProcessItem()
 				}
 			}
 		}
 	}
+	if id != 0 {
+		if nav_bb_arg != nil {
+			g.IMGUI_TEST_ENGINE_ITEM_ADD(nav_bb_arg, id)
+		} else {
+			g.IMGUI_TEST_ENGINE_ITEM_ADD(bb, id)
+		}
+	}
+
 	// Clipping test
-	var is_clipped = IsClippedEx(bb, id, false)
+	var is_clipped = g.IsClippedEx(bb, id, false)
 	if is_clipped {
 		return false
 	}
@@ -163,11 +175,11 @@ func ItemAdd(bb *ImRect, id ImGuiID, nav_bb_arg *ImRect, extra_flags ImGuiItemFl
 	// [WIP] Tab stop handling (previously was using internal FocusableItemRegister() api)
 	// FIXME-NAV: We would now want to move this before the clipping test, but this would require being able to scroll and currently this would mean an extra frame. (#4079, #343)
 	if extra_flags&ImGuiItemFlags_Inputable != 0 {
-		ItemInputable(window, id)
+		g.ItemInputable(window, id)
 	}
 
 	// We need to calculate this now to take account of the current clipping rectangle (as items like Selectable may change them)
-	if IsMouseHoveringRect(bb.Min, bb.Max, true) {
+	if g.IsMouseHoveringRect(bb.Min, bb.Max, true) {
 		g.LastItemData.StatusFlags |= ImGuiItemStatusFlags_HoveredRect
 	}
 	return true
@@ -176,8 +188,7 @@ func ItemAdd(bb *ImRect, id ImGuiID, nav_bb_arg *ImRect, extra_flags ImGuiItemFl
 // Advance cursor given item size for layout.
 // Register minimum needed size so it can extend the bounding box used for auto-fit calculation.
 // See comments in ItemAdd() about how/why the size provided to ItemSize() vs ItemAdd() may often different.
-func ItemSizeVec(size *ImVec2, text_baseline_y float) {
-	var g = GImGui
+func (g *ImGuiContext) ItemSizeVec(size *ImVec2, text_baseline_y float) {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return
@@ -209,7 +220,7 @@ func ItemSizeVec(size *ImVec2, text_baseline_y float) {
 
 	// Horizontal layout mode
 	if window.DC.LayoutType == ImGuiLayoutType_Horizontal {
-		SameLine(0, -1)
+		g.SameLine(0, -1)
 	}
 }
 
@@ -219,13 +230,12 @@ func ItemSizeVec(size *ImVec2, text_baseline_y float) {
 //	offset_from_start_x != 0 : align to specified x position (relative to window/group left)
 //	spacing_w < 0            : use default spacing if pos_x == 0, no spacing if pos_x != 0
 //	spacing_w >= 0           : enforce spacing amount
-func SameLine(offset_from_start_x, spacing_w float) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) SameLine(offset_from_start_x, spacing_w float) {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
 
-	var g = GImGui
 	if offset_from_start_x != 0.0 {
 		if spacing_w < 0.0 {
 			spacing_w = 0.0
@@ -243,7 +253,7 @@ func SameLine(offset_from_start_x, spacing_w float) {
 	window.DC.CurrLineTextBaseOffset = window.DC.PrevLineTextBaseOffset
 }
 
-func ItemSizeRect(bb *ImRect, text_baseline_y float) {
+func (g *ImGuiContext) ItemSizeRect(bb *ImRect, text_baseline_y float) {
 	size := bb.GetSize()
-	ItemSizeVec(&size, text_baseline_y)
+	g.ItemSizeVec(&size, text_baseline_y)
 }
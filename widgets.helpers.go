@@ -7,16 +7,14 @@ import (
 )
 
 // Remotely activate a button, checkbox, tree node etc. given its unique ID. activation is queued and processed on the next frame when the item is encountered again.
-func ActivateItem(id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) ActivateItem(id ImGuiID) {
 	g.NavNextActivateId = id
 }
 
 // Called by ItemAdd()
 // Process TAB/Shift+TAB. Be mindful that this function may _clear_ the ActiveID when tabbing out.
 // [WIP] This will eventually be refactored and moved into NavProcessItem()
-func ItemInputable(window *ImGuiWindow, id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) ItemInputable(window *ImGuiWindow, id ImGuiID) {
 	IM_ASSERT(id != 0 && id == g.LastItemData.ID)
 
 	// Increment counters
@@ -32,7 +30,7 @@ func ItemInputable(window *ImGuiWindow, id ImGuiID) {
 
 	// Process TAB/Shift-TAB to tab *OUT* of the currently focused item.
 	// (Note that we can always TAB out of a widget that doesn't allow tabbing in)
-	if g.ActiveId == id && g.TabFocusPressed && !IsActiveIdUsingKey(ImGuiKey_Tab) && g.TabFocusRequestNextWindow == nil {
+	if g.ActiveId == id && g.TabFocusPressed && !g.IsActiveIdUsingKey(ImGuiKey_Tab) && g.TabFocusRequestNextWindow == nil {
 		g.TabFocusRequestNextWindow = window
 
 		var add int
@@ -61,17 +59,16 @@ func ItemInputable(window *ImGuiWindow, id ImGuiID) {
 
 		// If another item is about to be focused, we clear our own active id
 		if g.ActiveId == id {
-			ClearActiveID()
+			g.ClearActiveID()
 		}
 	}
 }
 
-func CalcWrapWidthForPos(pos *ImVec2, wrap_pos_x float) float {
+func (g *ImGuiContext) CalcWrapWidthForPos(pos *ImVec2, wrap_pos_x float) float {
 	if wrap_pos_x < 0.0 {
 		return 0.0
 	}
 
-	var g = GImGui
 	var window = g.CurrentWindow
 	if wrap_pos_x == 0.0 {
 		// We could decide to setup a default wrapping max point for auto-resizing windows,
@@ -88,8 +85,7 @@ func CalcWrapWidthForPos(pos *ImVec2, wrap_pos_x float) float {
 }
 
 // Was the last item selection toggled? (after Selectable(), TreeNode() etc. We only returns toggle _event_ in order to handle clipping correctly)
-func IsItemToggledSelection() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemToggledSelection() bool {
 	return (g.LastItemData.StatusFlags & ImGuiItemStatusFlags_ToggledSelection) != 0
 }
 
@@ -148,8 +144,7 @@ func ShrinkWidths(items []ImGuiShrinkWidthItem, count int, width_excess float) {
 
 // Inputs
 // FIXME: Eventually we should aim to move e.g. IsActiveIdUsingKey() into IsKeyXXX functions.
-func SetItemUsingMouseWheel() {
-	var g = GImGui
+func (g *ImGuiContext) SetItemUsingMouseWheel() {
 	var id = g.LastItemData.ID
 	if g.HoveredId == id {
 		g.HoveredIdUsingMouseWheel = true
@@ -2,25 +2,22 @@ package imgui
 
 import (
 	"bytes"
-	"fmt"
-	"reflect"
 	"strings"
 )
 
 // Create text input in place of another active widget (e.g. used when doing a CTRL+Click on drag/slider widgets)
 // FIXME: Facilitate using this in variety of other situations.
-func TempInputText(bb *ImRect, id ImGuiID, label string, buf *[]byte, flags ImGuiInputTextFlags) bool {
+func (g *ImGuiContext) TempInputText(bb *ImRect, id ImGuiID, label string, buf *[]byte, flags ImGuiInputTextFlags) bool {
 	// On the first frame, g.TempInputTextId == 0, then on subsequent frames it becomes == id.
 	// We clear ActiveID on the first frame to allow the InputText() taking it back.
-	var g = GImGui
 	var init = (g.TempInputId != id)
 	if init {
-		ClearActiveID()
+		g.ClearActiveID()
 	}
 
 	g.CurrentWindow.DC.CursorPos = bb.Min
 	size := bb.GetSize()
-	var value_changed = InputTextEx(label, "", buf, &size, flags|ImGuiInputTextFlags_MergedItem, nil, nil)
+	var value_changed = g.InputTextEx(label, "", buf, &size, flags|ImGuiInputTextFlags_MergedItem, nil, nil)
 	if init {
 		// First frame we started displaying the InputText widget, we expect it to take the active id.
 		IM_ASSERT(g.ActiveId == id)
@@ -32,68 +29,155 @@ func TempInputText(bb *ImRect, id ImGuiID, label string, buf *[]byte, flags ImGu
 // Note that Drag/Slider functions are only forwarding the min/max values clamping values if the ImGuiSliderFlags_AlwaysClamp flag is set!
 // This is intended: this way we allow CTRL+Click manual input to set a value out of bounds, for maximum flexibility.
 // However this may not be ideal for all uses, as some user code may break on out of bound values.
-func TempInputScalar(bb *ImRect, id ImGuiID, label string, data_type ImGuiDataType, p_data any, format string, p_clamp_min any, p_clamp_max any) bool {
-	var g = GImGui
-	p_data_val := reflect.ValueOf(p_data).Elem()
-	var data_buf = []byte(strings.TrimSpace(fmt.Sprintf(format, p_data_val)))
+func (g *ImGuiContext) TempInputScalar(bb *ImRect, id ImGuiID, label string, data_type ImGuiDataType, p_data any, format string, p_clamp_min any, p_clamp_max any) bool {
+	switch data_type {
+	case ImGuiDataType_S8:
+		return TempInputScalarT(g, bb, id, label, p_data.(*int8), format, dataTypeArg[int8](p_clamp_min), dataTypeArg[int8](p_clamp_max))
+	case ImGuiDataType_U8:
+		return TempInputScalarT(g, bb, id, label, p_data.(*uint8), format, dataTypeArg[uint8](p_clamp_min), dataTypeArg[uint8](p_clamp_max))
+	case ImGuiDataType_S16:
+		return TempInputScalarT(g, bb, id, label, p_data.(*int16), format, dataTypeArg[int16](p_clamp_min), dataTypeArg[int16](p_clamp_max))
+	case ImGuiDataType_U16:
+		return TempInputScalarT(g, bb, id, label, p_data.(*uint16), format, dataTypeArg[uint16](p_clamp_min), dataTypeArg[uint16](p_clamp_max))
+	case ImGuiDataType_S32:
+		return TempInputScalarT(g, bb, id, label, p_data.(*int32), format, dataTypeArg[int32](p_clamp_min), dataTypeArg[int32](p_clamp_max))
+	case ImGuiDataType_U32:
+		return TempInputScalarT(g, bb, id, label, p_data.(*uint32), format, dataTypeArg[uint32](p_clamp_min), dataTypeArg[uint32](p_clamp_max))
+	case ImGuiDataType_S64:
+		return TempInputScalarT(g, bb, id, label, p_data.(*int64), format, dataTypeArg[int64](p_clamp_min), dataTypeArg[int64](p_clamp_max))
+	case ImGuiDataType_U64:
+		return TempInputScalarT(g, bb, id, label, p_data.(*uint64), format, dataTypeArg[uint64](p_clamp_min), dataTypeArg[uint64](p_clamp_max))
+	case ImGuiDataType_Float:
+		return TempInputScalarT(g, bb, id, label, p_data.(*float32), format, dataTypeArg[float32](p_clamp_min), dataTypeArg[float32](p_clamp_max))
+	case ImGuiDataType_Double:
+		return TempInputScalarT(g, bb, id, label, p_data.(*float64), format, dataTypeArg[float64](p_clamp_min), dataTypeArg[float64](p_clamp_max))
+	}
+	IM_ASSERT(false)
+	return false
+}
+
+func TempInputScalarT[T ImGuiScalar](g *ImGuiContext, bb *ImRect, id ImGuiID, label string, p_data *T, format string, p_clamp_min *T, p_clamp_max *T) bool {
+	format = ImParseFormatTrimDecorations(format)
+	var data_buf = []byte(strings.TrimSpace(DataTypeFormatStringT(*p_data, format)))
 
 	var flags = ImGuiInputTextFlags_AutoSelectAll | ImGuiInputTextFlags_NoMarkEdited
-	flags |= ImGuiInputTextFlags_CharsDecimal
-	if data_type == ImGuiDataType_Float || data_type == ImGuiDataType_Double {
-		flags = ImGuiInputTextFlags_CharsScientific
+	if DataTypeIsFloatingPoint[T]() {
+		flags |= ImGuiInputTextFlags_CharsScientific
+	} else {
+		flags |= ImGuiInputTextFlags_CharsDecimal
 	}
 
 	var value_changed = false
-	if TempInputText(bb, id, label, &data_buf, flags) {
+	if g.TempInputText(bb, id, label, &data_buf, flags) {
 		// Backup old value
-		var data_backup = reflect.ValueOf(p_data).Elem().Interface()
+		var data_backup = *p_data
 
 		// Apply new value (or operations) then clamp
-		DataTypeApplyOpFromText(string(data_buf), string(g.InputTextState.InitialTextA), data_type, p_data, "")
+		DataTypeApplyOpFromTextT(string(data_buf), string(g.InputTextState.InitialTextA), p_data, format)
 		if p_clamp_min != nil || p_clamp_max != nil {
-			if p_clamp_min != nil && p_clamp_max != nil && DataTypeCompare(data_type, p_clamp_min, p_clamp_max) > 0 {
+			if p_clamp_min != nil && p_clamp_max != nil && *p_clamp_min > *p_clamp_max {
 				p_clamp_min, p_clamp_max = p_clamp_max, p_clamp_min
 			}
-			DataTypeClamp(data_type, p_data, p_clamp_min, p_clamp_max)
+			DataTypeClampT(p_data, p_clamp_min, p_clamp_max)
 		}
 
 		// Only mark as edited if new value is different
-		value_changed = reflect.ValueOf(p_data).Elem().Interface() != data_backup
+		value_changed = *p_data != data_backup
 		if value_changed {
-			MarkItemEdited(id)
+			g.MarkItemEdited(id)
 		}
 	}
 	return value_changed
 }
 
-func TempInputIsActive(id ImGuiID) bool {
-	var g = GImGui
+func (g *ImGuiContext) TempInputIsActive(id ImGuiID) bool {
 	return (g.ActiveId == id && g.TempInputId == id)
 }
 
-func GetInputTextState(id ImGuiID) *ImGuiInputTextState {
-	var g = GImGui
+func (g *ImGuiContext) GetInputTextState(id ImGuiID) *ImGuiInputTextState {
 	if g.InputTextState.ID == id {
 		return &g.InputTextState
 	}
 	return nil
 } // Get input text state if active
 
-func InputText(label string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+func (g *ImGuiContext) InputText(label string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
 	IM_ASSERT((flags & ImGuiInputTextFlags_Multiline) == 0) // call InputTextMultiline()
-	return InputTextEx(label, "", char, &ImVec2{}, flags, callback, user_data)
+	return g.InputTextEx(label, "", char, &ImVec2{}, flags, callback, user_data)
 }
 
-func InputTextMultiline(label string, buf *[]byte, size ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
-	return InputTextEx(label, "", buf, &size, flags|ImGuiInputTextFlags_Multiline, callback, user_data)
+func (g *ImGuiContext) InputTextMultiline(label string, buf *[]byte, size ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return g.InputTextEx(label, "", buf, &size, flags|ImGuiInputTextFlags_Multiline, callback, user_data)
 }
 
-func InputTextWithHint(label string, hint string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+func (g *ImGuiContext) InputTextWithHint(label string, hint string, char *[]byte, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
 	IM_ASSERT((flags & ImGuiInputTextFlags_Multiline) == 0) // call InputTextMultiline()
-	return InputTextEx(label, hint, char, &ImVec2{}, flags, callback, user_data)
+	return g.InputTextEx(label, hint, char, &ImVec2{}, flags, callback, user_data)
+}
+
+// InputTextString is InputText() editing a Go string: the buffer grows as needed and s is only
+// assigned when the text changes. ImGuiInputTextFlags_CallbackResize is handled internally,
+// the other callback events are forwarded to callback.
+func (g *ImGuiContext) InputTextString(label string, s *string, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	IM_ASSERT((flags & ImGuiInputTextFlags_Multiline) == 0) // call InputTextMultilineString()
+	return g.inputTextString(label, "", s, &ImVec2{}, flags, callback, user_data)
+}
+
+func (g *ImGuiContext) InputTextMultilineString(label string, s *string, size ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	return g.inputTextString(label, "", s, &size, flags|ImGuiInputTextFlags_Multiline, callback, user_data)
+}
+
+func (g *ImGuiContext) InputTextWithHintString(label string, hint string, s *string, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback /*= L*/, user_data any) bool {
+	IM_ASSERT((flags & ImGuiInputTextFlags_Multiline) == 0) // call InputTextMultilineString()
+	return g.inputTextString(label, hint, s, &ImVec2{}, flags, callback, user_data)
+}
+
+type inputTextStringUserData struct {
+	ChainCallback         ImGuiInputTextCallback
+	ChainCallbackUserData any
 }
 
-func InputFloat(label string, v *float, step, step_fast float, format string, flags ImGuiInputTextFlags) bool {
+func inputTextStringCallback(data *ImGuiInputTextCallbackData) int {
+	var user_data = data.UserData.(*inputTextStringUserData)
+	if data.EventFlag == ImGuiInputTextFlags_CallbackResize {
+		// Grow the buffer, InputTextEx() copies the new text (and its zero-terminator) into it on return.
+		if int(len(data.Buf)) < data.BufSize {
+			data.Buf = append(data.Buf, make([]byte, data.BufSize-int(len(data.Buf)))...)
+		}
+	} else if user_data.ChainCallback != nil {
+		// Forward to user callback, if any
+		data.UserData = user_data.ChainCallbackUserData
+		return user_data.ChainCallback(data)
+	}
+	return 0
+}
+
+func (g *ImGuiContext) inputTextString(label string, hint string, s *string, size_arg *ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any) bool {
+	IM_ASSERT((flags & ImGuiInputTextFlags_CallbackResize) == 0) // The buffer is resized by InputTextString()
+	flags |= ImGuiInputTextFlags_CallbackResize
+
+	var buf = make([]byte, len(*s)+1)
+	copy(buf, *s)
+	var cb_user_data = inputTextStringUserData{
+		ChainCallback:         callback,
+		ChainCallbackUserData: user_data,
+	}
+	var value_changed = g.InputTextEx(label, hint, &buf, size_arg, flags, inputTextStringCallback, &cb_user_data)
+
+	if n := bytes.IndexByte(buf, 0); n >= 0 {
+		buf = buf[:n]
+	}
+	var text_changed = string(buf) != *s
+	if text_changed {
+		*s = string(buf)
+	}
+	if (flags & ImGuiInputTextFlags_EnterReturnsTrue) != 0 {
+		return value_changed
+	}
+	return text_changed
+}
+
+func (g *ImGuiContext) InputFloat(label string, v *float, step, step_fast float, format string, flags ImGuiInputTextFlags) bool {
 	flags |= ImGuiInputTextFlags_CharsScientific
 
 	var step_arg *float
@@ -106,22 +190,22 @@ func InputFloat(label string, v *float, step, step_fast float, format string, fl
 		step_fast_arg = &step_fast
 	}
 
-	return InputScalarFloat32(label, v, step_arg, step_fast_arg, format, flags)
+	return g.InputScalarFloat32(label, v, step_arg, step_fast_arg, format, flags)
 }
 
-func InputFloat2(label string, v *[2]float, format string, flags ImGuiInputTextFlags) bool {
-	return InputScalarFloat32s(label, (*v)[:], nil, nil, format, flags)
+func (g *ImGuiContext) InputFloat2(label string, v *[2]float, format string, flags ImGuiInputTextFlags) bool {
+	return g.InputScalarFloat32s(label, (*v)[:], nil, nil, format, flags)
 }
 
-func InputFloat3(label string, v *[3]float, format string /*= "%.3f"*/, flags ImGuiInputTextFlags) bool {
-	return InputScalarFloat32s(label, (*v)[:], nil, nil, format, flags)
+func (g *ImGuiContext) InputFloat3(label string, v *[3]float, format string /*= "%.3f"*/, flags ImGuiInputTextFlags) bool {
+	return g.InputScalarFloat32s(label, (*v)[:], nil, nil, format, flags)
 }
 
-func InputFloat4(label string, v *[4]float, format string /*= "%.3f"*/, flags ImGuiInputTextFlags) bool {
-	return InputScalarFloat32s(label, (*v)[:], nil, nil, format, flags)
+func (g *ImGuiContext) InputFloat4(label string, v *[4]float, format string /*= "%.3f"*/, flags ImGuiInputTextFlags) bool {
+	return g.InputScalarFloat32s(label, (*v)[:], nil, nil, format, flags)
 }
 
-func InputInt(label string, v *int, step int /*= 1*/, step_fast int /*= 100*/, flags ImGuiInputTextFlags) bool {
+func (g *ImGuiContext) InputInt(label string, v *int, step int /*= 1*/, step_fast int /*= 100*/, flags ImGuiInputTextFlags) bool {
 	// Hexadecimal input provided as a convenience but the flag name is awkward. Typically you'd use InputText() to parse your own data, if you want to handle prefixes.
 	var format = "%v"
 	if (flags & ImGuiInputTextFlags_CharsHexadecimal) != 0 {
@@ -138,22 +222,22 @@ func InputInt(label string, v *int, step int /*= 1*/, step_fast int /*= 100*/, f
 		step_fast_arg = &step_fast
 	}
 
-	return InputScalarInt32(label, v, step_arg, step_fast_arg, format, flags)
+	return g.InputScalarInt32(label, v, step_arg, step_fast_arg, format, flags)
 }
 
-func InputInt2(label string, v *[2]int, flags ImGuiInputTextFlags) bool {
-	return InputScalarInt32s(label, v[:], nil, nil, "%d", flags)
+func (g *ImGuiContext) InputInt2(label string, v *[2]int, flags ImGuiInputTextFlags) bool {
+	return g.InputScalarInt32s(label, v[:], nil, nil, "%d", flags)
 }
 
-func InputInt3(label string, v *[3]int, flags ImGuiInputTextFlags) bool {
-	return InputScalarInt32s(label, v[:], nil, nil, "%d", flags)
+func (g *ImGuiContext) InputInt3(label string, v *[3]int, flags ImGuiInputTextFlags) bool {
+	return g.InputScalarInt32s(label, v[:], nil, nil, "%d", flags)
 }
 
-func InputInt4(label string, v *[4]int, flags ImGuiInputTextFlags) bool {
-	return InputScalarInt32s(label, v[:], nil, nil, "%d", flags)
+func (g *ImGuiContext) InputInt4(label string, v *[4]int, flags ImGuiInputTextFlags) bool {
+	return g.InputScalarInt32s(label, v[:], nil, nil, "%d", flags)
 }
 
-func InputDouble(label string, v *double, step double /*= 0*/, step_fast double /*= 0*/, format string /*= "%.6f"*/, flags ImGuiInputTextFlags) bool {
+func (g *ImGuiContext) InputDouble(label string, v *double, step double /*= 0*/, step_fast double /*= 0*/, format string /*= "%.6f"*/, flags ImGuiInputTextFlags) bool {
 	flags |= ImGuiInputTextFlags_CharsScientific
 
 	var step_arg *double
@@ -166,15 +250,14 @@ func InputDouble(label string, v *double, step double /*= 0*/, step_fast double
 		step_fast_arg = &step_fast
 	}
 
-	return InputScalarFloat64(label, v, step_arg, step_fast_arg, format, flags)
+	return g.InputScalarFloat64(label, v, step_arg, step_fast_arg, format, flags)
 }
 
 func InputTextCalcLineCount(text string) int {
 	return int(strings.Count(text, "\n"))
 }
 
-func InputTextCalcTextSizeW(text []ImWchar, remaining *[]ImWchar, out_offset *ImVec2, stop_on_new_line bool) ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) InputTextCalcTextSizeW(text []ImWchar, remaining *[]ImWchar, out_offset *ImVec2, stop_on_new_line bool) ImVec2 {
 	var font = g.Font
 	var line_height = g.FontSize
 	var scale = line_height / font.FontSize
@@ -252,7 +335,7 @@ func runesContains(runes []rune, c rune) bool {
 }
 
 // Return false to discard a character.
-func InputTextFilterCharacter(p_char *rune, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any, input_source ImGuiInputSource) bool {
+func (g *ImGuiContext) InputTextFilterCharacter(p_char *rune, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, user_data any, input_source ImGuiInputSource) bool {
 	IM_ASSERT(input_source == ImGuiInputSource_Keyboard || input_source == ImGuiInputSource_Clipboard)
 	var c = *p_char
 
@@ -292,7 +375,6 @@ func InputTextFilterCharacter(p_char *rune, flags ImGuiInputTextFlags, callback
 		// We don't really intend to provide widespread support for it, but out of empathy for people stuck with using odd API, we support the bare minimum aka overriding the decimal point.
 		// Change the default decimal_point with:
 		//   ImGui::GetCurrentContext()->PlatformLocaleDecimalPoint = *localeconv()->decimal_point;
-		var g = GImGui
 		var c_decimal_point = (rune)(g.PlatformLocaleDecimalPoint)
 
 		// Allow 0-9 . - + * /
@@ -334,6 +416,7 @@ func InputTextFilterCharacter(p_char *rune, flags ImGuiInputTextFlags, callback
 	// Custom callback filter
 	if flags&ImGuiInputTextFlags_CallbackCharFilter != 0 {
 		var callback_data ImGuiInputTextCallbackData
+		callback_data.Ctx = g
 		callback_data.EventFlag = ImGuiInputTextFlags_CallbackCharFilter
 		callback_data.EventChar = (ImWchar)(c)
 		callback_data.Flags = flags
@@ -383,8 +466,8 @@ func ImStrbolW(buf_mid_line []ImWchar, buf_begin []ImWchar) []ImWchar { // find
 // (FIXME: Rather confusing and messy function, among the worse part of our codebase, expecting to rewrite a V2 at some point.. Partly because we are
 //
 //	doing UTF8 > U16 > UTF8 conversions on the go to easily interface with stb_textedit. Ideally should stay in UTF-8 all the time. See https://github.com/nothings/stb/issues/188)
-func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, callback_user_data any) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags ImGuiInputTextFlags, callback ImGuiInputTextCallback, callback_user_data any) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
@@ -393,7 +476,6 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 	IM_ASSERT(!((flags&ImGuiInputTextFlags_CallbackHistory) != 0 && (flags&ImGuiInputTextFlags_Multiline != 0)))        // Can't use both together (they both use up/down keys)
 	IM_ASSERT(!((flags&ImGuiInputTextFlags_CallbackCompletion) != 0 && (flags&ImGuiInputTextFlags_AllowTabInput != 0))) // Can't use both together (they both use tab key)
 
-	var g = GImGui
 	var io = g.IO
 	var style = g.Style
 
@@ -408,11 +490,11 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 	}
 
 	if is_multiline { // Open group before calling GetID() because groups tracks id created within their scope,
-		BeginGroup()
+		g.BeginGroup()
 	}
 
 	var id = window.GetIDs(label)
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 
 	var padding float
 	if label_size.x > 0.0 {
@@ -424,7 +506,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 		fontsize = g.FontSize * 8.0
 	}
 
-	var frame_size = CalcItemSize(*size_arg, CalcItemWidth(), fontsize+style.FramePadding.y*2.0) // Arbitrary default of 8 lines high for multi-line
+	var frame_size = g.CalcItemSize(*size_arg, g.CalcItemWidth(), fontsize+style.FramePadding.y*2.0) // Arbitrary default of 8 lines high for multi-line
 	var total_size = ImVec2{frame_size.x + padding, frame_size.y}
 
 	var frame_bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(frame_size)}
@@ -435,27 +517,27 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 	var item_status_flags ImGuiItemStatusFlags = 0
 	if is_multiline {
 		var backup_pos = window.DC.CursorPos
-		ItemSizeRect(&total_bb, style.FramePadding.y)
-		if !ItemAdd(&total_bb, id, &frame_bb, ImGuiItemFlags_Inputable) {
-			EndGroup()
+		g.ItemSizeRect(&total_bb, style.FramePadding.y)
+		if !g.ItemAdd(&total_bb, id, &frame_bb, ImGuiItemFlags_Inputable) {
+			g.EndGroup()
 			return false
 		}
 		item_status_flags = g.LastItemData.StatusFlags
 		window.DC.CursorPos = backup_pos
 
 		// We reproduce the contents of BeginChildFrame() in order to provide 'label' so our window internal data are easier to read/debug.
-		PushStyleColorVec(ImGuiCol_ChildBg, &style.Colors[ImGuiCol_FrameBg])
-		PushStyleFloat(ImGuiStyleVar_ChildRounding, style.FrameRounding)
-		PushStyleFloat(ImGuiStyleVar_ChildBorderSize, style.FrameBorderSize)
+		g.PushStyleColorVec(ImGuiCol_ChildBg, &style.Colors[ImGuiCol_FrameBg])
+		g.PushStyleFloat(ImGuiStyleVar_ChildRounding, style.FrameRounding)
+		g.PushStyleFloat(ImGuiStyleVar_ChildBorderSize, style.FrameBorderSize)
 
 		size := frame_bb.GetSize()
 
-		var child_visible = BeginChildEx(label, id, &size, true, ImGuiWindowFlags_NoMove)
-		PopStyleVar(2)
-		PopStyleColor(1)
+		var child_visible = g.BeginChildEx(label, id, &size, true, ImGuiWindowFlags_NoMove)
+		g.PopStyleVar(2)
+		g.PopStyleColor(1)
 		if !child_visible {
-			EndChild()
-			EndGroup()
+			g.EndChild()
+			g.EndGroup()
 			return false
 		}
 		draw_window = g.CurrentWindow                                                   // Child window
@@ -464,21 +546,21 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 		inner_size.x -= draw_window.ScrollbarSizes.x
 	} else {
 		// Support for internal ImGuiInputTextFlags_MergedItem flag, which could be redesigned as an ItemFlags if needed (with test performed in ItemAdd)
-		ItemSizeRect(&total_bb, style.FramePadding.y)
+		g.ItemSizeRect(&total_bb, style.FramePadding.y)
 		if (flags & ImGuiInputTextFlags_MergedItem) == 0 {
-			if !ItemAdd(&total_bb, id, &frame_bb, ImGuiItemFlags_Inputable) {
+			if !g.ItemAdd(&total_bb, id, &frame_bb, ImGuiItemFlags_Inputable) {
 				return false
 			}
 		}
 		item_status_flags = g.LastItemData.StatusFlags
 	}
-	var hovered = ItemHoverable(&frame_bb, id)
+	var hovered = g.ItemHoverable(&frame_bb, id)
 	if hovered {
 		g.MouseCursor = ImGuiMouseCursor_TextInput
 	}
 
 	// We are only allowed to access the state if we are already the active widget.
-	var state = GetInputTextState(id)
+	var state = g.GetInputTextState(id)
 
 	var focus_requested_by_code = (item_status_flags & ImGuiItemStatusFlags_FocusedByCode) != 0
 	var focus_requested_by_tabbing = (item_status_flags & ImGuiItemStatusFlags_FocusedByTabbing) != 0
@@ -518,7 +600,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 		resizeRune(&state.TextW, int(len(*buf)+1)) // wchar count <= UTF-8 count. we use +1 to make sure that .Data is always pointing to at least an empty string.
 		state.TextA = state.TextA[:0]
 		state.TextAIsValid = false // TextA is not valid yet (we will display buf until then)
-		state.CurLenW = ImTextStrFromUtf8(state.TextW, int(len(*buf)), string(*buf), &buf_end)
+		state.CurLenW = ImTextStrFromUtf8(state.TextW, int(len(state.TextW)), string(*buf), &buf_end)
 		state.CurLenA = (int)(len(*buf) - len(buf_end)) // We can't get the result from ImStrncpy() above because it is not UTF-8 aware. Here we'll cut off malformed UTF-8.
 
 		// Preserve cursor position and undo/redo stack if we come back to same widget
@@ -546,9 +628,9 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 
 	if g.ActiveId != id && init_make_active {
 		IM_ASSERT(state != nil && state.ID == id)
-		SetActiveID(id, window)
-		SetFocusID(id, window)
-		FocusWindow(window)
+		g.SetActiveID(id, window)
+		g.SetFocusID(id, window)
+		g.FocusWindow(window)
 
 		// Declare our inputs
 		IM_ASSERT(ImGuiNavInput_COUNT < 32)
@@ -557,18 +639,20 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			g.ActiveIdUsingNavDirMask |= (1 << ImGuiDir_Up) | (1 << ImGuiDir_Down)
 		}
 		g.ActiveIdUsingNavInputMask |= (1 << ImGuiNavInput_Cancel)
-		g.ActiveIdUsingKeyInputMask |= ((ImU64)(1 << ImGuiKey_Home)) | ((ImU64)(1 << ImGuiKey_End))
+		g.ActiveIdUsingKeyInputMask.SetBit(ImGuiKey_Home)
+		g.ActiveIdUsingKeyInputMask.SetBit(ImGuiKey_End)
 		if is_multiline {
-			g.ActiveIdUsingKeyInputMask |= ((ImU64)(1 << ImGuiKey_PageUp)) | ((ImU64)(1 << ImGuiKey_PageDown))
+			g.ActiveIdUsingKeyInputMask.SetBit(ImGuiKey_PageUp)
+			g.ActiveIdUsingKeyInputMask.SetBit(ImGuiKey_PageDown)
 		}
 		if flags&(ImGuiInputTextFlags_CallbackCompletion|ImGuiInputTextFlags_AllowTabInput) != 0 { // Disable keyboard tabbing out as we will use the \t character.
-			g.ActiveIdUsingKeyInputMask |= ((ImU64)(1 << ImGuiKey_Tab))
+			g.ActiveIdUsingKeyInputMask.SetBit(ImGuiKey_Tab)
 		}
 	}
 
 	// We have an edge case if ActiveId was set through another widget (e.g. widget being swapped), clear id immediately (don't wait until the end of the function)
 	if g.ActiveId == id && state == nil {
-		ClearActiveID()
+		g.ClearActiveID()
 	}
 
 	// Release focus when we click outside
@@ -615,7 +699,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 		password_font.FallbackGlyph = glyph
 		password_font.FallbackAdvanceX = glyph.AdvanceX
 		IM_ASSERT(len(password_font.Glyphs) == 0 && len(password_font.IndexAdvanceX) == 0 && len(password_font.IndexLookup) == 0)
-		PushFont(password_font)
+		g.PushFont(password_font)
 	}
 
 	// Process mouse inputs and character inputs
@@ -648,15 +732,15 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			state.SelectedAllMouseLock = true
 		} else if hovered && is_osx && io.MouseDoubleClicked[0] {
 			// Double-click select a word only, OS X style (by simulating keystrokes)
-			state.OnKeyPressed(STB_TEXTEDIT_K_WORDLEFT)
-			state.OnKeyPressed(STB_TEXTEDIT_K_WORDRIGHT | STB_TEXTEDIT_K_SHIFT)
+			state.OnKeyPressed(g, STB_TEXTEDIT_K_WORDLEFT)
+			state.OnKeyPressed(g, STB_TEXTEDIT_K_WORDRIGHT|STB_TEXTEDIT_K_SHIFT)
 		} else if io.MouseClicked[0] && !state.SelectedAllMouseLock {
 			if hovered {
-				stb_textedit_click(state, &state.Stb, mouse_x, mouse_y)
+				g.stb_textedit_click(state, &state.Stb, mouse_x, mouse_y)
 				state.CursorAnimReset()
 			}
 		} else if io.MouseDown[0] && !state.SelectedAllMouseLock && (io.MouseDelta.x != 0.0 || io.MouseDelta.y != 0.0) {
-			stb_textedit_drag(state, &state.Stb, mouse_x, mouse_y)
+			g.stb_textedit_drag(state, &state.Stb, mouse_x, mouse_y)
 			state.CursorAnimReset()
 			state.CursorFollow = true
 		}
@@ -667,11 +751,11 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 		// It is ill-defined whether the backend needs to send a \t character when pressing the TAB keys.
 		// Win32 and GLFW naturally do it but not SDL.
 		var ignore_char_inputs = (io.KeyCtrl && !io.KeyAlt) || (is_osx && io.KeySuper)
-		if (flags&ImGuiInputTextFlags_AllowTabInput != 0) && IsKeyPressedMap(ImGuiKey_Tab, true) && !ignore_char_inputs && !io.KeyShift && !is_readonly {
+		if (flags&ImGuiInputTextFlags_AllowTabInput != 0) && g.IsKeyPressedMap(ImGuiKey_Tab, true) && !ignore_char_inputs && !io.KeyShift && !is_readonly {
 			if !runesContains(io.InputQueueCharacters, '\t') {
 				var c = '\t' // Insert TAB
-				if InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Keyboard) {
-					state.OnKeyPressed((int)(c))
+				if g.InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Keyboard) {
+					state.OnKeyPressed(g, (int)(c))
 				}
 			}
 		}
@@ -686,8 +770,8 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 					if c == '\t' && io.KeyShift {
 						continue
 					}
-					if InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Keyboard) {
-						state.OnKeyPressed((int)(c))
+					if g.InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Keyboard) {
+						state.OnKeyPressed(g, (int)(c))
 					}
 				}
 			}
@@ -701,7 +785,12 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 	var cancel_edit = false
 	if g.ActiveId == id && !g.ActiveIdIsJustActivated && !clear_active_id {
 		IM_ASSERT(state != nil)
-		IM_ASSERT_USER_ERROR(io.KeyMods == GetMergedKeyModFlags(), "Mismatching io.KeyCtrl/io.KeyShift/io.KeyAlt/io.KeySuper vs io.KeyMods") // We rarely do this check, but if anything let's do it here.
+		IM_ASSERT_USER_ERROR(io.KeyMods == g.GetMergedKeyModFlags(), "Mismatching io.KeyCtrl/io.KeyShift/io.KeyAlt/io.KeySuper vs io.KeyMods") // We rarely do this check, but if anything let's do it here.
+
+		// Claim the routes of the editing shortcuts, so they are not also taken by a Shortcut() of the focused window
+		for _, key := range [...]ImGuiKey{ImGuiKey_A, ImGuiKey_C, ImGuiKey_V, ImGuiKey_X, ImGuiKey_Y, ImGuiKey_Z} {
+			g.SetShortcutRouting(ImGuiMod_Shortcut|ImGuiKeyChord(key), id, ImGuiInputFlags_RouteFocused)
+		}
 
 		var row_count_per_page = ImMaxInt((int)((inner_size.y-style.FramePadding.y)/g.FontSize), 1)
 		state.Stb.row_count_per_page = row_count_per_page
@@ -727,13 +816,13 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			is_shortcut_key = (io.KeyMods == ImGuiKeyModFlags_Super)
 		}
 
-		var is_cut = ((is_shortcut_key && IsKeyPressedMap(ImGuiKey_X, true)) || (is_shift_key_only && IsKeyPressedMap(ImGuiKey_Delete, true))) && !is_readonly && !is_password && (!is_multiline || state.HasSelection())
-		var is_copy = ((is_shortcut_key && IsKeyPressedMap(ImGuiKey_C, true)) || (is_ctrl_key_only && IsKeyPressedMap(ImGuiKey_Insert, true))) && !is_password && (!is_multiline || state.HasSelection())
-		var is_paste = ((is_shortcut_key && IsKeyPressedMap(ImGuiKey_V, true)) || (is_shift_key_only && IsKeyPressedMap(ImGuiKey_Insert, true))) && !is_readonly
-		var is_undo = ((is_shortcut_key && IsKeyPressedMap(ImGuiKey_Z, true)) && !is_readonly && is_undoable)
-		var is_redo = ((is_shortcut_key && IsKeyPressedMap(ImGuiKey_Y, true)) || (is_osx_shift_shortcut && IsKeyPressedMap(ImGuiKey_Z, true))) && !is_readonly && is_undoable
+		var is_cut = ((is_shortcut_key && g.IsKeyPressedMap(ImGuiKey_X, true)) || (is_shift_key_only && g.IsKeyPressedMap(ImGuiKey_Delete, true))) && !is_readonly && !is_password && (!is_multiline || state.HasSelection())
+		var is_copy = ((is_shortcut_key && g.IsKeyPressedMap(ImGuiKey_C, true)) || (is_ctrl_key_only && g.IsKeyPressedMap(ImGuiKey_Insert, true))) && !is_password && (!is_multiline || state.HasSelection())
+		var is_paste = ((is_shortcut_key && g.IsKeyPressedMap(ImGuiKey_V, true)) || (is_shift_key_only && g.IsKeyPressedMap(ImGuiKey_Insert, true))) && !is_readonly
+		var is_undo = ((is_shortcut_key && g.IsKeyPressedMap(ImGuiKey_Z, true)) && !is_readonly && is_undoable)
+		var is_redo = ((is_shortcut_key && g.IsKeyPressedMap(ImGuiKey_Y, true)) || (is_osx_shift_shortcut && g.IsKeyPressedMap(ImGuiKey_Z, true))) && !is_readonly && is_undoable
 
-		if IsKeyPressedMap(ImGuiKey_LeftArrow, true) {
+		if g.IsKeyPressedMap(ImGuiKey_LeftArrow, true) {
 			var k int = STB_TEXTEDIT_K_LEFT
 			if is_startend_key_down {
 				k = STB_TEXTEDIT_K_LINESTART
@@ -742,8 +831,8 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 					k = STB_TEXTEDIT_K_WORDLEFT
 				}
 			}
-			state.OnKeyPressed(k | k_mask)
-		} else if IsKeyPressedMap(ImGuiKey_RightArrow, true) {
+			state.OnKeyPressed(g, k|k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_RightArrow, true) {
 			var k int = STB_TEXTEDIT_K_RIGHT
 			if is_startend_key_down {
 				k = STB_TEXTEDIT_K_LINEEND
@@ -752,8 +841,8 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 					k = STB_TEXTEDIT_K_WORDRIGHT
 				}
 			}
-			state.OnKeyPressed(k | k_mask)
-		} else if IsKeyPressedMap(ImGuiKey_UpArrow, true) && is_multiline {
+			state.OnKeyPressed(g, k|k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_UpArrow, true) && is_multiline {
 			if io.KeyCtrl {
 				setScrollY(draw_window, ImMax(draw_window.Scroll.y-g.FontSize, 0.0))
 			} else {
@@ -761,59 +850,59 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 				if is_startend_key_down {
 					k = STB_TEXTEDIT_K_TEXTSTART
 				}
-				state.OnKeyPressed(k | k_mask)
+				state.OnKeyPressed(g, k|k_mask)
 			}
-		} else if IsKeyPressedMap(ImGuiKey_DownArrow, true) && is_multiline {
+		} else if g.IsKeyPressedMap(ImGuiKey_DownArrow, true) && is_multiline {
 			if io.KeyCtrl {
-				setScrollY(draw_window, ImMin(draw_window.Scroll.y+g.FontSize, GetScrollMaxY()))
+				setScrollY(draw_window, ImMin(draw_window.Scroll.y+g.FontSize, g.GetScrollMaxY()))
 			} else {
 				var k int = STB_TEXTEDIT_K_DOWN
 				if is_startend_key_down {
 					k = STB_TEXTEDIT_K_TEXTEND
 				}
-				state.OnKeyPressed(k | k_mask)
+				state.OnKeyPressed(g, k|k_mask)
 			}
-		} else if IsKeyPressedMap(ImGuiKey_PageUp, true) && is_multiline {
-			state.OnKeyPressed(STB_TEXTEDIT_K_PGUP | k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_PageUp, true) && is_multiline {
+			state.OnKeyPressed(g, STB_TEXTEDIT_K_PGUP|k_mask)
 			scroll_y -= float(row_count_per_page) * g.FontSize
-		} else if IsKeyPressedMap(ImGuiKey_PageDown, true) && is_multiline {
-			state.OnKeyPressed(STB_TEXTEDIT_K_PGDOWN | k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_PageDown, true) && is_multiline {
+			state.OnKeyPressed(g, STB_TEXTEDIT_K_PGDOWN|k_mask)
 			scroll_y += float(row_count_per_page) * g.FontSize
-		} else if IsKeyPressedMap(ImGuiKey_Home, true) {
+		} else if g.IsKeyPressedMap(ImGuiKey_Home, true) {
 			var k int = STB_TEXTEDIT_K_LINESTART
 			if is_startend_key_down {
 				k = STB_TEXTEDIT_K_TEXTSTART
 			}
-			state.OnKeyPressed(k | k_mask)
-		} else if IsKeyPressedMap(ImGuiKey_End, true) {
+			state.OnKeyPressed(g, k|k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_End, true) {
 			var k int = STB_TEXTEDIT_K_LINEEND
 			if is_startend_key_down {
 				k = STB_TEXTEDIT_K_TEXTEND
 			}
-			state.OnKeyPressed(k | k_mask)
-		} else if IsKeyPressedMap(ImGuiKey_Delete, true) && !is_readonly {
-			state.OnKeyPressed(STB_TEXTEDIT_K_DELETE | k_mask)
-		} else if IsKeyPressedMap(ImGuiKey_Backspace, true) && !is_readonly {
+			state.OnKeyPressed(g, k|k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_Delete, true) && !is_readonly {
+			state.OnKeyPressed(g, STB_TEXTEDIT_K_DELETE|k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_Backspace, true) && !is_readonly {
 			if !state.HasSelection() {
 				if is_wordmove_key_down {
-					state.OnKeyPressed(STB_TEXTEDIT_K_WORDLEFT | STB_TEXTEDIT_K_SHIFT)
+					state.OnKeyPressed(g, STB_TEXTEDIT_K_WORDLEFT|STB_TEXTEDIT_K_SHIFT)
 				} else if is_osx && io.KeySuper && !io.KeyAlt && !io.KeyCtrl {
-					state.OnKeyPressed(STB_TEXTEDIT_K_LINESTART | STB_TEXTEDIT_K_SHIFT)
+					state.OnKeyPressed(g, STB_TEXTEDIT_K_LINESTART|STB_TEXTEDIT_K_SHIFT)
 				}
 			}
-			state.OnKeyPressed(STB_TEXTEDIT_K_BACKSPACE | k_mask)
-		} else if IsKeyPressedMap(ImGuiKey_Enter, true) || IsKeyPressedMap(ImGuiKey_KeyPadEnter, true) {
+			state.OnKeyPressed(g, STB_TEXTEDIT_K_BACKSPACE|k_mask)
+		} else if g.IsKeyPressedMap(ImGuiKey_Enter, true) || g.IsKeyPressedMap(ImGuiKey_KeyPadEnter, true) {
 			var ctrl_enter_for_new_line = (flags & ImGuiInputTextFlags_CtrlEnterForNewLine) != 0
 			if !is_multiline || (ctrl_enter_for_new_line && !io.KeyCtrl) || (!ctrl_enter_for_new_line && io.KeyCtrl) {
 				enter_pressed = true
 				clear_active_id = true
 			} else if !is_readonly {
 				var c = '\n' // Insert new line
-				if InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Keyboard) {
-					state.OnKeyPressed((int)(c))
+				if g.InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Keyboard) {
+					state.OnKeyPressed(g, (int)(c))
 				}
 			}
-		} else if IsKeyPressedMap(ImGuiKey_Escape, true) {
+		} else if g.IsKeyPressedMap(ImGuiKey_Escape, true) {
 			clear_active_id = true
 			cancel_edit = true
 		} else if is_undo || is_redo {
@@ -821,9 +910,9 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			if is_undo {
 				action = STB_TEXTEDIT_K_UNDO
 			}
-			state.OnKeyPressed(action)
+			state.OnKeyPressed(g, action)
 			state.ClearSelection()
-		} else if is_shortcut_key && IsKeyPressedMap(ImGuiKey_A, true) {
+		} else if is_shortcut_key && g.IsKeyPressedMap(ImGuiKey_A, true) {
 			state.SelectAll()
 			state.CursorFollow = true
 		} else if is_cut || is_copy {
@@ -842,8 +931,8 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 
 				var clipboard_data_len = ImTextCountUtf8BytesFromStr(state.TextW[ib:], state.TextW[ie:]) + 1
 				var clipboard_data = make([]byte, clipboard_data_len)
-				ImTextStrToUtf8(clipboard_data, clipboard_data_len, state.TextW[ib:], state.TextW[ie:])
-				SetClipboardText(string(clipboard_data))
+				var clipboard_text_len = ImTextStrToUtf8(clipboard_data, clipboard_data_len, state.TextW[ib:], state.TextW[ie:])
+				g.SetClipboardText(string(clipboard_data[:clipboard_text_len]))
 			}
 			if is_cut {
 				if !state.HasSelection() {
@@ -853,7 +942,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 				stb_textedit_cut(state, &state.Stb)
 			}
 		} else if is_paste {
-			if clipboard := GetClipboardText(); clipboard != "" {
+			if clipboard := g.GetClipboardText(); clipboard != "" {
 				// Filter pasted buffer
 				var clipboard_len = (int)(len(clipboard))
 				var clipboard_filtered = make([]ImWchar, clipboard_len+1)
@@ -864,7 +953,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 					if c == 0 {
 						break
 					}
-					if !InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Clipboard) {
+					if !g.InputTextFilterCharacter(&c, flags, callback, callback_user_data, ImGuiInputSource_Clipboard) {
 						continue
 					}
 					clipboard_filtered[clipboard_filtered_len] = (ImWchar)(c)
@@ -930,14 +1019,14 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 
 				// The reason we specify the usage semantic (Completion/History) is that Completion needs to disable keyboard TABBING at the moment.
 				var event_flag ImGuiInputTextFlags = 0
-				var event_key = ImGuiKey_COUNT
-				if (flags&ImGuiInputTextFlags_CallbackCompletion) != 0 && IsKeyPressedMap(ImGuiKey_Tab, true) {
+				var event_key = ImGuiKey_None
+				if (flags&ImGuiInputTextFlags_CallbackCompletion) != 0 && g.IsKeyPressedMap(ImGuiKey_Tab, true) {
 					event_flag = ImGuiInputTextFlags_CallbackCompletion
 					event_key = ImGuiKey_Tab
-				} else if (flags&ImGuiInputTextFlags_CallbackHistory) != 0 && IsKeyPressedMap(ImGuiKey_UpArrow, true) {
+				} else if (flags&ImGuiInputTextFlags_CallbackHistory) != 0 && g.IsKeyPressedMap(ImGuiKey_UpArrow, true) {
 					event_flag = ImGuiInputTextFlags_CallbackHistory
 					event_key = ImGuiKey_UpArrow
-				} else if (flags&ImGuiInputTextFlags_CallbackHistory) != 0 && IsKeyPressedMap(ImGuiKey_DownArrow, true) {
+				} else if (flags&ImGuiInputTextFlags_CallbackHistory) != 0 && g.IsKeyPressedMap(ImGuiKey_DownArrow, true) {
 					event_flag = ImGuiInputTextFlags_CallbackHistory
 					event_key = ImGuiKey_DownArrow
 				} else if (flags&ImGuiInputTextFlags_CallbackEdit != 0) && state.Edited {
@@ -948,6 +1037,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 
 				if event_flag != 0 {
 					var callback_data ImGuiInputTextCallbackData
+					callback_data.Ctx = g
 					callback_data.EventFlag = event_flag
 					callback_data.Flags = flags
 					callback_data.UserData = callback_user_data
@@ -996,11 +1086,15 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 						}
 					}
 					if buf_dirty {
-						IM_ASSERT(callback_data.BufTextLen == (int)(len(callback_data.Buf))) // You need to maintain BufTextLen if you change the text!
+						var buf_text_len = int(bytes.IndexByte(callback_data.Buf, 0))
+						if buf_text_len < 0 {
+							buf_text_len = int(len(callback_data.Buf))
+						}
+						IM_ASSERT(callback_data.BufTextLen == buf_text_len) // You need to maintain BufTextLen if you change the text!
 						if callback_data.BufTextLen > backup_current_text_length && is_resizable {
 							resizeRune(&state.TextW, int(len(state.TextW))+(callback_data.BufTextLen-backup_current_text_length))
 						}
-						state.CurLenW = ImTextStrFromUtf8(state.TextW, int(len(state.TextW)), string(callback_data.Buf), nil)
+						state.CurLenW = ImTextStrFromUtf8(state.TextW, int(len(state.TextW)), string(callback_data.Buf[:callback_data.BufTextLen]), nil)
 						state.CurLenA = callback_data.BufTextLen // Assume correct length and valid UTF-8 from user, saves us an extra strlen()
 						state.CursorAnimReset()
 					}
@@ -1020,9 +1114,10 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			// of our owned buffer matches the size of the string object held by the user, and by design we allow InputText() to be used
 			// without any storage on user's side.
 			IM_ASSERT(apply_new_text_length >= 0)
-			var buf_size int
+			var buf_size = int(len(*buf))
 			if is_resizable {
 				var callback_data ImGuiInputTextCallbackData
+				callback_data.Ctx = g
 				callback_data.EventFlag = ImGuiInputTextFlags_CallbackResize
 				callback_data.Flags = flags
 				callback_data.Buf = *buf
@@ -1051,13 +1146,13 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 
 	// Release active ID at the end of the function (so e.g. pressing Return still does a final application of the value)
 	if clear_active_id && g.ActiveId == id {
-		ClearActiveID()
+		g.ClearActiveID()
 	}
 
 	// Render frame
 	if !is_multiline {
-		RenderNavHighlight(&frame_bb, id, 0)
-		RenderFrame(frame_bb.Min, frame_bb.Max, GetColorU32FromID(ImGuiCol_FrameBg, 1), true, style.FrameRounding)
+		g.RenderNavHighlight(&frame_bb, id, 0)
+		g.RenderFrame(frame_bb.Min, frame_bb.Max, g.GetColorU32FromID(ImGuiCol_FrameBg, 1), true, style.FrameRounding)
 	}
 
 	var clip_rect = ImVec4{frame_bb.Min.x, frame_bb.Min.y, frame_bb.Min.x + inner_size.x, frame_bb.Min.y + inner_size.y} // Not using frame_bb.Max because we have adjusted size
@@ -1153,10 +1248,10 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			}
 
 			// Calculate 2d position by finding the beginning of the line and measuring distance
-			cursor_offset.x = InputTextCalcTextSizeW(ImStrbolW(searches_input_ptr[0], text_begin), &searches_input_ptr[0], nil, false).x
+			cursor_offset.x = g.InputTextCalcTextSizeW(ImStrbolW(searches_input_ptr[0], text_begin), &searches_input_ptr[0], nil, false).x
 			cursor_offset.y = float(searches_result_line_no[0]) * g.FontSize
 			if searches_result_line_no[1] >= 0 {
-				select_start_offset.x = InputTextCalcTextSizeW(ImStrbolW(searches_input_ptr[1], text_begin), &searches_input_ptr[1], nil, false).x
+				select_start_offset.x = g.InputTextCalcTextSizeW(ImStrbolW(searches_input_ptr[1], text_begin), &searches_input_ptr[1], nil, false).x
 				select_start_offset.y = float(searches_result_line_no[1]) * g.FontSize
 			}
 
@@ -1209,8 +1304,8 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 				t = 1
 			}
 
-			var bg_color = GetColorU32FromID(ImGuiCol_TextSelectedBg, t) // FIXME: current code flow mandate that render_cursor is always true here, we are leaving the transparent one for tests.
-			var bg_offy_up float = -1.0                                  // FIXME: those offsets should be part of the style? they don't play so well with multi-line selection.
+			var bg_color = g.GetColorU32FromID(ImGuiCol_TextSelectedBg, t) // FIXME: current code flow mandate that render_cursor is always true here, we are leaving the transparent one for tests.
+			var bg_offy_up float = -1.0                                    // FIXME: those offsets should be part of the style? they don't play so well with multi-line selection.
 			var bg_offy_dn float = 2.0
 
 			if is_multiline {
@@ -1234,7 +1329,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 					}
 				} else {
 					var pp = slice[i:]
-					var rect_size = InputTextCalcTextSizeW(slice[i:], &pp, nil, true)
+					var rect_size = g.InputTextCalcTextSizeW(slice[i:], &pp, nil, true)
 					if rect_size.x <= 0.0 {
 						rect_size.x = IM_FLOOR(g.Font.GetCharAdvance((ImWchar)(' ')) * 0.50) // So we can see selected empty lines
 					}
@@ -1255,7 +1350,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			if is_displaying_hint {
 				c = ImGuiCol_TextDisabled
 			}
-			var col = GetColorU32FromID(c, 1)
+			var col = g.GetColorU32FromID(c, 1)
 			var clip = &clip_rect
 			if is_multiline {
 				clip = nil
@@ -1272,7 +1367,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			var cursor_screen_rect = ImRect{ImVec2{cursor_screen_pos.x, cursor_screen_pos.y - g.FontSize + 0.5}, ImVec2{cursor_screen_pos.x + 1.0, cursor_screen_pos.y - 1.5}}
 			if cursor_is_visible && cursor_screen_rect.Overlaps(ImRectFromVec4(&clip_rect)) {
 				bl := cursor_screen_rect.GetBL()
-				draw_window.DrawList.AddLine(&cursor_screen_rect.Min, &bl, GetColorU32FromID(ImGuiCol_Text, 1), 1)
+				draw_window.DrawList.AddLine(&cursor_screen_rect.Min, &bl, g.GetColorU32FromID(ImGuiCol_Text, 1), 1)
 			}
 
 			// Notify OS of text input position for advanced IME (-1 x offset so that Windows IME can cover our cursor. Bit of an extra nicety.)
@@ -1296,7 +1391,7 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 			if is_displaying_hint {
 				c = ImGuiCol_TextDisabled
 			}
-			var col = GetColorU32FromID(c, 1)
+			var col = g.GetColorU32FromID(c, 1)
 			var clip = &clip_rect
 			if is_multiline {
 				clip = nil
@@ -1306,29 +1401,30 @@ func InputTextEx(label string, hint string, buf *[]byte, size_arg *ImVec2, flags
 	}
 
 	if is_password && !is_displaying_hint {
-		PopFont()
+		g.PopFont()
 	}
 
 	if is_multiline {
-		Dummy(ImVec2{text_size.x, text_size.y + style.FramePadding.y})
-		EndChild()
-		EndGroup()
+		g.Dummy(ImVec2{text_size.x, text_size.y + style.FramePadding.y})
+		g.EndChild()
+		g.EndGroup()
 	}
 
 	// Log as text
 	if g.LogEnabled && (!is_password || is_displaying_hint) {
-		LogSetNextTextDecoration("{", "}")
-		LogRenderedText(&draw_pos, string(buf_display[:len(buf_display)-len(buf_display_end)]))
+		g.LogSetNextTextDecoration("{", "}")
+		g.LogRenderedText(&draw_pos, string(buf_display[:len(buf_display)-len(buf_display_end)]))
 	}
 
 	if label_size.x > 0 {
-		RenderText(ImVec2{frame_bb.Max.x + style.ItemInnerSpacing.x, frame_bb.Min.y + style.FramePadding.y}, label, true)
+		g.RenderText(ImVec2{frame_bb.Max.x + style.ItemInnerSpacing.x, frame_bb.Min.y + style.FramePadding.y}, label, true)
 	}
 
 	if value_changed && (flags&ImGuiInputTextFlags_NoMarkEdited) == 0 {
-		MarkItemEdited(id)
+		g.MarkItemEdited(id)
 	}
 
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags)
 	if (flags & ImGuiInputTextFlags_EnterReturnsTrue) != 0 {
 		return enter_pressed
 	} else {
@@ -1,25 +1,25 @@
 package imgui
 
-import "fmt"
-
-// Note: p_data, p_step, p_step_fast are _pointers_ to a memory address holding the data. For an Input widget, p_step and p_step_fast are optional.
-// Read code of e.g. InputFloat(), InputInt() etc. or examples in 'Demo.Widgets.Data Types' to understand how to use this function directly.
-func InputScalarInt64(label string, p_data, p_step, p_step_fast *int64, format string, flags ImGuiInputTextFlags) bool {
-	data_type := ImGuiDataType_S64
+// InputT edits a number of any of the ImGuiDataType types, e.g. InputT("Port", &port_u16, 1, 100, "%d", 0).
+// The step buttons are displayed when step is not 0, and clicking them with CTRL held uses step_fast.
+func InputT[T ImGuiScalar](label string, v *T, step T /*= 0*/, step_fast T /*= 0*/, format string, flags ImGuiInputTextFlags) bool {
+	return InputTContext[T](GImGui, label, v, step, step_fast, format, flags)
+}
 
-	var window = GetCurrentWindow()
+// InputTContext is InputT() for the context g.
+func InputTContext[T ImGuiScalar](g *ImGuiContext, label string, v *T, step T /*= 0*/, step_fast T /*= 0*/, format string, flags ImGuiInputTextFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 
 	if format == "" {
-		format = "%v"
+		format = DataTypeGetInfo(DataTypeOf[T]()).PrintFmt
 	}
 
-	var buf = []byte(fmt.Sprintf(format, *p_data))
+	var buf = []byte(DataTypeFormatStringT(*v, format))
 
 	var value_changed = false
 	if (flags & (ImGuiInputTextFlags_CharsHexadecimal | ImGuiInputTextFlags_CharsScientific)) == 0 {
@@ -28,14 +28,14 @@ func InputScalarInt64(label string, p_data, p_step, p_step_fast *int64, format s
 	flags |= ImGuiInputTextFlags_AutoSelectAll
 	flags |= ImGuiInputTextFlags_NoMarkEdited // We call MarkItemEdited() ourselves by comparing the actual data rather than the string.
 
-	if p_step != nil {
-		var button_size = GetFrameHeight()
+	if step != 0 {
+		var button_size = g.GetFrameHeight()
 
-		BeginGroup() // The only purpose of the group here is to allow the caller to query item data e.g. IsItemActive()
-		PushString(label)
-		SetNextItemWidth(ImMax(1.0, CalcItemWidth()-(button_size+style.ItemInnerSpacing.x)*2))
-		if InputText("", &buf, flags, nil, nil) { // PushId(label) + "" gives us the expected ID from outside point of view
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
+		g.BeginGroup() // The only purpose of the group here is to allow the caller to query item data e.g. IsItemActive()
+		g.PushString(label)
+		g.SetNextItemWidth(ImMax(1.0, g.CalcItemWidth()-(button_size+style.ItemInnerSpacing.x)*2))
+		if g.InputText("", &buf, flags, nil, nil) { // PushId(label) + "" gives us the expected ID from outside point of view
+			value_changed = DataTypeApplyOpFromTextT(string(buf), string(g.InputTextState.InitialTextA), v, format)
 		}
 
 		// Step buttons
@@ -43,437 +43,116 @@ func InputScalarInt64(label string, p_data, p_step, p_step_fast *int64, format s
 		style.FramePadding.x = style.FramePadding.y
 		var button_flags = ImGuiButtonFlags_Repeat | ImGuiButtonFlags_DontClosePopups
 		if flags&ImGuiInputTextFlags_ReadOnly != 0 {
-			BeginDisabled(true)
+			g.BeginDisabled(true)
 		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("-", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '-', p_data, p_data, step)
+		var step_arg = step
+		if g.IO.KeyCtrl && step_fast != 0 {
+			step_arg = step_fast
+		}
+		g.SameLine(0, style.ItemInnerSpacing.x)
+		if g.ButtonEx("-", &ImVec2{button_size, button_size}, button_flags) {
+			*v = DataTypeApplyOpT('-', *v, step_arg)
 			value_changed = true
 		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("+", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '+', p_data, p_data, step)
+		g.SameLine(0, style.ItemInnerSpacing.x)
+		if g.ButtonEx("+", &ImVec2{button_size, button_size}, button_flags) {
+			*v = DataTypeApplyOpT('+', *v, step_arg)
 			value_changed = true
 		}
 		if (flags & ImGuiInputTextFlags_ReadOnly) != 0 {
-			EndDisabled()
+			g.EndDisabled()
 		}
 
-		SameLine(0, style.ItemInnerSpacing.x)
-		TextEx(label, 0)
+		g.SameLine(0, style.ItemInnerSpacing.x)
+		g.TextEx(FindRenderedTextEnd(label), 0)
 		style.FramePadding = backup_frame_padding
 
-		PopID()
-		EndGroup()
+		g.PopID()
+		g.EndGroup()
 	} else {
-		if InputText(label, &buf, flags, nil, nil) {
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
+		if g.InputText(label, &buf, flags, nil, nil) {
+			value_changed = DataTypeApplyOpFromTextT(string(buf), string(g.InputTextState.InitialTextA), v, format)
 		}
 	}
 	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
+		g.MarkItemEdited(g.LastItemData.ID)
 	}
 
 	return value_changed
 }
 
-func InputScalarInt64s(label string, p_data []int64, p_step, p_step_fast *int64, format string, flags ImGuiInputTextFlags) bool {
-	var window = GetCurrentWindow()
-	if window.SkipItems {
-		return false
-	}
-
-	components := int(len(p_data))
-
-	var g = GImGui
-	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(components, CalcItemWidth())
-	for i := int(0); i < components; i++ {
-		p_data := &p_data[i]
-		PushID(i)
-		if i > 0 {
-			SameLine(0, g.Style.ItemInnerSpacing.x)
-		}
-		value_changed = InputScalarInt64("", p_data, p_step, p_step_fast, format, flags) || value_changed
-		PopID()
-		PopItemWidth()
-	}
-	PopID()
-
-	SameLine(0.0, g.Style.ItemInnerSpacing.x)
-	TextEx(label, 0)
-
-	EndGroup()
-	return value_changed
+// InputNT edits the components of v with one InputT() each, on a single line.
+func InputNT[T ImGuiScalar](label string, v []T, step T /*= 0*/, step_fast T /*= 0*/, format string, flags ImGuiInputTextFlags) bool {
+	return InputNTContext[T](GImGui, label, v, step, step_fast, format, flags)
 }
 
-// Note: p_data, p_step, p_step_fast are _pointers_ to a memory address holding the data. For an Input widget, p_step and p_step_fast are optional.
-// Read code of e.g. InputFloat(), InputInt() etc. or examples in 'Demo.Widgets.Data Types' to understand how to use this function directly.
-func InputScalarInt32(label string, p_data, p_step, p_step_fast *int32, format string, flags ImGuiInputTextFlags) bool {
-	data_type := ImGuiDataType_S32
-
-	var window = GetCurrentWindow()
+// InputNTContext is InputNT() for the context g.
+func InputNTContext[T ImGuiScalar](g *ImGuiContext, label string, v []T, step T /*= 0*/, step_fast T /*= 0*/, format string, flags ImGuiInputTextFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
-	var style = g.Style
-
-	if format == "" {
-		format = "%v"
-	}
-
-	var buf = []byte(fmt.Sprintf(format, *p_data))
-
 	var value_changed = false
-	if (flags & (ImGuiInputTextFlags_CharsHexadecimal | ImGuiInputTextFlags_CharsScientific)) == 0 {
-		flags |= ImGuiInputTextFlags_CharsDecimal
-	}
-	flags |= ImGuiInputTextFlags_AutoSelectAll
-	flags |= ImGuiInputTextFlags_NoMarkEdited // We call MarkItemEdited() ourselves by comparing the actual data rather than the string.
-
-	if p_step != nil {
-		var button_size = GetFrameHeight()
-
-		BeginGroup() // The only purpose of the group here is to allow the caller to query item data e.g. IsItemActive()
-		PushString(label)
-		SetNextItemWidth(ImMax(1.0, CalcItemWidth()-(button_size+style.ItemInnerSpacing.x)*2))
-		if InputText("", &buf, flags, nil, nil) { // PushId(label) + "" gives us the expected ID from outside point of view
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
-		}
-
-		// Step buttons
-		var backup_frame_padding = style.FramePadding
-		style.FramePadding.x = style.FramePadding.y
-		var button_flags = ImGuiButtonFlags_Repeat | ImGuiButtonFlags_DontClosePopups
-		if flags&ImGuiInputTextFlags_ReadOnly != 0 {
-			BeginDisabled(true)
-		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("-", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '-', p_data, p_data, step)
-			value_changed = true
-		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("+", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '+', p_data, p_data, step)
-			value_changed = true
-		}
-		if (flags & ImGuiInputTextFlags_ReadOnly) != 0 {
-			EndDisabled()
-		}
-
-		SameLine(0, style.ItemInnerSpacing.x)
-		TextEx(label, 0)
-		style.FramePadding = backup_frame_padding
-
-		PopID()
-		EndGroup()
-	} else {
-		if InputText(label, &buf, flags, nil, nil) {
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
-		}
-	}
-	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
-	}
-
-	return value_changed
-}
-
-func InputScalarInt32s(label string, p_data []int32, p_step, p_step_fast *int32, format string, flags ImGuiInputTextFlags) bool {
-	var window = GetCurrentWindow()
-	if window.SkipItems {
-		return false
-	}
-
-	components := int(len(p_data))
-
-	var g = GImGui
-	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(components, CalcItemWidth())
-	for i := int(0); i < components; i++ {
-		p_data := &p_data[i]
-		PushID(i)
+	g.BeginGroup()
+	g.PushString(label)
+	g.PushMultiItemsWidths(int(len(v)), g.CalcItemWidth())
+	for i := range v {
+		g.PushID(int(i))
 		if i > 0 {
-			SameLine(0, g.Style.ItemInnerSpacing.x)
+			g.SameLine(0, g.Style.ItemInnerSpacing.x)
 		}
-		value_changed = InputScalarInt32("", p_data, p_step, p_step_fast, format, flags) || value_changed
-		PopID()
-		PopItemWidth()
+		value_changed = InputTContext(g, "", &v[i], step, step_fast, format, flags) || value_changed
+		g.PopID()
+		g.PopItemWidth()
 	}
-	PopID()
-
-	SameLine(0.0, g.Style.ItemInnerSpacing.x)
-	TextEx(label, 0)
-
-	EndGroup()
-	return value_changed
-}
-
-// Note: p_data, p_step, p_step_fast are _pointers_ to a memory address holding the data. For an Input widget, p_step and p_step_fast are optional.
-// Read code of e.g. InputFloat(), InputInt() etc. or examples in 'Demo.Widgets.Data Types' to understand how to use this function directly.
-func InputScalarFloat64(label string, p_data, p_step, p_step_fast *float64, format string, flags ImGuiInputTextFlags) bool {
-	data_type := ImGuiDataType_Double
-
-	var window = GetCurrentWindow()
-	if window.SkipItems {
-		return false
-	}
-
-	var g = GImGui
-	var style = g.Style
-
-	if format == "" {
-		format = "%v"
-	}
-
-	var buf = []byte(fmt.Sprintf(format, *p_data))
-
-	var value_changed = false
-	if (flags & (ImGuiInputTextFlags_CharsHexadecimal | ImGuiInputTextFlags_CharsScientific)) == 0 {
-		flags |= ImGuiInputTextFlags_CharsDecimal
-	}
-	flags |= ImGuiInputTextFlags_AutoSelectAll
-	flags |= ImGuiInputTextFlags_NoMarkEdited // We call MarkItemEdited() ourselves by comparing the actual data rather than the string.
+	g.PopID()
 
-	if p_step != nil {
-		var button_size = GetFrameHeight()
-
-		BeginGroup() // The only purpose of the group here is to allow the caller to query item data e.g. IsItemActive()
-		PushString(label)
-		SetNextItemWidth(ImMax(1.0, CalcItemWidth()-(button_size+style.ItemInnerSpacing.x)*2))
-		if InputText("", &buf, flags, nil, nil) { // PushId(label) + "" gives us the expected ID from outside point of view
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
-		}
-
-		// Step buttons
-		var backup_frame_padding = style.FramePadding
-		style.FramePadding.x = style.FramePadding.y
-		var button_flags = ImGuiButtonFlags_Repeat | ImGuiButtonFlags_DontClosePopups
-		if flags&ImGuiInputTextFlags_ReadOnly != 0 {
-			BeginDisabled(true)
-		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("-", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '-', p_data, p_data, step)
-			value_changed = true
-		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("+", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '+', p_data, p_data, step)
-			value_changed = true
-		}
-		if (flags & ImGuiInputTextFlags_ReadOnly) != 0 {
-			EndDisabled()
-		}
-
-		SameLine(0, style.ItemInnerSpacing.x)
-		TextEx(label, 0)
-		style.FramePadding = backup_frame_padding
-
-		PopID()
-		EndGroup()
-	} else {
-		if InputText(label, &buf, flags, nil, nil) {
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
-		}
-	}
-	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
-	}
+	g.SameLine(0.0, g.Style.ItemInnerSpacing.x)
+	g.TextEx(FindRenderedTextEnd(label), 0)
 
+	g.EndGroup()
 	return value_changed
 }
 
-func InputScalarFloat64s(label string, p_data []float64, p_step, p_step_fast *float64, format string, flags ImGuiInputTextFlags) bool {
-	var window = GetCurrentWindow()
-	if window.SkipItems {
-		return false
-	}
-
-	components := int(len(p_data))
-
-	var g = GImGui
-	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(components, CalcItemWidth())
-	for i := int(0); i < components; i++ {
-		p_data := &p_data[i]
-		PushID(i)
-		if i > 0 {
-			SameLine(0, g.Style.ItemInnerSpacing.x)
-		}
-		value_changed = InputScalarFloat64("", p_data, p_step, p_step_fast, format, flags) || value_changed
-		PopID()
-		PopItemWidth()
+// inputStep dereferences the optional step of the InputScalarXXX() functions, 0 hides the step buttons.
+func inputStep[T ImGuiScalar](p_step *T) T {
+	if p_step == nil {
+		return 0
 	}
-	PopID()
-
-	SameLine(0.0, g.Style.ItemInnerSpacing.x)
-	TextEx(label, 0)
-
-	EndGroup()
-	return value_changed
+	return *p_step
 }
 
 // Note: p_data, p_step, p_step_fast are _pointers_ to a memory address holding the data. For an Input widget, p_step and p_step_fast are optional.
 // Read code of e.g. InputFloat(), InputInt() etc. or examples in 'Demo.Widgets.Data Types' to understand how to use this function directly.
-func InputScalarFloat32(label string, p_data, p_step, p_step_fast *float32, format string, flags ImGuiInputTextFlags) bool {
-	data_type := ImGuiDataType_Double
-
-	var window = GetCurrentWindow()
-	if window.SkipItems {
-		return false
-	}
-
-	var g = GImGui
-	var style = g.Style
-
-	if format == "" {
-		format = "%v"
-	}
-
-	var buf = []byte(fmt.Sprintf(format, *p_data))
-
-	var value_changed = false
-	if (flags & (ImGuiInputTextFlags_CharsHexadecimal | ImGuiInputTextFlags_CharsScientific)) == 0 {
-		flags |= ImGuiInputTextFlags_CharsDecimal
-	}
-	flags |= ImGuiInputTextFlags_AutoSelectAll
-	flags |= ImGuiInputTextFlags_NoMarkEdited // We call MarkItemEdited() ourselves by comparing the actual data rather than the string.
-
-	if p_step != nil {
-		var button_size = GetFrameHeight()
-
-		BeginGroup() // The only purpose of the group here is to allow the caller to query item data e.g. IsItemActive()
-		PushString(label)
-		SetNextItemWidth(ImMax(1.0, CalcItemWidth()-(button_size+style.ItemInnerSpacing.x)*2))
-		if InputText("", &buf, flags, nil, nil) { // PushId(label) + "" gives us the expected ID from outside point of view
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
-		}
-
-		// Step buttons
-		var backup_frame_padding = style.FramePadding
-		style.FramePadding.x = style.FramePadding.y
-		var button_flags = ImGuiButtonFlags_Repeat | ImGuiButtonFlags_DontClosePopups
-		if flags&ImGuiInputTextFlags_ReadOnly != 0 {
-			BeginDisabled(true)
-		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("-", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '-', p_data, p_data, step)
-			value_changed = true
-		}
-		SameLine(0, style.ItemInnerSpacing.x)
-		if ButtonEx("+", &ImVec2{button_size, button_size}, button_flags) {
-
-			step := p_step
-			if g.IO.KeyCtrl && p_step_fast != nil {
-				step = p_step_fast
-			}
-
-			DataTypeApplyOp(data_type, '+', p_data, p_data, step)
-			value_changed = true
-		}
-		if (flags & ImGuiInputTextFlags_ReadOnly) != 0 {
-			EndDisabled()
-		}
-
-		SameLine(0, style.ItemInnerSpacing.x)
-		TextEx(label, 0)
-		style.FramePadding = backup_frame_padding
+func (g *ImGuiContext) InputScalarInt64(label string, p_data, p_step, p_step_fast *int64, format string, flags ImGuiInputTextFlags) bool {
+	return InputTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
+}
 
-		PopID()
-		EndGroup()
-	} else {
-		if InputText(label, &buf, flags, nil, nil) {
-			value_changed = DataTypeApplyOpFromText(string(buf), string(g.InputTextState.InitialTextA), data_type, p_data, format)
-		}
-	}
-	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
-	}
+func (g *ImGuiContext) InputScalarInt64s(label string, p_data []int64, p_step, p_step_fast *int64, format string, flags ImGuiInputTextFlags) bool {
+	return InputNTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
+}
 
-	return value_changed
+func (g *ImGuiContext) InputScalarInt32(label string, p_data, p_step, p_step_fast *int32, format string, flags ImGuiInputTextFlags) bool {
+	return InputTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
 }
 
-func InputScalarFloat32s(label string, p_data []float32, p_step, p_step_fast *float32, format string, flags ImGuiInputTextFlags) bool {
-	var window = GetCurrentWindow()
-	if window.SkipItems {
-		return false
-	}
+func (g *ImGuiContext) InputScalarInt32s(label string, p_data []int32, p_step, p_step_fast *int32, format string, flags ImGuiInputTextFlags) bool {
+	return InputNTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
+}
 
-	components := int(len(p_data))
+func (g *ImGuiContext) InputScalarFloat64(label string, p_data, p_step, p_step_fast *float64, format string, flags ImGuiInputTextFlags) bool {
+	return InputTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
+}
 
-	var g = GImGui
-	var value_changed = false
-	BeginGroup()
-	PushString(label)
-	PushMultiItemsWidths(components, CalcItemWidth())
-	for i := int(0); i < components; i++ {
-		p_data := &p_data[i]
-		PushID(i)
-		if i > 0 {
-			SameLine(0, g.Style.ItemInnerSpacing.x)
-		}
-		value_changed = InputScalarFloat32("", p_data, p_step, p_step_fast, format, flags) || value_changed
-		PopID()
-		PopItemWidth()
-	}
-	PopID()
+func (g *ImGuiContext) InputScalarFloat64s(label string, p_data []float64, p_step, p_step_fast *float64, format string, flags ImGuiInputTextFlags) bool {
+	return InputNTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
+}
 
-	SameLine(0.0, g.Style.ItemInnerSpacing.x)
-	TextEx(label, 0)
+func (g *ImGuiContext) InputScalarFloat32(label string, p_data, p_step, p_step_fast *float32, format string, flags ImGuiInputTextFlags) bool {
+	return InputTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
+}
 
-	EndGroup()
-	return value_changed
+func (g *ImGuiContext) InputScalarFloat32s(label string, p_data []float32, p_step, p_step_fast *float32, format string, flags ImGuiInputTextFlags) bool {
+	return InputNTContext(g, label, p_data, inputStep(p_step), inputStep(p_step_fast), format, flags)
 }
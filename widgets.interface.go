@@ -2,7 +2,8 @@ package imgui
 
 import (
 	"fmt"
-	"reflect"
+	"math"
+	"strings"
 	"unsafe"
 )
 
@@ -29,115 +30,252 @@ func PatchFormatStringFloatToInt(format string) string {
 	return format
 }
 
-func DataTypeFormatString(data_type ImGuiDataType, p_data any, format string) string {
-	return fmt.Sprintf(format, p_data)
+// DataTypeOf returns the ImGuiDataType of T.
+// T may be a named type, so it is told from its size and arithmetic rather than from a type switch.
+func DataTypeOf[T ImGuiScalar]() ImGuiDataType {
+	var zero, one T = 0, 1
+	var is_float = one/2 != zero
+	var is_signed = zero-one < zero
+	switch unsafe.Sizeof(zero) {
+	case 1:
+		if is_signed {
+			return ImGuiDataType_S8
+		}
+		return ImGuiDataType_U8
+	case 2:
+		if is_signed {
+			return ImGuiDataType_S16
+		}
+		return ImGuiDataType_U16
+	case 4:
+		if is_float {
+			return ImGuiDataType_Float
+		}
+		if is_signed {
+			return ImGuiDataType_S32
+		}
+		return ImGuiDataType_U32
+	}
+	if is_float {
+		return ImGuiDataType_Double
+	}
+	if is_signed {
+		return ImGuiDataType_S64
+	}
+	return ImGuiDataType_U64
 }
 
-func DataTypeApplyOp(data_type ImGuiDataType, op int, output any, arg_1 any, arg_2 any) {
-	//FIXME (porting) overflow handling was removed, need to add it back?
-	IM_ASSERT(op == '+' || op == '-')
-	switch data_type {
+func DataTypeIsFloatingPoint[T ImGuiScalar]() bool {
+	var data_type = DataTypeOf[T]()
+	return data_type == ImGuiDataType_Float || data_type == ImGuiDataType_Double
+}
+
+// DataTypeGetRange returns the lowest and highest values of T (-FLT_MAX..FLT_MAX for floats), the bounds of an unbounded Drag widget.
+func DataTypeGetRange[T ImGuiScalar]() (min, max T) {
+	var lo, hi int64
+	switch DataTypeOf[T]() {
 	case ImGuiDataType_S8:
-		if op == '+' {
-			*(output.(*ImS8)) = *(arg_1.(*ImS8)) + *(arg_2.(*ImS8))
-		}
-		if op == '-' {
-			*(output.(*ImS8)) = *(arg_1.(*ImS8)) - *(arg_2.(*ImS8))
-		}
-		return
+		lo, hi = math.MinInt8, math.MaxInt8
 	case ImGuiDataType_U8:
-		if op == '+' {
-			*(output.(*ImU8)) = *(arg_1.(*ImU8)) + *(arg_2.(*ImU8))
-		}
-		if op == '-' {
-			*(output.(*ImU8)) = *(arg_1.(*ImU8)) - *(arg_2.(*ImU8))
-		}
-		return
+		lo, hi = 0, math.MaxUint8
 	case ImGuiDataType_S16:
-		if op == '+' {
-			*(output.(*ImS16)) = *(arg_1.(*ImS16)) + *(arg_2.(*ImS16))
-		}
-		if op == '-' {
-			*(output.(*ImS16)) = *(arg_1.(*ImS16)) - *(arg_2.(*ImS16))
-		}
-		return
+		lo, hi = math.MinInt16, math.MaxInt16
 	case ImGuiDataType_U16:
-		if op == '+' {
-			*(output.(*ImU16)) = *(arg_1.(*ImU16)) + *(arg_2.(*ImU16))
-		}
-		if op == '-' {
-			*(output.(*ImU16)) = *(arg_1.(*ImU16)) - *(arg_2.(*ImU16))
-		}
-		return
+		lo, hi = 0, math.MaxUint16
 	case ImGuiDataType_S32:
-		if op == '+' {
-			*(output.(*ImS32)) = *(arg_1.(*ImS32)) + *(arg_2.(*ImS32))
-		}
-		if op == '-' {
-			*(output.(*ImS32)) = *(arg_1.(*ImS32)) - *(arg_2.(*ImS32))
-		}
-		return
+		lo, hi = math.MinInt32, math.MaxInt32
 	case ImGuiDataType_U32:
-		if op == '+' {
-			*(output.(*ImU32)) = *(arg_1.(*ImU32)) + *(arg_2.(*ImU32))
-		}
-		if op == '-' {
-			*(output.(*ImU32)) = *(arg_1.(*ImU32)) - *(arg_2.(*ImU32))
-		}
-		return
+		lo, hi = 0, math.MaxUint32
 	case ImGuiDataType_S64:
-		if op == '+' {
-			*(output.(*ImS64)) = *(arg_1.(*ImS64)) + *(arg_2.(*ImS64))
-		}
-		if op == '-' {
-			*(output.(*ImS64)) = *(arg_1.(*ImS64)) - *(arg_2.(*ImS64))
+		lo, hi = math.MinInt64, math.MaxInt64
+	case ImGuiDataType_U64:
+		var u64_max uint64 = math.MaxUint64
+		return 0, T(u64_max)
+	case ImGuiDataType_Float:
+		var flt_max float64 = math.MaxFloat32
+		return T(-flt_max), T(flt_max)
+	case ImGuiDataType_Double:
+		var dbl_max float64 = math.MaxFloat64
+		return T(-dbl_max), T(dbl_max)
+	}
+	return T(lo), T(hi)
+}
+
+// DataTypeFromFloat64 converts v to T, saturating integer types to their range.
+func DataTypeFromFloat64[T ImGuiScalar](v float64) T {
+	if DataTypeIsFloatingPoint[T]() {
+		return T(v)
+	}
+	var lo, hi = DataTypeGetRange[T]()
+	if v <= float64(lo) {
+		return lo
+	}
+	if v >= float64(hi) {
+		return hi
+	}
+	return T(v)
+}
+
+// ImParseFormatVerb returns the verb of the first specifier of a format string, e.g. 'f' for "%.3f ms", or 0.
+func ImParseFormatVerb(format string) byte {
+	var fmt_start = ImParseFormatFindStart(format)
+	var fmt_end = ImParseFormatFindEnd(fmt_start)
+	if fmt_end == 0 {
+		return 0
+	}
+	return fmt_start[fmt_end-1]
+}
+
+// ImParseFormatTrimDecorations returns the first specifier of a format string without its prefix and suffix, e.g. "%.3f" for "ratio = %.3f ms".
+func ImParseFormatTrimDecorations(format string) string {
+	var fmt_start = ImParseFormatFindStart(format)
+	if len(fmt_start) == 0 {
+		return format
+	}
+	return fmt_start[:ImParseFormatFindEnd(fmt_start)]
+}
+
+// DataTypeFormatStringT formats v with a printf format. An integer verb displays a float truncated, and a float verb displays an integer as a float,
+// so that e.g. DragT() on a float64 with "%d" behaves as in C.
+func DataTypeFormatStringT[T ImGuiScalar](v T, format string) string {
+	switch ImParseFormatVerb(format) {
+	case 'd', 'i', 'u', 'x', 'X', 'o', 'b', 'c':
+		if DataTypeIsFloatingPoint[T]() {
+			return fmt.Sprintf(format, int64(v))
+		}
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		if !DataTypeIsFloatingPoint[T]() {
+			return fmt.Sprintf(format, float64(v))
 		}
-		return
+	}
+	return fmt.Sprintf(format, v)
+}
+
+func DataTypeFormatString(data_type ImGuiDataType, p_data any, format string) string {
+	switch data_type {
+	case ImGuiDataType_S8:
+		return DataTypeFormatStringT(*p_data.(*int8), format)
+	case ImGuiDataType_U8:
+		return DataTypeFormatStringT(*p_data.(*uint8), format)
+	case ImGuiDataType_S16:
+		return DataTypeFormatStringT(*p_data.(*int16), format)
+	case ImGuiDataType_U16:
+		return DataTypeFormatStringT(*p_data.(*uint16), format)
+	case ImGuiDataType_S32:
+		return DataTypeFormatStringT(*p_data.(*int32), format)
+	case ImGuiDataType_U32:
+		return DataTypeFormatStringT(*p_data.(*uint32), format)
+	case ImGuiDataType_S64:
+		return DataTypeFormatStringT(*p_data.(*int64), format)
 	case ImGuiDataType_U64:
-		if op == '+' {
-			*(output.(*ImU64)) = *(arg_1.(*ImU64)) + *(arg_2.(*ImU64))
+		return DataTypeFormatStringT(*p_data.(*uint64), format)
+	case ImGuiDataType_Float:
+		return DataTypeFormatStringT(*p_data.(*float32), format)
+	case ImGuiDataType_Double:
+		return DataTypeFormatStringT(*p_data.(*float64), format)
+	}
+	IM_ASSERT(false)
+	return ""
+}
+
+// DataTypeApplyOpT returns arg_1 + arg_2 or arg_1 - arg_2, clamping integer overflows.
+func DataTypeApplyOpT[T ImGuiScalar](op int, arg_1, arg_2 T) T {
+	IM_ASSERT(op == '+' || op == '-')
+	var result = arg_1 + arg_2
+	if op == '-' {
+		result = arg_1 - arg_2
+	}
+	if DataTypeIsFloatingPoint[T]() {
+		return result
+	}
+	var lo, hi = DataTypeGetRange[T]()
+	var zero T
+	if op == '+' {
+		if arg_2 > zero && result < arg_1 {
+			return hi
 		}
-		if op == '-' {
-			*(output.(*ImU64)) = *(arg_1.(*ImU64)) - *(arg_2.(*ImU64))
+		if arg_2 < zero && result > arg_1 {
+			return lo
 		}
-		return
-	case ImGuiDataType_Float:
-		if op == '+' {
-			*(output.(*float)) = *(arg_1.(*float)) + *(arg_2.(*float))
+	} else {
+		if arg_2 > zero && result > arg_1 {
+			return lo
 		}
-		if op == '-' {
-			*(output.(*float)) = *(arg_1.(*float)) - *(arg_2.(*float))
+		if arg_2 < zero && result < arg_1 {
+			return hi
 		}
-		return
+	}
+	return result
+}
+
+func DataTypeApplyOp(data_type ImGuiDataType, op int, output any, arg_1 any, arg_2 any) {
+	switch data_type {
+	case ImGuiDataType_S8:
+		*output.(*int8) = DataTypeApplyOpT(op, *arg_1.(*int8), *arg_2.(*int8))
+	case ImGuiDataType_U8:
+		*output.(*uint8) = DataTypeApplyOpT(op, *arg_1.(*uint8), *arg_2.(*uint8))
+	case ImGuiDataType_S16:
+		*output.(*int16) = DataTypeApplyOpT(op, *arg_1.(*int16), *arg_2.(*int16))
+	case ImGuiDataType_U16:
+		*output.(*uint16) = DataTypeApplyOpT(op, *arg_1.(*uint16), *arg_2.(*uint16))
+	case ImGuiDataType_S32:
+		*output.(*int32) = DataTypeApplyOpT(op, *arg_1.(*int32), *arg_2.(*int32))
+	case ImGuiDataType_U32:
+		*output.(*uint32) = DataTypeApplyOpT(op, *arg_1.(*uint32), *arg_2.(*uint32))
+	case ImGuiDataType_S64:
+		*output.(*int64) = DataTypeApplyOpT(op, *arg_1.(*int64), *arg_2.(*int64))
+	case ImGuiDataType_U64:
+		*output.(*uint64) = DataTypeApplyOpT(op, *arg_1.(*uint64), *arg_2.(*uint64))
+	case ImGuiDataType_Float:
+		*output.(*float32) = DataTypeApplyOpT(op, *arg_1.(*float32), *arg_2.(*float32))
 	case ImGuiDataType_Double:
-		if op == '+' {
-			*(output.(*double)) = *(arg_1.(*double)) + *(arg_2.(*double))
+		*output.(*float64) = DataTypeApplyOpT(op, *arg_1.(*float64), *arg_2.(*float64))
+	default:
+		IM_ASSERT(false)
+	}
+}
+
+// DataTypeScanT parses the number at the start of buf, saturated to the range of T. Integers are read in hexadecimal when hex is set.
+func DataTypeScanT[T ImGuiScalar](buf string, hex bool) (T, bool) {
+	var verb = "%d"
+	if hex {
+		verb = "%x"
+	}
+	switch DataTypeOf[T]() {
+	case ImGuiDataType_Float, ImGuiDataType_Double:
+		var v float64
+		if n, _ := fmt.Sscan(buf, &v); n < 1 {
+			return 0, false
 		}
-		if op == '-' {
-			*(output.(*double)) = *(arg_1.(*double)) - *(arg_2.(*double))
+		return T(v), true
+	case ImGuiDataType_U64:
+		var v uint64
+		if n, _ := fmt.Sscanf(buf, verb, &v); n < 1 {
+			return 0, false
 		}
-		return
-	case ImGuiDataType_COUNT:
-		break
+		return T(v), true
 	}
-	IM_ASSERT(false)
+	var v int64
+	if n, _ := fmt.Sscanf(buf, verb, &v); n < 1 {
+		return 0, false
+	}
+	var lo, hi = DataTypeGetRange[T]()
+	return T(ImClampInt64(v, int64(lo), int64(hi))), true
 }
 
-// DataTypeApplyOpFromText User can input math operators (e.g. +100) to edit a numerical values.
+// DataTypeApplyOpFromTextT User can input math operators (e.g. +100) to edit a numerical values.
 // NB: This is _not_ a full expression evaluator. We should probably add one and replace this dumb mess..
-func DataTypeApplyOpFromText(buf string, initial_value_buf string, data_type ImGuiDataType, p_data any, format string) bool {
-	for buf[0] == ' ' || buf[0] == '\t' {
-		buf = buf[1:]
+func DataTypeApplyOpFromTextT[T ImGuiScalar](buf string, initial_value_buf string, p_data *T, format string) bool {
+	buf = strings.TrimLeft(buf, " \t")
+	if len(buf) == 0 {
+		return false
 	}
 
 	// We don't support '-' op because it would conflict with inputing negative value.
 	// Instead you can use +-100 to subtract from an existing value
 	var op = buf[0]
 	if op == '+' || op == '*' || op == '/' {
-		buf = buf[1:]
-		for buf[0] == ' ' || buf[0] == '\t' {
-			buf = buf[1:]
-		}
+		buf = strings.TrimLeft(buf[1:], " \t")
 	} else {
 		op = 0
 	}
@@ -145,189 +283,176 @@ func DataTypeApplyOpFromText(buf string, initial_value_buf string, data_type ImG
 		return false
 	}
 
-	// Copy the value in an opaque buffer so we can compare at the end of the function if it changed at all.
-	var type_info = DataTypeGetInfo(data_type)
-
-	if format == "" {
-		format = type_info.ScanFmt
+	var hex = false
+	switch ImParseFormatVerb(format) {
+	case 'x', 'X':
+		hex = true
 	}
 
-	// FIXME-LEGACY: The aim is to remove those operators and write a proper expression evaluator at some point..
-	var arg1i int = 0
-	if data_type == ImGuiDataType_S32 {
-		var v = (p_data).(*int)
-		var data_backup = *v
-		var arg0i = *v
-		var arg1f = 0.0
-		if n, _ := fmt.Sscanf(initial_value_buf, format, &arg0i); op != 0 && n < 1 {
-			return false
-		}
-		// Store operand in a float so we can use fractional value for multipliers (*1.1), but constant always parsed as integer so we can fit big integers (e.g. 2000000003) past float precision
-		if op == '+' {
-			if n, _ := fmt.Sscanf(buf, "%d", &arg1i); n != 0 {
-				*v = arg0i + arg1i
-			}
-			// Add (use "+-" to subtract)
-		} else if op == '*' {
-			if n, _ := fmt.Sscanf(buf, "%f", &arg1f); n != 0 {
-				*v = arg0i * int(arg1f)
-			}
-			// Multiply
-		} else if op == '/' {
-			if n, _ := fmt.Sscanf(buf, "%f", &arg1f); n != 0 && arg1f != 0.0 {
-				*v = arg0i / int(arg1f)
-			}
-			// Divide
-		} else {
-			// Assign constant
-			if n, _ := fmt.Sscanf(buf, format, &arg1i); n == 1 {
-				*v = arg1i
-			}
-		}
-
-		return data_backup != *v
-	} else if data_type == ImGuiDataType_Float {
-		// For floats we have to ignore format with precision (e.g. "%.2f") because sscanf doesn't take them in
-		format = "%f"
-		var v = p_data.(*float)
-		var data_backup = *v
-		var arg0f, arg1f float = *v, 0.0
-		if n, _ := fmt.Sscanf(initial_value_buf, format, &arg0f); n < 1 && op != 0 {
-			return false
-		}
-		if n, _ := fmt.Sscanf(buf, format, &arg1f); n < 1 {
-			return false
-		}
-		if op == '+' {
-			*v = arg0f + arg1f
-			// Add (use "+-" to subtract)
-		} else if op == '*' {
-			*v = arg0f * arg1f
-			// Multiply
-		} else if op == '/' {
-			if arg1f != 0.0 {
-				*v = arg0f / arg1f
-			}
-			// Divide
-		} else {
-			*v = arg1f // Assign constant
+	var data_backup = *p_data
+	if op == 0 {
+		// Assign constant
+		if v, ok := DataTypeScanT[T](buf, hex); ok {
+			*p_data = v
 		}
+		return *p_data != data_backup
+	}
 
-		return data_backup != *v
-	} else if data_type == ImGuiDataType_Double {
-		format = "%lf" // scanf differentiate float/double unlike printf which forces everything to double because of ellipsis
-		var v = p_data.(*double)
-		var data_backup = *v
-		var arg0f, arg1f = *v, 0.0
-		if n, _ := fmt.Sscanf(initial_value_buf, format, &arg0f); n < 1 && op != 0 {
-			return false
+	var arg0, ok = DataTypeScanT[T](strings.TrimSpace(initial_value_buf), hex)
+	if !ok {
+		return false
+	}
+	switch op {
+	case '+': // Add (use "+-" to subtract)
+		var arg_op = int('+')
+		if buf[0] == '-' {
+			arg_op, buf = '-', buf[1:] // Also subtract from unsigned types
 		}
-		if n, _ := fmt.Sscanf(buf, format, &arg1f); n < 1 {
-			return false
+		if arg1, ok := DataTypeScanT[T](buf, hex); ok {
+			*p_data = DataTypeApplyOpT(arg_op, arg0, arg1)
 		}
-		if op == '+' {
-			*v = arg0f + arg1f
-			// Add (use "+-" to subtract)
-		} else if op == '*' {
-			*v = arg0f * arg1f
-			// Multiply
-		} else if op == '/' {
-			if arg1f != 0.0 {
-				*v = arg0f / arg1f
-			}
-			// Divide
-		} else {
-			*v = arg1f // Assign constant
+	case '*': // Multiply, the operand is a float so we can use fractional value for multipliers (*1.1)
+		if arg1, ok := DataTypeScanT[float64](buf, false); ok {
+			*p_data = DataTypeFromFloat64[T](float64(arg0) * arg1)
 		}
-		return data_backup != *v
-	} else if data_type == ImGuiDataType_U32 || data_type == ImGuiDataType_S64 || data_type == ImGuiDataType_U64 {
-		var data_backup = reflect.ValueOf(p_data).Elem().Interface()
-
-		// All other types assign constant
-		// We don't bother handling support for legacy operators since they are a little too crappy. Instead we will later implement a proper expression evaluator in the future.
-		if n, _ := fmt.Sscanf(buf, format, p_data); n < 1 {
-			return false
+	case '/': // Divide
+		if arg1, ok := DataTypeScanT[float64](buf, false); ok && arg1 != 0.0 {
+			*p_data = DataTypeFromFloat64[T](float64(arg0) / arg1)
 		}
+	}
+	return *p_data != data_backup
+}
 
-		return data_backup != reflect.ValueOf(p_data).Elem().Interface()
-	} else {
-		var data_backup = reflect.ValueOf(p_data).Elem().Interface()
-
-		// Small types need a 32-bit buffer to receive the result from scanf()
-		var v32 int
-		if n, _ := fmt.Sscanf(buf, format, &v32); n < 1 {
-			return false
-		}
-		if data_type == ImGuiDataType_S8 {
-			*p_data.(*int8) = int8(ImClampInt(v32, -128, 127))
-		} else if data_type == ImGuiDataType_U8 {
-			*p_data.(*uint8) = uint8(ImClampInt(v32, 0, 255))
-		} else if data_type == ImGuiDataType_S16 {
-			*p_data.(*int16) = int16(ImClampInt(v32, -32768, 32767))
-		} else if data_type == ImGuiDataType_U16 {
-			*p_data.(*uint16) = uint16(ImClampInt(v32, 0, 65535))
-		} else {
-			IM_ASSERT(false)
-		}
+func DataTypeApplyOpFromText(buf string, initial_value_buf string, data_type ImGuiDataType, p_data any, format string) bool {
+	switch data_type {
+	case ImGuiDataType_S8:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*int8), format)
+	case ImGuiDataType_U8:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*uint8), format)
+	case ImGuiDataType_S16:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*int16), format)
+	case ImGuiDataType_U16:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*uint16), format)
+	case ImGuiDataType_S32:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*int32), format)
+	case ImGuiDataType_U32:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*uint32), format)
+	case ImGuiDataType_S64:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*int64), format)
+	case ImGuiDataType_U64:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*uint64), format)
+	case ImGuiDataType_Float:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*float32), format)
+	case ImGuiDataType_Double:
+		return DataTypeApplyOpFromTextT(buf, initial_value_buf, p_data.(*float64), format)
+	}
+	IM_ASSERT(false)
+	return false
+}
 
-		return data_backup != reflect.ValueOf(p_data).Elem().Interface()
+func DataTypeCompareT[T ImGuiScalar](arg_1, arg_2 T) int {
+	if arg_1 < arg_2 {
+		return -1
 	}
+	if arg_1 > arg_2 {
+		return 1
+	}
+	return 0
 }
 
 func DataTypeCompare(data_type ImGuiDataType, arg_1 any, arg_2 any) int {
 	switch data_type {
 	case ImGuiDataType_S8:
-		return int(arg_1.(int8) - arg_2.(int8))
+		return DataTypeCompareT(*arg_1.(*int8), *arg_2.(*int8))
 	case ImGuiDataType_U8:
-		return int(arg_1.(uint8) - arg_2.(uint8))
+		return DataTypeCompareT(*arg_1.(*uint8), *arg_2.(*uint8))
 	case ImGuiDataType_S16:
-		return int(arg_1.(int16) - arg_2.(int16))
+		return DataTypeCompareT(*arg_1.(*int16), *arg_2.(*int16))
 	case ImGuiDataType_U16:
-		return int(arg_1.(uint16) - arg_2.(uint16))
+		return DataTypeCompareT(*arg_1.(*uint16), *arg_2.(*uint16))
 	case ImGuiDataType_S32:
-		return arg_1.(int32) - arg_2.(int32)
+		return DataTypeCompareT(*arg_1.(*int32), *arg_2.(*int32))
 	case ImGuiDataType_U32:
-		return int(arg_1.(uint32) - arg_2.(uint32))
+		return DataTypeCompareT(*arg_1.(*uint32), *arg_2.(*uint32))
 	case ImGuiDataType_S64:
-		return int(arg_1.(int64) - arg_2.(int64))
+		return DataTypeCompareT(*arg_1.(*int64), *arg_2.(*int64))
 	case ImGuiDataType_U64:
-		return int(arg_1.(uint64) - arg_2.(uint64))
+		return DataTypeCompareT(*arg_1.(*uint64), *arg_2.(*uint64))
 	case ImGuiDataType_Float:
-		return int(arg_1.(float32) - arg_2.(float32))
+		return DataTypeCompareT(*arg_1.(*float32), *arg_2.(*float32))
 	case ImGuiDataType_Double:
-		return int(arg_1.(float64) - arg_2.(float64))
+		return DataTypeCompareT(*arg_1.(*float64), *arg_2.(*float64))
 	}
 	IM_ASSERT(false)
 	return 0
 }
 
-func DataTypeClamp(data_type ImGuiDataType, a any, n any, x any) bool {
+// DataTypeClampT clamps *p_data to the optional bounds and returns true if it was changed.
+func DataTypeClampT[T ImGuiScalar](p_data *T, p_min *T, p_max *T) bool {
+	if p_min != nil && *p_data < *p_min {
+		*p_data = *p_min
+		return true
+	}
+	if p_max != nil && *p_data > *p_max {
+		*p_data = *p_max
+		return true
+	}
+	return false
+}
+
+func DataTypeClamp(data_type ImGuiDataType, p_data any, p_min any, p_max any) bool {
 	switch data_type {
 	case ImGuiDataType_S8:
-		*a.(*int8) = int8(ImClampInt(int(a.(int8)), int(n.(int8)), int(x.(int8))))
+		return DataTypeClampT(p_data.(*int8), dataTypeArg[int8](p_min), dataTypeArg[int8](p_max))
 	case ImGuiDataType_U8:
-		*a.(*uint8) = uint8(ImClampInt(int(a.(uint8)), int(n.(uint8)), int(x.(uint8))))
+		return DataTypeClampT(p_data.(*uint8), dataTypeArg[uint8](p_min), dataTypeArg[uint8](p_max))
 	case ImGuiDataType_S16:
-		*a.(*int16) = int16(ImClampInt(int(a.(int16)), int(n.(int16)), int(x.(int16))))
+		return DataTypeClampT(p_data.(*int16), dataTypeArg[int16](p_min), dataTypeArg[int16](p_max))
 	case ImGuiDataType_U16:
-		*a.(*uint16) = uint16(ImClampInt(int(a.(uint16)), int(n.(uint16)), int(x.(uint16))))
+		return DataTypeClampT(p_data.(*uint16), dataTypeArg[uint16](p_min), dataTypeArg[uint16](p_max))
 	case ImGuiDataType_S32:
-		*a.(*int32) = ImClampInt(a.(int32), n.(int32), x.(int32))
+		return DataTypeClampT(p_data.(*int32), dataTypeArg[int32](p_min), dataTypeArg[int32](p_max))
 	case ImGuiDataType_U32:
-		*a.(*uint32) = uint32(ImClampInt(int(a.(uint32)), int(n.(uint32)), int(x.(uint32))))
+		return DataTypeClampT(p_data.(*uint32), dataTypeArg[uint32](p_min), dataTypeArg[uint32](p_max))
 	case ImGuiDataType_S64:
-		*a.(*int64) = ImClampInt64(a.(int64), n.(int64), x.(int64))
+		return DataTypeClampT(p_data.(*int64), dataTypeArg[int64](p_min), dataTypeArg[int64](p_max))
 	case ImGuiDataType_U64:
-		*a.(*uint64) = ImClampUint64(a.(uint64), n.(uint64), x.(uint64))
+		return DataTypeClampT(p_data.(*uint64), dataTypeArg[uint64](p_min), dataTypeArg[uint64](p_max))
 	case ImGuiDataType_Float:
-		*a.(*float32) = ImClamp(a.(float32), n.(float32), x.(float32))
+		return DataTypeClampT(p_data.(*float32), dataTypeArg[float32](p_min), dataTypeArg[float32](p_max))
 	case ImGuiDataType_Double:
-		*a.(*float64) = ImClamp64(a.(float64), n.(float64), x.(float64))
+		return DataTypeClampT(p_data.(*float64), dataTypeArg[float64](p_min), dataTypeArg[float64](p_max))
 	}
 	IM_ASSERT(false)
 	return false
 }
 
+// dataTypeArg returns an optional argument of the ImGuiDataType API as a typed pointer.
+func dataTypeArg[T ImGuiScalar](p any) *T {
+	if p == nil {
+		return nil
+	}
+	return p.(*T)
+}
+
+// dataTypeMin dereferences an optional lower bound of the ImGuiDataType API, which defaults to the lowest value of T.
+func dataTypeMin[T ImGuiScalar](p any) T {
+	if p := dataTypeArg[T](p); p != nil {
+		return *p
+	}
+	var v_min, _ = DataTypeGetRange[T]()
+	return v_min
+}
+
+// dataTypeMax dereferences an optional upper bound of the ImGuiDataType API, which defaults to the highest value of T.
+func dataTypeMax[T ImGuiScalar](p any) T {
+	if p := dataTypeArg[T](p); p != nil {
+		return *p
+	}
+	var _, v_max = DataTypeGetRange[T]()
+	return v_max
+}
+
 func DataTypeGetInfo(data_type ImGuiDataType) *ImGuiDataTypeInfo {
 	IM_ASSERT(data_type >= 0 && data_type < ImGuiDataType_COUNT)
 	return &GDataTypeInfo[data_type]
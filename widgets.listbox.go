@@ -10,20 +10,19 @@ package imgui
 // open a framed scrolling region
 // Tip: To have a list filling the entire window width, use size.x = -FLT_MIN and pass an non-visible label e.g. "##empty"
 // Tip: If your vertical size is calculated from an item count (e.g. 10 * item_height) consider adding a fractional part to facilitate seeing scrolling boundaries (e.g. 10.25 * item_height).
-func BeginListBox(label string, size_arg ImVec2) bool {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) BeginListBox(label string, size_arg ImVec2) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
 	var style = g.Style
-	var id = GetIDs(label)
-	var label_size = CalcTextSize(label, true, -1)
+	var id = g.GetIDs(label)
+	var label_size = g.CalcTextSize(label, true, -1)
 
 	// Size default to hold ~7.25 items.
 	// Fractional number of items helps seeing that we can scroll down/up without looking at scrollbar.
-	var size_not_floored = CalcItemSize(size_arg, CalcItemWidth(), GetTextLineHeightWithSpacing()*7.25+style.FramePadding.y*2.0)
+	var size_not_floored = g.CalcItemSize(size_arg, g.CalcItemWidth(), g.GetTextLineHeightWithSpacing()*7.25+style.FramePadding.y*2.0)
 	var size = ImFloorVec(&size_not_floored)
 	var frame_size = ImVec2{size.x, ImMax(size.y, label_size.y)}
 	var frame_bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(frame_size)}
@@ -36,38 +35,37 @@ func BeginListBox(label string, size_arg ImVec2) bool {
 	var bb = ImRect{frame_bb.Min, frame_bb.Max.Add(ImVec2{padding, 0.0})}
 	g.NextItemData.ClearFlags()
 
-	if !IsRectVisibleMinMax(bb.Min, bb.Max) {
+	if !g.IsRectVisibleMinMax(bb.Min, bb.Max) {
 		v := bb.GetSize()
-		ItemSizeVec(&v, style.FramePadding.y)
-		ItemAdd(&bb, 0, &frame_bb, 0)
+		g.ItemSizeVec(&v, style.FramePadding.y)
+		g.ItemAdd(&bb, 0, &frame_bb, 0)
 		return false
 	}
 
 	// FIXME-OPT: We could omit the BeginGroup() if label_size.x but would need to omit the EndGroup() as well.
-	BeginGroup()
+	g.BeginGroup()
 	if label_size.x > 0.0 {
 		var label_pos = ImVec2{frame_bb.Max.x + style.ItemInnerSpacing.x, frame_bb.Min.y + style.FramePadding.y}
-		RenderText(label_pos, label, true)
+		g.RenderText(label_pos, label, true)
 		b := label_pos.Add(label_size)
 		window.DC.CursorMaxPos = ImMaxVec2(&window.DC.CursorMaxPos, &b)
 	}
 
-	BeginChildFrame(id, frame_bb.GetSize(), 0)
+	g.BeginChildFrame(id, frame_bb.GetSize(), 0)
 	return true
 }
 
 // only call EndListBox() if BeginListBox() returned true!
-func EndListBox() {
-	var g = GImGui
+func (g *ImGuiContext) EndListBox() {
 	var window = g.CurrentWindow
 	IM_ASSERT_USER_ERROR((window.Flags&ImGuiWindowFlags_ChildWindow) != 0, "Mismatched BeginListBox/EndListBox calls. Did you test the return value of BeginListBox?")
 
-	EndChildFrame()
-	EndGroup() // This is only required to be able to do IsItemXXX query on the whole ListBox including label
+	g.EndChildFrame()
+	g.EndGroup() // This is only required to be able to do IsItemXXX query on the whole ListBox including label
 }
 
-func ListBox(label string, current_item *int, items []string, items_count int, height_in_items int /*= -1*/) bool {
-	var value_changed = ListBoxFunc(label, current_item, func(data any, idx int, out_text *string) bool {
+func (g *ImGuiContext) ListBox(label string, current_item *int, items []string, items_count int, height_in_items int /*= -1*/) bool {
+	var value_changed = g.ListBoxFunc(label, current_item, func(data any, idx int, out_text *string) bool {
 		var items = data.([]string)
 		if out_text != nil {
 			*out_text = items[idx]
@@ -79,25 +77,23 @@ func ListBox(label string, current_item *int, items []string, items_count int, h
 
 // This is merely a helper around BeginListBox(), EndListBox().
 // Considering using those directly to submit custom data or store selection differently.
-func ListBoxFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count int, height_in_items int /*= -1*/) bool {
-	var g = GImGui
-
+func (g *ImGuiContext) ListBoxFunc(label string, current_item *int, items_getter func(data any, idx int, out_text *string) bool, data any, items_count int, height_in_items int /*= -1*/) bool {
 	// Calculate size from "height_in_items"
 	if height_in_items < 0 {
 		height_in_items = ImMinInt(items_count, 7)
 	}
 	var height_in_items_f = float(height_in_items) + 0.25
-	var size = ImVec2{0.0, ImFloor(GetTextLineHeightWithSpacing()*height_in_items_f + g.Style.FramePadding.y*2.0)}
+	var size = ImVec2{0.0, ImFloor(g.GetTextLineHeightWithSpacing()*height_in_items_f + g.Style.FramePadding.y*2.0)}
 
-	if !BeginListBox(label, size) {
+	if !g.BeginListBox(label, size) {
 		return false
 	}
 
 	// Assume all items have even height (= 1 line of text). If you need items of different height,
 	// you can create a custom version of ListBox() in your code without using the clipper.
 	var value_changed = false
-	var clipper ImGuiListClipper
-	clipper.Begin(items_count, GetTextLineHeightWithSpacing()) // We know exactly our line height here so we pass it as a minor optimization, but generally you don't need to.
+	var clipper = ImGuiListClipper{Ctx: g}
+	clipper.Begin(items_count, g.GetTextLineHeightWithSpacing()) // We know exactly our line height here so we pass it as a minor optimization, but generally you don't need to.
 	for clipper.Step() {
 		for i := clipper.DisplayStart; i < clipper.DisplayEnd; i++ {
 			var item_text string
@@ -105,22 +101,22 @@ func ListBoxFunc(label string, current_item *int, items_getter func(data any, id
 				item_text = "*Unknown item*"
 			}
 
-			PushID(i)
+			g.PushID(i)
 			var item_selected = (i == *current_item)
-			if (Selectable(item_text, item_selected, 0, ImVec2{})) {
+			if (g.Selectable(item_text, item_selected, 0, ImVec2{})) {
 				*current_item = i
 				value_changed = true
 			}
 			if item_selected {
-				SetItemDefaultFocus()
+				g.SetItemDefaultFocus()
 			}
-			PopID()
+			g.PopID()
 		}
 	}
-	EndListBox()
+	g.EndListBox()
 
 	if value_changed {
-		MarkItemEdited(g.LastItemData.ID)
+		g.MarkItemEdited(g.LastItemData.ID)
 	}
 
 	return value_changed
@@ -5,24 +5,25 @@ package imgui
 // - Use BeginMainMenuBar() to create a menu bar at the top of the screen and append to it.
 // - Use BeginMenu() to create a menu. You can call BeginMenu() multiple time with the same identifier to append more items to it.
 // - Not that MenuItem() keyboardshortcuts are displayed as a convenience but _not processed_ by Dear ImGui at the moment.
+//   Use MenuItemShortcut() to have the key chord of an item processed, including while its menu is closed.
 
 // append to menu-bar of current window (requires ImGuiWindowFlags_MenuBar flag set on parent window).
 // FIXME: Provided a rectangle perhaps e.g. a BeginMenuBarEx() could be used anywhere..
 // Currently the main responsibility of this function being to setup clip-rect + horizontal layout + menu navigation layer.
 // Ideally we also want this to be responsible for claiming space out of the main window scrolling rectangle, in which case ImGuiWindowFlags_MenuBar will become unnecessary.
 // Then later the same system could be used for multiple menu-bars, scrollbars, side-bars.
-func BeginMenuBar() bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) BeginMenuBar() bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
-	if window.Flags&ImGuiWindowFlags_MenuBar != 0 {
+	if window.Flags&ImGuiWindowFlags_MenuBar == 0 {
 		return false
 	}
 
 	IM_ASSERT(!window.DC.MenuBarAppending)
-	BeginGroup() // Backup position on layer 0 // FIXME: Misleading to use a group for that backup/restore
-	PushString("##menubar")
+	g.BeginGroup() // Backup position on layer 0 // FIXME: Misleading to use a group for that backup/restore
+	g.PushString("##menubar")
 
 	// We don't clip with current window clipping rectangle as it is already set to the area below. However we clip with window full rect.
 	// We remove 1 worth of rounding to Max.x to that text in long menus and small windows don't tend to display over the lower-right rounded area, which looks particularly glitchy.
@@ -38,7 +39,7 @@ func BeginMenuBar() bool {
 		},
 	}
 	clip_rect.ClipWith(window.OuterRectClipped)
-	PushClipRect(clip_rect.Min, clip_rect.Max, false)
+	g.PushClipRect(clip_rect.Min, clip_rect.Max, false)
 
 	// We overwrite CursorMaxPos because BeginGroup sets it to CursorPos (essentially the .EmitItem hack in EndMenuBar() would need something analogous here, maybe a BeginGroupEx() with flags).
 	window.DC.CursorPos = ImVec2{bar_rect.Min.x + window.DC.MenuBarOffset.x, bar_rect.Min.y + window.DC.MenuBarOffset.y}
@@ -46,20 +47,19 @@ func BeginMenuBar() bool {
 	window.DC.LayoutType = ImGuiLayoutType_Horizontal
 	window.DC.NavLayerCurrent = ImGuiNavLayer_Menu
 	window.DC.MenuBarAppending = true
-	AlignTextToFramePadding()
+	g.AlignTextToFramePadding()
 	return true
 }
 
 // only call EndMenuBar() if BeginMenuBar() returns true!
-func EndMenuBar() {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) EndMenuBar() {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
-	var g = GImGui
 
 	// Nav: When a move request within one of our child menu failed, capture the request to navigate among our siblings.
-	if NavMoveRequestButNoResultYet() && (g.NavMoveDir == ImGuiDir_Left || g.NavMoveDir == ImGuiDir_Right) && (g.NavWindow.Flags&ImGuiWindowFlags_ChildMenu) != 0 {
+	if g.NavMoveRequestButNoResultYet() && (g.NavMoveDir == ImGuiDir_Left || g.NavMoveDir == ImGuiDir_Right) && (g.NavWindow.Flags&ImGuiWindowFlags_ChildMenu) != 0 {
 		// Try to find out if the request is for one of our child menu
 		var nav_earliest_child = g.NavWindow
 		for nav_earliest_child.ParentWindow != nil && (nav_earliest_child.ParentWindow.Flags&ImGuiWindowFlags_ChildMenu) != 0 {
@@ -70,96 +70,104 @@ func EndMenuBar() {
 			// This involve a one-frame delay which isn't very problematic in this situation. We could remove it by scoring in advance for multiple window (probably not worth bothering)
 			var layer = ImGuiNavLayer_Menu
 			IM_ASSERT(window.DC.NavLayersActiveMaskNext&(1<<layer) != 0) // Sanity check
-			FocusWindow(window)
-			SetNavID(window.NavLastIds[layer], layer, 0, &window.NavRectRel[layer])
+			g.FocusWindow(window)
+			g.SetNavID(window.NavLastIds[layer], layer, 0, &window.NavRectRel[layer])
 			g.NavDisableHighlight = true // Hide highlight for the current frame so we don't see the intermediary selection.
 			g.NavDisableMouseHover = true
 			g.NavMousePosDirty = true
-			NavMoveRequestForward(g.NavMoveDir, g.NavMoveClipDir, g.NavMoveFlags) // Repeat
+			g.NavMoveRequestForward(g.NavMoveDir, g.NavMoveClipDir, g.NavMoveFlags) // Repeat
 		}
 	}
 
 	IM_ASSERT(window.Flags&ImGuiWindowFlags_MenuBar != 0)
 	IM_ASSERT(window.DC.MenuBarAppending)
-	PopClipRect()
-	PopID()
+	g.PopClipRect()
+	g.PopID()
 	window.DC.MenuBarOffset.x = window.DC.CursorPos.x - window.Pos.x // Save horizontal position so next append can reuse it. This is kinda equivalent to a per-layer CursorPos.
 	g.GroupStack[len(g.GroupStack)-1].EmitItem = false
-	EndGroup() // Restore position on layer 0
+	g.EndGroup() // Restore position on layer 0
 	window.DC.LayoutType = ImGuiLayoutType_Vertical
 	window.DC.NavLayerCurrent = ImGuiNavLayer_Main
 	window.DC.MenuBarAppending = false
 }
 
 // create and append to a full screen menu-bar.
-func BeginMainMenuBar() bool {
-	var g = GImGui
-	var viewport = GetMainViewport()
+func (g *ImGuiContext) BeginMainMenuBar() bool {
+	var viewport = g.GetMainViewport()
 
 	// For the main menu bar, which cannot be moved, we honor g.Style.DisplaySafeAreaPadding to ensure text can be visible on a TV set.
 	// FIXME: This could be generalized as an opt-in way to clamp window.DC.CursorStartPos to avoid SafeArea?
 	// FIXME: Consider removing support for safe area down the line... it's messy. Nowadays consoles have support for TV calibration in OS settings.
 	g.NextWindowData.MenuBarOffsetMinVal = ImVec2{g.Style.DisplaySafeAreaPadding.x, ImMax(g.Style.DisplaySafeAreaPadding.y-g.Style.FramePadding.y, 0.0)}
 	var window_flags = ImGuiWindowFlags_NoScrollbar | ImGuiWindowFlags_NoSavedSettings | ImGuiWindowFlags_MenuBar
-	var height = GetFrameHeight()
-	var is_open = BeginViewportSideBar("##MainMenuBar", viewport, ImGuiDir_Up, height, window_flags)
+	var height = g.GetFrameHeight()
+	var is_open = g.BeginViewportSideBar("##MainMenuBar", viewport, ImGuiDir_Up, height, window_flags)
 	g.NextWindowData.MenuBarOffsetMinVal = ImVec2{}
 
 	if is_open {
-		BeginMenuBar()
+		g.BeginMenuBar()
 	} else {
-		End()
+		g.End()
 	}
 
 	return is_open
 }
 
 // only call EndMainMenuBar() if BeginMainMenuBar() returns true!
-func EndMainMenuBar() {
-	EndMenuBar()
+func (g *ImGuiContext) EndMainMenuBar() {
+	g.EndMenuBar()
 
 	// When the user has left the menu layer (typically: closed menus through activation of an item), we restore focus to the previous window
 	// FIXME: With this strategy we won't be able to restore a nil focus.
-	var g = GImGui
 	if g.CurrentWindow == g.NavWindow && g.NavLayer == ImGuiNavLayer_Main && !g.NavAnyRequest {
-		FocusTopMostWindowUnderOne(g.NavWindow, nil)
+		g.FocusTopMostWindowUnderOne(g.NavWindow, nil)
 	}
 
-	End()
+	g.End()
 }
 
 // create a sub-menu entry. only call EndMenu() if this returns true!
-func BeginMenu(label string, enabled bool /*= true*/) bool {
-	return BeginMenuEx(label, "", enabled)
+// A closed menu submitted outside of a menu also returns true, with its items skipped, on the first frame it is submitted
+// and when the key chord of one of its MenuItemShortcut() items is pressed: see MenuItemShortcut().
+func (g *ImGuiContext) BeginMenu(label string, enabled bool /*= true*/) bool {
+	return g.BeginMenuEx(label, "", enabled)
 }
 
 // only call EndMenu() if BeginMenu() returns true!
-func EndMenu() {
+func (g *ImGuiContext) EndMenu() {
 	// Nav: When a left move request _within our child menu_ failed, close ourselves (the _parent_ menu).
 	// A menu doesn't close itself because EndMenuBar() wants the catch the last Left<>Right inputs.
 	// However, it means that with the current code, a BeginMenu() from outside another menu or a menu-bar won't be closable with the Left direction.
-	var g = GImGui
 	var window = g.CurrentWindow
-	if g.NavWindow != nil && g.NavWindow.ParentWindow == window && g.NavMoveDir == ImGuiDir_Left && NavMoveRequestButNoResultYet() && window.DC.LayoutType == ImGuiLayoutType_Vertical {
-		ClosePopupToLevel(int(len(g.BeginPopupStack)), true)
-		NavMoveRequestCancel()
+	if pass := &g.MenusShortcutsPass; len(pass.MenuIds) != 0 && window == pass.Window {
+		pass.MenuIds = pass.MenuIds[:len(pass.MenuIds)-1]
+		if len(pass.MenuIds) == 0 {
+			window.SkipItems = pass.SkipItemsBackup
+			g.LastItemData = pass.LastItemDataBackup
+			pass.Window = nil
+		}
+		return
+	}
+	if g.NavWindow != nil && g.NavWindow.ParentWindow == window && g.NavMoveDir == ImGuiDir_Left && g.NavMoveRequestButNoResultYet() && window.DC.LayoutType == ImGuiLayoutType_Vertical {
+		g.ClosePopupToLevel(int(len(g.BeginPopupStack)), true)
+		g.NavMoveRequestCancel()
 	}
 
-	EndPopup()
+	g.EndPopup()
 }
 
 // return true when activated.
-func MenuItem(label string, shortcut string /*= L*/, selected *bool /*= e*/, enabled bool /*= true*/) bool {
-	return MenuItemEx(label, "", shortcut, selected, enabled)
+func (g *ImGuiContext) MenuItem(label string, shortcut string /*= L*/, selected *bool /*= e*/, enabled bool /*= true*/) bool {
+	return g.MenuItemEx(label, "", shortcut, selected, enabled)
 }
 
 // return true when activated + toggle (*p_selected) if p_selected != NULL
-func MenuItemSelected(label string, shortcut string, p_selected *bool, enabled bool /*= true*/) bool {
+func (g *ImGuiContext) MenuItemSelected(label string, shortcut string, p_selected *bool, enabled bool /*= true*/) bool {
 	var b = false
 	if p_selected != nil {
 		b = *p_selected
 	}
-	if MenuItemEx(label, "", shortcut, &b, enabled) {
+	if g.MenuItemEx(label, "", shortcut, &b, enabled) {
 		if p_selected != nil {
 			*p_selected = !*p_selected
 		}
@@ -168,18 +176,72 @@ func MenuItemSelected(label string, shortcut string, p_selected *bool, enabled b
 	return false
 }
 
+// return true when activated or when key_chord is pressed + toggle (*p_selected) if p_selected != NULL.
+// key_chord is displayed as the shortcut, e.g. ImGuiMod_Ctrl | ImGuiKey_S.
+// While the menu is closed, the key chord is registered by its BeginMenu() in the window of the menu (e.g. the one of the menu bar),
+// which learns the key chords of its items on the first frame it is submitted or while it is open. When one of them is pressed,
+// BeginMenu() returns true for that frame without opening the menu: all the items are skipped but the one taking the key chord.
+// The route of an open menu takes precedence over the one of its window.
+func (g *ImGuiContext) MenuItemShortcut(label string, key_chord ImGuiKeyChord, p_selected *bool, enabled bool /*= true*/) bool {
+	var window = g.GetCurrentWindow()
+	if pass := &g.MenusShortcutsPass; len(pass.MenuIds) != 0 && window == pass.Window {
+		if !enabled {
+			return false
+		}
+		var shortcuts = g.MenusShortcuts[pass.MenuIds[0]]
+		shortcuts.AddKeyChord(key_chord)
+		if shortcuts.PassKeyChord != key_chord {
+			return false
+		}
+		shortcuts.PassKeyChord = 0
+		if p_selected != nil {
+			*p_selected = !*p_selected
+		}
+		return true
+	}
+	if window.SkipItems {
+		return false
+	}
+
+	// Register the key chord into the outer menu for when it is closed
+	if enabled {
+		for i := range g.BeginPopupStack {
+			if popup := &g.BeginPopupStack[i]; popup.Window.Flags&ImGuiWindowFlags_ChildMenu != 0 {
+				if shortcuts := g.MenusShortcuts[popup.PopupId]; shortcuts != nil {
+					shortcuts.AddKeyChord(key_chord)
+				}
+				break
+			}
+		}
+	}
+
+	var pressed = enabled && g.ShortcutEx(key_chord, window.GetIDs(label), ImGuiInputFlags_RouteFocused, window)
+	var b = p_selected != nil && *p_selected
+	var selected *bool
+	if p_selected != nil {
+		selected = &b
+	}
+	if g.MenuItemEx(label, "", g.GetKeyChordName(key_chord), selected, enabled) {
+		pressed = true
+	}
+	if pressed && p_selected != nil {
+		*p_selected = !*p_selected
+	}
+	return pressed
+}
+
 // Important: calling order matters!
 // FIXME: Somehow overlapping with docking tech.
 // FIXME: The "rect-cut" aspect of this could be formalized into a lower-level helper (rect-cut: https://halt.software/dead-simple-layouts)
-func BeginViewportSideBar(name string, viewport_p *ImGuiViewport, dir ImGuiDir, axis_size float, window_flags ImGuiWindowFlags) bool {
+func (g *ImGuiContext) BeginViewportSideBar(name string, viewport_p *ImGuiViewport, dir ImGuiDir, axis_size float, window_flags ImGuiWindowFlags) bool {
 	IM_ASSERT(dir != ImGuiDir_None)
 
-	var bar_window = FindWindowByName(name)
+	var bar_window = g.FindWindowByName(name)
 	if bar_window == nil || bar_window.BeginCount == 0 {
 		// Calculate and set window size/position
 		var viewport = viewport_p
 		if viewport_p == nil {
-			viewport = GetMainViewport()
+			viewport = g.GetMainViewport()
 		}
 
 		var avail_rect = viewport.GetBuildWorkRect()
@@ -203,8 +265,8 @@ func BeginViewportSideBar(name string, viewport_p *ImGuiViewport, dir ImGuiDir,
 		case ImGuiAxis_Y:
 			size.y = axis_size
 		}
-		SetNextWindowPos(&pos, 0, ImVec2{})
-		SetNextWindowSize(&size, 0)
+		g.SetNextWindowPos(&pos, 0, ImVec2{})
+		g.SetNextWindowSize(&size, 0)
 
 		// Report our size into work area (for next frame) using actual window size
 		if dir == ImGuiDir_Up || dir == ImGuiDir_Left {
@@ -225,25 +287,38 @@ func BeginViewportSideBar(name string, viewport_p *ImGuiViewport, dir ImGuiDir,
 	}
 
 	window_flags |= ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoResize | ImGuiWindowFlags_NoMove
-	PushStyleFloat(ImGuiStyleVar_WindowRounding, 0.0)
-	PushStyleVec(ImGuiStyleVar_WindowMinSize, ImVec2{}) // Lift normal size constraint
-	var is_open = Begin(name, nil, window_flags)
-	PopStyleVar(2)
+	g.PushStyleFloat(ImGuiStyleVar_WindowRounding, 0.0)
+	g.PushStyleVec(ImGuiStyleVar_WindowMinSize, ImVec2{}) // Lift normal size constraint
+	var is_open = g.Begin(name, nil, window_flags)
+	g.PopStyleVar(2)
 
 	return is_open
 }
 
 // Menus
-func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
+	var window = g.GetCurrentWindow()
+	if pass := &g.MenusShortcutsPass; len(pass.MenuIds) != 0 && window == pass.Window {
+		// Sub-menu of a closed menu: enter it too, unless it is recursive
+		var id = window.GetIDs(label)
+		if !enabled {
+			return false
+		}
+		for _, menu_id := range pass.MenuIds {
+			if menu_id == id {
+				return false
+			}
+		}
+		pass.MenuIds = append(pass.MenuIds, id)
+		return true
+	}
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 	var id = window.GetIDs(label)
-	var menu_is_open = IsPopupOpenID(id, ImGuiPopupFlags_None)
+	var menu_is_open = g.IsPopupOpenID(id, ImGuiPopupFlags_None)
 
 	// Sub-menus are ChildWindow so that mouse can be hovering across them (otherwise top-most popup menu would steal focus and not allow hovering on parent menu)
 	var flags = ImGuiWindowFlags_ChildMenu | ImGuiWindowFlags_AlwaysAutoResize | ImGuiWindowFlags_NoMove | ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoSavedSettings | ImGuiWindowFlags_NoNavFocus
@@ -263,17 +338,23 @@ func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
 	}
 	if contains {
 		if menu_is_open {
-			menu_is_open = BeginPopupEx(id, flags) // menu_is_open can be 'false' when the popup is completely clipped (e.g. zero size display)
+			menu_is_open = g.BeginPopupEx(id, flags) // menu_is_open can be 'false' when the popup is completely clipped (e.g. zero size display)
 		} else {
 			g.NextWindowData.ClearFlags() // we behave like Begin() and need to consume those values
+			if enabled && window.Flags&ImGuiWindowFlags_ChildMenu == 0 {
+				menu_is_open = g.beginMenuShortcutsPass(id, window, true)
+			}
 		}
 		return menu_is_open
 	}
 
 	// Tag menu as used. Next time BeginMenu() with same ID is called it will append to existing menu
 	g.MenusIdSubmittedThisFrame = append(g.MenusIdSubmittedThisFrame, id)
+	if shortcuts := g.MenusShortcuts[id]; shortcuts != nil {
+		shortcuts.LastFrameSubmitted = g.FrameCount
+	}
 
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 	var pressed bool
 	var menuset_is_open = (window.Flags&ImGuiWindowFlags_Popup) == 0 && (len(g.OpenPopupStack) > len(g.BeginPopupStack) && g.OpenPopupStack[len(g.BeginPopupStack)].OpenParentId == window.IDStack[len(window.IDStack)-1])
 	var backed_nav_window = g.NavWindow
@@ -286,9 +367,9 @@ func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
 	// e.g. Menus tend to overlap each other horizontally to amplify relative Z-ordering.
 	var popup_pos ImVec2
 	var pos = window.DC.CursorPos
-	PushString(label)
+	g.PushString(label)
 	if !enabled {
-		BeginDisabled(true)
+		g.BeginDisabled(true)
 	}
 	var offsets = &window.DC.MenuColumns
 	if window.DC.LayoutType == ImGuiLayoutType_Horizontal {
@@ -297,12 +378,12 @@ func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
 		// For ChildMenu, the popup position will be overwritten by the call to FindBestWindowPosForPopup() in Begin()
 		popup_pos = ImVec2{pos.x - 1.0 - IM_FLOOR(style.ItemSpacing.x*0.5), pos.y - style.FramePadding.y + window.MenuBarHeight()}
 		window.DC.CursorPos.x += IM_FLOOR(style.ItemSpacing.x * 0.5)
-		PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{style.ItemSpacing.x * 2.0, style.ItemSpacing.y})
+		g.PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{style.ItemSpacing.x * 2.0, style.ItemSpacing.y})
 		var w = label_size.x
 		var text_pos = ImVec2{window.DC.CursorPos.x + float(offsets.OffsetLabel), window.DC.CursorPos.y + window.DC.CurrLineTextBaseOffset}
-		pressed = Selectable("", menu_is_open, ImGuiSelectableFlags_NoHoldingActiveID|ImGuiSelectableFlags_SelectOnClick|ImGuiSelectableFlags_DontClosePopups, ImVec2{w, 0.0})
-		RenderText(text_pos, label, true)
-		PopStyleVar(1)
+		pressed = g.Selectable("", menu_is_open, ImGuiSelectableFlags_NoHoldingActiveID|ImGuiSelectableFlags_SelectOnClick|ImGuiSelectableFlags_DontClosePopups, ImVec2{w, 0.0})
+		g.RenderText(text_pos, label, true)
+		g.PopStyleVar(1)
 		window.DC.CursorPos.x += IM_FLOOR(style.ItemSpacing.x * (-1.0 + 0.5)) // -1 spacing to compensate the spacing added when Selectable() did a SameLine(). It would also work to call SameLine() ourselves after the PopStyleVar().
 	} else {
 		// Menu inside a menu
@@ -311,21 +392,21 @@ func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
 		popup_pos = ImVec2{pos.x, pos.y - style.WindowPadding.y}
 		var icon_w float
 		if icon != "" {
-			icon_w = CalcTextSize(icon, true, -1).x
+			icon_w = g.CalcTextSize(icon, true, -1).x
 		}
 		var checkmark_w = IM_FLOOR(g.FontSize * 1.20)
 		var min_w = window.DC.MenuColumns.DeclColumns(icon_w, label_size.x, 0.0, checkmark_w) // Feedback to next frame
-		var extra_w = ImMax(0.0, GetContentRegionAvail().x-min_w)
+		var extra_w = ImMax(0.0, g.GetContentRegionAvail().x-min_w)
 		var text_pos = ImVec2{window.DC.CursorPos.x + float(offsets.OffsetLabel), window.DC.CursorPos.y + window.DC.CurrLineTextBaseOffset}
-		pressed = Selectable("", menu_is_open, ImGuiSelectableFlags_NoHoldingActiveID|ImGuiSelectableFlags_SelectOnClick|ImGuiSelectableFlags_DontClosePopups|ImGuiSelectableFlags_SpanAvailWidth, ImVec2{min_w, 0.0})
-		RenderText(text_pos, label, true)
+		pressed = g.Selectable("", menu_is_open, ImGuiSelectableFlags_NoHoldingActiveID|ImGuiSelectableFlags_SelectOnClick|ImGuiSelectableFlags_DontClosePopups|ImGuiSelectableFlags_SpanAvailWidth, ImVec2{min_w, 0.0})
+		g.RenderText(text_pos, label, true)
 		if icon_w > 0.0 {
-			RenderText(pos.Add(ImVec2{float(offsets.OffsetIcon), 0.0}), icon, true)
+			g.RenderText(pos.Add(ImVec2{float(offsets.OffsetIcon), 0.0}), icon, true)
 		}
-		RenderArrow(window.DrawList, pos.Add(ImVec2{float(offsets.OffsetMark) + extra_w + g.FontSize*0.30, 0.0}), GetColorU32FromID(ImGuiCol_Text, 1), ImGuiDir_Right, 1)
+		RenderArrow(window.DrawList, pos.Add(ImVec2{float(offsets.OffsetMark) + extra_w + g.FontSize*0.30, 0.0}), g.GetColorU32FromID(ImGuiCol_Text, 1), ImGuiDir_Right, 1)
 	}
 	if !enabled {
-		EndDisabled()
+		g.EndDisabled()
 	}
 
 	var hovered = (g.HoveredId == id) && enabled
@@ -385,7 +466,7 @@ func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
 		}
 		if g.NavId == id && g.NavMoveDir == ImGuiDir_Right { // Nav-Right to open
 			want_open = true
-			NavMoveRequestCancel()
+			g.NavMoveRequestCancel()
 		}
 	} else {
 		// Menu bar
@@ -397,56 +478,113 @@ func BeginMenuEx(label string, icon string, enabled bool /*= true*/) bool {
 			want_open = true
 		} else if g.NavId == id && g.NavMoveDir == ImGuiDir_Down { // Nav-Down to open
 			want_open = true
-			NavMoveRequestCancel()
+			g.NavMoveRequestCancel()
 		}
 	}
 
 	if !enabled { // explicitly close if an open menu becomes disabled, facilitate users code a lot in pattern such as 'if (BeginMenu("options", has_object)) { ..use object.. }'
 		want_close = true
 	}
-	if want_close && IsPopupOpenID(id, ImGuiPopupFlags_None) {
-		ClosePopupToLevel(int(len(g.BeginPopupStack)), true)
+	if want_close && g.IsPopupOpenID(id, ImGuiPopupFlags_None) {
+		g.ClosePopupToLevel(int(len(g.BeginPopupStack)), true)
 	}
-	PopID()
+	g.PopID()
 
 	if !menu_is_open && want_open && len(g.OpenPopupStack) > len(g.BeginPopupStack) {
 		// Don't recycle same menu level in the same frame, first close the other menu and yield for a frame.
-		OpenPopup(label, 0)
+		g.OpenPopup(label, 0)
 		return false
 	}
 
 	menu_is_open = menu_is_open || want_open
 	if want_open {
-		OpenPopup(label, 0)
+		g.OpenPopup(label, 0)
 	}
 
 	if menu_is_open {
-		SetNextWindowPos(&popup_pos, ImGuiCond_Always, ImVec2{}) // Note: this is super misleading! The value will serve as reference for FindBestWindowPosForPopup(), not actual pos.
-		menu_is_open = BeginPopupEx(id, flags)                   // menu_is_open can be 'false' when the popup is completely clipped (e.g. zero size display)
+		g.SetNextWindowPos(&popup_pos, ImGuiCond_Always, ImVec2{}) // Note: this is super misleading! The value will serve as reference for FindBestWindowPosForPopup(), not actual pos.
+		menu_is_open = g.BeginPopupEx(id, flags)                   // menu_is_open can be 'false' when the popup is completely clipped (e.g. zero size display)
 	} else {
 		g.NextWindowData.ClearFlags() // We behave like Begin() and need to consume those values
 	}
 
+	var opened = ImGuiItemStatusFlags_None
+	if menu_is_open {
+		opened = ImGuiItemStatusFlags_Opened
+	}
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags|ImGuiItemStatusFlags_Openable|opened)
+
+	// Closed menu outside of a menu: register the shortcuts of its items
+	if !menu_is_open && enabled && window.Flags&ImGuiWindowFlags_ChildMenu == 0 && !g.IsPopupOpenID(id, ImGuiPopupFlags_None) {
+		menu_is_open = g.beginMenuShortcutsPass(id, window, false)
+	}
 	return menu_is_open
 }
 
-func MenuItemEx(label string, icon string, shortcut string, selected *bool, enabled bool /*= true*/) bool {
-	var window = GetCurrentWindow()
+// Register the key chords of the MenuItemShortcut() items of a closed menu, owned by the menu ID and routed from its window.
+// Return true when the menu must be submitted without its items: on the frame one of the key chords is pressed, so its item
+// takes it, and on the first frame the menu is submitted, to learn the key chords. Appending to a menu repeats its pass.
+func (g *ImGuiContext) beginMenuShortcutsPass(id ImGuiID, window *ImGuiWindow, appending bool) bool {
+	var shortcuts = g.MenusShortcuts[id]
+	if appending {
+		if shortcuts == nil || shortcuts.PassFrame != g.FrameCount {
+			return false
+		}
+	} else {
+		if shortcuts == nil {
+			if g.MenusShortcuts == nil {
+				g.MenusShortcuts = make(map[ImGuiID]*ImGuiMenuShortcuts)
+			}
+			shortcuts = &ImGuiMenuShortcuts{LastFrameSubmitted: g.FrameCount, PassFrame: g.FrameCount}
+			g.MenusShortcuts[id] = shortcuts
+		}
+		shortcuts.PassKeyChord = 0
+		for _, key_chord := range shortcuts.KeyChords {
+			if g.ShortcutEx(key_chord, id, ImGuiInputFlags_RouteFocused, window) && shortcuts.PassKeyChord == 0 {
+				shortcuts.PassKeyChord = key_chord
+				shortcuts.PassFrame = g.FrameCount
+			}
+		}
+		if shortcuts.PassFrame != g.FrameCount {
+			return false
+		}
+		shortcuts.KeyChords = shortcuts.KeyChords[:0] // Learned again during the pass
+	}
+
+	var pass = &g.MenusShortcutsPass
+	pass.Window = window
+	pass.MenuIds = append(pass.MenuIds[:0], id)
+	pass.SkipItemsBackup = window.SkipItems
+	pass.LastItemDataBackup = g.LastItemData
+	window.SkipItems = true
+	return true
+}
+
+func (shortcuts *ImGuiMenuShortcuts) AddKeyChord(key_chord ImGuiKeyChord) {
+	for _, chord := range shortcuts.KeyChords {
+		if chord == key_chord {
+			return
+		}
+	}
+	shortcuts.KeyChords = append(shortcuts.KeyChords, key_chord)
+}
+
+func (g *ImGuiContext) MenuItemEx(label string, icon string, shortcut string, selected *bool, enabled bool /*= true*/) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 	var pos = window.DC.CursorPos
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 
 	// We've been using the equivalent of ImGuiSelectableFlags_SetNavIdOnHover on all Selectable() since early Nav system days (commit 43ee5d73),
 	// but I am unsure whether this should be kept at all. For now moved it to be an opt-in feature used by menus only.
 	var pressed bool
-	PushString(label)
+	g.PushString(label)
 	if !enabled {
-		BeginDisabled(true)
+		g.BeginDisabled(true)
 	}
 	var flags = ImGuiSelectableFlags_SelectOnRelease | ImGuiSelectableFlags_SetNavIdOnHover
 	var offsets = &window.DC.MenuColumns
@@ -455,10 +593,10 @@ func MenuItemEx(label string, icon string, shortcut string, selected *bool, enab
 		// Note that in this situation: we don't render the shortcut, we render a highlight instead of the selected tick mark.
 		var w = label_size.x
 		window.DC.CursorPos.x += IM_FLOOR(style.ItemSpacing.x * 0.5)
-		PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{style.ItemSpacing.x * 2.0, style.ItemSpacing.y})
-		pressed = SelectablePointer("", selected, flags, ImVec2{w, 0.0})
-		PopStyleVar(1)
-		RenderText(pos.Add(ImVec2{float(offsets.OffsetLabel), 0.0}), label, true)
+		g.PushStyleVec(ImGuiStyleVar_ItemSpacing, ImVec2{style.ItemSpacing.x * 2.0, style.ItemSpacing.y})
+		pressed = g.SelectablePointer("", selected, flags, ImVec2{w, 0.0})
+		g.PopStyleVar(1)
+		g.RenderText(pos.Add(ImVec2{float(offsets.OffsetLabel), 0.0}), label, true)
 		window.DC.CursorPos.x += IM_FLOOR(style.ItemSpacing.x * (-1.0 + 0.5)) // -1 spacing to compensate the spacing added when Selectable() did a SameLine(). It would also work to call SameLine() ourselves after the PopStyleVar().
 	} else {
 		// Menu item inside a vertical menu
@@ -466,33 +604,38 @@ func MenuItemEx(label string, icon string, shortcut string, selected *bool, enab
 		//  Only when they are other items sticking out we're going to add spacing, yet only register minimum width into the layout system.
 		var icon_w float
 		if icon != "" {
-			icon_w = CalcTextSize(icon, true, -1).x
+			icon_w = g.CalcTextSize(icon, true, -1).x
 		}
 		var shortcut_w float
 		if shortcut != "" {
-			shortcut_w = CalcTextSize(shortcut, true, -1).x
+			shortcut_w = g.CalcTextSize(shortcut, true, -1).x
 		}
 		var checkmark_w = IM_FLOOR(g.FontSize * 1.20)
 		var min_w = window.DC.MenuColumns.DeclColumns(icon_w, label_size.x, shortcut_w, checkmark_w) // Feedback for next frame
-		var stretch_w = ImMax(0.0, GetContentRegionAvail().x-min_w)
-		pressed = Selectable("", false, flags|ImGuiSelectableFlags_SpanAvailWidth, ImVec2{min_w, 0.0})
-		RenderText(pos.Add(ImVec2{float(offsets.OffsetLabel), 0.0}), label, true)
+		var stretch_w = ImMax(0.0, g.GetContentRegionAvail().x-min_w)
+		pressed = g.Selectable("", false, flags|ImGuiSelectableFlags_SpanAvailWidth, ImVec2{min_w, 0.0})
+		g.RenderText(pos.Add(ImVec2{float(offsets.OffsetLabel), 0.0}), label, true)
 		if icon_w > 0.0 {
-			RenderText(pos.Add(ImVec2{float(offsets.OffsetIcon), 0.0}), icon, true)
+			g.RenderText(pos.Add(ImVec2{float(offsets.OffsetIcon), 0.0}), icon, true)
 		}
 		if shortcut_w > 0.0 {
-			PushStyleColorVec(ImGuiCol_Text, &style.Colors[ImGuiCol_TextDisabled])
-			RenderText(pos.Add(ImVec2{float(offsets.OffsetShortcut) + stretch_w, 0.0}), shortcut, false)
-			PopStyleColor(1)
+			g.PushStyleColorVec(ImGuiCol_Text, &style.Colors[ImGuiCol_TextDisabled])
+			g.RenderText(pos.Add(ImVec2{float(offsets.OffsetShortcut) + stretch_w, 0.0}), shortcut, false)
+			g.PopStyleColor(1)
 		}
 		if selected != nil && *selected {
-			RenderCheckMark(window.DrawList, pos.Add(ImVec2{float(offsets.OffsetMark) + stretch_w + g.FontSize*0.40, g.FontSize * 0.134 * 0.5}), GetColorU32FromID(ImGuiCol_Text, 1), g.FontSize*0.866)
+			RenderCheckMark(window.DrawList, pos.Add(ImVec2{float(offsets.OffsetMark) + stretch_w + g.FontSize*0.40, g.FontSize * 0.134 * 0.5}), g.GetColorU32FromID(ImGuiCol_Text, 1), g.FontSize*0.866)
 		}
 	}
+	var checked = ImGuiItemStatusFlags_None
+	if selected != nil && *selected {
+		checked = ImGuiItemStatusFlags_Checked
+	}
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(g.LastItemData.ID, label, g.LastItemData.StatusFlags|ImGuiItemStatusFlags_Checkable|checked)
 	if !enabled {
-		EndDisabled()
+		g.EndDisabled()
 	}
-	PopID()
+	g.PopID()
 
 	return pressed
 }
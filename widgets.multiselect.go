@@ -0,0 +1,764 @@
+package imgui
+
+import (
+	"sort"
+
+	"github.com/Splizard/imgui/golang"
+)
+
+// Multi-selection system
+// - Refer to 'Demo->Widgets->Selection State & Multi-Select' for references using this.
+// - This enables standard multi-selection/range-selection idioms (CTRL+Mouse/Keyboard, SHIFT+Mouse/Keyboard, etc.)
+//   with support for clipper (skipping non-visible items), box-select and many other details.
+// - Selectable() and TreeNode() are supported.
+// - The BeginMultiSelect()/EndMultiSelect() reference the selection through SetNextItemSelectionUserData() values,
+//   which are generally the index of the items. Your application owns the selection storage and applies the
+//   requests returned by BeginMultiSelect() and EndMultiSelect() to it, e.g. with ImGuiSelectionBasicStorage.
+// - Usage flow:
+//   BEGIN - (1) Call BeginMultiSelect() and retrieve the ImGuiMultiSelectIO* result.
+//         - (2) Honor request list (SetAll requests), as applied by BeginMultiSelect() on keyboard navigation or Ctrl+A.
+//         - (3) [If using clipper] You need to make sure RangeSrcItem is always submitted: clipper.IncludeItemByIndex(RangeSrcItem).
+//   LOOP  - (4) Submit your items with SetNextItemSelectionUserData() + Selectable()/TreeNode() calls.
+//   END   - (5) Call EndMultiSelect() and retrieve the ImGuiMultiSelectIO* result.
+//         - (6) Honor request list (SetAll/SetRange requests). Because of the clipper, SetRange requests may cover items that were not submitted.
+
+// BeginMultiSelect starts a multi-selection scope.
+// selection_size and items_count are optional (-1 when unknown): selection_size is used to skip the Escape/Ctrl+A
+// shortcuts when nothing is selected, items_count is copied into ImGuiMultiSelectIO for ImGuiSelectionBasicStorage.
+func (g *ImGuiContext) BeginMultiSelect(flags ImGuiMultiSelectFlags, selection_size int, items_count int) *ImGuiMultiSelectIO {
+	var window = g.CurrentWindow
+
+	g.MultiSelectTempDataStacked++
+	if g.MultiSelectTempDataStacked > int(len(g.MultiSelectTempData)) {
+		g.MultiSelectTempData = append(g.MultiSelectTempData, &ImGuiMultiSelectTempData{})
+	}
+	var ms = g.MultiSelectTempData[g.MultiSelectTempDataStacked-1]
+	g.CurrentMultiSelect = ms
+	if (flags & (ImGuiMultiSelectFlags_ScopeWindow | ImGuiMultiSelectFlags_ScopeRect)) == 0 {
+		flags |= ImGuiMultiSelectFlags_ScopeWindow
+	}
+	if flags&ImGuiMultiSelectFlags_SingleSelect != 0 {
+		flags &^= ImGuiMultiSelectFlags_BoxSelect2d | ImGuiMultiSelectFlags_BoxSelect1d
+	}
+	if flags&ImGuiMultiSelectFlags_BoxSelect2d != 0 {
+		flags &^= ImGuiMultiSelectFlags_BoxSelect1d
+	}
+
+	// FIXME: BeginFocusScope()
+	var id = window.IDStack[len(window.IDStack)-1]
+	ms.Clear()
+	ms.FocusScopeId = id
+	ms.Flags = flags
+	ms.IsFocused = (ms.FocusScopeId == g.NavFocusScopeId)
+	ms.BackupCursorMaxPos = window.DC.CursorMaxPos
+	window.DC.CursorMaxPos = window.DC.CursorPos
+	ms.ScopeRectMin = window.DC.CursorPos
+	g.PushFocusScope(ms.FocusScopeId)
+
+	// Use copy of keyboard mods at the time of the request, otherwise we would requires mods to be held for an extra frame.
+	if g.NavJustMovedToId != 0 {
+		ms.KeyMods = g.NavJustMovedToKeyMods
+	} else {
+		ms.KeyMods = g.IO.KeyMods
+	}
+	if flags&ImGuiMultiSelectFlags_NoRangeSelect != 0 {
+		ms.KeyMods &^= ImGuiKeyModFlags_Shift
+	}
+
+	// Bind storage
+	var storage, ok = g.MultiSelectStorage[id]
+	if !ok {
+		var state = NewImGuiMultiSelectState()
+		storage = &state
+		if g.MultiSelectStorage == nil {
+			g.MultiSelectStorage = make(map[ImGuiID]*ImGuiMultiSelectState)
+		}
+		g.MultiSelectStorage[id] = storage
+	}
+	storage.ID = id
+	storage.LastFrameActive = g.FrameCount
+	storage.LastSelectionSize = selection_size
+	storage.Window = window
+	ms.Storage = storage
+
+	// Output to user
+	ms.IO.Requests = ms.IO.Requests[:0]
+	ms.IO.RangeSrcItem = storage.RangeSrcItem
+	ms.IO.NavIdItem = storage.NavIdItem
+	ms.IO.NavIdSelected = storage.NavIdSelected == 1
+	ms.IO.ItemsCount = items_count
+
+	// Clear when using Navigation to move within the scope
+	// (we compare FocusScopeId so it possible to use multiple selections inside a same window)
+	var request_clear = false
+	var request_select_all = false
+	if g.NavJustMovedToId != 0 && g.NavJustMovedToFocusScopeId == ms.FocusScopeId && g.NavJustMovedToHasSelection {
+		if ms.KeyMods&ImGuiKeyModFlags_Shift != 0 && storage.RangeSrcItem != ImGuiSelectionUserData_Invalid {
+			ms.IsKeyboardSetRange = true
+		}
+		if (ms.KeyMods&(ImGuiKeyModFlags_Ctrl|ImGuiKeyModFlags_Shift)) == 0 && (flags&(ImGuiMultiSelectFlags_NoAutoClear|ImGuiMultiSelectFlags_NoAutoSelect)) == 0 {
+			request_clear = true
+		}
+	}
+
+	// Box-select handling: update active state.
+	var bs = &g.BoxSelectState
+	if flags&(ImGuiMultiSelectFlags_BoxSelect1d|ImGuiMultiSelectFlags_BoxSelect2d) != 0 {
+		ms.BoxSelectId = window.GetIDs("##BoxSelect")
+		var scope_rect = g.calcMultiSelectScopeRect(ms, window)
+		if g.BeginBoxSelect(&scope_rect, window, ms.BoxSelectId, flags) {
+			request_clear = request_clear || bs.RequestClear
+		}
+	}
+
+	if ms.IsFocused {
+		// Shortcut: Clear selection (Escape)
+		// Only claim shortcut if selection is not empty, allowing further presses on ESC to e.g. leave current child window.
+		if flags&ImGuiMultiSelectFlags_ClearOnEscape != 0 && (selection_size != 0 || bs.IsActive) {
+			if g.Shortcut(ImGuiKey_Escape, 0) {
+				request_clear = true
+				if bs.IsActive {
+					g.boxSelectDeactivateDrag(bs)
+				}
+			}
+		}
+
+		// Shortcut: Select all (CTRL+A)
+		if flags&(ImGuiMultiSelectFlags_SingleSelect|ImGuiMultiSelectFlags_NoSelectAll) == 0 {
+			if g.Shortcut(ImGuiMod_Ctrl|ImGuiKey_A, 0) {
+				request_select_all = true
+			}
+		}
+	}
+
+	if request_clear || request_select_all {
+		MultiSelectAddSetAll(ms, request_select_all)
+		if !request_select_all {
+			storage.LastSelectionSize = 0
+		}
+	}
+	switch {
+	case request_select_all:
+		ms.LoopRequestSetAll = 1
+	case request_clear:
+		ms.LoopRequestSetAll = 0
+	default:
+		ms.LoopRequestSetAll = -1
+	}
+	ms.LastSubmittedItem = ImGuiSelectionUserData_Invalid
+
+	return &ms.IO
+}
+
+// EndMultiSelect ends the scope started by BeginMultiSelect() and returns the requests to apply to the selection.
+// Return updated ImGuiMultiSelectIO structure.
+// Lifetime: don't hold on ImGuiMultiSelectIO* pointers over multiple frames or past any subsequent call to BeginMultiSelect() or EndMultiSelect().
+func (g *ImGuiContext) EndMultiSelect() *ImGuiMultiSelectIO {
+	var ms = g.CurrentMultiSelect
+	IM_ASSERT_USER_ERROR(ms != nil, "EndMultiSelect() without BeginMultiSelect()")
+	var storage = ms.Storage
+	var window = g.CurrentWindow
+	IM_ASSERT_USER_ERROR(ms.FocusScopeId == window.DC.NavFocusScopeIdCurrent, "EndMultiSelect() FocusScope mismatch!")
+	IM_ASSERT(storage.Window == g.CurrentWindow)
+	IM_ASSERT(g.MultiSelectTempDataStacked > 0 && g.MultiSelectTempData[g.MultiSelectTempDataStacked-1] == g.CurrentMultiSelect)
+
+	var scope_rect = g.calcMultiSelectScopeRect(ms, window)
+	if ms.IsFocused {
+		// We currently don't allow user code to modify RangeSrcItem by writing to BeginIO's version, but that would be an easy change here.
+		// Can't read storage.RangeSrcItem here: we want the state at the beginning of the scope.
+		if ms.IO.RangeSrcReset || (!ms.RangeSrcPassedBy && ms.IO.RangeSrcItem != ImGuiSelectionUserData_Invalid) {
+			storage.RangeSrcItem = ImGuiSelectionUserData_Invalid // Will be set to NavId.
+		}
+		if !ms.NavIdPassedBy && storage.NavIdItem != ImGuiSelectionUserData_Invalid {
+			storage.NavIdItem = ImGuiSelectionUserData_Invalid
+			storage.NavIdSelected = -1
+		}
+
+		if ms.Flags&(ImGuiMultiSelectFlags_BoxSelect1d|ImGuiMultiSelectFlags_BoxSelect2d) != 0 && g.GetBoxSelectState(ms.BoxSelectId) != nil {
+			g.EndBoxSelect(&scope_rect, ms.Flags)
+		}
+	}
+
+	if !ms.IsEndIO {
+		ms.IO.Requests = ms.IO.Requests[:0]
+	}
+
+	// Clear selection when clicking void?
+	// We specifically test for IsMouseDragPastThreshold(0) == false to allow box-selection!
+	// The InnerRect test is necessary for non-child/decorated windows.
+	var scope_hovered = g.IsWindowHovered(0) && window.InnerRect.ContainsVec(g.IO.MousePos)
+	if scope_hovered && ms.Flags&ImGuiMultiSelectFlags_ScopeRect != 0 {
+		scope_hovered = scope_rect.ContainsVec(g.IO.MousePos)
+	}
+	if scope_hovered && g.HoveredId == 0 && g.ActiveId == 0 {
+		if ms.Flags&(ImGuiMultiSelectFlags_BoxSelect1d|ImGuiMultiSelectFlags_BoxSelect2d) != 0 {
+			if !g.BoxSelectState.IsActive && !g.BoxSelectState.IsStarting && g.IO.MouseClicked[0] && !g.IO.MouseDoubleClicked[0] {
+				g.boxSelectPreStartDrag(ms.BoxSelectId, ImGuiSelectionUserData_Invalid)
+				g.FocusWindow(window)
+				g.SetHoveredID(ms.BoxSelectId) // Also prevents the click from moving the window.
+				if ms.Flags&ImGuiMultiSelectFlags_ScopeRect != 0 {
+					// Automatically switch FocusScope for initial click from void to box-select.
+					var mouse_rel = ImRect{g.IO.MousePos.Sub(window.Pos), g.IO.MousePos.Sub(window.Pos)}
+					g.SetNavID(0, ImGuiNavLayer_Main, ms.FocusScopeId, &mouse_rel)
+				}
+			}
+		}
+
+		if ms.Flags&ImGuiMultiSelectFlags_ClearOnClickVoid != 0 {
+			if g.IsMouseReleased(0) && !g.IsMouseDragPastThreshold(0, -1) && g.IO.KeyMods == ImGuiKeyModFlags_None {
+				MultiSelectAddSetAll(ms, false)
+			}
+		}
+	}
+
+	// Unwind
+	window.DC.CursorMaxPos = ImMaxVec2(&ms.BackupCursorMaxPos, &window.DC.CursorMaxPos)
+	g.PopFocusScope()
+
+	ms.FocusScopeId = 0
+	ms.Flags = ImGuiMultiSelectFlags_None
+	g.MultiSelectTempDataStacked--
+	if g.MultiSelectTempDataStacked > 0 {
+		g.CurrentMultiSelect = g.MultiSelectTempData[g.MultiSelectTempDataStacked-1]
+	} else {
+		g.CurrentMultiSelect = nil
+	}
+
+	return &ms.IO
+}
+
+// SetNextItemSelectionUserData sets the value identifying the next item within a BeginMultiSelect()/EndMultiSelect() scope,
+// generally its index. This is the value reported in ImGuiSelectionRequest.
+func (g *ImGuiContext) SetNextItemSelectionUserData(selection_user_data ImGuiSelectionUserData) {
+	g.NextItemData.Flags |= ImGuiNextItemDataFlags_HasSelectionUserData
+	g.NextItemData.SelectionUserData = selection_user_data
+	g.NextItemData.FocusScopeId = g.CurrentWindow.DC.NavFocusScopeIdCurrent
+
+	// Auto updating RangeSrcPassedBy for cases were clipper is not used (done before ItemAdd() clipping)
+	if ms := g.CurrentMultiSelect; ms != nil && ms.IO.RangeSrcItem == selection_user_data {
+		ms.RangeSrcPassedBy = true
+	}
+}
+
+func (g *ImGuiContext) calcMultiSelectScopeRect(ms *ImGuiMultiSelectTempData, window *ImGuiWindow) ImRect {
+	if ms.Flags&ImGuiMultiSelectFlags_ScopeRect != 0 {
+		// Warning: this depends on CursorMaxPos so it means to be called by EndMultiSelect() only
+		return ImRect{ms.ScopeRectMin, ImMaxVec2(&window.DC.CursorMaxPos, &ms.ScopeRectMin)}
+	}
+	// When a table, pull HostClipRect, which allows us to predict ClipRect before first row/layout is performed.
+	if g.CurrentTable != nil {
+		return g.CurrentTable.HostClipRect
+	}
+	return window.InnerClipRect
+}
+
+// MultiSelectItemHeader is called by Selectable() and TreeNode() before ButtonBehavior(), to apply
+// the select all/clear all and keyboard range requests to the display state, and alter the button flags.
+func (g *ImGuiContext) MultiSelectItemHeader(id ImGuiID, p_selected *bool, p_button_flags *ImGuiButtonFlags) {
+	var ms = g.CurrentMultiSelect
+
+	var selected = *p_selected
+	if ms.IsFocused {
+		var storage = ms.Storage
+		var item_data = g.NextItemData.SelectionUserData
+		IM_ASSERT_USER_ERROR(g.NextItemData.FocusScopeId == g.CurrentWindow.DC.NavFocusScopeIdCurrent, "Forgot to call SetNextItemSelectionUserData() prior to item, required in BeginMultiSelect()/EndMultiSelect() scope")
+
+		// If we are using keyboard we need this to be able to select without moving
+		// (e.g. arrow down + shift to select all from top to bottom)
+		if ms.LoopRequestSetAll != -1 {
+			selected = ms.LoopRequestSetAll == 1
+		} else if ms.IsKeyboardSetRange {
+			IM_ASSERT(storage.RangeSrcItem != ImGuiSelectionUserData_Invalid)
+			var is_range_dst = !ms.RangeDstPassedBy && g.NavJustMovedToId == id // Assume that g.NavJustMovedToId is not clipped.
+			if is_range_dst {
+				ms.RangeDstPassedBy = true
+			}
+			var is_range_src = storage.RangeSrcItem == item_data
+			if is_range_src || is_range_dst || ms.RangeSrcPassedBy != ms.RangeDstPassedBy {
+				// Apply range-select value to visible items
+				IM_ASSERT(storage.RangeSelected != -1)
+				selected = storage.RangeSelected != 0
+			} else if ms.KeyMods&ImGuiKeyModFlags_Ctrl == 0 && ms.Flags&ImGuiMultiSelectFlags_NoAutoClear == 0 {
+				// Clear other items
+				selected = false
+			}
+		}
+		*p_selected = selected
+	}
+
+	// Alter button behavior flags
+	// To handle drag and drop of multiple items we need to avoid clearing selection on click.
+	// Enabling this test makes actions using CTRL+SHIFT delay their effect on MouseUp which is annoying, but it allows drag and drop of multiple items.
+	if p_button_flags != nil {
+		var button_flags = *p_button_flags
+		button_flags |= ImGuiButtonFlags_NoHoveredOnFocus
+		if (!selected || (g.ActiveId == id && g.ActiveIdHasBeenPressedBefore)) && ms.Flags&ImGuiMultiSelectFlags_SelectOnClickRelease == 0 {
+			button_flags = (button_flags | ImGuiButtonFlags_PressedOnClick) &^ ImGuiButtonFlags_PressedOnClickRelease
+		} else {
+			button_flags |= ImGuiButtonFlags_PressedOnClickRelease
+		}
+		*p_button_flags = button_flags
+	}
+}
+
+// MultiSelectItemFooter is called by Selectable() and TreeNode() after ButtonBehavior(). In charge of:
+// - Auto-select on navigation.
+// - Box-select toggle handling.
+// - Right-click handling.
+// - Altering selection based on Ctrl/Shift modifiers, both for keyboard and mouse.
+// - Record current selection state for RangeSrc
+func (g *ImGuiContext) MultiSelectItemFooter(id ImGuiID, p_selected *bool, p_pressed *bool) {
+	var window = g.CurrentWindow
+
+	var selected = *p_selected
+	var pressed = *p_pressed
+	var ms = g.CurrentMultiSelect
+	var storage = ms.Storage
+	if pressed {
+		ms.IsFocused = true
+	}
+
+	var hovered = false
+	if g.LastItemData.StatusFlags&ImGuiItemStatusFlags_HoveredRect != 0 {
+		hovered = g.IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup)
+	}
+	if !ms.IsFocused && !hovered {
+		return
+	}
+
+	var item_data = g.NextItemData.SelectionUserData
+
+	var flags = ms.Flags
+	var is_singleselect = flags&ImGuiMultiSelectFlags_SingleSelect != 0
+	var is_ctrl = ms.KeyMods&ImGuiKeyModFlags_Ctrl != 0
+	var is_shift = ms.KeyMods&ImGuiKeyModFlags_Shift != 0
+
+	var apply_to_range_src = false
+	if g.NavId == id && storage.RangeSrcItem == ImGuiSelectionUserData_Invalid {
+		apply_to_range_src = true
+	}
+	if !ms.IsEndIO {
+		ms.IO.Requests = ms.IO.Requests[:0]
+		ms.IsEndIO = true
+	}
+
+	// Auto-select as you navigate a list
+	if g.NavJustMovedToId == id {
+		if flags&ImGuiMultiSelectFlags_NoAutoSelect == 0 {
+			if is_ctrl && is_shift {
+				pressed = true
+			} else if !is_ctrl {
+				selected = true
+				pressed = true
+			}
+		} else {
+			// With NoAutoSelect, using Shift+keyboard performs a write/copy
+			if is_shift {
+				pressed = true
+			} else if !is_ctrl {
+				apply_to_range_src = true // Since if (pressed) {} main block is not running we update this
+			}
+		}
+	}
+
+	if apply_to_range_src {
+		storage.RangeSrcItem = item_data
+		storage.RangeSelected = ImS8(bool2int(selected)) // Will be updated at the end of this function anyway.
+	}
+
+	// Box-select toggle handling
+	if ms.BoxSelectId != 0 {
+		if bs := g.GetBoxSelectState(ms.BoxSelectId); bs != nil {
+			var rect_overlap_curr = bs.BoxSelectRectCurr.Overlaps(g.LastItemData.Rect)
+			var rect_overlap_prev = bs.BoxSelectRectPrev.Overlaps(g.LastItemData.Rect)
+			if (rect_overlap_curr && !rect_overlap_prev && !selected) || (rect_overlap_prev && !rect_overlap_curr) {
+				if storage.LastSelectionSize <= 0 && bs.IsStartedSetNavIdOnce {
+					pressed = true // First item act as a pressed: code below will emit selection request and set NavId (whatever we emit here will be overridden anyway)
+					bs.IsStartedSetNavIdOnce = false
+				} else {
+					selected = !selected
+					MultiSelectAddSetRange(ms, selected, +1, item_data, item_data)
+				}
+				storage.LastSelectionSize = ImMaxInt(storage.LastSelectionSize+1, 1)
+			}
+		}
+	}
+
+	// Right-click handling: select the item unless it is already part of the selection.
+	if hovered && g.IsMouseClicked(1, false) && flags&ImGuiMultiSelectFlags_NoAutoSelect == 0 {
+		if g.ActiveId != 0 && g.ActiveId != id {
+			g.ClearActiveID()
+		}
+		g.SetFocusID(id, window)
+		if !pressed && !selected {
+			pressed = true
+			is_ctrl = false
+			is_shift = false
+		}
+	}
+
+	// Alter selection
+	if pressed {
+		// Box-select
+		var input_source = ImGuiInputSource_Mouse
+		if g.NavJustMovedToId == id || g.NavActivateId == id {
+			input_source = g.NavInputSource
+		}
+		if flags&(ImGuiMultiSelectFlags_BoxSelect1d|ImGuiMultiSelectFlags_BoxSelect2d) != 0 {
+			if !selected && !g.BoxSelectState.IsActive && !g.BoxSelectState.IsStarting && input_source == ImGuiInputSource_Mouse && g.IO.MouseClicked[0] && !g.IO.MouseDoubleClicked[0] {
+				g.boxSelectPreStartDrag(ms.BoxSelectId, item_data)
+			}
+		}
+
+		//----------------------------------------------------------------------------------------
+		// ACTION                      | Begin  | Pressed/Activated  | End
+		//----------------------------------------------------------------------------------------
+		// Keys Navigated:             | Clear  | Src=item, Sel=1               SetRange 1
+		// Keys Navigated: Ctrl        | n/a    | n/a
+		// Keys Navigated:      Shift  | n/a    | Dst=item, Sel=1,   => Clear + SetRange 1
+		// Keys Navigated: Ctrl+Shift  | n/a    | Dst=item, Sel=Src  => Clear + SetRange Src-Dst
+		// Keys Activated:             | n/a    | Src=item, Sel=1    => Clear + SetRange 1
+		// Keys Activated: Ctrl        | n/a    | Src=item, Sel=!Sel =>         SetRange 1
+		// Keys Activated:      Shift  | n/a    | Dst=item, Sel=1    => Clear + SetRange 1
+		//----------------------------------------------------------------------------------------
+		// Mouse Pressed:              | n/a    | Src=item, Sel=1,   => Clear + SetRange 1
+		// Mouse Pressed:  Ctrl        | n/a    | Src=item, Sel=!Sel =>         SetRange 1
+		// Mouse Pressed:       Shift  | n/a    | Dst=item, Sel=1,   => Clear + SetRange 1
+		// Mouse Pressed:  Ctrl+Shift  | n/a    | Dst=item, Sel=!Sel =>         SetRange Src-Dst
+		//----------------------------------------------------------------------------------------
+
+		if flags&ImGuiMultiSelectFlags_NoAutoClear == 0 {
+			var request_clear = false
+			if is_singleselect {
+				request_clear = true
+			} else if (input_source == ImGuiInputSource_Mouse || g.NavActivateId == id) && !is_ctrl {
+				request_clear = true
+			} else if (input_source == ImGuiInputSource_Keyboard || input_source == ImGuiInputSource_Gamepad) && is_shift && !is_ctrl {
+				request_clear = true // With is_shift==false the RequestClear was done in BeginIO, not necessary to do again.
+			}
+			if request_clear {
+				MultiSelectAddSetAll(ms, false)
+			}
+		}
+
+		var range_direction int
+		var range_selected bool
+		if is_shift && !is_singleselect {
+			if storage.RangeSrcItem == ImGuiSelectionUserData_Invalid {
+				storage.RangeSrcItem = item_data
+			}
+			if flags&ImGuiMultiSelectFlags_NoAutoSelect == 0 {
+				// Shift+Arrow always select
+				// Ctrl+Shift+Arrow copy source selection state (already stored by BeginMultiSelect() in storage.RangeSelected)
+				range_selected = true
+				if is_ctrl && storage.RangeSelected != -1 {
+					range_selected = storage.RangeSelected != 0
+				}
+			} else {
+				// Shift+Arrow copy source selection state
+				// Shift+Click always copy from target selection state
+				if ms.IsKeyboardSetRange {
+					range_selected = storage.RangeSelected == -1 || storage.RangeSelected != 0
+				} else {
+					range_selected = !selected
+				}
+			}
+			range_direction = -1
+			if ms.RangeSrcPassedBy {
+				range_direction = +1
+			}
+		} else {
+			// Ctrl inverts selection, otherwise always select
+			if flags&ImGuiMultiSelectFlags_NoAutoSelect == 0 && !is_ctrl {
+				selected = true
+			} else {
+				selected = !selected
+			}
+			storage.RangeSrcItem = item_data
+			range_selected = selected
+			range_direction = +1
+		}
+		MultiSelectAddSetRange(ms, range_selected, range_direction, storage.RangeSrcItem, item_data)
+	}
+
+	// Update/store the selection state of the Source item (used by CTRL+SHIFT, when Source is unselected we perform a range unselect)
+	if storage.RangeSrcItem == item_data {
+		storage.RangeSelected = ImS8(bool2int(selected))
+	}
+
+	// Update/store the selection state of focused item
+	if g.NavId == id {
+		storage.NavIdItem = item_data
+		storage.NavIdSelected = ImS8(bool2int(selected))
+	}
+	if storage.NavIdItem == item_data {
+		ms.NavIdPassedBy = true
+	}
+	ms.LastSubmittedItem = item_data
+
+	*p_selected = selected
+	*p_pressed = pressed
+}
+
+func MultiSelectAddSetAll(ms *ImGuiMultiSelectTempData, selected bool) {
+	var req = ImGuiSelectionRequest{ImGuiSelectionRequestType_SetAll, selected, 0, ImGuiSelectionUserData_Invalid, ImGuiSelectionUserData_Invalid}
+	ms.IO.Requests = append(ms.IO.Requests[:0], req) // Can always clear previous requests
+}
+
+func MultiSelectAddSetRange(ms *ImGuiMultiSelectTempData, selected bool, range_dir int, first_item, last_item ImGuiSelectionUserData) {
+	// Merge contiguous spans into same request (unless NoRangeSelect is set which guarantees single-item ranges)
+	if len(ms.IO.Requests) > 0 && first_item == last_item && ms.Flags&ImGuiMultiSelectFlags_NoRangeSelect == 0 {
+		var prev = &ms.IO.Requests[len(ms.IO.Requests)-1]
+		if prev.Type == ImGuiSelectionRequestType_SetRange && prev.RangeLastItem == ms.LastSubmittedItem && prev.Selected == selected {
+			prev.RangeLastItem = last_item
+			return
+		}
+	}
+
+	var req = ImGuiSelectionRequest{Type: ImGuiSelectionRequestType_SetRange, Selected: selected, RangeDirection: ImS8(range_dir)}
+	if range_dir > 0 {
+		req.RangeFirstItem, req.RangeLastItem = first_item, last_item
+	} else {
+		req.RangeFirstItem, req.RangeLastItem = last_item, first_item
+	}
+	ms.IO.Requests = append(ms.IO.Requests, req)
+}
+
+//-------------------------------------------------------------------------
+// Box-Select
+//-------------------------------------------------------------------------
+// Box-select is started by clicking in the void (or on an unselected item) of a multi-select scope
+// using ImGuiMultiSelectFlags_BoxSelect1d or ImGuiMultiSelectFlags_BoxSelect2d, and dragging the mouse.
+// Items are toggled as the rectangle moves over them, see MultiSelectItemFooter().
+
+// GetBoxSelectState returns the active box-select state if it is owned by id.
+func (g *ImGuiContext) GetBoxSelectState(id ImGuiID) *ImGuiBoxSelectState {
+	if id != 0 && g.BoxSelectState.ID == id && g.BoxSelectState.IsActive {
+		return &g.BoxSelectState
+	}
+	return nil
+}
+
+func (g *ImGuiContext) boxSelectPreStartDrag(id ImGuiID, clicked_item ImGuiSelectionUserData) {
+	var bs = &g.BoxSelectState
+	bs.ID = id
+	bs.IsStarting = true // Consider starting box-select.
+	bs.IsStartedFromVoid = (clicked_item == ImGuiSelectionUserData_Invalid)
+	bs.IsStartedSetNavIdOnce = bs.IsStartedFromVoid
+	bs.KeyMods = g.IO.KeyMods
+	bs.StartPosRel = g.IO.MousePos.Sub(g.CurrentWindow.DC.CursorStartPos)
+	bs.EndPosRel = bs.StartPosRel
+	bs.ScrollAccum = ImVec2{}
+}
+
+func (g *ImGuiContext) boxSelectActivateDrag(bs *ImGuiBoxSelectState, window *ImGuiWindow) {
+	bs.IsActive = true
+	bs.Window = window
+	bs.IsStarting = false
+	g.SetActiveID(bs.ID, window)
+	if bs.IsStartedFromVoid && bs.KeyMods&(ImGuiKeyModFlags_Ctrl|ImGuiKeyModFlags_Shift) == 0 {
+		bs.RequestClear = true
+	}
+}
+
+func (g *ImGuiContext) boxSelectDeactivateDrag(bs *ImGuiBoxSelectState) {
+	bs.IsActive = false
+	bs.IsStarting = false
+	if g.ActiveId == bs.ID {
+		g.ClearActiveID()
+	}
+	bs.ID = 0
+}
+
+func (g *ImGuiContext) boxSelectScrollWithMouseDrag(bs *ImGuiBoxSelectState, window *ImGuiWindow, inner_r *ImRect) {
+	IM_ASSERT(bs.Window == window)
+	var mouse = [2]float{g.IO.MousePos.x, g.IO.MousePos.y}
+	var min = [2]float{inner_r.Min.x, inner_r.Min.y}
+	var max = [2]float{inner_r.Max.x, inner_r.Max.y}
+	var scroll = [2]float{window.Scroll.x, window.Scroll.y}
+	var scroll_max = [2]float{window.ScrollMax.x, window.ScrollMax.y}
+	var accum = [2]*float{&bs.ScrollAccum.x, &bs.ScrollAccum.y}
+	for n := 0; n < 2; n++ { // each axis
+		var dist float
+		if mouse[n] > max[n] {
+			dist = mouse[n] - max[n]
+		} else if mouse[n] < min[n] {
+			dist = mouse[n] - min[n]
+		}
+		if dist == 0.0 || (dist < 0.0 && scroll[n] < 0.0) || (dist > 0.0 && scroll[n] >= scroll_max[n]) {
+			continue
+		}
+
+		// x1 to x4 depending on distance
+		var speed_multiplier = ImClamp(1.0+3.0*(ImAbs(dist)-g.FontSize)/(g.FontSize*4.0), 1.0, 4.0)
+		var scroll_step = g.FontSize * 35.0 * speed_multiplier * ImSign(dist) * g.IO.DeltaTime
+		*accum[n] += scroll_step
+
+		// Accumulate into a stored value so we can handle high-framerate
+		var scroll_step_i = ImFloorSigned(*accum[n])
+		if scroll_step_i == 0.0 {
+			continue
+		}
+		if n == 0 {
+			setScrollX(window, window.Scroll.x+scroll_step_i)
+		} else {
+			setScrollY(window, window.Scroll.y+scroll_step_i)
+		}
+		*accum[n] -= scroll_step_i
+	}
+}
+
+// BeginBoxSelect updates the box-select owned by box_select_id, and returns true while it is active.
+func (g *ImGuiContext) BeginBoxSelect(scope_rect *ImRect, window *ImGuiWindow, box_select_id ImGuiID, ms_flags ImGuiMultiSelectFlags) bool {
+	var bs = &g.BoxSelectState
+	g.KeepAliveID(box_select_id)
+	if bs.ID != box_select_id {
+		return false
+	}
+
+	// IsStarting is set by MultiSelectItemFooter() when considering a possible box-select. We validate it here and lock geometry.
+	bs.UnclipMode = false
+	bs.RequestClear = false
+	if bs.IsStarting && g.IsMouseDragPastThreshold(0, -1) {
+		g.boxSelectActivateDrag(bs, window)
+	} else if (bs.IsStarting || bs.IsActive) && !g.IO.MouseDown[0] {
+		g.boxSelectDeactivateDrag(bs)
+	}
+	if !bs.IsActive {
+		return false
+	}
+
+	// Current frame absolute prev/current rectangles are used to toggle selection.
+	// They are derived from positions relative to scrolling space.
+	var start_pos_abs = bs.StartPosRel.Add(window.DC.CursorStartPos)
+	var prev_end_pos_abs = bs.EndPosRel.Add(window.DC.CursorStartPos) // Clamped already
+	var curr_end_pos_abs = g.IO.MousePos
+	if ms_flags&ImGuiMultiSelectFlags_ScopeWindow != 0 { // Box-select scrolling only happens with ScopeWindow
+		curr_end_pos_abs = ImClampVec2(&curr_end_pos_abs, &scope_rect.Min, scope_rect.Max)
+	}
+	bs.BoxSelectRectPrev = ImRect{ImMinVec2(&start_pos_abs, &prev_end_pos_abs), ImMaxVec2(&start_pos_abs, &prev_end_pos_abs)}
+	bs.BoxSelectRectCurr = ImRect{ImMinVec2(&start_pos_abs, &curr_end_pos_abs), ImMaxVec2(&start_pos_abs, &curr_end_pos_abs)}
+
+	// Box-select 2D mode detects horizontal changes (vertical ones are already picked by Clipper)
+	// Storing an extra rect used by widgets supporting box-select.
+	if ms_flags&ImGuiMultiSelectFlags_BoxSelect2d != 0 {
+		if bs.BoxSelectRectPrev.Min.x != bs.BoxSelectRectCurr.Min.x || bs.BoxSelectRectPrev.Max.x != bs.BoxSelectRectCurr.Max.x {
+			bs.UnclipMode = true
+			bs.UnclipRect = bs.BoxSelectRectPrev // FIXME-OPT: UnclipRect x coordinates could be intersection of Prev and Curr rect on X axis.
+			bs.UnclipRect.AddRect(bs.BoxSelectRectCurr)
+		}
+	}
+	return true
+}
+
+// EndBoxSelect renders the box-select rectangle and scrolls the window when the mouse is dragged near its edges.
+func (g *ImGuiContext) EndBoxSelect(scope_rect *ImRect, ms_flags ImGuiMultiSelectFlags) {
+	var window = g.CurrentWindow
+	var bs = &g.BoxSelectState
+	IM_ASSERT(bs.IsActive)
+	bs.UnclipMode = false
+
+	// Render selection rectangle
+	var mouse_pos = ImClampVec2(&g.IO.MousePos, &scope_rect.Min, scope_rect.Max) // Clamp stored position according to current scrolling view
+	bs.EndPosRel = mouse_pos.Sub(window.DC.CursorStartPos)
+	var box_select_r = bs.BoxSelectRectCurr
+	box_select_r.ClipWith(*scope_rect)
+	window.DrawList.AddRectFilled(box_select_r.Min, box_select_r.Max, g.GetColorU32FromID(ImGuiCol_SeparatorHovered, 0.30), 0, 0) // FIXME-MULTISELECT: Styling
+	window.DrawList.AddRect(box_select_r.Min, box_select_r.Max, g.GetColorU32FromID(ImGuiCol_NavHighlight, 1), 0, 0, 1.0)         // FIXME-MULTISELECT FIXME-DPI: Styling
+
+	// Scroll
+	var enable_scroll = ms_flags&ImGuiMultiSelectFlags_ScopeWindow != 0 && ms_flags&ImGuiMultiSelectFlags_BoxSelectNoScroll == 0
+	if enable_scroll {
+		var scroll_r = *scope_rect
+		scroll_r.Expand(-g.FontSize)
+		if !scroll_r.ContainsVec(g.IO.MousePos) {
+			g.boxSelectScrollWithMouseDrag(bs, window, &scroll_r)
+		}
+	}
+}
+
+//-------------------------------------------------------------------------
+// ImGuiSelectionBasicStorage
+//-------------------------------------------------------------------------
+
+// ImGuiSelectionBasicStorage Optional helper to store the selection state of a multi-selection and apply the requests to it.
+// Items are identified by an ImGuiID, derived from their index by AdapterIndexToStorageId (the index itself when nil),
+// so SetNextItemSelectionUserData() must be given the index of the items.
+// Only the selected items are stored: a few selected items cost little whatever the list size, but select-all (Ctrl+A)
+// and large range selections store an entry for every selected item.
+type ImGuiSelectionBasicStorage struct {
+	Size                    int                                                     // Number of selected items, maintained by this helper.
+	UserData                any                                                     // User data for use by the adapter function, e.g. the items array.
+	AdapterIndexToStorageId func(self *ImGuiSelectionBasicStorage, idx int) ImGuiID // e.g. func(self, idx) ImGuiID { return items[idx].ID }
+
+	storage map[ImGuiID]struct{}
+}
+
+// GetStorageIdFromIndex returns the ID of the item at index idx.
+func (s *ImGuiSelectionBasicStorage) GetStorageIdFromIndex(idx int) ImGuiID {
+	if s.AdapterIndexToStorageId == nil {
+		return ImGuiID(idx)
+	}
+	return s.AdapterIndexToStorageId(s, idx)
+}
+
+// ApplyRequests applies the selection requests coming from BeginMultiSelect() and EndMultiSelect().
+// SetAll requests need the items_count parameter of BeginMultiSelect() to select all items.
+func (s *ImGuiSelectionBasicStorage) ApplyRequests(ms_io *ImGuiMultiSelectIO) {
+	for _, req := range ms_io.Requests {
+		switch req.Type {
+		case ImGuiSelectionRequestType_SetAll:
+			s.Clear()
+			if req.Selected {
+				IM_ASSERT_USER_ERROR(ms_io.ItemsCount >= 0, "Missing value for items_count in BeginMultiSelect() call!")
+				for idx := int(0); idx < ms_io.ItemsCount; idx++ {
+					s.SetItemSelected(s.GetStorageIdFromIndex(idx), true)
+				}
+			}
+		case ImGuiSelectionRequestType_SetRange:
+			for idx := int(req.RangeFirstItem); idx <= int(req.RangeLastItem); idx++ {
+				s.SetItemSelected(s.GetStorageIdFromIndex(idx), req.Selected)
+			}
+		}
+	}
+}
+
+// Contains returns true if the item is selected.
+func (s *ImGuiSelectionBasicStorage) Contains(id ImGuiID) bool {
+	_, ok := s.storage[id]
+	return ok
+}
+
+// SetItemSelected adds or removes an item from the selection.
+func (s *ImGuiSelectionBasicStorage) SetItemSelected(id ImGuiID, selected bool) {
+	_, ok := s.storage[id]
+	if ok == selected {
+		return
+	}
+	if selected {
+		if s.storage == nil {
+			s.storage = make(map[ImGuiID]struct{})
+		}
+		s.storage[id] = struct{}{}
+		s.Size++
+	} else {
+		delete(s.storage, id)
+		s.Size--
+	}
+}
+
+// Clear unselects all items.
+func (s *ImGuiSelectionBasicStorage) Clear() {
+	s.storage = nil
+	s.Size = 0
+}
+
+// GetSelectedItems returns the IDs of the selected items, in increasing order.
+func (s *ImGuiSelectionBasicStorage) GetSelectedItems() []ImGuiID {
+	var ids = make([]ImGuiID, 0, len(s.storage))
+	for id := range s.storage {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j golang.Int) bool { return ids[i] < ids[j] })
+	return ids
+}
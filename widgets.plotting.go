@@ -25,27 +25,26 @@ func Plot_ArrayGetter(data any, idx int) float {
 
 // Widgets: Data Plotting
 // - Consider using ImPlot (https://github.com/epezent/implot) which is much better!
-func PlotLines(label string, values []float, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/, stride int /*= sizeof(float)*/) {
+func (g *ImGuiContext) PlotLines(label string, values []float, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/, stride int /*= sizeof(float)*/) {
 	var data = ImGuiPlotArrayGetterData{values, stride}
-	PlotEx(ImGuiPlotType_Lines, label, Plot_ArrayGetter, &data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+	g.PlotEx(ImGuiPlotType_Lines, label, Plot_ArrayGetter, &data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
 }
 
-func PlotLinesFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/) {
-	PlotEx(ImGuiPlotType_Lines, label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+func (g *ImGuiContext) PlotLinesFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/) {
+	g.PlotEx(ImGuiPlotType_Lines, label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
 }
 
-func PlotHistogram(label string, values []float, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/, stride int /* = sizeof(float)*/) {
+func (g *ImGuiContext) PlotHistogram(label string, values []float, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/, stride int /* = sizeof(float)*/) {
 	var data = ImGuiPlotArrayGetterData{values, stride}
-	PlotEx(ImGuiPlotType_Histogram, label, Plot_ArrayGetter, &data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+	g.PlotEx(ImGuiPlotType_Histogram, label, Plot_ArrayGetter, &data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
 }
 
-func PlotHistogramFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/) {
-	PlotEx(ImGuiPlotType_Histogram, label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
+func (g *ImGuiContext) PlotHistogramFunc(label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int /*= 0*/, overlay_text string /*= L*/, scale_min float /*= X*/, scale_max float /*= X*/, graph_size ImVec2 /*= 0*/) {
+	g.PlotEx(ImGuiPlotType_Histogram, label, values_getter, data, values_count, values_offset, overlay_text, scale_min, scale_max, graph_size)
 }
 
-func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int, overlay_text string, scale_min float, scale_max float, frame_size ImVec2) int {
-	var g = GImGui
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any, idx int) float, data any, values_count int, values_offset int, overlay_text string, scale_min float, scale_max float, frame_size ImVec2) int {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return -1
 	}
@@ -53,9 +52,9 @@ func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any,
 	var style = g.Style
 	var id = window.GetIDs(label)
 
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 	if frame_size.x == 0.0 {
-		frame_size.x = CalcItemWidth()
+		frame_size.x = g.CalcItemWidth()
 	}
 	if frame_size.y == 0.0 {
 		frame_size.y = label_size.y + (style.FramePadding.y * 2)
@@ -69,11 +68,11 @@ func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any,
 	var frame_bb = ImRect{window.DC.CursorPos, window.DC.CursorPos.Add(frame_size)}
 	var inner_bb = ImRect{frame_bb.Min.Add(style.FramePadding), frame_bb.Max.Sub(style.FramePadding)}
 	var total_bb = ImRect{frame_bb.Min, frame_bb.Max.Add(ImVec2{padding, 0})}
-	ItemSizeRect(&total_bb, style.FramePadding.y)
-	if !ItemAdd(&total_bb, 0, &frame_bb, 0) {
+	g.ItemSizeRect(&total_bb, style.FramePadding.y)
+	if !g.ItemAdd(&total_bb, 0, &frame_bb, 0) {
 		return -1
 	}
-	var hovered = ItemHoverable(&frame_bb, id)
+	var hovered = g.ItemHoverable(&frame_bb, id)
 
 	// Determine scale from values if not specified
 	if scale_min == FLT_MAX || scale_max == FLT_MAX {
@@ -95,7 +94,7 @@ func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any,
 		}
 	}
 
-	RenderFrame(frame_bb.Min, frame_bb.Max, GetColorU32FromID(ImGuiCol_FrameBg, 1), true, style.FrameRounding)
+	g.RenderFrame(frame_bb.Min, frame_bb.Max, g.GetColorU32FromID(ImGuiCol_FrameBg, 1), true, style.FrameRounding)
 
 	var values_count_min int = 1
 	if plot_type == ImGuiPlotType_Lines {
@@ -119,9 +118,9 @@ func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any,
 			var v0 = values_getter(data, (v_idx+values_offset)%values_count)
 			var v1 = values_getter(data, (v_idx+1+values_offset)%values_count)
 			if plot_type == ImGuiPlotType_Lines {
-				SetTooltip("%d: %8.4g\n%d: %8.4g", v_idx, v0, v_idx+1, v1)
+				g.SetTooltip("%d: %8.4g\n%d: %8.4g", v_idx, v0, v_idx+1, v1)
 			} else if plot_type == ImGuiPlotType_Histogram {
-				SetTooltip("%d: %8.4g", v_idx, v0)
+				g.SetTooltip("%d: %8.4g", v_idx, v0)
 			}
 			idx_hovered = v_idx
 		}
@@ -151,14 +150,14 @@ func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any,
 			c = ImGuiCol_PlotLines
 		}
 
-		var col_base = GetColorU32FromID(c, 1)
+		var col_base = g.GetColorU32FromID(c, 1)
 
 		c = ImGuiCol_PlotHistogramHovered
 		if plot_type == ImGuiPlotType_Lines {
 			c = ImGuiCol_PlotLinesHovered
 		}
 
-		var col_hovered = GetColorU32FromID(c, 1)
+		var col_hovered = g.GetColorU32FromID(c, 1)
 
 		for n := int(0); n < res_w; n++ {
 			var t1 = t0 + t_step
@@ -200,10 +199,10 @@ func PlotEx(plot_type ImGuiPlotType, label string, values_getter func(data any,
 
 	// Text overlay
 	if overlay_text != "" {
-		RenderTextClipped(&ImVec2{frame_bb.Min.x, frame_bb.Min.y + style.FramePadding.y}, &frame_bb.Max, overlay_text, nil, &ImVec2{0.5, 0.0}, nil)
+		g.RenderTextClipped(&ImVec2{frame_bb.Min.x, frame_bb.Min.y + style.FramePadding.y}, &frame_bb.Max, overlay_text, nil, &ImVec2{0.5, 0.0}, nil)
 	}
 	if label_size.x > 0.0 {
-		RenderText(ImVec2{frame_bb.Max.x + style.ItemInnerSpacing.x, inner_bb.Min.y}, label, true)
+		g.RenderText(ImVec2{frame_bb.Max.x + style.ItemInnerSpacing.x, inner_bb.Min.y}, label, true)
 	}
 
 	// Return hovered index or -1 if none are hovered.
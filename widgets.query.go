@@ -8,14 +8,13 @@ package imgui
 // This is roughly matching the behavior of internal-facing ItemHoverable()
 // - we allow hovering to be true when ActiveId==window.MoveID, so that clicking on non-interactive items such as a Text() item still returns true with IsItemHovered()
 // - this should work even for non-interactive items that have no ID, so we cannot use LastItemId
-func IsItemHovered(flags ImGuiHoveredFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemHovered(flags ImGuiHoveredFlags) bool {
 	var window = g.CurrentWindow
 	if g.NavDisableMouseHover && !g.NavDisableHighlight {
 		if (g.LastItemData.InFlags&ImGuiItemFlags_Disabled != 0) && (flags&ImGuiHoveredFlags_AllowWhenDisabled == 0) {
 			return false
 		}
-		return IsItemFocused()
+		return g.IsItemFocused()
 	}
 
 	// Test for bounding box overlap, as updated as ItemAdd()
@@ -45,7 +44,7 @@ func IsItemHovered(flags ImGuiHoveredFlags) bool {
 
 	// Test if interactions on this window are blocked by an active popup or modal.
 	// The ImGuiHoveredFlags_AllowWhenBlockedByPopup flag will be tested here.
-	if !IsWindowContentHoverable(window, flags) {
+	if !g.IsWindowContentHoverable(window, flags) {
 		return false
 	}
 
@@ -61,33 +60,29 @@ func IsItemHovered(flags ImGuiHoveredFlags) bool {
 
 // IsItemFocused is the last item focused for keyboard/gamepad navigation?
 // == GetItemID() == GetFocusID()
-func IsItemFocused() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemFocused() bool {
 	return !(g.NavId != g.LastItemData.ID || g.NavId == 0)
 }
 
 // IsItemClicked is the last item hovered and mouse clicked on? (**)  == IsMouseClicked(mouse_button) && IsItemHovered()Important. (**) this it NOT equivalent to the behavior of e.g. Button(). Read comments in function definition.
 // Important: this can be useful but it is NOT equivalent to the behavior of e.g.Button()!
 // Most widgets have specific reactions based on mouse-up/down state, mouse position etc.
-func IsItemClicked(mouse_button ImGuiMouseButton) bool {
-	return IsMouseClicked(mouse_button, false) && IsItemHovered(ImGuiHoveredFlags_None)
+func (g *ImGuiContext) IsItemClicked(mouse_button ImGuiMouseButton) bool {
+	return g.IsMouseClicked(mouse_button, false) && g.IsItemHovered(ImGuiHoveredFlags_None)
 }
 
 // IsItemVisible is the last item visible? (items may be out of sight because of clipping/scrolling)
-func IsItemVisible() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemVisible() bool {
 	return g.CurrentWindow.ClipRect.Overlaps(g.LastItemData.Rect)
 }
 
 // IsItemEdited did the last item modify its underlying value this frame? or was pressed? This is generally the same as the "bool" return value of many widgets.
-func IsItemEdited() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemEdited() bool {
 	return (g.LastItemData.StatusFlags & ImGuiItemStatusFlags_Edited) != 0
 }
 
 // IsItemActivated was the last item just made active (item was previously inactive).
-func IsItemActivated() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemActivated() bool {
 	if g.ActiveId != 0 {
 		return g.ActiveId == g.LastItemData.ID && g.ActiveIdPreviousFrame != g.LastItemData.ID
 	}
@@ -95,8 +90,7 @@ func IsItemActivated() bool {
 }
 
 // IsItemDeactivated was the last item just made inactive (item was previously active). Useful for Undo/Redo patterns with widgets that requires continuous editing.
-func IsItemDeactivated() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemDeactivated() bool {
 	if g.LastItemData.StatusFlags&ImGuiItemStatusFlags_HasDeactivated != 0 {
 		return (g.LastItemData.StatusFlags & ImGuiItemStatusFlags_Deactivated) != 0
 	}
@@ -104,58 +98,49 @@ func IsItemDeactivated() bool {
 }
 
 // IsItemDeactivatedAfterEdit was the last item just made inactive and made a value change when it was active? (e.g. Slider/Drag moved). Useful for Undo/Redo patterns with widgets that requires continuous editing. Note that you may get false positives (some widgets such as Combo()/ListBox()/Selectable() will return true even when clicking an already selected item).
-func IsItemDeactivatedAfterEdit() bool {
-	var g = GImGui
-	return IsItemDeactivated() && (g.ActiveIdPreviousFrameHasBeenEditedBefore || (g.ActiveId == 0 && g.ActiveIdHasBeenEditedBefore))
+func (g *ImGuiContext) IsItemDeactivatedAfterEdit() bool {
+	return g.IsItemDeactivated() && (g.ActiveIdPreviousFrameHasBeenEditedBefore || (g.ActiveId == 0 && g.ActiveIdHasBeenEditedBefore))
 }
 
 // IsItemToggledOpen was the last item open state toggled? set by TreeNode().
-func IsItemToggledOpen() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsItemToggledOpen() bool {
 	return (g.LastItemData.StatusFlags & ImGuiItemStatusFlags_ToggledOpen) != 0
 }
 
 // IsAnyItemHovered is any item hovered?
-func IsAnyItemHovered() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsAnyItemHovered() bool {
 	return g.HoveredId != 0 || g.HoveredIdPreviousFrame != 0
 }
 
 // IsAnyItemActive is any item active?
-func IsAnyItemActive() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsAnyItemActive() bool {
 	return g.ActiveId != 0
 }
 
 // IsAnyItemFocused is any item focused?
-func IsAnyItemFocused() bool {
-	var g = GImGui
+func (g *ImGuiContext) IsAnyItemFocused() bool {
 	return g.NavId != 0 && !g.NavDisableHighlight
 }
 
 // GetItemRectMin get upper-left bounding rectangle of the last item (screen space)
-func GetItemRectMin() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetItemRectMin() ImVec2 {
 	return g.LastItemData.Rect.Min
 }
 
 // GetItemRectMax get lower-right bounding rectangle of the last item (screen space)
-func GetItemRectMax() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetItemRectMax() ImVec2 {
 	return g.LastItemData.Rect.Max
 }
 
 // GetItemRectSize get size of last item
-func GetItemRectSize() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetItemRectSize() ImVec2 {
 	return g.LastItemData.Rect.GetSize()
 }
 
 // SetItemAllowOverlap allow last item to be overlapped by a subsequent item. sometimes useful with invisible buttons, selectables, etc. to catch unused area.
 // Allow last item to be overlapped by a subsequent item. Both may be activated during the same frame before the later one takes priority.
 // FIXME: Although this is exposed, its interaction and ideal idiom with using ImGuiButtonFlags_AllowItemOverlap flag are extremely confusing, need rework.
-func SetItemAllowOverlap() {
-	var g = GImGui
+func (g *ImGuiContext) SetItemAllowOverlap() {
 	var id = g.LastItemData.ID
 	if g.HoveredId == id {
 		g.HoveredIdAllowOverlap = true
@@ -1,8 +1,7 @@
 package imgui
 
 // separator, generally horizontal. inside a menu bar or in horizontal layout mode, this becomes a vertical separator.
-func Separator() {
-	var g = GImGui
+func (g *ImGuiContext) Separator() {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return
@@ -17,17 +16,16 @@ func Separator() {
 	}
 
 	flags |= ImGuiSeparatorFlags_SpanAllColumns
-	SeparatorEx(flags)
+	g.SeparatorEx(flags)
 }
 
 // Horizontal/vertical separating line
-func SeparatorEx(flags ImGuiSeparatorFlags) {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) SeparatorEx(flags ImGuiSeparatorFlags) {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return
 	}
 
-	var g = GImGui
 	IM_ASSERT(ImIsPowerOfTwoInt(int(flags & (ImGuiSeparatorFlags_Horizontal | ImGuiSeparatorFlags_Vertical)))) // Check that only 1 option is selected
 
 	var thickness_draw float = 1.0
@@ -37,15 +35,15 @@ func SeparatorEx(flags ImGuiSeparatorFlags) {
 		var y1 = window.DC.CursorPos.y
 		var y2 = window.DC.CursorPos.y + window.DC.CurrLineSize.y
 		var bb = ImRect{ImVec2{window.DC.CursorPos.x, y1}, ImVec2{window.DC.CursorPos.x + thickness_draw, y2}}
-		ItemSizeVec(&ImVec2{thickness_layout, 0.0}, 0)
-		if !ItemAdd(&bb, 0, nil, 0) {
+		g.ItemSizeVec(&ImVec2{thickness_layout, 0.0}, 0)
+		if !g.ItemAdd(&bb, 0, nil, 0) {
 			return
 		}
 
 		// Draw
-		window.DrawList.AddLine(&ImVec2{bb.Min.x, bb.Min.y}, &ImVec2{bb.Min.x, bb.Max.y}, GetColorU32FromID(ImGuiCol_Separator, 1), 1)
+		window.DrawList.AddLine(&ImVec2{bb.Min.x, bb.Min.y}, &ImVec2{bb.Min.x, bb.Max.y}, g.GetColorU32FromID(ImGuiCol_Separator, 1), 1)
 		if g.LogEnabled {
-			LogText(" |")
+			g.LogText(" |")
 		}
 	} else if flags&ImGuiSeparatorFlags_Horizontal != 0 {
 		// Horizontal Separator
@@ -62,22 +60,22 @@ func SeparatorEx(flags ImGuiSeparatorFlags) {
 			columns = window.DC.CurrentColumns
 		}
 		if columns != nil {
-			PushColumnsBackground()
+			g.PushColumnsBackground()
 		}
 
 		// We don't provide our width to the layout so that it doesn't get feed back into AutoFit
 		var bb = ImRect{ImVec2{x1, window.DC.CursorPos.y}, ImVec2{x2, window.DC.CursorPos.y + thickness_draw}}
-		ItemSizeVec(&ImVec2{0.0, thickness_layout}, 0)
-		var item_visible = ItemAdd(&bb, 0, nil, 0)
+		g.ItemSizeVec(&ImVec2{0.0, thickness_layout}, 0)
+		var item_visible = g.ItemAdd(&bb, 0, nil, 0)
 		if item_visible {
 			// Draw
-			window.DrawList.AddLine(&bb.Min, &ImVec2{bb.Max.x, bb.Min.y}, GetColorU32FromID(ImGuiCol_Separator, 1), 1)
+			window.DrawList.AddLine(&bb.Min, &ImVec2{bb.Max.x, bb.Min.y}, g.GetColorU32FromID(ImGuiCol_Separator, 1), 1)
 			if g.LogEnabled {
-				LogRenderedText(&bb.Min, "--------------------------------\n")
+				g.LogRenderedText(&bb.Min, "--------------------------------\n")
 			}
 		}
 		if columns != nil {
-			PopColumnsBackground()
+			g.PopColumnsBackground()
 			columns.LineMinY = window.DC.CursorPos.y
 		}
 	}
@@ -103,8 +103,7 @@ func TabItemGetSectionIdx(tab *ImGuiTabItem) int {
 	return 1
 }
 
-func GetTabBarFromTabBarRef(ref ImGuiPtrOrIndex) *ImGuiTabBar {
-	var g = GImGui
+func (g *ImGuiContext) GetTabBarFromTabBarRef(ref ImGuiPtrOrIndex) *ImGuiTabBar {
 	if ref.Ptr != nil {
 		return ref.Ptr.(*ImGuiTabBar)
 	} else {
@@ -112,8 +111,7 @@ func GetTabBarFromTabBarRef(ref ImGuiPtrOrIndex) *ImGuiTabBar {
 	}
 }
 
-func GetTabBarRefFromTabBar(tab_bar *ImGuiTabBar) ImGuiPtrOrIndex {
-	var g = GImGui
+func (g *ImGuiContext) GetTabBarRefFromTabBar(tab_bar *ImGuiTabBar) ImGuiPtrOrIndex {
 	for idx, tab_bar_ref := range g.TabBars {
 		if tab_bar == tab_bar_ref {
 			return ImGuiPtrOrIndex{Index: int(idx)}
@@ -125,8 +123,7 @@ func GetTabBarRefFromTabBar(tab_bar *ImGuiTabBar) ImGuiPtrOrIndex {
 // Tab Bars, Tabs
 
 // create and append into a TabBar
-func BeginTabBar(str_id string, flags ImGuiTabBarFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginTabBar(str_id string, flags ImGuiTabBarFlags) bool {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return false
@@ -141,12 +138,11 @@ func BeginTabBar(str_id string, flags ImGuiTabBarFlags) bool {
 	}
 	var tab_bar_bb = ImRect{ImVec2{window.DC.CursorPos.x, window.DC.CursorPos.y}, ImVec2{window.WorkRect.Max.x, window.DC.CursorPos.y + g.FontSize + g.Style.FramePadding.y*2}}
 	tab_bar.ID = id
-	return BeginTabBarEx(tab_bar, &tab_bar_bb, flags|ImGuiTabBarFlags_IsFocused)
+	return g.BeginTabBarEx(tab_bar, &tab_bar_bb, flags|ImGuiTabBarFlags_IsFocused)
 }
 
 // only call EndTabBar() if BeginTabBar() returns true!
-func EndTabBar() {
-	var g = GImGui
+func (g *ImGuiContext) EndTabBar() {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return
@@ -160,7 +156,7 @@ func EndTabBar() {
 
 	// Fallback in case no TabItem have been submitted
 	if tab_bar.WantLayout {
-		TabBarLayout(tab_bar)
+		g.TabBarLayout(tab_bar)
 	}
 
 	// Restore the last visible height if no tab is visible, this reduce vertical flicker/movement when a tabs gets removed without calling SetTabItemClosed().
@@ -176,19 +172,18 @@ func EndTabBar() {
 	}
 
 	if (tab_bar.Flags & ImGuiTabBarFlags_DockNode) == 0 {
-		PopID()
+		g.PopID()
 	}
 
 	g.CurrentTabBarStack = g.CurrentTabBarStack[:len(g.CurrentTabBarStack)-1]
 	g.CurrentTabBar = nil
 	if len(g.CurrentTabBarStack) > 0 {
-		g.CurrentTabBar = GetTabBarFromTabBarRef(g.CurrentTabBarStack[len(g.CurrentTabBarStack)-1])
+		g.CurrentTabBar = g.GetTabBarFromTabBarRef(g.CurrentTabBarStack[len(g.CurrentTabBarStack)-1])
 	}
 }
 
 // create a Tab. Returns true if the Tab is selected.
-func BeginTabItem(label string, p_open *bool, flags ImGuiTabItemFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginTabItem(label string, p_open *bool, flags ImGuiTabItemFlags) bool {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return false
@@ -201,17 +196,16 @@ func BeginTabItem(label string, p_open *bool, flags ImGuiTabItemFlags) bool {
 	}
 	IM_ASSERT((flags & ImGuiTabItemFlags_Button) == 0) // BeginTabItem() Can't be used with button flags, use TabItemButton() instead!
 
-	var ret = TabItemEx(tab_bar, label, p_open, flags)
+	var ret = g.TabItemEx(tab_bar, label, p_open, flags)
 	if ret && (flags&ImGuiTabItemFlags_NoPushId) == 0 {
 		var tab = &tab_bar.Tabs[tab_bar.LastTabItemIdx]
-		PushOverrideID(tab.ID) // We already hashed 'label' so push into the ID stack directly instead of doing another hash through PushID(label)
+		g.PushOverrideID(tab.ID) // We already hashed 'label' so push into the ID stack directly instead of doing another hash through PushID(label)
 	}
 	return ret
 }
 
 // only call EndTabItem() if BeginTabItem() returns true!
-func EndTabItem() {
-	var g = GImGui
+func (g *ImGuiContext) EndTabItem() {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return
@@ -225,13 +219,12 @@ func EndTabItem() {
 	IM_ASSERT(tab_bar.LastTabItemIdx >= 0)
 	var tab = &tab_bar.Tabs[tab_bar.LastTabItemIdx]
 	if (tab.Flags & ImGuiTabItemFlags_NoPushId) == 0 {
-		PopID()
+		g.PopID()
 	}
 }
 
 // create a Tab behaving like a button. return true when clicked. cannot be selected in the tab bar.
-func TabItemButton(label string, flags ImGuiTabItemFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) TabItemButton(label string, flags ImGuiTabItemFlags) bool {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return false
@@ -242,7 +235,7 @@ func TabItemButton(label string, flags ImGuiTabItemFlags) bool {
 		IM_ASSERT_USER_ERROR(tab_bar != nil, "Needs to be called between BeginTabBar() and EndTabBar()!")
 		return false
 	}
-	return TabItemEx(tab_bar, label, nil, flags|ImGuiTabItemFlags_Button|ImGuiTabItemFlags_NoReorder)
+	return g.TabItemEx(tab_bar, label, nil, flags|ImGuiTabItemFlags_Button|ImGuiTabItemFlags_NoReorder)
 }
 
 // notify TabBar or Docking system of a closed tab/window ahead (useful to reduce visual flicker on reorderable tab bars).
@@ -250,12 +243,11 @@ func TabItemButton(label string, flags ImGuiTabItemFlags) bool {
 // [Public] This is call is 100% optional but it allows to remove some one-frame glitches when a tab has been unexpectedly removed.
 // To use it to need to call the function SetTabItemClosed() between BeginTabBar() and EndTabBar().
 // Tabs closed by the close button will automatically be flagged to avoid this issue.
-func SetTabItemClosed(tab_or_docked_window_label string) {
-	var g = GImGui
+func (g *ImGuiContext) SetTabItemClosed(tab_or_docked_window_label string) {
 	var is_within_manual_tab_bar = g.CurrentTabBar != nil && (g.CurrentTabBar.Flags&ImGuiTabBarFlags_DockNode) == 0
 	if is_within_manual_tab_bar {
 		var tab_bar = g.CurrentTabBar
-		var tab_id = TabBarCalcTabID(tab_bar, tab_or_docked_window_label)
+		var tab_id = g.TabBarCalcTabID(tab_bar, tab_or_docked_window_label)
 		if tab := TabBarFindTabByID(tab_bar, tab_id); tab != nil {
 			tab.WantClose = true // Will be processed by next call to TabBarLayout()
 		}
@@ -263,19 +255,18 @@ func SetTabItemClosed(tab_or_docked_window_label string) {
 }
 
 // Tab Bars
-func BeginTabBarEx(tab_bar *ImGuiTabBar, tab_bar_bb *ImRect, flags ImGuiTabBarFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginTabBarEx(tab_bar *ImGuiTabBar, tab_bar_bb *ImRect, flags ImGuiTabBarFlags) bool {
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return false
 	}
 
 	if (flags & ImGuiTabBarFlags_DockNode) == 0 {
-		PushOverrideID(tab_bar.ID)
+		g.PushOverrideID(tab_bar.ID)
 	}
 
 	// Add to stack
-	g.CurrentTabBarStack = append(g.CurrentTabBarStack, GetTabBarRefFromTabBar(tab_bar))
+	g.CurrentTabBarStack = append(g.CurrentTabBarStack, g.GetTabBarRefFromTabBar(tab_bar))
 	g.CurrentTabBar = tab_bar
 
 	// Append with multiple BeginTabBar()/EndTabBar() pairs.
@@ -324,7 +315,7 @@ func BeginTabBarEx(tab_bar *ImGuiTabBar, tab_bar_bb *ImRect, flags ImGuiTabBarFl
 		c = ImGuiCol_TabActive
 	}
 
-	var col = GetColorU32FromID(c, 1)
+	var col = g.GetColorU32FromID(c, 1)
 	var y = tab_bar.BarRect.Max.y - 1.0
 	{
 		var separator_min_x = tab_bar.BarRect.Min.x - IM_FLOOR(window.WindowPadding.x*0.5)
@@ -394,8 +385,7 @@ func TabBarQueueReorder(tab_bar *ImGuiTabBar, tab *ImGuiTabItem, offset int) {
 	tab_bar.ReorderRequestOffset = (int)(offset)
 }
 
-func TabBarQueueReorderFromMousePos(tab_bar *ImGuiTabBar, src_tab *ImGuiTabItem, mouse_pos ImVec2) {
-	var g = GImGui
+func (g *ImGuiContext) TabBarQueueReorderFromMousePos(tab_bar *ImGuiTabBar, src_tab *ImGuiTabItem, mouse_pos ImVec2) {
 	IM_ASSERT(tab_bar.ReorderRequestTabId == 0)
 	if (tab_bar.Flags & ImGuiTabBarFlags_Reorderable) == 0 {
 		return
@@ -445,7 +435,7 @@ func TabBarQueueReorderFromMousePos(tab_bar *ImGuiTabBar, src_tab *ImGuiTabItem,
 	}
 }
 
-func TabBarProcessReorder(tab_bar *ImGuiTabBar) bool {
+func (g *ImGuiContext) TabBarProcessReorder(tab_bar *ImGuiTabBar) bool {
 	var tab1 = TabBarFindTabByID(tab_bar, tab_bar.ReorderRequestTabId)
 	if tab1 == nil || (tab1.Flags&ImGuiTabItemFlags_NoReorder) != 0 {
 		return false
@@ -489,32 +479,31 @@ func TabBarProcessReorder(tab_bar *ImGuiTabBar) bool {
 	tab2[0] = item_tmp
 
 	if tab_bar.Flags&ImGuiTabBarFlags_SaveSettings != 0 {
-		MarkIniSettingsDirty()
+		g.MarkIniSettingsDirty()
 	}
 	return true
 }
 
-func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabItemFlags) bool {
+func (g *ImGuiContext) TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabItemFlags) bool {
 	// Layout whole tab bar if not already done
 	if tab_bar.WantLayout {
-		TabBarLayout(tab_bar)
+		g.TabBarLayout(tab_bar)
 	}
 
-	var g = GImGui
 	var window = g.CurrentWindow
 	if window.SkipItems {
 		return false
 	}
 
 	var style = g.Style
-	var id = TabBarCalcTabID(tab_bar, label)
+	var id = g.TabBarCalcTabID(tab_bar, label)
 
 	// If the user called us with *p_open == false, we early out and don't render.
 	// We make a call to ItemAdd() so that attempts to use a contextual popup menu with an implicit ID won't use an older ID.
 	if p_open != nil && !*p_open {
-		PushItemFlag(ImGuiItemFlags_NoNav|ImGuiItemFlags_NoNavDefaultFocus, true)
-		ItemAdd(&ImRect{}, id, nil, 0)
-		PopItemFlag()
+		g.PushItemFlag(ImGuiItemFlags_NoNav|ImGuiItemFlags_NoNavDefaultFocus, true)
+		g.ItemAdd(&ImRect{}, id, nil, 0)
+		g.PopItemFlag()
 		return false
 	}
 
@@ -529,7 +518,7 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 	}
 
 	// Calculate tab contents size
-	var size = TabItemCalcSize(label, p_open != nil)
+	var size = g.TabItemCalcSize(label, p_open != nil)
 
 	// Acquire tab data
 	var tab = TabBarFindTabByID(tab_bar, id)
@@ -594,9 +583,9 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 	// Note that tab_is_new is not necessarily the same as tab_appearing! When a tab bar stops being submitted
 	// and then gets submitted again, the tabs will have 'tab_appearing=true' but 'tab_is_new=false'.
 	if tab_appearing && (!tab_bar_appearing || tab_is_new) {
-		PushItemFlag(ImGuiItemFlags_NoNav|ImGuiItemFlags_NoNavDefaultFocus, true)
-		ItemAdd(&ImRect{}, id, nil, 0)
-		PopItemFlag()
+		g.PushItemFlag(ImGuiItemFlags_NoNav|ImGuiItemFlags_NoNavDefaultFocus, true)
+		g.ItemAdd(&ImRect{}, id, nil, 0)
+		g.PopItemFlag()
 		if is_tab_button {
 			return false
 		}
@@ -624,17 +613,17 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 	// We don't have CPU clipping primitives to clip the CloseButton (until it becomes a texture), so need to add an extra draw call (temporary in the case of vertical animation)
 	var want_clip_rect = is_central_section && (bb.Min.x < tab_bar.ScrollingRectMinX || bb.Max.x > tab_bar.ScrollingRectMaxX)
 	if want_clip_rect {
-		PushClipRect(ImVec2{ImMax(bb.Min.x, tab_bar.ScrollingRectMinX), bb.Min.y - 1}, ImVec2{tab_bar.ScrollingRectMaxX, bb.Max.y}, true)
+		g.PushClipRect(ImVec2{ImMax(bb.Min.x, tab_bar.ScrollingRectMinX), bb.Min.y - 1}, ImVec2{tab_bar.ScrollingRectMaxX, bb.Max.y}, true)
 	}
 
 	var backup_cursor_max_pos = window.DC.CursorMaxPos
 	sz := bb.GetSize()
-	ItemSizeVec(&sz, style.FramePadding.y)
+	g.ItemSizeVec(&sz, style.FramePadding.y)
 	window.DC.CursorMaxPos = backup_cursor_max_pos
 
-	if !ItemAdd(&bb, id, nil, 0) {
+	if !g.ItemAdd(&bb, id, nil, 0) {
 		if want_clip_rect {
-			PopClipRect()
+			g.PopClipRect()
 		}
 		window.DC.CursorPos = backup_main_cursor_pos
 		return tab_contents_visible
@@ -651,24 +640,24 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 		button_flags |= ImGuiButtonFlags_PressedOnDragDropHold
 	}
 	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, button_flags)
+	var pressed = g.ButtonBehavior(&bb, id, &hovered, &held, button_flags)
 	if pressed && !is_tab_button {
 		tab_bar.NextSelectedTabId = id
 	}
 
 	// Allow the close button to overlap unless we are dragging (in which case we don't want any overlapping tabs to be hovered)
 	if g.ActiveId != id {
-		SetItemAllowOverlap()
+		g.SetItemAllowOverlap()
 	}
 
 	// Drag and drop: re-order tabs
-	if held && !tab_appearing && IsMouseDragging(0, -1) {
+	if held && !tab_appearing && g.IsMouseDragging(0, -1) {
 		if !g.DragDropActive && (tab_bar.Flags&ImGuiTabBarFlags_Reorderable) != 0 {
 			// While moving a tab it will jump on the other side of the mouse, so we also test for MouseDelta.x
 			if g.IO.MouseDelta.x < 0.0 && g.IO.MousePos.x < bb.Min.x {
-				TabBarQueueReorderFromMousePos(tab_bar, tab, g.IO.MousePos)
+				g.TabBarQueueReorderFromMousePos(tab_bar, tab, g.IO.MousePos)
 			} else if g.IO.MouseDelta.x > 0.0 && g.IO.MousePos.x > bb.Max.x {
-				TabBarQueueReorderFromMousePos(tab_bar, tab, g.IO.MousePos)
+				g.TabBarQueueReorderFromMousePos(tab_bar, tab, g.IO.MousePos)
 			}
 		}
 	}
@@ -694,13 +683,13 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 
 	// Render tab shape
 	var display_draw_list = window.DrawList
-	var tab_col = GetColorU32FromID(c, 1)
-	TabItemBackground(display_draw_list, &bb, flags, tab_col)
-	RenderNavHighlight(&bb, id, 0)
+	var tab_col = g.GetColorU32FromID(c, 1)
+	g.TabItemBackground(display_draw_list, &bb, flags, tab_col)
+	g.RenderNavHighlight(&bb, id, 0)
 
 	// Select with right mouse button. This is so the common idiom for context menu automatically highlight the current widget.
-	var hovered_unblocked = IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup)
-	if hovered_unblocked && (IsMouseClicked(1, false) || IsMouseReleased(1)) {
+	var hovered_unblocked = g.IsItemHovered(ImGuiHoveredFlags_AllowWhenBlockedByPopup)
+	if hovered_unblocked && (g.IsMouseClicked(1, false) || g.IsMouseReleased(1)) {
 		if !is_tab_button {
 			tab_bar.NextSelectedTabId = id
 		}
@@ -713,11 +702,11 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 	// Render tab label, process close button
 	var close_button_id uint = 0
 	if p_open != nil {
-		close_button_id = GetIDWithSeed("#CLOSE", id)
+		close_button_id = g.GetIDWithSeed("#CLOSE", id)
 	}
 	var just_closed bool
 	var text_clipped bool
-	TabItemLabelAndCloseButton(display_draw_list, &bb, flags, tab_bar.FramePadding, label, id, close_button_id, tab_contents_visible, &just_closed, &text_clipped)
+	g.TabItemLabelAndCloseButton(display_draw_list, &bb, flags, tab_bar.FramePadding, label, id, close_button_id, tab_contents_visible, &just_closed, &text_clipped)
 	if just_closed && p_open != nil {
 		*p_open = false
 		TabBarCloseTab(tab_bar, tab)
@@ -725,7 +714,7 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 
 	// Restore main window position so user can draw there
 	if want_clip_rect {
-		PopClipRect()
+		g.PopClipRect()
 	}
 	window.DC.CursorPos = backup_main_cursor_pos
 
@@ -734,12 +723,13 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 	// (We test IsItemHovered() to discard e.g. when another item is active or drag and drop over the tab bar, which g.HoveredId ignores)
 	// FIXME: This is a mess.
 	// FIXME: We may want disabled tab to still display the tooltip?
-	if text_clipped && g.HoveredId == id && !held && g.HoveredIdNotActiveTimer > g.TooltipSlowDelay && IsItemHovered(0) {
+	if text_clipped && g.HoveredId == id && !held && g.HoveredIdNotActiveTimer > g.TooltipSlowDelay && g.IsItemHovered(0) {
 		if (tab_bar.Flags&ImGuiTabBarFlags_NoTooltip) == 0 && (tab.Flags&ImGuiTabItemFlags_NoTooltip) == 0 {
-			SetTooltip("%v", label)
+			g.SetTooltip("%v", label)
 		}
 	}
 
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags)
 	IM_ASSERT(!is_tab_button || !(tab_bar.SelectedTabId == tab.ID && is_tab_button)) // TabItemButton should not be selected
 	if is_tab_button {
 		return pressed
@@ -747,21 +737,19 @@ func TabItemEx(tab_bar *ImGuiTabBar, label string, p_open *bool, flags ImGuiTabI
 	return tab_contents_visible
 }
 
-func TabItemCalcSize(label string, has_close_button bool) ImVec2 {
-	var g = GImGui
-	var label_size = CalcTextSize(label, true, -1)
+func (g *ImGuiContext) TabItemCalcSize(label string, has_close_button bool) ImVec2 {
+	var label_size = g.CalcTextSize(label, true, -1)
 	var size = ImVec2{label_size.x + g.Style.FramePadding.x, label_size.y + g.Style.FramePadding.y*2.0}
 	if has_close_button {
 		size.x += g.Style.FramePadding.x + (g.Style.ItemInnerSpacing.x + g.FontSize) // We use Y intentionally to fit the close button circle.
 	} else {
 		size.x += g.Style.FramePadding.x + 1.0
 	}
-	return ImVec2{ImMin(size.x, TabBarCalcMaxTabWidth()), size.y}
+	return ImVec2{ImMin(size.x, g.TabBarCalcMaxTabWidth()), size.y}
 }
 
-func TabItemBackground(draw_list *ImDrawList, bb *ImRect, flags ImGuiTabItemFlags, col ImU32) {
+func (g *ImGuiContext) TabItemBackground(draw_list *ImDrawList, bb *ImRect, flags ImGuiTabItemFlags, col ImU32) {
 	// While rendering tabs, we trim 1 pixel off the top of our bounding box so they can fit within a regular frame height while looking "detached" from it.
-	var g = GImGui
 	var width = bb.GetWidth()
 	IM_ASSERT(width > 0.0)
 
@@ -783,15 +771,14 @@ func TabItemBackground(draw_list *ImDrawList, bb *ImRect, flags ImGuiTabItemFlag
 		draw_list.PathArcToFast(ImVec2{bb.Min.x + rounding + 0.5, y1 + rounding + 0.5}, rounding, 6, 9)
 		draw_list.PathArcToFast(ImVec2{bb.Max.x - rounding - 0.5, y1 + rounding + 0.5}, rounding, 9, 12)
 		draw_list.PathLineTo(ImVec2{bb.Max.x - 0.5, y2})
-		draw_list.PathStroke(GetColorU32FromID(ImGuiCol_Border, 1), 0, g.Style.TabBorderSize)
+		draw_list.PathStroke(g.GetColorU32FromID(ImGuiCol_Border, 1), 0, g.Style.TabBorderSize)
 	}
 }
 
 // Render text label (with custom clipping) + Unsaved Document marker + Close Button logic
 // We tend to lock style.FramePadding for a given tab-bar, hence the 'frame_padding' parameter.
-func TabItemLabelAndCloseButton(draw_list *ImDrawList, bb *ImRect, flags ImGuiTabItemFlags, frame_padding ImVec2, label string, tab_id ImGuiID, close_button_id ImGuiID, is_contents_visible bool, out_just_closed *bool, out_text_clipped *bool) {
-	var g = GImGui
-	var label_size = CalcTextSize(label, true, -1)
+func (g *ImGuiContext) TabItemLabelAndCloseButton(draw_list *ImDrawList, bb *ImRect, flags ImGuiTabItemFlags, frame_padding ImVec2, label string, tab_id ImGuiID, close_button_id ImGuiID, is_contents_visible bool, out_just_closed *bool, out_text_clipped *bool) {
+	var label_size = g.CalcTextSize(label, true, -1)
 
 	if out_just_closed != nil {
 		*out_just_closed = false
@@ -835,20 +822,20 @@ func TabItemLabelAndCloseButton(draw_list *ImDrawList, bb *ImRect, flags ImGuiTa
 
 	if close_button_visible {
 		var last_item_backup = g.LastItemData
-		PushStyleVec(ImGuiStyleVar_FramePadding, frame_padding)
-		if CloseButton(close_button_id, &button_pos) {
+		g.PushStyleVec(ImGuiStyleVar_FramePadding, frame_padding)
+		if g.CloseButton(close_button_id, &button_pos) {
 			close_button_pressed = true
 		}
-		PopStyleVar(1)
+		g.PopStyleVar(1)
 		g.LastItemData = last_item_backup
 
 		// Close with middle mouse button
-		if flags&ImGuiTabItemFlags_NoCloseWithMiddleMouseButton == 0 && IsMouseClicked(2, false) {
+		if flags&ImGuiTabItemFlags_NoCloseWithMiddleMouseButton == 0 && g.IsMouseClicked(2, false) {
 			close_button_pressed = true
 		}
 	} else if unsaved_marker_visible {
 		var bullet_bb = ImRect{button_pos, button_pos.Add(ImVec2{button_sz, button_sz}).Add(g.Style.FramePadding.Scale(2.0))}
-		RenderBullet(draw_list, bullet_bb.GetCenter(), GetColorU32FromID(ImGuiCol_Text, 1))
+		RenderBullet(draw_list, bullet_bb.GetCenter(), g.GetColorU32FromID(ImGuiCol_Text, 1))
 	}
 
 	// This is all rather complicated
@@ -869,7 +856,7 @@ func TabItemLabelAndCloseButton(draw_list *ImDrawList, bb *ImRect, flags ImGuiTa
 		}
 		ellipsis_max_x = text_pixel_clip_bb.Max.x
 	}
-	RenderTextEllipsis(draw_list, &text_ellipsis_clip_bb.Min, &text_ellipsis_clip_bb.Max, text_pixel_clip_bb.Max.x, ellipsis_max_x, label, &label_size)
+	g.RenderTextEllipsis(draw_list, &text_ellipsis_clip_bb.Min, &text_ellipsis_clip_bb.Max, text_pixel_clip_bb.Max.x, ellipsis_max_x, label, &label_size)
 
 	if out_just_closed != nil {
 		*out_just_closed = close_button_pressed
@@ -877,7 +864,7 @@ func TabItemLabelAndCloseButton(draw_list *ImDrawList, bb *ImRect, flags ImGuiTa
 }
 
 // Note: we may scroll to tab that are not selected! e.g. using keyboard arrow keys
-func TabBarScrollToTab(tab_bar *ImGuiTabBar, tab_id ImGuiID, sections [3]ImGuiTabBarSection) {
+func (g *ImGuiContext) TabBarScrollToTab(tab_bar *ImGuiTabBar, tab_id ImGuiID, sections [3]ImGuiTabBarSection) {
 	var tab = TabBarFindTabByID(tab_bar, tab_id)
 	if tab == nil {
 		return
@@ -886,7 +873,6 @@ func TabBarScrollToTab(tab_bar *ImGuiTabBar, tab_id ImGuiID, sections [3]ImGuiTa
 		return
 	}
 
-	var g = GImGui
 	var margin = g.FontSize * 1.0 // When to scroll to make Tab N+1 visible always make a bit of N visible to suggest more scrolling area (since we don't have a scrollbar)
 	var order = tab_bar.GetTabOrder(tab)
 
@@ -915,8 +901,7 @@ func TabBarScrollToTab(tab_bar *ImGuiTabBar, tab_id ImGuiID, sections [3]ImGuiTa
 	}
 }
 
-func TabBarTabListPopupButton(tab_bar *ImGuiTabBar) *ImGuiTabItem {
-	var g = GImGui
+func (g *ImGuiContext) TabBarTabListPopupButton(tab_bar *ImGuiTabBar) *ImGuiTabItem {
 	var window = g.CurrentWindow
 
 	// We use g.Style.FramePadding.y to match the square ArrowButton size
@@ -927,10 +912,10 @@ func TabBarTabListPopupButton(tab_bar *ImGuiTabBar) *ImGuiTabItem {
 
 	var arrow_col = g.Style.Colors[ImGuiCol_Text]
 	arrow_col.w *= 0.5
-	PushStyleColorVec(ImGuiCol_Text, &arrow_col)
-	PushStyleColorVec(ImGuiCol_Button, &ImVec4{})
-	var open = BeginCombo("##v", "", ImGuiComboFlags_NoPreview|ImGuiComboFlags_HeightLargest)
-	PopStyleColor(2)
+	g.PushStyleColorVec(ImGuiCol_Text, &arrow_col)
+	g.PushStyleColorVec(ImGuiCol_Button, &ImVec4{})
+	var open = g.BeginCombo("##v", "", ImGuiComboFlags_NoPreview|ImGuiComboFlags_HeightLargest)
+	g.PopStyleColor(2)
 
 	var tab_to_select *ImGuiTabItem = nil
 	if open {
@@ -941,11 +926,11 @@ func TabBarTabListPopupButton(tab_bar *ImGuiTabBar) *ImGuiTabItem {
 			}
 
 			var tab_name = tab_bar.GetTabName(tab)
-			if Selectable(tab_name, tab_bar.SelectedTabId == tab.ID, 0, ImVec2{}) {
+			if g.Selectable(tab_name, tab_bar.SelectedTabId == tab.ID, 0, ImVec2{}) {
 				tab_to_select = tab
 			}
 		}
-		EndCombo()
+		g.EndCombo()
 	}
 
 	window.DC.CursorPos = backup_cursor_pos
@@ -958,24 +943,22 @@ func TabBarScrollClamp(tab_bar *ImGuiTabBar, scrolling float) float {
 }
 
 // Dockables uses Name/ID in the global namespace. Non-dockable items use the ID stack.
-func TabBarCalcTabID(tab_bar *ImGuiTabBar, label string) ImU32 {
+func (g *ImGuiContext) TabBarCalcTabID(tab_bar *ImGuiTabBar, label string) ImU32 {
 	if (tab_bar.Flags & ImGuiTabBarFlags_DockNode) != 0 {
 		var id = ImHashStr(label, uintptr(len(label)), 0)
-		KeepAliveID(id)
+		g.KeepAliveID(id)
 		return id
 	} else {
-		var window = GImGui.CurrentWindow
+		var window = g.CurrentWindow
 		return window.GetIDs(label)
 	}
 }
 
-func TabBarCalcMaxTabWidth() float {
-	var g = GImGui
+func (g *ImGuiContext) TabBarCalcMaxTabWidth() float {
 	return g.FontSize * 20.0
 }
 
-func TabBarScrollingButtons(tab_bar *ImGuiTabBar) *ImGuiTabItem {
-	var g = GImGui
+func (g *ImGuiContext) TabBarScrollingButtons(tab_bar *ImGuiTabBar) *ImGuiTabItem {
 	var window = g.CurrentWindow
 
 	var arrow_button_size = ImVec2{g.FontSize - 2.0, g.FontSize + g.Style.FramePadding.y*2.0}
@@ -988,22 +971,22 @@ func TabBarScrollingButtons(tab_bar *ImGuiTabBar) *ImGuiTabItem {
 	var arrow_col = g.Style.Colors[ImGuiCol_Text]
 	arrow_col.w *= 0.5
 
-	PushStyleColorVec(ImGuiCol_Text, &arrow_col)
-	PushStyleColorVec(ImGuiCol_Button, &ImVec4{})
+	g.PushStyleColorVec(ImGuiCol_Text, &arrow_col)
+	g.PushStyleColorVec(ImGuiCol_Button, &ImVec4{})
 	var backup_repeat_delay = g.IO.KeyRepeatDelay
 	var backup_repeat_rate = g.IO.KeyRepeatRate
 	g.IO.KeyRepeatDelay = 0.250
 	g.IO.KeyRepeatRate = 0.200
 	var x = ImMax(tab_bar.BarRect.Min.x, tab_bar.BarRect.Max.x-scrolling_buttons_width)
 	window.DC.CursorPos = ImVec2{x, tab_bar.BarRect.Min.y}
-	if ArrowButtonEx("##<", ImGuiDir_Left, arrow_button_size, ImGuiButtonFlags_PressedOnClick|ImGuiButtonFlags_Repeat) {
+	if g.ArrowButtonEx("##<", ImGuiDir_Left, arrow_button_size, ImGuiButtonFlags_PressedOnClick|ImGuiButtonFlags_Repeat) {
 		select_dir = -1
 	}
 	window.DC.CursorPos = ImVec2{x + arrow_button_size.x, tab_bar.BarRect.Min.y}
-	if ArrowButtonEx("##>", ImGuiDir_Right, arrow_button_size, ImGuiButtonFlags_PressedOnClick|ImGuiButtonFlags_Repeat) {
+	if g.ArrowButtonEx("##>", ImGuiDir_Right, arrow_button_size, ImGuiButtonFlags_PressedOnClick|ImGuiButtonFlags_Repeat) {
 		select_dir = +1
 	}
-	PopStyleColor(2)
+	g.PopStyleColor(2)
 	g.IO.KeyRepeatRate = backup_repeat_rate
 	g.IO.KeyRepeatDelay = backup_repeat_delay
 
@@ -1042,8 +1025,7 @@ func TabBarScrollingButtons(tab_bar *ImGuiTabBar) *ImGuiTabItem {
 
 // This is called only once a frame before by the first call to ItemTab()
 // The reason we're not calling it in BeginTabBar() is to leave a chance to the user to call the SetTabItemClosed() functions.
-func TabBarLayout(tab_bar *ImGuiTabBar) {
-	var g = GImGui
+func (g *ImGuiContext) TabBarLayout(tab_bar *ImGuiTabBar) {
 	tab_bar.WantLayout = false
 
 	// Garbage collect by compacting list
@@ -1131,7 +1113,7 @@ func TabBarLayout(tab_bar *ImGuiTabBar) {
 
 	// Process order change request (we could probably process it when requested but it's just saner to do it in a single spot).
 	if tab_bar.ReorderRequestTabId != 0 {
-		if TabBarProcessReorder(tab_bar) {
+		if g.TabBarProcessReorder(tab_bar) {
 			if tab_bar.ReorderRequestTabId == tab_bar.SelectedTabId {
 				scroll_to_tab_id = tab_bar.ReorderRequestTabId
 			}
@@ -1142,7 +1124,7 @@ func TabBarLayout(tab_bar *ImGuiTabBar) {
 	// Tab List Popup (will alter tab_bar.BarRect and therefore the available width!)
 	var tab_list_popup_button = (tab_bar.Flags & ImGuiTabBarFlags_TabListPopupButton) != 0
 	if tab_list_popup_button {
-		if tab_to_select := TabBarTabListPopupButton(tab_bar); tab_to_select != nil { // NB: Will alter BarRect.Min.x!
+		if tab_to_select := g.TabBarTabListPopupButton(tab_bar); tab_to_select != nil { // NB: Will alter BarRect.Min.x!
 			scroll_to_tab_id = tab_to_select.ID
 			tab_bar.SelectedTabId = tab_to_select.ID
 		}
@@ -1185,7 +1167,7 @@ func TabBarLayout(tab_bar *ImGuiTabBar) {
 		if tab.Flags&ImGuiTabItemFlags_NoCloseButton != 0 {
 			has_close_button = false
 		}
-		tab.ContentWidth = TabItemCalcSize(tab_name, has_close_button).x
+		tab.ContentWidth = g.TabItemCalcSize(tab_name, has_close_button).x
 
 		var section_n = TabItemGetSectionIdx(tab)
 		var section = &sections[section_n]
@@ -1214,7 +1196,7 @@ func TabBarLayout(tab_bar *ImGuiTabBar) {
 	// Horizontal scrolling buttons
 	// (note that TabBarScrollButtons() will alter BarRect.Max.x)
 	if (tab_bar.WidthAllTabsIdeal > tab_bar.BarRect.GetWidth() && len(tab_bar.Tabs) > 1) && tab_bar.Flags&ImGuiTabBarFlags_NoTabListScrollingButtons == 0 && (tab_bar.Flags&ImGuiTabBarFlags_FittingPolicyScroll) != 0 {
-		if scroll_and_select_tab := TabBarScrollingButtons(tab_bar); scroll_and_select_tab != nil {
+		if scroll_and_select_tab := g.TabBarScrollingButtons(tab_bar); scroll_and_select_tab != nil {
 			scroll_to_tab_id = scroll_and_select_tab.ID
 			if (scroll_and_select_tab.Flags & ImGuiTabItemFlags_Button) == 0 {
 				tab_bar.SelectedTabId = scroll_to_tab_id
@@ -1298,7 +1280,7 @@ func TabBarLayout(tab_bar *ImGuiTabBar) {
 
 	// Update scrolling
 	if scroll_to_tab_id != 0 {
-		TabBarScrollToTab(tab_bar, scroll_to_tab_id, sections)
+		g.TabBarScrollToTab(tab_bar, scroll_to_tab_id, sections)
 	}
 	tab_bar.ScrollingAnim = TabBarScrollClamp(tab_bar, tab_bar.ScrollingAnim)
 	tab_bar.ScrollingTarget = TabBarScrollClamp(tab_bar, tab_bar.ScrollingTarget)
@@ -1327,6 +1309,6 @@ func TabBarLayout(tab_bar *ImGuiTabBar) {
 	// Actual layout in host window (we don't do it in BeginTabBar() so as not to waste an extra frame)
 	var window = g.CurrentWindow
 	window.DC.CursorPos = tab_bar.BarRect.Min
-	ItemSizeVec(&ImVec2{tab_bar.WidthAllTabs, tab_bar.BarRect.GetHeight()}, tab_bar.FramePadding.y)
+	g.ItemSizeVec(&ImVec2{tab_bar.WidthAllTabs, tab_bar.BarRect.GetHeight()}, tab_bar.FramePadding.y)
 	window.DC.IdealMaxPos.x = ImMax(window.DC.IdealMaxPos.x, tab_bar.BarRect.Min.x+tab_bar.WidthAllTabsIdeal)
 }
@@ -6,81 +6,80 @@ import "fmt"
 // - TreeNode functions return true when the node is open, in which case you need to also call TreePop() when you are finished displaying the tree node contents.
 
 // helper variation to easily decorelate the id from the displayed string. Read the FAQ about why and how to use ID. to align arbitrary text at the same level as a TreeNode() you can use Bullet().
-func TreeNodeF(str_id string, format string, args ...any) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) TreeNodeF(str_id string, format string, args ...any) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	return TreeNodeBehavior(window.GetIDs(str_id), 0, fmt.Sprintf(format, args...))
+	return g.TreeNodeBehavior(window.GetIDs(str_id), 0, fmt.Sprintf(format, args...))
 }
 
-func TreeNodeInterface(ptr_id any, format string, args ...any) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) TreeNodeInterface(ptr_id any, format string, args ...any) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	return TreeNodeBehavior(window.GetIDInterface(ptr_id), 0, fmt.Sprintf(format, args...))
+	return g.TreeNodeBehavior(window.GetIDInterface(ptr_id), 0, fmt.Sprintf(format, args...))
 }
 
-func TreeNodeEx(str_id string, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) TreeNodeEx(str_id string, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	return TreeNodeBehavior(window.GetIDs(str_id), flags, fmt.Sprintf(format, args...))
+	return g.TreeNodeBehavior(window.GetIDs(str_id), flags, fmt.Sprintf(format, args...))
 }
 
-func TreeNodeInterfaceEx(ptr_id any, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) TreeNodeInterfaceEx(ptr_id any, flags ImGuiTreeNodeFlags, format string, args ...any) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	return TreeNodeBehavior(window.GetIDInterface(ptr_id), flags, fmt.Sprintf(format, args...))
+	return g.TreeNodeBehavior(window.GetIDInterface(ptr_id), flags, fmt.Sprintf(format, args...))
 }
 
 // ~ Indent()+PushId(). Already called by TreeNode() when returning true, but you can call TreePush/TreePop yourself if desired.
-func TreePush(str_id string) {
-	var window = GetCurrentWindow()
-	Indent(0)
+func (g *ImGuiContext) TreePush(str_id string) {
+	var window = g.GetCurrentWindow()
+	g.Indent(0)
 	window.DC.TreeDepth++
 	if str_id != "" {
-		PushString(str_id)
+		g.PushString(str_id)
 	} else {
-		PushString("#TreePush")
+		g.PushString("#TreePush")
 	}
 }
 
-func TreePushInterface(ptr_id any) {
-	var window = GetCurrentWindow()
-	Indent(0)
+func (g *ImGuiContext) TreePushInterface(ptr_id any) {
+	var window = g.GetCurrentWindow()
+	g.Indent(0)
 	window.DC.TreeDepth++
 	if ptr_id != nil {
-		PushInterface(ptr_id)
+		g.PushInterface(ptr_id)
 	} else {
-		PushString("#TreePush")
+		g.PushString("#TreePush")
 	}
 } // "
 
 // horizontal distance preceding label when using TreeNode*() or Bullet() == (g.FontSize + style.FramePadding.x*2) for a regular unframed TreeNode
-func GetTreeNodeToLabelSpacing() float {
-	var g = GImGui
+func (g *ImGuiContext) GetTreeNodeToLabelSpacing() float {
 	return g.FontSize + (g.Style.FramePadding.x * 2.0)
 }
 
 // CollapsingHeader returns true when opened but do not indent nor push into the ID stack (because of the ImGuiTreeNodeFlags_NoTreePushOnOpen flag).
 // This is basically the same as calling TreeNodeEx(label, ImGuiTreeNodeFlags_CollapsingHeader). You can remove the _NoTreePushOnOpen flag if you want behavior closer to normal TreeNode().
 // if returning 'true' the header is open. doesn't indent nor push on ID stack. user doesn't have to call TreePop().
-func CollapsingHeader(label string, flags ImGuiTreeNodeFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) CollapsingHeader(label string, flags ImGuiTreeNodeFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	return TreeNodeBehavior(window.GetIDs(label), flags|ImGuiTreeNodeFlags_CollapsingHeader, label)
+	return g.TreeNodeBehavior(window.GetIDs(label), flags|ImGuiTreeNodeFlags_CollapsingHeader, label)
 }
 
 // when 'p_visible != NULL': if '*p_visible==true' display an additional small close button on upper right of the header which will set the to bool false when clicked, if '*p_visible==false' don't display the header.
@@ -88,8 +87,8 @@ func CollapsingHeader(label string, flags ImGuiTreeNodeFlags) bool {
 // p_visible != nil && *p_visible == true  : show a small close button on the corner of the header, clicking the button will set *p_visible = false
 // p_visible != nil && *p_visible == false : do not show the header at all
 // Do not mistake this with the Open state of the header itself, which you can adjust with SetNextItemOpen() or ImGuiTreeNodeFlags_DefaultOpen.
-func CollapsingHeaderVisible(label string, p_visible *bool, flags ImGuiTreeNodeFlags) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) CollapsingHeaderVisible(label string, p_visible *bool, flags ImGuiTreeNodeFlags) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
@@ -103,18 +102,17 @@ func CollapsingHeaderVisible(label string, p_visible *bool, flags ImGuiTreeNodeF
 	if p_visible != nil {
 		flags |= ImGuiTreeNodeFlags_AllowItemOverlap | ImGuiTreeNodeFlags_ClipLabelForTrailingButton
 	}
-	var is_open = TreeNodeBehavior(id, flags, label)
+	var is_open = g.TreeNodeBehavior(id, flags, label)
 	if p_visible != nil {
 		// Create a small overlapping close button
 		// FIXME: We can evolve this into user accessible helpers to add extra buttons on title bars, headers, etc.
 		// FIXME: CloseButton can overlap into text, need find a way to clip the text somehow.
-		var g = GImGui
 		var last_item_backup = g.LastItemData
 		var button_size = g.FontSize
 		var button_x = ImMax(g.LastItemData.Rect.Min.x, g.LastItemData.Rect.Max.x-g.Style.FramePadding.x*2.0-button_size)
 		var button_y = g.LastItemData.Rect.Min.y
-		var close_button_id = GetIDWithSeed("#CLOSE", id)
-		if CloseButton(close_button_id, &ImVec2{button_x, button_y}) {
+		var close_button_id = g.GetIDWithSeed("#CLOSE", id)
+		if g.CloseButton(close_button_id, &ImVec2{button_x, button_y}) {
 			*p_visible = false
 		}
 		g.LastItemData = last_item_backup
@@ -124,8 +122,7 @@ func CollapsingHeaderVisible(label string, p_visible *bool, flags ImGuiTreeNodeF
 }
 
 // set next TreeNode/CollapsingHeader open state.
-func SetNextItemOpen(is_open bool, cond ImGuiCond) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextItemOpen(is_open bool, cond ImGuiCond) {
 	if g.CurrentWindow.SkipItems {
 		return
 	}
@@ -138,52 +135,49 @@ func SetNextItemOpen(is_open bool, cond ImGuiCond) {
 	}
 }
 
-func TreeNode(label string) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) TreeNode(label string) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
-	return TreeNodeBehavior(window.GetIDs(label), 0, label)
+	return g.TreeNodeBehavior(window.GetIDs(label), 0, label)
 }
 
-func TreePushOverrideID(id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) TreePushOverrideID(id ImGuiID) {
 	var window = g.CurrentWindow
-	Indent(0)
+	g.Indent(0)
 	window.DC.TreeDepth++
 	window.IDStack = append(window.IDStack, id)
 }
 
 // ~ Unindent()+PopId()
-func TreePop() {
-	var g = GImGui
+func (g *ImGuiContext) TreePop() {
 	var window = g.CurrentWindow
-	Unindent(0)
+	g.Unindent(0)
 
 	window.DC.TreeDepth--
 	var tree_depth_mask ImU32 = (1 << window.DC.TreeDepth)
 
 	// Handle Left arrow to move to parent tree node (when ImGuiTreeNodeFlags_NavLeftJumpsBackHere is enabled)
-	if g.NavMoveDir == ImGuiDir_Left && g.NavWindow == window && NavMoveRequestButNoResultYet() {
+	if g.NavMoveDir == ImGuiDir_Left && g.NavWindow == window && g.NavMoveRequestButNoResultYet() {
 		if g.NavIdIsAlive && (window.DC.TreeJumpToParentOnPopMask&tree_depth_mask) != 0 {
-			SetNavID(window.IDStack[len(window.IDStack)-1], g.NavLayer, 0, &ImRect{})
-			NavMoveRequestCancel()
+			g.SetNavID(window.IDStack[len(window.IDStack)-1], g.NavLayer, 0, &ImRect{})
+			g.NavMoveRequestCancel()
 		}
 	}
 	window.DC.TreeJumpToParentOnPopMask &= tree_depth_mask - 1
 
 	IM_ASSERT(len(window.IDStack) > 1) // There should always be 1 element in the IDStack (pushed during window creation). If this triggers you called TreePop/PopID too much.
-	PopID()
+	g.PopID()
 }
 
 // Consume previous SetNextItemOpen() data, if any. May return true when logging
-func TreeNodeBehaviorIsOpen(id ImGuiID, flags ImGuiTreeNodeFlags) bool {
+func (g *ImGuiContext) TreeNodeBehaviorIsOpen(id ImGuiID, flags ImGuiTreeNodeFlags) bool {
 	if flags&ImGuiTreeNodeFlags_Leaf != 0 {
 		return true
 	}
 
 	// We only write to the tree storage if the user clicks (or explicitly use the SetNextItemOpen function)
-	var g = GImGui
 	var window = g.CurrentWindow
 	var storage = &window.DC.StateStorage
 
@@ -219,13 +213,12 @@ func TreeNodeBehaviorIsOpen(id ImGuiID, flags ImGuiTreeNodeFlags) bool {
 	return is_open
 }
 
-func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = &g.Style
 	var display_frame = (flags & ImGuiTreeNodeFlags_Framed) != 0
 	var padding ImVec2
@@ -236,7 +229,7 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 	}
 
 	label = FindRenderedTextEnd(label)
-	var label_size = CalcTextSize(label, false, 0)
+	var label_size = g.CalcTextSize(label, false, 0)
 
 	// We vertically grow up to current line height up the typical widget height.
 	var frame_height = ImMax(ImMin(window.DC.CurrLineSize.y, g.FontSize+style.FramePadding.y*2), label_size.y+padding.y*2)
@@ -270,7 +263,7 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 		text_width += padding.x * 2
 	}
 	var text_pos = ImVec2{window.DC.CursorPos.x + text_offset_x, window.DC.CursorPos.y + text_offset_y}
-	ItemSizeVec(&ImVec2{text_width, frame_height}, padding.y)
+	g.ItemSizeVec(&ImVec2{text_width, frame_height}, padding.y)
 
 	// For regular tree nodes, we arbitrary allow to click past 2 worth of ItemSpacing
 	var interact_bb = frame_bb
@@ -282,18 +275,22 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 	// For this purpose we essentially compare if g.NavIdIsAlive went from 0 to 1 between TreeNode() and TreePop().
 	// This is currently only support 32 level deep and we are fine with (1 << Depth) overflowing into a zero.
 	var is_leaf = (flags & ImGuiTreeNodeFlags_Leaf) != 0
-	var is_open = TreeNodeBehaviorIsOpen(id, flags)
+	var is_open = g.TreeNodeBehaviorIsOpen(id, flags)
 	if is_open && !g.NavIdIsAlive && (flags&ImGuiTreeNodeFlags_NavLeftJumpsBackHere) != 0 && flags&ImGuiTreeNodeFlags_NoTreePushOnOpen == 0 {
 		window.DC.TreeJumpToParentOnPopMask |= (1 << window.DC.TreeDepth)
 	}
 
-	var item_add = ItemAdd(&interact_bb, id, nil, 0)
+	var item_add = g.ItemAdd(&interact_bb, id, nil, 0)
 	g.LastItemData.StatusFlags |= ImGuiItemStatusFlags_HasDisplayRect
 	g.LastItemData.DisplayRect = frame_bb
 
+	var is_multi_select = (g.LastItemData.InFlags & ImGuiItemFlags_IsMultiSelect) != 0
+	if !item_add && is_multi_select && g.BoxSelectState.UnclipMode && g.BoxSelectState.UnclipRect.Overlaps(interact_bb) {
+		item_add = true // Extra layer of "no logic clip" for box-select support
+	}
 	if !item_add {
 		if is_open && flags&ImGuiTreeNodeFlags_NoTreePushOnOpen == 0 {
-			TreePushOverrideID(id)
+			g.TreePushOverrideID(id)
 		}
 		return is_open
 	}
@@ -312,8 +309,14 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 	var arrow_hit_x1 = (text_pos.x - text_offset_x) - style.TouchExtraPadding.x
 	var arrow_hit_x2 = (text_pos.x - text_offset_x) + (g.FontSize + padding.x*2.0) + style.TouchExtraPadding.x
 	var is_mouse_x_over_arrow = (g.IO.MousePos.x >= arrow_hit_x1 && g.IO.MousePos.x < arrow_hit_x2)
-	if window != g.HoveredWindow || !is_mouse_x_over_arrow {
-		button_flags |= ImGuiButtonFlags_NoKeyModifiers
+
+	// With multi-selection we absolutely need to distinguish open vs select, so _OpenOnArrow comes by default
+	if is_multi_select {
+		if flags&(ImGuiTreeNodeFlags_OpenOnArrow|ImGuiTreeNodeFlags_OpenOnDoubleClick) == 0 {
+			flags |= ImGuiTreeNodeFlags_OpenOnArrow | ImGuiTreeNodeFlags_OpenOnDoubleClick
+		} else {
+			flags |= ImGuiTreeNodeFlags_OpenOnArrow
+		}
 	}
 
 	// Open behaviors can be altered with the _OpenOnArrow and _OnOnDoubleClick flags.
@@ -336,8 +339,18 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 	var selected = (flags & ImGuiTreeNodeFlags_Selected) != 0
 	var was_selected = selected
 
+	if is_multi_select {
+		// Handle multi-select + alter button flags for it
+		g.MultiSelectItemHeader(id, &selected, &button_flags)
+		if is_mouse_x_over_arrow {
+			button_flags = (button_flags | ImGuiButtonFlags_PressedOnClick) &^ ImGuiButtonFlags_PressedOnClickRelease
+		}
+	} else if window != g.HoveredWindow || !is_mouse_x_over_arrow {
+		button_flags |= ImGuiButtonFlags_NoKeyModifiers
+	}
+
 	var hovered, held bool
-	var pressed = ButtonBehavior(&interact_bb, id, &hovered, &held, button_flags)
+	var pressed = g.ButtonBehavior(&interact_bb, id, &hovered, &held, button_flags)
 	var toggled = false
 	if !is_leaf {
 		if pressed && g.DragDropHoldJustPressedId != id {
@@ -359,11 +372,11 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 
 		if g.NavId == id && g.NavMoveDir == ImGuiDir_Left && is_open {
 			toggled = true
-			NavMoveRequestCancel()
+			g.NavMoveRequestCancel()
 		}
 		if g.NavId == id && g.NavMoveDir == ImGuiDir_Right && !is_open { // If there's something upcoming on the line we may want to give it the priority?
 			toggled = true
-			NavMoveRequestCancel()
+			g.NavMoveRequestCancel()
 		}
 
 		if toggled {
@@ -373,29 +386,38 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 		}
 	}
 	if flags&ImGuiTreeNodeFlags_AllowItemOverlap != 0 {
-		SetItemAllowOverlap()
+		g.SetItemAllowOverlap()
+	}
+
+	// Multi-selection support (footer)
+	if is_multi_select {
+		var pressed_copy = pressed && !toggled
+		g.MultiSelectItemFooter(id, &selected, &pressed_copy)
+		if pressed {
+			g.SetNavID(id, window.DC.NavLayerCurrent, window.DC.NavFocusScopeIdCurrent, &ImRect{interact_bb.Min.Sub(window.Pos), interact_bb.Max.Sub(window.Pos)})
+		}
 	}
 
-	// In this branch, TreeNodeBehavior() cannot toggle the selection so this will never trigger.
-	if selected != was_selected { //-V547
+	// Only multi-selection can toggle the selection from within TreeNodeBehavior()
+	if selected != was_selected {
 		g.LastItemData.StatusFlags |= ImGuiItemStatusFlags_ToggledSelection
 	}
 
 	// Render
-	var text_col = GetColorU32FromID(ImGuiCol_Text, 1)
+	var text_col = g.GetColorU32FromID(ImGuiCol_Text, 1)
 	var nav_highlight_flags = ImGuiNavHighlightFlags_TypeThin
 	if display_frame {
 		// Framed type
 		var bg_col ImU32
 		if held && hovered {
-			bg_col = GetColorU32FromID(ImGuiCol_HeaderActive, 1)
+			bg_col = g.GetColorU32FromID(ImGuiCol_HeaderActive, 1)
 		} else if hovered {
-			bg_col = GetColorU32FromID(ImGuiCol_HeaderHovered, 1)
+			bg_col = g.GetColorU32FromID(ImGuiCol_HeaderHovered, 1)
 		} else {
-			bg_col = GetColorU32FromID(ImGuiCol_Header, 1)
+			bg_col = g.GetColorU32FromID(ImGuiCol_Header, 1)
 		}
-		RenderFrame(frame_bb.Min, frame_bb.Max, bg_col, true, style.FrameRounding)
-		RenderNavHighlight(&frame_bb, id, nav_highlight_flags)
+		g.RenderFrame(frame_bb.Min, frame_bb.Max, bg_col, true, style.FrameRounding)
+		g.RenderNavHighlight(&frame_bb, id, nav_highlight_flags)
 		if flags&ImGuiTreeNodeFlags_Bullet != 0 {
 			RenderBullet(window.DrawList, ImVec2{text_pos.x - text_offset_x*0.60, text_pos.y + g.FontSize*0.5}, text_col)
 		} else if !is_leaf {
@@ -414,23 +436,23 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 		}
 
 		if g.LogEnabled {
-			LogSetNextTextDecoration("###", "###")
+			g.LogSetNextTextDecoration("###", "###")
 		}
-		RenderTextClipped(&text_pos, &frame_bb.Max, label, &label_size, nil, nil)
+		g.RenderTextClipped(&text_pos, &frame_bb.Max, label, &label_size, nil, nil)
 	} else {
 		// Unframed typed for tree nodes
 		if hovered || selected {
 			var bg_col ImU32
 			if held && hovered {
-				bg_col = GetColorU32FromID(ImGuiCol_HeaderActive, 1)
+				bg_col = g.GetColorU32FromID(ImGuiCol_HeaderActive, 1)
 			} else if hovered {
-				bg_col = GetColorU32FromID(ImGuiCol_HeaderHovered, 1)
+				bg_col = g.GetColorU32FromID(ImGuiCol_HeaderHovered, 1)
 			} else {
-				bg_col = GetColorU32FromID(ImGuiCol_Header, 1)
+				bg_col = g.GetColorU32FromID(ImGuiCol_Header, 1)
 			}
-			RenderFrame(frame_bb.Min, frame_bb.Max, bg_col, false, 0)
+			g.RenderFrame(frame_bb.Min, frame_bb.Max, bg_col, false, 0)
 		}
-		RenderNavHighlight(&frame_bb, id, nav_highlight_flags)
+		g.RenderNavHighlight(&frame_bb, id, nav_highlight_flags)
 		if flags&ImGuiTreeNodeFlags_Bullet != 0 {
 			RenderBullet(window.DrawList, ImVec2{text_pos.x - text_offset_x*0.5, text_pos.y + g.FontSize*0.5}, text_col)
 		} else if !is_leaf {
@@ -443,13 +465,18 @@ func TreeNodeBehavior(id ImGuiID, flags ImGuiTreeNodeFlags, label string) bool {
 			RenderArrow(window.DrawList, ImVec2{text_pos.x - text_offset_x + padding.x, text_pos.y + g.FontSize*0.15}, text_col, arrow, 0.70)
 		}
 		if g.LogEnabled {
-			LogSetNextTextDecoration(">", "")
+			g.LogSetNextTextDecoration(">", "")
 		}
-		RenderText(text_pos, label, false)
+		g.RenderText(text_pos, label, false)
 	}
 
 	if is_open && flags&ImGuiTreeNodeFlags_NoTreePushOnOpen == 0 {
-		TreePushOverrideID(id)
+		g.TreePushOverrideID(id)
+	}
+	var opened = ImGuiItemStatusFlags_None
+	if is_open {
+		opened = ImGuiItemStatusFlags_Opened
 	}
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags|ImGuiItemStatusFlags_Openable|opened)
 	return is_open
 }
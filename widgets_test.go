@@ -0,0 +1,187 @@
+package imgui
+
+import "testing"
+
+func TestDataTypeOf(t *testing.T) {
+	type celsius float32
+	for _, test := range []struct {
+		got, want ImGuiDataType
+	}{
+		{DataTypeOf[int8](), ImGuiDataType_S8},
+		{DataTypeOf[uint8](), ImGuiDataType_U8},
+		{DataTypeOf[int16](), ImGuiDataType_S16},
+		{DataTypeOf[uint16](), ImGuiDataType_U16},
+		{DataTypeOf[int32](), ImGuiDataType_S32},
+		{DataTypeOf[uint32](), ImGuiDataType_U32},
+		{DataTypeOf[int64](), ImGuiDataType_S64},
+		{DataTypeOf[uint64](), ImGuiDataType_U64},
+		{DataTypeOf[float32](), ImGuiDataType_Float},
+		{DataTypeOf[float64](), ImGuiDataType_Double},
+		{DataTypeOf[celsius](), ImGuiDataType_Float},
+	} {
+		if test.got != test.want {
+			t.Errorf("%s: DataTypeOf() = %s", DataTypeGetInfo(test.want).Name, DataTypeGetInfo(test.got).Name)
+		}
+	}
+}
+
+func TestDataTypeApplyOpFromText(t *testing.T) {
+	var s8 int8 = 100
+	if !DataTypeApplyOpFromTextT("+50", "100", &s8, "%d") || s8 != 127 {
+		t.Errorf("int8 100+50 = %d, want 127", s8)
+	}
+	var u8 uint8 = 3
+	if !DataTypeApplyOpFromTextT("+-5", "3", &u8, "%d") || u8 != 0 {
+		t.Errorf("uint8 3-5 = %d, want 0", u8)
+	}
+	var u16 uint16
+	if !DataTypeApplyOpFromTextT("1000000", "0", &u16, "%d") || u16 != 65535 {
+		t.Errorf("uint16 = %d, want 65535", u16)
+	}
+	var s32 int32 = 10
+	if !DataTypeApplyOpFromTextT("*1.5", "10", &s32, "%d") || s32 != 15 {
+		t.Errorf("int32 10*1.5 = %d, want 15", s32)
+	}
+	var u32 uint32
+	if !DataTypeApplyOpFromTextT("0000FF", "00000000", &u32, "%08X") || u32 != 255 {
+		t.Errorf("uint32 0xFF = %d, want 255", u32)
+	}
+	var u64 uint64
+	if !DataTypeApplyOpFromTextT("18446744073709551615", "0", &u64, "") || u64 != 1<<64-1 {
+		t.Errorf("uint64 = %d, want %d", u64, uint64(1<<64-1))
+	}
+	var f64 = 1.0
+	if !DataTypeApplyOpFromTextT("/4", "1.0", &f64, "%.3f") || f64 != 0.25 {
+		t.Errorf("float64 1/4 = %v, want 0.25", f64)
+	}
+	if DataTypeApplyOpFromTextT("/0", "1.0", &f64, "%.3f") || DataTypeApplyOpFromTextT("abc", "", &f64, "") || DataTypeApplyOpFromTextT(" ", "", &f64, "") {
+		t.Errorf("invalid inputs changed the value to %v", f64)
+	}
+}
+
+func TestScalarWidgets(t *testing.T) {
+	var ctx = newTestContext(func(io *ImGuiIO) {
+		io.MouseDoubleClickTime = 0 // Drags start at the center of the widgets, which would turn the second one into a double click
+	})
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	var (
+		s8   int8   = 0
+		u64  uint64 = 5
+		u16  uint16 = 500
+		f64s        = []float64{1, 2, 3}
+		i32  int32  = 7 // Edited through the ImGuiDataType API
+	)
+	var rects = map[string]ImRect{}
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{400, 300}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			DragT("S8", &s8, 1, -10, 10, "", 0)
+			rects["S8"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			DragT("U64", &u64, 1, 0, 0, "", 0)
+			rects["U64"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			SliderT("U16", &u16, 0, 1000, "%d units", 0)
+			rects["U16"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			DragNT("F64s", f64s, 0.5, 0, 0, "%.1f", 0)
+			InputT("I32", &i32, 1, 10, "", 0)
+			ui.DragScalar("S32", ImGuiDataType_S32, &i32, 1, nil, nil, "", 0)
+			rects["S32"] = ImRect{GetItemRectMin(), GetItemRectMax()}
+			ui.End()
+		})
+	}
+	var drag = func(name string, dx float) {
+		var rect = rects[name]
+		var center = rect.GetCenter()
+		mouseDrag(io, frame, center, ImVec2{center.x + dx, center.y}, 5)
+	}
+	frame()
+	frame()
+
+	drag("S8", 100)
+	if s8 != 10 {
+		t.Errorf("int8 dragged right: %d, want clamped to 10", s8)
+	}
+	drag("U64", -50)
+	if u64 != 0 {
+		t.Errorf("uint64 dragged left: %d, want 0 without wrapping around", u64)
+	}
+	drag("U64", 40)
+	if u64 == 0 || u64 > 40 {
+		t.Errorf("uint64 dragged right: %d, want in 1..40", u64)
+	}
+	var min = rects["U16"].Min
+	io.AddMousePosEvent(min.x+1, min.y+2)
+	frame()
+	io.AddMouseButtonEvent(0, true)
+	frame()
+	io.AddMouseButtonEvent(0, false)
+	frame()
+	if u16 != 0 {
+		t.Errorf("uint16 slider clicked on the left: %d, want 0", u16)
+	}
+	drag("S32", 20)
+	if i32 <= 7 {
+		t.Errorf("int32 dragged right through DragScalar(): %d, want more than 7", i32)
+	}
+}
+
+func TestInputTextString(t *testing.T) {
+	var ctx = newTestContext(nil)
+	defer DestroyContext(ctx)
+	var io = &ctx.IO
+
+	var (
+		name     = "héllo"
+		changes  = 0
+		filtered = 0
+		rect     ImRect
+	)
+	var frame = func() {
+		ctx.Frame(func(ui *ImGuiUI) {
+			ui.SetNextWindowPos(&ImVec2{10, 10}, ImGuiCond_Always, ImVec2{})
+			ui.SetNextWindowSize(&ImVec2{400, 300}, ImGuiCond_Always)
+			ui.Begin("Window", nil, 0)
+			var filter = func(data *ImGuiInputTextCallbackData) int {
+				if data.UserData != &filtered {
+					t.Errorf("callback user data = %v, want the caller's", data.UserData)
+				}
+				filtered++
+				return 0
+			}
+			if ui.InputTextString("Name", &name, ImGuiInputTextFlags_CallbackCharFilter, filter, &filtered) {
+				changes++
+			}
+			rect = ImRect{GetItemRectMin(), GetItemRectMax()}
+			ui.End()
+		})
+	}
+	frame()
+	io.AddMousePosEvent(rect.Min.x+5, rect.Min.y+5)
+	frame()
+	io.AddMouseButtonEvent(0, true)
+	frame()
+	io.AddMouseButtonEvent(0, false)
+	io.AddKeyEvent(ImGuiKey_End, true)
+	frame()
+	io.AddKeyEvent(ImGuiKey_End, false)
+	frame()
+	if changes != 0 {
+		t.Errorf("InputTextString() returned true %d times before any edit", changes)
+	}
+
+	io.AddInputCharacters(", wörld! This is longer than the initial buffer")
+	frame()
+	frame()
+	if want := "héllo, wörld! This is longer than the initial buffer"; name != want {
+		t.Errorf("name = %q, want %q", name, want)
+	}
+	if changes != 1 {
+		t.Errorf("InputTextString() returned true %d times, want once", changes)
+	}
+	if filtered == 0 {
+		t.Errorf("the character filter callback was not forwarded")
+	}
+}
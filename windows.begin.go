@@ -7,18 +7,17 @@ package imgui
 //     You can use the "##" or "###" markers to use the same label with different id, or same id with different label. See documentation at the top of this file.
 //   - Return false when window is collapsed, so you can early out in your code. You always need to call ImGui::End() even if false is returned.
 //   - Passing 'bool* p_open' displays a Close button on the upper-right corner of the window, the pointed value will be set to false when the button is pressed.
-func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 	var style = g.Style
 	IM_ASSERT(name != "")                        // Window name required
 	IM_ASSERT(g.WithinFrameScope)                // Forgot to call ImGui::NewFrame()
 	IM_ASSERT(g.FrameCountEnded != g.FrameCount) // Called ImGui::Render() or ImGui::EndFrame() and haven't called ImGui::NewFrame() again yet
 
 	// Find or create
-	var window = FindWindowByName(name)
+	var window = g.FindWindowByName(name)
 	var window_just_created = (window == nil)
 	if window_just_created {
-		window = CreateNewWindow(name, flags)
+		window = g.CreateNewWindow(name, flags)
 	}
 
 	// Automatically disable manual moving/resizing when NoInputs is set
@@ -46,6 +45,15 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		SetWindowConditionAllowFlags(window, ImGuiCond_Appearing, true)
 	}
 
+	// Docking
+	// (NB: when the dock node isn't visible this frame, the window is hidden and keeps its regular flags)
+	if first_begin_of_the_frame {
+		if g.NextWindowData.Flags&ImGuiNextWindowDataFlags_HasDock != 0 {
+			g.SetWindowDock(window, g.NextWindowData.DockId, g.NextWindowData.DockCond)
+		}
+		flags = g.BeginDocked(window, p_open, flags)
+	}
+
 	// Update Flags, LastFrameActive, BeginOrderXXX fields
 	if first_begin_of_the_frame {
 		window.Flags = (ImGuiWindowFlags)(flags)
@@ -65,7 +73,9 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 	}
 	var parent_window *ImGuiWindow
 	if first_begin_of_the_frame {
-		if flags&(ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_Popup) != 0 {
+		if window.DockIsActive {
+			parent_window = window.DockNode.HostWindow
+		} else if flags&(ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_Popup) != 0 {
 			parent_window = parent_window_in_stack
 		} else {
 			parent_window = nil
@@ -87,7 +97,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 	window_stack_data.ParentLastItemDataBackup = g.LastItemData
 	g.CurrentWindowStack = append(g.CurrentWindowStack, window_stack_data)
 	g.CurrentWindow = window
-	window.DC.StackSizesOnBegin.SetToCurrentState()
+	window.DC.StackSizesOnBegin.SetToCurrentState(g)
 	g.CurrentWindow = nil
 
 	if flags&ImGuiWindowFlags_Popup != 0 {
@@ -143,7 +153,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		setWindowCollapsed(window, g.NextWindowData.CollapsedVal, g.NextWindowData.CollapsedCond)
 	}
 	if g.NextWindowData.Flags&ImGuiNextWindowDataFlags_HasFocus != 0 {
-		FocusWindow(window)
+		g.FocusWindow(window)
 	}
 	if window.Appearing {
 		SetWindowConditionAllowFlags(window, ImGuiCond_Appearing, false)
@@ -214,8 +224,10 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		}
 
 		// SELECT VIEWPORT
-		// FIXME-VIEWPORT: In the docking/viewport branch, this is the point where we select the current viewport (which may affect the style)
-		SetCurrentWindow(window)
+		// We need to do this before using any style/font sizes, as viewport with a different DPI may affect font sizes.
+		g.WindowSelectViewport(window)
+		g.SetCurrentViewport(window, window.Viewport)
+		g.SetCurrentWindow(window)
 
 		// LOCK BORDER SIZE AND PADDING FOR THE FRAME (so that altering them doesn't cause inconsistencies)
 
@@ -246,12 +258,12 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		if flags&ImGuiWindowFlags_NoTitleBar == 0 && flags&ImGuiWindowFlags_NoCollapse == 0 {
 			// We don't use a regular button+id to test for double-click on title bar (mostly due to legacy reason, could be fixed), so verify that we don't have items over the title bar.
 			var title_bar_rect = window.TitleBarRect()
-			if g.HoveredWindow == window && g.HoveredId == 0 && g.HoveredIdPreviousFrame == 0 && IsMouseHoveringRect(title_bar_rect.Min, title_bar_rect.Max, true) && g.IO.MouseDoubleClicked[0] {
+			if g.HoveredWindow == window && g.HoveredId == 0 && g.HoveredIdPreviousFrame == 0 && g.IsMouseHoveringRect(title_bar_rect.Min, title_bar_rect.Max, true) && g.IO.MouseDoubleClicked[0] {
 				window.WantCollapseToggle = true
 			}
 			if window.WantCollapseToggle {
 				window.Collapsed = !window.Collapsed
-				MarkIniSettingsDirtyWindow(window)
+				g.MarkIniSettingsDirtyWindow(window)
 				if !window.Collapsed {
 					window.SizeFull = window.Size
 				}
@@ -264,7 +276,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		// SIZE
 
 		// Calculate auto-fit size, handle automatic resize
-		var size_auto_fit = CalcWindowAutoFitSize(window, &window.ContentSizeIdeal)
+		var size_auto_fit = g.CalcWindowAutoFitSize(window, &window.ContentSizeIdeal)
 		var use_current_size_for_scrollbar_x = window_just_created
 		var use_current_size_for_scrollbar_y = window_just_created
 		if (flags&ImGuiWindowFlags_AlwaysAutoResize != 0) && !window.Collapsed {
@@ -297,12 +309,12 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 				use_current_size_for_scrollbar_y = true
 			}
 			if !window.Collapsed {
-				MarkIniSettingsDirtyWindow(window)
+				g.MarkIniSettingsDirtyWindow(window)
 			}
 		}
 
 		// Apply minimum/maximum window size constraints and final size
-		window.SizeFull = CalcWindowSizeAfterConstraint(window, &window.SizeFull)
+		window.SizeFull = g.CalcWindowSizeAfterConstraint(window, &window.SizeFull)
 		if window.Collapsed && flags&ImGuiWindowFlags_ChildWindow == 0 {
 			titlebar := window.TitleBarRect()
 			window.SizeFull = titlebar.GetSize()
@@ -336,16 +348,16 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 			p := window.SetWindowPosVal.Sub(window.Size.Mul(window.SetWindowPosPivot))
 			setWindowPos(window, &p, 0) // Position given a pivot (e.g. for centering)
 		} else if (flags & ImGuiWindowFlags_ChildMenu) != 0 {
-			window.Pos = FindBestWindowPosForPopup(window)
+			window.Pos = g.FindBestWindowPosForPopup(window)
 		} else if (flags&ImGuiWindowFlags_Popup) != 0 && !window_pos_set_by_api && window_just_appearing_after_hidden_for_resize {
-			window.Pos = FindBestWindowPosForPopup(window)
+			window.Pos = g.FindBestWindowPosForPopup(window)
 		} else if (flags&ImGuiWindowFlags_Tooltip) != 0 && !window_pos_set_by_api && !window_is_child_tooltip {
-			window.Pos = FindBestWindowPosForPopup(window)
+			window.Pos = g.FindBestWindowPosForPopup(window)
 		}
 
 		// Calculate the range of allowed position for that window (to be movable and visible past safe area padding)
 		// When clamping to stay visible, we will enforce that window.Pos stays inside of visibility_rect.
-		var viewport = GetMainViewport()
+		var viewport = window.Viewport
 		var viewport_rect = ImRect(viewport.GetMainRect())
 		var viewport_work_rect = ImRect(viewport.GetWorkRect())
 		var visibility_padding = ImMaxVec2(&style.DisplayWindowPadding, &style.DisplaySafeAreaPadding)
@@ -353,13 +365,18 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 
 		// Clamp position/size so window stays visible within its viewport or monitor
 		// Ignore zero-sized display explicitly to avoid losing positions if a window manager reports zero-sized window when initializing or minimizing.
+		// (windows owning their viewport, or being moved out of it, are free to go anywhere on the desktop)
 		if !window_pos_set_by_api && flags&ImGuiWindowFlags_ChildWindow == 0 && window.AutoFitFramesX <= 0 && window.AutoFitFramesY <= 0 {
-			if viewport_rect.GetWidth() > 0.0 && viewport_rect.GetHeight() > 0.0 {
-				ClampWindowRect(window, &visibility_rect)
+			if !window.ViewportOwned && !window.ViewportAllowExtend && viewport_rect.GetWidth() > 0.0 && viewport_rect.GetHeight() > 0.0 {
+				g.ClampWindowRect(window, &visibility_rect)
 			}
 		}
 		window.Pos = *ImFloorVec(&window.Pos)
 
+		// Sync the viewport owned by the window, or create one if the window was moved outside of its viewport
+		g.WindowSyncOwnedViewport(window)
+		viewport_rect = window.Viewport.GetMainRect()
+
 		// Lock window rounding for the frame (so that altering them doesn't cause inconsistencies)
 		// Large values tend to lead to variety of artifacts and are not recommended.
 		if flags&ImGuiWindowFlags_ChildWindow != 0 {
@@ -397,7 +414,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		}
 		var resize_grip_draw_size = IM_FLOOR(ImMax(g.FontSize*1.10, window.WindowRounding+1.0+g.FontSize*0.2))
 		if !window.Collapsed {
-			if UpdateWindowManualResize(window, &size_auto_fit, &border_held, resize_grip_count, &resize_grip_col, &visibility_rect) {
+			if g.UpdateWindowManualResize(window, &size_auto_fit, &border_held, resize_grip_count, &resize_grip_col, &visibility_rect) {
 				use_current_size_for_scrollbar_x = true
 				use_current_size_for_scrollbar_y = true
 			}
@@ -513,7 +530,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		window.ScrollMax.y = ImMax(0.0, window.ContentSize.y+window.WindowPadding.y*2.0-window.InnerRect.GetHeight())
 
 		// Apply scrolling
-		window.Scroll = CalcNextScrollFromScrollTargetAndClamp(window)
+		window.Scroll = g.CalcNextScrollFromScrollTargetAndClamp(window)
 		window.ScrollTarget = ImVec2{FLT_MAX, FLT_MAX}
 
 		// DRAWING
@@ -521,10 +538,10 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		// Setup draw list and outer clipping rectangle
 		IM_ASSERT(len(window.DrawList.CmdBuffer) == 1 && window.DrawList.CmdBuffer[0].ElemCount == 0)
 		window.DrawList.PushTextureID(g.Font.ContainerAtlas.TexID)
-		PushClipRect(host_rect.Min, host_rect.Max, false)
+		g.PushClipRect(host_rect.Min, host_rect.Max, false)
 
 		// Draw modal window background (darkens what is behind them, all viewports)
-		var dim_bg_for_modal = (flags&ImGuiWindowFlags_Modal != 0) && window == GetTopMostPopupModal() && window.HiddenFramesCannotSkipItems <= 0
+		var dim_bg_for_modal = (flags&ImGuiWindowFlags_Modal != 0) && window == g.GetTopMostPopupModal() && window.HiddenFramesCannotSkipItems <= 0
 		var dim_bg_for_window_list = g.NavWindowingTargetAnim != nil && (window == g.NavWindowingTargetAnim.RootWindow)
 		if dim_bg_for_modal || dim_bg_for_window_list {
 
@@ -535,7 +552,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 				c = ImGuiCol_NavWindowingDimBg
 			}
 
-			var dim_bg_col = GetColorU32FromID(c, g.DimBgRatio)
+			var dim_bg_col = g.GetColorU32FromID(c, g.DimBgRatio)
 			window.DrawList.AddRectFilled(viewport_rect.Min, viewport_rect.Max, dim_bg_col, 0, 0)
 		}
 
@@ -544,7 +561,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 			var bb = window.Rect()
 			bb.Expand(g.FontSize)
 			if !bb.ContainsRect(viewport_rect) { // Avoid drawing if the window covers all the viewport anyway
-				window.DrawList.AddRectFilled(bb.Min, bb.Max, GetColorU32FromID(ImGuiCol_NavWindowingHighlight, g.NavWindowingHighlightAlpha*0.25), g.Style.WindowRounding, 0)
+				window.DrawList.AddRectFilled(bb.Min, bb.Max, g.GetColorU32FromID(ImGuiCol_NavWindowingHighlight, g.NavWindowingHighlightAlpha*0.25), g.Style.WindowRounding, 0)
 			}
 		}
 
@@ -553,7 +570,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		// FIXME: User code may rely on explicit sorting of overlapping child window and would need to disable this somehow. Please get in contact if you are affected (github #4493)
 		{
 			var render_decorations_in_parent = false
-			if (flags&ImGuiWindowFlags_ChildWindow != 0) && flags&ImGuiWindowFlags_Popup == 0 && !window_is_child_tooltip {
+			if (flags&ImGuiWindowFlags_ChildWindow != 0) && flags&ImGuiWindowFlags_Popup == 0 && !window_is_child_tooltip && !window.DockIsActive {
 				// - We test overlap with the previous child window only (testing all would end up being O(log N) not a good investment here)
 				// - We disable this when the parent window has zero vertices, which is a common pattern leading to laying out multiple overlapping childs
 				var previous_child *ImGuiWindow
@@ -581,7 +598,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 				window_to_highlight = g.NavWindowingTarget
 			}
 			var title_bar_is_highlight = want_focus || (window_to_highlight != nil && window.RootWindowForTitleBarHighlight == window_to_highlight.RootWindowForTitleBarHighlight)
-			RenderWindowDecorations(window, &title_bar_rect, title_bar_is_highlight, resize_grip_count, resize_grip_col, resize_grip_draw_size)
+			g.RenderWindowDecorations(window, &title_bar_rect, title_bar_is_highlight, resize_grip_count, resize_grip_col, resize_grip_draw_size)
 
 			if render_decorations_in_parent {
 				window.DrawList = &window.DrawListInst
@@ -597,7 +614,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 				bb.Expand(-g.FontSize - 1.0)
 				rounding = window.WindowRounding
 			}
-			window.DrawList.AddRect(bb.Min, bb.Max, GetColorU32FromID(ImGuiCol_NavWindowingHighlight, g.NavWindowingHighlightAlpha), rounding, 0, 3.0)
+			window.DrawList.AddRect(bb.Min, bb.Max, g.GetColorU32FromID(ImGuiCol_NavWindowingHighlight, g.NavWindowingHighlightAlpha), rounding, 0, 3.0)
 		}
 
 		// UPDATE RECTANGLES (2- THOSE AFFECTED BY SCROLLING)
@@ -712,13 +729,18 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 
 		// Apply focus (we need to call FocusWindow() AFTER setting DC.CursorStartPos so our initial navigation reference rectangle can start around there)
 		if want_focus {
-			FocusWindow(window)
-			NavInitWindow(window, false) // <-- this is in the way for us to be able to defer and sort reappearing FocusWindow() calls
+			g.FocusWindow(window)
+			g.NavInitWindow(window, false) // <-- this is in the way for us to be able to defer and sort reappearing FocusWindow() calls
+		}
+
+		// Close requested by platform window
+		if p_open != nil && window.ViewportOwned && window.Viewport.PlatformRequestClose && window.Viewport != g.GetMainViewport() {
+			*p_open = false
 		}
 
 		// Title bar
 		if flags&ImGuiWindowFlags_NoTitleBar == 0 {
-			RenderWindowTitleBarContents(window, &ImRect{ImVec2{title_bar_rect.Min.x + window.WindowBorderSize, title_bar_rect.Min.y}, ImVec2{title_bar_rect.Max.x - window.WindowBorderSize, title_bar_rect.Max.y}}, name, p_open)
+			g.RenderWindowTitleBarContents(window, &ImRect{ImVec2{title_bar_rect.Min.x + window.WindowBorderSize, title_bar_rect.Min.y}, ImVec2{title_bar_rect.Max.x - window.WindowBorderSize, title_bar_rect.Max.y}}, name, p_open)
 		}
 
 		// Clear hit test shape every frame
@@ -739,7 +761,7 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		// This is useful to allow creating context menus on title bar only, etc.
 		g.LastItemData.ID = window.MoveId
 		g.LastItemData.InFlags = g.CurrentItemFlags
-		if IsMouseHoveringRect(title_bar_rect.Min, title_bar_rect.Max, false) {
+		if g.IsMouseHoveringRect(title_bar_rect.Min, title_bar_rect.Max, false) {
 			g.LastItemData.StatusFlags = ImGuiItemStatusFlags_HoveredRect
 		} else {
 			g.LastItemData.StatusFlags = 0
@@ -747,18 +769,18 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 		g.LastItemData.Rect = title_bar_rect
 	} else {
 		// Append
-		SetCurrentWindow(window)
+		g.SetCurrentWindow(window)
 	}
 
 	// Pull/inherit current state
 	if flags&ImGuiWindowFlags_ChildWindow != 0 {
 		window.DC.NavFocusScopeIdCurrent = parent_window.DC.NavFocusScopeIdCurrent
 	} else {
-		window.GetIDs("#FOCUSSCOPE")
+		window.DC.NavFocusScopeIdCurrent = window.GetIDs("#FOCUSSCOPE")
 	}
 
 	if !window.Collapsed {
-		PushClipRect(window.InnerClipRect.Min, window.InnerClipRect.Max, true)
+		g.PushClipRect(window.InnerClipRect.Min, window.InnerClipRect.Max, true)
 	}
 
 	// Clear 'accessed' flag last thing (After PushClipRect which will set the flag. We want the flag to stay false when the default "Debug" window is unused)
@@ -787,6 +809,11 @@ func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
 			if parent_window != nil && (parent_window.Collapsed || parent_window.HiddenFramesCannotSkipItems > 0) {
 				window.HiddenFramesCannotSkipItems = 1
 			}
+
+			// Hide in a closed menu submitted for its shortcuts
+			if parent_window != nil && parent_window == g.MenusShortcutsPass.Window {
+				window.HiddenFramesCanSkipItems = 1
+			}
 		}
 
 		// Don't render if style alpha is 0.0 at the time of Begin(). This is arbitrary and inconsistent but has been there for a long while (may remove at some point)
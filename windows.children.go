@@ -3,15 +3,14 @@ package imgui
 import "fmt"
 
 // Popups, Modals, Tooltips
-func BeginChildEx(name string, id ImGuiID, size_arg *ImVec2, border bool, flags ImGuiWindowFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginChildEx(name string, id ImGuiID, size_arg *ImVec2, border bool, flags ImGuiWindowFlags) bool {
 	var parent_window = g.CurrentWindow
 
 	flags |= ImGuiWindowFlags_NoTitleBar | ImGuiWindowFlags_NoResize | ImGuiWindowFlags_NoSavedSettings | ImGuiWindowFlags_ChildWindow
 	flags |= (parent_window.Flags & ImGuiWindowFlags_NoMove) // Inherit the NoMove flag
 
 	// Size
-	var content_avail = GetContentRegionAvail()
+	var content_avail = g.GetContentRegionAvail()
 	var size = *ImFloorVec(size_arg)
 
 	var auto_fit_axises int
@@ -28,7 +27,7 @@ func BeginChildEx(name string, id ImGuiID, size_arg *ImVec2, border bool, flags
 	if size.y <= 0.0 {
 		size.y = ImMax(content_avail.y+size.y, 4.0)
 	}
-	SetNextWindowSize(&size, 0)
+	g.SetNextWindowSize(&size, 0)
 
 	// Build up name. If you need to append to a same child from multiple location in the ID stack, use BeginChild(ImGuiID id) with a stable value.
 	if name != "" {
@@ -41,7 +40,7 @@ func BeginChildEx(name string, id ImGuiID, size_arg *ImVec2, border bool, flags
 	if !border {
 		g.Style.ChildBorderSize = 0.0
 	}
-	var ret = Begin(string(g.TempBuffer[:]), nil, flags)
+	var ret = g.Begin(string(g.TempBuffer[:]), nil, flags)
 	g.Style.ChildBorderSize = backup_border_size
 
 	var child_window = g.CurrentWindow
@@ -56,9 +55,9 @@ func BeginChildEx(name string, id ImGuiID, size_arg *ImVec2, border bool, flags
 
 	// Process navigation-in immediately so NavInit can run on first frame
 	if g.NavActivateId == id && (flags&ImGuiWindowFlags_NavFlattened == 0) && (child_window.DC.NavLayersActiveMask != 0 || child_window.DC.NavHasScroll) {
-		FocusWindow(child_window)
-		NavInitWindow(child_window, false)
-		SetActiveID(id+1, child_window) // Steal ActiveId with another arbitrary id so that key-press won't activate child item
+		g.FocusWindow(child_window)
+		g.NavInitWindow(child_window, false)
+		g.SetActiveID(id+1, child_window) // Steal ActiveId with another arbitrary id so that key-press won't activate child item
 		g.ActiveIdSource = ImGuiInputSource_Nav
 	}
 	return ret
@@ -72,18 +71,17 @@ func BeginChildEx(name string, id ImGuiID, size_arg *ImVec2, border bool, flags
 //     [Important: due to legacy reason, this is inconsistent with most other functions such as BeginMenu/EndMenu,
 //     BeginPopup/EndPopup, etc. where the EndXXX call should only be called if the corresponding BeginXXX function
 //     returned true. Begin and BeginChild are the only odd ones out. Will be fixed in a future update.]
-func BeginChild(str_id string, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
-	var window = GetCurrentWindow()
-	return BeginChildEx(str_id, window.GetIDs(str_id), &size, border, flags)
+func (g *ImGuiContext) BeginChild(str_id string, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	var window = g.GetCurrentWindow()
+	return g.BeginChildEx(str_id, window.GetIDs(str_id), &size, border, flags)
 }
 
-func BeginChildID(id ImGuiID, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+func (g *ImGuiContext) BeginChildID(id ImGuiID, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
 	IM_ASSERT(id != 0)
-	return BeginChildEx("", id, &size, border, flags)
+	return g.BeginChildEx("", id, &size, border, flags)
 }
 
-func EndChild() {
-	var g = GImGui
+func (g *ImGuiContext) EndChild() {
 	var window = g.CurrentWindow
 
 	IM_ASSERT(!g.WithinEndChild)
@@ -91,7 +89,7 @@ func EndChild() {
 
 	g.WithinEndChild = true
 	if window.BeginCount > 1 {
-		End()
+		g.End()
 	} else {
 		var sz = window.Size
 		if window.AutoFitChildAxises&(1<<ImGuiAxis_X) != 0 { // Arbitrary minimum zero-ish child size of 4.0f causes less trouble than a 0.0f
@@ -100,22 +98,22 @@ func EndChild() {
 		if window.AutoFitChildAxises&(1<<ImGuiAxis_Y) != 0 {
 			sz.y = ImMax(4.0, sz.y)
 		}
-		End()
+		g.End()
 
 		var parent_window = g.CurrentWindow
 		var bb = ImRect{parent_window.DC.CursorPos, parent_window.DC.CursorPos.Add(sz)}
-		ItemSizeVec(&sz, 0)
+		g.ItemSizeVec(&sz, 0)
 		if (window.DC.NavLayersActiveMask != 0 || window.DC.NavHasScroll) && (window.Flags&ImGuiWindowFlags_NavFlattened == 0) {
-			ItemAdd(&bb, window.ChildId, nil, 0)
-			RenderNavHighlight(&bb, window.ChildId, 0)
+			g.ItemAdd(&bb, window.ChildId, nil, 0)
+			g.RenderNavHighlight(&bb, window.ChildId, 0)
 
 			// When browsing a window that has no activable items (scroll only) we keep a highlight on the child
 			if window.DC.NavLayersActiveMask == 0 && window == g.NavWindow {
-				RenderNavHighlight(&ImRect{bb.Min.Sub(ImVec2{2, 2}), bb.Max.Add(ImVec2{2, 2})}, g.NavId, ImGuiNavHighlightFlags_TypeThin)
+				g.RenderNavHighlight(&ImRect{bb.Min.Sub(ImVec2{2, 2}), bb.Max.Add(ImVec2{2, 2})}, g.NavId, ImGuiNavHighlightFlags_TypeThin)
 			}
 		} else {
 			// Not navigable into
-			ItemAdd(&bb, 0, nil, 0)
+			g.ItemAdd(&bb, 0, nil, 0)
 		}
 		if g.HoveredWindow == window {
 			g.LastItemData.StatusFlags |= ImGuiItemStatusFlags_HoveredWindow
@@ -127,20 +125,19 @@ func EndChild() {
 
 // calculate coarse clipping for large list of evenly sized items. Prefer using the ImGuiListClipper higher-level helper if you can.
 // helper to create a child window / scrolling region that looks like a normal widget frame
-func BeginChildFrame(id ImGuiID, size ImVec2, flags ImGuiWindowFlags) bool {
-	var g = GImGui
+func (g *ImGuiContext) BeginChildFrame(id ImGuiID, size ImVec2, flags ImGuiWindowFlags) bool {
 	var style = g.Style
-	PushStyleColorVec(ImGuiCol_ChildBg, &style.Colors[ImGuiCol_FrameBg])
-	PushStyleFloat(ImGuiStyleVar_ChildRounding, style.FrameRounding)
-	PushStyleFloat(ImGuiStyleVar_ChildBorderSize, style.FrameBorderSize)
-	PushStyleVec(ImGuiStyleVar_WindowPadding, style.FramePadding)
-	var ret = BeginChildID(id, size, true, ImGuiWindowFlags_NoMove|ImGuiWindowFlags_AlwaysUseWindowPadding|flags)
-	PopStyleVar(3)
-	PopStyleColor(1)
+	g.PushStyleColorVec(ImGuiCol_ChildBg, &style.Colors[ImGuiCol_FrameBg])
+	g.PushStyleFloat(ImGuiStyleVar_ChildRounding, style.FrameRounding)
+	g.PushStyleFloat(ImGuiStyleVar_ChildBorderSize, style.FrameBorderSize)
+	g.PushStyleVec(ImGuiStyleVar_WindowPadding, style.FramePadding)
+	var ret = g.BeginChildID(id, size, true, ImGuiWindowFlags_NoMove|ImGuiWindowFlags_AlwaysUseWindowPadding|flags)
+	g.PopStyleVar(3)
+	g.PopStyleColor(1)
 	return ret
 }
 
 // always call EndChildFrame() regardless of BeginChildFrame() return values (which indicates a collapsed/clipped window)
-func EndChildFrame() {
-	EndChild()
+func (g *ImGuiContext) EndChildFrame() {
+	g.EndChild()
 }
@@ -1,8 +1,8 @@
 package imgui
 
 // "bool* p_selected" poto int the selection state (read-write), as a convenient helper.
-func SelectablePointer(label string, p_selected *bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
-	if Selectable(label, *p_selected, flags, size_arg) {
+func (g *ImGuiContext) SelectablePointer(label string, p_selected *bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
+	if g.Selectable(label, *p_selected, flags, size_arg) {
 		*p_selected = !*p_selected
 		return true
 	}
@@ -17,18 +17,17 @@ func SelectablePointer(label string, p_selected *bool, flags ImGuiSelectableFlag
 // But you need to make sure the ID is unique, e.g. enclose calls in PushID/PopID or use ##unique_id.
 // With this scheme, ImGuiSelectableFlags_SpanAllColumns and ImGuiSelectableFlags_AllowItemOverlap are also frequently used flags.
 // FIXME: Selectable() with (size.x == 0.0f) and (SelectableTextAlign.x > 0.0f) followed by SameLine() is currently not supported.
-func Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_arg ImVec2) bool {
+	var window = g.GetCurrentWindow()
 	if window.SkipItems {
 		return false
 	}
 
-	var g = GImGui
 	var style = g.Style
 
 	// Submit label or explicit size to ItemSize(), whereas ItemAdd() will submit a larger/spanning rectangle.
 	var id = window.GetIDs(label)
-	var label_size = CalcTextSize(label, true, -1)
+	var label_size = g.CalcTextSize(label, true, -1)
 	var size = ImVec2{label_size.x, label_size.y}
 	if size_arg.x != 0.0 {
 		size.x = size_arg.x
@@ -38,7 +37,7 @@ func Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_ar
 	}
 	var pos = window.DC.CursorPos
 	pos.y += window.DC.CurrLineTextBaseOffset
-	ItemSizeVec(&size, 0.0)
+	g.ItemSizeVec(&size, 0.0)
 
 	// Fill horizontal space
 	// We don't support (size < 0.0f) in Selectable() because the ItemSpacing extension would make explicitly right-aligned sizes not visibly match other widgets.
@@ -89,27 +88,30 @@ func Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_ar
 		disabled_flags |= ImGuiItemFlags_Disabled
 	}
 
-	var item_add = ItemAdd(&bb, id, nil, disabled_flags)
+	var item_add = g.ItemAdd(&bb, id, nil, disabled_flags)
 	if span_all_columns {
 		window.ClipRect.Min.x = backup_clip_rect_min_x
 		window.ClipRect.Max.x = backup_clip_rect_max_x
 	}
 
+	var is_multi_select = (g.LastItemData.InFlags & ImGuiItemFlags_IsMultiSelect) != 0
 	if !item_add {
-		return false
+		if !is_multi_select || !g.BoxSelectState.UnclipMode || !g.BoxSelectState.UnclipRect.Overlaps(bb) { // Extra layer of "no logic clip" for box-select support
+			return false
+		}
 	}
 
 	var disabled_global = (g.CurrentItemFlags & ImGuiItemFlags_Disabled) != 0
 	if disabled_item && !disabled_global { // Only testing this as an optimization
-		BeginDisabled(true)
+		g.BeginDisabled(true)
 	}
 
 	// FIXME: We can standardize the behavior of those two, we could also keep the fast path of override ClipRect + full push on render only,
 	// which would be advantageous since most selectable are not selected.
 	if span_all_columns && window.DC.CurrentColumns != nil {
-		PushColumnsBackground()
+		g.PushColumnsBackground()
 	} else if span_all_columns && g.CurrentTable != nil {
-		TablePushBackgroundChannel()
+		g.TablePushBackgroundChannel()
 	}
 
 	// We use NoHoldingActiveID on menus so user can click and _hold_ on a menu then drag to browse child entries
@@ -131,40 +133,46 @@ func Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_ar
 	}
 
 	var was_selected = selected
-	var hovered, held bool
-	var pressed = ButtonBehavior(&bb, id, &hovered, &held, button_flags)
-
-	// Auto-select when moved into
-	// - This will be more fully fleshed in the range-select branch
-	// - This is not exposed as it won't nicely work with some user side handling of shift/control
-	// - We cannot do 'if (g.NavJustMovedToId != id) { selected = false; pressed = was_selected; }' for two reasons
-	//   - (1) it would require focus scope to be set, need exposing PushFocusScope() or equivalent (e.g. BeginSelection() calling PushFocusScope())
-	//   - (2) usage will fail with clipped items
-	//   The multi-select API aim to fix those issues, e.g. may be replaced with a BeginSelection() API.
-	if (flags&ImGuiSelectableFlags_SelectOnNav != 0) && g.NavJustMovedToId != 0 && g.NavJustMovedToFocusScopeId == window.DC.NavFocusScopeIdCurrent {
-		if g.NavJustMovedToId == id {
-			selected = true
-			pressed = true
+	var hovered, held, pressed bool
+	if is_multi_select {
+		// Handle multi-select + alter button flags for it
+		g.MultiSelectItemHeader(id, &selected, &button_flags)
+		pressed = g.ButtonBehavior(&bb, id, &hovered, &held, button_flags)
+		g.MultiSelectItemFooter(id, &selected, &pressed)
+	} else {
+		pressed = g.ButtonBehavior(&bb, id, &hovered, &held, button_flags)
+
+		// Auto-select when moved into
+		// - This is not exposed as it won't nicely work with some user side handling of shift/control
+		// - We cannot do 'if (g.NavJustMovedToId != id) { selected = false; pressed = was_selected; }' for two reasons
+		//   - (1) it would require focus scope to be set, need exposing PushFocusScope() or equivalent (e.g. BeginSelection() calling PushFocusScope())
+		//   - (2) usage will fail with clipped items
+		//   BeginMultiSelect() handles both of those.
+		if (flags&ImGuiSelectableFlags_SelectOnNav != 0) && g.NavJustMovedToId != 0 && g.NavJustMovedToFocusScopeId == window.DC.NavFocusScopeIdCurrent {
+			if g.NavJustMovedToId == id {
+				selected = true
+				pressed = true
+			}
 		}
 	}
 
 	// Update NavId when clicking or when Hovering (this doesn't happen on most widgets), so navigation can be resumed with gamepad/keyboard
 	if pressed || (hovered && (flags&ImGuiSelectableFlags_SetNavIdOnHover != 0)) {
 		if !g.NavDisableMouseHover && g.NavWindow == window && g.NavLayer == window.DC.NavLayerCurrent {
-			SetNavID(id, window.DC.NavLayerCurrent, window.DC.NavFocusScopeIdCurrent, &ImRect{bb.Min.Sub(window.Pos), bb.Max.Sub(window.Pos)}) // (bb == NavRect)
+			g.SetNavID(id, window.DC.NavLayerCurrent, window.DC.NavFocusScopeIdCurrent, &ImRect{bb.Min.Sub(window.Pos), bb.Max.Sub(window.Pos)}) // (bb == NavRect)
 			g.NavDisableHighlight = true
 		}
 	}
 	if pressed {
-		MarkItemEdited(id)
+		g.MarkItemEdited(id)
 	}
 
 	if flags&ImGuiSelectableFlags_AllowItemOverlap != 0 {
-		SetItemAllowOverlap()
+		g.SetItemAllowOverlap()
 	}
 
-	// In this branch, Selectable() cannot toggle the selection so this will never trigger.
-	if selected != was_selected { //-V547
+	// Only multi-selection can toggle the selection from within Selectable()
+	if selected != was_selected {
 		g.LastItemData.StatusFlags |= ImGuiItemStatusFlags_ToggledSelection
 	}
 
@@ -180,28 +188,29 @@ func Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_ar
 		case hovered:
 			c = ImGuiCol_HeaderHovered
 		}
-		var col = GetColorU32FromID(c, 1)
-		RenderFrame(bb.Min, bb.Max, col, false, 0.0)
+		var col = g.GetColorU32FromID(c, 1)
+		g.RenderFrame(bb.Min, bb.Max, col, false, 0.0)
 	}
-	RenderNavHighlight(&bb, id, ImGuiNavHighlightFlags_TypeThin|ImGuiNavHighlightFlags_NoRounding)
+	g.RenderNavHighlight(&bb, id, ImGuiNavHighlightFlags_TypeThin|ImGuiNavHighlightFlags_NoRounding)
 
 	if span_all_columns && window.DC.CurrentColumns != nil {
-		PopColumnsBackground()
+		g.PopColumnsBackground()
 	} else if span_all_columns && g.CurrentTable != nil {
-		TablePopBackgroundChannel()
+		g.TablePopBackgroundChannel()
 	}
 
-	RenderTextClipped(&text_min, &text_max, label, &label_size, &style.SelectableTextAlign, &bb)
+	g.RenderTextClipped(&text_min, &text_max, label, &label_size, &style.SelectableTextAlign, &bb)
 
 	// Automatically close popups
 	if pressed && (window.Flags&ImGuiWindowFlags_Popup != 0) && (flags&ImGuiSelectableFlags_DontClosePopups == 0) && (g.LastItemData.InFlags&ImGuiItemFlags_SelectableDontClosePopup == 0) {
-		CloseCurrentPopup()
+		g.CloseCurrentPopup()
 	}
 
 	if disabled_item && !disabled_global {
-		EndDisabled()
+		g.EndDisabled()
 	}
 
+	g.IMGUI_TEST_ENGINE_ITEM_INFO(id, label, g.LastItemData.StatusFlags)
 	return pressed //-V1020
 }
 
@@ -209,8 +218,7 @@ func Selectable(label string, selected bool, flags ImGuiSelectableFlags, size_ar
 // - Prefer using "SetItemDefaultFocus()" over "if (IsWindowAppearing()) SetScrollHereY()" when applicable to signify "this is the default item"
 
 // make last item the default focused item of a window.
-func SetItemDefaultFocus() {
-	var g = GImGui
+func (g *ImGuiContext) SetItemDefaultFocus() {
 	var window = g.CurrentWindow
 	if !window.Appearing {
 		return
@@ -219,17 +227,16 @@ func SetItemDefaultFocus() {
 		g.NavInitRequest = false
 		g.NavInitResultId = g.LastItemData.ID
 		g.NavInitResultRectRel = ImRect{g.LastItemData.Rect.Min.Sub(window.Pos), g.LastItemData.Rect.Max.Sub(window.Pos)}
-		NavUpdateAnyRequestFlag()
-		if !IsItemVisible() {
-			SetScrollHereY(0.5)
+		g.NavUpdateAnyRequestFlag()
+		if !g.IsItemVisible() {
+			g.SetScrollHereY(0.5)
 		}
 	}
 }
 
 // focus keyboard on the next widget. Use positive 'offset' to access sub components of a multiple component widget. Use -1 to access previous widget.
-func SetKeyboardFocusHere(offset int) {
+func (g *ImGuiContext) SetKeyboardFocusHere(offset int) {
 	IM_ASSERT(offset >= -1) // -1 is allowed but not below
-	var g = GImGui
 	var window = g.CurrentWindow
 	g.TabFocusRequestNextWindow = window
 	g.TabFocusRequestNextCounterRegular = window.DC.FocusCounterRegular + 1 + offset
@@ -239,56 +246,34 @@ func SetKeyboardFocusHere(offset int) {
 // Focus Scope (WIP)
 // This is generally used to identify a selection set (multiple of which may be in the same window), as selection
 // patterns generally need to react (e.g. clear selection) when landing on an item of the set.
-func PushFocusScope(id ImGuiID) {
-	var g = GImGui
+func (g *ImGuiContext) PushFocusScope(id ImGuiID) {
 	var window = g.CurrentWindow
 	g.FocusScopeStack = append(g.FocusScopeStack, window.DC.NavFocusScopeIdCurrent)
 	window.DC.NavFocusScopeIdCurrent = id
 }
 
-func PopFocusScope() {
-	var g = GImGui
+func (g *ImGuiContext) PopFocusScope() {
 	var window = g.CurrentWindow
 	IM_ASSERT(len(g.FocusScopeStack) > 0) // Too many PopFocusScope() ?
 	window.DC.NavFocusScopeIdCurrent = g.FocusScopeStack[len(g.FocusScopeStack)-1]
 	g.FocusScopeStack = g.FocusScopeStack[:len(g.FocusScopeStack)-1]
 }
 
-func GetFocusedFocusScope() ImGuiID { var g = GImGui; return g.NavFocusScopeId } // Focus scope which is actually active
-func GetFocusScope() ImGuiID {
-	var g = GImGui
+func (g *ImGuiContext) GetFocusedFocusScope() ImGuiID { return g.NavFocusScopeId } // Focus scope which is actually active
+func (g *ImGuiContext) GetFocusScope() ImGuiID {
 	return g.CurrentWindow.DC.NavFocusScopeIdCurrent
 } // Focus scope we are outputting into, set by PushFocusScope()
 
 // Notifies Dear ImGui when hosting platform windows lose or gain input focus
 func (io *ImGuiIO) AddFocusEvent(focused bool) {
-	if focused {
-		return
-	}
-
-	// Clear buttons state when focus is lost
-	// (this is useful so e.g. releasing Alt after focus loss on Alt-Tab doesn't trigger the Alt menu toggle)
-	io.KeysDown = [len(io.KeysDown)]bool{}
-	for n := range io.KeysDownDuration {
-		io.KeysDownDuration[n] = -1
-		io.KeysDownDurationPrev[n] = -1
-	}
-	io.KeyCtrl = false
-	io.KeyShift = false
-	io.KeyAlt = false
-	io.KeySuper = false
-	io.KeyMods = ImGuiKeyModFlags_None
-	io.KeyModsPrev = ImGuiKeyModFlags_None
-	for n := range io.NavInputsDownDuration {
-		io.NavInputsDownDuration[n] = -1
-		io.NavInputsDownDurationPrev[n] = -1
-	}
+	var e ImGuiInputEvent
+	e.Type = ImGuiInputEventType_Focus
+	e.AppFocused.Focused = focused
+	io.InputEventsQueue = append(io.InputEventsQueue, e)
 }
 
 // Windows: Display Order and Focus Order
-func FocusWindow(window *ImGuiWindow) {
-	var g = GImGui
-
+func (g *ImGuiContext) FocusWindow(window *ImGuiWindow) {
 	if g.NavWindow != window {
 		g.NavWindow = window
 		if window != nil && g.NavDisableMouseHover {
@@ -305,12 +290,12 @@ func FocusWindow(window *ImGuiWindow) {
 		g.NavInitRequest = false
 		g.NavMoveSubmitted = false
 		g.NavMoveScoringItems = false
-		NavUpdateAnyRequestFlag()
+		g.NavUpdateAnyRequestFlag()
 		//IMGUI_DEBUG_LOG("FocusWindow(\"%s\")\n", window ? window.Name : nil);
 	}
 
 	// Close popups if any
-	ClosePopupsOverWindow(window, false)
+	g.ClosePopupsOverWindow(window, false)
 
 	// Move the root window to the top of the pile
 	IM_ASSERT(window == nil || window.RootWindow != nil)
@@ -319,7 +304,7 @@ func FocusWindow(window *ImGuiWindow) {
 	var display_front_window *ImGuiWindow
 	if window != nil {
 		focus_front_window = window.RootWindow
-		display_front_window = window.RootWindow
+		display_front_window = window.RootWindowDockTree
 	}
 
 	// Steal active widgets. Some of the cases it triggers includes:
@@ -327,7 +312,7 @@ func FocusWindow(window *ImGuiWindow) {
 	// - When using Nav to activate menu items (due to timing of activating on press.new window appears.losing ActiveId)
 	if g.ActiveId != 0 && g.ActiveIdWindow != nil && g.ActiveIdWindow.RootWindow != focus_front_window {
 		if !g.ActiveIdNoClearOnFocusLoss {
-			ClearActiveID()
+			g.ClearActiveID()
 		}
 	}
 
@@ -337,14 +322,17 @@ func FocusWindow(window *ImGuiWindow) {
 	}
 
 	// Bring to front
-	BringWindowToFocusFront(focus_front_window)
+	g.BringWindowToFocusFront(focus_front_window)
+	if window.Viewport != nil && window.Viewport.LastFrontMostStampCount != g.ViewportFrontMostStampCount {
+		g.ViewportFrontMostStampCount++
+		window.Viewport.LastFrontMostStampCount = g.ViewportFrontMostStampCount
+	}
 	if ((window.Flags | display_front_window.Flags) & ImGuiWindowFlags_NoBringToFrontOnFocus) == 0 {
-		BringWindowToDisplayFront(display_front_window)
+		g.BringWindowToDisplayFront(display_front_window)
 	}
 }
 
-func BringWindowToFocusFront(window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) BringWindowToFocusFront(window *ImGuiWindow) {
 	IM_ASSERT(window == window.RootWindow)
 
 	var cur_order = window.FocusOrder
@@ -363,8 +351,7 @@ func BringWindowToFocusFront(window *ImGuiWindow) {
 	window.FocusOrder = (short)(new_order)
 }
 
-func BringWindowToDisplayFront(window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) BringWindowToDisplayFront(window *ImGuiWindow) {
 	var current_front_window = g.Windows[len(g.Windows)-1]
 	if current_front_window == window || current_front_window.RootWindow == window { // Cheap early out (could be better)
 		return
@@ -379,12 +366,10 @@ func BringWindowToDisplayFront(window *ImGuiWindow) {
 	}
 }
 
-func FocusTopMostWindowUnderOne(under_this_window *ImGuiWindow, ignore_window *ImGuiWindow) {
-	var g = GImGui
-
+func (g *ImGuiContext) FocusTopMostWindowUnderOne(under_this_window *ImGuiWindow, ignore_window *ImGuiWindow) {
 	var start_idx int
 	if under_this_window != nil {
-		start_idx = FindWindowFocusIndex(under_this_window)
+		start_idx = g.FindWindowFocusIndex(under_this_window)
 	} else {
 		start_idx = int(len(g.WindowsFocusOrder) - 1)
 	}
@@ -396,10 +381,10 @@ func FocusTopMostWindowUnderOne(under_this_window *ImGuiWindow, ignore_window *I
 		if window != ignore_window && window.WasActive {
 			if (window.Flags & (ImGuiWindowFlags_NoMouseInputs | ImGuiWindowFlags_NoNavInputs)) != (ImGuiWindowFlags_NoMouseInputs | ImGuiWindowFlags_NoNavInputs) {
 				var focus_window = NavRestoreLastChildNavWindow(window)
-				FocusWindow(focus_window)
+				g.FocusWindow(focus_window)
 				return
 			}
 		}
 	}
-	FocusWindow(nil)
+	g.FocusWindow(nil)
 }
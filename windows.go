@@ -13,19 +13,18 @@ func IsWindowActiveAndVisible(window *ImGuiWindow) bool {
 }
 
 // set next window background color alpha. helper to easily override the Alpha component of ImGuiCol_WindowBg/ChildBg/PopupBg. you may also use ImGuiWindowFlags_NoBackground.
-func SetNextWindowBgAlpha(alpha float) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowBgAlpha(alpha float) {
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasBgAlpha
 	g.NextWindowData.BgAlphaVal = alpha
 }
 
 // Layer is locked for the root window, however child windows may use a different viewport (e.g. extruding menu)
-func AddRootWindowToDrawData(window *ImGuiWindow) {
+func (g *ImGuiContext) AddRootWindowToDrawData(window *ImGuiWindow) {
 	var layer int
 	if window.Flags&ImGuiWindowFlags_Tooltip != 0 {
 		layer = 1
 	}
-	AddWindowToDrawData(window, layer)
+	g.AddWindowToDrawData(window, layer)
 }
 
 // Window has already passed the IsWindowNavFocusable()
@@ -39,15 +38,14 @@ func GetFallbackWindowNameForWindowingList(window *ImGuiWindow) string {
 	return "(Untitled)"
 }
 
-func AddWindowToDrawData(window *ImGuiWindow, layer int) {
-	var g = GImGui
-	var viewport = g.Viewports[0]
+func (g *ImGuiContext) AddWindowToDrawData(window *ImGuiWindow, layer int) {
+	var viewport = window.Viewport
 	g.IO.MetricsRenderWindows++
 	AddDrawListToDrawData(&viewport.DrawDataBuilder[layer], window.DrawList)
 	for i := range window.DC.ChildWindows {
 		var child = window.DC.ChildWindows[i]
 		if IsWindowActiveAndVisible(child) { // Clipped children may have been marked not active
-			AddWindowToDrawData(child, layer)
+			g.AddWindowToDrawData(child, layer)
 		}
 	}
 }
@@ -81,8 +79,7 @@ func AddWindowToSortBuffer(out_sorted_windows *[]*ImGuiWindow, window *ImGuiWind
 	}
 }
 
-func FindWindowByID(id ImGuiID) *ImGuiWindow {
-	var g = GImGui
+func (g *ImGuiContext) FindWindowByID(id ImGuiID) *ImGuiWindow {
 	ptr := g.WindowsById.GetInterface(id)
 	if ptr == nil {
 		return nil
@@ -90,13 +87,12 @@ func FindWindowByID(id ImGuiID) *ImGuiWindow {
 	return ptr.(*ImGuiWindow)
 }
 
-func FindWindowByName(e string) *ImGuiWindow {
+func (g *ImGuiContext) FindWindowByName(e string) *ImGuiWindow {
 	var id = ImHashStr(e, 0, 0)
-	return FindWindowByID(id)
+	return g.FindWindowByID(id)
 }
 
-func SetCurrentWindow(window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) SetCurrentWindow(window *ImGuiWindow) {
 	g.CurrentWindow = window
 	if window != nil && window.DC.CurrentTableIdx != -1 {
 		g.CurrentTable = g.Tables[uint(window.DC.CurrentTableIdx)]
@@ -156,8 +152,7 @@ func setWindowSize(window *ImGuiWindow, size *ImVec2, cond ImGuiCond) {
 }
 
 // set next window size. set axis to 0.0 to force an auto-fit on this axis. call before Begin()
-func SetNextWindowSize(size *ImVec2, cond ImGuiCond) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowSize(size *ImVec2, cond ImGuiCond) {
 	IM_ASSERT(cond == 0 || ImIsPowerOfTwoInt(int(cond))) // Make sure the user doesn't attempt to combine multiple condition flags.
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasSize
 	g.NextWindowData.SizeVal = *size
@@ -173,28 +168,28 @@ func SetWindowConditionAllowFlags(window *ImGuiWindow, flags ImGuiCond, enabled
 		window.SetWindowPosAllowFlags |= flags
 		window.SetWindowSizeAllowFlags |= flags
 		window.SetWindowCollapsedAllowFlags |= flags
+		window.SetWindowDockAllowFlags |= flags
 	} else {
 		window.SetWindowPosAllowFlags &= ^flags
 		window.SetWindowSizeAllowFlags &= ^flags
 		window.SetWindowCollapsedAllowFlags &= ^flags
+		window.SetWindowDockAllowFlags &= ^flags
 	}
 }
 
-func CreateNewWindow(name string, flags ImGuiWindowFlags) *ImGuiWindow {
-	var g = GImGui
-
+func (g *ImGuiContext) CreateNewWindow(name string, flags ImGuiWindowFlags) *ImGuiWindow {
 	// Create window the first time
 	var window = NewImGuiWindow(g, name)
 	window.Flags = flags
 	g.WindowsById.SetInterface(window.ID, window)
 
 	// Default/arbitrary window position. Use SetNextWindowPos() with the appropriate condition flag to change the initial position of a window.
-	var main_viewport = GetMainViewport()
+	var main_viewport = g.GetMainViewport()
 	window.Pos = main_viewport.Pos.Add(ImVec2{60, 60})
 
 	// User can disable loading and saving of settings. Tooltip and child windows also don't store settings.
 	if flags&ImGuiWindowFlags_NoSavedSettings == 0 {
-		if settings := FindWindowSettings(window.ID); settings != nil {
+		if settings := g.FindWindowSettings(window.ID); settings != nil {
 			// Retrieve settings from .ini file
 			for i := range g.SettingsWindows {
 				if &g.SettingsWindows[i] == settings {
@@ -247,8 +242,7 @@ func GetWindowBgColorIdxFromFlags(flags ImGuiWindowFlags) ImGuiCol {
 	return ImGuiCol_WindowBg
 }
 
-func CalcWindowAutoFitSize(window *ImGuiWindow, size_contents *ImVec2) ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) CalcWindowAutoFitSize(window *ImGuiWindow, size_contents *ImVec2) ImVec2 {
 	var style = g.Style
 	var decoration_up_height = window.TitleBarHeight() + window.MenuBarHeight()
 	var size_pad = window.WindowPadding.Scale(2)
@@ -266,13 +260,13 @@ func CalcWindowAutoFitSize(window *ImGuiWindow, size_contents *ImVec2) ImVec2 {
 		}
 
 		// FIXME-VIEWPORT-WORKAREA: May want to use GetWorkSize() instead of Size depending on the type of windows?
-		var avail_size = GetMainViewport().Size
+		var avail_size = g.GetMainViewport().Size
 		var s = avail_size.Sub(style.DisplaySafeAreaPadding.Scale(2.0))
 		var size_auto_fit = ImClampVec2(&size_desired, &size_min, ImMaxVec2(&size_min, &s))
 
 		// When the window cannot fit all contents (either because of constraints, either because screen is too small),
 		// we are growing the size on the other axis to compensate for expected scrollbar. FIXME: Might turn bigger than ViewportSize-WindowPadding.
-		var size_auto_fit_after_constraint = CalcWindowSizeAfterConstraint(window, &size_auto_fit)
+		var size_auto_fit_after_constraint = g.CalcWindowSizeAfterConstraint(window, &size_auto_fit)
 		var will_have_scrollbar_x = (size_auto_fit_after_constraint.x-size_pad.x-0.0 < size_contents.x && (window.Flags&ImGuiWindowFlags_NoScrollbar == 0) && (window.Flags&ImGuiWindowFlags_HorizontalScrollbar != 0)) || (window.Flags&ImGuiWindowFlags_AlwaysHorizontalScrollbar != 0)
 		var will_have_scrollbar_y = (size_auto_fit_after_constraint.y-size_pad.y-decoration_up_height < size_contents.y && (window.Flags&ImGuiWindowFlags_NoScrollbar == 0) || (window.Flags&ImGuiWindowFlags_AlwaysVerticalScrollbar != 0))
 		if will_have_scrollbar_x {
@@ -285,8 +279,7 @@ func CalcWindowAutoFitSize(window *ImGuiWindow, size_contents *ImVec2) ImVec2 {
 	}
 }
 
-func ClampWindowRect(window *ImGuiWindow, visibility_rect *ImRect) {
-	var g = GImGui
+func (g *ImGuiContext) ClampWindowRect(window *ImGuiWindow, visibility_rect *ImRect) {
 	var size_for_clamping = window.Size
 	if g.IO.ConfigWindowsMoveFromTitleBarOnly && window.Flags&ImGuiWindowFlags_NoTitleBar == 0 {
 		size_for_clamping.y = window.TitleBarHeight()
@@ -295,8 +288,7 @@ func ClampWindowRect(window *ImGuiWindow, visibility_rect *ImRect) {
 	window.Pos = ImClampVec2(&window.Pos, &sub, visibility_rect.Max)
 }
 
-func CalcWindowSizeAfterConstraint(window *ImGuiWindow, size_desired *ImVec2) ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) CalcWindowSizeAfterConstraint(window *ImGuiWindow, size_desired *ImVec2) ImVec2 {
 	var new_size = *size_desired
 	if (g.NextWindowData.Flags & ImGuiNextWindowDataFlags_HasSizeConstraint) != 0 {
 		// Using -1,-1 on either X/Y axis to preserve the current size.
@@ -372,8 +364,7 @@ func CalcWindowContentSizes(window *ImGuiWindow, content_size_current, content_s
 //    returned true. Begin and BeginChild are the only odd ones out. Will be fixed in a future update.]
 // - Note that the bottom of window stack always contains a window called "Debug".
 
-func End() {
-	var g = GImGui
+func (g *ImGuiContext) End() {
 	var window = g.CurrentWindow
 
 	window.StateStorage = window.DC.StateStorage
@@ -386,19 +377,19 @@ func End() {
 	IM_ASSERT(len(g.CurrentWindowStack) > 0)
 
 	// Error checking: verify that user doesn't directly call End() on a child window.
-	if window.Flags&ImGuiWindowFlags_ChildWindow != 0 {
+	if window.Flags&ImGuiWindowFlags_ChildWindow != 0 && !window.DockIsActive && window.Flags&ImGuiWindowFlags_DockNodeHost == 0 {
 		IM_ASSERT_USER_ERROR(g.WithinEndChild, "Must call EndChild() and not End()!")
 	}
 
 	// Close anything that is open
 	if window.DC.CurrentColumns != nil {
-		EndColumns()
+		g.EndColumns()
 	}
-	PopClipRect() // Inner window clip rectangle
+	g.PopClipRect() // Inner window clip rectangle
 
 	// Stop logging
 	if window.Flags&ImGuiWindowFlags_ChildWindow == 0 { // FIXME: add more options for scope of logging
-		LogFinish()
+		g.LogFinish()
 	}
 
 	// Pop from window stack
@@ -407,22 +398,23 @@ func End() {
 	if window.Flags&ImGuiWindowFlags_Popup != 0 {
 		g.BeginPopupStack = g.BeginPopupStack[:len(g.BeginPopupStack)-1]
 	}
-	window.DC.StackSizesOnBegin.CompareWithCurrentState()
+	window.DC.StackSizesOnBegin.CompareWithCurrentState(g)
 
 	var current *ImGuiWindow
 	if len(g.CurrentWindowStack) > 0 {
 		current = g.CurrentWindowStack[len(g.CurrentWindowStack)-1].Window
 	}
-	SetCurrentWindow(current)
+	g.SetCurrentWindow(current)
+	if current != nil {
+		g.SetCurrentViewport(current, current.Viewport)
+	}
 }
 
 // Find window given position, search front-to-back
 // FIXME: Note that we have an inconsequential lag here: OuterRectClipped is updated in Begin(), so windows moved programmatically
 // with SetWindowPos() and not SetNextWindowPos() will have that rectangle lagging by a frame at the time FindHoveredWindow() is
 // called, aka before the next Begin(). Moving window isn't affected.
-func FindHoveredWindow() {
-	var g = GImGui
-
+func (g *ImGuiContext) FindHoveredWindow() {
 	var hovered_window *ImGuiWindow = nil
 	var hovered_window_ignoring_moving_window *ImGuiWindow = nil
 	if g.MovingWindow != nil && (g.MovingWindow.Flags&ImGuiWindowFlags_NoMouseInputs == 0) {
@@ -446,6 +438,10 @@ func FindHoveredWindow() {
 		if window.Flags&ImGuiWindowFlags_NoMouseInputs != 0 {
 			continue
 		}
+		IM_ASSERT(window.Viewport != nil)
+		if window.Viewport != g.MouseViewport {
+			continue
+		}
 
 		// Using the clipped AABB, a child window will typically be clipped by its parent (not always)
 		var bb = ImRect(window.OuterRectClipped)
@@ -471,7 +467,7 @@ func FindHoveredWindow() {
 		if hovered_window == nil {
 			hovered_window = window
 		}
-		if hovered_window_ignoring_moving_window == nil && (g.MovingWindow == nil || window.RootWindow != g.MovingWindow.RootWindow) {
+		if hovered_window_ignoring_moving_window == nil && (g.MovingWindow == nil || window.RootWindowDockTree != g.MovingWindow.RootWindowDockTree) {
 			hovered_window_ignoring_moving_window = window
 		}
 		if hovered_window != nil && hovered_window_ignoring_moving_window != nil {
@@ -484,9 +480,8 @@ func FindHoveredWindow() {
 }
 
 // The reason this is exposed in imgui_internal.h is: on touch-based system that don't have hovering, we want to dispatch inputs to the right target (imgui vs imgui+app)
-func UpdateHoveredWindowAndCaptureFlags() {
-	var g = GImGui
-	var io = g.IO
+func (g *ImGuiContext) UpdateHoveredWindowAndCaptureFlags() {
+	var io = &g.IO
 	g.WindowsHoverPadding = ImMaxVec2(&g.Style.TouchExtraPadding, &ImVec2{WINDOWS_HOVER_PADDING, WINDOWS_HOVER_PADDING})
 
 	// Find the window hovered by mouse:
@@ -494,10 +489,10 @@ func UpdateHoveredWindowAndCaptureFlags() {
 	// - When moving a window we can skip the search, which also conveniently bypasses the fact that window.WindowRectClipped is lagging as this point of the frame.
 	// - We also support the moved window toggling the NoInputs flag after moving has started in order to be able to detect windows below it, which is useful for e.g. docking mechanisms.
 	var clear_hovered_windows = false
-	FindHoveredWindow()
+	g.FindHoveredWindow()
 
 	// Modal windows prevents mouse from hovering behind them.
-	var modal_window = GetTopMostPopupModal()
+	var modal_window = g.GetTopMostPopupModal()
 	if modal_window != nil && g.HoveredWindow != nil && !IsWindowChildOf(g.HoveredWindow.RootWindow, modal_window) {
 		clear_hovered_windows = true
 	}
@@ -573,31 +568,30 @@ func UpdateHoveredWindowAndCaptureFlags() {
 // FIXME: We don't have strong guarantee that g.MovingWindow stay synched with g.ActiveId == g.MovingWindow.MoveId.
 // This is currently enforced by the fact that BeginDragDropSource() is setting all g.ActiveIdUsingXXXX flags to inhibit navigation inputs,
 // but if we should more thoroughly test cases where g.ActiveId or g.MovingWindow gets changed and not the other.
-func UpdateMouseMovingWindowNewFrame() {
-	var g = GImGui
+func (g *ImGuiContext) UpdateMouseMovingWindowNewFrame() {
 	if g.MovingWindow != nil {
 		// We actually want to move the root window. g.MovingWindow == window we clicked on (could be a child window).
 		// We track it to preserve Focus and so that generally ActiveIdWindow == MovingWindow and ActiveId == MovingWindow.MoveId for consistency.
-		KeepAliveID(g.ActiveId)
-		IM_ASSERT(g.MovingWindow != nil && g.MovingWindow.RootWindow != nil)
-		var moving_window = g.MovingWindow.RootWindow
-		if g.IO.MouseDown[0] && IsMousePosValid(&g.IO.MousePos) {
+		g.KeepAliveID(g.ActiveId)
+		IM_ASSERT(g.MovingWindow != nil && g.MovingWindow.RootWindowDockTree != nil)
+		var moving_window = g.MovingWindow.RootWindowDockTree
+		if g.IO.MouseDown[0] && g.IsMousePosValid(&g.IO.MousePos) {
 			var pos = g.IO.MousePos.Sub(g.ActiveIdClickOffset)
 			if moving_window.Pos.x != pos.x || moving_window.Pos.y != pos.y {
-				MarkIniSettingsDirtyWindow(moving_window)
+				g.MarkIniSettingsDirtyWindow(moving_window)
 				setWindowPos(moving_window, &pos, ImGuiCond_Always)
 			}
-			FocusWindow(g.MovingWindow)
+			g.FocusWindow(g.MovingWindow)
 		} else {
 			g.MovingWindow = nil
-			ClearActiveID()
+			g.ClearActiveID()
 		}
 	} else {
 		// When clicking/dragging from a window that has the _NoMove flag, we still set the ActiveId in order to prevent hovering others.
 		if g.ActiveIdWindow != nil && g.ActiveIdWindow.MoveId == g.ActiveId {
-			KeepAliveID(g.ActiveId)
+			g.KeepAliveID(g.ActiveId)
 			if !g.IO.MouseDown[0] {
-				ClearActiveID()
+				g.ClearActiveID()
 			}
 		}
 	}
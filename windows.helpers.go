@@ -7,12 +7,10 @@ import "unsafe"
 // If this ever crash because g.CurrentWindow is NULL it means that either
 // - ImGui::NewFrame() has never been called, which is illegal.
 // - You are calling ImGui functions after ImGui::EndFrame()/ImGui::Render() and before the next ImGui::NewFrame(), which is also illegal.
-func GetCurrentWindowRead() *ImGuiWindow {
-	var g = GImGui
+func (g *ImGuiContext) GetCurrentWindowRead() *ImGuiWindow {
 	return g.CurrentWindow
 }
-func GetCurrentWindow() *ImGuiWindow {
-	var g = GImGui
+func (g *ImGuiContext) GetCurrentWindow() *ImGuiWindow {
 	g.CurrentWindow.WriteAccessed = true
 	return g.CurrentWindow
 }
@@ -20,10 +18,15 @@ func GetCurrentWindow() *ImGuiWindow {
 func UpdateWindowParentAndRootLinks(window *ImGuiWindow, flags ImGuiWindowFlags, parent_window *ImGuiWindow) {
 	window.ParentWindow = parent_window
 	window.RootWindow = window
+	window.RootWindowDockTree = window
 	window.RootWindowForTitleBarHighlight = window
 	window.RootWindowForNav = window
 	if parent_window != nil && (flags&ImGuiWindowFlags_ChildWindow != 0) && flags&ImGuiWindowFlags_Tooltip == 0 {
-		window.RootWindow = parent_window.RootWindow
+		window.RootWindowDockTree = parent_window.RootWindowDockTree
+		// Docked windows are their own root, while RootWindowDockTree crosses through dock nodes
+		if !window.DockIsActive && parent_window.Flags&ImGuiWindowFlags_DockNodeHost == 0 {
+			window.RootWindow = parent_window.RootWindow
+		}
 	}
 	if parent_window != nil && flags&ImGuiWindowFlags_Modal == 0 && (flags&(ImGuiWindowFlags_ChildWindow|ImGuiWindowFlags_Popup) != 0) {
 		window.RootWindowForTitleBarHighlight = parent_window.RootWindowForTitleBarHighlight
@@ -34,12 +37,12 @@ func UpdateWindowParentAndRootLinks(window *ImGuiWindow, flags ImGuiWindowFlags,
 	}
 }
 
-func CalcWindowNextAutoFitSize(window *ImGuiWindow) ImVec2 {
+func (g *ImGuiContext) CalcWindowNextAutoFitSize(window *ImGuiWindow) ImVec2 {
 	var size_contents_current ImVec2
 	var size_contents_ideal ImVec2
 	CalcWindowContentSizes(window, &size_contents_current, &size_contents_ideal)
-	var size_auto_fit = CalcWindowAutoFitSize(window, &size_contents_ideal)
-	var size_final = CalcWindowSizeAfterConstraint(window, &size_auto_fit)
+	var size_auto_fit = g.CalcWindowAutoFitSize(window, &size_contents_ideal)
+	var size_final = g.CalcWindowSizeAfterConstraint(window, &size_auto_fit)
 	return size_final
 }
 
@@ -56,8 +59,7 @@ func IsWindowChildOf(window *ImGuiWindow, potential_parent *ImGuiWindow) bool {
 	return false
 }
 
-func IsWindowAbove(potential_above *ImGuiWindow, potential_below *ImGuiWindow) bool {
-	var g = GImGui
+func (g *ImGuiContext) IsWindowAbove(potential_above *ImGuiWindow, potential_below *ImGuiWindow) bool {
 	for i := len(g.Windows) - 1; i >= 0; i-- {
 		var candidate_window = g.Windows[i]
 		if candidate_window == potential_above {
@@ -77,8 +79,7 @@ func SetWindowHitTestHole(window *ImGuiWindow, pos *ImVec2, size *ImVec2) {
 	window.HitTestHoleOffset = ImVec2ih{int16(diff.x), int16(diff.y)}
 }
 
-func BringWindowToDisplayBack(window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) BringWindowToDisplayBack(window *ImGuiWindow) {
 	if g.Windows[0] == window {
 		return
 	}
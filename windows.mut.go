@@ -4,8 +4,7 @@ package imgui
 // - Prefer using SetNextXXX functions (before Begin) rather that SetXXX functions (after Begin).
 
 // set next window position. call before Begin(). use pivot=(0.5,0.5) to center on given point, etc.
-func SetNextWindowPos(pos *ImVec2, cond ImGuiCond, pivot ImVec2) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowPos(pos *ImVec2, cond ImGuiCond, pivot ImVec2) {
 	IM_ASSERT(cond == 0 || ImIsPowerOfTwoInt(int(cond))) // Make sure the user doesn't attempt to combine multiple condition flags.
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasPos
 	g.NextWindowData.PosVal = *pos
@@ -18,8 +17,7 @@ func SetNextWindowPos(pos *ImVec2, cond ImGuiCond, pivot ImVec2) {
 }
 
 // set next window size limits. use -1,-1 on either X/Y axis to preserve the current size. Sizes will be rounded down. Use callback to apply non-trivial programmatic constraints.
-func SetNextWindowSizeConstraints(size_min ImVec2, size_max ImVec2, custom_callback ImGuiSizeCallback, custom_callback_data any) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowSizeConstraints(size_min ImVec2, size_max ImVec2, custom_callback ImGuiSizeCallback, custom_callback_data any) {
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasSizeConstraint
 	g.NextWindowData.SizeConstraintRect = ImRect{size_min, size_max}
 	g.NextWindowData.SizeCallback = custom_callback
@@ -29,15 +27,13 @@ func SetNextWindowSizeConstraints(size_min ImVec2, size_max ImVec2, custom_callb
 // Content size = inner scrollable rectangle, padded with WindowPadding.
 // SetNextWindowContentSize(ImVec2(100,100) + ImGuiWindowFlags_AlwaysAutoResize will always allow submitting a 100x100 item.
 // set next window content size (~ scrollable client area, which enforce the range of scrollbars). Not including window decorations (title bar, menu bar, etc.) nor WindowPadding. set an axis to 0.0 to leave it automatic. call before Begin()
-func SetNextWindowContentSize(size ImVec2) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowContentSize(size ImVec2) {
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasContentSize
 	g.NextWindowData.ContentSizeVal = *ImFloorVec(&size)
 }
 
 // set next window collapsed state. call before Begin()
-func SetNextWindowCollapsed(collapsed bool, cond ImGuiCond) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowCollapsed(collapsed bool, cond ImGuiCond) {
 	IM_ASSERT(cond == 0 || ImIsPowerOfTwoInt(int(cond))) // Make sure the user doesn't attempt to combine multiple condition flags.
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasCollapsed
 	g.NextWindowData.CollapsedVal = collapsed
@@ -49,20 +45,19 @@ func SetNextWindowCollapsed(collapsed bool, cond ImGuiCond) {
 }
 
 // set next window to be focused / top-most. call before Begin()
-func SetNextWindowFocus() {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowFocus() {
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasFocus
 }
 
 // (not recommended) set current window position - call within Begin()/End(). prefer using SetNextWindowPos(), as this may incur tearing and side-effects.
-func SetWindowPos(pos ImVec2, cond ImGuiCond) {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) SetWindowPos(pos ImVec2, cond ImGuiCond) {
+	var window = g.GetCurrentWindowRead()
 	setWindowPos(window, &pos, cond)
 }
 
 // (not recommended) set current window size - call within Begin()/End(). set to ImVec2(0, 0) to force an auto-fit. prefer using SetNextWindowSize(), as this may incur tearing and minor side-effects.
-func SetWindowSize(size ImVec2, cond ImGuiCond) {
-	setWindowSize(GImGui.CurrentWindow, &size, cond)
+func (g *ImGuiContext) SetWindowSize(size ImVec2, cond ImGuiCond) {
+	setWindowSize(g.CurrentWindow, &size, cond)
 }
 
 func setWindowCollapsed(window *ImGuiWindow, collapsed bool, cond ImGuiCond) {
@@ -77,20 +72,19 @@ func setWindowCollapsed(window *ImGuiWindow, collapsed bool, cond ImGuiCond) {
 }
 
 // (not recommended) set current window collapsed state. prefer using SetNextWindowCollapsed().
-func SetWindowCollapsed(collapsed bool, cond ImGuiCond) {
-	setWindowCollapsed(GImGui.CurrentWindow, collapsed, cond)
+func (g *ImGuiContext) SetWindowCollapsed(collapsed bool, cond ImGuiCond) {
+	setWindowCollapsed(g.CurrentWindow, collapsed, cond)
 }
 
 // (not recommended) set current window to be focused / top-most. prefer using SetNextWindowFocus().
-func SetWindowFocus() {
-	FocusWindow(GImGui.CurrentWindow)
+func (g *ImGuiContext) SetWindowFocus() {
+	g.FocusWindow(g.CurrentWindow)
 }
 
 // [OBSOLETE] set font scale. Adjust IO.FontGlobalScale if you want to scale all windows. This is an old API! For correct scaling, prefer to reload font + rebuild ImFontAtlas + call style.ScaleAllSizes().
-func SetWindowFontScale(scale float) {
+func (g *ImGuiContext) SetWindowFontScale(scale float) {
 	IM_ASSERT(scale > 0.0)
-	var g = GImGui
-	var window = GetCurrentWindow()
+	var window = g.GetCurrentWindow()
 	window.FontWindowScale = scale
 	calculated := window.CalcFontSize()
 	g.FontSize = calculated
@@ -98,33 +92,33 @@ func SetWindowFontScale(scale float) {
 }
 
 // set named window position.
-func SetNamedWindowPos(name string, pos ImVec2, cond ImGuiCond) {
-	if window := FindWindowByName(name); window != nil {
+func (g *ImGuiContext) SetNamedWindowPos(name string, pos ImVec2, cond ImGuiCond) {
+	if window := g.FindWindowByName(name); window != nil {
 		setWindowPos(window, &pos, cond)
 	}
 }
 
 // set named window size. set axis to 0.0 to force an auto-fit on this axis.
-func SetNamedWindowSize(name string, size ImVec2, cond ImGuiCond) {
-	if window := FindWindowByName(name); window != nil {
+func (g *ImGuiContext) SetNamedWindowSize(name string, size ImVec2, cond ImGuiCond) {
+	if window := g.FindWindowByName(name); window != nil {
 		setWindowSize(window, &size, cond)
 	}
 }
 
 // set named window collapsed state
-func SetNamedWindowCollapsed(name string, collapsed bool, cond ImGuiCond) {
-	if window := FindWindowByName(name); window != nil {
+func (g *ImGuiContext) SetNamedWindowCollapsed(name string, collapsed bool, cond ImGuiCond) {
+	if window := g.FindWindowByName(name); window != nil {
 		setWindowCollapsed(window, collapsed, cond)
 	}
 }
 
 // set named window to be focused / top-most. use NULL to remove focus.
-func SetNamedWindowFocus(name string) {
+func (g *ImGuiContext) SetNamedWindowFocus(name string) {
 	if name != "" {
-		if window := FindWindowByName(name); window != nil {
-			FocusWindow(window)
+		if window := g.FindWindowByName(name); window != nil {
+			g.FocusWindow(window)
 		}
 	} else {
-		FocusWindow(nil)
+		g.FocusWindow(nil)
 	}
 }
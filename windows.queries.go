@@ -3,13 +3,13 @@ package imgui
 // Windows Utilities
 // - 'current window' = the window we are appending into while inside a Begin()/End() block. 'next window' = next window we will Begin() into.
 
-func IsWindowAppearing() bool {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) IsWindowAppearing() bool {
+	var window = g.GetCurrentWindowRead()
 	return window.Appearing
 }
 
-func IsWindowCollapsed() bool {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) IsWindowCollapsed() bool {
+	var window = g.GetCurrentWindowRead()
 	return window.Collapsed
 }
 
@@ -21,9 +21,7 @@ func IsWindowNavFocusable(window *ImGuiWindow) bool {
 }
 
 // is current window focused? or its root/child, depending on flags. see flags for options.
-func IsWindowFocused(flags ImGuiFocusedFlags) bool {
-	var g = GImGui
-
+func (g *ImGuiContext) IsWindowFocused(flags ImGuiFocusedFlags) bool {
 	if flags&ImGuiFocusedFlags_AnyWindow != 0 {
 		return g.NavWindow != nil
 	}
@@ -42,9 +40,8 @@ func IsWindowFocused(flags ImGuiFocusedFlags) bool {
 }
 
 // is current window hovered (and typically: not blocked by a popup/modal)? see flags for options. NB: If you are trying to check whether your mouse should be dispatched to imgui or to your app, you should use the 'io.WantCaptureMouse' boolean for that! Please read the FAQ!
-func IsWindowHovered(flags ImGuiHoveredFlags) bool {
+func (g *ImGuiContext) IsWindowHovered(flags ImGuiHoveredFlags) bool {
 	IM_ASSERT((flags & ImGuiHoveredFlags_AllowWhenOverlapped) == 0) // Flags not supported by this function
-	var g = GImGui
 	if g.HoveredWindow == nil {
 		return false
 	}
@@ -71,7 +68,7 @@ func IsWindowHovered(flags ImGuiHoveredFlags) bool {
 		}
 	}
 
-	if !IsWindowContentHoverable(g.HoveredWindow, flags) {
+	if !g.IsWindowContentHoverable(g.HoveredWindow, flags) {
 		return false
 	}
 	if flags&ImGuiHoveredFlags_AllowWhenBlockedByActiveItem == 0 {
@@ -83,32 +80,31 @@ func IsWindowHovered(flags ImGuiHoveredFlags) bool {
 }
 
 // get draw list associated to the current window, to append your own drawing primitives
-func GetWindowDrawList() *ImDrawList {
-	var window = GetCurrentWindow()
+func (g *ImGuiContext) GetWindowDrawList() *ImDrawList {
+	var window = g.GetCurrentWindow()
 	return window.DrawList
 }
 
 // get current window position in screen space (useful if you want to do your own drawing via the DrawList API)
-func GetWindowPos() ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) GetWindowPos() ImVec2 {
 	var window = g.CurrentWindow
 	return window.Pos
 }
 
 // get current window size
-func GetWindowSize() ImVec2 {
-	var window = GetCurrentWindowRead()
+func (g *ImGuiContext) GetWindowSize() ImVec2 {
+	var window = g.GetCurrentWindowRead()
 	return window.Size
 }
 
 // get current window width (shortcut for GetWindowSize().x)
-func GetWindowWidth() float {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetWindowWidth() float {
+	var window = g.CurrentWindow
 	return window.Size.x
 }
 
 // get current window height (shortcut for GetWindowSize().y)
-func GetWindowHeight() float {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetWindowHeight() float {
+	var window = g.CurrentWindow
 	return window.Size.y
 }
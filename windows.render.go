@@ -1,21 +1,19 @@
 package imgui
 
 // Render a rectangle shaped with optional rounding and borders
-func RenderFrame(p_min ImVec2, p_max ImVec2, fill_col ImU32, border bool /*= true*/, rounding float) {
-	var g = GImGui
+func (g *ImGuiContext) RenderFrame(p_min ImVec2, p_max ImVec2, fill_col ImU32, border bool /*= true*/, rounding float) {
 	var window = g.CurrentWindow
 	window.DrawList.AddRectFilled(p_min, p_max, fill_col, rounding, 0)
 	var border_size = g.Style.FrameBorderSize
 	if border && border_size > 0.0 {
-		window.DrawList.AddRect(p_min.Add(ImVec2{1, 1}), p_max.Add(ImVec2{1, 1}), GetColorU32FromID(ImGuiCol_BorderShadow, 1), rounding, 0, border_size)
-		window.DrawList.AddRect(p_min, p_max, GetColorU32FromID(ImGuiCol_Border, 1), rounding, 0, border_size)
+		window.DrawList.AddRect(p_min.Add(ImVec2{1, 1}), p_max.Add(ImVec2{1, 1}), g.GetColorU32FromID(ImGuiCol_BorderShadow, 1), rounding, 0, border_size)
+		window.DrawList.AddRect(p_min, p_max, g.GetColorU32FromID(ImGuiCol_Border, 1), rounding, 0, border_size)
 	}
 }
 
 // Draw background and borders
 // Draw and handle scrollbars
-func RenderWindowDecorations(window *ImGuiWindow, title_bar_rect *ImRect, title_bar_is_highlight bool, resize_grip_count int, resize_grip_col [4]ImU32, resize_grip_draw_size float) {
-	var g = GImGui
+func (g *ImGuiContext) RenderWindowDecorations(window *ImGuiWindow, title_bar_rect *ImRect, title_bar_is_highlight bool, resize_grip_count int, resize_grip_col [4]ImU32, resize_grip_draw_size float) {
 	var style = g.Style
 	var flags = window.Flags
 
@@ -33,16 +31,20 @@ func RenderWindowDecorations(window *ImGuiWindow, title_bar_rect *ImRect, title_
 		g.Style.FrameBorderSize = window.WindowBorderSize
 		var title_bar_col ImU32
 		if title_bar_is_highlight && !g.NavDisableHighlight {
-			title_bar_col = GetColorU32FromID(ImGuiCol_TitleBgActive, 1)
+			title_bar_col = g.GetColorU32FromID(ImGuiCol_TitleBgActive, 1)
 		} else {
-			title_bar_col = GetColorU32FromID(ImGuiCol_TitleBgCollapsed, 1)
+			title_bar_col = g.GetColorU32FromID(ImGuiCol_TitleBgCollapsed, 1)
 		}
-		RenderFrame(title_bar_rect.Min, title_bar_rect.Max, title_bar_col, true, window_rounding)
+		g.RenderFrame(title_bar_rect.Min, title_bar_rect.Max, title_bar_col, true, window_rounding)
 		g.Style.FrameBorderSize = backup_border_size
 	} else {
 		// Window background
 		if flags&ImGuiWindowFlags_NoBackground == 0 {
-			var bg_col = GetColorU32FromID(GetWindowBgColorIdxFromFlags(flags), 1)
+			var bg_col_idx = GetWindowBgColorIdxFromFlags(flags)
+			if window.DockIsActive {
+				bg_col_idx = ImGuiCol_WindowBg // Docked windows are child windows of their dock node host, but still look like regular windows
+			}
+			var bg_col = g.GetColorU32FromID(bg_col_idx, 1)
 
 			var override_alpha = false
 			var alpha float = 1.0
@@ -64,9 +66,9 @@ func RenderWindowDecorations(window *ImGuiWindow, title_bar_rect *ImRect, title_
 
 		// Title bar
 		if flags&ImGuiWindowFlags_NoTitleBar == 0 {
-			var title_bar_col = GetColorU32FromID(ImGuiCol_TitleBg, 1)
+			var title_bar_col = g.GetColorU32FromID(ImGuiCol_TitleBg, 1)
 			if title_bar_is_highlight {
-				title_bar_col = GetColorU32FromID(ImGuiCol_TitleBgActive, 1)
+				title_bar_col = g.GetColorU32FromID(ImGuiCol_TitleBgActive, 1)
 			}
 			//fmt.Printf("render title bar %X", title_bar_col)
 			window.DrawList.AddRectFilled(title_bar_rect.Min, title_bar_rect.Max, title_bar_col, window_rounding, ImDrawFlags_RoundCornersTop)
@@ -82,19 +84,19 @@ func RenderWindowDecorations(window *ImGuiWindow, title_bar_rect *ImRect, title_
 				round = 0
 			}
 
-			window.DrawList.AddRectFilled(menu_bar_rect.Min.Add(ImVec2{window_border_size, 0}), menu_bar_rect.Max.Sub(ImVec2{window_border_size, 0}), GetColorU32FromID(ImGuiCol_MenuBarBg, 1), round, ImDrawFlags_RoundCornersTop)
+			window.DrawList.AddRectFilled(menu_bar_rect.Min.Add(ImVec2{window_border_size, 0}), menu_bar_rect.Max.Sub(ImVec2{window_border_size, 0}), g.GetColorU32FromID(ImGuiCol_MenuBarBg, 1), round, ImDrawFlags_RoundCornersTop)
 			if style.FrameBorderSize > 0.0 && menu_bar_rect.Max.y < window.Pos.y+window.Size.y {
 				from, to := menu_bar_rect.GetBL(), menu_bar_rect.GetBR()
-				window.DrawList.AddLine(&from, &to, GetColorU32FromID(ImGuiCol_Border, 1), style.FrameBorderSize)
+				window.DrawList.AddLine(&from, &to, g.GetColorU32FromID(ImGuiCol_Border, 1), style.FrameBorderSize)
 			}
 		}
 
 		// Scrollbars
 		if window.ScrollbarX {
-			Scrollbar(ImGuiAxis_X)
+			g.Scrollbar(ImGuiAxis_X)
 		}
 		if window.ScrollbarY {
-			Scrollbar(ImGuiAxis_Y)
+			g.Scrollbar(ImGuiAxis_Y)
 		}
 
 		// Render resize grips (after their input handling so we don't have a frame of latency)
@@ -119,16 +121,15 @@ func RenderWindowDecorations(window *ImGuiWindow, title_bar_rect *ImRect, title_
 		}
 
 		// Borders
-		RenderWindowOuterBorders(window)
+		g.RenderWindowOuterBorders(window)
 	}
 }
 
-func RenderWindowOuterBorders(window *ImGuiWindow) {
-	var g = GImGui
+func (g *ImGuiContext) RenderWindowOuterBorders(window *ImGuiWindow) {
 	var rounding = window.WindowRounding
 	var border_size = window.WindowBorderSize
 	if border_size > 0.0 && window.Flags&ImGuiWindowFlags_NoBackground == 0 {
-		window.DrawList.AddRect(window.Pos, window.Pos.Add(window.Size), GetColorU32FromID(ImGuiCol_Border, 1), rounding, 0, border_size)
+		window.DrawList.AddRect(window.Pos, window.Pos.Add(window.Size), g.GetColorU32FromID(ImGuiCol_Border, 1), rounding, 0, border_size)
 	}
 
 	var border_held = int(window.ResizeBorderHeld)
@@ -141,17 +142,16 @@ func RenderWindowOuterBorders(window *ImGuiWindow) {
 		window.DrawList.PathArcTo(
 			ImLerpVec2WithVec2(&border_r.Min, &border_r.Max, def.SegmentN2).
 				Add(ImVec2{0.5, 0.5}).Add(def.InnerDir.Scale(rounding)), rounding, def.OuterAngle, def.OuterAngle+IM_PI*0.25, 0)
-		window.DrawList.PathStroke(GetColorU32FromID(ImGuiCol_SeparatorActive, 1), 0, ImMax(2.0, border_size)) // Thicker than usual
+		window.DrawList.PathStroke(g.GetColorU32FromID(ImGuiCol_SeparatorActive, 1), 0, ImMax(2.0, border_size)) // Thicker than usual
 	}
 	if g.Style.FrameBorderSize > 0 && window.Flags&ImGuiWindowFlags_NoTitleBar == 0 {
 		var y = window.Pos.y + window.TitleBarHeight() - 1
-		window.DrawList.AddLine(&ImVec2{window.Pos.x + border_size, y}, &ImVec2{window.Pos.x + window.Size.x - border_size, y}, GetColorU32FromID(ImGuiCol_Border, 1), g.Style.FrameBorderSize)
+		window.DrawList.AddLine(&ImVec2{window.Pos.x + border_size, y}, &ImVec2{window.Pos.x + window.Size.x - border_size, y}, g.GetColorU32FromID(ImGuiCol_Border, 1), g.Style.FrameBorderSize)
 	}
 }
 
 // Render title text, collapse button, close button
-func RenderWindowTitleBarContents(window *ImGuiWindow, title_bar_rect *ImRect, name string, p_open *bool) {
-	var g = GImGui
+func (g *ImGuiContext) RenderWindowTitleBarContents(window *ImGuiWindow, title_bar_rect *ImRect, name string, p_open *bool) {
 	var style = g.Style
 	var flags = window.Flags
 
@@ -185,14 +185,14 @@ func RenderWindowTitleBarContents(window *ImGuiWindow, title_bar_rect *ImRect, n
 
 	// Collapse button (submitting first so it gets priority when choosing a navigation init fallback)
 	if has_collapse_button {
-		if CollapseButton(window.GetIDs("#COLLAPSE"), &collapse_button_pos) {
+		if g.CollapseButton(window.GetIDs("#COLLAPSE"), &collapse_button_pos) {
 			window.WantCollapseToggle = true // Defer actual collapsing to next frame as we are too far in the Begin() function
 		}
 	}
 
 	// Close button
 	if has_close_button {
-		if CloseButton(window.GetIDs("#CLOSE"), &close_button_pos) {
+		if g.CloseButton(window.GetIDs("#CLOSE"), &close_button_pos) {
 			*p_open = false
 		}
 	}
@@ -206,7 +206,7 @@ func RenderWindowTitleBarContents(window *ImGuiWindow, title_bar_rect *ImRect, n
 	if flags&ImGuiWindowFlags_UnsavedDocument != 0 {
 		marker_size_x = button_sz * 0.80
 	}
-	var text_size = CalcTextSize(name, true, -1).Add(ImVec2{marker_size_x, 0.0})
+	var text_size = g.CalcTextSize(name, true, -1).Add(ImVec2{marker_size_x, 0.0})
 
 	// As a nice touch we try to ensure that centered title text doesn't get affected by visibility of Close/Collapse button,
 	// while uncentered title text will still reach edges correctly.
@@ -230,11 +230,11 @@ func RenderWindowTitleBarContents(window *ImGuiWindow, title_bar_rect *ImRect, n
 		marker_pos.x = ImClamp(layout_r.Min.x+(layout_r.GetWidth()-text_size.x)*style.WindowTitleAlign.x+text_size.x, layout_r.Min.x, layout_r.Max.x)
 		marker_pos.y = (layout_r.Min.y + layout_r.Max.y) * 0.5
 		if marker_pos.x > layout_r.Min.x {
-			RenderBullet(window.DrawList, marker_pos, GetColorU32FromID(ImGuiCol_Text, 1))
+			RenderBullet(window.DrawList, marker_pos, g.GetColorU32FromID(ImGuiCol_Text, 1))
 			clip_r.Max.x = ImMin(clip_r.Max.x, marker_pos.x-float((int)(marker_size_x*0.5)))
 		}
 	}
 	//if (g.IO.KeyShift) window.DrawList.AddRect(layout_r.Min, layout_r.Max, IM_COL32(255, 128, 0, 255)); // [DEBUG]
 	//if (g.IO.KeyCtrl) window.DrawList.AddRect(clip_r.Min, clip_r.Max, IM_COL32(255, 128, 0, 255)); // [DEBUG]
-	RenderTextClipped(&layout_r.Min, &layout_r.Max, name, &text_size, &style.WindowTitleAlign, &clip_r)
+	g.RenderTextClipped(&layout_r.Min, &layout_r.Max, name, &text_size, &style.WindowTitleAlign, &clip_r)
 }
@@ -30,12 +30,12 @@ var resize_border_def = [4]ImGuiResizeBorderDef{
 	{ImVec2{0, -1}, ImVec2{1, 1}, ImVec2{0, 1}, IM_PI * 0.50}, // Down
 }
 
-func CalcResizePosSizeFromAnyCorner(window *ImGuiWindow, corner_target, corner_norm *ImVec2, out_pos, out_size *ImVec2) {
+func (g *ImGuiContext) CalcResizePosSizeFromAnyCorner(window *ImGuiWindow, corner_target, corner_norm *ImVec2, out_pos, out_size *ImVec2) {
 	a := window.Pos.Add(window.Size)
 	var pos_min = ImLerpVec2WithVec2(corner_target, &window.Pos, *corner_norm) // Expected window upper-left
 	var pos_max = ImLerpVec2WithVec2(&a, corner_target, *corner_norm)          // Expected window lower-right
 	var size_expected = pos_max.Sub(pos_min)
-	var size_constrained = CalcWindowSizeAfterConstraint(window, &size_expected)
+	var size_constrained = g.CalcWindowSizeAfterConstraint(window, &size_expected)
 	*out_pos = pos_min
 	if corner_norm.x == 0.0 {
 		out_pos.x -= (size_constrained.x - size_expected.x)
@@ -69,8 +69,7 @@ func GetResizeBorderRect(window *ImGuiWindow, border_n int, perp_padding, thickn
 
 // Handle resize for: Resize Grips, Borders, Gamepad
 // Return true when using auto-fit (double click on resize grip)
-func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border_held *int, resize_grip_count int, resize_grip_col *[4]ImU32, visibility_rect *ImRect) bool {
-	var g = GImGui
+func (g *ImGuiContext) UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border_held *int, resize_grip_count int, resize_grip_col *[4]ImU32, visibility_rect *ImRect) bool {
 	var flags = window.Flags
 
 	if (flags&ImGuiWindowFlags_NoResize != 0) || (flags&ImGuiWindowFlags_AlwaysAutoResize != 0) || window.AutoFitFramesX > 0 || window.AutoFitFramesY > 0 {
@@ -99,7 +98,7 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 	window.DC.NavLayerCurrent = ImGuiNavLayer_Menu
 
 	// Manual resize grips
-	PushString("#RESIZE")
+	g.PushString("#RESIZE")
 	for resize_grip_n := int(0); resize_grip_n < resize_grip_count; resize_grip_n++ {
 		var def = &resize_grip_def[resize_grip_n]
 
@@ -116,7 +115,7 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 			resize_rect.Min.y, resize_rect.Max.y = resize_rect.Max.y, resize_rect.Min.y
 		}
 		var resize_grip_id = window.GetIDInt(resize_grip_n) // == GetWindowResizeCornerID()
-		ButtonBehavior(&resize_rect, resize_grip_id, &hovered, &held, ImGuiButtonFlags_FlattenChildren|ImGuiButtonFlags_NoNavFocus)
+		g.ButtonBehavior(&resize_rect, resize_grip_id, &hovered, &held, ImGuiButtonFlags_FlattenChildren|ImGuiButtonFlags_NoNavFocus)
 		//GetForegroundDrawList(window).AddRect(resize_rect.Min, resize_rect.Max, IM_COL32(255, 255, 0, 255));
 		if hovered || held {
 			if resize_grip_n&1 != 0 {
@@ -128,9 +127,9 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 
 		if held && g.IO.MouseDoubleClicked[0] && resize_grip_n == 0 {
 			// Manual auto-fit when double-clicking
-			size_target = CalcWindowSizeAfterConstraint(window, size_auto_fit)
+			size_target = g.CalcWindowSizeAfterConstraint(window, size_auto_fit)
 			ret_auto_fit = true
-			ClearActiveID()
+			g.ClearActiveID()
 		} else if held {
 			// Resize from any of the four corners
 			// We don't use an incremental MouseDelta but rather compute an absolute target size based on mouse position
@@ -155,7 +154,7 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 			var corner_target = g.IO.MousePos.Sub(g.ActiveIdClickOffset).Add(
 				ImLerpVec2WithVec2(&ls, &rs, def.CornerPosN)) // Corner of the window corresponding to our corner grip
 			corner_target = ImClampVec2(&corner_target, &clamp_min, clamp_max)
-			CalcResizePosSizeFromAnyCorner(window, &corner_target, &def.CornerPosN, &pos_target, &size_target)
+			g.CalcResizePosSizeFromAnyCorner(window, &corner_target, &def.CornerPosN, &pos_target, &size_target)
 		}
 
 		// Only lower-left grip is visible before hovering/activating
@@ -168,7 +167,7 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 					c = ImGuiCol_ResizeGripHovered
 				}
 			}
-			resize_grip_col[resize_grip_n] = GetColorU32FromID(c, 1)
+			resize_grip_col[resize_grip_n] = g.GetColorU32FromID(c, 1)
 		}
 	}
 	for border_n := ImGuiDir(0); border_n < ImGuiDir(resize_border_count); border_n++ {
@@ -181,7 +180,7 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 		var hovered, held bool
 		var border_rect = GetResizeBorderRect(window, int(border_n), grip_hover_inner_size, WINDOWS_HOVER_PADDING)
 		var border_id = window.GetIDInt(int(border_n) + 4) // == GetWindowResizeBorderID()
-		ButtonBehavior(&border_rect, border_id, &hovered, &held, ImGuiButtonFlags_FlattenChildren)
+		g.ButtonBehavior(&border_rect, border_id, &hovered, &held, ImGuiButtonFlags_FlattenChildren)
 		//GetForegroundDrawLists(window).AddRect(border_rect.Min, border_rect.Max, IM_COL32(255, 255, 0, 255));
 		if (hovered && g.HoveredIdTimer > WINDOWS_RESIZE_FROM_EDGES_FEEDBACK_TIMER) || held {
 			if axis == ImGuiAxis_X {
@@ -220,10 +219,10 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 			border_target = ImClampVec2(&border_target, &clamp_min, clamp_max)
 
 			min := ImMinVec2(&def.SegmentN1, &def.SegmentN2)
-			CalcResizePosSizeFromAnyCorner(window, &border_target, &min, &pos_target, &size_target)
+			g.CalcResizePosSizeFromAnyCorner(window, &border_target, &min, &pos_target, &size_target)
 		}
 	}
-	PopID()
+	g.PopID()
 
 	// Restore nav layer
 	window.DC.NavLayerCurrent = ImGuiNavLayer_Main
@@ -232,10 +231,10 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 	if g.NavWindowingTarget != nil && g.NavWindowingTarget.RootWindow == window {
 		var nav_resize_delta ImVec2
 		if g.NavInputSource == ImGuiInputSource_Keyboard && g.IO.KeyShift {
-			nav_resize_delta = GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard, ImGuiInputReadMode_Down, 0, 0)
+			nav_resize_delta = g.GetNavInputAmount2d(ImGuiNavDirSourceFlags_Keyboard, ImGuiInputReadMode_Down, 0, 0)
 		}
 		if g.NavInputSource == ImGuiInputSource_Gamepad {
-			nav_resize_delta = GetNavInputAmount2d(ImGuiNavDirSourceFlags_PadDPad, ImGuiInputReadMode_Down, 0, 0)
+			nav_resize_delta = g.GetNavInputAmount2d(ImGuiNavDirSourceFlags_PadDPad, ImGuiInputReadMode_Down, 0, 0)
 		}
 		if nav_resize_delta.x != 0.0 || nav_resize_delta.y != 0.0 {
 			const NAV_RESIZE_SPEED float = 600
@@ -244,22 +243,22 @@ func UpdateWindowManualResize(window *ImGuiWindow, size_auto_fit *ImVec2, border
 			nav_resize_delta = ImMaxVec2(&nav_resize_delta, &yd)
 			g.NavWindowingToggleLayer = false
 			g.NavDisableMouseHover = true
-			resize_grip_col[0] = GetColorU32FromInt(uint(ImGuiCol_ResizeGripActive))
+			resize_grip_col[0] = g.GetColorU32FromInt(uint(ImGuiCol_ResizeGripActive))
 			// FIXME-NAV: Should store and accumulate into a separate size buffer to handle sizing constraints properly, right now a constraint will make us stuck.
 
 			desired := window.SizeFull.Add(nav_resize_delta)
-			size_target = CalcWindowSizeAfterConstraint(window, &desired)
+			size_target = g.CalcWindowSizeAfterConstraint(window, &desired)
 		}
 	}
 
 	// Apply back modified position/size to window
 	if size_target.x != FLT_MAX {
 		window.SizeFull = size_target
-		MarkIniSettingsDirtyWindow(window)
+		g.MarkIniSettingsDirtyWindow(window)
 	}
 	if pos_target.x != FLT_MAX {
 		window.Pos = *ImFloorVec(&pos_target)
-		MarkIniSettingsDirtyWindow(window)
+		g.MarkIniSettingsDirtyWindow(window)
 	}
 
 	window.Size = window.SizeFull
@@ -4,45 +4,42 @@ package imgui
 // Windows Scrolling
 
 // GetScrollX get scrolling amount [0 .. GetScrollMaxX()]
-func GetScrollX() float {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetScrollX() float {
+	var window = g.CurrentWindow
 	return window.Scroll.x
 }
 
 // GetScrollY get scrolling amount [0 .. GetScrollMaxY()]
-func GetScrollY() float {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetScrollY() float {
+	var window = g.CurrentWindow
 	return window.Scroll.y
 }
 
 // SetScrollX set scrolling amount [0 .. GetScrollMaxX()]
-func SetScrollX(scroll_x float) {
-	var g = GImGui
+func (g *ImGuiContext) SetScrollX(scroll_x float) {
 	setScrollX(g.CurrentWindow, scroll_x)
 }
 
 // SetScrollY set scrolling amount [0 .. GetScrollMaxY()]
-func SetScrollY(scroll_y float) {
-	var g = GImGui
+func (g *ImGuiContext) SetScrollY(scroll_y float) {
 	setScrollY(g.CurrentWindow, scroll_y)
 }
 
 // GetScrollMaxX get maximum scrolling amount ~~ ContentSize.x - WindowSize.x - DecorationsSize.x
-func GetScrollMaxX() float {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetScrollMaxX() float {
+	var window = g.CurrentWindow
 	return window.ScrollMax.x
 }
 
 // GetScrollMaxY get maximum scrolling amount ~~ ContentSize.y - WindowSize.y - DecorationsSize.y
-func GetScrollMaxY() float {
-	var window = GImGui.CurrentWindow
+func (g *ImGuiContext) GetScrollMaxY() float {
+	var window = g.CurrentWindow
 	return window.ScrollMax.y
 }
 
 // SetScrollHereX adjust scrolling amount to make current cursor position visible. center_x_ratio=0.0: left, 0.5: center, 1.0: right. When using to make a "default/current item" visible, consider using SetItemDefaultFocus() instead.
 // center_x_ratio: 0.0f left of last item, 0.5f horizontal center of last item, 1.0f right of last item.
-func SetScrollHereX(center_x_ratio float /*= 0.5*/) {
-	var g = GImGui
+func (g *ImGuiContext) SetScrollHereX(center_x_ratio float /*= 0.5*/) {
 	var window = g.CurrentWindow
 	var spacing_x = ImMax(window.WindowPadding.x, g.Style.ItemSpacing.x)
 	var target_pos_x = ImLerp(g.LastItemData.Rect.Min.x-spacing_x, g.LastItemData.Rect.Max.x+spacing_x, center_x_ratio)
@@ -54,32 +51,28 @@ func SetScrollHereX(center_x_ratio float /*= 0.5*/) {
 
 // SetScrollHereY adjust scrolling amount to make current cursor position visible. center_y_ratio=0.0: top, 0.5: center, 1.0: bottom. When using to make a "default/current item" visible, consider using SetItemDefaultFocus() instead.
 // center_y_ratio: 0.0f top of last item, 0.5f vertical center of last item, 1.0f bottom of last item.
-func SetScrollHereY(center_y_ratio float /*= 0.5*/) {
-	var g = GImGui
+func (g *ImGuiContext) SetScrollHereY(center_y_ratio float /*= 0.5*/) {
 	var window = g.CurrentWindow
 	var spacing_y = ImMax(window.WindowPadding.y, g.Style.ItemSpacing.y)
 	var target_pos_y = ImLerp(window.DC.CursorPosPrevLine.y-spacing_y, window.DC.CursorPosPrevLine.y+window.DC.PrevLineSize.y+spacing_y, center_y_ratio)
-	setScrollFromPosY(window, target_pos_y-window.Pos.y, center_y_ratio) // Convert from absolute to local pos
+	g.setScrollFromPosY(window, target_pos_y-window.Pos.y, center_y_ratio) // Convert from absolute to local pos
 
 	// Tweak: snap on edges when aiming at an item very close to the edge
 	window.ScrollTargetEdgeSnapDist.y = ImMax(0.0, window.WindowPadding.y-spacing_y)
 }
 
 // SetScrollFromPosX adjust scrolling amount to make given position visible. Generally GetCursorStartPos() + offset to compute a valid position
-func SetScrollFromPosX(local_x, center_x_ratio float /*= 0.5*/) {
-	var g = GImGui
+func (g *ImGuiContext) SetScrollFromPosX(local_x, center_x_ratio float /*= 0.5*/) {
 	setScrollFromPosX(g.CurrentWindow, local_x, center_x_ratio)
 }
 
 // SetScrollFromPosY adjust scrolling amount to make given position visible. Generally GetCursorStartPos() + offset to compute a valid position.
-func SetScrollFromPosY(local_y, center_y_ratio float /*= 0.5*/) {
-	var g = GImGui
-	setScrollFromPosY(g.CurrentWindow, local_y, center_y_ratio)
+func (g *ImGuiContext) SetScrollFromPosY(local_y, center_y_ratio float /*= 0.5*/) {
+	g.setScrollFromPosY(g.CurrentWindow, local_y, center_y_ratio)
 }
 
 // SetNextWindowScroll Use -1.0f on one axis to leave as-is
-func SetNextWindowScroll(scroll *ImVec2) {
-	var g = GImGui
+func (g *ImGuiContext) SetNextWindowScroll(scroll *ImVec2) {
 	g.NextWindowData.Flags |= ImGuiNextWindowDataFlags_HasScroll
 	g.NextWindowData.ScrollVal = *scroll
 }
@@ -115,7 +108,7 @@ func setScrollFromPosX(window *ImGuiWindow, local_x float, center_x_ratio float)
 	window.ScrollTargetEdgeSnapDist.x = 0.0
 }
 
-func setScrollFromPosY(window *ImGuiWindow, local_y float, center_y_ratio float) {
+func (g *ImGuiContext) setScrollFromPosY(window *ImGuiWindow, local_y float, center_y_ratio float) {
 	IM_ASSERT(center_y_ratio >= 0.0 && center_y_ratio <= 1.0)
 	var decoration_up_height = window.TitleBarHeight() + window.MenuBarHeight() // FIXME: Would be nice to have a more standardized access to our scrollable/client rect;
 	local_y -= decoration_up_height
@@ -124,8 +117,7 @@ func setScrollFromPosY(window *ImGuiWindow, local_y float, center_y_ratio float)
 	window.ScrollTargetEdgeSnapDist.y = 0.0
 }
 
-func ScrollToBringRectIntoView(window *ImGuiWindow, item_rect *ImRect) ImVec2 {
-	var g = GImGui
+func (g *ImGuiContext) ScrollToBringRectIntoView(window *ImGuiWindow, item_rect *ImRect) ImVec2 {
 	var window_rect = ImRect{window.InnerRect.Min.Sub(ImVec2{1, 1}), window.InnerRect.Max.Add(ImVec2{1, 1})}
 	//GetForegroundDrawList(window).AddRect(window_rect.Min, window_rect.Max, IM_COL32_WHITE); // [DEBUG]
 
@@ -137,18 +129,18 @@ func ScrollToBringRectIntoView(window *ImGuiWindow, item_rect *ImRect) ImVec2 {
 			setScrollFromPosX(window, item_rect.Max.x-window.Pos.x+g.Style.ItemSpacing.x, 1.0)
 		}
 		if item_rect.Min.y < window_rect.Min.y {
-			setScrollFromPosY(window, item_rect.Min.y-window.Pos.y-g.Style.ItemSpacing.y, 0.0)
+			g.setScrollFromPosY(window, item_rect.Min.y-window.Pos.y-g.Style.ItemSpacing.y, 0.0)
 		} else if item_rect.Max.y >= window_rect.Max.y {
-			setScrollFromPosY(window, item_rect.Max.y-window.Pos.y+g.Style.ItemSpacing.y, 1.0)
+			g.setScrollFromPosY(window, item_rect.Max.y-window.Pos.y+g.Style.ItemSpacing.y, 1.0)
 		}
 
-		var next_scroll = CalcNextScrollFromScrollTargetAndClamp(window)
+		var next_scroll = g.CalcNextScrollFromScrollTargetAndClamp(window)
 		delta_scroll = next_scroll.Sub(window.Scroll)
 	}
 
 	// Also scroll parent window to keep us into view if necessary
 	if window.Flags&ImGuiWindowFlags_ChildWindow != 0 {
-		delta_scroll = delta_scroll.Add(ScrollToBringRectIntoView(window.ParentWindow,
+		delta_scroll = delta_scroll.Add(g.ScrollToBringRectIntoView(window.ParentWindow,
 			&ImRect{item_rect.Min.Sub(delta_scroll), item_rect.Max.Sub(delta_scroll)}))
 	}
 
@@ -169,7 +161,7 @@ func CalcScrollEdgeSnap(target, snap_min, snap_max, snap_threshold, center_ratio
 	return target
 }
 
-func CalcNextScrollFromScrollTargetAndClamp(window *ImGuiWindow) ImVec2 {
+func (g *ImGuiContext) CalcNextScrollFromScrollTargetAndClamp(window *ImGuiWindow) ImVec2 {
 	var scroll = window.Scroll
 	if window.ScrollTarget.x < FLT_MAX {
 		var decoration_total_width = window.ScrollbarSizes.x